@@ -76,6 +76,7 @@ func main() {
 				cfg       gen.Config
 				storage   string
 				templates []string
+				features  []string
 				idtype    = idType(field.TypeInt)
 				cmd       = &cobra.Command{
 					Use:   "generate [flags] path",
@@ -86,7 +87,7 @@ func main() {
 					),
 					Args: cobra.ExactArgs(1),
 					Run: func(cmd *cobra.Command, path []string) {
-						opts := []entc.Option{entc.Storage(storage)}
+						opts := []entc.Option{entc.Storage(storage), entc.FeatureNames(features...)}
 						for _, tmpl := range templates {
 							typ := "dir"
 							if parts := strings.SplitN(tmpl, "=", 2); len(parts) > 1 {
@@ -124,6 +125,7 @@ func main() {
 			cmd.Flags().StringVar(&cfg.Header, "header", "", "override codegen header")
 			cmd.Flags().StringVar(&cfg.Target, "target", "", "target directory for codegen")
 			cmd.Flags().StringSliceVarP(&templates, "template", "", nil, "external templates to execute")
+			cmd.Flags().StringSliceVarP(&features, "feature", "", nil, "optional features to generate (e.g. json/equal)")
 			return cmd
 		}(),
 	)
@@ -7,6 +7,7 @@ package dialect
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"log"
 
@@ -88,16 +89,36 @@ func DebugWithContext(d Driver, logger func(context.Context, ...interface{})) Dr
 
 // Exec logs its params and calls the underlying driver Exec method.
 func (d *DebugDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
-	d.log(ctx, fmt.Sprintf("driver.Exec: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.Exec: query=%v args=%v", query, debugArgs(args)))
 	return d.Driver.Exec(ctx, query, args, v)
 }
 
 // Query logs its params and calls the underlying driver Query method.
 func (d *DebugDriver) Query(ctx context.Context, query string, args, v interface{}) error {
-	d.log(ctx, fmt.Sprintf("driver.Query: query=%v args=%v", query, args))
+	d.log(ctx, fmt.Sprintf("driver.Query: query=%v args=%v", query, debugArgs(args)))
 	return d.Driver.Query(ctx, query, args, v)
 }
 
+// debugArgs returns a copy of the given arguments, where byte slices (like
+// marshaled JSON values) are converted to strings in order to be readable.
+func debugArgs(args interface{}) interface{} {
+	vs, ok := args.([]interface{})
+	if !ok {
+		return args
+	}
+	out := make([]interface{}, len(vs))
+	for i, v := range vs {
+		switch b := v.(type) {
+		case []byte:
+			v = string(b)
+		case json.RawMessage:
+			v = string(b)
+		}
+		out[i] = v
+	}
+	return out
+}
+
 // Tx adds an log-id for the transaction and calls the underlying driver Tx command.
 func (d *DebugDriver) Tx(ctx context.Context) (Tx, error) {
 	tx, err := d.Driver.Tx(ctx)
@@ -119,13 +140,13 @@ type DebugTx struct {
 
 // Exec logs its params and calls the underlying transaction Exec method.
 func (d *DebugTx) Exec(ctx context.Context, query string, args, v interface{}) error {
-	d.log(ctx, fmt.Sprintf("Tx(%s).Exec: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).Exec: query=%v args=%v", d.id, query, debugArgs(args)))
 	return d.Tx.Exec(ctx, query, args, v)
 }
 
 // Query logs its params and calls the underlying transaction Query method.
 func (d *DebugTx) Query(ctx context.Context, query string, args, v interface{}) error {
-	d.log(ctx, fmt.Sprintf("Tx(%s).Query: query=%v args=%v", d.id, query, args))
+	d.log(ctx, fmt.Sprintf("Tx(%s).Query: query=%v args=%v", d.id, query, debugArgs(args)))
 	return d.Tx.Query(ctx, query, args, v)
 }
 
@@ -0,0 +1,275 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entsql provides SQL specific annotations for the schema objects.
+package entsql
+
+import (
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/schema/index"
+)
+
+// Annotation is a builtin schema annotation for attaching
+// SQL metadata to schema objects for both codegen and runtime.
+type Annotation struct {
+	// Incremental defines if the changes made on an element of a JSON array
+	// field (using the generated "Set<Elem>At" setters), should be applied on the
+	// database using partial updates (JSON_REPLACE or jsonb_set) instead of rewriting
+	// the whole column.
+	Incremental bool `json:"incremental,omitempty"`
+
+	// Type defines the column type of JSON fields in PostgreSQL. The supported
+	// values are "json" and "jsonb" (the default). Note that the "@>" operators
+	// and GIN indexes are available only for jsonb columns.
+	//
+	//	field.JSON("raw", json.RawMessage{}).
+	//		Annotations(entsql.Annotation{Type: "json"})
+	//
+	Type string `json:"type,omitempty"`
+
+	// Hashable defines if a "<Field>Hash" method should be generated for the
+	// JSON field on its entity, for computing the SHA-256 hash of its canonical
+	// JSON encoding. It can be used for detecting changes in the field content.
+	Hashable bool `json:"hashable,omitempty"`
+
+	// NonFinite defines if NaN and infinite values are supported by a JSON
+	// array of floats. These values are not part of the JSON standard, and
+	// therefore, are encoded as the strings "NaN", "+Inf" and "-Inf".
+	NonFinite bool `json:"non_finite,omitempty"`
+
+	// Backfill defines if the default value of a JSON field should be set on
+	// the existing rows of the table, when its column is added by the migration.
+	Backfill bool `json:"backfill,omitempty"`
+
+	// ForceJSON defines if the values of a JSON field should be encoded as JSON
+	// even if its Go type implements the sql.Scanner and driver.Valuer interfaces.
+	ForceJSON bool `json:"force_json,omitempty"`
+
+	// DefaultExpr defines the DEFAULT expression of the column in the database.
+	// Unlike the Go-side defaults, it is applied also on rows that are inserted
+	// outside ent. The expression is used as is (e.g. "'[]'" for an empty JSON
+	// array), and it is written in parentheses in MySQL, that supports default
+	// expressions for JSON columns only from version 8.0.13.
+	DefaultExpr string `json:"default_expr,omitempty"`
+
+	// Compress defines the compression algorithm of a JSON field. The values of
+	// the field are compressed after they are encoded, and stored in a binary
+	// column. The only supported algorithm is "gzip". Note that the JSON functions
+	// of the database cannot be used on compressed columns, and therefore, the JSON
+	// predicates and the incremental updates are not generated for these fields.
+	Compress string `json:"compress,omitempty"`
+
+	// Encrypt defines the identifier of the key that is used for encrypting the values
+	// of a JSON field at rest. The values are encrypted after they are encoded (and
+	// compressed), using the ent.Encrypter of the client, and stored in a binary column.
+	// Like compressed fields, the JSON predicates and the incremental updates are not
+	// generated for encrypted fields.
+	Encrypt string `json:"encrypt,omitempty"`
+
+	// SortedKeys defines if the values of a JSON field should be stored in a
+	// canonical form, with sorted object keys and without insignificant whitespaces.
+	// encoding/json sorts the keys of maps, but it encodes struct fields in their
+	// declaration order and raw values (e.g. json.RawMessage) as is. Hence, it is
+	// useful for fields that are compared or hashed by their stored bytes.
+	SortedKeys bool `json:"sorted_keys,omitempty"`
+
+	// Generated defines the expressions of a generated column per dialect (e.g.
+	// dialect.MySQL). The value of the column is computed by the database from the
+	// other columns of the row (e.g. from a JSON column), and it cannot be set by
+	// the generated builders. The expressions are used as is, and are written in
+	// the "GENERATED ALWAYS AS" clause of the column. Generated columns are virtual
+	// in MySQL and SQLite, and stored in PostgreSQL, that supports only them.
+	Generated map[string]string `json:"generated,omitempty"`
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "EntSQL"
+}
+
+// Incremental returns an annotation for enabling partial updates on
+// JSON array fields. For example:
+//
+//	field.Ints("ints").
+//		Annotations(entsql.Incremental())
+//
+func Incremental() *Annotation {
+	return &Annotation{Incremental: true}
+}
+
+// Hashable returns an annotation for generating a hash method for
+// a JSON field on its entity. For example:
+//
+//	field.JSON("meta", map[string]interface{}{}).
+//		Annotations(entsql.Hashable())
+//
+// Generates the following method:
+//
+//	func (u *User) MetaHash() ([sha256.Size]byte, error)
+//
+func Hashable() *Annotation {
+	return &Annotation{Hashable: true}
+}
+
+// NonFinite returns an annotation for supporting NaN and infinite
+// values in JSON arrays of floats. For example:
+//
+//	field.Floats("scores").
+//		Annotations(entsql.NonFinite())
+//
+func NonFinite() *Annotation {
+	return &Annotation{NonFinite: true}
+}
+
+// Backfill returns an annotation for setting the default value of a
+// JSON field on the existing rows of the table, when its column is
+// added by the migration. For example:
+//
+//	field.JSON("dirs", []http.Dir{}).
+//		Default([]http.Dir{"/tmp"}).
+//		Annotations(entsql.Backfill())
+//
+func Backfill() *Annotation {
+	return &Annotation{Backfill: true}
+}
+
+// ForceJSON returns an annotation for encoding the values of a JSON field
+// using JSON, when its Go type implements the sql.Scanner and driver.Valuer
+// interfaces. For example:
+//
+//	field.JSON("point", Point{}).
+//		Annotations(entsql.ForceJSON())
+//
+func ForceJSON() *Annotation {
+	return &Annotation{ForceJSON: true}
+}
+
+// DefaultExpr returns an annotation for setting the DEFAULT expression
+// of a JSON column in the database. For example:
+//
+//	field.Ints("ints").
+//		Annotations(entsql.DefaultExpr("'[]'"))
+//
+func DefaultExpr(expr string) *Annotation {
+	return &Annotation{DefaultExpr: expr}
+}
+
+// Compress returns an annotation for storing the values of a JSON
+// field compressed with the given algorithm. For example:
+//
+//	field.JSON("doc", json.RawMessage{}).
+//		Annotations(entsql.Compress("gzip"))
+//
+func Compress(algo string) *Annotation {
+	return &Annotation{Compress: algo}
+}
+
+// Encrypt returns an annotation for storing the values of a JSON field
+// encrypted with the key that has the given identifier. For example:
+//
+//	field.JSON("raw", json.RawMessage{}).
+//		Annotations(entsql.Encrypt("pii"))
+//
+func Encrypt(keyID string) *Annotation {
+	return &Annotation{Encrypt: keyID}
+}
+
+// SortedKeys returns an annotation for storing the values of a JSON
+// field in a canonical form, with sorted object keys. For example:
+//
+//	field.JSON("raw", json.RawMessage{}).
+//		Annotations(entsql.SortedKeys())
+//
+func SortedKeys() *Annotation {
+	return &Annotation{SortedKeys: true}
+}
+
+// Generated returns an annotation for defining a generated column, that
+// is computed using the given expression in all dialects. For example:
+//
+//	field.Int("ints_len").
+//		Optional().
+//		Annotations(entsql.Generated("JSON_LENGTH(`ints`)"))
+//
+// Use the Generated field of the Annotation for defining expressions that
+// are different between the dialects.
+func Generated(expr string) *Annotation {
+	return &Annotation{
+		Generated: map[string]string{
+			dialect.MySQL:    expr,
+			dialect.Postgres: expr,
+			dialect.SQLite:   expr,
+		},
+	}
+}
+
+// IndexAnnotation is a builtin schema annotation for attaching
+// SQL metadata to schema indexes for both codegen and runtime.
+type IndexAnnotation struct {
+	// Type defines the type (method) of the index. For example, GIN or GiST.
+	// Index types are supported only by PostgreSQL, and indexes that define
+	// them are skipped by the migration of the other dialects.
+	Type string `json:"type,omitempty"`
+
+	// JSONPath defines an index on the value stored in the given path of
+	// a JSON field. See JSONIndex for more info.
+	JSONPath string `json:"json_path,omitempty"`
+
+	// TSVector defines a full-text index on the string values of a JSON
+	// field. See TSVector for more info.
+	TSVector bool `json:"tsvector,omitempty"`
+}
+
+// Name describes the annotation name.
+func (IndexAnnotation) Name() string {
+	return "EntSQLIndexes"
+}
+
+// IndexType returns an index annotation for setting the type of the index.
+// For example, a GIN index on a jsonb column in PostgreSQL:
+//
+//	index.Fields("doc").
+//		Annotations(entsql.IndexType("GIN"))
+//
+func IndexType(t string) *IndexAnnotation {
+	return &IndexAnnotation{Type: t}
+}
+
+// JSONIndex returns an index on the value stored in the given path of a JSON
+// field. In MySQL, the value is extracted to a stored generated column (named
+// <field>_<path>), and the index is created on this column. Predicates that
+// check the existence of this path (e.g. sql.JSONHasKey) are rewritten to use
+// the generated column. For example:
+//
+//	func (User) Indexes() []ent.Index {
+//		return []ent.Index{
+//			entsql.JSONIndex("url", "$.Scheme"),
+//		}
+//	}
+//
+// Indexes on JSON paths are supported only by MySQL (>= 5.7.8), and they are
+// skipped by the migration of the other dialects.
+func JSONIndex(field, path string) *index.Builder {
+	return index.Fields(field).
+		Annotations(&IndexAnnotation{JSONPath: path})
+}
+
+// TSVector returns a full-text index on the string values of a JSON field.
+// The values are extracted to a stored generated column of type tsvector
+// (named <field>_tsv) using the "simple" text search configuration, and a
+// GIN index is created on this column. A "<Field>Matches" predicate is
+// generated for the field for searching it. For example:
+//
+//	func (User) Indexes() []ent.Index {
+//		return []ent.Index{
+//			entsql.TSVector("raw"),
+//		}
+//	}
+//
+// Full-text indexes are supported only by PostgreSQL (>= 12), and the
+// migration of the other dialects fails if the schema contains them.
+func TSVector(field string) *index.Builder {
+	return index.Fields(field).
+		Annotations(&IndexAnnotation{Type: "GIN", TSVector: true})
+}
@@ -6,10 +6,18 @@ package sql
 
 import (
 	"bytes"
+	"compress/gzip"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
+	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 
 	"github.com/facebook/ent/dialect"
@@ -483,6 +491,7 @@ type IndexBuilder struct {
 	name    string
 	unique  bool
 	table   string
+	method  string
 	columns []string
 }
 
@@ -516,6 +525,19 @@ func (i *IndexBuilder) Table(table string) *IndexBuilder {
 	return i
 }
 
+// Using sets the method (type) of the index. For example, GIN or GiST in PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		CreateIndex("index_name").
+//		Table("users").
+//		Using("GIN").
+//		Column("doc")
+//
+func (i *IndexBuilder) Using(method string) *IndexBuilder {
+	i.method = method
+	return i
+}
+
 // Column appends a column to the column list for the index.
 func (i *IndexBuilder) Column(column string) *IndexBuilder {
 	i.columns = append(i.columns, column)
@@ -537,7 +559,11 @@ func (i *IndexBuilder) Query() (string, []interface{}) {
 	i.WriteString("INDEX ")
 	i.Ident(i.name)
 	i.WriteString(" ON ")
-	i.Ident(i.table).Nested(func(b *Builder) {
+	i.Ident(i.table)
+	if i.method != "" {
+		i.WriteString(" USING ").WriteString(i.method)
+	}
+	i.Nested(func(b *Builder) {
 		b.IdentComma(i.columns...)
 	})
 	return i.String(), nil
@@ -709,6 +735,468 @@ func (u *UpdateBuilder) Add(column string, v interface{}) *UpdateBuilder {
 	return u
 }
 
+// JSONSet sets the value in the given path of the JSON column, without rewriting
+// the rest of the document. Multiple calls for the same column are combined into
+// one assignment. For example:
+//
+//	Update("users").JSONSet("ints", 99, "[2]").JSONSet("ints", 100, "[3]")
+//
+// Note that on SQLite, JSON values are stored as text. Hence, the JSON_SET function
+// rewrites the whole column in the database, but it is still applied atomically.
+func (u *UpdateBuilder) JSONSet(column string, v interface{}, path ...string) *UpdateBuilder {
+	return u.setJSON(column, v, path, false)
+}
+
+// JSONReplace is like JSONSet, but it replaces only values that already exist in the
+// JSON column, and paths that do not exist (e.g. array indexes that are out of range)
+// are ignored. For example:
+//
+//	Update("users").JSONReplace("ints", 99, "[2]")
+//
+// It uses the JSON_REPLACE function on MySQL and SQLite, and JSONB_SET with its
+// create_missing argument set to false on PostgreSQL.
+func (u *UpdateBuilder) JSONReplace(column string, v interface{}, path ...string) *UpdateBuilder {
+	return u.setJSON(column, v, path, true)
+}
+
+func (u *UpdateBuilder) setJSON(column string, v interface{}, path []string, replace bool) *UpdateBuilder {
+	for i, c := range u.columns {
+		if c != column {
+			continue
+		}
+		switch s := u.values[i].(type) {
+		case *jsonSet:
+			if s.replace == replace {
+				s.paths = append(s.paths, path)
+				s.values = append(s.values, v)
+				return u
+			}
+			u.values[i] = &jsonSet{column: column, base: s, replace: replace, paths: [][]string{path}, values: []interface{}{v}}
+			return u
+		}
+	}
+	return u.Set(column, &jsonSet{column: column, replace: replace, paths: [][]string{path}, values: []interface{}{v}})
+}
+
+// jsonSet is the expression for setting (or replacing)
+// values in JSON paths of a column.
+type jsonSet struct {
+	Builder
+	column  string
+	base    Querier
+	replace bool
+	paths   [][]string
+	values  []interface{}
+}
+
+// Query returns query representation of the JSON_SET (or JSON_REPLACE) expression.
+func (s *jsonSet) Query() (string, []interface{}) {
+	current := func() {
+		if s.base != nil {
+			s.Join(s.base)
+		} else {
+			s.Ident(s.column)
+		}
+	}
+	if s.postgres() {
+		for range s.paths {
+			s.WriteString("JSONB_SET(")
+		}
+		current()
+		for i, path := range s.paths {
+			s.Comma().WriteString(pgPath(path)).Comma().Arg(marshalArg(s.values[i]))
+			if s.replace {
+				s.WriteString(", false")
+			}
+			s.WriteByte(')')
+		}
+		return s.String(), s.args
+	}
+	if s.replace {
+		s.WriteString("JSON_REPLACE(")
+	} else {
+		s.WriteString("JSON_SET(")
+	}
+	current()
+	for i, path := range s.paths {
+		s.Comma().pathLiteral(path).Comma()
+		if s.mysql() {
+			s.WriteString("CAST(").Arg(marshalArg(s.values[i])).WriteString(" AS JSON)")
+		} else {
+			s.WriteString("JSON(").Arg(marshalArg(s.values[i])).WriteByte(')')
+		}
+	}
+	s.WriteByte(')')
+	return s.String(), s.args
+}
+
+// JSONAppend appends the given values (a slice or an array) to the JSON array
+// stored in the column. NULL columns are treated as empty arrays. For example:
+//
+//	Update("users").JSONAppend("strings", []string{"a", "b"})
+//
+// On SQLite, JSON values are stored as text. Hence, the stored array is read and
+// re-written with the new elements inserted at its end, within the same statement.
+// If the column was already set using JSONSet (or JSONRemove), the values are
+// appended after it.
+func (u *UpdateBuilder) JSONAppend(column string, vs interface{}) *UpdateBuilder {
+	for i, c := range u.columns {
+		if c != column {
+			continue
+		}
+		switch v := u.values[i].(type) {
+		case *jsonAppend:
+			v.values = append(v.values, vs)
+			return u
+		case *jsonSet, *jsonRemove:
+			u.values[i] = &jsonAppend{column: column, base: v.(Querier), values: []interface{}{vs}}
+			return u
+		}
+	}
+	return u.Set(column, &jsonAppend{column: column, values: []interface{}{vs}})
+}
+
+// jsonAppend is the expression for appending
+// values to a JSON array column.
+type jsonAppend struct {
+	Builder
+	column string
+	base   Querier
+	values []interface{}
+}
+
+// Query returns query representation of the JSON append expression.
+func (a *jsonAppend) Query() (string, []interface{}) {
+	var (
+		elems []json.RawMessage
+		empty = "JSON_ARRAY()"
+	)
+	for _, v := range a.values {
+		elems = append(elems, jsonElems(v)...)
+	}
+	if a.postgres() {
+		empty = "'[]'::jsonb"
+	}
+	current := func(b *Builder) {
+		b.WriteString("COALESCE(")
+		if a.base != nil {
+			b.Join(a.base)
+		} else {
+			b.Ident(a.column)
+		}
+		b.Comma().WriteString(empty).WriteByte(')')
+	}
+	switch {
+	case a.postgres():
+		current(&a.Builder)
+		a.WriteString(" || ").Arg(marshalArg(elems))
+	case a.mysql():
+		a.WriteString("JSON_ARRAY_APPEND(")
+		current(&a.Builder)
+		for _, e := range elems {
+			a.Comma().WriteString(`"$"`).Comma().WriteString("CAST(").Arg(string(e)).WriteString(" AS JSON)")
+		}
+		a.WriteByte(')')
+	default:
+		a.WriteString("JSON_INSERT(")
+		current(&a.Builder)
+		for _, e := range elems {
+			a.Comma().WriteString(`"$[#]"`).Comma().WriteString("JSON(").Arg(string(e)).WriteByte(')')
+		}
+		a.WriteByte(')')
+	}
+	return a.String(), a.args
+}
+
+// JSONRemove removes all occurrences of the given values (a slice or an array)
+// from the JSON array stored in the column. Values that do not exist in the array
+// are ignored, and therefore, the operation is idempotent. NULL columns are kept
+// NULL, and arrays that all their elements were removed become empty arrays:
+//
+//	Update("users").JSONRemove("ints", []int{1, 2})
+//
+// The array is unnested in a subquery, and its remaining elements are aggregated
+// back into an array. It uses jsonb_array_elements in PostgreSQL, JSON_TABLE in
+// MySQL (8.0 and above) and JSON_EACH in SQLite. Elements are compared by their JSON
+// values, and therefore, numbers do not match strings (e.g. 1 and "1"). If the column
+// was already set using JSONSet (or JSONAppend), the values are removed from its result.
+func (u *UpdateBuilder) JSONRemove(column string, vs interface{}) *UpdateBuilder {
+	for i, c := range u.columns {
+		if c != column {
+			continue
+		}
+		switch v := u.values[i].(type) {
+		case *jsonRemove:
+			v.values = append(v.values, vs)
+			return u
+		case *jsonSet, *jsonAppend:
+			u.values[i] = &jsonRemove{column: column, base: v.(Querier), values: []interface{}{vs}}
+			return u
+		}
+	}
+	return u.Set(column, &jsonRemove{column: column, values: []interface{}{vs}})
+}
+
+// jsonRemove is the expression for removing
+// values from a JSON array column.
+type jsonRemove struct {
+	Builder
+	column string
+	base   Querier
+	values []interface{}
+}
+
+// Query returns query representation of the JSON remove expression.
+func (r *jsonRemove) Query() (string, []interface{}) {
+	var elems []json.RawMessage
+	for _, v := range r.values {
+		elems = append(elems, jsonElems(v)...)
+	}
+	current := func(b *Builder) {
+		if r.base != nil {
+			b.Join(r.base)
+		} else {
+			b.Ident(r.column)
+		}
+	}
+	// The result of JSON_SET on a NULL column is NULL, and appended
+	// arrays are never NULL. Hence, only the column itself is checked.
+	_, appended := r.base.(*jsonAppend)
+	if !appended {
+		r.WriteString("CASE WHEN ").Ident(r.column).WriteOp(OpIsNull).WriteString(" THEN NULL ELSE ")
+	}
+	switch {
+	case r.postgres():
+		r.WriteString(`(SELECT COALESCE(jsonb_agg("e" ORDER BY "i"), '[]'::jsonb) FROM jsonb_array_elements(`)
+		current(&r.Builder)
+		r.WriteString(`) WITH ORDINALITY AS "t"("e", "i")`)
+		for i, e := range elems {
+			if i == 0 {
+				r.WriteString(" WHERE ")
+			} else {
+				r.WriteString(" AND ")
+			}
+			r.WriteString(`"e" <> `).Arg(string(e)).WriteString("::jsonb")
+		}
+		r.WriteByte(')')
+	case r.mysql():
+		// IN is not supported for JSON values in MySQL, and each value is compared separately.
+		r.WriteString("(SELECT COALESCE(JSON_ARRAYAGG(`e`), JSON_ARRAY()) FROM JSON_TABLE(")
+		current(&r.Builder)
+		r.WriteString(", '$[*]' COLUMNS(`e` JSON PATH '$')) AS `t`")
+		for i, e := range elems {
+			if i == 0 {
+				r.WriteString(" WHERE ")
+			} else {
+				r.WriteString(" AND ")
+			}
+			r.WriteString("`e` <> CAST(").Arg(string(e)).WriteString(" AS JSON)")
+		}
+		r.WriteByte(')')
+	default:
+		// JSON_EACH returns booleans as integers, and they are converted back to JSON.
+		r.WriteString("(SELECT JSON_GROUP_ARRAY(CASE `type` WHEN 'true' THEN JSON('true') WHEN 'false' THEN JSON('false') ELSE `value` END) FROM JSON_EACH(")
+		current(&r.Builder)
+		r.WriteByte(')')
+		for i, e := range elems {
+			if i == 0 {
+				r.WriteString(" WHERE NOT (")
+			} else {
+				r.WriteString(" AND NOT (")
+			}
+			// The `value` column of JSON_EACH is NULL for JSON nulls, an integer for booleans
+			// and a JSON text for objects and arrays. Hence, elements are matched by their type
+			// first, and containers are compared to the minified JSON text of the removed value.
+			var v interface{}
+			_ = json.Unmarshal(e, &v)
+			switch v.(type) {
+			case nil:
+				r.WriteString("`type` = 'null'")
+			case bool:
+				r.WriteString("`type` = '" + string(e) + "'")
+			case string:
+				r.WriteString("`type` = 'text' AND `value` = ").Arg(v)
+			case float64:
+				r.WriteString("`type` IN ('integer', 'real') AND `value` = ").Arg(v)
+			default:
+				r.WriteString("`type` IN ('object', 'array') AND `value` = JSON(").Arg(string(e)).WriteByte(')')
+			}
+			r.WriteByte(')')
+		}
+		r.WriteByte(')')
+	}
+	if !appended {
+		r.WriteString(" END")
+	}
+	return r.String(), r.args
+}
+
+// jsonElems returns the JSON encoding of the elements in the given
+// slice (or array). Non-array values are treated as a single element.
+func jsonElems(v interface{}) []json.RawMessage {
+	var elems []json.RawMessage
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(buf, &elems); err != nil {
+		return []json.RawMessage{buf}
+	}
+	return elems
+}
+
+// JSONMerge applies the given value as a JSON merge-patch (RFC 7386) on the JSON
+// document stored in the column. Keys with null values are removed from the stored
+// document, object values are merged recursively, and any other value overrides the
+// stored one. NULL columns (or non-object values) are treated as empty objects:
+//
+//	Update("users").JSONMerge("meta", json.RawMessage(`{"a": 1, "b": null}`))
+//
+// On MySQL and SQLite, the patch is applied using the JSON_MERGE_PATCH and JSON_PATCH
+// functions. On PostgreSQL, the patch is unfolded into a jsonb expression that follows
+// its structure. Calling JSONMerge more than once on the same column applies the
+// patches in order, and on PostgreSQL, they are folded into one patch beforehand.
+func (u *UpdateBuilder) JSONMerge(column string, v interface{}) *UpdateBuilder {
+	for i, c := range u.columns {
+		if m, ok := u.values[i].(*jsonMerge); ok && c == column {
+			m.patches = append(m.patches, v)
+			return u
+		}
+	}
+	return u.Set(column, &jsonMerge{column: column, patches: []interface{}{v}})
+}
+
+// jsonMerge is the expression for applying
+// JSON merge-patches on a column.
+type jsonMerge struct {
+	Builder
+	column  string
+	patches []interface{}
+}
+
+// Query returns query representation of the JSON merge-patch expression.
+func (m *jsonMerge) Query() (string, []interface{}) {
+	switch {
+	case m.postgres():
+		m.pgMerge()
+	case m.mysql():
+		m.WriteString("JSON_MERGE_PATCH(COALESCE(").Ident(m.column).WriteString(", JSON_OBJECT())")
+		for _, p := range m.patches {
+			m.Comma().WriteString("CAST(").Arg(marshalArg(p)).WriteString(" AS JSON)")
+		}
+		m.WriteByte(')')
+	default:
+		m.WriteString(strings.Repeat("JSON_PATCH(", len(m.patches)))
+		m.WriteString("COALESCE(").Ident(m.column).WriteString(", '{}')")
+		for _, p := range m.patches {
+			m.Comma().WriteString("JSON(").Arg(marshalArg(p)).WriteString("))")
+		}
+	}
+	return m.String(), m.args
+}
+
+// pgMerge writes the jsonb expression of applying the patches on the column.
+// The patches are folded into a single patch tree before the expression is
+// written, and therefore, the expression of a patch is not repeated by the
+// patches that follow it.
+func (m *jsonMerge) pgMerge() {
+	var root *pgPatch
+	for _, p := range m.patches {
+		buf, err := json.Marshal(p)
+		if err != nil {
+			m.Arg(p).WriteString("::jsonb")
+			return
+		}
+		root = root.fold(buf)
+	}
+	root.writeTo(m, func() { m.Ident(m.column) })
+}
+
+// pgPatch is a node in a tree of folded JSON merge-patches. A node with
+// a value replaces its target (or deletes it, if the value is null), and
+// a node without a value patches the keys of its target object.
+type pgPatch struct {
+	value json.RawMessage
+	keys  map[string]*pgPatch
+}
+
+// fold returns the node of applying the given patch after the node.
+// Object patches that are applied after a value are merged into the
+// value, and any other patch replaces the node.
+func (p *pgPatch) fold(patch json.RawMessage) *pgPatch {
+	var obj map[string]json.RawMessage
+	switch {
+	case !bytes.HasPrefix(patch, []byte("{")) || json.Unmarshal(patch, &obj) != nil:
+		return &pgPatch{value: patch}
+	case p != nil && p.value != nil:
+		return &pgPatch{value: mergePatch(p.value, obj)}
+	case p == nil:
+		p = &pgPatch{keys: make(map[string]*pgPatch, len(obj))}
+	}
+	for k, v := range obj {
+		p.keys[k] = p.keys[k].fold(v)
+	}
+	return p
+}
+
+// writeTo writes the jsonb expression of applying the node on the target.
+// Since the structure of the patch is known, it is unfolded into a sequence
+// of key deletions (for null values) and key assignments (for other values).
+// Keys and values are passed as arguments.
+func (p *pgPatch) writeTo(m *jsonMerge, target func()) {
+	if p.value != nil {
+		m.Arg(string(p.value)).WriteString("::jsonb")
+		return
+	}
+	keys := make([]string, 0, len(p.keys))
+	for k := range p.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	m.WriteString(strings.Repeat("(", len(keys)))
+	m.WriteString("(CASE WHEN jsonb_typeof(")
+	target()
+	m.WriteString(") = 'object' THEN ")
+	target()
+	m.WriteString(" ELSE '{}'::jsonb END)")
+	for _, k := range keys {
+		k := k
+		if string(p.keys[k].value) == "null" {
+			m.WriteString(" - ").Arg(k).WriteString("::text)")
+			continue
+		}
+		m.WriteString(" || jsonb_build_object(").Arg(k).WriteString("::text, ")
+		p.keys[k].writeTo(m, func() {
+			m.WriteByte('(')
+			target()
+			m.WriteString(" -> ").Arg(k).WriteString("::text)")
+		})
+		m.WriteString("))")
+	}
+}
+
+// mergePatch applies the given merge-patch object on the target
+// document, as defined in RFC 7386, and returns its JSON encoding.
+func mergePatch(target json.RawMessage, patch map[string]json.RawMessage) json.RawMessage {
+	var obj map[string]json.RawMessage
+	if !bytes.HasPrefix(target, []byte("{")) || json.Unmarshal(target, &obj) != nil {
+		obj = make(map[string]json.RawMessage, len(patch))
+	}
+	for k, v := range patch {
+		var sub map[string]json.RawMessage
+		switch {
+		case string(v) == "null":
+			delete(obj, k)
+		case bytes.HasPrefix(v, []byte("{")) && json.Unmarshal(v, &sub) == nil:
+			obj[k] = mergePatch(obj[k], sub)
+		default:
+			obj[k] = v
+		}
+	}
+	buf, _ := json.Marshal(obj)
+	return buf
+}
+
 // SetNull sets a column as null value.
 func (u *UpdateBuilder) SetNull(column string) *UpdateBuilder {
 	u.nulls = append(u.nulls, column)
@@ -911,90 +1399,1077 @@ func (p *Predicate) NEQ(col string, arg interface{}) *Predicate {
 	})
 }
 
-// LT returns a "<" predicate.
-func LT(col string, value interface{}) *Predicate {
-	return P().LT(col, value)
+// LT returns a "<" predicate.
+func LT(col string, value interface{}) *Predicate {
+	return P().LT(col, value)
+}
+
+// LT appends a "<" predicate.
+func (p *Predicate) LT(col string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.Ident(col)
+		b.WriteOp(OpLT)
+		b.Arg(arg)
+	})
+}
+
+// LTE returns a "<=" predicate.
+func LTE(col string, value interface{}) *Predicate {
+	return P().LTE(col, value)
+}
+
+// LTE appends a "<=" predicate.
+func (p *Predicate) LTE(col string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.Ident(col)
+		b.WriteOp(OpLTE)
+		b.Arg(arg)
+	})
+}
+
+// GT returns a ">" predicate.
+func GT(col string, value interface{}) *Predicate {
+	return P().GT(col, value)
+}
+
+// GT appends a ">" predicate.
+func (p *Predicate) GT(col string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.Ident(col)
+		b.WriteOp(OpGT)
+		b.Arg(arg)
+	})
+}
+
+// GTE returns a ">=" predicate.
+func GTE(col string, value interface{}) *Predicate {
+	return P().GTE(col, value)
+}
+
+// GTE appends a ">=" predicate.
+func (p *Predicate) GTE(col string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.Ident(col)
+		b.WriteOp(OpGTE)
+		b.Arg(arg)
+	})
+}
+
+// JSONHasKey calls Predicate.JSONHasKey.
+func JSONHasKey(col, path string) *Predicate {
+	return P().JSONHasKey(col, path)
+}
+
+// JSONHasKey return a predicate for checking that a JSON key exists and not NULL.
+// Numeric keys (e.g. "2" or "a.2") are treated as array indexes, and are identical
+// to "[2]" and "a[2]". Hence, JSONHasKey("ints", "2") checks that the JSON array has
+// an element at index 2.
+//
+//	P().JSONHasKey("column", "a.b[2].c")
+//	P().JSONHasKey("column", "2")
+//
+// Note that an index that is applied on a JSON object (or a key that is applied on
+// a JSON array) evaluates to NULL, except for MySQL that wraps non-array values
+// with an array. i.e. index 0 exists for all non-NULL values in MySQL.
+func (p *Predicate) JSONHasKey(col, path string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONHasKey", col, dotPath(path)) {
+			return
+		}
+		b.JSONPath(col, DotPath(path), indexKeys(), pathColumn()).WriteOp(OpNotNull)
+	})
+}
+
+// dotPath returns the MySQL format of the given dot-path
+// (with numeric keys as array indexes). e.g. "$.a[2]".
+func dotPath(dotpath string) string {
+	p := &JSONPath{}
+	DotPath(dotpath)(p)
+	indexKeys()(p)
+	return jsonPath(p.path, true)
+}
+
+// indexKeys converts the numeric keys of the
+// JSON path to array indexes. e.g. "2" => "[2]".
+func indexKeys() JSONOption {
+	return func(p *JSONPath) {
+		for i, s := range p.path {
+			if s != "" && isNumber(s) {
+				p.path[i] = "[" + s + "]"
+			}
+		}
+	}
+}
+
+// JSONPathHasKey calls Predicate.JSONPathHasKey.
+func JSONPathHasKey(col string, path ...string) *Predicate {
+	return P().JSONPathHasKey(col, path...)
+}
+
+// JSONPathHasKey return a predicate for checking that a JSON key exists
+// and not NULL. Unlike JSONHasKey, the path is given as a list of keys,
+// and array indexes (e.g. "[1]"). Missing intermediate keys do not fail
+// the query, but evaluate to NULL.
+//
+//	P().JSONPathHasKey("column", "a", "b", "[2]", "c")
+//
+func (p *Predicate) JSONPathHasKey(col string, path ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.postgres() {
+			b.Ident(col).WriteString(" #> ").WriteString(pgPath(path))
+		} else {
+			b.JSONPath(col, Path(path...), pathColumn())
+		}
+		b.WriteOp(OpNotNull)
+	})
+}
+
+// JSONValueEQ calls Predicate.JSONValueEQ.
+func JSONValueEQ(col, path string, arg interface{}) *Predicate {
+	return P().JSONValueEQ(col, path, arg)
+}
+
+// JSONValueEQ return a predicate for checking that a JSON
+// value (returned by the path) is equal to the given argument.
+//
+//	P().JSONValueEQ("column", "a.b[2].c", arg)
+//
+func (p *Predicate) JSONValueEQ(col, path string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.JSONPath(col, DotPath(path)).WriteOp(OpEQ).Arg(arg)
+	})
+}
+
+// JSONValuePathEQ calls Predicate.JSONValuePathEQ.
+func JSONValuePathEQ(col string, arg interface{}, path ...string) *Predicate {
+	return P().JSONValuePathEQ(col, arg, path...)
+}
+
+// JSONValuePathEQ return a predicate for checking that a JSON value (returned
+// by the path) is equal to the given argument. Unlike JSONValueEQ, the path is
+// given as a list of keys, and array indexes (e.g. "[1]"). Missing intermediate
+// keys do not fail the query, but evaluate to NULL, and therefore, are never
+// equal to the argument.
+//
+//	P().JSONValuePathEQ("column", arg, "a", "b", "[2]", "c")
+//
+func (p *Predicate) JSONValuePathEQ(col string, arg interface{}, path ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.postgres() {
+			b.Ident(col).WriteString(" #>> ").WriteString(pgPath(path))
+		} else {
+			b.JSONPath(col, Path(path...))
+		}
+		b.WriteOp(OpEQ).Arg(arg)
+	})
+}
+
+// JSONValueAfter calls Predicate.JSONValueAfter.
+func JSONValueAfter(col string, value interface{}, idCol string, id interface{}, path ...string) *Predicate {
+	return P().JSONValueAfter(col, value, idCol, id, path...)
+}
+
+// JSONValueAfter returns a predicate for cursor (keyset) pagination over results
+// that are ordered by the JSON value stored in the given path (see OrderByJSON),
+// and then by the ID column in ascending order. The cursor is the pair of the JSON
+// value and the ID of the last row in the previous page, and the predicate matches
+// the rows that come after it. Rows with equal values are tie-broken by their IDs.
+//
+// The value is either a Go value, or its JSON encoding (json.RawMessage), which
+// makes it easy to store in opaque cursors. A nil value (or JSON null) stands for
+// a missing path (or a NULL column), that is ordered first in MySQL and SQLite,
+// and last in PostgreSQL.
+//
+//	s.Where(JSONValueAfter(s.C("url"), "https", s.C("id"), 10, "Scheme"))
+//	OrderByJSON("url", "Scheme")(s)
+//	s.OrderBy(Asc(s.C("id")))
+//
+func (p *Predicate) JSONValueAfter(col string, value interface{}, idCol string, id interface{}, path ...string) *Predicate {
+	if raw, ok := value.(json.RawMessage); ok && string(raw) == "null" {
+		value = nil
+	}
+	return p.Append(func(b *Builder) {
+		nullsLast := b.postgres()
+		b.WriteByte('(')
+		defer b.WriteByte(')')
+		if value == nil {
+			b.WriteByte('(').JSONPath(col, Path(path...)).WriteOp(OpIsNull)
+			b.WriteString(" AND ").Ident(idCol).WriteOp(OpGT).Arg(id).WriteByte(')')
+			if !nullsLast {
+				b.WriteString(" OR ").JSONPath(col, Path(path...)).WriteOp(OpNotNull)
+			}
+			return
+		}
+		arg := func(b *Builder) {
+			switch {
+			case b.postgres():
+				b.Arg(marshalArg(value))
+			case b.mysql():
+				b.WriteString("CAST(").Arg(marshalArg(value)).WriteString(" AS JSON)")
+			default:
+				// JSON_EXTRACT returns SQL values in SQLite.
+				v := value
+				if raw, ok := value.(json.RawMessage); ok {
+					var u interface{}
+					if err := json.Unmarshal(raw, &u); err == nil {
+						v = u
+					}
+				}
+				b.Arg(v)
+			}
+		}
+		b.JSONPath(col, Path(path...)).WriteOp(OpGT)
+		arg(b)
+		b.WriteString(" OR (").JSONPath(col, Path(path...)).WriteOp(OpEQ)
+		arg(b)
+		b.WriteString(" AND ").Ident(idCol).WriteOp(OpGT).Arg(id).WriteByte(')')
+		if nullsLast {
+			b.WriteString(" OR ").JSONPath(col, Path(path...)).WriteOp(OpIsNull)
+		}
+	})
+}
+
+// JSONTypeEQ calls Predicate.JSONTypeEQ.
+func JSONTypeEQ(col, typ string, path ...string) *Predicate {
+	return P().JSONTypeEQ(col, typ, path...)
+}
+
+// JSONTypeEQ return a predicate for checking that the type of a JSON value
+// (returned by the path) is equal to the given type. The type is one of
+// "object", "array", "string", "number", "boolean" or "null", and it is
+// mapped to the type names of the dialect. Other types are passed to the
+// database as is. Missing keys evaluate to NULL, and never match any type.
+//
+//	P().JSONTypeEQ("column", "string", "a", "b")
+//
+func (p *Predicate) JSONTypeEQ(col, typ string, path ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		var types map[string][]string
+		switch {
+		case b.postgres():
+			b.WriteString("JSONB_TYPEOF(").JSONPath(col, Path(path...)).WriteByte(')')
+		case b.mysql():
+			types = jsonTypes[dialect.MySQL]
+			b.WriteString("JSON_TYPE(").JSONPath(col, Path(path...)).WriteByte(')')
+		default:
+			types = jsonTypes[dialect.SQLite]
+			// JSON_EXTRACT returns SQL values in SQLite.
+			b.WriteString("JSON_TYPE(").Ident(col).Comma().pathLiteral(path).WriteByte(')')
+		}
+		names, ok := types[typ]
+		if !ok {
+			names = []string{typ}
+		}
+		if len(names) == 1 {
+			b.WriteOp(OpEQ).Arg(names[0])
+			return
+		}
+		args := make([]interface{}, len(names))
+		for i := range names {
+			args[i] = names[i]
+		}
+		b.WriteOp(OpIn).Nested(func(b *Builder) {
+			b.Args(args...)
+		})
+	})
+}
+
+// jsonTypes maps the JSON types to their names in MySQL and SQLite.
+// PostgreSQL uses the same names in jsonb_typeof.
+var jsonTypes = map[string]map[string][]string{
+	dialect.MySQL: {
+		"object":  {"OBJECT"},
+		"array":   {"ARRAY"},
+		"string":  {"STRING"},
+		"number":  {"INTEGER", "UNSIGNED INTEGER", "DOUBLE", "DECIMAL"},
+		"boolean": {"BOOLEAN"},
+		"null":    {"NULL"},
+	},
+	dialect.SQLite: {
+		"string":  {"text"},
+		"number":  {"integer", "real"},
+		"boolean": {"true", "false"},
+	},
+}
+
+// JSONBoolEQ calls Predicate.JSONBoolEQ.
+func JSONBoolEQ(col string, v bool, path ...string) *Predicate {
+	return P().JSONBoolEQ(col, v, path...)
+}
+
+// JSONBoolEQ return a predicate for checking that the JSON value (returned by the path)
+// is the given boolean. The dialects represent the extracted booleans differently (e.g.
+// SQLite returns 1 and 0), and therefore, the value is compared as a JSON boolean in all
+// of them, and numbers (or strings) do not match the predicate. The path is passed to the
+// database as an argument, and therefore, it is safe to use with keys that are provided
+// at runtime.
+//
+//	P().JSONBoolEQ("column", true, "a", "b")
+//
+func (p *Predicate) JSONBoolEQ(col string, v bool, path ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.WriteByte('(').Ident(col).WriteString(" #> ").Arg(pgArray(path)).WriteString("::text[])::jsonb")
+			b.WriteOp(OpEQ).Arg(strconv.FormatBool(v)).WriteString("::jsonb")
+		case b.mysql():
+			b.WriteString("JSON_EXTRACT(").Ident(col).Comma().Arg(jsonPath(path, true)).WriteByte(')')
+			b.WriteOp(OpEQ).WriteString("CAST(").Arg(strconv.FormatBool(v)).WriteString(" AS JSON)")
+		default:
+			// JSON_EXTRACT returns SQL values in SQLite.
+			b.WriteString("JSON_TYPE(").Ident(col).Comma().Arg(jsonPath(path, false)).WriteByte(')')
+			b.WriteOp(OpEQ).Arg(strconv.FormatBool(v))
+		}
+	})
+}
+
+// JSONQueryParamEQ calls Predicate.JSONQueryParamEQ.
+func JSONQueryParamEQ(col, key, value string, path ...string) *Predicate {
+	return P().JSONQueryParamEQ(col, key, value, path...)
+}
+
+// JSONQueryParamEQ return a predicate for checking that the URL query string
+// stored in the JSON path (e.g. the "RawQuery" of url.URL) holds the given
+// parameter. Since query strings are stored as strings, the predicate matches
+// the encoded "key=value" pair using the LIKE operator (GLOB in SQLite, as its
+// LIKE is case-insensitive). Note that parameters that were encoded differently
+// (e.g. "a+b" and "a%20b") do not match.
+//
+//	P().JSONQueryParamEQ("column", "ref", "x", "RawQuery")
+//
+func (p *Predicate) JSONQueryParamEQ(col, key, value string, path ...string) *Predicate {
+	pair := url.QueryEscape(key) + "=" + url.QueryEscape(value)
+	return p.Append(func(b *Builder) {
+		// Encoded pairs do not contain the GLOB special
+		// characters, but may contain the LIKE ones.
+		op, any, escape, pair := " GLOB ", "*", "", pair
+		if b.postgres() || b.mysql() {
+			op, any, escape, pair = " LIKE ", "%", " ESCAPE '|'", likeEscaper.Replace(pair)
+		}
+		b.Nested(func(b *Builder) {
+			for i, pattern := range []string{pair, pair + "&" + any, any + "&" + pair, any + "&" + pair + "&" + any} {
+				if i > 0 {
+					b.WriteString(" OR ")
+				}
+				b.JSONPath(col, Path(path...), Unquote(true)).WriteString(op).Arg(pattern).WriteString(escape)
+			}
+		})
+	})
+}
+
+// likeEscaper escapes the LIKE special characters using the "|" escape character.
+var likeEscaper = strings.NewReplacer("|", "||", "%", "|%", "_", "|_")
+
+// JSONTextHasKey calls Predicate.JSONTextHasKey.
+func JSONTextHasKey(col, key string) *Predicate {
+	return P().JSONTextHasKey(col, key)
+}
+
+// JSONTextHasKey return a predicate for checking that a JSON document, that is
+// stored in a text column (e.g. LONGTEXT in MySQL 5.6), has the given key. Since
+// the JSON functions of the database cannot be used on such columns, the encoded
+// `"key":` pair is matched using the LIKE operator. Note that the predicate also
+// matches keys of nested objects, and documents that were not encoded compactly
+// (e.g. `{"key" : 1}`) do not match.
+//
+//	P().JSONTextHasKey("column", "a")
+//
+func (p *Predicate) JSONTextHasKey(col, key string) *Predicate {
+	return p.Append(func(b *Builder) {
+		pattern := "%" + likeEscaper.Replace(fmt.Sprint(marshalArg(key))+":") + "%"
+		b.Ident(col).WriteString(" LIKE ").Arg(pattern).WriteString(" ESCAPE '|'")
+	})
+}
+
+// JSONTextArrayContainsAny calls Predicate.JSONTextArrayContainsAny.
+func JSONTextArrayContainsAny(col string, values ...interface{}) *Predicate {
+	return P().JSONTextArrayContainsAny(col, values...)
+}
+
+// JSONTextArrayContainsAny return a predicate for checking that a JSON array, that
+// is stored in a text column (e.g. LONGTEXT in MySQL 5.6), contains at least one of
+// the given values. Like JSONTextHasKey, the encoded values are matched using the
+// LIKE operator in any position of the array. Hence, it requires the array to be
+// encoded compactly (as encoding/json does), and it is not accurate for strings
+// that hold encoded JSON elements (e.g. the string ",1," matches the number 1).
+//
+//	P().JSONTextArrayContainsAny("column", "a", "b")
+//
+func (p *Predicate) JSONTextArrayContainsAny(col string, values ...interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		if len(values) == 0 {
+			b.WriteString("FALSE")
+			return
+		}
+		b.Nested(func(b *Builder) {
+			for i, v := range values {
+				enc := likeEscaper.Replace(fmt.Sprint(marshalArg(v)))
+				for j, pattern := range []string{"[" + enc + "]", "[" + enc + ",%", "%," + enc + "]", "%," + enc + ",%"} {
+					if i > 0 || j > 0 {
+						b.WriteString(" OR ")
+					}
+					b.Ident(col).WriteString(" LIKE ").Arg(pattern).WriteString(" ESCAPE '|'")
+				}
+			}
+		})
+	})
+}
+
+// TextFallback returns a predicate that is rendered as the text predicate in the
+// given dialects, and as p in the rest. It is used for JSON columns that are stored
+// as text in some dialects (e.g. using field.JSON(...).SchemaType), where the JSON
+// functions of the database cannot be used.
+//
+//	TextFallback(JSONKeyExists("c", "a"), JSONTextHasKey("c", "a"), dialect.MySQL)
+//
+func TextFallback(p, text *Predicate, dialects ...string) *Predicate {
+	return P(func(b *Builder) {
+		pred := p
+		for _, d := range dialects {
+			if b.dialect == d {
+				pred = text
+			}
+		}
+		b.Join(pred)
+	})
+}
+
+// JSONKeyExists calls Predicate.JSONKeyExists.
+func JSONKeyExists(col, key string) *Predicate {
+	return P().JSONKeyExists(col, key)
+}
+
+// JSONKeyExists return a predicate for checking that a top-level key of
+// a JSON object exists and not NULL. Unlike JSONHasKey, the key is passed
+// to the database as an argument, and is not parsed as a JSON path. Hence,
+// it is safe to use with keys that are provided at runtime.
+//
+//	P().JSONKeyExists("column", "a.b")
+//
+func (p *Predicate) JSONKeyExists(col, key string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONKeyExists", col, key) {
+			return
+		}
+		switch {
+		case b.postgres():
+			b.Ident(col).WriteString("->").Arg(key).WriteString("::text").WriteOp(OpNotNull)
+		case b.mysql():
+			b.WriteString("JSON_EXTRACT(").Ident(col).Comma()
+			b.WriteString("CONCAT('$.', JSON_QUOTE(").Arg(key).WriteString(")))").WriteOp(OpNotNull)
+		default:
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			b.Ident("key").WriteOp(OpEQ).Arg(key).WriteString(" AND ").Ident("value").WriteOp(OpNotNull).WriteByte(')')
+		}
+	})
+}
+
+// JSONHasKeys calls Predicate.JSONHasKeys.
+func JSONHasKeys(col string, keys ...string) *Predicate {
+	return P().JSONHasKeys(col, keys...)
+}
+
+// JSONHasKeys return a predicate for checking that all the given top-level keys
+// exist in a JSON object. Like JSONKeyExists, the keys are passed to the database
+// as arguments. Unlike JSONKeyExists, keys that hold JSON null values match as well.
+//
+//	P().JSONHasKeys("column", "a", "b", "c")
+//
+// The predicate is written using the ?& operator in PostgreSQL, and as a conjunction
+// of key checks in MySQL and SQLite. An empty list of keys matches all rows.
+func (p *Predicate) JSONHasKeys(col string, keys ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.jsonHasKeys(col, "?&", "AND", keys)
+	})
+}
+
+// JSONHasAnyKey calls Predicate.JSONHasAnyKey.
+func JSONHasAnyKey(col string, keys ...string) *Predicate {
+	return P().JSONHasAnyKey(col, keys...)
+}
+
+// JSONHasAnyKey return a predicate for checking that at least one of the given
+// top-level keys exists in a JSON object. See JSONHasKeys for more info.
+//
+//	P().JSONHasAnyKey("column", "a", "b", "c")
+//
+// The predicate is written using the ?| operator in PostgreSQL, and as a disjunction
+// of key checks in MySQL and SQLite. An empty list of keys does not match any row.
+func (p *Predicate) JSONHasAnyKey(col string, keys ...string) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.jsonHasKeys(col, "?|", "OR", keys)
+	})
+}
+
+// jsonHasKeys writes the key checks of JSONHasKeys and JSONHasAnyKey, using
+// the given PostgreSQL operator, or the given logical operator in the rest.
+func (b *Builder) jsonHasKeys(col, pgOp, op string, keys []string) {
+	if len(keys) == 0 {
+		if op == "AND" {
+			b.WriteString("TRUE")
+		} else {
+			b.WriteString("FALSE")
+		}
+		return
+	}
+	if b.postgres() {
+		args := make([]interface{}, len(keys))
+		for i := range keys {
+			args[i] = keys[i]
+		}
+		b.Ident(col).WriteString(" " + pgOp + " ARRAY[").Args(args...).WriteString("]::text[]")
+		return
+	}
+	b.Nested(func(b *Builder) {
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteString(" " + op + " ")
+			}
+			if b.mysql() {
+				// JSON null values are extracted as JSON (and not SQL) NULL.
+				b.WriteString("JSON_EXTRACT(").Ident(col).Comma()
+				b.WriteString("CONCAT('$.', JSON_QUOTE(").Arg(k).WriteString(")))").WriteOp(OpNotNull)
+			} else {
+				b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+				b.Ident("key").WriteOp(OpEQ).Arg(k).WriteByte(')')
+			}
+		}
+	})
+}
+
+// JSONHasKeyFold calls Predicate.JSONHasKeyFold.
+func JSONHasKeyFold(col, key string) *Predicate {
+	return P().JSONHasKeyFold(col, key)
+}
+
+// JSONHasKeyFold return a predicate for checking that a top-level key of a JSON
+// object is equal to the given key under case-folding. Like JSONKeyExists, the key
+// is passed to the database as an argument. Unlike JSONKeyExists, keys that hold
+// JSON null values match as well.
+//
+//	P().JSONHasKeyFold("column", "env")
+//
+// Note that the keys of the object are lowercased and compared one by one, and
+// this predicate cannot use indexes (e.g. GIN indexes in PostgreSQL). Hence, it
+// should be used only on small result sets, or when the casing of keys cannot be
+// normalized on writes. Also, SQLite lowercases only ASCII characters.
+func (p *Predicate) JSONHasKeyFold(col, key string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONHasKeyFold", col, key) {
+			return
+		}
+		switch {
+		case b.postgres():
+			// jsonb_object_keys fails on non-object values.
+			b.WriteString("EXISTS(SELECT * FROM jsonb_object_keys(CASE WHEN jsonb_typeof(").Ident(col)
+			b.WriteString(") = 'object' THEN ").Ident(col).WriteString(" END) AS ").Ident("k")
+			b.WriteString(" WHERE lower(").Ident("k").WriteString(") = lower(").Arg(key).WriteString("))")
+		case b.mysql():
+			b.WriteString("JSON_CONTAINS(LOWER(JSON_KEYS(").Ident(col).WriteString(")), ")
+			b.WriteString("JSON_QUOTE(LOWER(").Arg(key).WriteString(")))")
+		default:
+			// Array indexes are also returned as keys by JSON_EACH.
+			b.WriteString("JSON_TYPE(").Ident(col).WriteString(") = 'object' AND ")
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			b.WriteString("LOWER(").Ident("key").WriteString(") = LOWER(").Arg(key).WriteString("))")
+		}
+	})
+}
+
+// JSONKeyEQ calls Predicate.JSONKeyEQ.
+func JSONKeyEQ(col, key string, arg interface{}) *Predicate {
+	return P().JSONKeyEQ(col, key, arg)
+}
+
+// JSONKeyEQ return a predicate for checking that the value stored in
+// a top-level key of a JSON object is equal to the given argument. Like
+// JSONKeyExists, the key is passed to the database as an argument.
+//
+//	P().JSONKeyEQ("column", "env", "prod")
+//
+func (p *Predicate) JSONKeyEQ(col, key string, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONKeyEQ", col, key, arg) {
+			return
+		}
+		switch {
+		case b.postgres():
+			b.Ident(col).WriteString("->>").Arg(key).WriteString("::text").WriteOp(OpEQ).Arg(arg)
+		case b.mysql():
+			b.WriteString("JSON_EXTRACT(").Ident(col).Comma()
+			b.WriteString("CONCAT('$.', JSON_QUOTE(").Arg(key).WriteString(")))").WriteOp(OpEQ).Arg(arg)
+		default:
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			b.Ident("key").WriteOp(OpEQ).Arg(key).WriteString(" AND ").Ident("value").WriteOp(OpEQ).Arg(arg).WriteByte(')')
+		}
+	})
+}
+
+// JSONArrayContains calls Predicate.JSONArrayContains.
+func JSONArrayContains(col string, value interface{}) *Predicate {
+	return P().JSONArrayContains(col, value)
+}
+
+// JSONArrayContains return a predicate for checking that a JSON
+// array (stored in the given column) contains the given value.
+//
+//	P().JSONArrayContains("column", 1)
+//	P().JSONArrayContains("column", "a")
+//
+// SQLite does not support JSON containment, and the predicate is written as an
+// EXISTS subquery on the JSON_EACH values of the array. Since these values keep
+// the SQL type of the JSON elements (INTEGER, REAL or TEXT) and are compared to
+// the argument without any affinity conversion, numbers never match strings (e.g.
+// 1 and "1"), the same as in MySQL and PostgreSQL.
+func (p *Predicate) JSONArrayContains(col string, value interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONArrayContains", col, marshalArg(value)) {
+			return
+		}
+		switch {
+		case b.postgres():
+			b.Ident(col).WriteString(" @> ").Arg(marshalArg(value))
+		case b.mysql():
+			b.WriteString("JSON_CONTAINS(").Ident(col).Comma()
+			b.WriteString("CAST(").Arg(marshalArg(value)).WriteString(" AS JSON))")
+		default:
+			// SQLite does not support JSON containment. Therefore, we
+			// iterate over the array elements and compare their values.
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			b.Ident("value").WriteOp(OpEQ).Arg(value).WriteByte(')')
+		}
+	})
+}
+
+// JSONArrayContainsAny calls Predicate.JSONArrayContainsAny.
+func JSONArrayContainsAny(col string, values ...interface{}) *Predicate {
+	return P().JSONArrayContainsAny(col, values...)
+}
+
+// JSONArrayContainsAny return a predicate for checking that a JSON array (stored
+// in the given column) shares at least one element with the given values.
+//
+//	P().JSONArrayContainsAny("column", 2, 5)
+//
+// The arrays are compared using JSON_OVERLAPS in MySQL, which is available only in
+// MySQL 8 (and older versions fail to execute the query). PostgreSQL does not support
+// the array overlap operator (&&) on jsonb values, and the predicate is written as a
+// containment check of any of the values (@> ANY). In SQLite, the elements of the array
+// are compared to the values in an EXISTS subquery on JSON_EACH. Empty arrays, NULL
+// columns and empty values never match the predicate.
+func (p *Predicate) JSONArrayContainsAny(col string, values ...interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			args := make([]interface{}, len(values))
+			for i := range values {
+				args[i] = marshalArg(values[i])
+			}
+			b.Ident(col).WriteString(" @> ANY(ARRAY[").Args(args...).WriteString("]::jsonb[])")
+		case b.mysql():
+			b.WriteString("JSON_OVERLAPS(").Ident(col).Comma()
+			b.WriteString("CAST(").Arg(marshalArg(values)).WriteString(" AS JSON))")
+		default:
+			b.WriteString("EXISTS(SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			b.Ident("value").WriteOp(OpIn).Nested(func(b *Builder) {
+				b.Args(values...)
+			}).WriteByte(')')
+		}
+	})
+}
+
+// JSONContainsValue calls Predicate.JSONContainsValue.
+func JSONContainsValue(col string, value interface{}) *Predicate {
+	return P().JSONContainsValue(col, value)
+}
+
+// JSONContainsValue return a predicate for checking that the given scalar value
+// (a string, number, boolean or nil for JSON null) is stored anywhere in the JSON
+// document of the given column, in any depth of nested objects and arrays.
+//
+//	P().JSONContainsValue("column", "a")
+//	P().JSONContainsValue("column", 1)
+//
+// Note that the predicate scans the whole document of each row, and it cannot use
+// any index. Hence, it should be used with care on large tables or documents, and
+// preferably combined with other (indexed) predicates. In PostgreSQL, the document
+// is searched using the jsonb_path_exists function and the `$.**` accessor, which
+// are available only in PostgreSQL 12 and above. In MySQL, all values of the document
+// are extracted using the `$**` wildcard and checked using JSON containment. In SQLite,
+// the nodes of the document are iterated using JSON_TREE. Numbers never match strings
+// (e.g. 1 and "1"), and NULL columns never match the predicate.
+func (p *Predicate) JSONContainsValue(col string, value interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.WriteString("jsonb_path_exists(").Ident(col).WriteString(", '$.** ? (@ == $v)', ")
+			b.Arg(marshalArg(map[string]interface{}{"v": value})).WriteByte(')')
+		case b.mysql():
+			b.WriteString("JSON_CONTAINS(JSON_EXTRACT(").Ident(col).WriteString(`, "$", "$**.*", "$**[*]")`).Comma()
+			b.WriteString("CAST(").Arg(marshalArg(value)).WriteString(" AS JSON))")
+		default:
+			// JSON_TREE represents booleans as integers (and JSON null as NULL).
+			// Therefore, these values are matched by their JSON type.
+			b.WriteString("EXISTS(SELECT * FROM JSON_TREE(").Ident(col).WriteString(") WHERE ")
+			switch v := value.(type) {
+			case nil:
+				b.Ident("type").WriteOp(OpEQ).WriteString("'null'")
+			case bool:
+				typ := "'false'"
+				if v {
+					typ = "'true'"
+				}
+				b.Ident("type").WriteOp(OpEQ).WriteString(typ)
+			default:
+				b.Ident("type").WriteString(" IN ('integer', 'real', 'text') AND ").Ident("atom").WriteOp(OpEQ).Arg(v)
+			}
+			b.WriteByte(')')
+		}
+	})
+}
+
+// JSONArrayAny calls Predicate.JSONArrayAny.
+func JSONArrayAny(col string, op func(string, interface{}) *Predicate, arg interface{}) *Predicate {
+	return P().JSONArrayAny(col, op, arg)
+}
+
+// JSONArrayAny return a predicate for checking that at least one element of
+// a JSON array (stored in the given column) matches the predicate created by
+// op. For example, it checks that one of the elements is greater than 2:
+//
+//	P().JSONArrayAny("column", GT, 2)
+//
+// The array is expanded using jsonb_array_elements in PostgreSQL, JSON_TABLE
+// in MySQL (8.0 and above) and json_each in SQLite. Note that, in PostgreSQL the
+// elements are compared as jsonb values (i.e. the argument is encoded as JSON).
+// Empty arrays and NULL columns never match the predicate.
+func (p *Predicate) JSONArrayAny(col string, op func(string, interface{}) *Predicate, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.WriteString("EXISTS")
+		b.jsonArrayElems(col, op, arg, false)
+	})
+}
+
+// JSONArrayAll calls Predicate.JSONArrayAll.
+func JSONArrayAll(col string, op func(string, interface{}) *Predicate, arg interface{}) *Predicate {
+	return P().JSONArrayAll(col, op, arg)
+}
+
+// JSONArrayAll return a predicate for checking that all elements of a JSON
+// array (stored in the given column) match the predicate created by op. For
+// example, it checks that all elements are less than 10:
+//
+//	P().JSONArrayAll("column", LT, 10)
+//
+// See JSONArrayAny for more info. Unlike JSONArrayAny, empty arrays and NULL
+// columns always match the predicate.
+func (p *Predicate) JSONArrayAll(col string, op func(string, interface{}) *Predicate, arg interface{}) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.WriteString("NOT EXISTS")
+		b.jsonArrayElems(col, op, arg, true)
+	})
+}
+
+// jsonArrayElems writes a subquery that selects the elements of the JSON
+// array that match the predicate, or that do not match it, if not is true.
+func (b *Builder) jsonArrayElems(col string, op func(string, interface{}) *Predicate, arg interface{}, not bool) {
+	b.Nested(func(b *Builder) {
+		var pred *Predicate
+		switch {
+		case b.postgres():
+			b.WriteString("SELECT * FROM jsonb_array_elements(").Ident(col).WriteString(`) AS "e" WHERE `)
+			pred = op("e", marshalArg(arg))
+		case b.mysql():
+			b.WriteString("SELECT * FROM JSON_TABLE(").Ident(col).WriteString(", '$[*]' COLUMNS(`e` JSON PATH '$')) AS `t` WHERE ")
+			pred = op("e", arg)
+		default:
+			b.WriteString("SELECT * FROM JSON_EACH(").Ident(col).WriteString(") WHERE ")
+			pred = op("value", arg)
+		}
+		if not {
+			pred = Not(pred)
+		}
+		b.Join(pred)
+	})
+}
+
+// JSONEQ calls Predicate.JSONEQ.
+func JSONEQ(col string, raw json.RawMessage) *Predicate {
+	return P().JSONEQ(col, raw)
+}
+
+// JSONEQ return a predicate for checking that the JSON document stored
+// in the given column is equal to the given raw value. The comparison
+// ignores the formatting of the documents and the order of object keys.
+//
+//	P().JSONEQ("column", json.RawMessage(`{"a": 1, "b": 2}`))
+//
+func (p *Predicate) JSONEQ(col string, raw json.RawMessage) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.Ident(col).WriteOp(OpEQ).Arg(string(raw))
+		case b.mysql():
+			b.Ident(col).WriteOp(OpEQ).WriteString("CAST(").Arg(string(raw)).WriteString(" AS JSON)")
+		default:
+			// SQLite stores JSON documents as text. Therefore, we check that all nodes of
+			// the column (identified by their full path) exist in the given document, and
+			// that the two documents have the same number of nodes.
+			b.WriteString("NOT EXISTS(SELECT * FROM JSON_TREE(").Ident(col).WriteString(") AS `j1` WHERE NOT EXISTS")
+			b.WriteString("(SELECT * FROM JSON_TREE(").Arg(string(raw)).WriteString(") AS `j2` WHERE ")
+			b.WriteString("`j1`.`fullkey` = `j2`.`fullkey` AND `j1`.`type` = `j2`.`type` AND `j1`.`atom` IS `j2`.`atom`))")
+			b.WriteString(" AND (SELECT COUNT(*) FROM JSON_TREE(").Ident(col).WriteString("))")
+			b.WriteOp(OpEQ).WriteString("(SELECT COUNT(*) FROM JSON_TREE(").Arg(string(raw)).WriteString("))")
+		}
+	})
+}
+
+// JSONColumnEQ calls Predicate.JSONColumnEQ.
+func JSONColumnEQ(col1, col2 string) *Predicate {
+	return P().JSONColumnEQ(col1, col2)
+}
+
+// JSONColumnEQ return a predicate for checking that the JSON documents
+// stored in the two given columns are equal.
+//
+//	P().JSONColumnEQ("a", "b")
+//
+// In MySQL, the documents are compared using JSON containment in both directions,
+// which ignores the order of object keys, but also the order and the multiplicity
+// of array elements (e.g. [1, 2] and [2, 1, 1] are equal). In PostgreSQL, the two
+// documents are compared as JSONB values. In SQLite, they are compared as text after
+// they were minified using the JSON function. Hence, documents with different order
+// of object keys are not equal. Note that documents that were encoded by ent (using
+// encoding/json) are always stored with sorted map keys.
+func (p *Predicate) JSONColumnEQ(col1, col2 string) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.Ident(col1).WriteString("::jsonb").WriteOp(OpEQ).Ident(col2).WriteString("::jsonb")
+		case b.mysql():
+			b.WriteString("JSON_CONTAINS(").Ident(col1).Comma().Ident(col2).WriteString(") AND ")
+			b.WriteString("JSON_CONTAINS(").Ident(col2).Comma().Ident(col1).WriteByte(')')
+		default:
+			b.WriteString("JSON(").Ident(col1).WriteByte(')').WriteOp(OpEQ)
+			b.WriteString("JSON(").Ident(col2).WriteByte(')')
+		}
+	})
+}
+
+// JSONLenEQ calls Predicate.JSONLenEQ.
+func JSONLenEQ(col string, n int) *Predicate {
+	return P().JSONLenEQ(col, n)
+}
+
+// JSONLenEQ return a predicate for checking that the length
+// of a JSON array (stored in the given column) is equal to n.
+//
+//	P().JSONLenEQ("column", 2)
+//
+func (p *Predicate) JSONLenEQ(col string, n int) *Predicate {
+	return p.Append(func(b *Builder) {
+		b.JSONLen(col).WriteOp(OpEQ).Arg(n)
+	})
+}
+
+// JSONIsEmptyObject calls Predicate.JSONIsEmptyObject.
+func JSONIsEmptyObject(col string) *Predicate {
+	return P().JSONIsEmptyObject(col)
+}
+
+// JSONIsEmptyObject return a predicate for checking that the JSON value stored
+// in the column is an empty object ({}). NULL values are not empty.
+//
+//	P().JSONIsEmptyObject("column")
+//
+func (p *Predicate) JSONIsEmptyObject(col string) *Predicate {
+	return p.jsonIsEmpty(col, "OBJECT", "{}")
+}
+
+// JSONIsEmptyArray calls Predicate.JSONIsEmptyArray.
+func JSONIsEmptyArray(col string) *Predicate {
+	return P().JSONIsEmptyArray(col)
+}
+
+// JSONIsEmptyArray return a predicate for checking that the JSON value stored
+// in the column is an empty array ([]). NULL values are not empty.
+//
+//	P().JSONIsEmptyArray("column")
+//
+func (p *Predicate) JSONIsEmptyArray(col string) *Predicate {
+	return p.jsonIsEmpty(col, "ARRAY", "[]")
+}
+
+// jsonIsEmpty appends a predicate for checking that the JSON value stored in the
+// column is of the given type (in its MySQL name), and has no elements. MySQL
+// checks the type and the length of the value, and the other dialects compare
+// it with the given empty document (after it was minified in SQLite).
+func (p *Predicate) jsonIsEmpty(col, typ, empty string) *Predicate {
+	return p.Append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.Ident(col).WriteString("::jsonb = '" + empty + "'::jsonb")
+		case b.mysql():
+			b.Nested(func(b *Builder) {
+				b.WriteString("JSON_TYPE(").Ident(col).WriteString(") = '" + typ + "' AND ")
+				b.WriteString("JSON_LENGTH(").Ident(col).WriteString(") = 0")
+			})
+		default:
+			b.WriteString("JSON(").Ident(col).WriteString(") = '" + empty + "'")
+		}
+	})
+}
+
+// JSONPathQuery calls Predicate.JSONPathQuery.
+func JSONPathQuery(col, path string) *Predicate {
+	return P().JSONPathQuery(col, path)
+}
+
+// JSONPathQuery return a predicate for checking that the given SQL/JSON path
+// returns any item for the JSON value stored in the column. Unlike the paths
+// of the other JSON predicates, the path is a PostgreSQL jsonpath expression,
+// and may contain filter expressions. For example:
+//
+//	P().JSONPathQuery("column", "$.a[*] ? (@ > 5)")
+//
+// The path is passed to the database as an argument. SQL/JSON paths are supported
+// only by PostgreSQL (12 and above), where the predicate uses jsonb_path_exists.
+// MySQL and SQLite fall back to checking that a value exists in the path, only for
+// paths that are composed of keys (e.g. $.a or $."a b") and array indexes (e.g. [0]),
+// and wildcard array indexes (e.g. [*]) in MySQL. For other paths, these dialects
+// write a call to jsonb_path_exists, and the database fails the query with an
+// error of an unknown function. Use RegisterJSONFunc for overriding this behavior.
+func (p *Predicate) JSONPathQuery(col, path string) *Predicate {
+	return p.Append(func(b *Builder) {
+		if b.jsonFunc("JSONPathQuery", col, path) {
+			return
+		}
+		switch {
+		case b.mysql() && isSimplePath(path, true):
+			// JSON null values are extracted as JSON nulls, and not as SQL NULLs.
+			b.WriteString("JSON_EXTRACT(").Ident(col).Comma().Arg(path).WriteByte(')').WriteOp(OpNotNull)
+		case b.dialect == dialect.SQLite && isSimplePath(path, false):
+			b.WriteString("JSON_TYPE(").Ident(col).Comma().Arg(path).WriteByte(')').WriteOp(OpNotNull)
+		default:
+			b.WriteString("jsonb_path_exists(").Ident(col).WriteString("::jsonb, ").Arg(path).WriteString("::jsonpath)")
+		}
+	})
+}
+
+// JSONLenGT calls Predicate.JSONLenGT.
+func JSONLenGT(col string, n int) *Predicate {
+	return P().JSONLenGT(col, n)
 }
 
-// LT appends a "<" predicate.
-func (p *Predicate) LT(col string, arg interface{}) *Predicate {
+// JSONLenGT return a predicate for checking that the length
+// of a JSON array (stored in the given column) is greater than n.
+//
+//	P().JSONLenGT("column", 2)
+//
+func (p *Predicate) JSONLenGT(col string, n int) *Predicate {
 	return p.Append(func(b *Builder) {
-		b.Ident(col)
-		p.WriteOp(OpLT)
-		b.Arg(arg)
+		b.JSONLen(col).WriteOp(OpGT).Arg(n)
 	})
 }
 
-// LTE returns a "<=" predicate.
-func LTE(col string, value interface{}) *Predicate {
-	return P().LTE(col, value)
+// JSONLenLT calls Predicate.JSONLenLT.
+func JSONLenLT(col string, n int) *Predicate {
+	return P().JSONLenLT(col, n)
 }
 
-// LTE appends a "<=" predicate.
-func (p *Predicate) LTE(col string, arg interface{}) *Predicate {
+// JSONLenLT return a predicate for checking that the length
+// of a JSON array (stored in the given column) is less than n.
+//
+//	P().JSONLenLT("column", 2)
+//
+func (p *Predicate) JSONLenLT(col string, n int) *Predicate {
 	return p.Append(func(b *Builder) {
-		b.Ident(col)
-		p.WriteOp(OpLTE)
-		b.Arg(arg)
+		b.JSONLen(col).WriteOp(OpLT).Arg(n)
 	})
 }
 
-// GT returns a ">" predicate.
-func GT(col string, value interface{}) *Predicate {
-	return P().GT(col, value)
+// JSONKeyCountEQ calls Predicate.JSONKeyCountEQ.
+func JSONKeyCountEQ(col string, n int) *Predicate {
+	return P().JSONKeyCountEQ(col, n)
 }
 
-// GT appends a ">" predicate.
-func (p *Predicate) GT(col string, arg interface{}) *Predicate {
-	return p.Append(func(b *Builder) {
-		b.Ident(col)
-		p.WriteOp(OpGT)
-		b.Arg(arg)
-	})
+// JSONKeyCountEQ return a predicate for checking that the number of top-level
+// keys of a JSON object (stored in the given column) is equal to n.
+//
+//	P().JSONKeyCountEQ("column", 2)
+//
+func (p *Predicate) JSONKeyCountEQ(col string, n int) *Predicate {
+	return p.jsonKeyCount(col, OpEQ, n)
 }
 
-// GTE returns a ">=" predicate.
-func GTE(col string, value interface{}) *Predicate {
-	return P().GTE(col, value)
+// JSONKeyCountGT calls Predicate.JSONKeyCountGT.
+func JSONKeyCountGT(col string, n int) *Predicate {
+	return P().JSONKeyCountGT(col, n)
 }
 
-// GTE appends a ">=" predicate.
-func (p *Predicate) GTE(col string, arg interface{}) *Predicate {
-	return p.Append(func(b *Builder) {
-		b.Ident(col)
-		p.WriteOp(OpGTE)
-		b.Arg(arg)
-	})
+// JSONKeyCountGT return a predicate for checking that the number of top-level
+// keys of a JSON object (stored in the given column) is greater than n.
+//
+//	P().JSONKeyCountGT("column", 2)
+//
+func (p *Predicate) JSONKeyCountGT(col string, n int) *Predicate {
+	return p.jsonKeyCount(col, OpGT, n)
 }
 
-// JSONHasKey calls Predicate.JSONHasKey.
-func JSONHasKey(col, path string) *Predicate {
-	return P().JSONHasKey(col, path)
+// JSONKeyCountLT calls Predicate.JSONKeyCountLT.
+func JSONKeyCountLT(col string, n int) *Predicate {
+	return P().JSONKeyCountLT(col, n)
 }
 
-// JSONHasKey return a predicate for checking that a JSON key exists and not NULL.
+// JSONKeyCountLT return a predicate for checking that the number of top-level
+// keys of a JSON object (stored in the given column) is less than n.
 //
-//	P().JSONHasKey("column", "a.b[2].c")
+//	P().JSONKeyCountLT("column", 2)
 //
-func (p *Predicate) JSONHasKey(col, path string) *Predicate {
+func (p *Predicate) JSONKeyCountLT(col string, n int) *Predicate {
+	return p.jsonKeyCount(col, OpLT, n)
+}
+
+// jsonKeyCount appends a predicate for comparing the number of top-level keys of the
+// JSON object stored in the column with n. The keys are counted using JSON_LENGTH in
+// MySQL, and by counting the rows of jsonb_object_keys and json_each in PostgreSQL and
+// SQLite. Values that are not JSON objects (e.g. arrays or NULLs) do not match the
+// predicate, as they are not counted (jsonb_object_keys fails on them in PostgreSQL).
+func (p *Predicate) jsonKeyCount(col string, op Op, n int) *Predicate {
 	return p.Append(func(b *Builder) {
-		b.JSONPath(col, DotPath(path)).WriteOp(OpNotNull)
+		if !b.jsonFunc("JSONKeyCount", col) {
+			b.WriteString("CASE WHEN ")
+			switch {
+			case b.postgres():
+				b.WriteString("JSONB_TYPEOF(").Ident(col).WriteString("::jsonb) = 'object' THEN ")
+				b.WriteString("(SELECT COUNT(*) FROM JSONB_OBJECT_KEYS(").Ident(col).WriteString("::jsonb))")
+			case b.mysql():
+				b.WriteString("JSON_TYPE(").Ident(col).WriteString(") = 'OBJECT' THEN ")
+				b.WriteString("JSON_LENGTH(").Ident(col).WriteByte(')')
+			default:
+				b.WriteString("JSON_TYPE(").Ident(col).WriteString(") = 'object' THEN ")
+				b.WriteString("(SELECT COUNT(*) FROM JSON_EACH(").Ident(col).WriteString("))")
+			}
+			b.WriteString(" END")
+		}
+		b.WriteOp(op).Arg(n)
 	})
 }
 
-// JSONValueEQ calls Predicate.JSONValueEQ.
-func JSONValueEQ(col, path string, arg interface{}) *Predicate {
-	return P().JSONValueEQ(col, path, arg)
+// TSMatch calls Predicate.TSMatch.
+func TSMatch(col, term string) *Predicate {
+	return P().TSMatch(col, term)
 }
 
-// JSONValueEQ return a predicate for checking that a JSON
-// value (returned by the path) is equal to the given argument.
+// TSMatch returns a predicate for the full-text search of the term in a tsvector
+// column (PostgreSQL only). The term is converted to a query using plainto_tsquery
+// and the "simple" configuration, that is also used by the entsql.TSVector indexes.
 //
-//	P().JSONValueEQ("column", "a.b[2].c", arg)
+//	P().TSMatch("raw_tsv", "term")
 //
-func (p *Predicate) JSONValueEQ(col, path string, arg interface{}) *Predicate {
+func (p *Predicate) TSMatch(col, term string) *Predicate {
 	return p.Append(func(b *Builder) {
-		b.JSONPath(col, DotPath(path)).WriteOp(OpEQ).Arg(arg)
+		b.Ident(col).WriteString(" @@ PLAINTO_TSQUERY('simple', ").Arg(term).WriteByte(')')
 	})
 }
 
@@ -1201,12 +2676,17 @@ func (p *Predicate) Append(f func(*Builder)) *Predicate {
 	return p
 }
 
-// Query returns query representation of a predicate.
+// Query returns query representation of a predicate. The predicate functions
+// are evaluated on each call, using the dialect of the query the predicate is
+// joined to (e.g. a Selector). Hence, dialect-specific predicates (like the JSON
+// predicates) can be created once, and reused in queries of different dialects.
 func (p *Predicate) Query() (string, []interface{}) {
+	b := p.Builder.clone()
 	for _, f := range p.fns {
-		f(&p.Builder)
+		f(&b)
 	}
-	return p.String(), p.args
+	p.total = b.total
+	return b.String(), b.args
 }
 
 // clone returns a shallow clone of p.
@@ -1227,6 +2707,9 @@ func (p *Predicate) mayWrap(preds []*Predicate, b *Builder, op string) {
 		defer b.WriteByte(')')
 	}
 	for i := range preds {
+		// The depth is restored after the predicate was rendered,
+		// as the predicate may be reused in a different position.
+		depth := preds[i].depth
 		preds[i].depth = p.depth + 1
 		if i > 0 {
 			b.WriteByte(' ')
@@ -1240,6 +2723,7 @@ func (p *Predicate) mayWrap(preds []*Predicate, b *Builder, op string) {
 		} else {
 			b.Join(preds[i])
 		}
+		preds[i].depth = depth
 	}
 }
 
@@ -1299,6 +2783,159 @@ func (f Func) Avg(ident string) string {
 	return f.byName("AVG", ident)
 }
 
+// JSONArraySum returns an aggregation function that sums the numeric elements
+// of the JSON arrays stored in the given column, across all rows of each group.
+//
+//	GroupBy(user.FieldName).
+//		Aggregate(sql.JSONArraySum(user.FieldInts)).
+//		Scan(ctx, &v)
+//
+// The elements of each array are expanded using jsonb_array_elements_text in
+// PostgreSQL, JSON_TABLE in MySQL and json_each in SQLite. Note that JSON_TABLE
+// is available only in MySQL 8, and older versions fail to execute the query.
+func JSONArraySum(column string) func(*Selector) string {
+	return func(s *Selector) string {
+		b := &Builder{dialect: s.dialect}
+		b.WriteString("SUM").Nested(func(b *Builder) {
+			b.Nested(func(b *Builder) {
+				switch {
+				case b.postgres():
+					b.WriteString(`SELECT SUM("e"::numeric) FROM jsonb_array_elements_text(`).Ident(s.C(column)).WriteString(`) AS "e"`)
+				case b.mysql():
+					b.WriteString("SELECT SUM(`t`.`e`) FROM JSON_TABLE(").Ident(s.C(column)).WriteString(", '$[*]' COLUMNS(`e` DOUBLE PATH '$')) AS `t`")
+				default:
+					b.WriteString("SELECT SUM(`value`) FROM json_each(").Ident(s.C(column)).WriteString(")")
+				}
+			})
+		})
+		return b.String()
+	}
+}
+
+// JSONArrayLen returns an aggregation function that sums the lengths of the
+// JSON arrays stored in the given column, across all rows of each group. When
+// grouping by the primary key, it returns the length of the array of each row.
+//
+//	GroupBy(user.FieldID).
+//		Aggregate(ent.As(sql.JSONArrayLen(user.FieldInts), "n")).
+//		Scan(ctx, &v)
+//
+// The length is computed using the same functions as the JSON length predicates,
+// and groups that contain only NULL values are aggregated to NULL.
+func JSONArrayLen(column string) func(*Selector) string {
+	return func(s *Selector) string {
+		b := &Builder{dialect: s.dialect}
+		b.WriteString("SUM").Nested(func(b *Builder) {
+			b.JSONLen(s.C(column))
+		})
+		return b.String()
+	}
+}
+
+// JSONValue returns a function that selects the value stored in the given JSON
+// path of the column, instead of the whole JSON document.
+//
+//	s := Select().From(Table("users"))
+//	s.Select(JSONValue("url", "Host")(s))
+//
+// Scalar values are extracted unquoted: as text in MySQL (JSON_UNQUOTE) and
+// PostgreSQL (the ->> operator), and as their SQL type in SQLite. Hence, both
+// numbers and strings can be scanned into Go strings, and numbers can also be
+// scanned into Go numeric types. Missing paths are selected as NULL values.
+func JSONValue(column string, path ...string) func(*Selector) string {
+	return func(s *Selector) string {
+		b := &Builder{dialect: s.dialect}
+		b.JSONPath(s.C(column), Path(path...), Unquote(true))
+		return b.String()
+	}
+}
+
+// JSONPathQueryFirst returns a function that selects the first item that is returned
+// by the given SQL/JSON path for the JSON value stored in the column, or NULL if there
+// are no items. Like JSONPathQuery, the path is a PostgreSQL jsonpath expression, and
+// it is supported only by PostgreSQL (using jsonb_path_query_first).
+//
+//	s := Select().From(Table("users"))
+//	s.Select(JSONPathQueryFirst("ints", "$[*] ? (@ > 5)")(s))
+//
+// Since selected columns cannot hold arguments, the path is written to the query as a
+// string literal (with its quotes escaped), and it should not be built from untrusted
+// input. MySQL and SQLite fall back to extracting the value stored in paths that are
+// composed of keys and array indexes (without wildcards). For other paths, these dialects
+// write a call to jsonb_path_query_first, and the database fails the query.
+func JSONPathQueryFirst(column, path string) func(*Selector) string {
+	return func(s *Selector) string {
+		b := &Builder{dialect: s.dialect}
+		lit := "'" + strings.ReplaceAll(path, "'", "''") + "'"
+		if b.mysql() {
+			lit = strings.ReplaceAll(lit, `\`, `\\`)
+		}
+		switch {
+		case !b.postgres() && isSimplePath(path, false):
+			b.WriteString("JSON_EXTRACT(").Ident(s.C(column)).Comma().WriteString(lit).WriteByte(')')
+		default:
+			b.WriteString("jsonb_path_query_first(").Ident(s.C(column)).WriteString("::jsonb, ").WriteString(lit + "::jsonpath)")
+		}
+		return b.String()
+	}
+}
+
+// JSONExtract returns a Querier that extracts the value stored in the given JSON
+// path of the column. Unlike the Path option, the segments of the path are not
+// written to the query text, and therefore, the path can be built at runtime from
+// untrusted input. For example:
+//
+//	P(func(b *Builder) {
+//		b.Join(JSONExtract("meta", []string{"env", key})).WriteOp(OpNotNull)
+//	})
+//
+// In PostgreSQL, each segment is bound as an element of the text array of the
+// #> operator. In MySQL and SQLite, the path is bound as a single argument, where
+// each key is quoted, and therefore, segments like "a.b" or "*" are matched as
+// keys, and not as paths or wildcards. Segments in the format "[n]" are used as
+// array indexes. Since SQLite does not support escaping in quoted keys, paths
+// with keys that contain double quotes are written as NULL in this dialect.
+func JSONExtract(column string, path []string) Querier {
+	return &jsonExtract{column: column, path: path}
+}
+
+// jsonExtract is the Querier returned by JSONExtract.
+type jsonExtract struct {
+	Builder
+	column string
+	path   []string
+}
+
+// Query returns query representation of the JSON extraction.
+func (e *jsonExtract) Query() (string, []interface{}) {
+	b := e.Builder.clone()
+	switch {
+	case len(e.path) == 0:
+		b.Ident(e.column)
+	case b.postgres():
+		b.Ident(e.column).WriteString(" #> ARRAY[")
+		for i, s := range e.path {
+			if i > 0 {
+				b.Comma()
+			}
+			if idx, ok := isJSONIdx(s); ok {
+				s = idx
+			}
+			b.Arg(s)
+		}
+		b.WriteString("]::text[]")
+	default:
+		path, ok := quotedPath(e.path, b.mysql())
+		if !ok {
+			b.WriteString("NULL")
+			break
+		}
+		b.WriteString("JSON_EXTRACT(").Ident(e.column).Comma().Arg(path).WriteByte(')')
+	}
+	e.total = b.total
+	return b.String(), b.args
+}
+
 // byName wraps an identifier with a function name.
 func (f Func) byName(fn, ident string) string {
 	f.WriteString(fn)
@@ -1421,19 +3058,20 @@ func (j join) clone() join {
 // Selector is a builder for the `SELECT` statement.
 type Selector struct {
 	Builder
-	as       string
-	columns  []string
-	from     TableView
-	joins    []join
-	where    *Predicate
-	or       bool
-	not      bool
-	order    []string
-	group    []string
-	having   *Predicate
-	limit    *int
-	offset   *int
-	distinct bool
+	as        string
+	columns   []string
+	from      TableView
+	joins     []join
+	where     *Predicate
+	or        bool
+	not       bool
+	order     []string
+	group     []string
+	having    *Predicate
+	limit     *int
+	offset    *int
+	distinct  bool
+	forUpdate bool
 }
 
 // Select returns a new selector for the `SELECT` statement.
@@ -1612,6 +3250,17 @@ func (s *Selector) Count(columns ...string) *Selector {
 	return s
 }
 
+// ForUpdate sets the selector to lock the selected rows against concurrent updates until
+// the end of the transaction, using the FOR UPDATE clause. SQLite does not support row-level
+// locking (writing transactions lock the whole database), and the clause is omitted there.
+//
+//	Select().From(Table("users")).Where(EQ("id", 1)).ForUpdate()
+//
+func (s *Selector) ForUpdate() *Selector {
+	s.forUpdate = true
+	return s
+}
+
 // Clone returns a duplicate of the selector, including all associated steps. It can be
 // used to prepare common SELECT statements and use them differently after the clone is made.
 func (s *Selector) Clone() *Selector {
@@ -1623,20 +3272,21 @@ func (s *Selector) Clone() *Selector {
 		joins[i] = s.joins[i].clone()
 	}
 	return &Selector{
-		Builder:  s.Builder.clone(),
-		as:       s.as,
-		or:       s.or,
-		not:      s.not,
-		from:     s.from,
-		limit:    s.limit,
-		offset:   s.offset,
-		distinct: s.distinct,
-		where:    s.where.clone(),
-		having:   s.having.clone(),
-		joins:    append([]join{}, joins...),
-		group:    append([]string{}, s.group...),
-		order:    append([]string{}, s.order...),
-		columns:  append([]string{}, s.columns...),
+		Builder:   s.Builder.clone(),
+		as:        s.as,
+		or:        s.or,
+		not:       s.not,
+		from:      s.from,
+		limit:     s.limit,
+		offset:    s.offset,
+		distinct:  s.distinct,
+		forUpdate: s.forUpdate,
+		where:     s.where.clone(),
+		having:    s.having.clone(),
+		joins:     append([]join{}, joins...),
+		group:     append([]string{}, s.group...),
+		order:     append([]string{}, s.order...),
+		columns:   append([]string{}, s.columns...),
 	}
 }
 
@@ -1654,6 +3304,31 @@ func Desc(column string) string {
 	return b.String()
 }
 
+// OrderByJSON returns a function that orders the selector results by the
+// JSON value stored in the given path of the column. The values are compared
+// using the JSON ordering of each dialect. Hence, numbers are ordered numerically,
+// and strings are ordered using a binary collation in MySQL and SQLite, and the
+// default collation of the database in PostgreSQL. Scalar values with mixed types
+// are ordered by their type first:
+//
+//	MySQL:      JSON_EXTRACT(col, path). Numbers < Strings < Booleans.
+//	PostgreSQL: col->path (jsonb).       Strings < Numbers < Booleans.
+//	SQLite:     JSON_EXTRACT(col, path). Numbers and Booleans (0/1) < Strings.
+//
+// Missing paths (and NULL columns) are ordered first, except for PostgreSQL
+// that orders them last.
+//
+//	s.Where(...).OrderBy(...)
+//	OrderByJSON("column", "a", "b")(s)
+//
+func OrderByJSON(column string, path ...string) func(*Selector) {
+	return func(s *Selector) {
+		b := &Builder{dialect: s.dialect}
+		b.JSONPath(s.C(column), Path(path...))
+		s.OrderBy(b.String())
+	}
+}
+
 // OrderBy appends the `ORDER BY` clause to the `SELECT` statement.
 func (s *Selector) OrderBy(columns ...string) *Selector {
 	s.order = append(s.order, columns...)
@@ -1740,6 +3415,10 @@ func (s *Selector) Query() (string, []interface{}) {
 		b.WriteString(" OFFSET ")
 		b.Arg(*s.offset)
 	}
+	// SQLite does not support row-level locking.
+	if s.forUpdate && (b.postgres() || b.mysql()) {
+		b.WriteString(" FOR UPDATE")
+	}
 	s.total = b.total
 	return b.String(), b.args
 }
@@ -2014,6 +3693,17 @@ type JSONPath struct {
 	path    []string
 	cast    string
 	unquote bool
+	column  bool
+}
+
+// pathColumn indicates that the JSON path can be replaced with a generated
+// column that was registered for it using RegisterJSONPathColumn (MySQL only).
+// Note that the generated column holds the unquoted value of the path, and
+// therefore, it can be used only for checking the existence of the path.
+func pathColumn() JSONOption {
+	return func(p *JSONPath) {
+		p.column = true
+	}
 }
 
 // writeTo writes the JSON path to the builder.
@@ -2021,6 +3711,8 @@ func (p *JSONPath) writeTo(b *Builder) {
 	switch {
 	case len(p.path) == 0:
 		b.Ident(p.ident)
+	case p.column && b.mysql() && pathColumns.lookup(b, p):
+		// Generated column was written by lookup.
 	case b.postgres():
 		if p.cast != "" {
 			b.WriteString("CAST(")
@@ -2035,7 +3727,7 @@ func (p *JSONPath) writeTo(b *Builder) {
 			if idx, ok := isJSONIdx(s); ok {
 				b.WriteString(idx)
 			} else {
-				b.WriteString("'" + s + "'")
+				b.WriteString("'" + strings.ReplaceAll(s, "'", "''") + "'")
 			}
 		}
 	default:
@@ -2045,15 +3737,7 @@ func (p *JSONPath) writeTo(b *Builder) {
 		}
 		b.WriteString("JSON_EXTRACT(")
 		b.Ident(p.ident).Comma()
-		b.WriteString(`"$`)
-		for _, p := range p.path {
-			if _, ok := isJSONIdx(p); ok {
-				b.WriteString(p)
-			} else {
-				b.WriteString("." + p)
-			}
-		}
-		b.WriteString(`")`)
+		b.pathLiteral(p.path).WriteByte(')')
 	}
 }
 
@@ -2070,6 +3754,158 @@ func (b *Builder) JSONPath(ident string, opts ...JSONOption) *Builder {
 	return b
 }
 
+// pathColumns holds the generated columns that were registered for JSON paths.
+var pathColumns = &jsonColumns{m: make(map[string]string)}
+
+// jsonColumns is a registry of generated columns. The keys are formatted
+// as "table.column:path" for qualified columns, and "column:path" for
+// unqualified columns. The latter is empty if it's ambiguous.
+type jsonColumns struct {
+	sync.RWMutex
+	m map[string]string
+}
+
+// RegisterJSONPathColumn registers a generated column that holds the value
+// stored in the given JSON path of a table column. Predicates that check the
+// existence of this path (e.g. JSONHasKey) are written using the generated
+// column (with its index) in MySQL. The path is given in the MySQL format.
+//
+//	RegisterJSONPathColumn("users", "url", "$.Scheme", "url_scheme")
+//
+func RegisterJSONPathColumn(table, column, path, generated string) {
+	pathColumns.Lock()
+	defer pathColumns.Unlock()
+	pathColumns.m[table+"."+column+":"+path] = generated
+	if c, ok := pathColumns.m[column+":"+path]; ok && c != generated {
+		generated = ""
+	}
+	pathColumns.m[column+":"+path] = generated
+}
+
+// lookup writes the generated column registered for
+// the given JSON path, and reports if it was found.
+func (c *jsonColumns) lookup(b *Builder, p *JSONPath) bool {
+	c.RLock()
+	defer c.RUnlock()
+	path := jsonPath(p.path, true)
+	parts := strings.Split(strings.Replace(p.ident, "`", "", -1), ".")
+	switch generated := c.m[strings.Join(parts, ".")+":"+path]; {
+	case generated == "":
+		return false
+	case len(parts) == 2:
+		b.Ident(parts[0]).WriteByte('.').Ident(generated)
+	default:
+		b.Ident(generated)
+	}
+	return true
+}
+
+// dialectFuncs holds the JSON functions that were registered for dialects.
+var dialectFuncs = &jsonFuncs{m: make(map[string]string)}
+
+// jsonFuncs is a registry of JSON function templates. The keys
+// are formatted as "dialect:name". e.g. "mysql:JSONHasKey".
+type jsonFuncs struct {
+	sync.RWMutex
+	m map[string]string
+}
+
+// RegisterJSONFunc registers a template that overrides how the given JSON
+// predicate (or function) is written for the dialect. It allows supporting
+// databases that are compatible with one of the dialects, but differ in their
+// JSON functions (e.g. MariaDB). In the template, "{col}" is replaced with the
+// quoted column identifier, and "{0}", "{1}", etc are replaced with the input
+// arguments of the predicate (in order) as placeholders. Other text, including
+// braces, is written as is.
+//
+//	RegisterJSONFunc(dialect.MySQL, "JSONKeyExists", "JSON_EXISTS({col}, CONCAT('$.', {0}))")
+//
+// The names that can be overridden, and their arguments are:
+//
+//	JSONHasKey(path)		the path in MySQL format, e.g. "$.a.b[2]".
+//	JSONKeyExists(key)
+//	JSONHasKeyFold(key)
+//	JSONKeyEQ(key, arg)
+//	JSONArrayContains(value)	the JSON encoding of the value.
+//	JSONLen()			used by the JSONLen predicates.
+//	JSONKeyCount()			used by the JSONKeyCount predicates.
+//	JSONPathQuery(path)		the SQL/JSON path, e.g. "$.a[*] ? (@ > 5)".
+//
+// The registry is empty by default, and the builder falls back to its default
+// rendering for names that were not registered. It is safe for concurrent use,
+// but templates should be registered on initialization (e.g. in an init function),
+// before queries are built, to get a consistent rendering for all queries.
+func RegisterJSONFunc(dialect, name, template string) {
+	dialectFuncs.Lock()
+	defer dialectFuncs.Unlock()
+	dialectFuncs.m[dialect+":"+name] = template
+}
+
+// lookup returns the template registered for the given dialect and name.
+func (f *jsonFuncs) lookup(dialect, name string) (string, bool) {
+	f.RLock()
+	defer f.RUnlock()
+	t, ok := f.m[dialect+":"+name]
+	return t, ok
+}
+
+// jsonFunc writes the template that was registered for the
+// given JSON function name, and reports if it was found.
+func (b *Builder) jsonFunc(name, ident string, args ...interface{}) bool {
+	t, ok := dialectFuncs.lookup(b.dialect, name)
+	if !ok {
+		return false
+	}
+	for t != "" {
+		i := strings.IndexByte(t, '{')
+		if i == -1 {
+			b.WriteString(t)
+			break
+		}
+		b.WriteString(t[:i])
+		t = t[i:]
+		j := strings.IndexByte(t, '}')
+		if j == -1 {
+			b.WriteString(t)
+			break
+		}
+		switch v := t[1:j]; {
+		case v == "col":
+			b.Ident(ident)
+		case isNumber(v):
+			n, err := strconv.Atoi(v)
+			if err != nil || n >= len(args) {
+				b.WriteString(t[:j+1])
+				break
+			}
+			b.Arg(args[n])
+		default:
+			b.WriteString(t[:j+1])
+		}
+		t = t[j+1:]
+	}
+	return true
+}
+
+// JSONLen appends the length of the JSON array stored in the given column.
+//
+//	b.JSONLen("column").WriteOp(OpGT).Arg(1)
+//
+func (b *Builder) JSONLen(ident string) *Builder {
+	if b.jsonFunc("JSONLen", ident) {
+		return b
+	}
+	switch {
+	case b.postgres():
+		b.WriteString("JSONB_ARRAY_LENGTH(")
+	case b.mysql():
+		b.WriteString("JSON_LENGTH(")
+	default:
+		b.WriteString("JSON_ARRAY_LENGTH(")
+	}
+	return b.Ident(ident).WriteByte(')')
+}
+
 // Arg appends an input argument to the builder.
 func (b *Builder) Arg(a interface{}) *Builder {
 	if r, ok := a.(*raw); ok {
@@ -2204,7 +4040,9 @@ func (b Builder) mysql() bool {
 
 // fromIdent sets the builder dialect from the identifier format.
 func (b *Builder) fromIdent(ident string) {
-	if strings.Contains(ident, `"`) {
+	// MySQL and SQLite expressions may contain double-quoted strings
+	// (e.g. JSON paths), but their identifiers are quoted with backticks.
+	if strings.Contains(ident, `"`) && !strings.Contains(ident, "`") {
 		b.SetDialect(dialect.Postgres)
 	}
 	// otherwise, use the default.
@@ -2461,6 +4299,281 @@ func isNumber(s string) bool {
 	return true
 }
 
+// jsonPath returns the MySQL (or SQLite) representation of the given JSON
+// path. For example, $.a.b[2].c. Keys that are not identifiers are quoted and
+// escaped (e.g. $."a.b"). SQLite compares keys to their escaped JSON text, but
+// its quoted keys cannot contain double quotes, and therefore, these keys are
+// written unquoted (e.g. $.a\"b).
+func jsonPath(path []string, mysql bool) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, p := range path {
+		switch _, ok := isJSONIdx(p); {
+		case ok:
+			b.WriteString(p)
+		case isPathKey(p), p == "*", p == "**", isQuotedKey(p):
+			// Wildcards and keys that were quoted by ParsePath are written as is.
+			b.WriteString("." + p)
+		case !mysql && strings.ContainsRune(p, '"'):
+			b.WriteString("." + pathEscaper.Replace(p))
+		default:
+			b.WriteString(`."` + pathEscaper.Replace(p) + `"`)
+		}
+	}
+	return b.String()
+}
+
+// isPathKey reports if the given key can be written
+// unquoted in MySQL and SQLite paths. e.g. "a" or "a_1".
+func isPathKey(s string) bool {
+	for i, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isQuotedKey reports if the given key is a quoted key without
+// escape characters, as returned by ParsePath. e.g. "\"a.b\"".
+func isQuotedKey(s string) bool {
+	return len(s) > 2 && s[0] == '"' && s[len(s)-1] == '"' && !strings.ContainsAny(s[1:len(s)-1], `"\`)
+}
+
+// pathLiteral writes the MySQL (or SQLite) representation of the given JSON path
+// as an SQL string. Paths with quoted keys are written as single-quoted strings,
+// and their quotes (and backslashes in MySQL) are escaped. e.g. '$."a''b"'.
+func (b *Builder) pathLiteral(path []string) *Builder {
+	p := jsonPath(path, b.mysql())
+	if !strings.ContainsAny(p, `"'\`) {
+		return b.WriteString(`"` + p + `"`)
+	}
+	p = strings.ReplaceAll(p, "'", "''")
+	if b.mysql() {
+		p = strings.ReplaceAll(p, `\`, `\\`)
+	}
+	return b.WriteString("'" + p + "'")
+}
+
+// quotedPath returns the MySQL (or SQLite) representation of the given JSON
+// path, where all keys are quoted. For example, `$."a"."b.c"[2]`. Keys are
+// escaped in MySQL, and false is returned if one of the keys contains a double
+// quote in SQLite, as its paths do not support escaping.
+func quotedPath(path []string, escape bool) (string, bool) {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, p := range path {
+		if _, ok := isJSONIdx(p); ok {
+			b.WriteString(p)
+			continue
+		}
+		switch {
+		case escape:
+			p = pathEscaper.Replace(p)
+		case strings.ContainsRune(p, '"'):
+			return "", false
+		}
+		b.WriteString(`."` + p + `"`)
+	}
+	return b.String(), true
+}
+
+// pathEscaper escapes the special characters of quoted keys in
+// MySQL paths, and of quoted elements in PostgreSQL arrays.
+var pathEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// pgPath returns the PostgreSQL text-array representation
+// of the given JSON path. For example, '{a,b,2,c}'. Keys with
+// special characters are quoted and escaped. e.g. '{"a,b",c}'.
+func pgPath(path []string) string {
+	return "'" + strings.ReplaceAll(pgArray(path), "'", "''") + "'"
+}
+
+// pgArray returns the PostgreSQL text-array literal of the given
+// JSON path, that can be passed to the database as an argument.
+func pgArray(path []string) string {
+	elems := make([]string, len(path))
+	for i, s := range path {
+		switch idx, ok := isJSONIdx(s); {
+		case ok:
+			s = idx
+		case s == "", strings.ContainsAny(s, `{}",\`), strings.IndexFunc(s, unicode.IsSpace) != -1, strings.EqualFold(s, "null"):
+			s = `"` + pathEscaper.Replace(s) + `"`
+		}
+		elems[i] = s
+	}
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// marshalArg returns the JSON encoding of the given argument. If the
+// argument cannot be encoded, it is returned as is, and the error is
+// reported by the database driver.
+func marshalArg(arg interface{}) interface{} {
+	buf, err := json.Marshal(arg)
+	if err != nil {
+		return arg
+	}
+	return string(buf)
+}
+
+// MarshalNonFinite returns the JSON encoding of the given slice (or array) of
+// floats, where NaN and infinite values, that are not supported by JSON (and
+// encoding/json), are encoded as the strings "NaN", "+Inf" and "-Inf". It is
+// used by the generated code for fields annotated with entsql.NonFinite.
+//
+//	MarshalNonFinite([]float64{1, math.Inf(1), math.NaN()})	// [1,"+Inf","NaN"]
+//
+func MarshalNonFinite(v interface{}) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch k := rv.Kind(); {
+	case k == reflect.Slice && rv.IsNil(), k == reflect.Ptr:
+		return []byte("null"), nil
+	case k != reflect.Slice && k != reflect.Array:
+		return nil, fmt.Errorf("sql: unexpected type %T for non-finite floats", v)
+	}
+	vs := make([]interface{}, rv.Len())
+	for i := range vs {
+		switch f := rv.Index(i).Float(); {
+		case math.IsNaN(f):
+			vs[i] = "NaN"
+		case math.IsInf(f, 1):
+			vs[i] = "+Inf"
+		case math.IsInf(f, -1):
+			vs[i] = "-Inf"
+		default:
+			vs[i] = rv.Index(i).Interface()
+		}
+	}
+	return json.Marshal(vs)
+}
+
+// UnmarshalNonFinite decodes the JSON encoding that was returned by
+// MarshalNonFinite into the given pointer to a slice (or array) of floats.
+func UnmarshalNonFinite(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("sql: unexpected type %T for non-finite floats", v)
+	}
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return json.Unmarshal(data, v)
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	for rv = rv.Elem(); rv.Kind() == reflect.Ptr; rv = rv.Elem() {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Slice:
+		rv.Set(reflect.MakeSlice(rv.Type(), len(elems), len(elems)))
+	case reflect.Array:
+		rv.Set(reflect.Zero(rv.Type()))
+	default:
+		return fmt.Errorf("sql: unexpected type %T for non-finite floats", v)
+	}
+	for i := 0; i < len(elems) && i < rv.Len(); i++ {
+		var (
+			f float64
+			s string
+		)
+		switch err := json.Unmarshal(elems[i], &s); {
+		case err != nil:
+			if err := json.Unmarshal(elems[i], &f); err != nil {
+				return err
+			}
+		case s == "NaN":
+			f = math.NaN()
+		case s == "+Inf":
+			f = math.Inf(1)
+		case s == "-Inf":
+			f = math.Inf(-1)
+		default:
+			return fmt.Errorf("sql: unexpected non-finite float %q", s)
+		}
+		rv.Index(i).SetFloat(f)
+	}
+	return nil
+}
+
+// Compress returns a marshal function that compresses the output of the given
+// marshal function (or encoding/json, if it is nil) using the given algorithm. The
+// only supported algorithm is "gzip". It is used by the generated code for fields
+// annotated with entsql.Compress.
+//
+//	Compress("gzip", json.Marshal)
+//
+func Compress(algo string, marshal func(interface{}) ([]byte, error)) func(interface{}) ([]byte, error) {
+	return func(v interface{}) ([]byte, error) {
+		if algo != "gzip" {
+			return nil, fmt.Errorf("sql: unsupported compression algorithm %q", algo)
+		}
+		if marshal == nil {
+			marshal = json.Marshal
+		}
+		buf, err := marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var b bytes.Buffer
+		w := gzip.NewWriter(&b)
+		if _, err := w.Write(buf); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+}
+
+// Decompress decompresses the given data, that was compressed by Compress
+// using the given algorithm.
+func Decompress(algo string, data []byte) ([]byte, error) {
+	if algo != "gzip" {
+		return nil, fmt.Errorf("sql: unsupported compression algorithm %q", algo)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// SortKeys returns a marshal function that re-encodes the output of the given
+// marshal function (or encoding/json, if it is nil) in a canonical form, with
+// sorted object keys and without insignificant whitespaces. Unlike maps, the
+// fields of structs and the keys of raw values (e.g. json.RawMessage) are not
+// sorted by encoding/json. It is used by the generated code for fields annotated
+// with entsql.SortedKeys.
+//
+//	SortKeys(json.Marshal)
+//
+func SortKeys(marshal func(interface{}) ([]byte, error)) func(interface{}) ([]byte, error) {
+	return func(v interface{}) ([]byte, error) {
+		if marshal == nil {
+			marshal = json.Marshal
+		}
+		buf, err := marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		var u interface{}
+		dec := json.NewDecoder(bytes.NewReader(buf))
+		dec.UseNumber()
+		if err := dec.Decode(&u); err != nil {
+			return nil, err
+		}
+		return json.Marshal(u)
+	}
+}
+
 // isJSONIdx reports whether the string represents a JSON index.
 func isJSONIdx(s string) (string, bool) {
 	if len(s) > 2 && s[0] == '[' && s[len(s)-1] == ']' && isNumber(s[1:len(s)-1]) {
@@ -2469,6 +4582,46 @@ func isJSONIdx(s string) (string, bool) {
 	return "", false
 }
 
+// isSimplePath reports if the given SQL/JSON path is composed only of keys (e.g. .a
+// or ."a b") and array indexes (e.g. [0]), and therefore, it has the same meaning in
+// all dialects. Wildcard array indexes ([*]) are accepted only if wildcard is true.
+func isSimplePath(path string, wildcard bool) bool {
+	if !strings.HasPrefix(path, "$") {
+		return false
+	}
+	for s := path[1:]; s != ""; {
+		switch {
+		case strings.HasPrefix(s, `."`):
+			i := strings.IndexByte(s[2:], '"')
+			if i == -1 || strings.ContainsRune(s[2:2+i], '\\') {
+				return false
+			}
+			s = s[i+3:]
+		case s[0] == '.':
+			i := 1
+			for i < len(s) && (s[i] == '_' || 'a' <= s[i] && s[i] <= 'z' || 'A' <= s[i] && s[i] <= 'Z' || i > 1 && '0' <= s[i] && s[i] <= '9') {
+				i++
+			}
+			if i == 1 {
+				return false
+			}
+			s = s[i:]
+		case s[0] == '[':
+			i := strings.IndexByte(s, ']')
+			if i == -1 {
+				return false
+			}
+			if idx := s[1:i]; idx == "" || !isNumber(idx) && !(wildcard && idx == "*") {
+				return false
+			}
+			s = s[i+1:]
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func isFunc(s string) bool {
 	return strings.Contains(s, "(") && strings.Contains(s, ")")
 }
@@ -5,6 +5,7 @@
 package sql
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 	"testing"
@@ -302,6 +303,135 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `UPDATE "users" SET "spouse_id" = NULL, "name" = $1`,
 			wantArgs:  []interface{}{"foo"},
 		},
+		{
+			input:     Update("users").JSONSet("ints", 99, "[2]").JSONSet("ints", "a", "[3]"),
+			wantQuery: "UPDATE `users` SET `ints` = JSON_SET(`ints`, \"$[2]\", JSON(?), \"$[3]\", JSON(?))",
+			wantArgs:  []interface{}{"99", `"a"`},
+		},
+		{
+			input:     Dialect(dialect.MySQL).Update("users").Set("name", "foo").JSONSet("ints", 99, "[2]"),
+			wantQuery: "UPDATE `users` SET `name` = ?, `ints` = JSON_SET(`ints`, \"$[2]\", CAST(? AS JSON))",
+			wantArgs:  []interface{}{"foo", "99"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				Set("name", "foo").
+				JSONSet("ints", 99, "[2]").
+				JSONSet("ints", 100, "[3]").
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "name" = $1, "ints" = JSONB_SET(JSONB_SET("ints", '{2}', $2), '{3}', $3) WHERE "id" = $4`,
+			wantArgs:  []interface{}{"foo", "99", "100", 1},
+		},
+		{
+			input:     Update("users").JSONReplace("ints", 99, "[2]").JSONReplace("ints", 100, "[3]"),
+			wantQuery: "UPDATE `users` SET `ints` = JSON_REPLACE(`ints`, \"$[2]\", JSON(?), \"$[3]\", JSON(?))",
+			wantArgs:  []interface{}{"99", "100"},
+		},
+		{
+			input:     Dialect(dialect.MySQL).Update("users").JSONSet("ints", 99, "[2]").JSONReplace("ints", 100, "[3]"),
+			wantQuery: "UPDATE `users` SET `ints` = JSON_REPLACE(JSON_SET(`ints`, \"$[2]\", CAST(? AS JSON)), \"$[3]\", CAST(? AS JSON))",
+			wantArgs:  []interface{}{"99", "100"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONReplace("ints", 99, "[2]").
+				JSONSet("ints", 100, "[3]").
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "ints" = JSONB_SET(JSONB_SET("ints", '{2}', $1, false), '{3}', $2) WHERE "id" = $3`,
+			wantArgs:  []interface{}{"99", "100", 1},
+		},
+		{
+			input:     Update("users").JSONAppend("strings", []string{"a", "b"}),
+			wantQuery: "UPDATE `users` SET `strings` = JSON_INSERT(COALESCE(`strings`, JSON_ARRAY()), \"$[#]\", JSON(?), \"$[#]\", JSON(?))",
+			wantArgs:  []interface{}{`"a"`, `"b"`},
+		},
+		{
+			input:     Dialect(dialect.MySQL).Update("users").JSONAppend("ints", []int{1}).JSONAppend("ints", []int{2}),
+			wantQuery: "UPDATE `users` SET `ints` = JSON_ARRAY_APPEND(COALESCE(`ints`, JSON_ARRAY()), \"$\", CAST(? AS JSON), \"$\", CAST(? AS JSON))",
+			wantArgs:  []interface{}{"1", "2"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONSet("ints", 99, "[0]").
+				JSONAppend("ints", []int{1, 2}).
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "ints" = COALESCE(JSONB_SET("ints", '{0}', $1), '[]'::jsonb) || $2 WHERE "id" = $3`,
+			wantArgs:  []interface{}{"99", "[1,2]", 1},
+		},
+		{
+			input:     Update("users").JSONRemove("ints", []int{1}).JSONRemove("ints", []int{2}),
+			wantQuery: "UPDATE `users` SET `ints` = CASE WHEN `ints` IS NULL THEN NULL ELSE (SELECT JSON_GROUP_ARRAY(CASE `type` WHEN 'true' THEN JSON('true') WHEN 'false' THEN JSON('false') ELSE `value` END) FROM JSON_EACH(`ints`) WHERE NOT (`type` IN ('integer', 'real') AND `value` = ?) AND NOT (`type` IN ('integer', 'real') AND `value` = ?)) END",
+			wantArgs:  []interface{}{float64(1), float64(2)},
+		},
+		{
+			input:     Update("users").JSONRemove("raw", []interface{}{nil, true, "a", map[string]int{"b": 1}, []int{1, 2}}),
+			wantQuery: "UPDATE `users` SET `raw` = CASE WHEN `raw` IS NULL THEN NULL ELSE (SELECT JSON_GROUP_ARRAY(CASE `type` WHEN 'true' THEN JSON('true') WHEN 'false' THEN JSON('false') ELSE `value` END) FROM JSON_EACH(`raw`) WHERE NOT (`type` = 'null') AND NOT (`type` = 'true') AND NOT (`type` = 'text' AND `value` = ?) AND NOT (`type` IN ('object', 'array') AND `value` = JSON(?)) AND NOT (`type` IN ('object', 'array') AND `value` = JSON(?))) END",
+			wantArgs:  []interface{}{"a", `{"b":1}`, "[1,2]"},
+		},
+		{
+			input:     Dialect(dialect.MySQL).Update("users").JSONRemove("strings", []string{"a", "b"}),
+			wantQuery: "UPDATE `users` SET `strings` = CASE WHEN `strings` IS NULL THEN NULL ELSE (SELECT COALESCE(JSON_ARRAYAGG(`e`), JSON_ARRAY()) FROM JSON_TABLE(`strings`, '$[*]' COLUMNS(`e` JSON PATH '$')) AS `t` WHERE `e` <> CAST(? AS JSON) AND `e` <> CAST(? AS JSON)) END",
+			wantArgs:  []interface{}{`"a"`, `"b"`},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONSet("ints", 99, "[0]").
+				JSONRemove("ints", []int{1}).
+				JSONAppend("ints", []int{1}).
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "ints" = COALESCE(CASE WHEN "ints" IS NULL THEN NULL ELSE (SELECT COALESCE(jsonb_agg("e" ORDER BY "i"), '[]'::jsonb) FROM jsonb_array_elements(JSONB_SET("ints", '{0}', $1)) WITH ORDINALITY AS "t"("e", "i") WHERE "e" <> $2::jsonb) END, '[]'::jsonb) || $3 WHERE "id" = $4`,
+			wantArgs:  []interface{}{"99", "1", "[1]", 1},
+		},
+		{
+			input:     Update("users").JSONMerge("raw", json.RawMessage(`{"a": 1}`)).JSONMerge("raw", map[string]interface{}{"b": nil}),
+			wantQuery: "UPDATE `users` SET `raw` = JSON_PATCH(JSON_PATCH(COALESCE(`raw`, '{}'), JSON(?)), JSON(?))",
+			wantArgs:  []interface{}{`{"a":1}`, `{"b":null}`},
+		},
+		{
+			input:     Dialect(dialect.MySQL).Update("users").JSONMerge("raw", json.RawMessage(`{"a": 1}`)).JSONMerge("raw", json.RawMessage(`{"b": null}`)),
+			wantQuery: "UPDATE `users` SET `raw` = JSON_MERGE_PATCH(COALESCE(`raw`, JSON_OBJECT()), CAST(? AS JSON), CAST(? AS JSON))",
+			wantArgs:  []interface{}{`{"a":1}`, `{"b":null}`},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONMerge("raw", json.RawMessage(`{"b": null, "a": {"c": 1}}`)).
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "raw" = (((CASE WHEN jsonb_typeof("raw") = 'object' THEN "raw" ELSE '{}'::jsonb END) || jsonb_build_object($1::text, ((CASE WHEN jsonb_typeof(("raw" -> $2::text)) = 'object' THEN ("raw" -> $3::text) ELSE '{}'::jsonb END) || jsonb_build_object($4::text, $5::jsonb)))) - $6::text) WHERE "id" = $7`,
+			wantArgs:  []interface{}{"a", "a", "a", "c", "1", "b", 1},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONMerge("raw", json.RawMessage(`{"a": {"b": 1}}`)).
+				JSONMerge("raw", json.RawMessage(`{"a": {"c": null}}`)).
+				JSONMerge("raw", map[string]int{"d": 1}),
+			wantQuery: `UPDATE "users" SET "raw" = (((CASE WHEN jsonb_typeof("raw") = 'object' THEN "raw" ELSE '{}'::jsonb END) || jsonb_build_object($1::text, (((CASE WHEN jsonb_typeof(("raw" -> $2::text)) = 'object' THEN ("raw" -> $3::text) ELSE '{}'::jsonb END) || jsonb_build_object($4::text, $5::jsonb)) - $6::text))) || jsonb_build_object($7::text, $8::jsonb))`,
+			wantArgs:  []interface{}{"a", "a", "a", "b", "1", "c", "d", "1"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONMerge("raw", json.RawMessage(`{"a": {"b": 1}}`)).
+				JSONMerge("raw", json.RawMessage(`{"a": {"b": 1}}`)).
+				JSONMerge("raw", json.RawMessage(`{"a": {"b": 1}}`)),
+			wantQuery: `UPDATE "users" SET "raw" = ((CASE WHEN jsonb_typeof("raw") = 'object' THEN "raw" ELSE '{}'::jsonb END) || jsonb_build_object($1::text, ((CASE WHEN jsonb_typeof(("raw" -> $2::text)) = 'object' THEN ("raw" -> $3::text) ELSE '{}'::jsonb END) || jsonb_build_object($4::text, $5::jsonb))))`,
+			wantArgs:  []interface{}{"a", "a", "a", "b", "1"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Update("users").
+				JSONMerge("raw", json.RawMessage(`{"a": null}`)).
+				JSONMerge("raw", json.RawMessage(`{"a": {"b": 1, "c": null}}`)),
+			wantQuery: `UPDATE "users" SET "raw" = ((CASE WHEN jsonb_typeof("raw") = 'object' THEN "raw" ELSE '{}'::jsonb END) || jsonb_build_object($1::text, $2::jsonb))`,
+			wantArgs:  []interface{}{"a", `{"b":1}`},
+		},
+		{
+			input:     Dialect(dialect.Postgres).Update("users").JSONMerge("raw", []int{1}).JSONMerge("raw", json.RawMessage(`{"a": {"b": null, "c": 2}}`)),
+			wantQuery: `UPDATE "users" SET "raw" = $1::jsonb`,
+			wantArgs:  []interface{}{`{"a":{"c":2}}`},
+		},
+		{
+			input:     Dialect(dialect.Postgres).Update("users").JSONMerge("raw", []int{1}),
+			wantQuery: `UPDATE "users" SET "raw" = $1::jsonb`,
+			wantArgs:  []interface{}{"[1]"},
+		},
 		{
 			input: Update("users").Set("name", "foo").
 				Where(EQ("name", "bar")).
@@ -1012,6 +1142,85 @@ func TestBuilder(t *testing.T) {
 				OrderBy(Desc("name"), "age"),
 			wantQuery: `SELECT "name", "age", COUNT(*) FROM "users" GROUP BY "name", "age" ORDER BY "name" DESC, "age"`,
 		},
+		{
+			input: func() Querier {
+				s := Select("*").From(Table("users"))
+				OrderByJSON("url", "Scheme")(s)
+				return s
+			}(),
+			wantQuery: "SELECT * FROM `users` ORDER BY JSON_EXTRACT(`users`.`url`, \"$.Scheme\")",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.Postgres).Select("*").From(Table("users"))
+				OrderByJSON("url", "a", "[1]")(s)
+				return s
+			}(),
+			wantQuery: `SELECT * FROM "users" ORDER BY "users"."url"->'a'->1`,
+		},
+		{
+			input: func() Querier {
+				s := Select().From(Table("users"))
+				return s.Select("name", JSONArraySum("ints")(s)).GroupBy("name")
+			}(),
+			wantQuery: "SELECT `name`, SUM((SELECT SUM(`value`) FROM json_each(`users`.`ints`))) FROM `users` GROUP BY `name`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.MySQL).Select().From(Table("users"))
+				return s.Select(JSONArraySum("ints")(s))
+			}(),
+			wantQuery: "SELECT SUM((SELECT SUM(`t`.`e`) FROM JSON_TABLE(`users`.`ints`, '$[*]' COLUMNS(`e` DOUBLE PATH '$')) AS `t`)) FROM `users`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.Postgres).Select().From(Table("users"))
+				return s.Select(JSONArraySum("ints")(s))
+			}(),
+			wantQuery: `SELECT SUM((SELECT SUM("e"::numeric) FROM jsonb_array_elements_text("users"."ints") AS "e")) FROM "users"`,
+		},
+		{
+			input: func() Querier {
+				s := Select().From(Table("users"))
+				return s.Select("id", As(JSONArrayLen("ints")(s), "n")).GroupBy("id")
+			}(),
+			wantQuery: "SELECT `id`, SUM(JSON_ARRAY_LENGTH(`users`.`ints`)) AS `n` FROM `users` GROUP BY `id`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.MySQL).Select().From(Table("users"))
+				return s.Select(JSONArrayLen("ints")(s))
+			}(),
+			wantQuery: "SELECT SUM(JSON_LENGTH(`users`.`ints`)) FROM `users`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.Postgres).Select().From(Table("users"))
+				return s.Select(JSONArrayLen("ints")(s))
+			}(),
+			wantQuery: `SELECT SUM(JSONB_ARRAY_LENGTH("users"."ints")) FROM "users"`,
+		},
+		{
+			input: func() Querier {
+				s := Select().From(Table("users"))
+				return s.Select(JSONValue("url", "Host")(s))
+			}(),
+			wantQuery: "SELECT JSON_EXTRACT(`users`.`url`, \"$.Host\") FROM `users`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.MySQL).Select().From(Table("users"))
+				return s.Select("id", As(JSONValue("url", "User", "Username")(s), "name"))
+			}(),
+			wantQuery: "SELECT `id`, JSON_UNQUOTE(JSON_EXTRACT(`users`.`url`, \"$.User.Username\")) AS `name` FROM `users`",
+		},
+		{
+			input: func() Querier {
+				s := Dialect(dialect.Postgres).Select().From(Table("users"))
+				return s.Select(JSONValue("url", "User", "Username")(s), JSONValue("ints", "[0]")(s))
+			}(),
+			wantQuery: `SELECT "users"."url"->'User'->>'Username', "users"."ints"->>0 FROM "users"`,
+		},
 		{
 			input: Select("*").
 				From(Table("users")).
@@ -1027,6 +1236,34 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `SELECT * FROM "users" LIMIT $1`,
 			wantArgs:  []interface{}{1},
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("users")).
+				Where(EQ("id", 1)).
+				Limit(1).
+				ForUpdate(),
+			wantQuery: `SELECT * FROM "users" WHERE "id" = $1 LIMIT $2 FOR UPDATE`,
+			wantArgs:  []interface{}{1, 1},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("users")).
+				Where(EQ("id", 1)).
+				ForUpdate(),
+			wantQuery: "SELECT * FROM `users` WHERE `id` = ? FOR UPDATE",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select("*").
+				From(Table("users")).
+				Where(EQ("id", 1)).
+				ForUpdate(),
+			wantQuery: "SELECT * FROM `users` WHERE `id` = ?",
+			wantArgs:  []interface{}{1},
+		},
 		{
 			input:     Select("age").Distinct().From(Table("users")),
 			wantQuery: "SELECT DISTINCT `age` FROM `users`",
@@ -1161,6 +1398,14 @@ func TestBuilder(t *testing.T) {
 				Columns("first", "last"),
 			wantQuery: `CREATE UNIQUE INDEX "unique_name" ON "users"("first", "last")`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("doc_index").
+				Table("users").
+				Using("GIN").
+				Column("doc"),
+			wantQuery: `CREATE INDEX "doc_index" ON "users" USING GIN("doc")`,
+		},
 		{
 			input:     DropIndex("name_index"),
 			wantQuery: "DROP INDEX `name_index`",
@@ -1302,7 +1547,7 @@ WHERE
 					b.WriteOp(OpEQ)
 					b.Arg("a")
 				})),
-			wantQuery: "SELECT * FROM `users` WHERE JSON_EXTRACT(`a`, \"$.b.\"c[1]\".d[1][2].e\") = ?",
+			wantQuery: "SELECT * FROM `users` WHERE JSON_EXTRACT(`a`, '$.b.\"c[1]\".d[1][2].e') = ?",
 			wantArgs:  []interface{}{"a"},
 		},
 		{
@@ -1311,6 +1556,26 @@ WHERE
 				Where(JSONHasKey("j", "a.*.c")),
 			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$.a.*.c\") IS NOT NULL",
 		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONHasKey("j", "2")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$[2]\") IS NOT NULL",
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONHasKey("j", "a.1.b")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$.a[1].b\") IS NOT NULL",
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONHasKey("j", "2")),
+			wantQuery: `SELECT * FROM "test" WHERE "j"->2 IS NOT NULL`,
+		},
 		{
 			input: Dialect(dialect.Postgres).
 				Select("*").
@@ -1332,67 +1597,925 @@ WHERE
 			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$.a.b.c\") = ?",
 			wantArgs:  []interface{}{1},
 		},
-	}
-	for i, tt := range tests {
-		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			query, args := tt.input.Query()
-			require.Equal(t, tt.wantQuery, query)
-			require.Equal(t, tt.wantArgs, args)
-		})
-	}
-}
-
-func TestParsePath(t *testing.T) {
-	tests := []struct {
-		input    string
-		wantPath []string
-		wantErr  bool
-	}{
 		{
-			input:    "a.b.c",
-			wantPath: []string{"a", "b", "c"},
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONValuePathEQ("j", 1, "a", "b", "c")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$.a.b.c\") = ?",
+			wantArgs:  []interface{}{1},
 		},
 		{
-			input:    "a[1][2]",
-			wantPath: []string{"a", "[1]", "[2]"},
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONValuePathEQ("j", "a", "a", "[1]", "c")),
+			wantQuery: `SELECT * FROM "test" WHERE "j" #>> '{a,1,c}' = $1`,
+			wantArgs:  []interface{}{"a"},
 		},
 		{
-			input:    "a[1][2].b",
-			wantPath: []string{"a", "[1]", "[2]", "b"},
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONPathHasKey("j", "a", "[1]", "c")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, \"$.a[1].c\") IS NOT NULL",
 		},
 		{
-			input:    `a."b.c[0]"`,
-			wantPath: []string{"a", `"b.c[0]"`},
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(Not(JSONPathHasKey("j", "a", "b"))),
+			wantQuery: `SELECT * FROM "test" WHERE NOT ("j" #> '{a,b}' IS NOT NULL)`,
 		},
 		{
-			input:    `a."b.c[0]".d`,
-			wantPath: []string{"a", `"b.c[0]"`, "d"},
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONValuePathEQ("j", 1, "a.b", "c'd", `e"f`, `g\h`, "}")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, '$.\"a.b\".\"c''d\".e\\\"f.\"g\\\\h\".\"}\"') = ?",
+			wantArgs:  []interface{}{1},
 		},
 		{
-			input: `...`,
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONValuePathEQ("j", 1, "a.b", "c'd", `e"f`, `g\h`, "}")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, '$.\"a.b\".\"c''d\".\"e\\\\\"f\".\"g\\\\\\\\h\".\"}\"') = ?",
+			wantArgs:  []interface{}{1},
 		},
 		{
-			input:    `.a.b.`,
-			wantPath: []string{"a", "b"},
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONValuePathEQ("j", 1, "a.b", "c'd", `e"f`, `g\h`, "}")),
+			wantQuery: `SELECT * FROM "test" WHERE "j" #>> '{a.b,c''d,"e\"f","g\\h","}"}' = $1`,
+			wantArgs:  []interface{}{1},
 		},
 		{
-			input:   `a."`,
-			wantErr: true,
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONPathHasKey("j", "a}' OR '1'='1")),
+			wantQuery: `SELECT * FROM "test" WHERE "j" #> '{"a}'' OR ''1''=''1"}' IS NOT NULL`,
 		},
 		{
-			input:   `a[`,
-			wantErr: true,
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONPathHasKey("j", `a") OR 1=1 OR ("`)),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, '$.\"a\\\\\") OR 1=1 OR (\\\\\"\"') IS NOT NULL",
 		},
 		{
-			input:   `a[a]`,
-			wantErr: true,
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "string", "a'b")),
+			wantQuery: `SELECT * FROM "test" WHERE JSONB_TYPEOF("j"->'a''b') = $1`,
+			wantArgs:  []interface{}{"string"},
 		},
-	}
-	for i, tt := range tests {
-		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			path, err := ParsePath(tt.input)
-			require.Equal(t, tt.wantPath, path)
-			require.Equal(t, tt.wantErr, err != nil)
-		})
-	}
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONKeyExists("j", `a"b`)),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_EACH(`j`) WHERE `key` = ? AND `value` IS NOT NULL)",
+			wantArgs:  []interface{}{`a"b`},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONKeyExists("j", "a.b")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, CONCAT('$.', JSON_QUOTE(?))) IS NOT NULL",
+			wantArgs:  []interface{}{"a.b"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONKeyExists("j", "a'b")),
+			wantQuery: `SELECT * FROM "test" WHERE "j"->$1::text IS NOT NULL`,
+			wantArgs:  []interface{}{"a'b"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONHasKeys("j", "a", "b")),
+			wantQuery: "SELECT * FROM `test` WHERE (EXISTS(SELECT * FROM JSON_EACH(`j`) WHERE `key` = ?) AND EXISTS(SELECT * FROM JSON_EACH(`j`) WHERE `key` = ?))",
+			wantArgs:  []interface{}{"a", "b"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONHasAnyKey("j", "a", "b.c")),
+			wantQuery: "SELECT * FROM `test` WHERE (JSON_EXTRACT(`j`, CONCAT('$.', JSON_QUOTE(?))) IS NOT NULL OR JSON_EXTRACT(`j`, CONCAT('$.', JSON_QUOTE(?))) IS NOT NULL)",
+			wantArgs:  []interface{}{"a", "b.c"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(And(JSONHasKeys("j", "a", "b"), JSONHasAnyKey("j", "c"))),
+			wantQuery: `SELECT * FROM "test" WHERE "j" ?& ARRAY[$1, $2]::text[] AND "j" ?| ARRAY[$3]::text[]`,
+			wantArgs:  []interface{}{"a", "b", "c"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(Or(JSONHasKeys("j"), JSONHasAnyKey("j"))),
+			wantQuery: `SELECT * FROM "test" WHERE TRUE OR FALSE`,
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONKeyEQ("j", "env", "prod")),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_EACH(`j`) WHERE `key` = ? AND `value` = ?)",
+			wantArgs:  []interface{}{"env", "prod"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONKeyEQ("j", "env", "prod")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, CONCAT('$.', JSON_QUOTE(?))) = ?",
+			wantArgs:  []interface{}{"env", "prod"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONKeyEQ("j", "env", "prod")),
+			wantQuery: `SELECT * FROM "test" WHERE "j"->>$1::text = $2`,
+			wantArgs:  []interface{}{"env", "prod"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "number", "a", "[1]")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`j`, \"$.a[1]\") IN (?, ?)",
+			wantArgs:  []interface{}{"integer", "real"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "object")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`j`, \"$\") = ?",
+			wantArgs:  []interface{}{"object"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "string", "a")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(JSON_EXTRACT(`j`, \"$.a\")) = ?",
+			wantArgs:  []interface{}{"STRING"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "number")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`j`) IN (?, ?, ?, ?)",
+			wantArgs:  []interface{}{"INTEGER", "UNSIGNED INTEGER", "DOUBLE", "DECIMAL"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONTypeEQ("j", "boolean", "a", "b")),
+			wantQuery: `SELECT * FROM "test" WHERE JSONB_TYPEOF("j"->'a'->'b') = $1`,
+			wantArgs:  []interface{}{"boolean"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", true, "a", "b")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`j`, ?) = ?",
+			wantArgs:  []interface{}{"$.a.b", "true"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", false, "a")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, ?) = CAST(? AS JSON)",
+			wantArgs:  []interface{}{"$.a", "false"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", true, "a")),
+			wantQuery: `SELECT * FROM "test" WHERE ("j" #> $1::text[])::jsonb = $2::jsonb`,
+			wantArgs:  []interface{}{"{a}", "true"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", true, `a") OR 1=1 OR ("`)),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`j`, ?) = ?",
+			wantArgs:  []interface{}{`$.a\") OR 1=1 OR (\"`, "true"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", true, "a') OR 1=1 OR ('")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_EXTRACT(`j`, ?) = CAST(? AS JSON)",
+			wantArgs:  []interface{}{`$."a') OR 1=1 OR ('"`, "true"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONBoolEQ("j", false, "a}' OR '1'='1", "[0]")),
+			wantQuery: `SELECT * FROM "test" WHERE ("j" #> $1::text[])::jsonb = $2::jsonb`,
+			wantArgs:  []interface{}{`{"a}' OR '1'='1",0}`, "false"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONQueryParamEQ("u", "ref", "a b", "RawQuery")),
+			wantQuery: "SELECT * FROM `test` WHERE (JSON_EXTRACT(`u`, \"$.RawQuery\") GLOB ? OR JSON_EXTRACT(`u`, \"$.RawQuery\") GLOB ? OR JSON_EXTRACT(`u`, \"$.RawQuery\") GLOB ? OR JSON_EXTRACT(`u`, \"$.RawQuery\") GLOB ?)",
+			wantArgs:  []interface{}{"ref=a+b", "ref=a+b&*", "*&ref=a+b", "*&ref=a+b&*"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONQueryParamEQ("u", "a_b", "50%", "RawQuery")),
+			wantQuery: "SELECT * FROM `test` WHERE (JSON_UNQUOTE(JSON_EXTRACT(`u`, \"$.RawQuery\")) LIKE ? ESCAPE '|' OR JSON_UNQUOTE(JSON_EXTRACT(`u`, \"$.RawQuery\")) LIKE ? ESCAPE '|' OR JSON_UNQUOTE(JSON_EXTRACT(`u`, \"$.RawQuery\")) LIKE ? ESCAPE '|' OR JSON_UNQUOTE(JSON_EXTRACT(`u`, \"$.RawQuery\")) LIKE ? ESCAPE '|')",
+			wantArgs:  []interface{}{"a|_b=50|%25", "a|_b=50|%25&%", "%&a|_b=50|%25", "%&a|_b=50|%25&%"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONQueryParamEQ("u", "ref", "x", "RawQuery")),
+			wantQuery: `SELECT * FROM "test" WHERE ("u"->>'RawQuery' LIKE $1 ESCAPE '|' OR "u"->>'RawQuery' LIKE $2 ESCAPE '|' OR "u"->>'RawQuery' LIKE $3 ESCAPE '|' OR "u"->>'RawQuery' LIKE $4 ESCAPE '|')`,
+			wantArgs:  []interface{}{"ref=x", "ref=x&%", "%&ref=x", "%&ref=x&%"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONArrayContainsAny("a", 2, 5)),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_EACH(`a`) WHERE `value` IN (?, ?))",
+			wantArgs:  []interface{}{2, 5},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONArrayContainsAny("a", 2, 5)),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_OVERLAPS(`a`, CAST(? AS JSON))",
+			wantArgs:  []interface{}{"[2,5]"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONArrayContainsAny("a", 2, "a")),
+			wantQuery: `SELECT * FROM "test" WHERE "a" @> ANY(ARRAY[$1, $2]::jsonb[])`,
+			wantArgs:  []interface{}{"2", `"a"`},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONArrayAny("a", GT, 2)),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_EACH(`a`) WHERE `value` > ?)",
+			wantArgs:  []interface{}{2},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONArrayAll("a", LT, 10)),
+			wantQuery: "SELECT * FROM `test` WHERE NOT EXISTS(SELECT * FROM JSON_EACH(`a`) WHERE NOT (`value` < ?))",
+			wantArgs:  []interface{}{10},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(And(JSONArrayAny("a", EQ, 1), JSONArrayAll("a", GTE, 0))),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_TABLE(`a`, '$[*]' COLUMNS(`e` JSON PATH '$')) AS `t` WHERE `e` = ?) AND NOT EXISTS(SELECT * FROM JSON_TABLE(`a`, '$[*]' COLUMNS(`e` JSON PATH '$')) AS `t` WHERE NOT (`e` >= ?))",
+			wantArgs:  []interface{}{1, 0},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(And(EQ("b", 1), JSONArrayAny("a", GT, 1.5), JSONArrayAll("a", NEQ, "a"))),
+			wantQuery: `SELECT * FROM "test" WHERE "b" = $1 AND EXISTS(SELECT * FROM jsonb_array_elements("a") AS "e" WHERE "e" > $2) AND NOT EXISTS(SELECT * FROM jsonb_array_elements("a") AS "e" WHERE NOT ("e" <> $3))`,
+			wantArgs:  []interface{}{1, "1.5", `"a"`},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONArrayContains("a", 1)),
+			wantQuery: "SELECT * FROM `test` WHERE EXISTS(SELECT * FROM JSON_EACH(`a`) WHERE `value` = ?)",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONArrayContains("a", "a")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_CONTAINS(`a`, CAST(? AS JSON))",
+			wantArgs:  []interface{}{`"a"`},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONArrayContains("a", 1.5)),
+			wantQuery: `SELECT * FROM "test" WHERE "a" @> $1`,
+			wantArgs:  []interface{}{"1.5"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(Not(JSONArrayContains("a", 1))),
+			wantQuery: `SELECT * FROM "test" WHERE NOT ("a" @> $1)`,
+			wantArgs:  []interface{}{"1"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONEQ("a", json.RawMessage(`{ }`))),
+			wantQuery: `SELECT * FROM "test" WHERE "a" = $1`,
+			wantArgs:  []interface{}{`{ }`},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONEQ("a", json.RawMessage(`{}`))),
+			wantQuery: "SELECT * FROM `test` WHERE `a` = CAST(? AS JSON)",
+			wantArgs:  []interface{}{`{}`},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONEQ("a", json.RawMessage(`{}`))),
+			wantQuery: "SELECT * FROM `test` WHERE NOT EXISTS(SELECT * FROM JSON_TREE(`a`) AS `j1` WHERE NOT EXISTS(SELECT * FROM JSON_TREE(?) AS `j2` WHERE `j1`.`fullkey` = `j2`.`fullkey` AND `j1`.`type` = `j2`.`type` AND `j1`.`atom` IS `j2`.`atom`)) AND (SELECT COUNT(*) FROM JSON_TREE(`a`)) = (SELECT COUNT(*) FROM JSON_TREE(?))",
+			wantArgs:  []interface{}{`{}`, `{}`},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONHasKeyFold("a", "Env")),
+			wantQuery: `SELECT * FROM "test" WHERE EXISTS(SELECT * FROM jsonb_object_keys(CASE WHEN jsonb_typeof("a") = 'object' THEN "a" END) AS "k" WHERE lower("k") = lower($1))`,
+			wantArgs:  []interface{}{"Env"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONHasKeyFold("a", "Env")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_CONTAINS(LOWER(JSON_KEYS(`a`)), JSON_QUOTE(LOWER(?)))",
+			wantArgs:  []interface{}{"Env"},
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONHasKeyFold("a", "Env")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_TYPE(`a`) = 'object' AND EXISTS(SELECT * FROM JSON_EACH(`a`) WHERE LOWER(`key`) = LOWER(?))",
+			wantArgs:  []interface{}{"Env"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONColumnEQ("a", "b")),
+			wantQuery: `SELECT * FROM "test" WHERE "a"::jsonb = "b"::jsonb`,
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONColumnEQ("a", "b")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_CONTAINS(`a`, `b`) AND JSON_CONTAINS(`b`, `a`)",
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONColumnEQ("a", "b")),
+			wantQuery: "SELECT * FROM `test` WHERE JSON(`a`) = JSON(`b`)",
+		},
+		{
+			input: Select("*").
+				From(Table("test")).
+				Where(JSONLenEQ("a", 1)),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_ARRAY_LENGTH(`a`) = ?",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select("*").
+				From(Table("test")).
+				Where(JSONLenGT("a", 1)),
+			wantQuery: "SELECT * FROM `test` WHERE JSON_LENGTH(`a`) > ?",
+			wantArgs:  []interface{}{1},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("*").
+				From(Table("test")).
+				Where(JSONLenLT("a", 1)),
+			wantQuery: `SELECT * FROM "test" WHERE JSONB_ARRAY_LENGTH("a") < $1`,
+			wantArgs:  []interface{}{1},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestPredicateReuse(t *testing.T) {
+	p := And(JSONHasKey("a", "b"), GT("c", 1))
+	query, args := Dialect(dialect.MySQL).Select("*").From(Table("t")).Where(p).Query()
+	require.Equal(t, "SELECT * FROM `t` WHERE JSON_EXTRACT(`a`, \"$.b\") IS NOT NULL AND `c` > ?", query)
+	require.Equal(t, []interface{}{1}, args)
+	query, args = Dialect(dialect.Postgres).Select("*").From(Table("t")).Where(EQ("d", 2)).Where(p).Query()
+	require.Equal(t, `SELECT * FROM "t" WHERE "d" = $1 AND ("a"->'b' IS NOT NULL AND "c" > $2)`, query)
+	require.Equal(t, []interface{}{2, 1}, args)
+	query, args = Dialect(dialect.MySQL).Select("*").From(Table("t")).Where(p).Query()
+	require.Equal(t, "SELECT * FROM `t` WHERE JSON_EXTRACT(`a`, \"$.b\") IS NOT NULL AND `c` > ?", query, "predicates are rendered on each call")
+	require.Equal(t, []interface{}{1}, args)
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantPath []string
+		wantErr  bool
+	}{
+		{
+			input:    "a.b.c",
+			wantPath: []string{"a", "b", "c"},
+		},
+		{
+			input:    "a[1][2]",
+			wantPath: []string{"a", "[1]", "[2]"},
+		},
+		{
+			input:    "a[1][2].b",
+			wantPath: []string{"a", "[1]", "[2]", "b"},
+		},
+		{
+			input:    `a."b.c[0]"`,
+			wantPath: []string{"a", `"b.c[0]"`},
+		},
+		{
+			input:    `a."b.c[0]".d`,
+			wantPath: []string{"a", `"b.c[0]"`, "d"},
+		},
+		{
+			input: `...`,
+		},
+		{
+			input:    `.a.b.`,
+			wantPath: []string{"a", "b"},
+		},
+		{
+			input:   `a."`,
+			wantErr: true,
+		},
+		{
+			input:   `a[`,
+			wantErr: true,
+		},
+		{
+			input:   `a[a]`,
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			path, err := ParsePath(tt.input)
+			require.Equal(t, tt.wantPath, path)
+			require.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestRegisterJSONPathColumn(t *testing.T) {
+	RegisterJSONPathColumn("docs", "meta", "$.a.b", "meta_a_b")
+	query, _ := Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("docs")).
+		Where(JSONHasKey("meta", "a.b")).
+		Query()
+	require.Equal(t, "SELECT * FROM `docs` WHERE `meta_a_b` IS NOT NULL", query)
+
+	t1 := Table("docs")
+	query, _ = Dialect(dialect.MySQL).
+		Select("*").
+		From(t1).
+		Where(And(JSONHasKey(t1.C("meta"), "a.b"), JSONPathHasKey(t1.C("meta"), "a", "b"), JSONHasKey(t1.C("meta"), "a.c"))).
+		Query()
+	require.Equal(t, "SELECT * FROM `docs` WHERE `docs`.`meta_a_b` IS NOT NULL AND `docs`.`meta_a_b` IS NOT NULL AND JSON_EXTRACT(`docs`.`meta`, \"$.a.c\") IS NOT NULL", query)
+
+	// Generated columns are used only in MySQL.
+	query, _ = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("docs")).
+		Where(JSONHasKey("meta", "a.b")).
+		Query()
+	require.Equal(t, "SELECT * FROM `docs` WHERE JSON_EXTRACT(`meta`, \"$.a.b\") IS NOT NULL", query)
+
+	// Unqualified columns are ambiguous if they were registered for multiple tables.
+	RegisterJSONPathColumn("files", "meta", "$.a.b", "meta_ab")
+	query, _ = Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("docs")).
+		Where(JSONHasKey("meta", "a.b")).
+		Query()
+	require.Equal(t, "SELECT * FROM `docs` WHERE JSON_EXTRACT(`meta`, \"$.a.b\") IS NOT NULL", query)
+}
+
+func TestRegisterJSONFunc(t *testing.T) {
+	RegisterJSONFunc(dialect.MySQL, "JSONKeyExists", "JSON_EXISTS({col}, CONCAT('$.', {0}))")
+	RegisterJSONFunc(dialect.MySQL, "JSONHasKey", "JSON_EXISTS({col}, {0})")
+	RegisterJSONFunc(dialect.MySQL, "JSONLen", "JSON_LENGTH({col}, '$')")
+	defer func() {
+		delete(dialectFuncs.m, dialect.MySQL+":JSONKeyExists")
+		delete(dialectFuncs.m, dialect.MySQL+":JSONHasKey")
+		delete(dialectFuncs.m, dialect.MySQL+":JSONLen")
+	}()
+	query, args := Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("users")).
+		Where(And(JSONKeyExists("meta", "a.b"), JSONHasKey("meta", "a.2"), JSONLenGT("ints", 1))).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE JSON_EXISTS(`meta`, CONCAT('$.', ?)) AND JSON_EXISTS(`meta`, ?) AND JSON_LENGTH(`ints`, '$') > ?", query)
+	require.Equal(t, []interface{}{"a.b", "$.a[2]", 1}, args)
+
+	// Other dialects are not affected.
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("users")).
+		Where(JSONHasKey("meta", "a.b")).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE JSON_EXTRACT(`meta`, \"$.a.b\") IS NOT NULL", query)
+	require.Empty(t, args)
+
+	// Unknown placeholders and braces are written as is.
+	RegisterJSONFunc(dialect.Postgres, "JSONKeyEQ", "{col}->>{0} = {1} AND {col} @> '{}' AND {2}")
+	defer delete(dialectFuncs.m, dialect.Postgres+":JSONKeyEQ")
+	query, args = Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users")).
+		Where(JSONKeyEQ("meta", "env", "prod")).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "meta"->>$1 = $2 AND "meta" @> '{}' AND {2}`, query)
+	require.Equal(t, []interface{}{"env", "prod"}, args)
+}
+
+func TestJSONExtract(t *testing.T) {
+	// A path that was built from untrusted input.
+	path := []string{"a.b", `c"), 1) OR (1`, "[2]", "*"}
+	p := func(path []string) *Predicate {
+		return P(func(b *Builder) {
+			b.Join(JSONExtract("meta", path)).WriteOp(OpNotNull)
+		})
+	}
+	query, args := Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("users")).
+		Where(And(EQ("id", 1), p(path))).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `id` = ? AND JSON_EXTRACT(`meta`, ?) IS NOT NULL", query)
+	require.Equal(t, []interface{}{1, `$."a.b"."c\"), 1) OR (1"[2]."*"`}, args)
+
+	query, args = Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("users")).
+		Where(And(EQ("id", 1), p(path))).
+		Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "id" = $1 AND "meta" #> ARRAY[$2, $3, $4, $5]::text[] IS NOT NULL`, query)
+	require.Equal(t, []interface{}{1, "a.b", `c"), 1) OR (1`, "2", "*"}, args)
+
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("users")).
+		Where(Or(p([]string{"a.b", "[2]"}), p(path))).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE JSON_EXTRACT(`meta`, ?) IS NOT NULL OR NULL IS NOT NULL", query)
+	require.Equal(t, []interface{}{`$."a.b"[2]`}, args)
+
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("users")).
+		Where(p(nil)).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `meta` IS NOT NULL", query)
+	require.Empty(t, args)
+}
+
+func TestJSONIsEmpty(t *testing.T) {
+	for _, tt := range []struct {
+		dialect   string
+		pred      *Predicate
+		wantQuery string
+	}{
+		{
+			dialect:   dialect.Postgres,
+			pred:      Or(JSONIsEmptyObject("raw"), JSONIsEmptyArray("ints")),
+			wantQuery: `SELECT * FROM "users" WHERE "raw"::jsonb = '{}'::jsonb OR "ints"::jsonb = '[]'::jsonb`,
+		},
+		{
+			dialect:   dialect.MySQL,
+			pred:      Or(JSONIsEmptyObject("raw"), JSONIsEmptyArray("ints")),
+			wantQuery: "SELECT * FROM `users` WHERE (JSON_TYPE(`raw`) = 'OBJECT' AND JSON_LENGTH(`raw`) = 0) OR (JSON_TYPE(`ints`) = 'ARRAY' AND JSON_LENGTH(`ints`) = 0)",
+		},
+		{
+			dialect:   dialect.SQLite,
+			pred:      Or(JSONIsEmptyObject("raw"), JSONIsEmptyArray("ints")),
+			wantQuery: "SELECT * FROM `users` WHERE JSON(`raw`) = '{}' OR JSON(`ints`) = '[]'",
+		},
+	} {
+		t.Run(tt.dialect, func(t *testing.T) {
+			query, args := Dialect(tt.dialect).Select("*").From(Table("users")).Where(tt.pred).Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Empty(t, args)
+		})
+	}
+}
+
+func TestJSONKeyCount(t *testing.T) {
+	for _, tt := range []struct {
+		dialect   string
+		pred      *Predicate
+		wantQuery string
+	}{
+		{
+			dialect:   dialect.Postgres,
+			pred:      Or(JSONKeyCountEQ("raw", 1), JSONKeyCountGT("raw", 2)),
+			wantQuery: `SELECT * FROM "users" WHERE CASE WHEN JSONB_TYPEOF("raw"::jsonb) = 'object' THEN (SELECT COUNT(*) FROM JSONB_OBJECT_KEYS("raw"::jsonb)) END = $1 OR CASE WHEN JSONB_TYPEOF("raw"::jsonb) = 'object' THEN (SELECT COUNT(*) FROM JSONB_OBJECT_KEYS("raw"::jsonb)) END > $2`,
+		},
+		{
+			dialect:   dialect.MySQL,
+			pred:      Or(JSONKeyCountEQ("raw", 1), JSONKeyCountGT("raw", 2)),
+			wantQuery: "SELECT * FROM `users` WHERE CASE WHEN JSON_TYPE(`raw`) = 'OBJECT' THEN JSON_LENGTH(`raw`) END = ? OR CASE WHEN JSON_TYPE(`raw`) = 'OBJECT' THEN JSON_LENGTH(`raw`) END > ?",
+		},
+		{
+			dialect:   dialect.SQLite,
+			pred:      Or(JSONKeyCountEQ("raw", 1), JSONKeyCountGT("raw", 2)),
+			wantQuery: "SELECT * FROM `users` WHERE CASE WHEN JSON_TYPE(`raw`) = 'object' THEN (SELECT COUNT(*) FROM JSON_EACH(`raw`)) END = ? OR CASE WHEN JSON_TYPE(`raw`) = 'object' THEN (SELECT COUNT(*) FROM JSON_EACH(`raw`)) END > ?",
+		},
+	} {
+		t.Run(tt.dialect, func(t *testing.T) {
+			query, args := Dialect(tt.dialect).Select("*").From(Table("users")).Where(tt.pred).Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, []interface{}{1, 2}, args)
+		})
+	}
+	query, args := Dialect(dialect.SQLite).Select("*").From(Table("users")).Where(JSONKeyCountLT("raw", 3)).Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE CASE WHEN JSON_TYPE(`raw`) = 'object' THEN (SELECT COUNT(*) FROM JSON_EACH(`raw`)) END < ?", query)
+	require.Equal(t, []interface{}{3}, args)
+}
+
+func TestTSMatch(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).Select("*").From(Table("users")).Where(And(TSMatch("raw_tsv", "foo bar"), NotNull("raw"))).Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "raw_tsv" @@ PLAINTO_TSQUERY('simple', $1) AND "raw" IS NOT NULL`, query)
+	require.Equal(t, []interface{}{"foo bar"}, args)
+}
+
+func TestJSONPathQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		dialect   string
+		path      string
+		wantQuery string
+	}{
+		{
+			name:      "postgres",
+			dialect:   dialect.Postgres,
+			path:      "$.a[*] ? (@ > 5)",
+			wantQuery: `SELECT * FROM "users" WHERE jsonb_path_exists("ints"::jsonb, $1::jsonpath)`,
+		},
+		{
+			name:      "mysql",
+			dialect:   dialect.MySQL,
+			path:      `$.a[*]."b c"[0]`,
+			wantQuery: "SELECT * FROM `users` WHERE JSON_EXTRACT(`ints`, ?) IS NOT NULL",
+		},
+		{
+			name:      "mysql/filter",
+			dialect:   dialect.MySQL,
+			path:      "$.a[*] ? (@ > 5)",
+			wantQuery: "SELECT * FROM `users` WHERE jsonb_path_exists(`ints`::jsonb, ?::jsonpath)",
+		},
+		{
+			name:      "sqlite",
+			dialect:   dialect.SQLite,
+			path:      "$.a_1[2]",
+			wantQuery: "SELECT * FROM `users` WHERE JSON_TYPE(`ints`, ?) IS NOT NULL",
+		},
+		{
+			name:      "sqlite/wildcard",
+			dialect:   dialect.SQLite,
+			path:      "$.a[*]",
+			wantQuery: "SELECT * FROM `users` WHERE jsonb_path_exists(`ints`::jsonb, ?::jsonpath)",
+		},
+		{
+			name:      "sqlite/mode",
+			dialect:   dialect.SQLite,
+			path:      "strict $.a",
+			wantQuery: "SELECT * FROM `users` WHERE jsonb_path_exists(`ints`::jsonb, ?::jsonpath)",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := Dialect(tt.dialect).Select("*").From(Table("users")).Where(JSONPathQuery("ints", tt.path)).Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, []interface{}{tt.path}, args)
+		})
+	}
+
+	s := Dialect(dialect.Postgres).Select().From(Table("users"))
+	query, args := s.Select(JSONPathQueryFirst("ints", "$[*] ? (@ like_regex \"^a'b\")")(s)).Query()
+	require.Equal(t, `SELECT jsonb_path_query_first("users"."ints"::jsonb, '$[*] ? (@ like_regex "^a''b")'::jsonpath) FROM "users"`, query)
+	require.Empty(t, args)
+	s = Dialect(dialect.MySQL).Select().From(Table("users"))
+	query, _ = s.Select(JSONPathQueryFirst("ints", "$.a[0]")(s)).Query()
+	require.Equal(t, "SELECT JSON_EXTRACT(`users`.`ints`, '$.a[0]') FROM `users`", query)
+}
+
+func TestJSONValueAfter(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		dialect   string
+		value     interface{}
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "postgres",
+			dialect:   dialect.Postgres,
+			value:     "http",
+			wantQuery: `SELECT * FROM "users" WHERE ("url"->'Scheme' > $1 OR ("url"->'Scheme' = $2 AND "id" > $3) OR "url"->'Scheme' IS NULL)`,
+			wantArgs:  []interface{}{`"http"`, `"http"`, 10},
+		},
+		{
+			name:      "postgres/null",
+			dialect:   dialect.Postgres,
+			value:     json.RawMessage("null"),
+			wantQuery: `SELECT * FROM "users" WHERE (("url"->'Scheme' IS NULL AND "id" > $1))`,
+			wantArgs:  []interface{}{10},
+		},
+		{
+			name:      "mysql",
+			dialect:   dialect.MySQL,
+			value:     json.RawMessage(`"http"`),
+			wantQuery: "SELECT * FROM `users` WHERE (JSON_EXTRACT(`url`, \"$.Scheme\") > CAST(? AS JSON) OR (JSON_EXTRACT(`url`, \"$.Scheme\") = CAST(? AS JSON) AND `id` > ?))",
+			wantArgs:  []interface{}{`"http"`, `"http"`, 10},
+		},
+		{
+			name:      "mysql/null",
+			dialect:   dialect.MySQL,
+			wantQuery: "SELECT * FROM `users` WHERE ((JSON_EXTRACT(`url`, \"$.Scheme\") IS NULL AND `id` > ?) OR JSON_EXTRACT(`url`, \"$.Scheme\") IS NOT NULL)",
+			wantArgs:  []interface{}{10},
+		},
+		{
+			name:      "sqlite",
+			dialect:   dialect.SQLite,
+			value:     json.RawMessage(`"http"`),
+			wantQuery: "SELECT * FROM `users` WHERE (JSON_EXTRACT(`url`, \"$.Scheme\") > ? OR (JSON_EXTRACT(`url`, \"$.Scheme\") = ? AND `id` > ?))",
+			wantArgs:  []interface{}{"http", "http", 10},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := Dialect(tt.dialect).Select("*").From(Table("users")).Where(JSONValueAfter("url", tt.value, "id", 10, "Scheme")).Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestJSONContainsValue(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		dialect   string
+		value     interface{}
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "postgres",
+			dialect:   dialect.Postgres,
+			value:     "a",
+			wantQuery: `SELECT * FROM "users" WHERE jsonb_path_exists("raw", '$.** ? (@ == $v)', $1)`,
+			wantArgs:  []interface{}{`{"v":"a"}`},
+		},
+		{
+			name:      "mysql",
+			dialect:   dialect.MySQL,
+			value:     1,
+			wantQuery: "SELECT * FROM `users` WHERE JSON_CONTAINS(JSON_EXTRACT(`raw`, \"$\", \"$**.*\", \"$**[*]\"), CAST(? AS JSON))",
+			wantArgs:  []interface{}{"1"},
+		},
+		{
+			name:      "sqlite",
+			dialect:   dialect.SQLite,
+			value:     "a",
+			wantQuery: "SELECT * FROM `users` WHERE EXISTS(SELECT * FROM JSON_TREE(`raw`) WHERE `type` IN ('integer', 'real', 'text') AND `atom` = ?)",
+			wantArgs:  []interface{}{"a"},
+		},
+		{
+			name:      "sqlite/bool",
+			dialect:   dialect.SQLite,
+			value:     true,
+			wantQuery: "SELECT * FROM `users` WHERE EXISTS(SELECT * FROM JSON_TREE(`raw`) WHERE `type` = 'true')",
+		},
+		{
+			name:      "sqlite/null",
+			dialect:   dialect.SQLite,
+			wantQuery: "SELECT * FROM `users` WHERE EXISTS(SELECT * FROM JSON_TREE(`raw`) WHERE `type` = 'null')",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := Dialect(tt.dialect).Select("*").From(Table("users")).Where(JSONContainsValue("raw", tt.value)).Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestJSONText(t *testing.T) {
+	query, args := Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("users")).
+		Where(JSONTextHasKey("meta", "a_b")).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `meta` LIKE ? ESCAPE '|'", query)
+	require.Equal(t, []interface{}{`%"a|_b":%`}, args)
+
+	query, args = Dialect(dialect.MySQL).
+		Select("*").
+		From(Table("users")).
+		Where(And(JSONTextArrayContainsAny("tags", "a", 1), EQ("id", 1))).
+		Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE (`tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|' OR `tags` LIKE ? ESCAPE '|') AND `id` = ?", query)
+	require.Equal(t, []interface{}{`["a"]`, `["a",%`, `%,"a"]`, `%,"a",%`, `[1]`, `[1,%`, `%,1]`, `%,1,%`, 1}, args)
+
+	query, args = Select("*").From(Table("users")).Where(JSONTextArrayContainsAny("tags")).Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE FALSE", query)
+	require.Empty(t, args)
+
+	p := TextFallback(JSONKeyExists("meta", "a"), JSONTextHasKey("meta", "a"), dialect.MySQL)
+	query, args = Dialect(dialect.MySQL).Select("*").From(Table("users")).Where(p).Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `meta` LIKE ? ESCAPE '|'", query)
+	require.Equal(t, []interface{}{`%"a":%`}, args)
+	query, args = Dialect(dialect.Postgres).Select("*").From(Table("users")).Where(p).Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "meta"->$1::text IS NOT NULL`, query)
+	require.Equal(t, []interface{}{"a"}, args)
+}
+
+func TestSortKeys(t *testing.T) {
+	v := struct {
+		B int
+		A json.RawMessage
+	}{B: 1, A: json.RawMessage(`{"d": [2, 1], "c": 1.50}`)}
+	buf, err := SortKeys(json.Marshal)(v)
+	require.NoError(t, err)
+	require.Equal(t, `{"A":{"c":1.50,"d":[2,1]},"B":1}`, string(buf))
+	nilbuf, err := SortKeys(nil)(v)
+	require.NoError(t, err)
+	require.Equal(t, buf, nilbuf, "encoding/json is used by default")
+	buf, err = SortKeys(json.Marshal)(nil)
+	require.NoError(t, err)
+	require.Equal(t, "null", string(buf))
+
+	gz, err := Compress("gzip", SortKeys(json.Marshal))(v)
+	require.NoError(t, err)
+	data, err := Decompress("gzip", gz)
+	require.NoError(t, err)
+	require.Equal(t, `{"A":{"c":1.50,"d":[2,1]},"B":1}`, string(data))
+}
+
+func TestCompress(t *testing.T) {
+	v := map[string]string{"a": strings.Repeat("b", 100)}
+	buf, err := Compress("gzip", json.Marshal)(v)
+	require.NoError(t, err)
+	plain, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Less(t, len(buf), len(plain))
+	data, err := Decompress("gzip", buf)
+	require.NoError(t, err)
+	require.Equal(t, plain, data)
+	nilbuf, err := Compress("gzip", nil)(v)
+	require.NoError(t, err)
+	require.Equal(t, buf, nilbuf, "encoding/json is used by default")
+
+	_, err = Compress("zstd", json.Marshal)(v)
+	require.EqualError(t, err, `sql: unsupported compression algorithm "zstd"`)
+	_, err = Decompress("gzip", plain)
+	require.Error(t, err)
 }
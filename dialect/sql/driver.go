@@ -7,6 +7,7 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -25,12 +26,14 @@ func Open(driver, source string) (*Driver, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Driver{conn{db}, driver}, nil
+	return OpenDB(driver, db), nil
 }
 
 // OpenDB wraps the given database/sql.DB method with a Driver.
 func OpenDB(driver string, db *sql.DB) *Driver {
-	return &Driver{conn{db}, driver}
+	d := &Driver{dialect: driver}
+	d.conn = conn{ExecQuerier: db, sqlite: d.Dialect() == dialect.SQLite}
+	return d
 }
 
 // DB returns the underlying *sql.DB instance.
@@ -60,7 +63,7 @@ func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, erro
 	if err != nil {
 		return nil, err
 	}
-	return &Tx{conn{tx}}, nil
+	return &Tx{conn{ExecQuerier: tx, sqlite: d.sqlite}}, nil
 }
 
 // Close closes the underlying connection.
@@ -86,6 +89,9 @@ type ExecQuerier interface {
 // shared connection ExecQuerier between Driver and Tx.
 type conn struct {
 	ExecQuerier
+	// sqlite indicates if errors of failed statements
+	// should be checked for a missing JSON1 extension.
+	sqlite bool
 }
 
 // Exec implements the dialect.Exec method.
@@ -97,12 +103,12 @@ func (c *conn) Exec(ctx context.Context, query string, args, v interface{}) erro
 	switch v := v.(type) {
 	case nil:
 		if _, err := c.ExecContext(ctx, query, argv...); err != nil {
-			return err
+			return c.jsonErr(ctx, err)
 		}
 	case *sql.Result:
 		res, err := c.ExecContext(ctx, query, argv...)
 		if err != nil {
-			return err
+			return c.jsonErr(ctx, err)
 		}
 		*v = res
 	default:
@@ -123,12 +129,55 @@ func (c *conn) Query(ctx context.Context, query string, args, v interface{}) err
 	}
 	rows, err := c.QueryContext(ctx, query, argv...)
 	if err != nil {
-		return err
+		return c.jsonErr(ctx, err)
 	}
 	*vr = Rows{rows}
 	return nil
 }
 
+// jsonErr wraps errors of SQLite statements that failed on a missing JSON function
+// with a JSONUnsupportedError, if the JSON1 extension is not available. Since the
+// error may also be caused by a misspelled function name (e.g. in a raw predicate),
+// the extension is probed using the json function before the error is wrapped.
+func (c *conn) jsonErr(ctx context.Context, err error) error {
+	if !c.sqlite || !strings.Contains(strings.ToLower(err.Error()), "no such function: json") {
+		return err
+	}
+	rows, perr := c.QueryContext(ctx, "SELECT json('{}')")
+	if perr == nil {
+		rows.Close()
+		return err
+	}
+	return &JSONUnsupportedError{wrap: err}
+}
+
+// JSONUnsupportedError is returned by the SQLite driver for statements that use
+// JSON functions (e.g. JSON predicates), when SQLite was built without the JSON1
+// extension. For mattn/go-sqlite3, the extension is enabled using the "json1" (or
+// "sqlite_json") build tag.
+type JSONUnsupportedError struct {
+	wrap error
+}
+
+// Error implements the error interface.
+func (e *JSONUnsupportedError) Error() string {
+	return fmt.Sprintf("dialect/sql: sqlite was built without the json1 extension: %v", e.wrap)
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *JSONUnsupportedError) Unwrap() error {
+	return e.wrap
+}
+
+// IsJSONUnsupported returns a boolean indicating whether the error is a JSONUnsupportedError.
+func IsJSONUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *JSONUnsupportedError
+	return errors.As(err, &e)
+}
+
 var _ dialect.Driver = (*Driver)(nil)
 
 type (
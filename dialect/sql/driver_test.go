@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/facebook/ent/dialect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_JSONUnsupported(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := OpenDB(dialect.SQLite, db)
+	query := "SELECT * FROM `users` WHERE JSON_EXTRACT(`tags`, '$') IS NOT NULL"
+	missing := errors.New("no such function: JSON_EXTRACT")
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(missing)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT json('{}')")).WillReturnError(errors.New("no such function: json"))
+	err = drv.Query(ctx, query, []interface{}{}, &Rows{})
+	require.True(t, IsJSONUnsupported(err))
+	require.True(t, errors.Is(err, missing))
+	require.EqualError(t, err, "dialect/sql: sqlite was built without the json1 extension: no such function: JSON_EXTRACT")
+
+	// Misspelled function names are reported as is.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `tags` = JSON_SETT(`tags`)")).WillReturnError(errors.New("no such function: JSON_SETT"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT json('{}')")).WillReturnRows(sqlmock.NewRows([]string{"json"}).AddRow("{}"))
+	err = drv.Exec(ctx, "UPDATE `users` SET `tags` = JSON_SETT(`tags`)", []interface{}{}, nil)
+	require.EqualError(t, err, "no such function: JSON_SETT")
+	require.False(t, IsJSONUnsupported(err))
+
+	// Transactions are checked as well.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `tags` = JSON_ARRAY()")).WillReturnError(errors.New("no such function: JSON_ARRAY"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT json('{}')")).WillReturnError(errors.New("no such function: json"))
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "UPDATE `users` SET `tags` = JSON_ARRAY()", []interface{}{}, nil)
+	require.True(t, IsJSONUnsupported(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Other dialects are not probed.
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	drv = OpenDB(dialect.MySQL, db)
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnError(missing)
+	err = drv.Query(ctx, query, []interface{}{}, &Rows{})
+	require.Equal(t, missing, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
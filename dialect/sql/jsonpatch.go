@@ -0,0 +1,346 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrJSONPatchTest is returned (wrapped) by ApplyJSONPatch when
+// a "test" operation of the patch failed.
+var ErrJSONPatchTest = errors.New("sql: json patch test operation failed")
+
+// JSONPatchOp is an operation of a JSON patch (RFC 6902). The supported operations
+// are "add", "remove", "replace", "move", "copy" and "test". Path and From are JSON
+// pointers (RFC 6901), and Value is encoded using encoding/json (nil is JSON null).
+//
+//	[]JSONPatchOp{
+//		{Op: "replace", Path: "/a", Value: 1},
+//		{Op: "add", Path: "/b/-", Value: "c"},
+//		{Op: "move", From: "/d", Path: "/e"},
+//	}
+//
+type JSONPatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ApplyJSONPatch applies the given JSON patch operations on the given document in
+// order, and returns the patched document. An empty document is treated as JSON null.
+// The operations are validated before they are applied, and an error is returned if
+// one of them failed. i.e. the patch is applied atomically.
+func ApplyJSONPatch(doc []byte, ops []JSONPatchOp) ([]byte, error) {
+	for i, op := range ops {
+		if err := op.validate(); err != nil {
+			return nil, fmt.Errorf("sql: invalid json patch operation %d: %w", i, err)
+		}
+	}
+	var v interface{}
+	if len(doc) > 0 {
+		dec := json.NewDecoder(bytes.NewReader(doc))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+	}
+	for i, op := range ops {
+		var err error
+		if v, err = op.apply(v); err != nil {
+			return nil, fmt.Errorf("sql: json patch operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return json.Marshal(v)
+}
+
+// validate checks the operation and its pointers.
+func (op JSONPatchOp) validate() error {
+	switch op.Op {
+	case "add", "remove", "replace", "test":
+	case "move", "copy":
+		if _, err := jsonPointer(op.From); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+	_, err := jsonPointer(op.Path)
+	return err
+}
+
+// apply applies the operation on the given document, and returns the modified document.
+func (op JSONPatchOp) apply(doc interface{}) (interface{}, error) {
+	path, _ := jsonPointer(op.Path)
+	from, _ := jsonPointer(op.From)
+	switch op.Op {
+	case "add":
+		v, err := jsonValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(doc, path, v, false)
+	case "remove":
+		doc, _, err := jsonDelete(doc, path)
+		return doc, err
+	case "replace":
+		v, err := jsonValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := jsonGet(doc, path); err != nil {
+			return nil, err
+		}
+		return jsonAdd(doc, path, v, true)
+	case "move":
+		if op.From == op.Path {
+			_, err := jsonGet(doc, from)
+			return doc, err
+		}
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("cannot move %q into one of its children", op.From)
+		}
+		doc, v, err := jsonDelete(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(doc, path, v, false)
+	case "copy":
+		v, err := jsonGet(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(doc, path, jsonCopy(v), false)
+	default:
+		want, err := jsonValue(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		v, err := jsonGet(doc, path)
+		if err != nil || !jsonEqual(v, want) {
+			return nil, ErrJSONPatchTest
+		}
+		return doc, nil
+	}
+}
+
+// jsonPointer parses the given JSON pointer (RFC 6901) to its reference tokens.
+func jsonPointer(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if s[0] != '/' {
+		return nil, fmt.Errorf("invalid json pointer %q", s)
+	}
+	tokens := strings.Split(s[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return tokens, nil
+}
+
+// jsonIndex returns the array index of the given token, that must be less than n.
+func jsonIndex(t string, n int) (int, error) {
+	i, err := strconv.Atoi(t)
+	if err != nil || i < 0 || (len(t) > 1 && t[0] == '0') || t[0] == '+' {
+		return 0, fmt.Errorf("invalid array index %q", t)
+	}
+	if i >= n {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+// jsonValue returns the decoded JSON value of the given Go value.
+func jsonValue(v interface{}) (interface{}, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// jsonGet returns the value that is referenced by the given path.
+func jsonGet(doc interface{}, path []string) (interface{}, error) {
+	for _, t := range path {
+		switch d := doc.(type) {
+		case map[string]interface{}:
+			v, ok := d[t]
+			if !ok {
+				return nil, fmt.Errorf("key %q does not exist", t)
+			}
+			doc = v
+		case []interface{}:
+			i, err := jsonIndex(t, len(d))
+			if err != nil {
+				return nil, err
+			}
+			doc = d[i]
+		default:
+			return nil, fmt.Errorf("cannot reference %q in a non-container value", t)
+		}
+	}
+	return doc, nil
+}
+
+// jsonAdd adds (or replaces) the value that is referenced by the given path, and
+// returns the modified document. Values are inserted into arrays, unless replace
+// is true, and the "-" token appends the value to the end of an array.
+func jsonAdd(doc interface{}, path []string, v interface{}, replace bool) (interface{}, error) {
+	if len(path) == 0 {
+		return v, nil
+	}
+	t, last := path[0], len(path) == 1
+	switch d := doc.(type) {
+	case map[string]interface{}:
+		if last {
+			d[t] = v
+			return d, nil
+		}
+		child, ok := d[t]
+		if !ok {
+			return nil, fmt.Errorf("key %q does not exist", t)
+		}
+		child, err := jsonAdd(child, path[1:], v, replace)
+		if err != nil {
+			return nil, err
+		}
+		d[t] = child
+		return d, nil
+	case []interface{}:
+		switch {
+		case last && t == "-" && !replace:
+			return append(d, v), nil
+		case last && !replace:
+			i, err := jsonIndex(t, len(d)+1)
+			if err != nil {
+				return nil, err
+			}
+			d = append(d, nil)
+			copy(d[i+1:], d[i:])
+			d[i] = v
+			return d, nil
+		}
+		i, err := jsonIndex(t, len(d))
+		if err != nil {
+			return nil, err
+		}
+		if last {
+			d[i] = v
+			return d, nil
+		}
+		child, err := jsonAdd(d[i], path[1:], v, replace)
+		if err != nil {
+			return nil, err
+		}
+		d[i] = child
+		return d, nil
+	default:
+		return nil, fmt.Errorf("cannot reference %q in a non-container value", t)
+	}
+}
+
+// jsonDelete removes the value that is referenced by the given path, and
+// returns the modified document and the removed value.
+func jsonDelete(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("cannot remove the whole document")
+	}
+	parent, err := jsonGet(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	t := path[len(path)-1]
+	switch d := parent.(type) {
+	case map[string]interface{}:
+		v, ok := d[t]
+		if !ok {
+			return nil, nil, fmt.Errorf("key %q does not exist", t)
+		}
+		delete(d, t)
+		return doc, v, nil
+	case []interface{}:
+		i, err := jsonIndex(t, len(d))
+		if err != nil {
+			return nil, nil, err
+		}
+		v := d[i]
+		// Arrays are modified in place, and therefore, they are set back to their parent.
+		doc, err = jsonAdd(doc, path[:len(path)-1], append(d[:i:i], d[i+1:]...), true)
+		return doc, v, err
+	default:
+		return nil, nil, fmt.Errorf("cannot reference %q in a non-container value", t)
+	}
+}
+
+// jsonCopy returns a deep copy of the given value.
+func jsonCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = jsonCopy(e)
+		}
+		return m
+	case []interface{}:
+		a := make([]interface{}, len(v))
+		for i, e := range v {
+			a[i] = jsonCopy(e)
+		}
+		return a
+	default:
+		return v
+	}
+}
+
+// jsonEqual reports if the two decoded values are equal. Numbers
+// are compared by their values, and not by their representation.
+func jsonEqual(v1, v2 interface{}) bool {
+	switch v1 := v1.(type) {
+	case map[string]interface{}:
+		v2, ok := v2.(map[string]interface{})
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+		for k, e1 := range v1 {
+			if e2, ok := v2[k]; !ok || !jsonEqual(e1, e2) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		v2, ok := v2.([]interface{})
+		if !ok || len(v1) != len(v2) {
+			return false
+		}
+		for i := range v1 {
+			if !jsonEqual(v1[i], v2[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		v2, ok := v2.(json.Number)
+		if !ok {
+			return false
+		}
+		r1, ok1 := new(big.Rat).SetString(string(v1))
+		r2, ok2 := new(big.Rat).SetString(string(v2))
+		return ok1 && ok2 && r1.Cmp(r2) == 0
+	default:
+		return v1 == v2
+	}
+}
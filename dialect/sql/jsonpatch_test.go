@@ -0,0 +1,86 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		doc     string
+		ops     []JSONPatchOp
+		want    string
+		wantErr bool
+	}{
+		{
+			doc:  `{"a": 1}`,
+			ops:  []JSONPatchOp{{Op: "add", Path: "/b", Value: []int{1, 2}}, {Op: "add", Path: "/b/1", Value: "x"}, {Op: "add", Path: "/b/-", Value: nil}},
+			want: `{"a":1,"b":[1,"x",2,null]}`,
+		},
+		{
+			doc:  `{"a": {"b": [1, 2, 3]}, "c": 1}`,
+			ops:  []JSONPatchOp{{Op: "remove", Path: "/a/b/0"}, {Op: "remove", Path: "/c"}},
+			want: `{"a":{"b":[2,3]}}`,
+		},
+		{
+			doc:  `{"a": [1, 2], "b": 1}`,
+			ops:  []JSONPatchOp{{Op: "replace", Path: "/a/1", Value: 3}, {Op: "replace", Path: "/b", Value: map[string]int{"c": 1}}},
+			want: `{"a":[1,3],"b":{"c":1}}`,
+		},
+		{
+			doc:  `{"a": {"b": 1}, "c": [1]}`,
+			ops:  []JSONPatchOp{{Op: "move", From: "/a/b", Path: "/c/0"}, {Op: "copy", From: "/c", Path: "/d"}, {Op: "add", Path: "/d/-", Value: 2}},
+			want: `{"a":{},"c":[1,1],"d":[1,1,2]}`,
+		},
+		{
+			doc:  `{"a/b": {"~c": 1.0}}`,
+			ops:  []JSONPatchOp{{Op: "test", Path: "/a~1b/~0c", Value: 1}, {Op: "replace", Path: "", Value: []int{}}},
+			want: `[]`,
+		},
+		{
+			doc:  ``,
+			ops:  []JSONPatchOp{{Op: "test", Path: "", Value: nil}, {Op: "add", Path: "", Value: map[string]int{"a": 1}}},
+			want: `{"a":1}`,
+		},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "inc", Path: "/a"}}, wantErr: true},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "add", Path: "a", Value: 1}}, wantErr: true},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "remove", Path: "/b"}}, wantErr: true},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "replace", Path: "/b", Value: 1}}, wantErr: true},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "add", Path: "/b/c", Value: 1}}, wantErr: true},
+		{doc: `{"a": [1]}`, ops: []JSONPatchOp{{Op: "add", Path: "/a/2", Value: 1}}, wantErr: true},
+		{doc: `{"a": [1]}`, ops: []JSONPatchOp{{Op: "remove", Path: "/a/01"}}, wantErr: true},
+		{doc: `{"a": {"b": 1}}`, ops: []JSONPatchOp{{Op: "move", From: "/a", Path: "/a/c"}}, wantErr: true},
+		{doc: `{"a": 1}`, ops: []JSONPatchOp{{Op: "copy", From: "/b", Path: "/c"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.doc, func(t *testing.T) {
+			got, err := ApplyJSONPatch([]byte(tt.doc), tt.ops)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestApplyJSONPatch_Test(t *testing.T) {
+	doc := []byte(`{"a": [1, {"b": "c"}]}`)
+	_, err := ApplyJSONPatch(doc, []JSONPatchOp{{Op: "test", Path: "/a", Value: []interface{}{1, map[string]string{"b": "c"}}}})
+	require.NoError(t, err)
+	for _, op := range []JSONPatchOp{
+		{Op: "test", Path: "/a/0", Value: "1"},
+		{Op: "test", Path: "/a/1", Value: map[string]string{"b": "c", "d": "e"}},
+		{Op: "test", Path: "/b", Value: nil},
+	} {
+		_, err := ApplyJSONPatch(doc, []JSONPatchOp{{Op: "add", Path: "/c", Value: 1}, op})
+		require.True(t, errors.Is(err, ErrJSONPatchTest), "unexpected error: %v", err)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/facebook/ent/dialect"
 	"github.com/facebook/ent/dialect/sql"
@@ -119,6 +121,16 @@ func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
 func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
 	for _, t := range tables {
 		m.setupTable(t)
+		for _, idx := range t.Indexes {
+			if err := m.checkIndex(t, idx); err != nil {
+				return err
+			}
+		}
+		for _, c := range t.Columns {
+			if err := m.checkColumn(t, c); err != nil {
+				return err
+			}
+		}
 		switch exist, err := m.tableExist(ctx, tx, t.Name); {
 		case err != nil:
 			return err
@@ -127,6 +139,11 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			if err != nil {
 				return err
 			}
+			if g, ok := m.sqlDialect.(generatedReader); ok && t.hasGenerated() {
+				if err := g.generatedColumns(ctx, tx, curr); err != nil {
+					return err
+				}
+			}
 			if err := m.verify(ctx, tx, curr); err != nil {
 				return err
 			}
@@ -145,6 +162,9 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			if err := tx.Exec(ctx, query, args, nil); err != nil {
 				return fmt.Errorf("create table %q: %v", t.Name, err)
 			}
+			if err := m.comment(ctx, tx, t.Name, t.Columns); err != nil {
+				return err
+			}
 			// If global unique identifier is enabled and it's not
 			// a relation table, allocate a range for the table pk.
 			if m.universalID && len(t.PrimaryKey) == 1 {
@@ -154,6 +174,9 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 			}
 			// indexes.
 			for _, idx := range t.Indexes {
+				if m.skipIndex(idx) {
+					continue
+				}
 				query, args := m.addIndex(idx, t.Name).Query()
 				if err := tx.Exec(ctx, query, args, nil); err != nil {
 					return fmt.Errorf("create index %q: %v", idx.Name, err)
@@ -213,7 +236,18 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 	if m.dropColumns {
 		drop = change.column.drop
 	}
-	queries := m.alterColumns(table, change.column.add, change.column.modify, drop)
+	// Columns that are backfilled are added as nullable columns,
+	// and their constraint is applied after the rows were updated.
+	add := make([]*Column, len(change.column.add))
+	for i, c := range change.column.add {
+		add[i] = c
+		if c.Backfill != "" && !c.Nullable {
+			nc := *c
+			nc.Nullable = true
+			add[i] = &nc
+		}
+	}
+	queries := m.alterColumns(table, add, change.column.modify, drop)
 	// If there's actual action to execute on ALTER TABLE.
 	for i := range queries {
 		query, args := queries[i].Query()
@@ -221,7 +255,16 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 			return fmt.Errorf("alter table %q: %v", table, err)
 		}
 	}
+	if err := m.backfill(ctx, tx, table, change.column.add); err != nil {
+		return err
+	}
+	if err := m.comment(ctx, tx, table, change.column.add); err != nil {
+		return err
+	}
 	for _, idx := range change.index.add {
+		if m.skipIndex(idx) {
+			continue
+		}
 		query, args := m.addIndex(idx, table).Query()
 		if err := tx.Exec(ctx, query, args, nil); err != nil {
 			return fmt.Errorf("create index %q: %v", table, err)
@@ -230,6 +273,142 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 	return nil
 }
 
+// backfill sets the backfill value of the given columns on the existing rows
+// of the table (i.e. rows with NULL values), and then applies the NOT NULL
+// constraint on the columns that are not nullable. Note that SQLite does not
+// support modifying columns, and they are kept nullable in this dialect.
+func (m *Migrate) backfill(ctx context.Context, tx dialect.Tx, table string, columns []*Column) error {
+	for _, c := range columns {
+		if c.Backfill == "" {
+			continue
+		}
+		query, args := sql.Dialect(m.Dialect()).
+			Update(table).
+			Set(c.Name, c.Backfill).
+			Where(sql.IsNull(c.Name)).
+			Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("backfill column %q of table %q: %v", c.Name, table, err)
+		}
+		if c.Nullable || m.Dialect() == dialect.SQLite {
+			continue
+		}
+		for _, q := range m.alterColumns(table, nil, []*Column{c}, nil) {
+			query, args := q.Query()
+			if err := tx.Exec(ctx, query, args, nil); err != nil {
+				return fmt.Errorf("alter table %q: %v", table, err)
+			}
+		}
+	}
+	return nil
+}
+
+// comment sets the comments of the given columns, in dialects that do
+// not support comments as a part of the column definition.
+func (m *Migrate) comment(ctx context.Context, tx dialect.Tx, table string, columns []*Column) error {
+	c, ok := m.sqlDialect.(commenter)
+	if !ok {
+		return nil
+	}
+	for _, q := range c.commentColumns(table, columns) {
+		query, args := q.Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("comment columns of table %q: %v", table, err)
+		}
+	}
+	return nil
+}
+
+// skipIndex reports if the index should be skipped by the migration. Typed
+// indexes (e.g. GIN) are supported only by PostgreSQL, and indexes on JSON
+// paths are supported only by MySQL. The other dialects skip them, because
+// they can't be created on these databases as defined.
+func (m *Migrate) skipIndex(idx *Index) bool {
+	switch {
+	case idx.Type != "":
+		return m.Dialect() != dialect.Postgres
+	case idx.JSONColumn != "":
+		d, ok := m.sqlDialect.(*MySQL)
+		return !ok || !d.supportsJSONIndex()
+	}
+	return false
+}
+
+// generatedColumns returns the generated columns that are needed for creating
+// the index. Indexes on JSON paths and on JSON columns are created on stored
+// generated columns in MySQL, and full-text indexes are created on generated
+// tsvector columns in PostgreSQL. The other dialects do not use them.
+func (m *Migrate) generatedColumns(idx *Index) []*Column {
+	switch m.sqlDialect.(type) {
+	case *MySQL:
+		return idx.generatedColumns()
+	case *Postgres:
+		if idx.TSColumn != "" {
+			return []*Column{idx.tsColumn()}
+		}
+	}
+	return nil
+}
+
+// checkIndex checks that an index that contains JSON columns
+// can be created by the database, and returns a descriptive
+// error if the dialect (or its version) does not support it.
+func (m *Migrate) checkIndex(t *Table, idx *Index) error {
+	if idx.TSColumn != "" {
+		d, ok := m.sqlDialect.(*Postgres)
+		if !ok {
+			return fmt.Errorf("sql/schema: tsvector index %q of table %q is supported only by PostgreSQL", idx.Name, t.Name)
+		}
+		if compareVersions(d.version, "12.0.0") == -1 {
+			return fmt.Errorf("sql/schema: tsvector index %q of table %q requires PostgreSQL 12 or above (got %s)", idx.Name, t.Name, d.version)
+		}
+		return nil
+	}
+	if idx.JSONColumn != "" || m.skipIndex(idx) {
+		return nil
+	}
+	for _, c := range idx.Columns {
+		if c.Type != field.TypeJSON {
+			continue
+		}
+		switch d := m.sqlDialect.(type) {
+		case *MySQL:
+			if !d.supportsJSONIndex() {
+				return fmt.Errorf("sql/schema: index %q of table %q on json column %q requires MySQL 5.7.8 or above (got %s)", idx.Name, t.Name, c.Name, d.version)
+			}
+		case *Postgres:
+			if typ := d.cType(c); typ != "jsonb" {
+				return fmt.Errorf("sql/schema: index %q of table %q on json column %q requires the jsonb type (got %s)", idx.Name, t.Name, c.Name, typ)
+			}
+		}
+	}
+	return nil
+}
+
+// checkColumn checks that a generated column can be created
+// by the database, and returns a descriptive error if it has
+// no expression for the dialect, or if its version does not
+// support generated columns.
+func (m *Migrate) checkColumn(t *Table, c *Column) error {
+	if len(c.Generated) == 0 {
+		return nil
+	}
+	switch d := m.sqlDialect.(type) {
+	case *MySQL:
+		if compareVersions(d.version, "5.7.6") == -1 {
+			return fmt.Errorf("sql/schema: generated column %q of table %q requires MySQL 5.7.6 or above (got %s)", c.Name, t.Name, d.version)
+		}
+	case *Postgres:
+		if compareVersions(d.version, "12.0.0") == -1 {
+			return fmt.Errorf("sql/schema: generated column %q of table %q requires PostgreSQL 12 or above (got %s)", c.Name, t.Name, d.version)
+		}
+	}
+	if c.Generated[m.Dialect()] == "" {
+		return fmt.Errorf("sql/schema: missing %s expression for generated column %q of table %q", m.Dialect(), c.Name, t.Name)
+	}
+	return nil
+}
+
 // changes to apply on existing table.
 type changes struct {
 	// column changes.
@@ -308,13 +487,31 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		}
 	}
 
+	// Add generated columns of JSON indexes. Existing rows are
+	// backfilled by the database when the stored columns are added.
+	generated := make(map[string]bool)
+	for _, idx := range new.Indexes {
+		if m.skipIndex(idx) {
+			continue
+		}
+		for _, c := range m.generatedColumns(idx) {
+			if generated[c.Name] {
+				continue
+			}
+			generated[c.Name] = true
+			if _, ok := curr.column(c.Name); !ok {
+				change.column.add = append(change.column.add, c)
+			}
+		}
+	}
+
 	// Drop columns.
 	for _, c1 := range curr.Columns {
 		// If a column was dropped, multi-columns indexes that are associated with this column will
 		// no longer behave the same. Therefore, these indexes should be dropped too. There's no need
 		// to do it explicitly (here), because entc will remove them from the schema specification,
 		// and they will be dropped in the block below.
-		if _, ok := new.column(c1.Name); !ok {
+		if _, ok := new.column(c1.Name); !ok && !generated[c1.Name] {
 			change.column.drop = append(change.column.drop, c1)
 		}
 	}
@@ -555,6 +752,52 @@ func rollback(tx dialect.Tx, err error) error {
 }
 
 // exist checks if the given COUNT query returns a value >= 1.
+// SupportsJSON reports if the database that the given driver is connected to
+// supports the JSON type and functions that are used by the JSON predicates of
+// the sql package. In MySQL, the version is queried using "SELECT VERSION()",
+// and versions 5.7.8 and above are supported. In PostgreSQL, it is queried using
+// "SHOW server_version_num", and versions 9.4 (that added jsonb) and above are
+// supported. In SQLite, the JSON1 extension is probed by calling the json function.
+// Other dialects (e.g. Gremlin) do not support JSON.
+func SupportsJSON(ctx context.Context, drv dialect.Driver) (bool, error) {
+	var query string
+	switch drv.Dialect() {
+	case dialect.MySQL:
+		query = "SELECT VERSION()"
+	case dialect.Postgres:
+		query = "SHOW server_version_num"
+	case dialect.SQLite:
+		query = "SELECT JSON('[]')"
+	default:
+		return false, nil
+	}
+	rows := &sql.Rows{}
+	if err := drv.Query(ctx, query, []interface{}{}, rows); err != nil {
+		// SQLite was compiled without the JSON1 extension.
+		if drv.Dialect() == dialect.SQLite && strings.Contains(err.Error(), "no such function") {
+			return false, nil
+		}
+		return false, fmt.Errorf("probing json support: %v", err)
+	}
+	defer rows.Close()
+	v, err := sql.ScanString(rows)
+	if err != nil {
+		return false, fmt.Errorf("scanning json support probe: %v", err)
+	}
+	switch drv.Dialect() {
+	case dialect.MySQL:
+		return compareVersions(v, "5.7.8") >= 0, nil
+	case dialect.Postgres:
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return false, fmt.Errorf("malformed version: %s", v)
+		}
+		return n >= 90400, nil
+	default:
+		return true, nil
+	}
+}
+
 func exist(ctx context.Context, tx dialect.Tx, query string, args ...interface{}) (bool, error) {
 	rows := &sql.Rows{}
 	if err := tx.Query(ctx, query, args, rows); err != nil {
@@ -592,6 +835,18 @@ type sqlDialect interface {
 	alterColumns(table string, add, modify, drop []*Column) sql.Queries
 }
 
+// commenter is implemented by dialects that set the comments
+// of columns using separate statements (e.g. PostgreSQL).
+type commenter interface {
+	commentColumns(table string, columns []*Column) sql.Queries
+}
+
+// generatedReader is implemented by dialects that do not return
+// the generated columns of tables in their description (e.g. SQLite).
+type generatedReader interface {
+	generatedColumns(context.Context, dialect.Tx, *Table) error
+}
+
 type preparer interface {
 	prepare(context.Context, dialect.Tx, *changes, string) error
 }
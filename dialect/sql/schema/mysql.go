@@ -170,6 +170,17 @@ func (d *MySQL) tBuilder(t *Table) *sql.TableBuilder {
 	for _, c := range t.Columns {
 		b.Column(d.addColumn(c))
 	}
+	if d.supportsJSONIndex() {
+		generated := make(map[string]bool)
+		for _, idx := range t.Indexes {
+			for _, c := range idx.generatedColumns() {
+				if !generated[c.Name] {
+					generated[c.Name] = true
+					b.Column(d.addColumn(c))
+				}
+			}
+		}
+	}
 	for _, pk := range t.PrimaryKey {
 		b.PrimaryKey(pk.Name)
 	}
@@ -260,18 +271,67 @@ func (d *MySQL) cType(c *Column) (t string) {
 // The syntax/order is: datatype [Charset] [Unique|Increment] [Collation] [Nullable].
 func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	generated := c.generated(b, dialect.MySQL, "VIRTUAL")
 	c.unique(b)
 	if c.Increment {
 		b.Attr("AUTO_INCREMENT")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	if !generated {
+		d.defaultValue(c, b)
+	}
+	if c.Comment != "" {
+		b.Attr("COMMENT " + mysqlQuote(c.Comment))
+	}
 	return b
 }
 
+// defaultValue adds the `DEFAULT` attribute to the column. Default expressions
+// are supported only from MySQL 8.0.13, and they must be written in parentheses.
+// In older versions, they are skipped.
+func (d *MySQL) defaultValue(c *Column, b *sql.ColumnBuilder) {
+	switch {
+	case c.DefaultExpr == "":
+		c.defaultValue(b)
+	case compareVersions(d.version, "8.0.13") >= 0:
+		b.Attr("DEFAULT (" + c.DefaultExpr + ")")
+	}
+}
+
+// mysqlQuote returns the given string as a MySQL string literal.
+func mysqlQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(s) + "'"
+}
+
 // addIndex returns the querying for adding an index to MySQL.
 func (d *MySQL) addIndex(i *Index, table string) *sql.IndexBuilder {
-	return i.Builder(table)
+	if i.JSONColumn == "" && len(i.generatedColumns()) == 0 {
+		return i.Builder(table)
+	}
+	idx := sql.CreateIndex(i.Name).Table(table)
+	if i.Unique {
+		idx.Unique()
+	}
+	switch {
+	// Indexes on JSON paths are created on their generated columns.
+	case i.JSONColumn != "":
+		idx.Column(i.JSONColumn)
+	// JSON columns are replaced with their hash columns.
+	default:
+		for _, c := range i.Columns {
+			if c.Type == field.TypeJSON {
+				c = c.hashColumn()
+			}
+			idx.Column(c.Name)
+		}
+	}
+	return idx
+}
+
+// supportsJSONIndex reports if the MySQL version supports indexes on
+// JSON paths and columns. i.e. JSON type and stored generated columns.
+func (d *MySQL) supportsJSONIndex() bool {
+	return compareVersions(d.version, "5.7.8") >= 0
 }
 
 // dropIndex drops a MySQL index.
@@ -69,6 +69,72 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with column comments",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "doc", Type: field.TypeJSON, Nullable: true, Comment: `free-form 'meta' \ data`},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("5.7.8")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `doc` json NULL COMMENT 'free-form ''meta'' \\\\ data', PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with json default expression",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "ints", Type: field.TypeJSON, Nullable: true, DefaultExpr: "'[]'"},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `ints` json NULL DEFAULT ('[]'), PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with json default expression 5.7",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "ints", Type: field.TypeJSON, Nullable: true, DefaultExpr: "'[]'"},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("5.7.8")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `ints` json NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table 5.6",
 			tables: []*Table{
@@ -198,6 +264,225 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with json index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url_scheme", Columns: c[1:2], JSONPath: "$.Scheme", JSONColumn: "url_scheme"},
+						},
+					},
+				}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.start("5.7.23")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `url` json NULL, `url_scheme` varchar(255) AS (JSON_UNQUOTE(JSON_EXTRACT(`url`, '$.Scheme'))) STORED NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE INDEX `user_url_scheme` ON `users`(`url_scheme`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with json index 5.6",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url_scheme", Columns: c[1:2], JSONPath: "$.Scheme", JSONColumn: "url_scheme"},
+						},
+					},
+				}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.start("5.6.35")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `url` longblob NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add json index to table",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url_scheme", Columns: c[1:2], JSONPath: "$.Scheme", JSONColumn: "url_scheme"},
+						},
+					},
+				}
+			}(),
+			options: []MigrateOption{WithDropColumn(true), WithDropIndex(true)},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "").
+						AddRow("url", "json", "YES", "", "NULL", "", "", ""))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `url_scheme` varchar(255) AS (JSON_UNQUOTE(JSON_EXTRACT(`url`, '$.Scheme'))) STORED NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE INDEX `user_url_scheme` ON `users`(`url_scheme`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with unique json column index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "name", Type: field.TypeString},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_name_url", Unique: true, Columns: c[1:3]},
+						},
+					},
+				}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.start("5.7.23")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `name` varchar(255) NOT NULL, `url` json NULL, `url_hash` varchar(64) AS (SHA2(`url`, 256)) STORED NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE UNIQUE INDEX `user_name_url` ON `users`(`name`, `url_hash`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add unique json column index to table",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url", Unique: true, Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			options: []MigrateOption{WithDropColumn(true), WithDropIndex(true)},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "").
+						AddRow("url", "json", "YES", "", "NULL", "", "", ""))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `url_hash` varchar(64) AS (SHA2(`url`, 256)) STORED NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE UNIQUE INDEX `user_url` ON `users`(`url_hash`)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "unique json column index 5.6",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url", Unique: true, Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock mysqlMock) {
+				mock.start("5.6.35")
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "json index exists",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "url", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_url_scheme", Columns: c[1:2], JSONPath: "$.Scheme", JSONColumn: "url_scheme"},
+						},
+					},
+				}
+			}(),
+			options: []MigrateOption{WithDropColumn(true), WithDropIndex(true)},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "").
+						AddRow("url", "json", "YES", "", "NULL", "", "", "").
+						AddRow("url_scheme", "varchar(255)", "YES", "MUL", "NULL", "STORED GENERATED", "utf8mb4", "utf8mb4_bin"))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1").
+						AddRow("user_url_scheme", "url_scheme", "1", "1"))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "enums",
 			tables: []*Table{
@@ -402,6 +687,41 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "add json column with backfill to table",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "dirs", Type: field.TypeJSON, Backfill: `["/tmp"]`},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("5.7.23")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", ""))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `dirs` json NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("UPDATE `users` SET `dirs` = ? WHERE `dirs` IS NULL")).
+					WithArgs(`["/tmp"]`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(escape("ALTER TABLE `users` MODIFY COLUMN `dirs` json NOT NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add bool column with default value",
 			tables: []*Table{
@@ -245,6 +245,10 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Type = field.TypeBytes
 	case "jsonb":
 		c.Type = field.TypeJSON
+	case "json":
+		c.Type = field.TypeJSON
+		// Keep the actual type for detecting json/jsonb changes.
+		c.SchemaType = map[string]string{dialect.Postgres: "json"}
 	case "uuid":
 		c.Type = field.TypeUUID
 	}
@@ -267,6 +271,11 @@ func (d *Postgres) tBuilder(t *Table) *sql.TableBuilder {
 	for _, c := range t.Columns {
 		b.Column(d.addColumn(c))
 	}
+	for _, idx := range t.Indexes {
+		if idx.TSColumn != "" {
+			b.Column(d.addColumn(idx.tsColumn()))
+		}
+	}
 	for _, pk := range t.PrimaryKey {
 		b.PrimaryKey(pk.Name)
 	}
@@ -318,15 +327,35 @@ func (d *Postgres) cType(c *Column) (t string) {
 func (d *Postgres) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Dialect(dialect.Postgres).
 		Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	// PostgreSQL supports only stored generated columns.
+	generated := c.generated(b, dialect.Postgres, "STORED")
 	c.unique(b)
 	if c.Increment {
 		b.Attr("GENERATED BY DEFAULT AS IDENTITY")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	if !generated {
+		c.defaultValue(b)
+	}
 	return b
 }
 
+// commentColumns returns the queries for setting the comments of the given
+// columns. Unlike MySQL, comments are not a part of the column definition in
+// PostgreSQL, and they are set using the COMMENT ON COLUMN statement.
+func (d *Postgres) commentColumns(table string, columns []*Column) sql.Queries {
+	var queries sql.Queries
+	for _, c := range columns {
+		if c.Comment == "" {
+			continue
+		}
+		// Escape single quote by replacing each with 2.
+		comment := strings.Replace(c.Comment, "'", "''", -1)
+		queries = append(queries, sql.Raw(fmt.Sprintf(`COMMENT ON COLUMN "%s"."%s" IS '%s'`, table, c.Name, comment)))
+	}
+	return queries
+}
+
 // alterColumn returns list of ColumnBuilder for applying in order to alter a column.
 func (d *Postgres) alterColumn(c *Column) (ops []*sql.ColumnBuilder) {
 	b := sql.Dialect(dialect.Postgres)
@@ -368,6 +397,13 @@ func (d *Postgres) addIndex(i *Index, table string) *sql.IndexBuilder {
 	if i.Unique {
 		idx.Unique()
 	}
+	if i.Type != "" {
+		idx.Using(i.Type)
+	}
+	// Full-text indexes are created on their generated columns.
+	if i.TSColumn != "" {
+		return idx.Column(i.TSColumn)
+	}
 	for _, c := range i.Columns {
 		idx.Column(c.Name)
 	}
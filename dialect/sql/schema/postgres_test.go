@@ -75,6 +75,121 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with json default expression",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "ints", Type: field.TypeJSON, DefaultExpr: "'[]'"},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "ints" jsonb NOT NULL DEFAULT '[]', PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with column comments",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "doc", Type: field.TypeJSON, Nullable: true, Comment: "free-form 'meta' data"},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "doc" jsonb NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`COMMENT ON COLUMN "users"."doc" IS 'free-form ''meta'' data'`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add column with comment to table",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "doc", Type: field.TypeJSON, Nullable: true, Comment: "free-form data"},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "doc" jsonb NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`COMMENT ON COLUMN "users"."doc" IS 'free-form data'`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add json columns with backfill to table",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "dirs", Type: field.TypeJSON, Backfill: `["/tmp"]`},
+						{Name: "meta", Type: field.TypeJSON, Nullable: true, Backfill: `{}`},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "dirs" jsonb NULL, ADD COLUMN "meta" jsonb NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`UPDATE "users" SET "dirs" = $1 WHERE "dirs" IS NULL`)).
+					WithArgs(`["/tmp"]`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ALTER COLUMN "dirs" TYPE jsonb, ALTER COLUMN "dirs" SET NOT NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`UPDATE "users" SET "meta" = $1 WHERE "meta" IS NULL`)).
+					WithArgs(`{}`).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -386,6 +501,274 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "modify json column to jsonb",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "doc", Type: field.TypeJSON, Nullable: true},
+						{Name: "raw", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{dialect.Postgres: "json"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL").
+						AddRow("doc", "json", "YES", "NULL").
+						AddRow("raw", "json", "YES", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ALTER COLUMN "doc" TYPE jsonb, ALTER COLUMN "doc" DROP NOT NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with gin index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc", Type: "GIN", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "doc" jsonb NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "user_doc" ON "users" USING GIN("doc")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with unique json column index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc", Unique: true, Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "doc" jsonb NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE UNIQUE INDEX "user_doc" ON "users"("doc")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "unique index on json type column",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{dialect.Postgres: "json"}},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc", Unique: true, Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "gin index exists",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc", Type: "GIN", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL").
+						AddRow("doc", "jsonb", "YES", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0).
+						AddRow("user_doc", "doc", "f", "f", 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with tsvector index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc_tsv", Type: "GIN", TSColumn: "doc_tsv", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "doc" jsonb NULL, "doc_tsv" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "doc")) STORED NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "user_doc_tsv" ON "users" USING GIN("doc_tsv")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "add tsvector index to table",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc_tsv", Type: "GIN", TSColumn: "doc_tsv", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL").
+						AddRow("doc", "jsonb", "YES", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "doc_tsv" tsvector GENERATED ALWAYS AS (to_tsvector('simple', "doc")) STORED NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "user_doc_tsv" ON "users" USING GIN("doc_tsv")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "tsvector index exists",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc_tsv", Type: "GIN", TSColumn: "doc_tsv", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			options: []MigrateOption{WithDropColumn(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
+						AddRow("id", "bigint", "NO", "NULL").
+						AddRow("doc", "jsonb", "YES", "NULL").
+						AddRow("doc_tsv", "tsvector", "YES", "NULL"))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0).
+						AddRow("user_doc_tsv", "doc_tsv", "f", "f", 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "tsvector index requires postgres 12",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc_tsv", Type: "GIN", TSColumn: "doc_tsv", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("110000")
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "apply uniqueness on column",
 			tables: []*Table{
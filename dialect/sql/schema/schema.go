@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/facebook/ent/dialect"
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/schema/field"
 )
@@ -97,6 +98,16 @@ func (t *Table) column(name string) (*Column, bool) {
 	return nil, false
 }
 
+// hasGenerated reports if the table has generated columns.
+func (t *Table) hasGenerated() bool {
+	for _, c := range t.Columns {
+		if len(c.Generated) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // index returns a table index by its name.
 func (t *Table) index(name string) (*Index, bool) {
 	for _, idx := range t.Indexes {
@@ -137,20 +148,24 @@ func (t *Table) fk(symbol string) (*ForeignKey, bool) {
 
 // Column schema definition for SQL dialects.
 type Column struct {
-	Name       string            // column name.
-	Type       field.Type        // column type.
-	SchemaType map[string]string // optional schema type per dialect.
-	Attr       string            // extra attributes.
-	Size       int64             // max size parameter for string, blob, etc.
-	Key        string            // key definition (PRI, UNI or MUL).
-	Unique     bool              // column with unique constraint.
-	Increment  bool              // auto increment attribute.
-	Nullable   bool              // null or not null attribute.
-	Default    interface{}       // default value.
-	Enums      []string          // enum values.
-	typ        string            // row column type (used for Rows.Scan).
-	indexes    Indexes           // linked indexes.
-	foreign    *ForeignKey       // linked foreign-key.
+	Name        string            // column name.
+	Type        field.Type        // column type.
+	SchemaType  map[string]string // optional schema type per dialect.
+	Attr        string            // extra attributes.
+	Size        int64             // max size parameter for string, blob, etc.
+	Key         string            // key definition (PRI, UNI or MUL).
+	Unique      bool              // column with unique constraint.
+	Increment   bool              // auto increment attribute.
+	Nullable    bool              // null or not null attribute.
+	Default     interface{}       // default value.
+	Enums       []string          // enum values.
+	Comment     string            // column comment.
+	Backfill    string            // value for existing rows when the column is added.
+	DefaultExpr string            // default expression, used as is.
+	Generated   map[string]string // generated column expression per dialect.
+	typ         string            // row column type (used for Rows.Scan).
+	indexes     Indexes           // linked indexes.
+	foreign     *ForeignKey       // linked foreign-key.
 }
 
 // UniqueKey returns boolean indicates if this column is a unique key.
@@ -237,6 +252,10 @@ func (c *Column) ScanDefault(value string) error {
 // Note that, in SQLite if a NOT NULL constraint is specified,
 // then the column must have a default value which not NULL.
 func (c *Column) defaultValue(b *sql.ColumnBuilder) {
+	if c.DefaultExpr != "" {
+		b.Attr("DEFAULT " + c.DefaultExpr)
+		return
+	}
 	// has default, and it's supported in the database level.
 	if c.Default != nil && c.supportDefault() {
 		attr := "DEFAULT "
@@ -253,6 +272,18 @@ func (c *Column) defaultValue(b *sql.ColumnBuilder) {
 	}
 }
 
+// generated adds the `GENERATED ALWAYS AS` clause of the given dialect to the
+// column, and reports if it was added. The kind (VIRTUAL or STORED) of the column
+// depends on the dialect. Generated columns cannot have default values.
+func (c *Column) generated(b *sql.ColumnBuilder, name, kind string) bool {
+	expr, ok := c.Generated[name]
+	if !ok {
+		return false
+	}
+	b.Attr("GENERATED ALWAYS AS (" + expr + ") " + kind)
+	return true
+}
+
 // supportDefault reports if the column type supports default value.
 func (c Column) supportDefault() bool {
 	switch {
@@ -308,6 +339,19 @@ func (c *Column) scanTypeOr(t string) string {
 	return t
 }
 
+// hashColumn returns the generated column that holds the SHA-256 hash of the
+// JSON column. MySQL does not support indexes on JSON columns, therefore,
+// indexes that contain them are created on their hash columns instead.
+func (c *Column) hashColumn() *Column {
+	return &Column{
+		Name:     c.Name + "_hash",
+		Type:     field.TypeString,
+		Size:     64,
+		Nullable: true,
+		Attr:     fmt.Sprintf("AS (SHA2(`%s`, 256)) STORED", c.Name),
+	}
+}
+
 // ForeignKey definition for creation.
 type ForeignKey struct {
 	Symbol     string          // foreign-key name. Generated if empty.
@@ -364,10 +408,20 @@ func (r ReferenceOption) ConstName() string {
 type Index struct {
 	Name     string    // index name.
 	Unique   bool      // uniqueness.
+	Type     string    // index type (e.g. GIN). Postgres only.
 	Columns  []*Column // actual table columns.
 	columns  []string  // columns loaded from query scan.
 	primary  bool      // primary key index.
 	realname string    // real name in the database (Postgres only).
+	// JSONPath and JSONColumn define an index on a JSON path of the
+	// column. The value is extracted to a stored generated column,
+	// and the index is created on it. MySQL only.
+	JSONPath   string
+	JSONColumn string
+	// TSColumn defines a full-text index on the string values of a
+	// JSON column. The values are extracted to a stored generated
+	// tsvector column, and the index is created on it. Postgres only.
+	TSColumn string
 }
 
 // Builder returns the query builder for index creation. The DSL is identical in all dialects.
@@ -382,6 +436,47 @@ func (i *Index) Builder(table string) *sql.IndexBuilder {
 	return idx
 }
 
+// jsonColumn returns the generated column that holds the (unquoted)
+// value of the JSON path of the index.
+func (i *Index) jsonColumn() *Column {
+	return &Column{
+		Name:     i.JSONColumn,
+		Type:     field.TypeString,
+		Size:     DefaultStringLen,
+		Nullable: true,
+		Attr:     fmt.Sprintf("AS (JSON_UNQUOTE(JSON_EXTRACT(`%s`, '%s'))) STORED", i.Columns[0].Name, strings.Replace(i.JSONPath, "'", "''", -1)),
+	}
+}
+
+// tsColumn returns the generated column that holds the tsvector of the
+// string values of the JSON column of the index. The "simple" text search
+// configuration is used, as the values are not written in a known language.
+func (i *Index) tsColumn() *Column {
+	return &Column{
+		Name:       i.TSColumn,
+		Type:       field.TypeString,
+		Nullable:   true,
+		SchemaType: map[string]string{dialect.Postgres: "tsvector"},
+		Generated:  map[string]string{dialect.Postgres: fmt.Sprintf(`to_tsvector('simple', "%s")`, i.Columns[0].Name)},
+	}
+}
+
+// generatedColumns returns the stored generated columns that are used by the
+// index in MySQL. i.e. the column that holds the value of its JSON path, or the
+// columns that hold the hashes of the JSON columns it contains.
+func (i *Index) generatedColumns() []*Column {
+	if i.JSONColumn != "" {
+		return []*Column{i.jsonColumn()}
+	}
+	var columns []*Column
+	for _, c := range i.Columns {
+		if c.Type == field.TypeJSON {
+			columns = append(columns, c.hashColumn())
+		}
+	}
+	return columns
+}
+
 // DropBuilder returns the query builder for the drop index.
 func (i *Index) DropBuilder(table string) *sql.DropIndexBuilder {
 	idx := sql.DropIndex(i.Name).Table(table)
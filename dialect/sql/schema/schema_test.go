@@ -5,10 +5,15 @@
 package schema
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/schema/field"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -93,3 +98,42 @@ func TestColumn_ScanDefault(t *testing.T) {
 	require.Equal(t, false, c1.Default)
 	require.Error(t, c1.ScanDefault("foo"))
 }
+
+func TestSupportsJSON(t *testing.T) {
+	tests := []struct {
+		dialect string
+		query   string
+		value   string
+		err     error
+		want    bool
+		wantErr bool
+	}{
+		{dialect: dialect.MySQL, query: "SELECT VERSION()", value: "5.6.35", want: false},
+		{dialect: dialect.MySQL, query: "SELECT VERSION()", value: "5.7.26-log", want: true},
+		{dialect: dialect.MySQL, query: "SELECT VERSION()", value: "8.0.19", want: true},
+		{dialect: dialect.Postgres, query: "SHOW server_version_num", value: "90300", want: false},
+		{dialect: dialect.Postgres, query: "SHOW server_version_num", value: "120002", want: true},
+		{dialect: dialect.SQLite, query: "SELECT JSON('[]')", value: "[]", want: true},
+		{dialect: dialect.SQLite, query: "SELECT JSON('[]')", err: errors.New("no such function: JSON"), want: false},
+		{dialect: dialect.MySQL, query: "SELECT VERSION()", err: sqlmock.ErrCancelled, wantErr: true},
+	}
+	for _, tt := range tests {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		expect := mock.ExpectQuery(escape(tt.query))
+		if tt.err != nil {
+			expect.WillReturnError(tt.err)
+		} else {
+			expect.WillReturnRows(sqlmock.NewRows([]string{"v"}).AddRow(tt.value))
+		}
+		ok, err := SupportsJSON(context.Background(), sql.OpenDB(tt.dialect, db))
+		require.Equal(t, tt.wantErr, err != nil, err)
+		require.Equal(t, tt.want, ok)
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	ok, err := SupportsJSON(context.Background(), sql.OpenDB(dialect.Gremlin, db))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
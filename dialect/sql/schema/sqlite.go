@@ -125,15 +125,41 @@ func (*SQLite) cType(c *Column) (t string) {
 // addColumn returns the DSL query for adding the given column to a table.
 func (d *SQLite) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	// Stored generated columns cannot be added to existing tables.
+	generated := c.generated(b, dialect.SQLite, "VIRTUAL")
 	c.unique(b)
 	if c.Increment {
 		b.Attr("PRIMARY KEY AUTOINCREMENT")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	if !generated {
+		c.defaultValue(b)
+	}
 	return b
 }
 
+// generatedColumns adds the generated columns of the table to its description,
+// as they are hidden from the pragma_table_info function that is used by table.
+func (d *SQLite) generatedColumns(ctx context.Context, tx dialect.Tx, t *Table) error {
+	rows := &sql.Rows{}
+	query, args := sql.Select("name", "type", "notnull", "dflt_value", "pk").
+		From(sql.Table(fmt.Sprintf("pragma_table_xinfo('%s')", t.Name)).Unquote()).
+		Where(sql.In("hidden", 2, 3)).
+		Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("sqlite: reading generated columns %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		c := &Column{}
+		if err := d.scanColumn(c, rows); err != nil {
+			return fmt.Errorf("sqlite: %v", err)
+		}
+		t.AddColumn(c)
+	}
+	return rows.Err()
+}
+
 // addIndex returns the querying for adding an index to SQLite.
 func (d *SQLite) addIndex(i *Index, table string) *sql.IndexBuilder {
 	return i.Builder(table)
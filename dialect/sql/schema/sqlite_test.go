@@ -76,6 +76,56 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with gin index",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc", Type: "GIN", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `doc` json NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "tsvector index is not supported",
+			tables: func() []*Table {
+				c := []*Column{
+					{Name: "id", Type: field.TypeInt, Increment: true},
+					{Name: "doc", Type: field.TypeJSON, Nullable: true},
+				}
+				return []*Table{
+					{
+						Name:       "users",
+						Columns:    c,
+						PrimaryKey: c[0:1],
+						Indexes: Indexes{
+							{Name: "user_doc_tsv", Type: "GIN", TSColumn: "doc_tsv", Columns: c[1:2]},
+						},
+					},
+				}
+			}(),
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -263,6 +313,42 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "add generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "name", Type: field.TypeString, Nullable: true},
+						{Name: "name_upper", Type: field.TypeString, Nullable: true, Generated: map[string]string{dialect.SQLite: "UPPER(name)"}},
+						{Name: "name_len", Type: field.TypeInt, Nullable: true, Generated: map[string]string{dialect.SQLite: "LENGTH(name)"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+					WithArgs().
+					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
+						AddRow("name", "varchar(255)", 0, nil, 0).
+						AddRow("id", "integer", 1, "NULL", 1))
+				mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('users')")).
+					WithArgs().
+					WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "origin"}))
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('users') WHERE `hidden` IN (?, ?)")).
+					WithArgs(2, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
+						AddRow("name_upper", "varchar(255)", 0, nil, 0))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `name_len` integer GENERATED ALWAYS AS (LENGTH(name)) VIRTUAL NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add edge to table",
 			tables: func() []*Table {
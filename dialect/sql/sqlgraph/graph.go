@@ -292,6 +292,11 @@ type (
 		Column string
 		Type   field.Type
 		Value  driver.Value // value to be stored.
+		// Marshal is an optional function for encoding the
+		// value of JSON fields, instead of json.Marshal. If it
+		// is nil, values that implement driver.Valuer are not
+		// encoded, and passed to the driver as is.
+		Marshal func(interface{}) ([]byte, error)
 	}
 
 	// EdgeTarget holds the information for the target nodes
@@ -382,6 +387,14 @@ type (
 		Clear []*FieldSpec // field = NULL
 	}
 
+	// PatchSpec holds the JSON patch (RFC 6902) operations for
+	// a JSON column. Patches are applied on the stored values in
+	// a read-modify-write manner, after the columns were updated.
+	PatchSpec struct {
+		Column string
+		Ops    []sql.JSONPatchOp
+	}
+
 	// UpdateSpec holds the information for updating one
 	// or more nodes in the graph.
 	UpdateSpec struct {
@@ -389,6 +402,8 @@ type (
 		Edges     EdgeMut
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
+		Modifiers []func(*sql.UpdateBuilder)
+		Patches   []*PatchSpec
 
 		ScanValues []interface{}
 		Assign     func(...interface{}) error
@@ -477,6 +492,8 @@ type QuerySpec struct {
 	Limit     int
 	Offset    int
 	Unique    bool
+	ForUpdate bool     // Lock the selected rows. Ignored by count queries.
+	Omit      []string // Columns that are selected as NULL, without their values.
 	Order     func(*sql.Selector)
 	Predicate func(*sql.Selector)
 
@@ -546,8 +563,18 @@ type query struct {
 }
 
 func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
+	for _, c := range q.Omit {
+		if c == q.Node.ID.Column || !contains(q.Node.Columns, c) {
+			return fmt.Errorf("sqlgraph: invalid column %q for omit", c)
+		}
+	}
 	rows := &sql.Rows{}
-	query, args := q.selector().Query()
+	selector := q.selector()
+	if q.ForUpdate {
+		// DISTINCT is not allowed with FOR UPDATE in PostgreSQL.
+		selector.SetDistinct(false).ForUpdate()
+	}
+	query, args := selector.Query()
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -585,7 +612,17 @@ func (q *query) selector() *sql.Selector {
 	if q.From != nil {
 		selector = q.From
 	}
-	selector.Select(selector.Columns(q.Node.Columns...)...)
+	columns := selector.Columns(q.Node.Columns...)
+	for i, c := range q.Node.Columns {
+		// Omitted columns keep their position in the selection,
+		// and therefore, the scanning order of the node values.
+		if contains(q.Omit, c) {
+			b := &sql.Builder{}
+			b.SetDialect(selector.Dialect())
+			columns[i] = b.WriteString("NULL AS ").Ident(c).String()
+		}
+	}
+	selector.Select(columns...)
 	if pred := q.Predicate; pred != nil {
 		pred(selector)
 	}
@@ -633,6 +670,9 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	if err := u.setExternalEdges(ctx, []driver.Value{id}, addEdges, clearEdges); err != nil {
 		return err
 	}
+	if err := u.patchNodes(ctx, tx, []driver.Value{id}); err != nil {
+		return err
+	}
 	selector := u.builder.Select(u.Node.Columns...).
 		From(u.builder.Table(u.Node.Table)).
 		Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
@@ -684,9 +724,72 @@ func (u *updater) nodes(ctx context.Context, tx dialect.ExecQuerier) (int, error
 	if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
 		return 0, err
 	}
+	if err := u.patchNodes(ctx, tx, ids); err != nil {
+		return 0, err
+	}
 	return len(ids), nil
 }
 
+// patchNodes applies the JSON patches on the stored values of the given nodes.
+// The values are locked for update (if supported), patched and written back.
+func (u *updater) patchNodes(ctx context.Context, tx dialect.ExecQuerier, ids []driver.Value) error {
+	if len(u.Patches) == 0 {
+		return nil
+	}
+	columns := make([]string, len(u.Patches))
+	for i, p := range u.Patches {
+		columns[i] = p.Column
+	}
+	for _, id := range ids {
+		rows := &sql.Rows{}
+		query, args := u.builder.Select(columns...).
+			From(u.builder.Table(u.Node.Table)).
+			Where(sql.EQ(u.Node.ID.Column, id)).
+			ForUpdate().
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		values := make([]sql.NullString, len(columns))
+		if err := u.scanPatched(rows, id, values); err != nil {
+			return err
+		}
+		update := u.builder.Update(u.Node.Table).Where(sql.EQ(u.Node.ID.Column, id))
+		for i, p := range u.Patches {
+			doc, err := sql.ApplyJSONPatch([]byte(values[i].String), p.Ops)
+			if err != nil {
+				return fmt.Errorf("patch column %s: %w", p.Column, err)
+			}
+			update.Set(p.Column, json.RawMessage(doc))
+		}
+		var res sql.Result
+		query, args = update.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanPatched scans the values of the patched columns of the given node.
+func (u *updater) scanPatched(rows *sql.Rows, id driver.Value, values []sql.NullString) error {
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return &NotFoundError{table: u.Node.Table, id: id}
+	}
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return fmt.Errorf("failed scanning rows: %v", err)
+	}
+	return rows.Close()
+}
+
 func (u *updater) setExternalEdges(ctx context.Context, ids []driver.Value, addEdges, clearEdges map[Rel][]*EdgeSpec) error {
 	if err := u.graph.clearM2MEdges(ctx, ids, clearEdges[M2M]); err != nil {
 		return err
@@ -738,6 +841,9 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 	for _, fi := range u.Fields.Add {
 		update.Add(fi.Column, fi.Value)
 	}
+	for _, m := range u.Modifiers {
+		m(update)
+	}
 	return nil
 }
 
@@ -1085,8 +1191,19 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
 	for _, fi := range fields {
 		value := fi.Value
+		// JSON values that implement the driver.Valuer interface are passed to
+		// the driver as is, unless a custom marshal function was provided.
+		if _, ok := value.(driver.Valuer); ok && fi.Type == field.TypeJSON && fi.Marshal == nil {
+			set(fi.Column, value)
+			continue
+		}
 		if fi.Type == field.TypeJSON {
-			buf, err := json.Marshal(value)
+			marshal := json.Marshal
+			// Untyped nil values (e.g. nil interfaces) are encoded as JSON null.
+			if fi.Marshal != nil && value != nil {
+				marshal = fi.Marshal
+			}
+			buf, err := marshal(value)
 			if err != nil {
 				return fmt.Errorf("marshal value for column %s: %v", fi.Column, err)
 			}
@@ -1229,3 +1346,13 @@ func product(a, b []driver.Value) [][2]driver.Value {
 	}
 	return c
 }
+
+// contains reports if the given column exists in the list.
+func contains(columns []string, column string) bool {
+	for i := range columns {
+		if columns[i] == column {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/facebook/ent/dialect"
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/schema/field"
 
@@ -499,6 +500,66 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "fields/json/marshal",
+			spec: &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{
+						Column: "json",
+						Type:   field.TypeJSON,
+						Value:  []string{"<a>"},
+						Marshal: func(v interface{}) ([]byte, error) {
+							return []byte(`["<a>"]`), nil
+						},
+					},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`json`) VALUES (?)")).
+					WithArgs([]byte(`["<a>"]`)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name: "fields/json/nil",
+			spec: &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "json", Type: field.TypeJSON, Value: nil, Marshal: func(v interface{}) ([]byte, error) {
+						return []byte("{}"), v.(error)
+					}},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`json`) VALUES (?)")).
+					WithArgs([]byte("null")).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name: "fields/json/valuer",
+			spec: &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "json", Type: field.TypeJSON, Value: sql.NullString{String: "[1,2]", Valid: true}},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`json`) VALUES (?)")).
+					WithArgs("[1,2]").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectCommit()
+			},
+		},
 		{
 			name: "edges/m2o",
 			spec: &CreateSpec{
@@ -817,6 +878,44 @@ func TestBatchCreate(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "json fields",
+			nodes: []*CreateSpec{
+				{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id"},
+					Fields: []*FieldSpec{
+						{Column: "ints", Type: field.TypeJSON, Value: []int{1, 2}},
+						{Column: "strings", Type: field.TypeJSON, Value: []string{"a"}},
+					},
+				},
+				{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id"},
+					Fields: []*FieldSpec{
+						{Column: "ints", Type: field.TypeJSON, Value: []int{3}},
+					},
+				},
+				{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id"},
+					Fields: []*FieldSpec{
+						{Column: "ints", Type: field.TypeJSON, Value: []int(nil)},
+						{Column: "strings", Type: field.TypeJSON, Value: []string{"b", "c"}, Marshal: func(v interface{}) ([]byte, error) {
+							return []byte(`"b,c"`), nil
+						}},
+					},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				// JSON values are marshaled per row, and inserted in one statement.
+				m.ExpectExec(escape("INSERT INTO `users` (`ints`, `strings`) VALUES (?, ?), (?, ?), (?, ?)")).
+					WithArgs([]byte("[1,2]"), []byte(`["a"]`), []byte("[3]"), nil, []byte("null"), []byte(`"b,c"`)).
+					WillReturnResult(sqlmock.NewResult(10, 3))
+				m.ExpectCommit()
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -921,6 +1020,99 @@ func TestUpdateNode(t *testing.T) {
 			},
 			wantUser: &user{age: 31, id: 1},
 		},
+		{
+			name: "modifiers",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+				Modifiers: []func(*sql.UpdateBuilder){
+					func(u *sql.UpdateBuilder) {
+						u.Set("name", "Ariel")
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ?, `name` = ? WHERE `id` = ?")).
+					WithArgs(30, "Ariel", 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "Ariel"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1},
+		},
+		{
+			name: "patches",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+				Patches: []*PatchSpec{
+					{Column: "tags", Ops: []sql.JSONPatchOp{{Op: "add", Path: "/-", Value: "b"}, {Op: "remove", Path: "/0"}}},
+					{Column: "meta", Ops: []sql.JSONPatchOp{{Op: "add", Path: "", Value: map[string]int{"a": 1}}}},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` = ?")).
+					WithArgs(30, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `tags`, `meta` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"tags", "meta"}).
+						AddRow(`["a"]`, nil))
+				mock.ExpectExec(escape("UPDATE `users` SET `tags` = ?, `meta` = ? WHERE `id` = ?")).
+					WithArgs([]byte(`["b"]`), []byte(`{"a":1}`), 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "Ariel"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1},
+		},
+		{
+			name: "patches/test_failed",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Patches: []*PatchSpec{
+					{Column: "tags", Ops: []sql.JSONPatchOp{{Op: "test", Path: "/0", Value: "b"}, {Op: "remove", Path: "/0"}}},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT `tags` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"tags"}).
+						AddRow(`["a"]`))
+				mock.ExpectRollback()
+			},
+			wantErr:  true,
+			wantUser: &user{},
+		},
 		{
 			name: "edges/o2o_non_inverse and m2o",
 			spec: &UpdateSpec{
@@ -1097,6 +1289,36 @@ func TestUpdateNodes(t *testing.T) {
 			},
 			wantAffected: 2,
 		},
+		{
+			name: "json append",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Modifiers: []func(*sql.UpdateBuilder){
+					func(u *sql.UpdateBuilder) {
+						u.JSONAppend("strings", []string{"x"})
+					},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.NotNull("strings"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `strings` IS NOT NULL")).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).
+						AddRow(1).
+						AddRow(2))
+				// All rows are updated in one statement.
+				mock.ExpectExec(escape("UPDATE `users` SET `strings` = JSON_INSERT(COALESCE(`strings`, JSON_ARRAY()), \"$[#]\", JSON(?)) WHERE `id` IN (?, ?)")).
+					WithArgs(`"x"`, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			wantAffected: 2,
+		},
 		{
 			name: "with predicate",
 			spec: &UpdateSpec{
@@ -1293,6 +1515,42 @@ func TestQueryNodes(t *testing.T) {
 	n, err := CountNodes(context.Background(), sql.OpenDB("", db), spec)
 	require.NoError(t, err)
 	require.Equal(t, 3, n)
+
+	// Lock the selected rows. Count queries are not locked.
+	mock.ExpectQuery(escape("SELECT `users`.`id`, `users`.`age`, `users`.`name`, `users`.`fk1`, `users`.`fk2` FROM `users` WHERE `age` < ? ORDER BY `id` LIMIT ? OFFSET ? FOR UPDATE")).
+		WithArgs(40, 3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name", "fk1", "fk2"}).
+			AddRow(1, 10, nil, nil, nil))
+	mock.ExpectQuery(escape("SELECT COUNT(DISTINCT `users`.`id`) FROM `users` WHERE `age` < ? ORDER BY `id` LIMIT ? OFFSET ?")).
+		WithArgs(40, 3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).
+			AddRow(1))
+	users = nil
+	spec.ForUpdate = true
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, []*user{{id: 1, age: 10}}, users)
+	n, err = CountNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	// Omitted columns are selected as NULL, in their position.
+	mock.ExpectQuery(escape("SELECT `users`.`id`, NULL AS `age`, `users`.`name`, `users`.`fk1`, `users`.`fk2` FROM `users` WHERE `age` < ? ORDER BY `id` LIMIT ? OFFSET ? FOR UPDATE")).
+		WithArgs(40, 3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name", "fk1", "fk2"}).
+			AddRow(1, nil, "a8m", nil, nil))
+	users = nil
+	spec.Omit = []string{"age"}
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, []*user{{id: 1, name: "a8m"}}, users)
+	spec.Omit = []string{"id"}
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.EqualError(t, err, `sqlgraph: invalid column "id" for omit`)
+	spec.Omit = []string{"unknown"}
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), spec)
+	require.EqualError(t, err, `sqlgraph: invalid column "unknown" for omit`)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestQueryEdges(t *testing.T) {
@@ -309,6 +309,19 @@ type (
 	//	}
 	//
 	Hook func(Mutator) Mutator
+
+	// Encrypter is the interface that is used by the generated code for encrypting
+	// the values of fields that are encrypted at rest (e.g. JSON fields annotated with
+	// entsql.Encrypt), before they are stored in the database, and for decrypting them
+	// when they are loaded. The key identifier is the one that was configured in the
+	// schema, and it is up to the implementation (e.g. a KMS client) to resolve it.
+	Encrypter interface {
+		// Encrypt encrypts the given plaintext using the key with the given identifier.
+		Encrypt(keyID string, plaintext []byte) ([]byte, error)
+		// Decrypt decrypts the given ciphertext, that was encrypted by Encrypt
+		// using the key with the given identifier.
+		Decrypt(keyID string, ciphertext []byte) ([]byte, error)
+	}
 )
 
 // Mutate calls f(ctx, m).
@@ -115,6 +115,21 @@ func Storage(typ string) Option {
 	}
 }
 
+// FeatureNames enables the optional codegen features with the given names.
+// See gen.AllFeatures for the list of supported features.
+func FeatureNames(names ...string) Option {
+	return func(cfg *gen.Config) error {
+		for _, name := range names {
+			feature, err := gen.NewFeature(name)
+			if err != nil {
+				return err
+			}
+			cfg.Features = append(cfg.Features, feature)
+		}
+		return nil
+	}
+}
+
 // TemplateFiles parses the named files and associates the resulting templates
 // with codegen templates.
 func TemplateFiles(filenames ...string) Option {
@@ -0,0 +1,64 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package gen
+
+import "fmt"
+
+// Feature defines an optional part of the generated code, that is generated
+// only if it was enabled in the codegen config (e.g. using entc.FeatureNames).
+type Feature struct {
+	Name        string // feature name.
+	Description string // feature description.
+}
+
+var (
+	// FeatureJSONEqual adds a <Field>Equal method to the entities for each of their JSON
+	// fields, that reports if the value of the field is equal to the given value.
+	FeatureJSONEqual = Feature{
+		Name:        "json/equal",
+		Description: "Adds <Field>Equal methods to the entities for comparing the values of their JSON fields",
+	}
+
+	// FeatureJSONCopy adds a Get<Field> method to the entities for each of their JSON
+	// fields that hold a slice or a map of basic types, that returns a copy of the value.
+	FeatureJSONCopy = Feature{
+		Name:        "json/copy",
+		Description: "Adds Get<Field> methods to the entities for getting copies of their JSON slices and maps",
+	}
+
+	// FeatureJSONImport adds a CreateFromJSON method to the entity clients, that creates
+	// entities in bulk from newline-delimited JSON objects.
+	FeatureJSONImport = Feature{
+		Name:        "json/import",
+		Description: "Adds CreateFromJSON methods to the clients for creating entities from newline-delimited JSON",
+	}
+
+	// AllFeatures holds the list of all features that are supported by the codegen.
+	AllFeatures = []Feature{
+		FeatureJSONEqual,
+		FeatureJSONCopy,
+		FeatureJSONImport,
+	}
+)
+
+// NewFeature returns the feature with the given name.
+func NewFeature(name string) (Feature, error) {
+	for _, f := range AllFeatures {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return Feature{}, fmt.Errorf("entc/gen: invalid feature name %q", name)
+}
+
+// FeatureEnabled reports if the feature with the given name was enabled in the config.
+func (c Config) FeatureEnabled(name string) bool {
+	for _, f := range c.Features {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -46,6 +46,9 @@ type (
 		// Note that, additional templates are executed on the Graph object and
 		// the execution output is stored in a file derived by the template name.
 		Template *template.Template
+		// Features defines the optional features to generate. See AllFeatures for
+		// the list of supported features. By default, no feature is generated.
+		Features []Feature
 	}
 	// Graph holds the nodes/entities of the loaded graph schema. Note that, it doesn't
 	// hold the edges of the graph. Instead, each Type holds the edges for other Types.
@@ -380,6 +383,8 @@ func (g *Graph) Tables() (all []*schema.Table) {
 		table := tables[n.Table()]
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
+			ti := table.Indexes[len(table.Indexes)-1]
+			ti.Type, ti.JSONPath, ti.JSONColumn, ti.TSColumn = idx.Type, idx.JSONPath, idx.JSONColumn, idx.TSColumn
 		}
 	}
 	return
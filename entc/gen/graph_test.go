@@ -6,8 +6,10 @@ package gen
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -283,3 +285,37 @@ func TestGraph_Gen(t *testing.T) {
 	_, err = os.Stat(target + "/external.go")
 	require.NoError(err)
 }
+
+func TestGraph_Features(t *testing.T) {
+	require := require.New(t)
+	f, err := NewFeature("json/equal")
+	require.NoError(err)
+	require.Equal(FeatureJSONEqual, f)
+	_, err = NewFeature("unknown")
+	require.EqualError(err, `entc/gen: invalid feature name "unknown"`)
+
+	target := filepath.Join(os.TempDir(), "ent")
+	require.NoError(os.MkdirAll(target, os.ModePerm), "creating tmpdir")
+	defer os.RemoveAll(target)
+	schema := &load.Schema{
+		Name: "T1",
+		Fields: []*load.Field{
+			{Name: "ints", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", Nillable: true}, Optional: true},
+		},
+	}
+	for _, features := range [][]Feature{nil, {FeatureJSONEqual, FeatureJSONCopy, FeatureJSONImport}} {
+		cfg := &Config{Package: "entc/gen", Target: target, Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}, Features: features}
+		require.Equal(len(features) > 0, cfg.FeatureEnabled(FeatureJSONEqual.Name))
+		require.Equal(len(features) > 0, cfg.FeatureEnabled(FeatureJSONCopy.Name))
+		graph, err := NewGraph(cfg, schema)
+		require.NoError(err)
+		require.NoError(graph.Gen())
+		buf, err := ioutil.ReadFile(filepath.Join(target, "t1.go"))
+		require.NoError(err)
+		require.Equal(len(features) > 0, strings.Contains(string(buf), "func (t *T1) IntsEqual(v []int) bool"))
+		require.Equal(len(features) > 0, strings.Contains(string(buf), "func (t *T1) GetInts() []int"))
+		buf, err = ioutil.ReadFile(filepath.Join(target, "client.go"))
+		require.NoError(err)
+		require.Equal(len(features) > 0, strings.Contains(string(buf), "func (c *T1Client) CreateFromJSON(ctx context.Context, r io.Reader, opts ...ImportOption) ([]*T1, error)"))
+	}
+}
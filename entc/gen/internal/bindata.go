@@ -146,7 +146,7 @@ func templateBaseTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x6f\x6f\xdb\x3c\x0e\x7f\x6d\x7f\x0a\xce\x48\x0f\x76\xd1\x3a\xdd\xde\x5d\x87\x1c\xb0\xb5\xdd\x5d\x81\xbb\xdd\x83\xa7\xed\x30\x60\x1b\x06\xd5\xa6\x13\x21\xb6\xe4\x49\x72\xd6\x22\xf0\x77\x7f\x40\xca\x76\xed\x24\x4f\xf7\xe7\x55\x64\x89\xfc\x89\xfc\x91\x14\x99\xed\x76\x7e\x1c\x5e\xe8\xfa\xd1\xc8\xe5\xca\xc1\xab\xb3\x97\xff\x3c\xad\x0d\x5a\x54\x0e\xde\x89\x0c\xef\xb5\x5e\xc3\xb5\xca\x52\x78\x53\x96\xc0\x42\x16\xe8\xdc\x6c\x30\x4f\xc3\xdb\x95\xb4\x60\x75\x63\x32\x84\x4c\xe7\x08\xd2\x42\x29\x33\x54\x16\x73\x68\x54\x8e\x06\xdc\x0a\xe1\x4d\x2d\xb2\x15\xc2\xab\xf4\xac\x3f\x85\x42\x37\x2a\x0f\xa5\xe2\xf3\xff\x5e\x5f\x5c\xbd\xbf\xb9\x82\x42\x96\x08\xdd\x9e\xd1\xda\x41\x2e\x0d\x66\x4e\x9b\x47\xd0\x05\xb8\xd1\x65\xce\x20\xa6\xe1\xf1\xbc\x6d\xc3\x70\xbb\x85\x1c\x0b\xa9\x10\xa2\xcc\xa0\x70\x18\x41\xdb\xd2\xee\xac\x5e\x2f\xe1\x7c\x01\xf7\xc2\x22\xcc\xd2\x0b\xad\x0a\xb9\x4c\xff\x10\xd9\x5a\x2c\x11\x3a\x55\x87\x55\x5d\x0a\x87\x10\xad\x50\xe4\x68\x22\x98\xed\x1f\xc9\xaa\xd6\xc6\xf5\x47\xfe\x0b\xe2\x30\xd8\x6e\x4f\xc1\x08\xb5\x44\x98\xd5\xc2\xad\xe8\xb2\x59\x7a\x23\xef\x4b\xa9\x96\xd7\x2c\x65\x49\x23\x08\x22\x36\x87\x44\xda\x36\xf2\x7a\xa8\x72\x3a\x4b\xf8\xaa\xd9\x7d\x23\x4b\xa2\x8b\x11\x2e\xd8\x8d\xf7\xa2\xc2\xde\x13\x83\x19\xca\x8d\x3f\x1f\xd6\x83\x52\x27\x54\x35\x4e\x38\xa9\x15\x09\xd5\x46\x2a\x37\xd2\x8b\xd2\xfe\x94\xd9\x09\xe7\x73\x18\x5f\xdb\xb6\x14\x3a\xe2\xbd\xdf\x29\xb4\x01\xa6\x53\xaa\x25\x08\x16\x4e\x3b\x8b\x00\x95\x93\xee\x31\x0d\xdd\x63\x8d\xbb\x30\xd6\x99\x26\x73\xb0\x0d\x83\x8c\xf9\x0e\x83\xc1\xac\xe3\xed\x16\x60\x96\xfe\xaf\xfb\xee\xfd\x0b\x56\x5a\xaf\x2d\x7c\xfa\xf2\x1f\xad\xd7\xa1\xa7\xfe\xbb\x74\x2b\xc0\x07\x47\x24\xcd\x20\x7a\xeb\xf1\xa3\x89\xcb\xc1\x24\x44\x16\x9d\x23\x89\xb4\x63\xa3\xa3\x97\x1c\xbd\x11\x1b\xf4\xbe\xa0\xf7\x71\xe2\x4c\x97\x6f\xb9\x70\x82\x12\x25\x0d\x8b\x46\x65\x10\x4f\x58\x6f\x5b\x36\x7e\x74\x7b\xc2\xa8\x71\xe6\x1e\x20\xd3\xca\xe1\x83\xa3\xfc\xa2\xdf\x04\xe2\xe3\xf1\x05\x27\x80\xc6\x68\x93\x10\x25\xb2\xa0\x0f\x8a\xcf\x0e\x7c\x5a\x1b\x64\xc0\xe4\x35\x4b\xbc\x58\x80\x92\x25\xa9\x04\x06\x5d\x63\x14\x7d\x32\x52\x18\xb4\x61\xb0\x11\x86\xd2\x2f\x20\x51\x46\x0f\x83\x40\x51\xfd\x4d\x6e\x0e\x83\x84\xaf\x2c\x51\xed\xba\x93\x32\xe7\x09\x2c\x16\x70\xc6\xb7\x90\x36\xe3\xc3\xbe\x6d\x8c\x79\xe3\xb4\xf1\x65\xd3\x3b\x9e\x84\x41\x0b\x58\x5a\x64\x00\x32\xa9\x6a\x1c\x70\x74\x35\xc1\xf0\x0a\xdf\x35\x2a\x8b\x89\xd2\x43\x5c\x9d\x40\x05\x7d\x3a\x24\x10\x7f\x10\x65\x83\x63\xbe\x82\x21\x79\x4e\x40\xaf\x89\xb7\x2a\xed\xd8\xdd\xc9\xa2\x84\x84\x65\x01\x2f\xf4\xda\x2b\x4e\x78\x2b\x2a\x97\x5e\x11\x6a\x11\x47\x8d\xc2\x87\x1a\x33\x87\x39\x0c\x99\xc9\x89\x7c\x74\x1b\x9d\x40\xc5\x40\x54\xb2\xc1\xa4\xa4\xda\x16\x16\x83\x3c\x9d\xfe\x1e\x61\x4f\x0e\xa5\xb9\x56\x08\x0b\x70\xa6\xc1\x70\x64\x6e\x0f\x1b\x06\x01\x3b\x45\x75\x28\xc9\xf3\x67\xa2\x78\x0a\x2f\x5f\x83\x84\x7f\x2d\xe0\xec\x35\xc8\xd3\xd3\x81\xba\x03\xb6\xb1\xca\x27\xf9\x25\xae\x1a\x47\xf8\xe4\xaa\x2c\xe0\xeb\x49\x9f\x99\x55\xe3\x3c\xb9\x6c\xf3\x09\xec\xd0\xb0\x9f\xa0\xfb\x19\x4a\xa0\x6d\xb8\xef\xd2\x53\x39\x7e\x84\x4c\x94\xa5\xf5\xa5\x29\x54\x0e\xb5\x50\x32\xb3\x20\x0b\xbf\xe5\x55\x2d\x08\xe5\xb3\xe1\x97\xaa\xf2\xe3\xe1\xb2\x9c\xd4\x06\x59\xbe\x39\xf9\xbb\x6a\x1c\x45\xac\x2b\xd9\x91\xbf\x6c\x6a\x8c\xc6\x24\x63\x2f\x37\xe4\xdd\x4f\x1a\x39\x14\xbb\x77\x8e\x50\xa9\x23\xcc\x0a\x89\x65\x6e\x7d\x0f\x78\xe7\xd7\x6d\xbb\xdd\x12\x2b\xb3\xf4\xfa\x32\xbd\xb3\x68\x2e\xb9\xd5\xe5\xfe\xa0\xd7\x58\x80\xa8\x6b\x7e\x2b\xbb\x0d\x12\xf7\x22\xdd\x3b\x38\x6e\x55\x05\xdf\x50\xf4\x17\x84\x01\x1f\xca\x02\xb4\x81\x59\x91\x5e\x62\x21\x9a\xd2\x41\x4c\x71\x89\x95\x76\xb4\xf9\xff\x9a\xe2\x2f\xca\x04\x62\x45\x10\x9e\x47\xb6\x8a\x56\x49\xe2\x81\xba\x54\xf2\xb5\xba\x93\x39\x5c\x16\xc5\x50\xb8\xff\x46\x07\x6d\x4b\x0f\xde\x53\xcd\x76\x76\x8c\x8c\xf0\xa8\x41\xb0\xe9\x01\x47\xfd\xbb\x03\xec\x44\xbb\xc0\x7a\xba\xbc\x27\xd7\xf6\x56\x56\xe8\x57\x77\x77\xcc\x48\x9c\x8c\x38\x09\xf6\xab\x3c\xbd\x41\xe7\x61\x6f\xb8\x91\x71\x18\x48\x6f\x93\x0c\x16\xf2\x9b\xd7\xeb\x77\xe1\xff\xc7\x07\x51\xca\x9c\x51\xf8\xa1\xd9\x92\x39\xe7\x10\x79\xac\xce\xb6\x88\x13\xee\xdc\x47\xdd\xa6\xef\xf1\x7b\x1c\xf5\x73\x4a\xdb\x9e\x43\x25\xad\xa5\x76\x6b\xf0\x5b\x23\x0d\xe6\xc0\x31\x82\xcf\x53\x94\xcf\x51\x94\xb4\x4f\xc6\x0c\xbe\xf4\x81\x1c\x76\xe8\x83\x1b\xa9\x67\xa3\xb3\x50\x1b\xeb\x19\xb9\x52\x4d\xf5\x14\xb5\xcd\xaf\x46\x6d\x12\xb4\xd9\xbd\xb0\x32\xf3\x79\x95\xbe\xa5\xf5\x2d\x3d\xa9\xd1\x26\xea\x89\x9a\xb6\xbe\xfd\x28\x0e\xd6\x11\x3c\x17\x0c\x23\x1e\x7c\x70\x7e\x8f\xf5\x71\x13\x18\xb3\xbe\x19\x6e\x2e\x84\x2c\x89\x75\x5a\x1e\x66\xfe\x1c\x8e\xbe\x7b\xbc\x2e\x04\x07\x99\xdf\x5d\x77\x75\x87\xbe\xb2\xaf\xf2\x25\x4e\xeb\x8e\x6b\x0c\x87\x1a\xeb\x28\xeb\x8b\x01\xd3\x3b\x25\xbf\x35\x38\x62\xf2\xd9\x12\xc3\x9d\xd4\xbd\xbe\x9c\x14\xd9\x6e\x06\x8f\x06\x84\x1f\x23\xd9\x38\x19\x0d\x0d\x3b\x09\xf8\x33\x51\xc1\xdf\xae\x05\xcc\x97\xd8\x05\x04\xf7\x4a\xe1\xb9\x08\x3c\xb5\xa7\x5f\x1c\x2e\x67\xae\xaa\xcb\x61\x98\x2e\x20\xca\xa5\x28\x31\x73\xf3\x23\x3b\xef\xff\x69\x8c\xfb\x3c\x2b\x3d\x0c\x23\xa9\x57\xdf\x9d\x47\x7d\x2f\x28\xd7\x63\xdc\x23\xeb\x27\xfe\xb7\x4d\xb9\x8e\x20\xae\x85\xcd\x44\xd9\xf5\xaa\x64\xef\x2f\xc0\xf4\x1f\x40\xb9\x9e\xce\xf3\xfc\xfd\x83\x61\x9e\xa5\x74\x71\x60\xa8\x97\x68\x27\x63\xbd\x47\xdb\x9f\xe9\x3b\x60\x9a\xda\xfd\x54\x3f\xa2\x8e\x9d\x9c\x1f\xc3\x75\xc1\x36\xd8\x8e\x9f\xdc\xb0\xc9\xb6\xa9\xfd\xff\x22\x06\xf7\x46\xd1\x7f\x83\x79\xe7\xe6\x0f\x39\xff\x7a\xcf\x2c\x4d\x88\xf7\x8f\xfe\x4a\xd8\xdb\x29\xf9\x6d\x1b\x02\x00\x3c\x1f\xf3\x72\x0d\xd1\x9f\x98\x49\xdc\xf0\xc6\xa8\x73\x73\x52\x1d\x8e\x68\xf0\x14\xd2\x43\xab\xbf\x02\x00\x00\xff\xff\x21\xd2\x5d\x25\x58\x0f\x00\x00")
+var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdb\x6e\xe3\x38\xd2\xbe\x96\x9e\xa2\x46\x70\x1a\x52\xe0\xd0\x99\xb9\xfb\xd3\xc8\x0f\x74\xa7\x93\xd9\x2c\x76\x32\x8b\x49\x32\x18\xa0\xbb\x31\xa0\xa5\x92\xcd\xb6\x44\x2a\x24\xe5\x24\x30\xf4\xee\x8b\xa2\x28\x59\x52\xbc\xe9\x03\xb0\x37\x89\x49\xd6\xf1\xab\x03\x4b\xdc\xed\x16\xc7\xe1\x85\xaa\x9e\xb5\x58\xad\x2d\xfc\x72\xfa\xf3\xff\x9d\x54\x1a\x0d\x4a\x0b\x57\x3c\xc5\xa5\x52\x1b\xb8\x96\x29\x83\x77\x45\x01\x8e\xc8\x00\x9d\xeb\x2d\x66\x2c\xbc\x5b\x0b\x03\x46\xd5\x3a\x45\x48\x55\x86\x20\x0c\x14\x22\x45\x69\x30\x83\x5a\x66\xa8\xc1\xae\x11\xde\x55\x3c\x5d\x23\xfc\xc2\x4e\xbb\x53\xc8\x55\x2d\xb3\x50\x48\x77\xfe\xaf\xeb\x8b\xcb\x9b\xdb\x4b\xc8\x45\x81\xe0\xf7\xb4\x52\x16\x32\xa1\x31\xb5\x4a\x3f\x83\xca\xc1\x0e\x94\x59\x8d\xc8\xc2\xe3\x45\xd3\x84\xe1\x6e\x07\x19\xe6\x42\x22\x44\xa9\x46\x6e\x31\x82\xa6\xa1\xdd\x59\xb5\x59\xc1\xd9\x39\x2c\xb9\x41\x98\xb1\x0b\x25\x73\xb1\x62\xff\xe6\xe9\x86\xaf\x10\x3c\xab\xc5\xb2\x2a\xb8\x45\x88\xd6\xc8\x33\xd4\x11\xcc\x5e\x1e\x89\xb2\x52\xda\x76\x47\xed\x0a\xe2\x30\xd8\xed\x4e\x40\x73\xb9\x42\x98\x55\xdc\xae\x49\xd9\x8c\xdd\x8a\x65\x21\xe4\xea\xda\x51\x19\x12\x16\x04\x91\x33\x87\x48\x9a\x26\x6a\xf9\x50\x66\x74\x96\x38\x07\x66\xcb\x5a\x14\x04\x97\x93\x70\xe1\xdc\xb8\xe1\x25\x76\x9e\x68\x4c\x51\x6c\xdb\xf3\xfe\x77\xcf\xe4\x89\xca\xda\x72\x2b\x94\x24\xa2\x4a\x0b\x69\x07\x7c\x11\xeb\x4e\x1d\x3a\xe1\x62\x01\x43\xb5\x4d\x43\xa1\xa3\x58\x74\x3b\xb9\xd2\xe0\xe0\x14\x72\x05\xdc\x11\x33\x6f\x11\xa0\xb4\xc2\x3e\xb3\xd0\x3e\x57\x38\x15\x63\xac\xae\x53\x0b\xbb\x30\x48\x1d\xde\x61\xd0\x9b\x75\xbc\xdb\x01\xcc\xd8\x6f\x7e\xdd\xf9\x17\xac\x95\xda\x18\xf8\xf8\xf9\x1f\x4a\x6d\xc2\x16\xfa\x47\x61\xd7\x80\x4f\x96\x40\x9a\x41\xf4\xbe\x95\x1f\x0d\x35\x85\xc1\x28\x44\x06\xad\x25\x0a\xe6\x21\xf3\xf0\x92\xa3\xb7\x7c\x8b\xad\x2f\xd8\xfa\x38\x72\xc6\xe7\x5b\xc6\x2d\xa7\x44\x61\x61\x5e\xcb\x14\xe2\x11\xea\x4d\x03\xc7\x63\x3f\x13\x27\x35\x4e\xed\x13\xa4\x4a\x5a\x7c\xb2\x94\x5f\xf4\x3f\x81\xf8\x78\xa8\x60\x0e\xa8\xb5\xd2\x09\x41\x22\x72\x5a\x50\x7c\x26\xe2\x59\xa5\xd1\x09\x4c\xde\x3a\x8a\x9f\xce\x41\x8a\x82\x58\x02\x8d\xb6\xd6\x92\x96\x4e\x52\x18\x34\x61\xb0\xe5\x9a\xd2\x2f\x20\x52\x27\x3d\x0c\x02\x49\xf5\x37\xd2\x1c\x06\x89\x53\x59\xa0\x9c\xba\xc3\x1c\xe6\x09\x9c\x9f\xc3\xa9\xd3\x42\xdc\x4e\x3e\xbc\xb4\x8d\xd6\xec\xd6\x2a\xdd\x96\x4d\xe7\x78\x12\x06\x0d\x60\x61\xd0\x09\x20\x93\xca\xda\x82\x8b\xae\xd2\x70\xde\xfe\xc2\xab\x5a\xa6\x31\x41\x7a\x08\xab\x39\x94\xd0\xa5\x43\x02\xf1\x9f\xbc\xa8\x71\x88\x57\xd0\x27\xcf\x1c\xd4\x86\x70\x2b\x99\x47\x77\x92\x45\x09\x11\x8b\x1c\x7e\x52\x9b\x96\x71\x84\x5b\x5e\x5a\x76\x49\x38\xe5\x71\x54\x4b\x7c\xaa\x30\xb5\x98\x41\x9f\x99\x2e\x91\x8f\xee\xa2\x39\x94\x4e\x10\x95\x6c\x30\x2a\xa9\xa6\x81\xf3\x9e\x3e\x0c\x7e\x14\xb0\xbd\x43\x2c\x53\x12\xe1\x1c\xac\xae\x31\x1c\x98\xdb\x89\x0d\x83\xa0\x21\x5b\xa8\x0e\x05\x79\xfe\x4a\x14\x4f\xe0\xe7\xb7\x20\xe0\xff\xcf\xe1\xf4\x2d\x88\x93\x93\x1e\xba\x03\xb6\x39\x96\x8f\xe2\x73\x5c\xd6\x96\xe4\x93\xab\x22\x87\xbf\xe7\x5d\x66\x96\xb5\x6d\x4b\xd4\xd9\x3c\x87\x09\x0c\x2f\x13\x74\x84\xb4\xb7\xdc\x65\xe9\x0b\x97\xf6\xe5\xf8\x17\xa4\xbc\x28\x8c\x2b\x22\xe0\x32\x83\x8a\x4b\x91\x1a\x10\x79\xbb\xd5\xb2\x1a\xe0\x92\x18\x95\xfe\xae\xaa\xfc\xeb\x70\x59\x8e\x6a\x83\x20\xda\xf6\x3e\x4f\x41\x1a\x44\x4c\xe4\x53\x7f\x9d\xa9\x31\x6a\x9d\x0c\xbd\xdc\x52\xe7\xfa\x46\x23\xfb\x62\x27\xd1\x4a\x93\x2d\x74\x23\xcc\x72\x81\x45\x66\x28\xd8\x33\x76\xd5\xfe\x6e\x9a\xdd\x8e\x50\x99\xb1\xeb\x0f\xec\xde\xa0\xfe\xe0\xae\x3a\xea\x6d\xbb\x5d\xcf\x71\x0e\xbc\xaa\xa8\xe3\x75\x1b\x44\xde\x92\xf8\x3e\x38\xbc\xaa\x72\xa7\xc1\x53\xd2\x99\x3b\x14\x39\x28\x0d\xb3\x9c\x7d\xc0\x9c\xd7\x85\x85\x98\xe2\x12\x4b\x65\x69\xf3\xf7\x8a\x92\x96\x17\x09\xc4\x12\x69\xc3\xe1\x48\x6a\x5c\x23\x4d\x12\xd7\x6f\xba\x54\x6a\x6b\x75\x92\x39\x8c\xd6\x79\xdf\xfe\x7f\x45\x0b\x4d\x13\x27\x6f\x07\x35\xeb\xed\x18\x18\xd1\x4a\x9d\x58\x78\x6d\xfe\x79\xfb\xfb\xcd\x3b\xad\xf9\xf3\x7e\xf9\xfe\xd9\xa2\x77\xa7\x63\x58\x1c\x03\xcd\x36\x6d\x7f\xf7\x02\x0d\x4d\x20\x73\xb0\x0a\xf8\x56\x89\x0c\xcc\x9a\x6b\xba\xe2\x84\x35\x80\x05\x96\x28\xad\x81\x25\xda\x47\x44\xd9\x5e\x74\x02\x0d\x03\x37\x6a\x90\xdc\x20\xd8\x92\x6f\x2d\xde\xae\xad\x0e\x26\x0a\xef\xa2\x37\xde\xa7\xda\xc7\xb3\xd3\xb3\xd3\xcf\x73\xf8\x16\x5a\xc6\x58\xb2\x77\xd8\x35\xd7\xb1\xde\x6f\x11\xb2\xdb\x0d\xa1\xba\x13\x14\x28\xfa\x75\x7f\xef\x92\x22\x4e\x06\x69\xd1\xab\x1a\xad\xc7\x71\xbb\x45\xdb\xaa\xb9\x75\x77\xbb\xcb\x4c\x92\xb3\x4d\xc2\x83\x96\xfa\x8a\x78\xf3\x27\x2f\x44\xe6\x62\xed\x7a\xef\x8e\xf0\x38\x03\x37\x0a\xf9\xfc\x69\x9a\xc8\xd5\xe0\x19\xfd\x51\xda\xb0\x1b\x7c\x8c\xa3\x6e\x74\x6b\x9a\x33\x28\x85\x31\x14\x1e\x8d\x0f\xb5\xd0\x98\x81\x4b\x5b\xf8\x34\x96\xf2\x29\x8a\x92\x26\x7c\xe9\x4b\x13\x4e\x76\x68\xe1\x66\x8b\x16\x1d\x6f\xa1\xd2\x86\x56\xd7\xe6\x52\xd6\xa5\x67\x15\x39\x6c\xbf\x37\x91\xd5\xa6\x85\x9e\x0a\xa7\xcf\x4b\x22\xbd\x7b\xae\x90\xdd\x88\xa2\xe0\xcb\x82\xec\x85\x37\x6f\x60\xeb\x7b\x4a\x1f\x8c\x41\x0d\xcc\x96\xdc\x88\x94\x54\xcf\x72\xf6\x9e\x7e\x93\x04\x88\xb6\x91\xb7\x6e\x32\x49\xbc\xcc\x88\xde\x33\x0a\x14\x6d\xb5\x12\x0f\xf6\xef\x1f\x8b\xd8\xf0\x4e\x1d\x46\x6c\xdb\x6b\xce\xb9\x28\x28\x62\x4a\xff\xb7\xa8\x9d\xc1\xd1\x63\x2b\xcf\x87\xef\x60\xd4\xa6\xbf\x7d\x1b\x43\x87\x0f\xbb\xcc\x56\x5d\xdd\xfb\x26\xe1\x5a\x16\xf6\x2d\xcb\x43\xe6\x0f\x67\xc8\xee\xa5\x78\xa8\xfb\x74\xfd\x5a\xc7\xc2\x49\xda\x5f\x7f\x18\xf5\xac\x69\xf6\x0f\xe6\xad\xaf\x4b\x32\x71\x32\x98\xc1\xc6\xa9\xfa\x4d\x51\xc1\x1f\xae\x23\xcc\x56\x08\x9f\xc6\x42\xfa\x32\x7a\x2d\x02\xde\x2a\x29\x0a\x3f\xab\x13\xa8\xec\x0a\xb9\xad\x35\x5e\x4a\xca\xf0\x0c\xa2\x2f\x46\xc9\x45\xf7\xd9\xd4\x34\x74\xef\x1b\xb4\xd4\xa7\xc1\xa0\x6d\xc7\x70\x7f\x03\xa9\x7c\xfc\xe1\xa1\x55\x09\x1c\x1c\xa9\x5a\x7e\xc1\xd4\x82\x5d\x73\x0b\x25\xaf\x8c\xcf\x23\xc9\x4b\x9a\xe4\x15\xf1\x09\x4d\xb2\x1d\xf5\x96\xc6\x47\xc3\xe0\xa6\x2e\x0a\xbf\x00\xae\x11\xc4\x4a\x2a\x8d\xd9\xdc\x0d\x1a\xb5\xdc\x48\xf5\x28\x3b\xe5\x94\xa3\xfe\x7e\x48\x55\x46\x08\xb9\xf6\xf0\xdd\x73\x87\x77\x2e\x56\xcb\x2f\x64\xe9\x47\x63\xe9\x56\xf9\x4c\x30\xb0\x3f\xf8\xe3\x6f\x68\x0c\x5f\xe1\xe0\xc2\xa7\xb9\x8e\xfc\x98\x83\xe6\x8f\x94\x7b\x6d\x56\x13\x3f\xe5\x95\xc8\xa1\x15\x11\x6b\xfe\xe8\x72\x24\x92\x75\x51\x44\xc4\x1a\xd0\xa7\x95\x15\xd2\x0d\x8e\x14\x2a\xf3\x28\x6c\xba\x76\xe2\xdc\xf9\xff\x7c\x98\x78\x65\x9a\x68\x35\xf8\xa9\xe1\xda\xfc\x8a\x12\x35\xa7\x61\x9b\x72\x27\x08\x52\xfa\x16\x3f\xdc\xb2\x2e\x94\x34\x96\x4b\x6a\xa6\x67\x44\xea\xbe\x72\xb6\xae\xbf\xb5\x1d\xb4\xab\x0b\x52\x3e\xab\x65\xc9\xb5\x59\xf3\x82\xb0\x73\xe9\xc6\xee\xbb\xad\xbe\x4b\x76\x35\xdf\xf7\x62\xbf\x3f\x15\x71\xe8\x7b\xb9\x3f\x25\xc6\x68\x8f\x5d\xbe\xd7\xe3\x62\xbf\xdb\x1d\x94\xd4\xfb\x17\xb1\x68\xc2\xe4\xab\x8d\xf0\xa4\x0b\x7e\x68\xe0\x8d\x92\x57\x42\x0a\x8b\x07\x04\x47\xe6\xa1\xd8\x8b\xe9\x29\xa3\x49\x68\xc6\x95\xfb\xe2\xaa\xd8\x4b\x6c\x1a\xca\xad\x39\xbc\xd9\xbe\x76\x27\x0c\xdb\x7c\x5f\x23\x2e\xda\x70\xf4\xe0\xfb\x37\x25\x5e\xdb\xc5\xf7\x4d\x3c\x98\x94\xcc\xeb\x53\xc4\xde\xe4\xa1\x2b\x7e\x66\x3b\x0b\x0f\x9b\x33\x2a\x65\x38\x7a\xf0\x96\xf8\xaf\x9a\x69\xab\xda\x0b\xfe\x9e\x07\x86\x99\x2d\xab\xa2\x7f\x50\xc9\x21\xca\x04\x2f\x30\xb5\x8b\x23\xb3\xe8\x5e\x9b\x86\xdf\x7a\xd4\x2b\xe1\xa9\x7f\x96\x68\xd9\xa7\x6f\x12\x84\xc3\xb2\x2e\x36\x43\xb9\x47\xa6\x7d\xf5\x79\x5f\x17\x9b\x08\xe2\x8a\x9b\x94\x17\xfe\x7b\x25\xf1\xfc\x7b\x3c\xc7\xaf\x40\xc5\xa6\x7b\xea\xe8\x25\x7f\xf5\x41\xc7\x51\xa9\xfc\xc0\xc3\x0e\xcd\xbb\xc3\xa7\x9d\x62\x73\xf8\x5d\xc7\x0b\xa6\x97\x1b\x6a\x87\x23\xe8\x1c\xc8\x8b\x63\xb8\x6e\x7b\xbb\xf1\xf8\x64\xda\x99\x6c\xea\x8a\x2e\x06\x03\x4e\x78\x6b\x14\xbd\x0f\x2d\xbc\x9b\x5f\xc5\xfc\x6f\x62\x9c\x00\xdf\x96\xe7\x9a\x9b\xbb\x31\xf8\x4d\x13\x02\x00\xbc\x1e\xf3\x62\x03\xd1\x1f\x98\x0a\xdc\xba\x8d\x1e\x5c\xdf\xec\x0e\x47\x34\xd8\x87\xf4\xd0\xaf\xff\x0c\x00\x33\x3b\x79\xce\x5c\x15\x00\x00")
 
 func templateBuilderCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -161,7 +161,7 @@ func templateBuilderCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 3928, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 5468, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -186,7 +186,7 @@ func templateBuilderDeleteTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\x5b\x73\xdc\x36\x96\x7e\x26\x7f\xc5\x09\xcb\x99\x6d\x6a\x3b\xec\x64\xde\xd6\x59\x3d\x78\xac\x24\xa3\xaa\x5d\x6b\x6b\xac\xec\x8b\xcb\x35\x81\x08\xb4\x84\x35\x2f\x1d\x02\xdd\x92\xaa\xd3\xff\x7d\xeb\x1c\x00\x24\xc0\x4b\xdf\x2c\x7b\xb3\x79\x88\x25\x12\x04\xce\xe5\x3b\x77\x68\xbb\x5d\x5c\xc4\x6f\xeb\xd5\x73\x23\xef\x1f\x34\xfc\xf5\xfb\x1f\xfe\xed\xbb\x55\x23\x94\xa8\x34\xfc\xcc\x72\x71\x57\xd7\x9f\xe0\xba\xca\x33\x78\x53\x14\x40\x8b\x14\xe0\xfb\x66\x23\x78\x16\xdf\x3e\x48\x05\xaa\x5e\x37\xb9\x80\xbc\xe6\x02\xa4\x82\x42\xe6\xa2\x52\x82\xc3\xba\xe2\xa2\x01\xfd\x20\xe0\xcd\x8a\xe5\x0f\x02\xfe\x9a\x7d\xef\xde\xc2\xb2\x5e\x57\x3c\x96\x15\xbd\xff\x8f\xeb\xb7\x3f\xbd\x7b\xff\x13\x2c\x65\x21\xc0\x3e\x6b\xea\x5a\x03\x97\x8d\xc8\x75\xdd\x3c\x43\xbd\x04\xed\x1d\xa6\x1b\x21\xb2\xf8\x62\xb1\xdb\xc5\xf1\x76\x0b\x5c\x2c\x65\x25\x20\x29\xd7\x9a\x69\x59\x57\x09\xd8\x17\xaf\x56\x9f\xee\xe1\xf5\x25\xdc\x31\x25\xe0\x55\xf6\xb6\xae\x96\xf2\x3e\xfb\x2f\x96\x7f\x62\xf7\x02\x17\x6d\xb7\xa0\x45\xb9\x2a\x98\x16\x90\x3c\x08\xc6\x45\x93\xc0\x2b\xfa\x5c\x96\xab\xba\xd1\x30\x8b\xa3\x24\xaf\x2b\x2d\x9e\x74\x12\x47\xc9\xb2\xa4\x7f\xd4\x73\x95\x27\x71\x1c\x6d\xb7\xdf\x41\xc3\xaa\x7b\x01\xaf\x2a\x3c\xe8\x55\xf6\xae\xe6\x42\xe1\x06\x51\x94\x20\x05\xc3\x43\x17\xf8\xb8\xf2\x1e\x24\x66\x1f\x51\x71\x3a\x38\x4a\xee\xa5\x7e\x58\xdf\x65\x79\x5d\x2e\x96\x56\x0b\x0b\x51\xe9\x24\x4e\xe3\x38\xaf\x2b\x45\x54\x2d\x16\x70\xb3\x12\x0d\x31\x0c\xfa\x79\x25\x54\x16\x47\x37\xab\xb7\x8d\x40\x66\x00\xe0\x12\x44\xa5\x33\xf7\x04\xdf\x5d\x89\x42\x84\xef\xcc\x93\xee\xdd\x4d\x25\x7a\xef\x6e\x2a\x7a\xfd\xeb\x8a\xf7\xb6\x35\x4f\xba\x77\xfe\xa7\xed\x93\x98\xe8\x44\x99\xb4\x24\xee\x15\xd9\xed\xf3\x4a\x18\xf1\xbc\x63\x25\xca\x06\x2e\x21\x09\x1e\x84\xc2\x4a\x49\xcd\x13\xdb\x11\x02\x1c\x26\xe8\x5d\x95\xfd\xa7\xfd\xd5\xee\x16\x2f\x16\x10\xac\xda\xed\xa0\x11\xd6\x04\x14\xb0\x0a\xea\x4e\xc6\x0f\x4c\x03\x2d\x14\x04\xd1\xed\x16\x56\xc5\xba\x61\x85\x47\x1d\xee\x57\xd1\xf9\x16\xc7\xf7\x0d\x5b\x3d\x64\x31\x32\x3f\x38\x48\xe9\x66\x9d\x6b\xd8\xc6\x51\x4e\x18\x89\xa3\x7a\x05\x37\xab\x38\xd2\xcf\x2b\x7c\x29\xab\x7b\x64\x16\xb7\xbf\xbe\xca\xfe\xb6\x96\x05\x17\xcd\xcf\x52\x14\xc8\x3a\x5c\xb4\x6f\x50\x68\x24\x3e\x4f\xb4\x4b\xcb\x2f\x2d\xb7\xc2\xc5\x0f\x96\xe3\xfb\x2c\xbb\x4d\x68\x17\xb9\x04\x56\x71\xf7\x3c\x7b\xb7\x2e\x45\x23\x73\xfc\xfd\x6d\x5d\x6d\x44\xa3\x05\xbf\xad\xff\xc6\x94\xcc\xcd\x37\x11\xe3\xfc\x84\xed\xad\xf6\x82\x9f\xf3\x42\xb0\x46\x70\x4b\x70\xc9\x56\x1f\x8c\x08\x3e\x1a\x31\x6d\x43\xfe\x84\xe5\xef\x27\x7e\x2f\x54\x40\xf7\x2b\x91\xfd\x5a\xc9\xdf\xd7\x74\x1c\x78\xff\x21\x21\x62\x9c\x3e\x61\xd8\xf4\x65\x19\x39\x82\xc6\x3f\xbb\xab\xeb\xc2\x31\x53\xa8\x23\xcf\x42\xa6\x46\x8f\xf3\x78\x8c\xa2\x46\x94\xf5\x66\xea\xdc\xa3\xb6\x98\x12\x31\xaf\x2b\x61\x29\xaf\x0b\xfe\xdf\xac\x58\x0b\x58\xae\xab\x7c\x66\x1d\x1c\x2a\x17\xff\x4d\x61\x76\x11\x18\xdd\x1c\x44\xd3\xd4\x4d\x1a\xef\xe2\x78\xc3\x1a\xf8\x27\xd9\xb9\xb3\x25\xb8\xb4\xeb\x3d\x70\xa7\xb3\x4a\x16\x69\x68\x82\x37\x2b\x67\x88\xab\x46\x56\x1a\x66\x39\x2b\x45\x6b\x3d\x29\x24\x66\x41\x32\x62\x97\xf6\xd3\xdd\x0e\x58\x51\xd4\x8f\x0a\x74\x0d\x25\xab\xd0\x7f\xa2\x95\xb5\x07\x1b\x43\x5a\x5b\x8b\x5d\x2b\x59\xdd\x13\x87\xf8\x2b\x2b\xa0\xa6\x6d\xd4\x88\x3d\x76\x07\x90\x40\x06\xec\xc4\x64\xd9\xe2\xb1\x6f\xc3\x39\x39\x57\x85\xaf\x3a\x2a\x96\x75\xe3\xb8\xca\x62\xdc\x6f\xe4\xcb\x59\x6e\x89\x9d\x03\x59\x3d\xfe\xa3\x15\x64\x59\x36\x4a\x56\x0a\x7d\x92\xd0\x6f\x94\x28\xcc\xbf\xf4\x5e\x6c\xe3\xc8\x3a\x94\xd7\x0e\x8e\xf9\x3c\x8e\xa2\x7a\xf5\xda\x87\x68\xbd\xc2\x87\xfa\x39\x78\x3a\xf0\xbf\xb8\x26\xb0\xcc\xd7\x50\xb2\x4f\x62\x36\x62\x9f\xe9\x3c\x8e\x76\x71\x84\xcc\xff\x93\xb8\x41\xe2\x8c\xb9\x12\x6b\x5b\xa2\x41\xcf\xca\x94\xd6\x35\x42\xaf\x9b\x0a\xca\xd8\x3a\x6a\xfb\x81\x81\x46\xf2\x28\xf5\x43\xd2\xd2\x91\x5c\x5f\xf9\xa8\xc0\xa5\xe8\x3f\x85\x56\xa4\x7e\xc9\x61\x49\x06\x42\x69\x42\x07\x07\x2b\xfc\xee\x93\x99\xe4\xd0\x77\x9b\xe9\x04\x0e\xb6\x2d\x89\x84\x88\x72\xa0\x80\x94\x38\x42\x73\x98\xa1\xd9\x8a\xa6\x31\x56\x82\xbf\xd4\x55\x2e\x00\x93\x84\xec\xa6\xca\x05\x3e\xd9\x90\xb5\x85\x66\x15\x47\x51\x1a\x47\x51\x99\xb5\xd6\x78\x69\xed\x51\x3f\xc1\xb1\x36\x49\x54\xd0\x81\xd9\x55\x3d\xa3\xcf\xed\xb3\x48\x2e\xa1\xcc\xc8\xe8\xcd\xef\x44\xe3\x25\x2c\x4b\x9d\xfd\x84\xdf\x2e\x67\xc9\xef\x6b\xd1\x3c\xa3\x95\xd4\x05\x07\xa2\x51\xc1\xaa\x56\xba\x03\xb3\x54\x50\xd5\xda\xd8\x9d\xe0\x49\x4a\x3b\xed\x8c\xd7\xb3\xdb\xd2\x77\x44\x0f\x5c\x42\x99\xbd\x2d\xa4\xa8\xf4\x2c\xcd\x02\x7a\xb3\x5f\x84\x46\xc6\xe6\x20\xb9\xdd\x04\xff\xbf\x4b\x8d\xcf\x23\x49\x77\x1b\xc5\xe6\x75\x99\x4d\xc6\xbf\x4b\xf8\x8b\xe4\x88\x24\x0f\x3f\x13\xf0\x99\x46\x0e\x72\x1d\xe6\x1b\x07\x21\x84\xe1\xbd\xa7\xc7\xcf\x84\xd0\x88\xfe\x4f\xd2\xbd\x3d\x03\x09\x9b\x43\x25\x8b\xa3\x64\x87\xab\xb3\xeb\x2b\x2b\xc0\xc5\x02\x8c\xd6\xc0\x6c\xa6\x80\x91\x4b\xfb\x0d\xfd\xbc\x79\xf3\x1b\x2c\x9b\xba\x0c\x85\x03\xd7\xa1\xb4\xe0\x91\x29\xdc\x4b\x3c\x89\x7c\xad\x05\xc7\x2c\x88\x81\x6e\x58\xa5\x18\xf9\x60\x98\xe1\x86\xb7\x4f\xe9\x3c\x7c\xce\x0a\xc8\xcd\xf9\x52\x59\x12\xb0\xc0\x20\xd9\xcf\xca\x7e\xe6\x94\x82\x83\x18\x5c\x58\xb2\x31\x89\x32\x3f\xa1\x47\x34\x0f\xb7\xce\x0b\x96\x99\xf9\x69\xe7\x16\x65\xb2\x92\x7a\x96\xb6\xea\x31\x4f\xad\x20\x6e\x9f\x3a\x21\x54\x46\x02\xb7\x4f\xbf\x91\x53\x77\x34\x28\x93\x0c\x3e\x8a\x46\x04\xbc\x7a\x1c\xa9\x1f\x71\x2f\xa9\xfd\xbd\x48\x69\x50\xeb\x07\xd1\x3c\x4a\x25\xf6\xf0\x77\xfb\x34\x43\xa5\xdf\x3e\xf9\x9a\x96\x4b\x88\xd0\xb3\x7e\x42\x1e\xcb\x8c\x37\x72\x23\x9a\x6c\x76\xa1\x9f\xae\xe8\xc7\xf4\x47\xf8\xa6\xfe\x44\x98\x70\x90\x90\xc5\x3c\x30\x77\x57\x13\xed\x76\xaf\x07\x16\xde\xac\xab\x0a\x3d\x41\x5f\x67\x89\xf1\xd7\xfa\x89\x44\x7b\xfb\x34\x26\x56\xfd\xd4\x17\x29\x1a\x3a\x62\x91\xac\xd3\x24\x66\x04\xc5\x5f\x95\x68\xae\xa8\x5e\x33\x39\xc9\x62\x01\xef\x85\xbe\xbe\xea\x6c\xd2\x78\x4a\x6b\x87\xce\xb5\x67\xf0\xae\xa6\xbc\x9b\xe9\x39\x95\x82\xf4\x65\x97\x9c\x4b\x05\x2c\xcf\xc5\x0a\x15\x51\x57\xc5\x33\xd4\x55\xcf\xb0\x29\x52\x93\x45\x47\x4e\xec\x43\x73\x24\x52\x26\xa2\xc4\x91\xee\xc8\x2f\xe5\x16\x0b\xb8\xbe\x6a\x11\x60\xf9\x31\xfc\xd9\xfa\xa0\x33\xa5\x80\x3f\x5c\x48\xf8\x51\xc0\x36\x4c\x16\xec\xae\x10\x86\x2f\xb9\x44\x50\x3d\x32\x05\xab\xa6\xde\x48\x2e\x38\xe6\x42\xf8\xc5\x9d\xa1\xa8\x43\xd5\x90\xbd\xeb\x2b\x84\xd5\x08\x7b\x73\x10\x4f\x52\x69\x45\xd9\xa1\x03\xdb\x3e\x6e\x2f\x51\xb9\x1e\xd4\xfc\x90\x7e\x31\xfd\xe1\x1c\x74\xb3\x16\xd6\x65\x4f\x97\x2a\x04\x53\x4a\x1f\x44\x2e\x10\xda\x6d\x25\xf2\x9e\x72\x0e\xcc\x72\xb6\x28\x0a\xf1\x3b\x2e\x4c\xca\x84\xdc\x2d\x7d\x75\x09\x09\x49\xd8\x3d\xea\x12\x61\x78\x45\x92\xe9\x92\x8c\xf7\x42\x27\xb8\xf3\x7b\xca\x60\x1c\x8d\x66\xa9\xa9\xb3\xdb\xb5\x5e\xc1\x9e\x64\x89\x2d\x84\x94\x66\x95\x76\x28\x6e\xf7\xf7\xe3\x8b\x29\x7e\x1c\x04\x0d\x92\xf7\xe1\xcf\xdb\x64\x66\xd8\xb1\x7c\x2d\xc7\x80\x38\xac\xb6\x6c\x36\xb8\xea\x2a\xa2\xc5\x05\x52\xa3\x51\x68\x95\xad\xe2\x28\xf9\xad\x37\xa2\x69\x24\x17\xb0\x6a\xc4\x46\xd6\x6b\x05\x39\x2b\x0a\x4a\xac\xdf\x70\x9e\x01\x35\x57\xce\x2c\x06\xcb\x6c\xb2\x1c\xbc\xb4\x01\xca\x2f\x4b\x76\x71\x27\xc0\xb6\xa8\xf8\x45\x68\x53\x8c\x77\xb6\x13\x0a\x73\xdc\x8c\x0e\x0a\xb7\x77\x00\xda\x43\x13\x4a\x78\x68\x0b\xd1\xc6\x78\xdc\x51\x96\x62\xca\xb3\x36\xbe\x49\xb4\x36\x41\x71\xd8\x59\xc5\xc6\x82\x7f\x92\xdf\x1b\x23\x22\x9f\x65\x97\x9f\xf4\xd9\xb6\xde\x31\x4c\xb0\x68\xd7\xeb\x91\x37\x50\xdf\xfd\x8f\xc8\xc9\x6b\x54\xff\xa2\xa7\x1c\x87\xf1\x3b\x76\xa9\x54\xb0\x14\x3a\x7f\x10\x9c\x76\x6d\x43\x3f\x67\x9a\xdd\x31\x8c\x5d\xf8\xf8\x8d\x8b\x69\x5e\xd4\x46\xb8\x04\x39\x41\xe0\xa4\x31\xd0\xb4\xdd\xa1\x39\xd4\x4d\xbb\x23\x50\x2a\x0a\x4b\x26\x0b\x75\x9a\x1a\x8d\xdc\x26\x92\xe6\x0d\x18\x4f\x81\x22\x94\x85\x71\xa4\xbb\xdd\x45\xeb\x18\xfa\xaa\x77\x59\xbc\x51\xbc\x5c\xc2\x37\x65\x56\xaf\xb2\x6b\x35\xf3\xda\x5a\x61\xe2\xb5\x19\xc6\xd8\x31\xbd\xa2\x2f\x37\x49\x74\x1b\xa1\xba\xce\x59\x2b\x24\x45\x19\xb6\x45\xd5\x61\x0f\xfc\xc7\x1f\xe0\xa7\x8f\x03\x0c\x1e\x4b\x5c\x23\x7e\x5f\xcb\x46\x50\x9a\x72\x7d\x65\xcb\xa9\x9e\x71\xb5\x94\xb9\xf3\x8c\xb8\xc8\x34\xdc\x23\xd4\x42\x6a\x88\xc7\x77\xdf\x1c\x24\x68\x58\x80\x50\xa6\x35\x41\xe7\x6b\xf8\xf6\x31\xa1\x63\xd3\xd0\xba\xdc\xf9\xd6\x46\x43\x8f\x6e\xb3\xe2\x1d\x35\x6c\x4f\x76\x67\x23\x81\xe3\x0d\xe7\xa3\x81\xa3\x1f\x07\x18\xe7\x0a\x5a\x3f\xae\xeb\xd0\x96\xb3\x38\x7a\x81\x50\xd0\xf6\xbd\x96\xd9\xdf\x99\xfa\xa5\xf6\x3a\x58\x7e\x77\x2a\x0a\x7d\xae\x85\xd7\xa4\x9f\xf6\x15\x17\x5d\xec\x59\xf8\xaf\x97\xe0\x45\x9c\xb0\x30\xdc\x1b\x07\xfe\x12\x7c\x46\xda\x34\x02\x7c\xc3\xb9\xe0\x63\x6a\x0c\x3c\xa3\x81\x8a\x49\xc3\x99\x42\x49\x77\x0e\x6d\x24\xea\x1a\x2c\x4b\xe5\x47\x8a\x3d\xc2\x9f\xa4\xe1\xb8\x78\xe1\x02\xc6\x14\xfb\x56\xfe\x61\xd0\xe8\xa2\x46\xb4\xf3\xec\xa5\x8b\x1b\x91\x49\x4d\xda\x39\x41\xeb\xd8\x4c\xc5\xc9\x8a\x49\xbc\xbe\x2d\x04\x6b\x8e\x42\x2c\xf5\x7a\x7a\x79\xf8\x99\xa0\xb5\xa2\x98\x4e\x55\x4c\x26\x70\x5e\x8a\x71\x4c\x8e\xd1\x03\x7c\x99\x05\x8d\xac\x0f\x5d\x96\xb7\xdb\x7d\x84\x4b\x70\x7d\xac\x6d\x0b\xc6\x96\x9b\x36\x0b\x0c\x05\x68\xe4\x2a\x78\x32\x2a\x4a\x87\x56\x1b\x0f\x0d\x0c\x43\x68\x22\x72\x2d\x51\x27\x02\x34\x14\x34\x22\xcf\x48\xdb\xab\x0f\xf7\x70\xeb\xc1\xab\xfe\x34\x0a\x2c\xc7\xb7\xe7\x84\xff\x21\x94\x18\xcd\x76\x1b\x7a\xc1\x8a\x02\xf2\x07\x4c\xe9\x95\x4b\x4f\x92\x80\xdb\xe4\xc4\xfc\xf7\x50\xa6\xdb\x25\x92\x5f\x3a\x41\xf5\x5c\x6c\x68\x6a\x11\xa7\x19\xd9\xac\x27\xec\x39\xf8\xd2\x4e\x07\xe9\xae\x27\x6b\xaf\x0e\x1a\x8e\x34\x4c\xd7\x09\x1f\x27\x8c\x13\xca\xac\xc9\x7b\x23\x0e\xbb\xe6\x12\x12\x85\xd5\x0c\x3d\xf0\x4b\x1e\xc9\xd5\xcf\x81\x33\x98\xad\x98\xca\x59\x81\x5f\xa5\x30\x53\xb2\xba\x5f\x17\xac\xc1\x3d\x49\x4f\x7f\x80\x79\x9f\x42\x72\x7d\xa5\xa6\xcf\x74\xfb\x8e\x6f\xeb\x7e\x11\xae\xb5\x6f\x1a\xb8\x1e\x6d\x16\x43\x6e\x1b\x1b\xdb\x6a\x0c\x08\x5d\xb6\x2f\x5a\x4b\x11\xfc\x5e\xb8\x00\x6a\x67\x1f\xee\xd5\xdd\x33\x48\x6e\x88\xc4\xec\xd2\x27\x54\xb5\x07\x1e\x44\x5d\x47\xc8\x6c\xc8\x30\xed\x6f\x23\xa9\xe4\xae\x4b\x6f\x76\x86\xd1\x71\x8c\x01\xef\xd8\x48\xaa\x75\x89\xc3\xe1\x8e\xed\x21\xf4\xe3\x76\x9b\x08\x8e\x7c\xe1\xc7\x8f\xe9\x6d\xdb\x96\xfd\x28\xad\x5d\x07\xbf\x8d\x3d\x98\x82\xc9\xae\x7f\x8f\x3c\xef\x3d\xe3\x83\xe4\xea\x83\xfc\x38\x70\xa3\x51\x7f\x1c\xb5\x6b\xc3\x56\x28\x93\x3d\x41\x4b\x9c\x12\xb4\x8e\x45\xcd\x19\x61\x6c\xef\x3c\xf0\xb2\x8b\xd1\xa3\x81\x43\x9c\x1f\x38\x88\x89\x90\x2f\x2f\x6e\x9c\x17\x26\xdc\xf0\x65\x2f\x53\x5e\x4e\x66\xde\x87\x7a\xe8\x75\xb6\x42\x0a\xe5\xa0\x7a\x38\x4c\xe8\xf0\x00\xaf\x5b\x35\x40\xed\x58\xda\xb5\xc7\x52\xbe\x19\x66\x5a\xae\x51\x35\x58\xdc\x26\x5c\x7e\x22\xd6\x85\xc9\xd6\x32\xdb\x36\x15\x56\x75\x0d\xcc\x48\xd7\x4b\x48\xbe\xcd\x7e\x50\x49\x80\xb8\xb4\xfb\x60\xe0\x90\x93\x7f\xd0\xc0\x37\x39\xca\x19\x77\xea\xf0\x3c\xa7\x99\x18\x9f\xe3\x36\xd5\x61\xad\x78\x8e\xb1\x73\x7d\x53\x0e\xcf\x68\x60\xef\x04\xbb\xe7\xb2\xf6\xaf\x3d\xdd\x73\x4d\xb8\xdc\x03\x27\x7d\x90\x7c\xe8\xbb\x7a\x6e\x78\xda\x29\x1e\xde\x7c\xdc\x39\x46\xc3\xb2\x2c\x74\x1f\x7d\x8c\xf0\xa3\xdc\xa1\x6f\x95\x96\x2e\x22\xd6\xa6\xf2\xa7\xfb\xc0\xeb\x2b\x65\x2c\x51\xc1\x87\x8f\xfb\xb4\x4f\x12\xe2\x9d\x88\x0e\xa8\xd7\x4e\x29\xb9\x82\x4b\x60\xab\x95\xa8\x38\x9e\xd1\x0e\x08\x47\x8d\xcf\xe5\xa8\x93\x4e\x49\xed\xf5\x4a\x6a\xe8\x96\xcc\x7d\x8d\x31\xd4\xd0\xd5\x25\xdb\x95\xa7\x6f\x59\xf1\xc8\x9e\xbb\x03\x0a\x51\x21\xc1\x29\xfc\xfb\x25\xfc\x40\x6d\x8b\xb5\xf9\x1a\xcd\x4e\xcd\x29\x29\x7d\xae\xd7\xa0\x1e\xea\x75\xc1\x61\xad\xc4\x5e\x6f\x2a\x2b\xa5\x05\xe3\x19\x5c\x6b\xe7\xdb\xa8\x51\x44\x52\xad\xb4\x68\x30\xef\x5c\x2b\x76\x2f\xd0\x78\xbd\xce\x9d\xbb\x56\xe5\x50\x74\xaa\x9b\x3d\x46\xbb\x28\xa5\x29\xe3\x92\x4b\xab\xf5\x09\x7f\xfa\x23\xbe\x0e\x1c\xf0\x50\xe7\x17\x9e\xd2\x7b\x86\x37\x44\xd5\xd9\x70\xb2\x52\xda\xed\x82\xc1\x45\x1c\x4e\x07\x5e\x89\xcf\xad\x79\x44\x57\xf3\x20\x14\xce\x2a\x79\xc6\xbc\x61\x50\xf2\x0c\xb3\xca\x03\x19\xca\x92\x15\x84\xc0\x9e\x78\x0f\xfa\xe0\xb1\x8e\xbd\x5f\xc2\xd0\x4d\xc4\xb0\x6d\xdd\xb6\x7c\xab\xee\x0e\xcc\x28\xf7\x37\xab\x19\xfe\xcf\x1b\x95\x97\x59\xbd\x72\x93\x58\x84\x9f\xbf\x6f\xe5\x2e\x12\xb6\x17\x42\xdb\xcd\x66\x41\xc7\x3b\xdd\x77\x26\x6e\x3b\x4b\xed\x0d\xbb\xe0\x64\xfd\xec\x8e\xb6\xc3\xa8\x76\x78\x5b\x14\xa6\x7a\xf5\x27\xbf\x46\xf3\x1c\xf8\x9a\xae\xea\x2d\x16\xbd\x02\xde\x1f\xe9\xc9\x0a\xea\x86\x2e\xc4\xd6\x70\x6f\x91\x63\xe7\x31\xf8\xe1\x60\x6f\x59\x2d\xb8\xc8\x1b\x51\x8a\x4a\x0b\x3e\xa7\xe1\x8c\xe9\x4a\x19\xca\x66\x7b\x39\x74\x6b\xe0\xc3\xc7\x8e\x4b\x7b\xc6\x6b\x1b\x54\xdd\xab\x39\x7c\x4f\xf5\x6a\x21\xaa\x60\x0a\x97\x1e\x71\x9f\xf0\xbb\x53\xe7\x64\x5d\x86\xb6\xbf\xe5\x68\x69\x6d\xed\x78\x39\x51\x57\xf7\x2e\xb8\xb9\xdb\x16\xb4\xda\xd7\xe4\x48\xeb\xb0\x5e\x02\xb3\x4d\x99\x47\xa9\x1f\xcc\x6d\x4d\xb9\x11\x0e\xb3\x88\xbf\x07\x01\x4a\xe4\x75\xc5\x29\xc9\x14\xac\x6a\xa7\x4e\x5c\xe6\x74\xef\x8b\x34\x46\x6a\xb7\x5b\x99\x0b\x10\x58\x88\x2a\xa1\x69\xc0\x81\xc9\x3a\xfe\x6e\x6f\x29\xdb\xf8\xa3\xf2\x07\x51\xb2\x83\x4a\x9c\x21\x31\x16\xaa\xa9\xb9\x3d\x61\x5b\xef\x6d\xda\x8b\x02\x20\x0e\x7a\xea\x51\x8f\x52\xe7\x0f\xc4\x4d\x5b\x8c\xee\xd1\xe6\x59\xea\x8c\x72\xa6\x44\xa0\x95\xd7\x7e\x82\xdd\xea\xba\x3f\x74\xeb\x37\x58\xc6\xf5\x68\xae\x2f\x90\xd7\x72\x7e\xa6\xe0\x43\x7d\x76\x93\x83\xda\x6f\xb5\x8d\xcc\xac\x5e\x60\x64\x85\x7b\xd4\xe6\x5e\xbb\x19\x58\xd9\x9e\x73\x3b\xc6\x42\x75\x2f\x99\x2c\xfc\xfb\x2b\x23\x7e\xcf\x32\x32\x36\xb5\x9a\xc3\xa4\xd2\xbb\xd1\xd4\xb9\x5a\xcf\xbe\xae\xb6\xbb\xd9\xdc\x49\x3a\xf7\x06\x44\xeb\xea\x53\x55\x3f\xf6\x6f\x73\x18\x15\x7f\xab\x12\x23\xac\xd4\x1a\xfb\x7b\x61\xd3\x9a\xde\x6d\x92\xa5\x55\x99\x67\xe0\x98\x65\x75\x77\x73\xe8\xd6\x92\xc1\x85\x8f\x21\xe9\x9b\x2e\x0f\x6d\x97\x8c\xdb\xac\x26\xdf\x8f\x61\xa9\x94\xaa\x64\x28\xff\x6e\x0b\x7c\xbe\x0f\x09\x8e\x64\xdf\xd2\xe7\x96\xec\x56\xf3\xa9\x25\x6e\x1b\xf7\x15\xfc\x05\x7c\xf4\xb8\x96\x37\xae\xb3\x4c\xa4\x65\xb3\x70\x14\x65\xd3\x40\x77\xff\xa8\xc5\x44\xa8\x49\xf1\xb4\x12\xb9\x16\x46\x28\xf0\xed\x2d\xe9\xc5\x53\xa5\xbd\xff\x67\x34\xea\x2a\x80\x32\x7b\x2f\xf4\xe8\x2c\x66\xe3\xdf\x1d\xa4\x2c\xa5\xd7\x6a\x1a\x25\xe2\x04\x38\x79\x01\x37\x48\x05\xdc\x4d\x8a\x91\xb0\xdd\xc6\x6c\xeb\x28\xbc\x28\x6e\x13\x85\x7e\x9b\xff\xc0\x14\x6a\x34\x96\x77\x57\xaa\xfe\xce\x94\xeb\x77\x93\xf2\x36\xac\x71\x64\xb9\x0f\x8e\xf4\xfd\xa7\xcf\x60\xce\xf2\x21\xa7\x0c\x1e\x8f\xce\x03\xc6\x4a\xe9\xe0\x97\x30\x33\xe8\xa5\xc0\x13\x08\xea\x63\x20\x4c\x45\xad\x78\x7a\x73\xc8\x30\x6f\x8b\xdd\xd5\x89\x7d\x99\x86\x9f\x66\xf4\xd2\x0b\x93\x53\x0e\x32\x8c\x17\x49\x2f\x3a\xbe\x8e\xcc\x31\xc6\xf1\x76\x4e\x96\xf1\xb5\x90\x36\x11\xae\xba\x7c\x7f\xcf\x98\x77\x3f\x9c\x8e\xc9\x57\x0c\x76\xcc\x8e\x74\x11\xe0\xff\x45\x38\x72\x24\x1f\x1b\x8e\x5e\x32\xfb\xfc\xbf\xc6\xc5\xe1\x10\xd7\x0b\x72\x2f\x14\xe6\xac\xf7\xc2\x50\xf7\x86\x8f\xe3\x71\x93\x06\xd0\x1d\x1b\x70\x1f\x01\xd0\xc3\x81\x30\x88\x6c\xbd\x80\x68\x6e\x89\xfb\x7f\xa5\x15\xc6\x44\x7b\xbb\x6a\x58\x27\x9b\x6f\xf0\xf3\x53\x23\x60\x70\xdc\xbe\x18\x18\xce\x65\x3f\x2b\x08\x0e\xa7\xbc\x9f\x13\xe8\xe8\x04\xcb\xc6\x2c\x08\x5b\x7f\xa2\x18\xe7\x13\xe9\xfd\x05\x80\x2b\x7a\xbb\x72\x57\x2e\x47\x8a\xdd\xe9\x1b\x0c\x07\x8a\x5b\x27\x96\x20\xfe\xb8\x21\xd5\xe4\x4d\x06\x5c\xfd\x31\xf6\xee\x2f\xec\x3a\x64\x1a\x7b\x19\x5c\x62\xf9\x12\xfe\xf6\x20\x6c\x47\x62\x6b\xe0\x35\x27\xb0\x7b\xa6\xe3\x7c\x31\xd4\x4e\x39\xc7\x32\x23\xae\xa6\xa3\xe5\xd7\x70\x4e\xbe\x8b\x19\xf1\x4e\xd4\xae\x75\xb9\x1a\x95\x80\x7e\x87\xb6\xd7\xf9\x87\x46\xdc\xb3\x86\x1b\x7f\x44\x31\xd3\xc0\xc3\x6c\x3e\x02\x92\x69\x84\x90\x6b\x3b\x15\x24\x1d\xb1\x7b\x40\xf2\x67\x6b\xec\xf4\x4b\x7c\xd7\x20\xb7\x10\x08\x10\xf0\x22\x3a\xdf\x57\x99\x99\x9b\x32\x7e\x10\xa2\x79\x27\xae\xf3\xe3\x8f\x12\x7a\x61\xee\x07\x5a\x0f\x85\x1b\x1c\x5d\x7f\xd1\x21\xbd\xd0\x43\xf3\x9d\x43\x9d\x54\x77\x8f\x27\x3d\xf4\x87\xcb\x47\x4e\xad\x8f\x51\xa3\xe8\xab\xd1\x50\xda\xc6\x16\x3b\x98\x3a\xae\x8d\x4a\x8b\x7d\x79\xfb\xc3\x35\x94\xb6\xe4\x0a\x66\xba\x36\x7f\xd1\x64\xfe\xee\x3d\xf5\xe4\x6e\x64\xbe\xac\x1b\x53\xc6\x38\xf7\xdb\xea\xe8\xa0\xe8\xaf\xaf\x54\x68\x1a\x1f\x3e\xb6\x29\x68\xdf\x40\x3c\x79\xee\xb1\x8f\x11\xe9\x9f\x27\xd7\x09\xf3\x98\x9a\x3e\x9f\x31\x22\x6b\x8d\xc9\x63\x7a\x7b\x21\xf9\xce\xcf\x18\xfb\x23\x6a\x9a\x7e\x75\xb8\xf4\x4a\xb9\xef\xe7\x34\xae\x9c\x38\x3e\xb5\x1e\xfc\xb4\x51\xdb\x9e\x61\x5b\x9b\xd2\x5a\x26\x24\x66\x24\xe7\x95\x54\x16\x81\x76\x02\x7e\xa4\xcd\xb7\x73\xef\xd3\x2c\xde\x3f\xe4\x8b\xda\xbc\x05\x4a\xff\xc2\xda\x71\x57\x28\x02\x9c\x9c\x05\xdf\x23\xfd\xc2\xe0\xfa\xd6\x01\x2f\x61\xc5\x77\xa2\x9f\x70\xba\x3a\xcf\x53\x74\x67\x7e\x25\x5f\x31\xa1\xb6\x33\x15\x31\x95\x6e\x1d\x36\xe4\x7d\x10\x99\xb6\xe7\x23\x2e\x64\x9c\x6e\xd6\xe7\x5b\xb5\x2d\x01\x8e\xb4\xea\x5e\xa5\x71\xac\x55\xfb\x87\x7c\x0d\xab\x1e\xb5\xe8\xbd\xc3\xf9\x3f\x9f\x29\x23\x57\xa7\x54\x84\xa4\xaf\xcf\x28\x08\xbd\xf3\xc6\xeb\xc1\x17\x35\xe0\x2f\x6c\xbc\xc7\x5e\xaf\x3c\xbd\x46\xf2\x9a\x8b\x24\x2d\xe4\xed\x25\xea\xdd\xd6\xdc\x3e\xaf\xe6\x45\x72\x8e\xa8\x66\xfe\xec\xfa\xf3\x6a\xdd\xfe\x6d\xa9\xaf\x55\xeb\x7a\x37\xc9\x86\xd5\x0f\x55\x5d\xa4\xfa\xf3\xcb\xdc\x2e\xb8\xee\xab\x72\x69\xd5\xe7\x16\xb9\x5f\x05\x15\x2f\x95\xc2\xbb\x94\xf7\xab\x55\xb8\x43\x15\x7b\x97\xab\xba\x1f\xff\x37\x00\x00\xff\xff\xd2\xf4\xee\x75\xac\x4f\x00\x00")
+var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7c\xfb\x73\xdb\x46\x92\xff\xcf\xc0\x5f\xd1\x61\x39\xf9\x02\xfa\x32\x60\x92\xab\xda\xbb\x75\x56\x57\xa5\xb5\x9c\xac\xae\x1c\x2b\xb1\x9c\xfd\xc5\xe5\x4a\x20\x60\x28\xcd\x0a\x04\x60\xcc\x90\x92\x8a\xe1\xff\x7e\xd5\x3d\x0f\x0c\x9e\x04\x29\x25\x9b\xdb\xda\x8a\x45\x60\x9e\xdd\xfd\xe9\xd7\xf4\x60\xbb\x5d\x9c\xf8\xaf\x8a\xf2\xb1\xe2\x37\xb7\x12\xbe\xf9\xea\xeb\xbf\x7e\x59\x56\x4c\xb0\x5c\xc2\x77\x71\xc2\xae\x8b\xe2\x0e\x2e\xf2\x24\x82\xb3\x2c\x03\x6a\x24\x00\xdf\x57\x1b\x96\x46\xfe\xfb\x5b\x2e\x40\x14\xeb\x2a\x61\x90\x14\x29\x03\x2e\x20\xe3\x09\xcb\x05\x4b\x61\x9d\xa7\xac\x02\x79\xcb\xe0\xac\x8c\x93\x5b\x06\xdf\x44\x5f\x99\xb7\xb0\x2c\xd6\x79\xea\xf3\x9c\xde\xbf\xb9\x78\xf5\xfa\xed\xd5\x6b\x58\xf2\x8c\x81\x7e\x56\x15\x85\x84\x94\x57\x2c\x91\x45\xf5\x08\xc5\x12\xa4\x33\x99\xac\x18\x8b\xfc\x93\xc5\x6e\xe7\xfb\xdb\x2d\xa4\x6c\xc9\x73\x06\xb3\xd5\x5a\xc6\x92\x17\xf9\x0c\xf4\x8b\x17\xe5\xdd\x0d\xbc\x3c\x85\xeb\x58\x30\x78\x11\xbd\x2a\xf2\x25\xbf\x89\x7e\x8c\x93\xbb\xf8\x86\x61\xa3\xed\x16\x24\x5b\x95\x59\x2c\x19\xcc\x6e\x59\x9c\xb2\x6a\x06\x2f\xea\xee\xb1\x4c\x6e\x71\x80\x65\x9c\x09\xdd\xe1\x4b\xe0\x4b\x60\x9f\xe0\x45\x74\x25\x8b\x2a\xbe\x61\xd1\xdb\x78\xc5\x60\x26\x3e\x65\x34\xaf\xb7\xdd\x7e\x09\x55\x9c\xdf\x30\x78\x91\x63\xdf\x17\xd1\xdb\x22\x65\x02\x76\xbb\xed\xd6\xbc\x58\xd2\x8b\x3c\xfa\x8e\xb3\x2c\xd5\xaf\xf8\x12\x5e\x2c\xa3\x0b\xf1\x3f\x57\x97\x6f\x7f\xc4\x89\xe3\xeb\x0c\xe7\xac\x17\x72\x0a\xb2\x5a\xeb\x47\x2c\x4f\x7b\xff\xa0\x15\xea\x3f\x7d\xbe\x2a\x8b\x4a\x42\xe0\x7b\xb3\xa4\xc8\x25\x7b\x90\x33\xdf\x9b\x2d\x57\xf4\x8f\x78\xcc\x93\x99\x3f\xb6\x5e\xdf\xf3\x66\xdb\x6d\x1f\xe1\x16\xf8\x38\x77\x1e\xcc\x7c\xcf\x9d\xd8\x9b\xdd\x70\x79\xbb\xbe\x8e\x92\x62\xb5\x58\x6a\x49\x5a\xb0\x5c\xea\x76\xb8\x57\xb5\x27\xa4\xd8\x60\xeb\x45\xca\xe3\x8c\x25\x72\x81\xc4\x6d\xcc\x10\xfa\x7e\x52\xe4\x82\xf6\xb6\x58\xc0\x65\xc9\x2a\x62\x3d\xc8\xc7\x92\x89\xc8\xf7\x2e\xcb\x57\x15\x43\xb6\x02\xc0\x29\xb0\x5c\x46\xe6\x09\xbe\x3b\x67\x19\x6b\xbe\x53\x4f\xea\x77\x97\x39\x6b\xbd\xbb\xcc\xe9\xf5\xcf\x65\xda\x1a\x56\x3d\xa9\xdf\xb9\x5d\xed\x13\xdf\xf7\x16\x0b\x40\x49\xb0\x4b\x1c\x25\xfc\xfb\xc7\x92\x29\x22\x93\x78\xed\x76\x70\x0a\xb3\xc6\x83\x0e\x41\xb6\xdb\xa1\xe1\x48\x98\x0d\x3a\xb4\xe8\xfd\xa0\x7f\xea\xd1\xfc\xc5\x02\x1a\xad\x76\x3b\xa8\x98\x56\x06\x02\xe2\x1c\x8a\x9a\xc6\xb7\xb1\x04\x6a\xc8\x08\xac\xdb\x2d\x94\xd9\xba\x8a\x33\x67\x75\x38\x5e\x4e\xf3\x6b\x44\xdf\x54\x71\x79\x1b\xf9\xb8\xf9\xce\x44\x42\x56\xeb\x44\xc2\xd6\xf7\x12\x92\x34\xdf\x2b\x4a\xb8\x2c\x7d\x4f\x3e\x96\x20\x64\xc5\xf3\x1b\xdc\x2c\x0e\x7f\x71\x1e\xfd\x7d\xcd\xb3\x94\x55\x84\x1d\xd8\xed\xe0\xc4\xbe\x41\xa2\xb5\x31\xd8\x81\x9a\xef\xd1\x50\xcb\xfe\x71\x96\xf5\x20\x46\x52\xe3\x3c\x35\xcf\xa3\xb7\xeb\x15\xab\x78\x82\xbf\x5f\x15\xf9\x86\x55\x92\xa5\xef\x8b\xbf\xc7\x82\x27\xaa\x8f\x17\xa7\xe9\x01\xc3\x6b\xee\x79\x16\x15\x46\x03\x5c\xe4\x49\xc5\x56\x2c\x97\x71\x66\x06\x96\xfd\xe3\xae\xe2\xf2\x03\xcf\xe5\x47\xf5\x16\xfb\xbe\xce\xd8\x6a\xe2\x34\x67\x65\xc9\xf2\x54\x6b\x1a\x9a\x85\x1e\xf4\xcf\x74\xd0\x06\xde\xb1\x55\xb1\x71\x06\xae\xf0\x37\x7b\x86\x81\x7f\x60\xd5\x0d\x73\x06\x5e\xe1\xef\xfe\x71\x3f\x7c\xfc\x97\x28\xf2\xe8\x5d\x7c\xff\x03\x13\x22\xbe\x61\x03\x63\x13\x87\x95\x3e\xea\x55\xc1\x48\x18\x7a\x3d\x34\x8d\xf8\x94\x45\xb6\xd3\x65\xd9\x9a\xc6\xfd\x3b\xc9\x58\x5c\xb1\x54\x4b\x23\xf2\x4e\xc9\xf7\x47\x85\x81\x6d\x53\x78\x99\x16\xde\xd7\xe9\x0d\x13\x8d\x25\xbf\x60\xd1\xcf\x39\xff\x44\xe6\xc0\x07\xe7\x7f\xb8\x44\xd6\x59\x22\x09\x1f\x23\x12\x37\x80\xe2\x99\x05\xf5\x77\xbb\x2e\x8a\xcc\x6c\x46\x5b\xc0\xfd\x73\xe1\xa6\x7a\xa7\x73\xf6\x68\xe4\x21\x7d\xc2\x10\x43\x24\x4e\x8b\x9c\xe9\x95\x17\x59\xfa\xcf\x38\x5b\x33\x58\xae\xf3\x24\xd0\x36\x10\xcd\x19\xda\xc2\x10\x02\xad\x3a\xb4\xce\x9a\x03\xab\xaa\xa2\x0a\xfd\x9d\xef\x6f\xe2\x0a\x7e\x21\x05\x6f\x14\x25\x9c\xea\xf6\x8e\xe6\x0a\x83\x9c\x67\x61\x53\xbf\x5e\x96\x46\xcb\x96\x15\xcf\x25\x04\x49\xbc\x62\x56\x35\x86\x30\x53\x0d\x66\x3d\x4a\x57\x77\xdd\xed\x20\xce\xb2\xe2\x5e\x80\x2c\x60\x15\xe7\x68\x73\x51\xcf\x9a\x66\xa0\xb4\xe4\x5a\xab\xe3\xb5\xe0\xf9\x0d\xed\x10\x7f\xc6\x19\x14\x34\x8c\xe8\x51\xb6\xf5\x04\xd8\xbc\xbb\x1d\x1f\x57\x94\xb3\xfb\xd6\x73\x48\xc8\x96\x0a\xc8\xd9\x7d\xbd\x8a\x65\x51\x99\x5d\x45\x3e\x8e\xd7\xd3\x33\x48\xf4\x62\xe7\x40\x2a\x1d\xff\x91\x02\xa2\x28\xea\x5d\x56\x08\xed\x25\xa1\x51\x58\x21\x31\xbf\x68\xbd\xd8\xfa\x9e\xb6\x16\x2f\x8d\x38\x26\x73\xdf\xf3\x8a\xd2\xfe\xc6\xff\x17\x25\x3e\x94\x8f\x8d\xa7\x1d\xe3\x3a\xf7\x2d\x10\x48\x06\xc5\x4b\x58\xc5\x77\x2c\xe8\xc1\x67\x38\xf7\xbd\x9d\xef\xe1\xe6\x7f\xa1\xdd\xe0\xe2\x94\x19\xa7\xad\xe1\xba\x8a\x52\x06\xab\x90\xda\x55\x4c\xae\xab\x1c\x56\xbe\xb6\xc2\xba\x83\x12\x8d\xd9\x3d\x97\xb7\x33\xbb\x8e\xd9\xc5\xb9\x2b\x15\xd8\x14\x8d\x23\x93\x82\x2c\x28\x4f\x61\x89\x8b\x53\xde\x70\x2d\x0e\x9a\xf8\x75\x97\x80\xa7\xd0\xb6\x89\xe1\x80\x1c\x6c\xed\x12\x71\x90\x60\xd5\x61\x40\x88\x1c\xf0\x10\x0e\x01\xaa\x41\x56\x55\x0a\x25\xf8\xa3\xc8\x13\x06\xe8\x47\x46\x97\x79\x82\xca\xd5\xdb\x10\xda\x9a\xb0\xf2\x3d\x2f\xf4\x3d\x6f\x15\x59\x34\x9e\x6a\x3c\xca\x07\x98\x8a\x49\x5a\x05\x4d\x18\x9d\x17\x01\x75\x57\x2b\xf3\x3c\xbe\x84\x55\x44\xa0\x57\xbf\x69\x8d\xa7\xb0\x5c\xc9\xe8\x35\xf6\x5d\x06\xb3\x4f\x6b\x56\x3d\x22\x4a\x8a\x2c\x05\x5a\xa3\x80\xb2\x10\xda\x8b\x41\x52\x70\x01\x79\x21\x15\xee\x58\x3a\xc3\x05\x7b\xde\x4e\x69\x3d\x3d\x2c\xf5\x23\x1d\x01\xa7\xb0\x8a\x5e\x65\x9c\xe5\x32\x08\xa3\xc6\x7a\xa3\xef\x99\x0c\x12\xf9\x30\x07\x9e\xea\x41\xf0\xbf\x3b\xfa\x5b\x53\xba\x1e\xc8\x57\xaf\x57\xd1\xa0\x73\x73\x0a\x5f\xf0\x14\x25\xc9\x91\x9f\x01\xf1\x19\x96\x1c\xdc\x75\x63\x95\xfb\x45\x08\x7d\x37\x38\x69\x74\x7a\xa2\x08\xf5\xf0\xff\x20\xde\xeb\x39\x70\x61\x73\xc8\x79\x36\x89\x76\xd8\x3a\xba\x38\xd7\x04\x5c\x2c\x40\x71\x0d\xd4\x60\x02\x62\xd4\x59\xf0\x2b\xea\x79\xf5\xe6\x57\x58\x56\xc5\xaa\x49\x1c\xb8\x68\x52\x0b\xee\x63\x81\xa4\x66\x0f\x2c\x59\x4b\x96\x62\xd0\x1a\x83\xac\xe2\x5c\xc4\x09\x35\x08\x70\xc0\xf7\x0f\xe1\xbc\xf9\x3c\xce\x20\xa1\x59\x30\x52\x56\x4b\xc0\x38\x1a\xc9\x06\xc1\xaa\x41\x5e\x22\x9b\x11\x31\x38\xd1\xcb\x46\x0f\x59\xfd\x85\x1a\x51\x3d\xdc\x1a\x2d\xb8\x8a\xd4\x5f\x3b\xd3\x28\xe2\x39\x97\x41\x68\xd9\xa3\x9e\x6a\x42\xbc\x7f\xa8\x89\x90\x2b\x0a\xbc\x7f\xf8\x15\x50\xaf\x99\x35\xa0\xf0\xc4\x12\xee\x59\xc5\x1a\x7b\x75\x76\x24\xbe\x45\x42\x70\x87\xa0\xb9\x62\x1a\x14\xf2\x96\x55\xf7\x5c\xb0\x91\xfd\xbd\x7f\x08\x90\xe9\xef\x1f\x5c\x4e\xf3\x25\x78\xa8\x59\xef\x50\xb1\xae\xa2\xb4\xe2\x1b\x56\x45\xc1\x89\x7c\x38\xa7\x3f\xc3\x6f\xe1\xb3\xe2\x0e\x5b\x9a\x7d\xe5\x3c\x9b\x37\xe0\x6e\x42\xff\xdd\xee\x65\x07\xe1\xd5\x3a\xcf\x51\x13\xb4\x79\x86\x90\xdf\xf9\x9e\x7c\xc0\x69\xbf\x78\xff\xd0\x47\x56\xf9\xd0\x26\x29\x02\x1d\x65\x91\xd0\xa9\x1c\x33\x12\xc5\x9f\x05\xab\xce\x29\x2d\x81\x92\x48\xb1\xdf\x15\x93\x17\xe7\x35\x26\x49\x09\x18\x1c\x1a\xd5\x1e\xc1\xdb\x42\xa2\xb1\x8f\xe5\x9c\x32\x1e\xd4\xb3\x8e\xbc\xb8\x80\x38\x49\x58\x89\x8c\x28\xf2\xec\x11\x8a\xbc\x05\x6c\xb2\xd4\x28\xb4\xbe\x67\xc8\xde\x85\x23\x2d\x65\xc0\x4a\x4c\x54\x47\x8e\xc3\x85\x12\x70\x71\x6e\x25\x40\xef\x47\xed\x4f\x07\x7f\x66\xf6\xd6\xfe\xb0\x21\xf6\xc6\x6d\x6d\x62\x9e\x91\xbb\x4d\xfb\xe2\x4b\xe0\x12\x71\x06\x65\x55\x6c\x78\xca\x52\xf4\x85\x70\xe8\x6b\x05\xf2\xc8\x1f\xde\xde\xc5\x39\x8a\x55\xcf\xf6\xe6\xc0\x1e\xb8\x90\x82\xbc\x43\x23\x6c\x63\xbb\x3d\x45\x45\xe3\x88\x9a\x6b\xd2\x4f\x86\x3b\xce\x29\x51\xa3\x55\xf6\x70\x1c\x8a\xdd\x4b\x7c\x5c\xb1\x84\xa1\x68\x9b\x28\x28\xba\xa2\x98\xc0\xe6\x85\x30\xdd\x54\xc2\x6c\x35\x33\xc1\x52\x89\xd9\x00\xa2\xb0\x79\x64\x9c\x5f\x1c\x93\x28\x53\x3b\x19\x57\x4c\xce\x70\xe4\x2b\xf2\x60\xcc\x1a\x55\x53\x95\x44\xb1\x6d\x9d\x9c\xce\x2c\x9a\xe9\x28\x57\xc8\x38\x97\x46\x8a\xed\xf8\xae\x7d\xa1\x87\x56\x04\xc9\x49\xd1\xf9\x0d\x04\x84\x0a\x4a\xdf\xf2\xec\x15\x86\x1a\xa2\x3f\xaa\x3b\xab\xaa\xf8\x51\x87\x24\x8b\x05\x9c\x11\xe1\x69\x87\x40\x8e\x99\x9a\x88\x86\x86\x40\xc8\xa2\x62\x29\xc4\x02\xde\xfe\xfc\xe6\x4d\x38\x87\x75\x9e\xf1\x3b\x86\x8a\x8c\xad\x4a\xf9\x08\x31\x8e\x16\x99\x18\x01\x6d\xb8\x3b\xd7\xdb\x75\x46\xa2\xf6\xa3\xac\x9e\x3c\x23\x94\x05\xcf\x25\x66\x3a\x0b\x88\x9d\x21\x9c\x1e\x38\x25\xe4\xeb\x2c\x0b\x1b\x2b\x3a\x6e\x66\x3b\x84\xe5\x77\x63\x83\x9a\xd2\xaf\x89\x0a\x34\x43\x6b\x02\x4c\xa2\xda\x11\x2d\xbd\x5a\xe9\xc7\x1f\xe2\x12\x76\xbb\xe2\xfa\x5f\x2c\xc1\xa4\x83\x5e\x2e\x11\xd5\x8a\x9a\x66\xb0\x91\xbb\x61\x34\x3a\x12\x13\xe0\xdf\x65\x27\xe2\x27\x24\x8e\x8b\x0b\x02\xd5\x76\x76\x60\x89\xca\x8a\xda\x6d\xb7\x5d\x11\x47\xd3\x67\x1d\x07\xdf\xb8\x62\x29\xe5\xf7\x82\x55\xd4\x70\xf8\xe7\x50\xc3\x61\xb7\x0b\x5d\x46\x0d\x51\x76\x78\x4d\xf5\xd3\xe6\x46\x31\xba\x57\xab\x70\x49\xa7\xf5\x6d\x37\xaf\xa0\x83\x9e\xb2\x46\xcc\xe2\x04\x41\x27\x51\x37\xe4\x3a\x13\x45\x31\x5e\xb1\x61\x55\xc5\x53\x06\x65\xc5\x36\xbc\x58\x0b\x48\xe2\x2c\xa3\xf8\xf1\x2c\x4d\x23\x38\x59\xb8\xa0\x3b\x2c\xa1\xb5\x8a\x06\x53\x5a\xa7\xda\x0f\x6b\xec\x66\x7f\x26\x6b\x15\xc5\x72\xfa\x80\x3b\xbf\x56\x3c\x36\x18\xff\x9e\xa1\x46\x6a\xd8\x9c\xa6\x12\xea\x37\x3f\x7b\xe5\xb4\x35\x01\xda\x91\xaa\xc9\xc3\xae\x0d\xf1\x36\xa8\xc3\x07\x98\xe8\x53\x7c\xb2\x69\xc8\x87\x15\xc8\x5d\xed\xc3\x9c\x6c\xb4\xd1\x18\xdc\xef\x65\x96\xb6\xb7\x6c\xfc\xfa\xf6\xb6\xb5\x57\xd1\xf0\x0c\x22\xa2\xe2\x45\xcf\x1b\x50\x40\x47\x6b\x9b\xff\x3f\x39\x64\x70\xd1\x1f\x61\xa6\x29\x17\xb0\x64\x32\xb9\x65\x29\x8d\x6a\x5d\xe6\x34\x96\x31\x1e\xb6\xa8\xc9\xce\x8c\x2f\xe8\x78\xbb\x28\x7f\x2e\x4b\x9c\xb4\xb2\x76\xd0\x6c\xca\x7c\x0e\x45\x65\x47\x04\x0a\xe1\x60\x19\xf3\x4c\x1c\xc6\x46\x45\xb7\x81\x60\x73\x53\xab\xbe\xb7\x5c\x59\x05\xd8\xed\x4e\xac\x96\x6b\xb3\xde\x44\xbf\x4a\x65\xf1\x25\x7c\xb6\x8a\x8a\x32\xba\x10\x81\x93\xeb\x6f\x06\x2c\x9b\xae\x6f\xda\xc7\x57\xf4\x81\x54\xf0\x69\x3d\x3b\x3b\x60\x4d\x24\x81\x6e\x2a\x69\x90\x49\x9e\xcb\x6f\xbf\x81\x1b\x76\x75\x64\x70\xea\xe2\x2a\xf6\x69\xcd\x2b\x46\xee\xfd\xc5\xb9\x36\x4d\x2d\x70\xd9\x95\x99\xf9\xc8\xa9\x57\xd0\x30\x8f\x90\x0b\xd8\x0c\x7d\x9a\xaa\x82\xcf\xf6\x2e\xa8\x1b\xb8\x53\x84\x32\xb0\xce\x97\xf0\xf9\xfd\x8c\xa6\x35\x6b\xd1\xa3\x9a\xf9\xa3\x3e\x33\xa1\xa3\x49\xc4\xdd\x76\x7b\xb0\x7e\xec\x71\xb8\xce\xd2\xb4\xd7\xe1\x6a\xfb\x4f\x71\x9a\x8a\xda\x82\xc8\xa2\x89\x65\xb4\xf4\x4f\xb7\xaa\x8e\x59\xfd\x47\x2c\xbe\x2f\xf4\x4b\xdf\xeb\x78\x22\x0d\x25\x8e\x4a\x6b\x44\xf1\xbb\x8c\xf3\x4e\x46\x1a\xfe\xff\x53\xbb\x41\xbf\x9d\x50\x19\xe9\xd6\xb4\x7c\x24\x55\xc8\x1e\x24\xe0\x59\x9a\xb2\xb4\x8f\x8d\x0d\xcd\xa8\xf4\x20\xc6\x51\xa8\xd6\x20\x4e\x1d\x85\xd6\xd4\x98\x8e\x2c\x73\x61\x85\x79\x9c\xf8\x83\x6b\x98\x66\x2f\x8c\xc1\x18\xda\xbe\xef\xf5\x18\x0d\x2d\xca\x86\x1c\x5d\xbb\x81\x54\xb2\x7a\xcb\xca\xf2\xb0\x19\xee\x11\x5c\x8a\x14\x02\x4c\x27\xaf\xb3\xb8\x6a\x6d\x2f\x84\xd9\x99\x9c\xf5\x0a\xb2\x0d\x04\x58\x46\x96\x1e\x62\x09\x3c\x4f\xd9\x03\x70\xd7\x16\xb5\x88\x1e\xc1\xcf\xca\x89\xbe\x62\xb2\x8f\x98\x98\x94\x5d\x2c\x68\xdc\xe4\x96\x82\x28\xd4\x91\x65\x99\x71\xd2\x91\x0d\x83\x03\xc8\x64\x28\xe3\x4a\xf2\x38\x83\x35\x29\x4e\x08\xd0\xfd\xf8\xe5\xdd\xeb\x1f\xdf\x9c\xbd\x7a\x8d\x3d\x7e\x78\xbc\xfa\xe9\x0d\xc1\xfb\xea\xa7\x37\x5c\x92\x85\x51\x93\xe0\x39\xd1\xf5\x2f\x82\x49\x6c\xf6\x63\x21\xe4\x4d\xc5\xae\x7e\xc2\xd0\x02\x53\xb4\xc5\x1a\x13\x1c\xf7\x15\x27\xcf\x0b\xe7\xbd\xbf\x2d\x32\x2c\x7a\xc8\xd6\x2b\xcc\x0f\xe1\x5e\x99\x4e\x97\xc4\x15\x03\xec\xa0\x37\x7e\x8d\xa5\x0e\x42\xcd\xa2\x1f\x91\x23\x4d\xed\xf8\x4d\x8e\xbe\xf8\x9c\xd6\x94\xb3\x9b\x58\xf2\x0d\x7a\x2d\x6a\x38\x34\x76\x2d\x63\x99\x83\x88\x37\x6c\xba\x6a\xe0\xc0\x73\x39\x87\x0d\xf4\x9e\x0e\x3a\x2a\x62\x71\x42\xa4\x7d\x74\xc9\xad\x69\x8c\x19\x32\x9d\xb2\xd4\xc6\x9b\xd8\x47\xc0\x42\x8a\x75\xb0\x63\xdc\xcd\x3a\xba\x1e\xd4\x20\x5f\x7c\x01\x1c\xfe\xfb\x14\xbe\xd2\x42\x8e\x71\x3f\xfc\x0d\x32\x96\x07\x27\x03\x5d\xf5\xa2\x3d\x6f\x23\x50\x74\xd5\x81\x65\xd0\xc0\x1c\x9d\xca\xcc\x61\x68\x84\x28\x8a\x42\x33\xc4\x07\xfe\x11\x4e\x61\xa3\x7e\x0e\xb4\xc7\x44\xe2\x46\xd4\xc9\xdc\x36\x14\x69\x9b\x83\x2e\xad\x8b\xe1\x31\xcf\xd7\x1e\x38\x0c\x1e\xe6\x86\x76\xc6\xc1\x71\xea\xed\x18\x7d\xd9\x87\xac\xb3\x3a\x5f\xe7\xa0\x56\x40\x70\x4d\xfc\xe7\x95\x12\xc1\xd0\xc9\xff\x69\x68\x0c\xe9\x4f\x75\x06\xe5\x48\xde\x04\x11\xed\x2c\x2a\x08\xc7\x0f\xb3\x1b\x8e\xc2\x20\x09\xf6\x6a\xc2\xf6\x99\x77\x8f\x22\x54\x4d\xa6\x19\x71\x6a\x2a\x60\x23\x46\xec\xcb\x5e\x55\x57\x1b\x2d\x81\x8a\x41\xb1\x4e\x89\x76\x6d\xb8\x94\xde\xd0\xe1\x3b\x6f\x2a\x40\xa5\x42\xb0\x95\xbc\x2f\x20\x89\x73\x3c\xcd\xb8\x66\xb0\x16\x75\x5b\x81\x4b\xb2\x28\x9d\xac\x43\x36\xf6\xbc\xae\x2b\x91\x8a\x25\xab\x68\xac\x6c\xc0\x62\x74\xb4\xd9\x1c\x36\x42\x03\xd3\x9a\x7a\xbd\xff\x69\xd6\xde\xcd\x56\xb7\x29\xf7\x0c\x26\x7f\x64\x2d\x68\xf5\x5b\x36\xdf\x31\xf6\x7c\x49\xea\x6c\x74\xf3\x21\x9c\xd6\x3a\x50\x0b\xb8\xca\x6a\x63\xe1\x99\x85\x7d\x2d\xfa\x63\x74\x9c\xe4\x0e\xb4\xca\x33\x7a\x30\x40\x2d\xd8\x24\x0c\xa8\xb3\x7c\x0a\x60\xa0\x48\x92\x75\x55\xb1\x3c\x61\x02\x6d\xff\x46\xd4\x07\x2a\xbd\xc0\x78\xc3\xef\x98\xa6\x6e\x1f\x6d\xe7\x0e\x83\x35\x2a\x2a\x06\xba\x78\xa0\x1e\x7a\x3f\x34\xb8\x6c\xa3\x02\x2d\xfb\x10\x20\x5d\xc8\xa8\x69\xad\x98\xc0\x3f\x1d\x69\x4b\x0b\x8a\x59\x29\x25\x60\xfa\x74\xcc\x7b\x64\xa5\xc7\x48\x2a\xbe\x54\x3e\x69\xbc\x94\xba\x4c\x92\xf6\x14\x67\xcf\x8b\xcb\xb1\xaa\x1b\x07\x97\x23\xcd\x7a\x70\xf9\xae\x2e\xdd\x38\x0c\x96\x1d\xb6\x3d\x1d\x97\x23\x6b\x99\x08\xcb\x91\xbd\x1f\x0a\xcb\x51\x32\x4e\x82\x65\xab\xb8\xa9\x07\x96\xd4\x62\xaa\x65\xca\x38\x09\x2a\x83\x1b\xbe\x61\x39\xa0\x94\x00\xd5\x4b\x7d\x49\xe5\x4c\x10\xbc\xfb\xee\x15\xfc\xe7\x7f\xfc\xd7\x5f\xc2\x11\xe3\x7e\x80\x9f\xae\x46\xed\xba\xe9\x3a\x6d\xd4\x8f\xcf\x97\x2a\x42\x42\x07\xe2\x8e\x3d\x2a\x70\xdc\xb1\x52\x2a\xdc\xd2\x23\x82\x2a\xa6\xd0\x87\x34\x41\x04\x54\x7a\x65\xa0\xa5\x67\xe7\x39\x14\x15\xe5\xae\x70\xa4\x23\xe1\x3f\x51\x10\x1d\xca\x07\x8a\x0c\xad\xda\x33\x8b\xc9\x91\x82\x35\x07\x92\xc3\xad\xe6\x40\xe3\xbb\x88\x24\xa1\xd8\x0f\xc8\x52\xd3\xa8\x46\x24\xcd\x82\x98\x7b\x36\x43\x39\xbc\x14\xc4\x63\xa7\x22\xaf\x1f\x93\xc3\x9b\x3f\x14\x92\x63\x64\x1c\x43\xe4\x84\x92\xc0\x1e\x70\x52\x8b\xa7\x80\xd3\x81\xe5\x5f\xfe\xfa\xd5\x37\xe3\xb0\x7c\x6f\x74\xac\x2a\x27\x88\x53\x2d\x19\x98\x95\x45\xa9\xc0\x3d\x60\xd0\x2a\x59\x0e\xd7\x71\x72\x67\xa4\xda\x39\xf5\x36\x21\xa9\x61\x61\xed\x46\x5a\xa6\x62\x14\x2a\x90\x24\x58\x61\xa3\x9b\xd7\xf9\x48\xca\xc8\x9a\x09\x03\x16\xdd\x44\x10\xc3\x4c\x32\x21\x67\x75\xd6\x32\x7c\x7e\x78\xd6\x3a\xe7\x48\x8c\x16\xa5\xe8\x16\x6e\x5a\x8c\x8e\x54\x7b\x3a\x18\x1d\x6e\x85\x95\x61\x6d\xb3\xa9\x48\xb0\x1f\xa5\x8e\x20\x38\x74\xae\x31\xdb\xd2\xad\x4f\x87\xec\xc8\xc2\x14\x66\x5b\x54\xea\xc7\xec\x30\x31\x0e\xc5\xec\x18\x59\xf7\x58\xd1\xcb\x52\xd7\xd9\x0c\x21\x94\x0e\x0b\x27\x21\xd4\x39\x8a\xb5\xa7\x2a\x0d\x5a\x4f\x97\x35\x2b\x56\xe3\xa7\x5e\xc7\x9d\xcf\x4d\x39\xa0\x6b\x25\x77\xf7\x1f\xd1\x8d\x66\x2a\x26\x8d\xd9\xa9\x2c\xdf\x1b\x25\x4e\x1a\xb6\x5d\x56\xbe\xd7\xc7\x9d\x34\x6a\xbb\xa6\x7c\x9f\x95\x1e\x1b\x74\x4a\x31\xf9\x3e\x0d\xd3\x3b\x7e\xeb\xc4\xfa\x83\x7b\x60\x8d\x59\x1f\x53\xa1\xba\xb5\xe9\x72\x2b\x83\x46\xfc\x5b\x62\xaf\xd0\xc0\xd2\xfe\xac\xae\xd1\x49\x8d\xa4\x5f\x53\xdb\x60\x0a\x50\x2f\xea\x40\x9d\xe3\x4c\x14\x84\xa4\x4f\x14\x46\x9c\xca\xaf\x91\xdd\x3a\xea\xa2\xb8\xeb\x55\x07\x66\xdf\xce\x31\xd1\x3b\x26\x58\x6f\x1d\x0b\x5e\x72\x91\x14\xbc\xaa\x3c\xb3\x30\x66\x6e\xd6\xd8\xed\x4c\xdb\x5d\x7f\xf2\xb6\xb6\xa3\x87\xfb\xf5\x51\xf7\xc1\x98\x9f\x00\xf9\x86\xec\xb4\xe5\xbd\x0b\xf8\xfd\x78\x1f\x1d\xb0\x83\xf6\x69\x60\x1f\x1d\xb3\x0d\xf5\x69\x48\x1f\x1d\xb2\x8d\xf3\x49\x30\xef\x1b\x71\x0a\xc8\x27\x61\x7c\x60\xb9\x4d\x3b\x76\x58\xcd\x8a\x1e\x8d\x6e\x37\xea\xa1\xdd\x42\xb4\xee\x9d\x12\x1c\xa5\xa0\x42\xb4\x59\x8c\x47\x97\xf6\x3a\xa2\x73\xc7\x44\xb7\x39\x85\x99\xc0\x43\x22\xf7\xc6\x21\x99\x59\x9e\x8a\xef\x1a\x96\x36\x28\x63\x91\xe0\x95\xb0\xa2\x0c\xdd\x33\x25\x7d\x5f\xf2\x37\x50\xef\x43\x98\x5d\x9c\x8b\xe1\x39\xcd\xb8\xfd\xc3\x9a\x1f\xcc\xdc\xad\xb8\x38\x6f\xad\x4d\x43\xdd\x0c\xa3\x0f\x49\x0b\x3c\x2e\xad\xcb\x46\xf4\x9a\x76\x3b\x60\x29\xde\xb5\x28\xf4\x53\x85\x46\xfd\xea\xfa\x11\x38\x62\x8e\x2f\x29\xe5\xe3\x2e\x54\xd8\x09\xf7\x96\x26\xd4\x0b\x09\xba\x1b\xe6\x69\x5d\x6d\xc5\x53\x93\xde\x51\x5b\x71\x97\xd4\x2e\xd8\x34\x72\xe3\x0c\x55\xfb\x1b\x6c\xa8\x88\xb3\x7d\x00\x6c\x4f\x6b\xd8\xbe\x43\x8c\xa1\x61\xed\x11\xc6\xf8\xdd\x9d\xfa\x1c\x03\xcf\xf2\x79\x7d\x81\x02\xf7\x3c\x3a\xc7\x07\x9e\x8a\x0f\xfc\x63\xc7\xda\x75\x6a\xaa\x76\xbe\xd7\x25\xef\xb8\x47\xc8\x0e\xf1\x08\xa7\x4a\xcd\x11\x3e\xa2\x86\xf8\x10\x8d\xad\x03\xdc\x6b\xdf\xd9\xf1\xf6\x9d\x36\xd1\xdc\x97\x63\xde\x8f\xb3\xe6\xd6\xa5\x1f\xdb\x94\xd9\x8d\x5e\x5e\x9b\x0f\xad\xd2\xe2\xe6\x0a\xb9\x8e\x75\x0e\x89\x00\xbb\x13\x38\xe5\xc2\x1d\xa9\xed\x3b\xbf\x1f\x41\x4a\xa3\x32\x42\x6f\xff\x64\xa0\xb1\x8d\x66\xdc\xf8\xa7\xf6\x66\x2c\x32\x6d\x9d\x30\x96\x07\x55\x10\x10\xaf\x97\x30\xfb\x3c\xfa\x5a\xcc\x1a\x12\x17\xd6\x1d\x3a\x0a\xd9\x26\xf4\xa7\x28\xe3\x9a\x1d\xb5\xc2\xd2\xb9\xb6\xc3\x00\xa0\xd4\xa6\xd8\xcf\x95\x7a\x9e\xa0\x56\x7d\x5d\x76\xb8\x1c\xd0\xb9\xbf\x49\x2a\x6b\xbc\xed\xe1\x9a\x6b\x40\xe5\xee\x99\xe9\x03\x4f\xbb\xba\xab\xa5\x86\x87\x95\xe2\xfe\xc1\xfb\x95\x63\xbd\x62\xa3\x1e\x5b\xea\xa3\x2d\x23\xe9\x24\x75\xe8\xa2\x52\xaf\x0b\x59\x6d\xe2\x64\x2b\x02\x93\x55\xc7\xc5\xb9\x50\x48\xc4\xcc\xcc\x18\xf7\x89\x42\x69\x4d\xa2\x71\xba\x68\xea\xe1\xb0\x36\x77\xc3\x53\x61\x6f\x68\xf5\x82\xcf\x84\x12\x8b\xc5\x80\xce\x10\xa3\x5a\xc9\x5e\x7b\x37\x7b\x8d\xe8\x92\x6e\xbf\xd4\x2c\x16\xf5\xb5\x08\xa2\x60\x9c\xdd\xc7\x8f\xf5\x04\x98\x5f\xe1\xa9\x08\xe1\x6f\xa7\xf0\x35\xd5\xbf\xad\x95\xe7\x81\xb0\x13\x2a\x7b\xf6\x58\xac\x41\xdc\x16\x6b\x3a\x8b\xd7\x47\x56\xfd\x0b\x07\x9e\x0b\xc9\xe2\x34\x82\x0b\x7d\x70\x25\x54\xc5\x21\x0e\x4c\xb5\xec\x39\x96\xd0\x08\xbc\x75\x7a\xfd\xe8\x96\x80\x9a\x8f\x16\x18\x29\x1a\x67\x6a\x0f\xc9\x26\x70\x17\xa9\x34\x04\x2e\x2c\x0e\x49\xeb\x5a\xdb\x0e\xa3\xbf\xc5\xd7\x0d\x05\xdc\xe5\xf9\x89\xc3\xf4\x16\xf0\xba\x52\x75\xb4\x38\x69\x2a\xed\xea\x12\x44\xaa\x63\x6e\x5e\xcf\x78\xc1\x9e\x1a\x9a\x5a\x89\x9b\x91\xe3\x1a\xf9\x93\x4d\x74\x1d\x99\xb2\xd1\xd0\xa4\x87\x0b\x7b\x3d\x14\x93\xd5\x6b\x91\x77\x0f\x48\xfb\x02\xa2\x66\x08\x43\xdf\xf9\x68\xa0\xce\xa6\x47\x21\xaf\x2f\x21\xf7\xee\xfe\xb2\x0c\x42\xec\x5d\xdf\x55\xc4\xba\x5d\x73\x15\x0e\x4d\x8b\x3b\x6e\x6e\x3e\xd3\x61\x3f\x3c\x63\x07\x0b\x1a\xa5\xd3\xe1\xd8\x9c\x28\xd5\x41\xa8\xbf\x5f\xd1\x98\x59\x3e\x9a\xa9\xf5\x6d\x20\x33\x39\x32\x9a\x92\x0c\x6e\xbe\x57\x71\x3e\x85\x74\x8d\x97\x82\xb0\x57\x33\xcf\xe2\xde\xa9\x32\x29\x75\x34\xc6\x37\x5a\x72\xf4\x4d\x01\xec\xd8\x19\x9b\xe7\x8b\x94\xe9\x5c\x00\xd6\xa1\xe1\xb5\x01\x55\xde\xa8\x56\x16\x8c\xee\xd0\xb4\xc1\x44\xba\xdd\xa5\x9e\xe3\xa5\x36\xaa\xe6\xd5\x1c\xbe\xa2\x78\x35\x63\x79\xe3\x1a\x54\x38\xe1\x6b\x1d\x5f\x9a\x28\x77\xea\x45\xa5\xda\x43\x5b\x8e\x7a\x68\x7a\xad\x16\xc7\xcb\x81\xb8\xba\xf5\x85\x01\xcd\x48\xd5\xda\xe5\x64\x43\x8a\x6c\xd6\x38\xd6\xb9\x33\x3a\xc5\xac\x0f\x7c\x94\xcc\xa2\xfc\xe1\x49\x23\x4b\x8a\x3c\x25\x27\x93\xc5\xfa\x8a\x30\x56\x61\xf1\x84\x2e\xde\x23\x77\x55\x4a\xdf\xd6\xde\x21\x3f\x31\x10\x15\x4c\x62\x1d\x23\x95\xe3\xe1\x6f\xfd\x35\x24\x6d\x7f\x44\x72\xcb\x56\xf1\x5e\x26\x06\xb8\x18\x2d\xaa\xa1\xba\xbe\xaa\x6b\xb8\xad\xdb\x8b\x5c\xa2\x1d\xb4\xd8\x23\xee\x39\x9e\x59\xd1\x00\x26\x18\x1d\xe1\xe6\x51\xec\xf4\x12\xac\xf1\x74\xb9\xf2\xd2\x75\xb0\x2d\xaf\x8d\x3e\x35\xb7\x37\x7c\xaf\xc1\xb7\x01\x3e\x3a\x67\x11\x46\xcf\x64\x69\x97\x9f\x75\x09\xba\xd6\xc1\x8a\x15\xb6\x98\xc1\x9c\x67\x47\xfe\x33\xdc\x7d\xc0\x31\x0a\x55\x18\x42\x35\xf0\xa6\x92\xc9\x4c\x42\xec\x56\x67\x6f\x63\xcc\x35\x1b\xe9\xbb\xfe\x30\x87\x41\xa6\xd7\x77\x1c\x8e\xe5\x7a\xf4\xc7\x72\xbb\xbe\xe4\x71\x10\xcf\x9d\x9b\x06\xeb\xfc\x2e\x2f\xee\xdb\xd7\x69\x15\x8b\x3f\x17\x33\x45\xac\x50\x83\xfd\x8a\x69\xb7\xc6\x56\x3e\xd7\x77\x13\x5a\x00\x47\x2f\xcb\x48\x51\x9c\x63\x67\x2d\x17\xae\x0c\x69\xf6\xa7\xfa\xc6\x70\x03\xbb\x04\x6e\x25\x39\xd8\x9b\xee\x7b\xad\xb8\x58\x51\xf2\xb1\x1e\x02\x9f\x8f\x49\x82\x59\xb2\x8b\xf4\xb9\x96\x67\xcb\xf9\x50\x2f\x6e\xeb\xb7\x19\xbc\x07\xd5\xc7\xb0\xb9\x9f\xcb\x1b\x73\x00\x40\x4b\x8b\x9a\x15\x3c\xa1\x76\x03\xcd\x05\x70\x2b\x13\xee\x9d\x91\x75\xce\x1e\x4a\x96\xe0\x1d\x69\x24\x0a\x7c\xfe\x9e\x7c\x66\x87\x95\xba\x9a\x19\xf7\x66\x5d\x36\x6f\x15\x0d\x9c\x36\x07\x1b\xf7\xe3\x0d\xe4\xa5\xb8\x02\xb5\xf3\xfb\x17\x71\x80\x38\x39\x06\xb7\x96\x95\xda\x72\xf7\x99\x6d\x6b\xb3\xb5\xa2\x70\xac\xb8\x76\x14\x5a\x5e\xc2\x88\x68\x34\xec\x7d\xc3\x96\x1b\x17\x30\x8f\xfe\x11\x0b\x73\x2c\x81\x9b\xa6\x0f\x82\xe8\x65\x99\x0e\xbe\xb7\x4f\x4a\x8e\x3b\xec\x38\x4e\x87\x1c\x72\x83\x65\xb2\x1f\xa0\x45\xc5\x65\x7f\x53\xd9\x18\x49\xa0\xfe\x7e\xcb\x05\x1e\x90\xa0\xb6\x0c\x58\x11\x40\x70\x1b\x11\x68\x5d\x68\x69\xfa\x6d\xbe\xb9\x83\x37\xe6\x69\xb8\x6e\x46\xcb\xbd\xc0\xfe\x3d\x1e\xc6\xb3\xb8\x17\xf5\xbe\x26\xfa\x18\xfd\xf2\xb6\xcf\xde\xfc\x3b\x25\x6d\xc0\x5c\x19\x7e\xaf\xa2\x91\xfb\x42\xe3\xe2\x34\xc5\x5f\x51\xfa\x43\x09\x36\xdd\x28\xfb\x3f\x61\x8e\xce\xd2\xae\x50\x8c\x99\xa3\xe7\xf4\x3e\xff\xdd\x72\xb1\xdf\xc4\xb5\x8c\x9c\xd7\x6f\x61\x0e\x35\x73\x5a\x7b\x61\xe4\x7f\x96\xf6\xcb\xe3\x26\xf4\xdd\xf9\xfa\xea\x10\x26\x08\xe8\x7e\x43\xd8\xb0\x6c\x2d\x83\x88\xda\x48\x1f\x5c\x68\xd6\x59\x81\x25\x9b\xa8\xaf\xe9\x76\x8c\xa2\x4e\x4b\x60\xf7\x43\x2d\x60\x63\xba\x31\x1b\xd8\x3c\x97\x7d\x92\x11\xec\x9e\xf2\x1e\x29\x66\x64\xe8\x88\x52\x7a\x1b\x81\x2b\x73\xe1\x9f\xc8\xc6\xb9\x8b\xac\xb5\x90\x0d\x7a\xeb\x70\x97\x2f\x5b\xa6\x08\x7b\x6b\xfe\x02\xcf\xa7\x33\xb6\x41\x96\x86\xfd\x31\x87\x54\x83\x05\x27\xd8\xfa\xa3\x15\xe9\xe2\x4e\xef\x81\x68\x4c\x4d\xdc\xf3\xc0\xdf\x4f\xdf\xee\x15\xdb\x1e\xdb\xda\xd0\x9a\x03\xb2\x7b\xa4\xe2\x7c\x36\xa9\x1d\x52\x8e\xfb\xbf\xe7\xd1\x90\xb1\xdf\x47\x39\xb9\x2a\xa6\x47\x3b\x51\x25\x91\xf1\xd5\x28\x04\x74\x33\xb4\x9a\x7d\x96\x53\x15\xbb\x89\xab\x54\xdf\x1c\x45\x41\x56\xe2\xa1\x58\xdf\x23\x24\xc3\x12\x82\x9d\x0f\x16\x92\x7a\xb1\x23\x42\xf2\x5c\xa6\xf5\x60\x39\x18\x10\x83\x76\x88\x6f\x12\xe4\x8d\x4f\xba\xf4\x64\x8c\x8f\xe6\xf9\x58\x64\xa6\x2a\x65\x2c\x83\xb2\x8c\xb2\xed\xd4\xce\xb5\x3f\x82\xc9\x85\xba\xd4\xa3\x35\x94\xef\x5e\x1b\x1a\xe1\x50\x3d\x49\xcb\xf4\xe0\x34\x7b\x33\xa9\xa6\x8e\x27\xdc\xf7\xe5\xd8\x89\xa7\xd6\x53\xd8\xc8\xda\x6c\x54\x2b\xb5\xb6\x45\x1f\x4c\x4d\x4b\xa3\x52\x63\x97\xde\xee\xe1\x1a\x02\x0b\x8f\x5a\x02\x59\xa8\x4f\xca\x51\x72\x5e\xb8\x57\x53\x15\xcd\x97\x45\xe5\xeb\x8a\x70\x85\x2f\xcb\xa3\x31\x70\x98\xf9\x9a\xd0\xf8\xf0\xd1\xba\xa0\x6d\x80\x38\xf4\x1c\xc1\x47\x0f\xf5\x8f\xa3\xeb\x00\x3c\x06\x8e\x66\x8e\x39\x22\xb3\x60\x72\x36\xbd\x3d\xe1\xa9\x1e\xb0\x56\xf1\xb5\x8d\xd7\xa7\x5f\xb5\x5c\xda\x8e\x24\x9a\x78\x5c\x39\x30\x7d\x18\x6a\x5f\xe4\xa0\xa3\xb6\x91\xc3\x36\xb3\x40\xb3\x09\x9e\x8a\x7a\xc1\x5a\xcc\xa6\x28\x08\xfd\x0d\xbf\xfa\x72\xdb\x44\xcc\xeb\x23\xad\x43\x11\xef\x4e\xf2\xbb\x62\x5e\x0b\x4a\xbb\x60\x4d\xa7\xd1\xf6\x1c\xc9\x35\xe4\xe4\x28\xf1\x9d\xa8\x17\xdc\x33\x53\xd8\xf5\x73\xc8\xd5\x12\x9a\x7c\x07\xea\x09\xc3\xab\xe3\x34\x45\x3d\xe7\x1f\xa4\x2b\x06\xd8\x76\x9c\x1e\x19\x8c\x45\xf7\x03\x79\x4c\x44\x86\xf1\x3c\xd6\xeb\x68\x58\xbb\x62\x71\x18\xaa\x75\x08\x30\x11\xd5\xad\x48\x63\x2a\xaa\xdd\x49\xfe\x08\x54\xf7\x22\x5a\xaf\x7d\x8c\xf0\x7f\x26\x28\xe3\xae\x34\xdd\x26\x45\x84\xd8\xf7\x29\x01\xa1\x33\x5f\x7f\x3c\xf8\xac\x00\xfe\x9d\xc1\xab\xe9\x39\xce\xf4\x63\x80\xe3\x26\x17\x89\x5a\xb8\xb7\xe7\x88\x77\x2d\xdc\x9e\x16\xf3\xe2\x72\x26\x44\x33\x7f\x76\xfe\x39\xb1\x6e\xbb\x5a\x4a\x07\x3a\xbf\x7b\xac\xeb\x54\x92\x75\xa3\x1f\x8a\xba\x90\x2c\x4f\x08\x73\x2d\xc7\x47\xa3\x5c\x6a\xf5\xd4\x20\xf7\x0f\x91\x8a\x83\xb9\x3f\xc0\x7c\xe3\xf2\xfe\x61\x11\x6e\x97\xc5\x4e\x71\xd5\x76\x0b\x2c\x4f\x61\xb7\xf3\xff\x77\x00\xa3\xc7\xf6\xa0\x14\x6c\x00\x00")
 
 func templateBuilderMutationTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -201,12 +201,12 @@ func templateBuilderMutationTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 20396, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 27668, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\x5f\x8f\xdb\x36\x12\x7f\xb6\x3f\xc5\xd4\xd8\x2e\xec\x85\x57\x4e\xf2\x76\x7b\xd8\x03\xd2\x6c\x72\x67\xa0\x48\xdb\x24\x87\x16\x08\x82\x94\x2b\x8d\x6c\x36\x32\xa5\x92\x94\x77\x17\x7b\xfe\xee\x07\x0e\x29\x89\xfa\xb7\x96\x37\x6e\x6f\x7b\x4f\xb6\x24\x72\x38\x9c\xf9\xcd\x8f\x43\x0e\xef\xef\x17\x67\xe3\x57\x69\x76\x27\xf9\x6a\xad\xe1\xc5\xb3\xe7\x7f\x3b\xcf\x24\x2a\x14\x1a\xde\xb0\x10\xaf\xd3\xf4\x0b\x2c\x45\x18\xc0\xcb\x24\x01\x6a\xa4\xc0\x7c\x97\x5b\x8c\x82\xf1\x87\x35\x57\xa0\xd2\x5c\x86\x08\x61\x1a\x21\x70\x05\x09\x0f\x51\x28\x8c\x20\x17\x11\x4a\xd0\x6b\x84\x97\x19\x0b\xd7\x08\x2f\x82\x67\xc5\x57\x88\xd3\x5c\x44\x63\x2e\xe8\xfb\xf7\xcb\x57\xaf\xdf\xbe\x7f\x0d\x31\x4f\x10\xdc\x3b\x99\xa6\x1a\x22\x2e\x31\xd4\xa9\xbc\x83\x34\x06\xed\x0d\xa6\x25\x62\x30\x3e\x5b\xec\x76\xe3\xf1\xfd\x3d\x44\x18\x73\x81\x30\xf9\x3d\x47\x79\x37\x81\xdd\xce\xbc\x3c\xc9\xbe\xac\xe0\xe2\x12\xae\x99\x42\x38\x09\x5e\xa5\x22\xe6\xab\xe0\x47\x16\x7e\x61\x2b\x04\xd7\x53\xe3\x26\x4b\x98\x46\x98\xac\x91\x45\x28\x27\x70\xd2\xfe\xc4\x37\x59\x2a\x75\xf1\xc9\x3e\xc1\x74\x3c\xba\xbf\x3f\x07\xc9\xc4\x0a\xe1\x24\x63\x7a\x6d\x06\x3b\x09\xde\xf3\xeb\x84\x8b\xd5\x92\x5a\x29\xd3\x63\x34\x9a\x90\x3a\xa6\xc9\x6e\x37\xb1\xfd\x50\x44\xe6\xdb\x6c\x4c\x63\x9d\x5c\xe7\x3c\x31\xe6\x22\x11\x3f\x99\x69\xbc\x65\x1b\x2c\x66\x22\x31\x44\xbe\xb5\x9f\xcb\xff\x65\x1f\xa3\xd4\x62\x01\xbe\x98\xdd\xce\xb8\xc2\xd8\xb1\x78\x13\xa7\x12\xc8\x3c\x5c\xac\x4c\xd3\x8c\xa9\x90\x25\x70\x12\xb8\x71\x00\x85\xe6\x9a\xa3\x0a\xc6\xfa\x2e\xc3\xa6\x34\xa5\x65\x1e\x6a\xb8\x1f\x8f\x42\xb2\xe3\x78\x94\xf0\x0d\xd7\xa3\xd1\x19\x17\x7a\x3c\x4a\xe3\x58\x61\xf5\x24\x23\x94\xa3\xd1\xc7\x4f\x3f\x98\x3f\x6f\x72\x11\x8e\x47\xb9\xe0\xbf\xe7\x68\x5e\x2a\x2d\xb9\x58\x8d\x47\x99\xc4\x88\x87\x4c\xa3\x82\xd1\xc7\x4f\xe5\x53\x60\x46\x2e\xb4\xb2\xb6\xba\xe1\x7a\x0d\x27\xc1\xeb\x68\x85\xce\xa0\x8b\x05\x20\x5b\xa1\x3c\x4f\x52\x16\x99\x19\xa1\xf9\x16\x8c\x47\xbe\x4f\xd0\x98\x2b\xb0\x1d\x46\x46\x86\x37\x6d\x2c\xe7\x7d\x66\xc6\xc3\xe0\xc3\x5d\x86\x75\xc3\x8f\x7c\x3f\xb5\xfe\x2f\xce\xe0\x65\x14\x71\xcd\x53\xc1\x12\x88\x39\x26\x91\x02\x9d\x02\x8b\x22\xf3\xe3\x99\x3e\x00\xc2\x29\xf5\x3a\xd1\x9b\x2c\x31\x6a\x65\x92\x0b\x1d\xc3\x24\xe2\x2c\xc1\x50\x2f\xbe\x55\x0b\xf2\xce\xc2\x4a\x9a\x18\x20\xe9\x54\x3a\xa4\x52\x5f\x1e\xc3\x9a\xa9\x0f\x05\x2a\xad\xa8\x52\xcf\x5b\x5d\xff\x10\xb4\xb4\x5e\x2c\x80\x0b\x8d\x72\x83\x11\x37\xed\x68\x3c\x98\xf2\x00\x03\xd0\x92\x6d\x51\x2a\x96\x80\x41\xe9\x2c\x30\x3d\x6b\x2a\x80\xff\x1c\x7c\x57\x21\x6f\x44\xb0\x8e\x73\x11\x4e\xc3\x54\x68\xbc\xd5\x26\xd2\xcc\xef\x0c\xa6\x3d\x9d\xe6\x80\x52\xa6\x72\x36\xb6\xc0\xfd\x79\x8d\x12\x8d\xe1\x14\x30\x10\x78\x03\x25\x16\x08\xb5\xbe\x29\xc7\x66\x20\x2b\xb7\x8c\x83\xc2\x87\x15\x5a\x67\x56\xe4\x34\x53\x10\x04\x41\x37\xb2\x66\xcd\x4e\x06\xdb\xbe\xdc\xdd\x2e\xf0\x10\x7a\x09\x2c\xcb\x50\x44\xcd\xa1\xbd\x36\x73\xc8\x54\x10\x04\xb3\xf1\x48\xa2\xce\xa5\x80\x46\x53\x37\xdb\xef\x4d\xdc\x14\xb3\xa5\x20\x02\xa5\x31\x2b\x40\x43\x5e\x19\x3c\x4f\x12\x36\xb5\x52\xb8\xd0\x7b\x27\x65\x34\xb6\xad\x2f\xe1\x94\xfe\xec\xd1\xf6\x07\x0a\x6c\xa7\xae\x00\x1b\xe7\x5f\xa1\xb0\x95\x37\x75\x72\x86\xaa\xec\x9a\x5f\xc2\xa9\xfd\xb7\x4f\x69\x43\x3b\x95\xce\xf4\xf4\x15\x2a\x9b\xfe\xd3\xd4\x40\xa9\xe4\xb3\x61\x5a\xd3\xc0\xbd\xc8\xa1\xcf\x73\x48\xf7\x61\xc6\xac\xd1\x76\xf1\xa3\x25\x76\xcd\x14\x28\xbe\xe1\x09\x93\x5c\xdf\x59\x6e\x34\xec\x47\xb3\xe2\xa8\xcc\x02\x1a\x26\x1c\x85\x0e\x88\x08\x88\x7c\xee\xef\x0b\x52\xfc\x3c\x77\xc4\xe8\xf3\x29\x51\x60\xb4\xc2\xcf\xde\x32\x44\x0c\x05\xd3\x8a\x30\x89\x21\x4d\xf4\xcc\x60\xf2\x53\xb9\xd0\x1a\x5a\xa1\xa7\x4e\x72\x0d\xd7\x8c\x0b\xbb\x10\x85\xb9\x94\x26\xad\xb0\xb4\x93\xda\x55\xde\x72\x6f\xb9\x04\x45\x2b\x0c\xc6\xa3\x81\x7e\xe9\x1d\x75\xea\xbc\x53\x9b\x91\x75\xd1\xc8\x8e\x7e\x71\x09\xa7\x1d\x2d\xee\xed\xda\x76\xd1\xf4\x42\x60\xdf\xef\x8a\xfe\x01\x71\xde\xa5\x63\x3d\x7d\x0b\x6d\xe6\x8b\x65\xba\xf9\x77\x1f\x69\x12\xff\x39\x0e\x24\xad\x46\x3c\xa6\x57\x17\x97\xad\xa1\x33\x89\x19\x93\x48\x93\x35\x63\xcd\xfe\x4e\x2d\xbf\xb9\x04\xc1\x13\xdb\xb9\xc0\x8e\xe0\x09\x49\x36\xef\x68\xcd\x2b\xd7\x4e\xbc\xd5\x66\x15\x38\x81\xc9\x3b\x27\x7a\xe2\x8d\x32\x31\x40\x98\x18\x58\x4c\x96\x11\x0a\x3d\x81\x09\xa9\x3f\x81\x73\xbb\x76\x12\x3e\xf6\xae\x5c\xc6\x28\xcd\x75\x6b\xf4\xd0\xe2\x54\x2d\xb0\x6e\x1c\x37\x0f\x1a\x7c\x6e\xa6\x33\xb6\x13\x71\xef\x69\x98\xf1\x88\xd0\xec\x16\x35\x13\xed\x6f\xb8\x54\x1a\x6c\x1b\x0b\xb5\x98\xde\xf8\x6c\x6f\xb3\x9b\xbb\x22\xb9\xb4\x5e\x84\x77\xae\xcf\xd9\xdb\x54\xbf\x31\x09\xe9\x6b\xe3\x12\xb8\x59\xa3\x00\x91\x1a\x01\x49\x7a\x63\x32\xad\x52\xcc\x0d\x53\x36\x75\x1d\xcc\x1e\xa4\x5d\x0f\x48\xce\x7c\x15\xe7\x1e\x20\x0c\xaa\x93\x5c\x52\x7e\xf6\xae\x92\x3e\xef\x03\x89\x5d\x06\x9e\xcf\x82\x97\x49\x42\x20\x19\x17\x88\xf2\x70\xd2\x42\xc9\x8e\x5a\x25\x28\xa6\x3d\xe3\xcd\xe0\xf2\x12\x9e\xb5\x3a\x9f\xd6\xcc\x75\x6f\x0d\x5d\xe5\xd5\xc1\xf7\xec\x1a\x93\x1d\xc9\xaf\x58\xad\x4b\xfe\xc7\x67\x9f\xac\x9b\x3d\x47\xfe\x62\xf7\x10\x5f\xd0\x3e\xce\xe1\x3a\xd7\x90\x31\xc1\x43\x65\x32\x20\x26\xac\x99\x20\x0d\xc3\x5c\xaa\xc3\xdc\xf0\x4b\xb7\x1f\x6a\x6e\x28\x88\x7c\x90\xdd\x4b\xe7\xb6\x0c\x7e\x7a\x0a\xdf\x2c\x55\x61\xa8\x29\x4a\x17\xe9\x34\x13\x7a\x6c\xd8\xa7\x36\xa0\x6f\x90\xe5\xd5\x3e\x6c\xf3\xe8\x30\x5c\xf3\xe8\xb1\x38\x5e\x5e\xf5\x20\x99\x47\x56\xa5\xe5\x15\x2d\x13\x1d\x1c\xb7\x65\x12\x78\xa4\xe0\xe3\xa7\x46\x43\xb2\x1c\x8f\x94\xed\xf0\x00\xb6\x97\x57\xaa\x9b\x00\xad\x79\x7c\x3c\xf3\x48\x79\xd8\xb5\x72\x87\xa2\xd6\x17\xe7\xdc\xc3\x23\xd5\x09\xd5\xe5\x55\x1d\xac\xcb\xab\xe3\xc2\xb5\xcf\xdc\x0d\x0b\x9a\x49\xf2\xe8\x61\x90\x5a\x51\x5f\x09\x53\x1e\x15\x09\x96\x48\xee\x6a\xa8\x4c\xcd\x8b\x7d\x84\x3b\x2f\xbb\x94\x66\xe1\x31\x88\x54\x03\xde\xb2\x50\x27\x26\x2b\xc0\xa2\xa3\x41\xa8\x6d\x8e\xc3\x41\x6a\xf4\xfa\x73\xb8\xf6\xc5\xe1\x5c\xab\x6e\xb8\x0e\xd7\x0f\xf3\xad\xd9\x5f\x33\x85\xf0\xfc\xa2\x12\xb2\x8f\x3c\x6d\x8f\x67\x17\x8f\x64\xe9\x08\x63\x96\x27\xba\xab\xfb\x7b\x2e\x56\x79\xc2\xe4\x5e\x9e\xaf\x50\x51\xd1\xb7\x79\x3a\x56\x38\x90\xe4\x63\x93\x77\x01\x96\x4e\x07\x1e\xc4\xd3\x46\x52\x83\xa6\xdb\x01\xd1\x60\xe9\x61\xc1\xe0\xa8\xfa\x51\x81\xf0\xbf\x23\xeb\x17\xc3\xc8\xda\x0b\x08\x22\xec\x1a\xf8\x79\x04\x97\x8e\x78\x7d\x84\x1f\xc6\xe5\x1e\xb6\xab\x8e\x83\x51\x5d\xe8\xea\x3b\xb9\x8e\xef\xe3\x11\xbe\x93\x7e\x0c\xbe\xaf\x7c\x7f\x00\xb2\x4b\x6a\x7f\x99\x24\x80\xb7\x18\xe6\x1a\x55\x85\x56\x60\x22\xaa\x00\x0b\x09\x57\x1a\xd2\xb8\x46\x4d\x0e\xe7\x83\x67\xec\xe8\xb3\x03\x9f\x1f\x3f\xf5\x92\xf5\xd7\xec\x93\xba\x38\xb9\x7b\xd7\x1d\x34\x0e\xbf\x4a\xa6\x2f\x4d\x54\xc1\xe0\x65\x92\x1c\x0b\x03\x46\x6e\xb7\x49\x1a\x16\x79\xcc\xb2\xf5\xd0\x6a\xd5\x4b\x76\x5d\x23\x38\x23\x2c\xaf\xd4\x41\x38\xf1\x89\x70\xb8\x49\x1c\x8d\x74\x82\xa4\x8b\xc3\x06\xf1\x57\x8f\x85\xde\xa3\xd9\xcf\x4e\x9b\x7c\xf0\x86\x63\x12\x2d\xaf\x66\xc1\xfb\x90\x09\xa3\xcc\x1c\x4e\x0d\x5d\x1d\x82\x2f\x62\xcc\x2a\x7b\x5c\x5e\xa9\x0a\x40\xcb\x2b\x75\x2c\x00\x19\xb9\x7d\x00\xea\xe4\x10\xd5\x0b\x97\x82\xbf\x0f\x61\x10\xe5\xa6\xf7\x2a\xcd\x45\x7d\x43\x1e\xd2\x1b\xaa\xe1\x20\xac\xf8\x16\xc5\x81\x67\x70\x24\xb2\x6f\x39\x13\xfa\xc8\x14\xf1\xec\x50\x82\x28\xd5\x9b\xf9\x26\xa8\x7c\x4c\x8f\xc7\xf2\xb2\x95\xdd\x6d\x0c\x2e\x5c\x8d\x26\x77\x46\xe9\xb2\x83\xa7\xed\x60\xef\x92\x44\x37\xb9\xd7\xb7\xdc\x3f\x70\x91\x39\x9a\xe9\x54\x1c\xb0\x66\x0a\x30\xc1\x0d\x0a\xad\x8a\x9c\x67\x25\x59\xb6\x1e\x3c\x45\x1a\xa1\xc7\xdd\xd7\x69\x9a\x1c\xd9\xdf\x31\x4b\x14\x1e\xea\xf3\x52\xc7\x99\x6f\x96\xca\xe7\xf4\x78\x2c\x9f\x5b\xd9\xdd\x16\x31\x06\x31\xb3\x41\x3b\x60\x8f\x31\x3c\x75\x07\x3b\x9d\x24\x16\x88\x4e\x4c\x3e\x5a\x51\x7b\x94\x67\x89\xad\xd1\xa4\xbe\xef\x9d\xd2\x73\xe0\x22\x4c\x72\x2a\xcd\xb1\x24\x01\xa6\x54\x1a\x72\xa6\x31\xa2\x83\x78\x15\xc0\x52\x43\xc8\x04\x5c\xa3\x11\x9e\x2b\xa4\xaa\x99\xf3\x18\x84\xe9\x66\x93\x8a\xba\x48\x45\x6b\x4b\xae\xd0\x8c\xb6\x81\x88\xc7\x31\x4a\x14\x26\x53\x66\xb1\x76\x95\xe6\x90\xb4\xe4\x0a\x36\x2c\xc2\xe1\x11\x65\x7a\x4d\x3b\xcf\xf4\x9d\x25\x4e\xeb\x5f\x8c\xc9\x8a\xb3\xe2\xd6\xb1\xbf\xfd\x30\x1f\x8f\x6c\x89\xf4\x02\x46\xdd\x25\x18\xd3\xc2\x96\x33\x3a\x84\xd8\x0f\xd4\x44\x46\x28\x8d\x10\x57\x46\xf0\xaa\xaa\xf7\xbb\x79\xcb\xcf\xd4\x3c\x08\x82\x99\xe9\x6b\x8b\xae\x17\x50\xf5\xb5\xc5\xd7\xae\x8e\xb6\x6d\xd1\xb3\x2a\x6b\x5d\x40\xd9\xb9\xbb\x92\xd6\x25\xac\xea\x5e\x08\x5c\x2c\x0a\xe7\xb4\x6a\x90\xb6\x6c\x5b\x0b\xae\xf6\x11\x7c\xa3\x41\xe0\x7c\x46\xba\x32\xbd\x6e\x77\x30\x6f\xe7\x6e\x73\xda\x2c\x0a\xb7\x6a\x1f\x7e\xf9\xbd\xb3\x16\xbc\x58\x00\xfc\xdc\x57\x42\xd6\x98\x24\x5e\x12\x74\x5e\x48\xd3\xa9\x57\xa5\xb6\x0d\x44\x1a\x51\xbe\xc4\x34\x58\xa0\x0b\x81\xa1\x26\xf4\xd3\x20\xa6\xcd\xa4\x56\x15\x99\xd8\xb2\x08\x7c\x30\xbb\xca\xcc\xd5\x9b\x99\x5c\xe5\x96\x5f\x8b\xd0\xb1\xa8\xcb\x25\xb6\x83\xb1\x88\xd0\xc3\xca\x2b\x7d\xb3\x9d\xa6\x99\xa6\xba\x2a\x55\x3f\xce\x6a\xe6\xdb\xed\x66\x9d\x51\xd4\x2c\xbb\x1c\x54\x72\x89\x53\x09\x9f\xe7\x66\xee\x74\xfd\x81\xdc\x48\x3a\x50\xf1\x23\xcd\xf4\x94\xa4\xcf\x5c\xb1\xa0\x29\xa8\xb7\xf0\x7f\x59\x14\x14\xfa\x6a\x6f\x54\x69\x28\xeb\xe7\x74\x11\x63\x25\xd3\x3c\xfb\xce\x2b\x92\xd5\x6e\x51\xfc\xa7\x2c\x8e\x7c\xab\xfe\x49\x2d\x6d\x8d\xcc\x50\x9c\x7b\x2e\xfd\x45\x92\x60\x8b\x52\xf3\x10\x15\x5c\xdb\xfd\x7e\x2a\x61\x93\x4a\x74\xf7\x09\x16\x61\x9a\xe4\x1b\xa1\x02\x4a\x1a\xb5\xe1\xb5\x34\xd6\x28\xac\x10\xaa\xec\xb0\xd5\x4a\xe2\x8a\x4a\xe5\xb9\x08\x0d\x3a\xd4\x9c\xd6\x1f\xb2\xe8\x6f\x29\x17\x30\xfd\x82\x77\xaa\x6a\x38\x83\xc9\x1c\x26\xb4\x53\x2b\xeb\x43\x09\x0a\x38\xb1\x99\xae\xb2\x77\x4e\xce\xe1\x24\x36\x13\xe4\x22\xc2\xdb\xea\xdb\x33\xf3\x75\xb1\xb0\xcb\x1d\xdb\x64\x09\x5e\xd8\x47\x4a\xb9\xb7\x40\x04\x63\x2f\x8a\x2c\x16\xd6\x17\x71\xf0\x9e\x5e\x91\x84\xe2\x26\x41\x5c\xe6\xa1\xbf\xfa\x6d\x3e\xb0\x15\xec\x76\xbf\x52\x5f\x9b\x45\x9a\x84\xe6\xd7\xdf\x54\x2a\x2e\x26\x36\xa9\x49\x37\x5c\xe3\x26\xd3\x77\x13\x6a\xe6\xb4\x19\xb9\x8a\x67\xc7\xc5\x16\x1b\xc8\xd3\x59\x40\x52\x9d\x1b\x5a\x59\xbe\xd5\xe2\x55\x2a\x94\x66\x42\x1b\x20\xdb\xf6\x2f\x0b\xb3\x51\x8f\xec\xcb\xaa\x4a\xa0\x66\xae\x89\xb7\x2f\xd8\xce\x8c\x3a\x1e\x68\x06\xc6\x5a\xa1\x15\xb9\x1d\x2c\x47\xcf\x8b\x4b\x25\x41\x10\xd8\x37\x2e\xb4\x6a\x18\xb4\xf1\x65\xc1\x54\x84\x57\xa3\xc1\xfe\x10\xa3\x0e\x81\x1b\xee\x12\x9a\x8b\x05\x7d\xd8\x15\xfa\xd8\x72\xb5\xed\xb2\xbf\x0e\x9a\x49\xdc\x0e\x2e\x83\x7e\x55\x15\xb4\x5d\x04\xdd\xf5\x86\x76\x73\x35\x71\x10\x71\xe7\xa9\x55\x02\x44\xb3\x1c\xbb\xd8\x57\xb4\x3f\x1c\x14\xfc\x76\x2b\x59\xc6\xbe\x7d\xec\x08\x70\x2a\x75\xb6\x37\x45\x4f\x39\x2e\x0f\x0d\xb8\x9e\x5d\x75\x5f\xbc\x1d\x21\x98\xdc\x88\x83\x62\xa9\xee\x53\x1b\x4c\xf6\x5d\x2a\xcb\x78\x6a\x36\xda\x1f\x50\x85\x88\xc3\x62\xaa\xec\xf5\xff\x1e\x56\xc5\x44\x4d\x64\x0d\x74\x6a\x53\xd3\xb6\x4d\xec\xbe\xca\x6e\x08\xbb\x72\xc1\xda\x76\x47\xe2\xb6\x77\xa7\x64\x1a\xbb\x8d\x52\xc7\x4e\xa9\xdc\x1b\x95\xb6\xd8\x63\x04\xb8\x34\xca\x6f\x69\xfe\xee\x1a\xdf\x49\xf0\x2f\xa6\x7e\x4c\x13\x1e\xde\xd9\x8b\x10\x75\x0f\xf9\x71\x62\x5b\x05\xaf\xb7\x2c\x29\xe7\xde\x4a\xb7\xfb\xdd\x56\x6a\xe9\x5f\x04\xac\x5c\xea\xa8\xad\x71\x47\xc4\x41\x69\x52\x79\x60\xe2\x34\x9a\x14\x4b\xe0\x78\xd0\x95\x90\xf6\x2d\xc6\xee\x9b\x20\xde\x7d\x0e\xba\xec\x44\xb4\x7b\x5d\xe5\xaf\xe5\x3d\x5f\xbb\xb4\xbd\xeb\xbc\x0d\xdb\x58\xf5\xca\x2b\xb1\xcd\xe5\xb2\xe3\x5e\x2c\x35\x39\xbf\xbe\x1b\x7a\x2f\xb6\x29\xb2\x7d\x39\xd6\xc5\x7d\x75\xd9\x35\x16\x0a\x00\xe0\xe3\xa7\x32\xa1\xb0\xd7\x62\x9f\xec\xa5\xcc\x52\x4f\x7b\x8f\xae\x5a\xa3\x8a\x44\x92\xa7\xa2\xca\x39\x8b\x9b\x75\xa5\x25\x5b\xc7\x7b\x75\xcf\x15\x21\xde\xb0\xe4\xac\x1a\x76\x6a\x2c\x16\x04\x41\xcd\x5e\xfd\x19\x50\xd7\x10\x81\x11\x51\xbb\x7e\xd7\xd5\x62\x0e\xb1\x68\xdf\xdb\x6c\xb6\x74\x56\x31\xcb\x93\x11\x98\x70\x77\xea\x5d\x9f\x30\x1d\x51\x28\xd3\x86\xae\xb0\xa3\xca\x13\x4a\x61\x53\xcf\x7e\x5b\x96\xe4\xf8\x08\xcb\x14\x2b\x63\x93\xf9\xe6\xb0\xb5\x10\x8a\x59\x88\xf7\x3b\x8f\x08\x5d\x9d\xd1\x63\x96\xd6\xfc\x3d\xae\xeb\x2d\x62\x17\xc7\x62\x9d\x02\xda\x64\xe7\x36\x55\x0f\xd8\xb2\xd9\xa9\x5a\xf3\xb7\x33\xcf\xce\xd5\x51\x9a\x79\x3a\xe0\x24\xed\x00\x83\x76\x1e\xa9\xb5\x2c\xda\x3a\x65\x6c\xcd\xc8\x9f\x42\x8b\x8c\xeb\x87\x6b\x96\xc9\xbc\xdb\x98\xda\x51\xe8\x86\x6b\xbe\xf5\x0e\x25\x5c\xf1\xc5\x4b\x34\xb5\x49\x32\xed\x5b\x77\x26\xe1\xb5\xdb\xed\xca\xd3\xb9\x8e\xf2\x9c\x49\xb1\x6c\xb6\x59\x20\x36\x28\x76\x94\x22\xb9\x03\x96\x24\xe9\x8d\xd9\x53\xae\x8b\x2c\x94\x8b\x55\x05\x6e\x5a\x20\x4c\xfa\x4a\xbc\x56\x3b\x43\x18\x68\xec\x9a\xa2\x0f\x96\x74\x74\xa3\x96\xe3\xdd\x51\xeb\x88\x5f\xe2\xd9\x19\xfc\x03\x9e\x77\xa6\x2b\xa9\x54\xc1\x5b\xbc\x99\x4e\xaa\xdd\xdb\x45\x17\x85\x07\x75\x43\x72\x45\x95\x78\x16\xae\x39\x6e\xd9\x75\x82\xd6\x30\xd4\xc9\x18\x86\x52\x78\xbd\x66\x02\x9e\x5b\x93\x4c\x8a\xd3\x87\x22\xdd\x2e\x66\xd2\x5a\xdc\x1f\x80\xce\x69\x07\x76\x1e\xce\xbf\xb6\x65\x6a\xd5\x46\x43\x15\x3e\xb5\xd7\x7b\xe3\xe8\x2b\x7d\xfb\x60\x01\x4a\x17\xe7\x41\xdb\x87\x69\xa9\x85\x96\x9e\x5c\xcc\x8f\xac\x9a\x5d\xac\x49\x28\x79\x77\xd5\xfe\x7a\x1c\x9d\x7b\xf1\x53\xb6\xf0\x22\x88\x81\x79\x9b\x58\xdb\x3d\x85\xd8\xf1\x94\xec\x89\x9e\xcf\x50\x8b\x1e\x3f\x82\xba\x41\xb9\xf5\x2f\x71\x0c\x70\x41\x1f\x36\x9d\xe9\xbd\xdb\x1c\x5b\x3b\x6c\x75\x99\xa3\xf4\x4b\x75\x69\xc9\xbb\xd3\x71\xe8\x05\x3d\xef\x56\x87\xeb\x1a\x6f\x74\x40\xbd\xe2\x43\x23\xbd\xb8\x59\x03\xdf\x46\x6e\xbd\x56\xd6\x91\xc6\x63\x37\x4c\x01\xde\x66\x74\x40\x3b\x99\xbb\xa9\xd5\xa1\x56\x8b\x3d\xcf\x49\xf5\xe8\xf3\x3e\xfc\x41\xf1\xe7\x0f\xdd\x7f\x89\xe4\x90\xf8\x6b\x20\xee\x31\x11\x58\xcb\xeb\xfb\x77\x19\xcd\xcc\x7d\xdf\xde\x82\xda\x3f\x76\x6f\x61\xf7\x9e\x1d\x5b\x0b\xfb\xa1\x7b\x6f\xd1\x3c\x01\x28\x37\x17\xad\xf3\x83\x8e\xdd\x85\x1b\xd1\x6d\x09\xdc\xb2\x3c\x60\x97\xd1\x92\x3d\x60\x9b\xf1\x34\xb7\x13\x9d\x99\x73\x79\xcc\xf2\xf8\xcc\xb9\xe1\xb2\x22\x40\x9a\x86\x3b\x4e\xee\xdc\x1a\xec\xe0\xe4\xb9\x2d\x61\x48\xf6\xbc\xb7\xd7\xb1\xd3\xe7\x83\xac\xfa\xc8\x04\xba\x3d\xa9\xbf\x50\x06\x5d\x9e\xd3\xf5\x66\x01\xb6\x85\x49\x03\xba\x17\xfe\xc1\x26\x3e\x4e\xda\xdc\xb6\xf6\xa3\xf3\xe6\xa6\x8a\xc3\x12\xe7\xca\x1e\x5f\x91\x39\x3f\x84\x99\x27\x97\x3a\x3f\xce\xc3\x8f\x49\x9e\xbb\xf9\xe1\x29\x66\xcf\x7f\x72\xdc\xfc\xd1\x29\xf3\x10\xc3\xff\x45\x73\xe6\x3d\x51\xfe\xa4\x93\xe6\xc7\x62\xe4\xf0\xb4\xb9\x1b\x00\x7f\x5e\xde\xdc\xca\x4a\xf7\x25\xce\xca\x95\x25\x1f\x91\x39\x17\x7f\xff\x1b\x00\x00\xff\xff\x14\x70\xd7\xcc\x31\x44\x00\x00")
+var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\xdd\x6f\xdb\xb8\x96\x7f\x96\xff\x8a\x73\x85\x4c\x60\x17\xae\xdc\xce\xdb\xa6\xc8\x02\xdd\xa6\xdd\xeb\xc5\x6c\xe7\xde\x49\x17\xb7\x40\x10\x74\x18\x89\xb2\x79\x2b\x53\x1a\x92\x72\x62\x78\xfc\xbf\x2f\x0e\x3f\x24\xea\x2b\x96\xd3\xcc\x6c\xb1\xf7\x29\x91\x44\x1e\x9e\xcf\x1f\x0f\x0f\x8f\xf7\xfb\xc5\x8b\xc9\xbb\xbc\xd8\x09\xb6\x5a\x2b\xf8\xf1\xd5\xeb\x7f\x7b\x59\x08\x2a\x29\x57\xf0\x81\xc4\xf4\x2e\xcf\xbf\xc2\x92\xc7\x11\xbc\xcd\x32\xd0\x83\x24\xe0\x77\xb1\xa5\x49\x34\xf9\xb4\x66\x12\x64\x5e\x8a\x98\x42\x9c\x27\x14\x98\x84\x8c\xc5\x94\x4b\x9a\x40\xc9\x13\x2a\x40\xad\x29\xbc\x2d\x48\xbc\xa6\xf0\x63\xf4\xca\x7d\x85\x34\x2f\x79\x32\x61\x5c\x7f\xff\x69\xf9\xee\xfd\xc7\xeb\xf7\x90\xb2\x8c\x82\x7d\x27\xf2\x5c\x41\xc2\x04\x8d\x55\x2e\x76\x90\xa7\xa0\xbc\xc5\x94\xa0\x34\x9a\xbc\x58\x1c\x0e\x93\xc9\x7e\x0f\x09\x4d\x19\xa7\x10\xfe\x56\x52\xb1\x0b\xe1\x70\xc0\x97\x67\xc5\xd7\x15\x5c\x5c\xc2\x1d\x91\x14\xce\xa2\x77\x39\x4f\xd9\x2a\xfa\x1b\x89\xbf\x92\x15\x05\x3b\x53\xd1\x4d\x91\x11\x45\x21\x5c\x53\x92\x50\x11\xc2\x59\xf7\x13\xdb\x14\xb9\x50\xee\x93\x79\x82\xe9\x24\xd8\xef\x5f\x82\x20\x7c\x45\xe1\xac\x20\x6a\x8d\x8b\x9d\x45\xd7\xec\x2e\x63\x7c\xb5\xd4\xa3\x24\x12\x0b\x82\x50\xb3\x83\x43\x0e\x87\xd0\xcc\xa3\x3c\xc1\x6f\xb3\x89\x16\xe0\xec\xae\x64\x19\xaa\x4b\x93\xf8\x3b\x8a\xf1\x91\x6c\xa8\x93\x44\xd0\x98\xb2\xad\xf9\x5c\xfd\x5f\xcd\x41\xa6\x16\x0b\xf0\xc9\x1c\x0e\x68\x0a\xd4\xad\x7b\x93\xe6\x02\xb4\x7a\x18\x5f\xe1\xd0\x82\xc8\x98\x64\x70\x16\xd9\x75\x80\x72\xc5\x14\xa3\x32\x9a\xa8\x5d\x41\xdb\xd4\xa4\x12\x65\xac\x60\x3f\x09\x62\xad\xc7\x49\x90\xb1\x0d\x53\x41\xf0\x82\x71\x35\x09\xf2\x34\x95\xb4\x7e\x12\x09\x15\x41\x70\x73\xfb\x33\xfe\xf3\xa1\xe4\xf1\x24\x28\x39\xfb\xad\xa4\xf8\x52\x2a\xc1\xf8\x6a\x12\x14\x82\x26\x2c\x26\x8a\x4a\x08\x6e\x6e\xab\xa7\x68\xbf\xaf\xb9\x32\xba\xba\x67\x6a\x0d\x67\xd1\xfb\x64\x45\xad\x42\x17\x0b\xa0\x64\x45\xc5\xcb\x2c\x27\x09\x4a\x44\xf1\x5b\x34\x09\x7c\x9b\x50\x54\x57\x64\x26\x04\x48\xc3\x13\x9b\x56\x72\xbf\xc0\xf5\x68\xf4\x69\x57\xd0\xa6\xe2\x03\xdf\x4e\x9d\xff\x17\x2f\xe0\x6d\x92\x30\xc5\x72\x4e\x32\x48\x19\xcd\x12\x09\x2a\x07\x92\x24\xf8\xc7\x53\x7d\x04\xda\x4f\xf5\xac\x33\xb5\x29\x32\x64\xab\x10\x8c\xab\x14\xc2\x84\x91\x8c\xc6\x6a\xf1\x83\x5c\x68\xeb\x2c\x0c\xa5\x10\xce\xa2\x6b\x95\x0b\xeb\xa9\x7a\x2e\x4b\x61\x4d\xe4\x27\xe7\x95\x86\x54\xc5\xe7\x43\xe5\xae\xe6\x43\xd4\xe1\x7a\xb1\x00\xc6\x15\x15\x1b\x9a\x30\x24\xa0\xd7\x83\x29\x8b\x68\x04\x4a\x90\x2d\x15\x92\x64\x80\x8e\x3c\x8b\x70\x66\x83\x05\xf0\x9f\xa3\xff\xa8\x1c\x63\x12\xe0\x04\x48\x4b\x1e\x4f\xe3\x9c\x2b\xfa\xa0\x30\xd2\xf0\xef\x0c\xa6\x03\x93\xe6\x40\x85\xc8\xc5\x6c\x62\x1c\xf7\x1f\x6b\x2a\x28\x2a\x4e\x02\x01\x4e\xef\xa1\xf2\x05\xed\xb5\xbe\x2a\x27\xb8\x10\x4c\x1b\x31\xe1\x6c\x68\xc7\xc0\xe1\x30\x33\x24\xa7\x85\x84\x28\x8a\xfa\x3d\x6b\xd6\x9e\x84\xbe\xed\xd3\x3d\x1c\xea\x99\x12\x2e\x81\x14\x05\xe5\x49\x7b\x69\x6f\xcc\x1c\x0a\x19\x45\xd1\x6c\x12\x08\xaa\x4a\xc1\xa1\x35\xd4\x4a\xfb\x13\xc6\x8d\x93\x56\x07\x11\x48\x45\x0b\xe7\x34\xda\x2a\xa3\xe5\xd4\xc4\xa6\x86\x0a\xe3\xea\xa8\x50\x70\x38\x44\x66\xf4\x25\x9c\xeb\x7f\x8e\x70\xfb\xb3\x0e\x6c\xcb\x2e\x07\x13\xe7\xdf\xc0\xb0\xa1\x37\xb5\x74\xc6\xb2\x6c\x87\x5f\xc2\xb9\xf9\xef\x18\xd3\x08\x3b\x35\xcf\xfa\xe9\x1b\x58\xc6\xf9\xd3\x1c\x5d\xa9\xc2\xb3\x71\x5c\xe3\xe8\x61\xcf\xd1\x9f\xe7\x90\x1f\xf3\x19\xdc\xa3\xcd\xe6\xa7\xb7\xd8\x35\x91\x20\xd9\x86\x65\x44\x30\xb5\x03\xc4\x35\xa0\xc9\xca\x48\xc5\xa8\xc4\x0d\x34\xce\x18\xe5\x2a\xd2\x40\xa0\xc1\x67\xbf\x77\xa0\xf8\x65\x6e\x81\xd1\xc7\x53\x64\x0d\x69\x7c\x71\x02\x39\x84\x82\x69\x0d\x98\x1a\x21\x11\x35\x67\x10\xfe\xbd\xda\x68\x83\xc5\x02\xf4\x53\x2f\xb8\xc6\x6b\xc2\xb8\xd9\x88\xe2\x52\x08\x4c\x2b\x90\xcd\x1d\xe4\x66\x97\xdf\xef\xfd\xd1\xc8\x42\x34\x09\x46\xda\x65\x70\xd5\xa9\xb5\x4e\x43\x22\xe3\x58\x81\x59\xfd\xe2\x12\xce\x7b\x46\xec\xcd\xde\x76\xd1\xb6\x42\x64\xde\x1f\xdc\xfc\x48\x63\xde\xa5\x45\x3d\xf5\x00\x5d\xe4\x4b\x45\xbe\xf9\x9f\x21\xd0\xd4\xf8\x67\x31\x50\x73\x15\xb0\x14\x1f\x71\x63\x68\x2f\x5d\x08\x5a\x10\x41\xb5\xb0\xd3\x58\x3d\xcc\xde\xe8\x91\x7f\xb9\x04\xce\x32\x33\xd9\xf9\x0e\x67\x99\xa6\x8c\xef\x90\xd7\x7a\xef\xa4\x0f\x0a\x77\x81\x33\x08\x7f\xb1\xa4\x43\x6f\x95\x10\x1d\x21\x44\xb7\x08\x97\x09\xe5\x2a\x84\x50\xb3\x1f\xc2\x4b\x74\x0e\x4d\x68\xc4\xce\x85\x4a\x69\xef\x5b\xc1\x63\x9b\x53\xbd\xc1\xda\x75\xac\x1c\x7a\xf1\x39\xca\x37\x31\x82\xd8\xf7\x5a\xf7\x93\x40\x27\x77\x76\x53\xc3\xed\xe3\x03\x13\x52\x81\x19\x63\x5c\x2d\xd5\x6f\x7c\xb4\x37\xd9\xcd\xce\x25\x97\x9a\x52\x04\xbf\xd8\x39\x2f\x3e\xe6\xea\x03\x26\xa4\xef\xd1\x24\x70\xbf\xa6\x1c\x78\x8e\xd6\xcb\xf2\x7b\x2a\x3c\x32\xf7\x44\x9a\xd4\x75\x34\x7a\x68\xee\x06\x9c\xe4\x85\xcf\xa2\xdb\x14\x2d\x92\x14\x59\x29\xd0\xab\x23\x67\xb1\xca\x6f\x7a\x9c\xc4\x6c\x03\xaf\x67\xd1\xdb\x2c\xc3\xb5\x66\x13\xe7\x51\x9e\x9f\x74\xbc\xe4\xa0\x47\x65\x94\x4f\x07\xd6\x9b\xc1\xe5\x25\xbc\xea\x4c\x3e\x6f\xa8\x6b\xaf\xb9\xf1\xf2\xea\xe8\x27\x72\x47\xb3\x03\x1a\xca\x4d\x1b\xa0\x7f\xf3\xea\xd6\x98\xd9\x33\xe4\x67\x4c\x5c\x33\xf6\x95\x9a\xc7\x39\xdc\x95\x0a\x0a\xc2\x59\x2c\x81\xa5\x40\x38\xea\x20\x17\x90\xc7\x71\x29\xe4\x69\x66\xf8\xdc\x6f\x87\x86\x19\x1c\x90\x8f\xd2\x7b\x65\xdc\x8e\xc2\xcf\xcf\xe1\x2f\x4b\xe9\x14\x35\xa5\xc2\x46\xba\x96\x44\x3f\xb6\xf4\xd3\x58\xd0\x57\xc8\xf2\xea\x98\x6f\xb3\xe4\x34\xbf\x66\xc9\x53\xfd\x78\x79\x35\xe0\xc9\x2c\x31\x2c\x2d\xaf\x74\x22\xdd\x83\x71\x5b\x22\x80\x25\x12\x6e\x6e\x5b\x03\xb5\xe6\x58\x22\x8d\x92\x1f\xf1\xed\xe5\x95\xec\x07\x40\xa3\x1e\xdf\x9f\x59\x22\x3d\xdf\x35\x74\xc7\x7a\xad\x4f\xce\x9a\x87\x25\xb2\xd7\x55\x97\x57\x4d\x67\x5d\x5e\x3d\xaf\xbb\x0e\xa9\xbb\xa5\x41\x14\x92\x25\x8f\x3b\xe9\xf2\xea\x19\xdc\x94\x25\x56\xfc\x9f\x79\xb6\x6b\x78\x65\x8e\x2f\x8e\x01\xee\xbc\x9a\x52\xa9\x85\xa5\xc0\x73\x05\xf4\x81\xc4\x2a\xc3\xac\x80\xba\x89\xe8\xa1\x66\x38\x1d\xef\xa4\xc8\xd7\x9f\x83\xb5\x3f\x9e\x8e\xb5\xf2\x9e\xa9\x78\xfd\x38\xde\xe2\xf9\x1a\xcb\x15\xaf\x2f\x6a\x22\xc7\xc0\xd3\xcc\x78\x75\xf1\x44\x94\x4e\x68\x4a\xca\x4c\xf5\x4d\xbf\x66\x7c\x55\x66\x44\x1c\xa1\x50\xa5\xdd\x3c\xdb\xd5\xf0\x8d\xb6\x78\xae\x70\x40\x5a\xcf\x0e\xde\xce\x59\x7a\x0d\x78\x12\x4e\x23\xa5\xe5\xd5\x91\x80\x60\xc9\x13\x82\x81\x25\x4f\x0f\x84\xff\x3b\xb0\xfe\x71\x1c\x58\x7b\x01\xa1\x01\xbb\xe1\xfc\x2c\x81\x4b\x5c\xe9\xe6\xd5\xad\xef\xe1\xa7\x61\xb9\xe7\xdb\xf5\xc4\xd1\x5e\xed\x78\xf5\x8d\xdc\xf4\xef\xe7\x03\x7c\x4b\xbd\xdf\x62\xa7\xe1\x7d\x6d\xfb\x13\x3c\xbb\x82\x76\xac\xf3\xd2\x07\x1a\x97\x58\xf5\xa8\xbc\x15\x08\x4f\x6a\x87\x85\x8c\x49\x85\x25\x59\x1f\x9a\xac\x9f\x8f\x96\xd8\xc2\x67\x8f\x7f\xde\xdc\x0e\x82\x35\x4b\x87\xa4\x3e\x7e\x4e\xea\xc3\x64\xfb\xae\x4d\xcc\x3f\xb7\xc1\xe1\x50\x21\x7d\xa5\xa2\xda\x0d\xde\x66\xd9\x73\xf9\x00\xd2\xed\x57\xc9\xcd\x6d\x1f\xcc\xf5\xed\x0a\x83\x5e\x51\xc9\x70\x0a\xd8\xf5\xad\x80\x7e\x32\xea\x3c\x28\x95\xa0\x64\xd3\x3a\x11\xee\xf7\x83\x75\xcc\xc5\x02\xae\xf5\x94\x21\xff\x8b\x49\x96\x49\x48\xb9\xae\x0a\x52\x12\xaf\x1b\x00\x3b\x87\xfb\x35\xde\x1f\xe0\x1c\x91\xdf\x4b\x20\x82\x82\xa0\x24\xd1\xe7\x49\x04\x65\xb4\x5d\x42\x14\xc1\x0b\x81\xb9\x3e\x18\xe7\xa5\x02\x57\x41\xc6\x79\xf7\xeb\x3c\xc3\x49\xb2\xcc\x14\xd8\x02\x55\x0e\x1b\xba\xc9\xc5\x2e\x82\x4f\x6b\x0a\x4c\x51\x41\xb0\xe8\x0b\x52\xe5\x85\x74\x75\x0c\x7d\xe2\x44\xfa\x06\xc9\x1d\x5e\xc3\xdd\x0e\x52\x3e\xd7\xd1\x83\xc3\x2c\xce\xd7\x80\x8e\x03\x8c\xd0\xd1\x64\xb1\x40\x02\x1f\x73\x85\x32\x10\x35\x6f\x55\xb8\xf3\x54\x97\x79\x24\x7a\x1e\xe6\x4c\xb2\x2c\xf0\x86\xc1\xa7\x31\x87\x3b\x1a\x93\x52\x52\x3b\x12\x35\x80\xe2\xd1\x44\xab\x8c\x64\x19\xae\xc0\xf3\x04\xbf\xa5\xca\xde\xce\x78\xe2\x9a\xa4\x8b\x24\x11\xfc\x95\xf2\x98\xce\x6b\x57\xf6\x79\x66\x8e\x93\x7b\xac\xdb\x0a\xfa\x5b\x49\xa5\x3a\x61\x73\x32\xcc\xf6\x79\xfa\x5c\x5b\x17\xab\x2a\x0d\x7f\x9f\x39\x04\xd0\x7f\xd0\x65\xfb\x6f\x05\x58\x0a\x75\xa1\x8b\xb9\x42\x17\x56\xc2\x8d\xdb\xad\x14\x9c\x31\x78\x85\x4c\xfd\xfe\x3b\x54\x55\x84\x76\xa8\x0c\x5e\x15\x98\x90\xa9\xe6\x21\x23\x15\xac\x68\xd6\x64\xf4\x91\xde\x4f\x43\x77\xf9\x74\x38\x5c\xb4\xee\x51\x22\xeb\xe1\x49\x4e\x1b\x56\x1c\xb0\x75\x38\x33\x15\x10\xbf\x8c\xff\x0c\x10\x78\x1a\xfa\xd5\xe6\x42\xf3\x38\x10\x34\x6f\x6b\x1c\xac\x3c\xf0\x59\xa0\xd0\x52\x7f\x8a\x8f\x3c\xba\x4b\xb4\x64\x79\xf3\x38\x12\xda\xeb\x09\xc1\x14\xfd\xaf\xeb\x9f\x3f\x0e\xe1\xd2\x3d\x0e\x90\xae\x9e\x59\x61\xa6\xf3\x1a\x95\xc3\x3d\x10\xac\xf6\x6b\x22\x44\x08\xb2\xf3\xc0\x0a\x97\xe8\xc7\xab\x0a\xac\x60\x2a\xa9\xd3\xf0\x2c\x82\xf7\x6d\xe8\x43\x23\x50\x8e\xd5\xe1\x04\x4a\x89\x60\xa6\x9f\x18\x5f\x2d\xfe\x29\x73\x3e\xc7\x25\x2c\x04\x09\x9a\xe6\x82\xce\x81\x29\x69\xd8\xb1\x37\x57\x08\x15\x28\x87\xa2\x1c\x79\xe5\x3a\xa2\xcd\x88\x2d\xc9\x4a\x2a\x11\x0b\x12\x3d\x4d\x52\x2e\x99\x62\x5b\xea\x6e\xbd\x88\xa0\xb8\x42\xbe\xc1\xe9\x49\xe4\x43\x58\xd7\x05\xe6\x5a\x32\xad\x04\x3d\x46\xe7\xb7\x6e\x65\xad\x2a\x8b\x6f\x78\x53\xc5\x38\x39\x05\x58\x2a\x4b\xf5\xfb\xcd\x3d\xb0\x3c\xd2\x63\x7c\x28\x61\x29\x7c\xa9\x76\x4d\x37\xe0\x5a\xdf\x51\x4e\xef\xe7\x10\xde\x84\x47\xe3\x48\xd2\x02\xe3\x31\x0c\x27\xc1\x18\xbf\x43\xdf\x45\x10\x86\x3e\x07\x46\x96\x82\xbb\x32\xad\x58\x42\x0b\x46\xff\x4d\x84\x5c\x93\x4c\x4f\x43\x4c\x60\x69\x9b\xa5\x06\x4f\x81\x45\xc3\xc7\x04\x93\xb4\x98\xbd\x39\x4e\x05\x65\xbb\x84\x70\x1e\x4e\x82\xc0\x92\xbb\x84\x7b\x43\x6c\x7a\x57\xa6\xb3\xb6\x3a\x7a\x33\x8c\xc6\x88\x49\x4d\xa8\x47\xdf\xb7\x61\x7d\x1b\x82\x6c\xb4\x82\xb0\x46\x9c\xea\xd5\x73\x81\x4e\xbd\xc6\x08\xff\x79\x14\x66\x2a\x4a\x48\x68\x0e\xf7\xc7\x81\xa6\xda\x54\xb4\xb4\xcb\x2b\x79\x52\x12\xee\x21\x41\x32\x5e\x5e\x7b\x46\x6b\x0b\xaa\x33\xf0\xd6\x91\x63\x3e\xfa\x70\x38\xa0\x91\x6b\x8a\xd7\xdc\xd3\xf6\x61\xeb\x03\xa2\xcf\xf2\x6a\x16\x5d\xc7\x84\x1b\x65\x9d\xe3\x59\xf0\xcd\x80\x03\xf5\x25\xef\xba\x76\x58\x97\xe6\x96\x57\xb2\xf6\x91\xe5\x95\x7c\x2e\xef\x40\xba\xfd\xea\xea\x28\x02\x39\xae\x0e\xc9\x3d\xca\x70\x87\xe3\x4a\x61\x83\x8e\xe1\xc9\x68\xc5\x7b\x97\x97\xbc\x79\xdb\x11\xeb\x37\xba\x41\x86\xc2\x8a\x6d\xa9\xbd\x29\x19\x2d\x99\x26\x39\xe0\x09\x8c\xab\x67\x3e\x7f\xbd\x3a\xf5\xf4\x55\xb1\xe7\x52\x0f\xfd\xa2\xb6\xb1\x7e\x7c\x2e\x2b\x6b\x62\x03\x76\x66\xdc\x36\xc0\x94\x56\x29\x7d\x7a\xf0\xb8\x1d\x6d\x5d\x6d\x41\x2b\xdc\xfb\x07\xe6\xdf\x66\x89\x92\xa2\x38\x35\x06\xe0\xf5\x2f\xcd\xe8\x86\x72\x25\x5d\x41\x69\x25\x48\xb1\x1e\x2d\xa2\x5e\x61\xc0\xdc\x77\x79\x9e\x3d\xb3\xbd\x53\x92\x49\x7a\xaa\xcd\x2b\x1e\x9d\xcd\xf5\x8b\xda\xe6\xfa\xf1\xb9\x6c\xae\x89\x0d\xd8\x1c\x15\x82\xd2\x50\x1c\x33\x68\x74\x8f\xdd\xd1\x46\xd7\x14\xad\x74\xef\x32\xac\x7c\x3b\xa3\x13\x48\xca\x22\xd3\x2d\x2b\x2e\xac\x8d\xed\x2d\xd3\x73\x60\x3c\xce\x4a\x7d\x52\x20\x59\x06\x44\xca\x3c\xc6\x96\x9e\x44\x37\x66\xc8\x08\x96\x0a\x62\xc2\xe1\x4e\xe7\x65\x25\x36\xe3\xa9\x1c\xac\xc5\x20\xce\x37\x9b\x9c\x37\x49\x62\xa3\x04\xe6\x8e\x3a\x23\xdd\x40\xc2\xd2\x94\xe2\x6d\x7d\xb6\xf3\x0e\x8a\xb1\xe6\x92\x49\xd8\x90\x84\x8e\xd6\xae\x96\x6d\x3a\x6b\x7f\x80\x7d\xa5\x89\xf3\xe6\x17\xdc\x1e\xdd\x45\x7c\xa7\xa7\xc2\x7c\x98\x4f\x82\x40\x77\xaf\x5c\x40\xd0\x19\xa2\x3f\xe0\x08\xd3\x2b\xd2\x43\xc4\x7c\xd0\x43\xb0\x07\x03\x89\xd8\x1e\x0d\xaf\x65\x6d\x7f\x98\x77\xec\xac\x5b\x36\xb0\x5f\x03\xe7\x9a\x8e\xb6\x0b\xa8\xe7\x9a\xce\xb6\xbe\x89\x66\xac\x9b\x59\xf7\x0c\x5d\xb8\xe6\x90\xa1\x06\xb8\x3e\x62\xf5\x74\x47\x70\xb1\x70\xc6\xe9\x34\x78\x99\x9e\xb8\x46\x70\x5d\x1c\x8b\xbe\xc8\xda\x0c\x49\xe3\xad\x7e\x77\x02\xbe\x9d\xdb\x23\x52\xbb\xe3\xae\xd3\x58\xe2\x4c\x7b\x71\x59\xb5\x91\x34\x1b\xed\x16\x0b\x80\x7f\x0c\x1d\xba\x15\xc5\xaa\x4f\x15\x04\x2f\x1d\x35\x95\x7b\x87\x66\x33\x00\xb3\x63\xfc\x8f\x28\x7d\x98\x8a\x73\xce\x69\x8c\x61\xa1\x72\xbd\x08\x8e\x09\x1b\x2d\x27\xa1\xae\x64\x98\xd2\x4e\x5e\xd8\x66\x3e\x22\x56\xa5\xc1\x57\x17\x3a\xc6\xeb\x4a\x41\xbb\xc1\xe8\x22\xf4\xb4\xde\x95\x21\x69\xa7\x79\xa1\x74\xd3\x5a\x7d\xc0\xa5\xde\xbc\xde\x28\x6a\xf7\xb4\x9c\xd4\xcf\x82\x75\xa1\x2f\x73\xc8\x0b\x85\x04\x8c\x19\x35\x0f\x48\x38\xc8\x0b\x35\xd5\xd4\x6d\x1d\x22\x18\x5d\x2a\xb9\x74\xdd\x1a\x2e\xc8\x5b\x33\xd1\x77\xbc\xaa\x06\xb6\x74\x9c\xad\x44\x5e\x16\xae\x4b\xe6\xe2\xb2\xa2\x6a\x94\xf3\x7b\x55\x69\xfc\x41\xfe\xa7\x1e\x69\x1a\x90\x10\xe2\xec\x73\x65\x2f\x4d\x09\xb6\x54\x28\x16\x53\x89\x05\x32\x0c\x8e\x5c\xc0\x26\x17\xee\xd8\xba\x88\xf3\xac\xdc\x70\x89\x85\x37\x04\x4c\x26\x21\x4f\xf1\x10\xaa\x89\x60\x0d\x08\xc8\x6a\x25\xe8\x0a\x43\x09\xcd\x81\xde\x21\xe7\x7a\xff\xd1\x01\xf1\xcf\x9c\x71\x98\x7e\xa5\x3b\x59\x0f\x9c\x41\x38\x07\x64\x2b\x9a\x54\x25\xaa\x8c\x72\x38\x33\x99\xae\x0e\x0a\xfc\x70\x96\xa2\xba\x19\x4f\xe8\x43\xfd\x0d\x0b\x53\xb6\x0a\xf8\xfe\x81\x6c\x8a\x8c\x5e\x98\x47\x7d\x1f\xb3\x05\x0d\x30\xa6\x0b\x77\xb1\x30\x51\x9d\xe2\xc9\xb2\x8c\x95\xa6\xee\xda\x34\xd3\x2a\x0f\xfd\xd5\x1f\xf3\x89\xac\xe0\x70\xf8\x55\xcf\xd5\x59\x8a\x4e\x68\x7e\xc5\xf3\xe5\x45\xa8\x53\x90\x39\x1e\xe1\xe9\xa6\x50\xbb\x50\x0f\xb3\xdc\x04\xb6\x9d\xcc\x33\xb4\xb3\xb3\xe9\x98\x9d\xce\x50\x89\x41\x60\xcd\xd0\xc9\xf2\xf1\x39\xc5\x2c\x43\x2a\xc2\x15\xee\x0a\x66\xfc\x5b\xa7\xb6\x69\x5d\x31\xb3\x09\xd4\xcc\x0e\xf1\xce\x05\xdb\x19\xb2\xe3\x39\xcd\xc8\x58\x73\x5c\x69\xb3\x83\xc1\xe8\xb9\xab\x5d\x44\x51\x64\xde\xd8\xd0\x6a\xf8\x20\xea\x73\x12\xe8\x57\x55\x78\xb5\x06\x1c\x0f\x31\x3d\x21\xb2\xcb\x5d\x42\x7b\xb3\xd0\x1f\x0e\x8e\x1f\x04\x74\x37\xe5\x78\x93\x59\x21\xe8\x76\x74\x8f\xd9\xb7\xa4\x72\xdd\xe3\x97\xdf\x97\x75\x64\x37\xb1\x2e\x62\x2f\xab\xeb\x04\x48\x4b\xe9\x6e\x13\xa4\x3e\x1f\x8e\x0a\x7e\x73\x94\xac\x62\xdf\x3c\xf6\x04\x78\x5d\x47\x6b\x1c\x8a\xbe\xe7\xb8\x3c\x35\xe0\x06\x4e\xd5\x43\xf1\xf6\x0c\xc1\x64\x57\x1c\x15\x4b\x4d\x9b\x9a\x60\x32\xef\x72\x51\xc5\x53\x7b\xd0\xf1\x80\x72\x24\x4e\x8b\xa9\x6a\xd6\xff\xf7\xb0\x72\x82\x62\x64\x8d\x34\x6a\x9b\xd3\xae\x4e\xfc\x6a\x69\x9b\x2f\xad\x50\x4f\x2a\x54\xdf\xe0\x49\x09\x07\xdb\x83\x52\xcf\x49\xc9\xc9\x50\xeb\xe2\x88\x12\x00\xef\x1f\xe9\x76\xe2\x2e\x49\x58\x0a\x67\xd1\x5f\x89\xfc\x5b\x9e\xb1\x78\x57\xdd\x0a\x79\xcc\xf8\x71\x62\x46\x45\xef\xb7\x24\xab\x64\xef\xa4\xdb\xb3\x37\x47\xb9\xf4\xc2\xc8\x7d\xb3\xf5\xa8\xfd\xbe\xdd\x80\x6b\x5d\x29\xac\x2d\x10\x5a\x8e\x42\xb7\x05\x4e\x46\xf5\xdb\xb6\x5b\x6d\xf7\xfb\xfe\x36\x5b\xaf\xb2\xa8\x3b\xc9\x35\xec\xde\xd5\xf9\x6b\xf5\x23\x2a\x93\x7f\xfd\xd2\xfb\x53\xa3\xd6\xae\x57\xfd\xde\xa8\xf5\xbe\xef\x47\x47\x7a\xc8\xcb\xbb\xdd\xd8\x1f\x1d\xb5\x49\x76\x7f\x79\x64\xe3\xde\x85\xfb\x24\x48\xb9\x04\x00\xb8\xb9\xad\x12\x0a\xf3\x9b\xa3\xef\xf6\x17\x2f\x15\x9f\xe6\x47\x0a\xf5\x1e\xe5\x12\x49\xbc\x60\xae\x72\x4e\xf7\xb3\x85\x4a\x93\x76\x27\xab\xa3\xbb\x69\x39\x17\xe2\x2d\x4d\xce\xea\x65\xa7\xa8\xb1\x28\x8a\xaa\x17\xde\x6f\x1a\xda\xfa\xb7\x2d\x55\xed\x25\xa2\x94\x7b\xd0\x3b\x34\x02\x6f\xd9\x2c\x00\xdb\xc0\xe8\x1b\x69\xb5\x82\xdb\x13\xe6\x47\x19\xa3\xb2\x47\x60\x5d\xa2\x90\x38\xc6\xbb\xb5\xd6\x17\xf4\xb5\xfe\xf4\x5d\xd5\x13\x34\xe3\x76\xc6\x36\xf2\xcd\x61\x6b\x5c\x28\x25\x31\xdd\xfb\x77\x34\xb6\x89\xcb\x43\x96\xf6\x52\x3e\xd6\x75\xa1\xce\xaa\xc3\x95\xc5\x7a\x09\xf8\xce\xd4\x38\x54\x3d\xa2\xcb\x36\x42\xd6\x7b\xfe\xd6\x79\x1f\xbe\xaa\x4b\x69\xf8\x74\x42\x25\xed\x04\x85\x7e\x1e\xa5\xd1\x4e\x95\xb1\x23\x91\x2f\xc2\x9b\xc7\x8b\x6b\x1a\xe4\x5c\x35\x02\x7f\xea\xa2\x2c\x84\x6e\xf4\x8d\x65\x5d\x94\xb0\xb7\xb4\x5e\xa2\xa9\x30\xc9\x34\x6f\x6d\x4d\xc2\x1b\x77\x38\x54\xd5\xb9\x9e\xde\x27\x3c\xd2\x98\x6c\xd3\x79\x6c\xe4\x4e\x94\xd8\x06\x48\x32\xfc\x19\x41\x62\xfa\xaf\xab\xdf\x60\x56\xce\xad\x37\x08\x4c\x5f\x35\xae\x35\x6a\x08\x23\x95\xdd\x60\xf4\xd1\x2b\x1d\xe5\x61\x91\x4b\x5f\x6c\x43\x6a\xd7\x93\x34\x3f\x72\x06\xff\x0e\xaf\x7b\xd3\x95\xc1\x7e\x87\x16\x83\x51\x53\x91\xf6\x7e\x97\xc4\x6b\x46\xb7\xe4\x2e\xa3\x46\x31\x7a\x12\x96\x34\x75\x0a\xaf\xd6\x84\xc3\x6b\x93\xc9\xbb\x2e\x88\x2a\xdd\x76\x92\x74\x36\xf7\x47\x5c\xe7\xbc\xc7\x77\xda\x02\xd9\x65\xec\xdb\x6d\x95\x5a\x75\xbd\xa1\x0e\x9f\xc6\xeb\xa3\x71\xf4\x8d\xb6\x1d\x28\x52\xd7\x1a\xd1\x62\x6d\xe7\x8f\xea\xa4\x41\xf1\x91\x5c\xcc\x8f\xac\x86\x5e\x30\xdb\x32\x51\x24\x6d\x2b\xa5\x7f\x60\x53\xf0\xd2\x8b\x9f\x6a\x84\x17\x41\x04\xf0\x6d\x66\x74\xf7\x3d\xc4\x8e\xc7\xe4\x40\xf4\x7c\xa9\x78\xed\x1c\x00\xfa\x9d\xd2\xda\x60\xb4\x09\x86\x7c\xd3\xaa\xde\x6b\x95\xdd\x6a\xc8\xf4\x3a\x65\x2b\xbb\xd4\x1d\xe1\x5e\xc3\xec\x89\x1d\xb3\x7e\xcb\xac\x9d\x9a\x6e\x54\xa4\x67\xa5\xa7\x46\x7a\xd5\x31\xf6\x43\x62\xf7\x6b\x69\x0c\x89\x68\x87\x7d\x1f\xf4\xa1\xd0\x05\xda\x70\x6e\x45\x6b\xba\x5a\x23\xf6\x3c\x23\x35\xa3\xcf\xfb\xf0\x07\xc5\x9f\xbf\x74\xbf\x83\x9c\x1a\x7f\x1e\xc5\xa7\x46\x60\x23\xaf\x1f\x3e\x65\xb4\x04\x3a\x7a\xb6\xd0\xe3\x9f\x7a\xb6\x30\x67\xcf\x9e\xa3\x85\xf9\xd0\x7f\xb6\x68\x57\x00\xaa\xc3\x45\xfb\x43\xdf\xe9\xc2\xae\x68\x8f\x04\x76\x5b\x1e\x71\xca\xe8\xd0\x1e\x71\xcc\xf8\xd6\x9f\xfd\xf7\xea\xdb\x30\xf2\x2f\xf7\xb3\xff\xde\x7c\xdf\x95\x2e\xbe\x21\xdf\x6f\x39\x9a\x0b\xeb\xb6\xb9\x9f\x27\xe3\xef\x2c\x76\x72\xca\xdf\xa5\x30\x26\xe7\x3f\x3a\xeb\xb9\x93\xfe\x93\xb4\xfa\x79\x94\x5a\x3b\x69\x7f\x57\x28\x5f\x8a\xce\xfe\xf8\x7d\xe5\xfd\xce\x73\x87\x73\x17\x33\x02\xf3\xdb\xfe\x74\x65\xb4\x8a\x1b\xdc\x3d\x39\xd9\xef\x6a\xfb\xc9\xd9\x7e\x9b\xc5\x71\xe9\x7e\xad\x8f\x6f\xc8\xf7\x1f\xf3\x99\xef\x2e\xe1\x7f\x9a\x85\x07\x12\x8e\x9b\xdb\x47\x52\x8e\xae\x5a\x1a\x24\xbf\xa3\x9c\xff\x4f\x8e\x1b\x8f\xb3\x3f\x24\xd1\x1f\xa3\xf8\x21\xa7\xfc\xce\x33\xfd\xb6\x42\xa3\x3e\x94\xfc\x4e\x53\xfd\xa7\xfa\xc8\x40\xec\x9d\x1c\x79\x1e\xc9\x3f\x38\xdb\x6f\x8b\x74\x34\xdd\x97\xf6\x32\xf5\x09\xf9\x3e\x50\x9e\xc0\xe1\x30\xf9\xdf\x01\x00\x78\x76\x75\x18\x44\x4e\x00\x00")
 
 func templateBuilderQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -221,12 +221,12 @@ func templateBuilderQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 17457, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 20036, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5d\x6f\xdb\x36\x17\xbe\xb6\x7e\xc5\x79\x05\xbf\x80\x6c\x2c\x74\xdb\xbb\x0d\xf0\x45\x1a\xb7\x80\x81\xb5\x05\xe6\xe6\xaa\xe8\x05\x23\x1e\x39\x6c\x15\x52\x25\x29\x6f\x81\xa6\xff\x3e\xf0\x4b\x92\x65\xc7\x8e\xd3\xed\xce\x26\xcf\x17\x9f\xf3\x9c\x0f\x35\xcd\x62\x9e\xdc\xc8\xea\x51\xf1\xed\xbd\x81\x37\xaf\x5e\xff\x7a\x55\x29\xd4\x28\x0c\xbc\xa7\x39\xde\x49\xf9\x1d\xd6\x22\x27\x70\x5d\x96\xe0\x84\x34\xd8\x7b\xb5\x43\x46\x92\xcf\xf7\x5c\x83\x96\xb5\xca\x11\x72\xc9\x10\xb8\x86\x92\xe7\x28\x34\x32\xa8\x05\x43\x05\xe6\x1e\xe1\xba\xa2\xf9\x3d\xc2\x1b\xf2\x2a\xde\x42\x21\x6b\xc1\x12\x2e\xdc\xfd\xef\xeb\x9b\x77\x1f\x37\xef\xa0\xe0\x25\x42\x38\x53\x52\x1a\x60\x5c\x61\x6e\xa4\x7a\x04\x59\x80\x19\x38\x33\x0a\x91\x24\xf3\x45\xdb\x26\x49\xd3\x00\xc3\x82\x0b\x84\x54\xa3\x31\xa8\x52\x68\x5b\x7b\x3a\xbd\xab\x79\x69\x63\xf8\x6d\x09\x15\xd5\x39\x2d\x61\x4a\x36\xb9\xac\x90\xbc\x0d\x37\x41\x50\x61\x8e\x7c\xe7\x25\xbb\xdf\x9d\x7a\x10\x2a\x38\x96\x4c\x5b\x91\x29\x79\xef\x7f\x87\x9b\xba\x62\xd4\x78\xed\x82\x96\x1a\xfd\xf9\x15\xf0\x02\xa4\x82\xec\x9e\xea\x4d\x5d\x14\xfc\xaf\xde\x64\x7a\xeb\x54\xd2\xd9\xa9\xdb\x4f\xc2\x0a\xb4\x6d\x32\x19\x3a\x59\x82\x51\x35\x76\xc7\x21\x2a\x1b\xd4\x87\xda\xd0\xbb\x12\x87\xb1\x5d\x01\xda\x78\x78\x01\x53\xb2\x5e\x91\x5b\x8d\x6a\xe5\xb0\x62\x87\x06\x68\x55\xa1\x60\xdd\x81\x55\xe8\x8c\x08\x27\x6f\x1f\xab\xa8\xd8\x22\x4c\x0b\x87\x43\xd1\xb9\x72\xa6\xaa\x7d\xfc\x0a\xf2\xf9\xb1\x42\xb2\x31\x8a\x8b\x2d\xb4\x6d\xd3\xd8\x40\xf0\x87\x15\xec\x21\x6f\x5b\xf0\xba\x4b\x48\x77\xb4\xac\x31\x0d\x47\xc1\xa9\x0f\xb2\x16\xb9\x4b\xa3\xe2\xc2\x40\xba\x41\x93\x5a\xfb\x1b\xa3\xea\xdc\xb8\x07\x3b\xd1\xc5\x02\x3a\xe9\xb6\x05\x8d\x46\x3b\x32\xb9\x43\xf2\x91\x3e\x58\xdc\xc0\x45\x4d\x92\x89\x13\xcb\xf6\xf2\xdf\xb6\x30\x1f\x32\xa7\x6d\x67\x43\x8b\x99\x8f\x34\x84\xec\xdf\xe7\x64\x46\x4a\xd0\x24\x93\x89\x05\x6e\x31\xb7\x41\x18\xfb\x7e\x51\x3f\xa0\xe2\x39\x18\xab\x23\x77\xa8\x14\x67\x08\x95\xc2\x1d\x97\xb5\x86\x9c\x96\xa5\x06\x23\xe1\x9a\x31\x02\x8e\xd9\xde\x04\x2f\x80\xba\xb4\x78\x34\x3f\x06\x33\x1d\x1f\x9c\xe0\x64\xf4\x0a\xf2\x50\x1b\x6a\xb8\x14\xa4\x69\x22\x68\x7f\xa0\x3e\x0a\x5b\x36\x0b\x9e\x22\xe0\x27\x8d\x1d\x40\x61\xb5\x15\x9a\x5a\x09\x18\xe9\x25\x93\x36\xb1\xe9\x5b\xcc\x81\xee\x24\x67\xb0\x45\x81\xca\x83\xc1\xcb\xd2\x72\x15\x7c\xc5\x6a\x28\xa4\xea\x0f\x2d\x44\x3a\x82\xe0\x59\x63\x21\xc8\x84\x34\x3d\x0e\x41\x78\x06\x99\x74\x5c\xfb\x54\xd9\x10\x6d\x8d\x17\x64\x85\x05\xad\x4b\x33\xf3\x2a\x99\xc3\x2f\xe2\x35\x2d\x88\x2f\xaf\x28\x34\xeb\x1f\x1d\x23\x78\x7f\x40\xb7\xe8\xee\x28\xed\x22\xef\xf6\xd4\xcf\xf0\xcf\x3e\xca\x5e\x6d\xf9\x0e\x05\x38\xe2\xdb\xee\x69\xe3\x15\xbc\x24\xc9\xe4\x12\x7a\x8e\x1c\xf7\x34\x9d\x3f\x83\xa7\x13\x5e\x40\xa7\xf0\xbf\xa5\x75\xef\xcf\x0f\x78\x30\x4c\xff\x7c\x98\xff\x89\xe3\xe0\x53\x2c\x98\xf8\x2c\xc6\x26\x32\xc8\xe8\x01\xa9\x0b\x72\x23\xc5\x0e\x95\x41\xf6\x59\xbe\xa5\xfa\x80\xe8\x47\x9a\xc1\x35\x63\x27\xb3\x12\xbb\x01\x65\x4c\xf7\x0f\x35\x72\x3f\x2b\x17\x22\xfe\x92\x86\x70\x79\x5d\xbd\x0c\xd2\xbe\x12\xce\x41\x77\x53\x22\x55\xcf\x02\x2f\xb7\x92\x9e\xcc\x9e\xab\xb2\xf8\x57\xf0\xfb\x19\xa4\x2e\x40\x68\x80\x55\x3f\xc3\xd0\xcf\xf2\x77\x6c\x8b\xfd\x0c\x93\x6e\x88\xa5\xd4\x92\x2a\x8e\xac\x29\x92\x5b\xc1\x7f\xb8\xa9\x1b\x64\x96\x6e\xd9\x08\x22\xc3\x49\xc5\x99\xde\xef\x1e\x59\x5c\x3d\x64\x35\x83\x4c\x73\xb1\xad\x4b\xaa\xac\x4d\x87\xdc\xdf\x61\x35\x99\x41\xba\x5e\xe9\xa7\x7d\x46\xbb\xc7\xcd\xc6\x3f\xde\xa8\xb3\x35\x8a\x2d\xe4\x33\x9a\x09\x8c\x95\x96\x69\x7d\x8f\xc2\xae\x47\x21\xdb\x62\xac\x11\x0c\x45\x1a\xae\xee\x1e\x81\x33\x1f\xa4\x6b\xc8\x83\x40\x75\xe7\xf0\xb2\xf1\xda\x47\x95\x1d\xbe\xde\x39\x43\xbf\x56\x71\xa6\x81\x10\xd2\xb9\x19\xc6\xb7\x5e\x9d\x9b\xc7\x27\x38\xf5\xe2\x08\x4e\x8f\xbf\x61\x61\x76\x06\xa7\xd8\x97\xe8\xc1\xe8\x59\xaf\xf4\xc9\xe9\x83\x7b\xa5\x1a\xf2\x7c\x38\x82\xa2\x99\xf1\x14\x7a\x7e\x86\xff\x93\x01\xd5\x87\x95\x71\xe6\x45\x9f\x99\x3d\x3b\xa5\x38\x7b\x7a\x3e\xb5\x2d\x2c\xc7\x19\x18\x67\x76\xce\xd9\xa5\xd3\xaa\xdf\x6b\x4b\xf9\x27\x2a\xc8\x5c\x52\x0a\x48\xff\x4f\x5e\xeb\x74\x0f\xb9\x6e\x55\x3f\xb7\xe4\x9e\x5f\x70\xf7\x8a\x7b\x94\xf2\x23\x7b\xee\xd9\x4a\x6e\x9a\x71\xb1\x0e\x6b\xf5\x38\x0b\x7e\x7e\x41\x3e\xd2\x20\x86\x95\x33\x1f\xf9\x3c\x51\xb7\x7b\xf5\x78\xd5\x9e\xc8\xdf\x91\x62\x76\xf1\x90\xf5\xaa\x5b\x73\x6d\x21\x07\x23\xdc\x7f\xd0\x3d\xd0\xef\x98\x7d\xf9\x7a\x94\x8e\xbf\x40\x89\xa2\x9f\xca\xb3\x38\x9e\xb8\x9b\x13\x3c\xdd\xfb\xb0\xe1\x5e\xca\xdf\x2f\x21\xfd\x36\xe8\xc2\xc1\xa5\xdd\x74\xfd\x7d\xdb\xba\xef\x25\x37\x8c\x7a\xdc\x1c\xb3\x39\xd3\x5f\xa2\xd0\xd7\x40\x6c\x7b\xdd\x1f\x92\xf5\xea\x0c\x95\xc7\x50\x70\xa6\x09\x21\xe3\x65\x7f\x6f\x36\x2e\x16\xf0\x21\x74\x45\xf0\x46\x7b\x46\x91\x78\x13\x89\x25\xef\xbe\x61\x6e\xfc\x97\x39\x42\x48\x1a\x49\x9e\x49\x9a\x68\x2d\x6e\x00\x07\xe6\x9b\xe4\xa9\x77\xc5\xc6\x9d\xf8\x69\x1e\x82\xff\x27\x00\x00\xff\xff\x37\x9e\x70\x0b\xcb\x10\x00\x00")
+var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x4f\x6f\xdb\x3e\x12\x3d\x5b\x9f\x62\x56\xf0\x02\xb6\xd1\xd0\x6d\x0f\x0b\xb4\x80\x0f\x69\x9c\x2c\xbc\xd8\x24\x8b\xb8\x3d\x15\x3d\x30\xe2\xc8\x61\x2b\x93\x0a\x49\xa9\x0d\xbc\xfa\xee\x8b\xa1\xfe\xfa\x4f\x6c\x27\xce\x9e\x7e\x37\x5b\x1c\x3e\xce\xbc\x79\x43\x72\xb8\x5a\x8d\x47\xc1\x85\x4e\x9f\x8c\x5c\x3c\x38\xf8\xf8\xfe\xc3\xa7\xb3\xd4\xa0\x45\xe5\xe0\x8a\x47\x78\xaf\xf5\x2f\x98\xa9\x88\xc1\x79\x92\x80\x37\xb2\x40\xe3\x26\x47\xc1\x82\xaf\x0f\xd2\x82\xd5\x99\x89\x10\x22\x2d\x10\xa4\x85\x44\x46\xa8\x2c\x0a\xc8\x94\x40\x03\xee\x01\xe1\x3c\xe5\xd1\x03\xc2\x47\xf6\xbe\x1e\x85\x58\x67\x4a\x04\x52\xf9\xf1\x7f\xcf\x2e\x2e\x6f\xe6\x97\x10\xcb\x04\xa1\xfa\x66\xb4\x76\x20\xa4\xc1\xc8\x69\xf3\x04\x3a\x06\xd7\x59\xcc\x19\x44\x16\x8c\xc6\x45\x11\x04\xab\x15\x08\x8c\xa5\x42\x08\x2d\x3a\x87\x26\x84\xa2\xa0\xaf\xfd\xfb\x4c\x26\xe4\xc3\xe7\x09\xa4\xdc\x46\x3c\x81\x3e\x9b\x47\x3a\x45\xf6\xa5\x1a\xa9\x0c\x0d\x46\x28\xf3\xd2\xb2\xf9\xdd\xbf\x5f\x37\x8a\x25\x26\xc2\x92\x49\x9f\x5d\x95\xbf\xab\x91\x2c\x15\xdc\x95\xb3\x63\x9e\x58\x2c\x67\x9c\x81\x8c\x41\x1b\x18\x3c\x70\x3b\xcf\xe2\x58\xfe\x69\x3d\x0a\xbf\xf9\x29\xe1\x70\xdf\xe8\xad\xc2\x70\x48\x58\xbd\xee\x22\x13\x70\x26\xc3\xe6\x73\xe5\x15\x39\x75\x9d\x39\x7e\x9f\x60\xd7\xb7\x33\x40\xf2\x47\xc6\xd0\x67\xb3\x29\xfb\x66\xd1\x4c\x3d\x57\x62\x1b\x80\xa7\x29\x2a\xd1\x7c\xa0\x09\x0d\x88\xf2\xf6\x14\xac\xe1\x6a\x81\xd0\x8f\x29\xd8\xda\xb4\x28\x56\x2b\x0a\x56\x69\x07\xfd\x98\xcd\xec\x3f\x51\xa1\xe1\xae\xb3\x4a\xba\x4e\x6d\xcc\xbe\x3e\xa5\xc8\xe6\xce\x48\xb5\x68\xe6\xe3\x23\x19\xf6\x1b\xb3\xa2\x80\x72\xee\x04\xc2\x9c\x27\x19\x52\x66\xe9\x13\xaa\x16\x39\xce\x54\x44\xe0\xa9\x91\xca\x41\x38\x47\x17\x12\xfe\xdc\x99\x2c\x72\x9e\x0b\xef\xc4\x78\x0c\x8d\x75\x51\x80\x45\x67\xbd\xce\xfc\x47\x76\xc3\x97\x44\x29\xf8\x80\x58\xd0\xf3\xa0\x83\x35\x69\x14\x05\x8c\xba\xa2\x2a\x8a\x61\x17\xd1\x1b\xa7\x95\x7f\x55\x7c\xde\x66\x63\x12\xac\x82\x5e\x8f\x38\x1d\x8f\xc8\x09\x47\xf1\xab\x6c\x89\x46\x46\xe0\x68\x8e\xce\xd1\x18\x29\x10\x52\x83\xb9\xd4\x99\x85\x88\x27\x89\x05\xa7\xe1\x5c\x08\x06\x5e\xf4\x25\x84\x8c\x81\xfb\x8c\xf9\xd5\xd8\x4d\x05\xd3\x48\xc5\x1b\xf6\x36\xa2\x60\xcb\xcc\x71\x27\xb5\x62\xab\x55\x4d\xda\x1d\xda\x9d\xb4\x0d\x86\x95\xb3\x35\xe1\x7b\xc1\xb6\xa8\xa0\xd9\x06\x5d\x66\x14\x6c\xcc\x0b\x7a\x45\x40\xe9\x1b\x8f\x80\xe7\x5a\x0a\x58\x90\x62\x4a\x32\x64\x92\x90\x8c\x3d\x3b\x68\x2c\xc4\xda\xb4\x1f\x89\x22\x5b\x93\x50\xaa\x8e\x28\x18\x54\xd2\x2b\x79\xa8\x8c\x87\x30\xd0\x86\xd8\xb9\x4d\x29\x5e\x2a\xff\x98\x4d\x31\xe6\x59\xe2\x86\xe5\x94\x01\x4d\x6e\xf8\xea\xc7\xac\xac\xbc\xda\x68\xd8\x06\x5d\x7b\x70\xb5\x25\xb7\x7a\xb9\x9d\xb2\xab\x75\xb7\x36\xfd\x80\xfe\x28\x28\x1a\x5a\xc8\x1c\x15\x78\xe1\xd3\xc6\x4a\xfe\x2a\x99\xb0\xa0\xf7\x12\x79\x6e\x2c\xdc\xca\x74\x74\x84\x4e\x7b\x32\xae\x2a\xb0\x28\xe0\x6f\x13\x4a\x83\xd7\xef\xb6\x0e\xba\xe9\x1f\xd5\x53\x28\xff\x3d\x22\xe1\x59\x15\xd0\x68\x5b\xcf\xdd\x8c\x6e\x89\x3a\x66\x17\x5a\xe5\x68\x1c\x8a\xaf\xfa\x0b\xb7\x5b\x42\xdf\xb1\x19\x9c\x0b\xb1\x37\x2b\x95\xc7\xc0\x85\xb0\x6d\xa0\x4e\xaf\x67\xe5\x85\x8c\xd7\x34\xbc\x64\x43\x78\x79\x5d\xbd\x8e\xd2\x99\xfd\xd7\xfc\xf6\x66\xa6\x22\x83\x4b\x54\x8e\x27\x87\x39\xf4\x1b\xea\xc0\x4a\xb5\xc8\x12\x6e\x36\xd8\x1c\x42\x78\xee\xc2\x9d\x9c\x36\x0a\xc7\xc4\xaf\x05\xdc\x81\x54\x02\xff\x80\x2c\x4f\xf3\xe7\xf6\xde\xd7\x70\x2d\x41\x2a\xf7\x0e\xf2\x0a\x92\x82\xbc\x4c\x70\xf9\x06\x9c\xcb\x77\x90\x9f\xca\xf7\xb9\x3f\x59\xa9\x0a\x8f\x90\xac\xb7\x3d\x4e\xb5\xde\xd4\x42\xee\x0f\x87\xb7\x25\x34\xb7\xc0\x18\x7b\x73\x36\x73\xcb\x18\x3b\x95\xce\x3b\x5c\xea\xfc\x38\x36\xbd\xe9\xfe\x9d\xb9\x72\x0d\x8c\x37\xb5\xc0\x93\x04\x74\x14\x65\xc6\xa0\x8a\xd0\x92\x56\x73\x0b\xb1\xd1\xcb\xbf\x10\xc5\xd7\x68\x16\x78\x1c\xc5\xde\xf4\x58\xbd\x26\x12\x6d\xe7\x6c\xa3\x6c\xc2\x92\x00\xce\x52\xee\xa2\x07\xd0\xea\x8d\x49\x2e\x61\x7f\x5a\xad\xd8\x1d\xff\x7d\x8d\xd6\xf2\x05\x9e\x42\xaf\x07\x3c\x95\xde\xff\x10\xc8\x3a\xbd\x03\xba\xfc\xb2\xb9\xd3\x86\x2f\xb0\xd4\x57\x68\x1f\x93\x70\xf8\x2c\xf1\x1e\xe4\x14\xe2\x7d\x28\x30\xb8\xbb\xba\x80\x7f\x7c\x7a\xff\x71\x08\x3a\xa5\x5b\xbb\xd4\xca\xbe\x7d\x22\x74\x6a\xe1\xfb\x0f\xfb\x98\xb0\x86\x80\xdb\xf4\x94\x44\xe8\xd4\xbe\x3e\x0d\xed\x8d\xf0\x90\xbc\x2f\x12\xe4\xe6\x28\x96\x23\xb2\x2c\xd5\x5d\xde\xd9\x74\xbc\xce\xdf\x2b\x99\x3b\x85\xa4\x17\x30\xd4\xfc\x5a\xad\x76\xf4\x7b\x48\xc2\xeb\xb3\x4b\xb1\x40\xdb\xb4\x5e\xda\x77\x75\x21\xa7\x5b\x56\xdd\xc3\xf5\x91\x7d\x53\xf2\xd1\x77\xa8\x95\xcd\xc4\x37\xe6\xe1\x1a\x34\x2d\xdc\x97\xc2\xae\x5f\xa7\x07\x75\x9b\xae\xd3\x61\xf7\xce\x51\x15\xc4\x7f\xab\x36\x7e\x08\xe1\x6c\x6a\x9f\x5f\xb3\xc6\xdd\x0d\x5b\xff\x29\x41\x3d\xd6\x86\x6f\x55\x62\x6b\x98\xea\x0a\xa7\xe9\xea\xd5\x5e\xda\x2b\x9f\x8a\x02\x50\x2c\xb0\xbe\x34\x62\x75\x6b\xad\x86\xee\x9f\x40\x8a\x4e\x73\xdc\x71\xd4\x36\x0b\xbe\xac\xdf\x6c\xbd\x1a\x6c\x47\xef\x17\xf3\x2d\x7f\x51\x48\x51\x1f\x30\x65\x86\xbb\xfe\xcd\xa6\xfb\xef\xa3\x7b\xc5\xf5\x6a\x0f\xf6\xf7\x83\xdd\x0a\x6d\x00\xfb\xd8\xd6\x6a\x53\xa2\x75\x4f\x33\x9b\xda\xbd\xed\x18\xae\xb5\x63\x55\x9e\xdb\xc2\xdd\x84\xd9\x6c\xcb\x8e\xcf\xf0\xff\xa5\x63\x6b\xdd\x1a\x48\x01\xa3\xce\xda\x87\xb2\x47\x6d\x9b\x14\xcf\x37\x6c\x45\x01\x93\xcd\x0c\x6c\x66\x76\x24\xc5\x4b\xdb\xb7\xf6\xa1\x27\xd1\xbf\xd1\xc0\xc0\x57\x5f\x0c\xe1\xdf\xd9\x07\x1b\xae\x31\xd7\x3c\x6b\x1d\x7a\xf5\x39\xfc\xe2\xb3\x56\xdc\x7d\x3c\xf4\xf0\x73\xb0\x92\x57\xab\xcd\x62\xed\xd6\xea\x6e\x15\x9c\xfe\x62\xb4\x63\x83\xe8\x56\x4e\x37\xfb\x24\xca\x3d\x75\xbb\x56\x8f\x67\xc5\x9e\xfc\xed\x28\x66\xdf\x00\xb3\xd9\xb4\x79\xf7\x49\x6c\x03\x42\xfb\xc9\xe7\x09\x2c\xf9\x2f\x1c\x7c\xff\xb1\x53\x8e\xef\x20\x41\xd5\xe0\x0c\x87\xf5\x39\x25\x29\x5d\xa1\x6c\x77\x6c\xba\xec\xc8\x32\x7a\xb2\x96\x30\x81\xf0\x67\x67\x17\xae\x96\xa4\xa7\x9f\x72\xbc\x28\x08\xa2\x3c\x8c\x6a\xfc\x4a\xd9\x52\xd8\xef\xb5\xd1\x8f\x4a\xd8\x34\xdc\x7e\x64\xb3\xe9\x01\x29\x6f\x52\x21\x45\x7d\x8b\xee\xbe\x7e\x75\x0e\xc9\x20\x18\x8f\xe1\xba\xda\x15\xa1\xe4\xb7\x55\x14\xab\x47\x6a\x61\xe9\xfb\x9f\x18\xb9\xba\xef\xad\x92\xc6\x82\x23\x45\x53\xa3\x0d\xaa\xa4\x6f\xc1\xaf\x82\xe7\xe2\xaa\x37\xee\xa0\x3c\xcd\x51\x09\x28\x8a\xe0\x7f\x03\x00\xf8\x57\x39\x6a\xf7\x17\x00\x00")
 
 func templateBuilderSetterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -241,12 +241,12 @@ func templateBuilderSetterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 4299, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 6135, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x6f\x6f\xdb\xba\xd5\x7f\x6d\x7d\x8a\x53\xc1\x2d\xa4\xc0\x96\xd3\xbe\x7b\x52\xf8\x01\x6e\xdb\x74\x0b\xb0\xf5\x0e\x4d\xef\xdd\xc5\x72\x83\x82\x96\x8e\x6c\x2e\x32\xa9\x92\x94\x93\xcc\xd3\x77\x1f\x0e\x49\xc9\x92\xad\xe4\x3a\x45\xb6\xe1\x02\x03\x02\x44\x12\xc9\xc3\x73\x7e\xe7\xdf\x8f\xf4\x76\x3b\x3b\x09\xde\xcb\xf2\x5e\xf1\xe5\xca\xc0\x9b\xd3\xd7\xff\x37\x2d\x15\x6a\x14\x06\x3e\xb2\x14\x17\x52\xde\xc0\x85\x48\x13\xf8\xa1\x28\xc0\x4e\xd2\x40\xe3\x6a\x83\x59\x12\x7c\x59\x71\x0d\x5a\x56\x2a\x45\x48\x65\x86\xc0\x35\x14\x3c\x45\xa1\x31\x83\x4a\x64\xa8\xc0\xac\x10\x7e\x28\x59\xba\x42\x78\x93\x9c\x36\xa3\x90\xcb\x4a\x64\x01\x17\x76\xfc\x4f\x17\xef\xcf\x3f\x5d\x9e\x43\xce\x0b\x04\xff\x4d\x49\x69\x20\xe3\x0a\x53\x23\xd5\x3d\xc8\x1c\x4c\x67\x33\xa3\x10\x93\xe0\x64\x56\xd7\x41\xb0\xdd\x42\x86\x39\x17\x08\x61\x55\x66\xcc\x60\x08\x75\x4d\x5f\xc7\xe5\xcd\x12\xce\xe6\xb0\x60\x1a\x61\x9c\xbc\x97\x22\xe7\xcb\xe4\x2f\x2c\xbd\x61\x4b\x04\xbf\xd4\xe0\xba\x2c\x98\x41\x08\x57\xc8\x32\x54\x21\x8c\x0f\x87\xf8\xba\x94\xca\x34\x43\xee\x0d\xa2\x60\xb4\xdd\x4e\x41\x31\xb1\x44\x18\x97\xcc\xac\x68\xb3\x71\x72\xc9\x17\x05\x17\xcb\x0b\x3b\x4b\xd3\x8a\xd1\x28\xb4\xea\xd0\x94\xba\x0e\xdd\x3a\x14\x19\x8d\xc5\x81\xdd\x6b\xbc\xa8\x78\x41\x78\x59\x11\x3f\x59\x3b\x3e\xb1\x35\x36\xa6\x28\x4c\x91\x6f\xdc\x78\xfb\xdc\x2e\xf2\x93\xd6\x95\x61\x86\x4b\x41\x93\x4a\xc5\x85\xe9\xac\x0b\x93\x66\xd4\xc2\x13\xcc\x66\xd0\xdd\xb6\xae\xc9\x77\x04\x7c\xf3\x25\x97\x0a\x2c\x9e\x5c\x2c\xed\xd4\xc4\xeb\x03\x28\x0c\x37\x1c\x75\x12\x98\xfb\x12\xf7\xc5\x68\xa3\xaa\xd4\xc0\x36\x18\xa5\x16\x70\x67\xed\x0e\x4b\xe7\xa3\x59\xce\xb1\xc8\x34\x41\x3a\x25\x84\x4a\x85\x19\x4f\x99\x41\x0d\x57\xd7\xed\x4b\xd2\xdd\x37\x70\x5a\xff\x75\x85\x0a\x81\x65\x99\x06\x06\x02\x6f\xa1\x9d\x6d\x55\xee\x98\x90\x04\x79\x25\x52\x88\xba\xf8\xd5\x35\x9c\xf4\x15\x8e\x9d\xc4\xa8\xd4\x90\x24\xc9\xf0\xd6\xf1\xfe\x22\x32\xaf\x2f\x36\xe9\x58\x30\x07\x56\x96\x28\xb2\xe8\xc1\x29\x13\x28\x75\x92\x24\x71\x30\x52\x68\x2a\x25\xa0\xe7\x63\x67\xeb\x76\x0b\xb7\xdc\xac\x00\xef\x0c\xc5\xca\x18\xc2\x77\x6e\xff\xb0\xe7\xf8\x51\x2f\x52\x35\x1a\x43\x33\x12\x1f\x13\x3e\xca\xbe\x4f\x98\x77\x15\x66\x4b\xd4\x87\x22\x67\x33\xb8\x64\x1b\x04\xbc\xc3\xb4\x22\xb3\x09\xfa\x6f\x15\xaa\x7b\x60\x22\x03\x67\x98\xfb\x2a\xaa\xf5\x02\x15\x25\xb1\x92\xb7\x7a\xb6\x41\x65\x78\x8a\x1a\xd6\xcc\xa4\x2b\xcc\x60\x71\xef\xb2\x5b\x96\xa8\x6c\x8c\x0e\xb9\x0e\x86\x7c\x47\x1a\x44\xa9\xb9\x83\x54\x0a\x83\x77\x86\xb2\x9c\xfe\xc7\x10\x71\x61\x26\x80\x4a\x49\x15\x7b\x77\xed\x21\xf0\xd9\x0b\x0e\xbb\x69\xe2\xcb\x43\xe8\xaa\x47\xf8\x37\x54\xf2\x67\x56\x54\x18\xc2\xa9\x8b\xd4\x41\x88\x34\xdb\xa0\x47\xa8\x4d\x6e\x3b\x7b\xc3\x14\x15\x8a\x11\x2a\xe5\x74\x09\x46\x23\x96\xe7\x98\x1a\xcc\x80\x0b\x13\x8c\xe2\x60\xc4\x73\x28\x50\xec\x1b\x9b\xac\xa4\xbc\xd1\x31\xcc\xe7\x70\x4a\x06\xb4\xeb\xac\x55\x30\xdf\x8f\x19\x17\xb1\x97\x46\x2a\x57\xde\x1a\x68\xe2\x60\x54\x03\x16\x1a\xad\x10\x52\x68\x5d\x19\xf8\x33\x55\x03\x49\x62\xec\x13\x7e\xac\x44\x1a\x11\xe8\x43\x68\x4e\x60\xed\xa6\x71\x29\x62\x88\x2c\x20\x5d\x6c\x47\xa3\xa6\xb8\x4c\x40\xde\x50\xf9\x59\x27\x91\xf5\x55\xd2\x2c\x6b\x32\x89\x26\xf3\x1c\x5e\xc8\x1b\xb7\xb0\x49\x00\xc1\x8b\x09\xe4\x6b\x93\x9c\x93\xd4\x3c\x0a\x2b\x81\x77\xa5\xc3\xa9\xad\x6b\xb6\xde\xbc\xfc\x12\x4e\x60\x6d\x05\x91\x3b\x46\xbd\xca\x57\xd7\x30\x6f\xe7\xd3\xe8\xf7\x83\xb6\x33\x2a\xc9\xa4\x40\x98\x83\x51\x15\x06\x3b\x95\x7b\xa2\x83\xd1\xc8\x1a\x47\x35\x88\x13\x02\x8f\x78\x74\x0a\xaf\xdf\x02\x87\xff\x9f\xc3\xe9\x5b\xe0\xd3\x69\x0b\xe1\x80\x7e\x76\xc9\x15\xbf\x8e\xd6\x95\x21\xf9\x64\x32\xcf\xe1\xab\xb3\xe7\xcc\x1a\xeb\x40\xb6\x7a\x4f\x60\x0f\x8e\xf8\xad\x9d\xf8\x62\x4e\x08\xbb\x8d\xbc\xfa\xa7\xad\xde\x01\xfd\x0d\x1a\xb5\x4b\xf3\x5f\x5c\x6f\xbf\x41\xfb\x36\x81\x45\x65\xa0\x64\x82\xa7\x1a\x78\x0e\x4c\xb8\x68\x00\x99\xa6\x95\xd2\x4f\x4a\xdf\x5f\x86\xf3\x97\xda\xd7\x36\xd8\xf3\xdf\xd9\x21\x40\x1d\x8f\xf1\x7c\xdf\x56\xab\x61\x84\x4a\xc5\x43\x36\x7a\xf3\xce\xef\x30\x1d\xa8\x62\x47\x1b\x41\xeb\x87\x6d\x70\x98\x6c\x83\xd1\xd7\x63\xd4\xf7\xda\xed\x70\x27\xc1\x3b\xdc\xe9\xed\xb9\x70\xb7\x92\x87\x75\xde\xb6\x38\x0e\x68\xdb\x98\x7a\x18\x55\x7d\xa4\x8f\xec\x38\x7b\xd5\xd6\x37\xa0\xb1\x59\x97\x45\xcb\x61\x72\x08\x33\xce\x0a\x4c\xcd\xec\xa5\x9e\x35\x0c\xaf\x9b\xb3\x76\xd1\x5d\x5b\x93\xdd\xf2\x81\x06\x38\x96\x02\x07\x68\xd6\x8f\x62\x98\x69\x75\x89\x56\x67\xe5\x3e\xd7\x3a\x9a\x6a\xf5\x64\x3c\xca\xb6\x18\x68\x2e\x96\x05\x0e\xd0\xae\xfb\x0e\xe9\xea\x0b\x7c\x32\xef\xfa\x6d\x96\xd1\xb7\xfa\x38\xa2\xf1\xdd\x02\x9f\x8d\x6c\x38\x41\x59\x8b\xd7\x23\x29\xd1\x47\xf0\x51\x36\x71\xd2\xf5\xc5\xb3\xf2\x8a\x50\xf0\x22\x7c\x2e\x6e\x21\xe8\x14\xd6\xd3\xf5\x29\x0c\x83\x56\xff\x8f\x5d\x3c\x81\x5d\x7c\x1f\x60\xbf\xc9\x2c\x5a\xb1\xbf\x3f\x56\x61\x91\x1e\xe0\x15\x3b\x93\xfe\x1d\x9c\xa2\x97\xc8\x8f\xd2\x8a\x5e\x6e\x34\xc7\xb8\xe4\xf3\x4e\xe0\x73\x12\x8d\x7d\xd9\x8f\x13\x0e\x90\xee\xea\xe3\xa9\x85\xeb\x77\xc3\x40\x06\xb4\xfe\x2f\x92\x90\x8e\x36\xff\x59\x1e\xb2\x7b\x9c\x9d\x80\x5e\x31\x85\x59\xd3\xbd\x5d\x77\x86\x05\x9a\x5b\x44\x17\x0d\xe6\x56\xfa\x96\xa6\x34\xd8\x1b\xaf\x83\x0b\xaf\xa6\xa9\x93\x0a\x36\xb3\xe1\xea\xfa\x8f\x52\xde\x04\x6d\x9d\x81\xc1\x72\xf9\x90\x32\xb6\x07\x83\xc2\xb5\xdc\xb0\xe2\xc9\xca\xf8\x0e\xee\x79\x52\x87\x70\x95\x4c\xa7\xac\x80\xe4\x32\x95\x25\x26\xef\xfa\x7c\xea\xd9\x2f\xb8\xb6\xdb\xe6\x6a\xee\xeb\x04\xc6\xe8\x18\xdf\xb9\xb5\xcc\xbb\x8a\xe7\x30\xc6\xe4\x27\xc1\xbf\x55\xce\x7d\xd6\xe9\x36\x7e\x5b\xf9\xe1\xfb\x02\x19\x45\x0b\x26\x97\xd6\x45\x1f\x09\x6a\x37\xdb\xf3\x3a\xbb\xa0\xae\x21\xa5\x99\x2e\x9d\xe9\x33\xee\x88\x5b\xb6\x44\x30\xd2\x7f\xfd\x72\x5f\xb6\x43\x09\x95\xf6\xe3\x18\x7b\x67\xa7\x68\xf0\x3a\xea\xa0\x55\x25\xbd\x25\x9d\x12\xbd\x7f\xd7\x64\x2b\x35\x85\x02\x75\xf1\x16\x87\xd2\xb6\x1b\x79\x8b\x0a\xa2\x26\x01\x5e\x26\xaf\x75\xd8\x33\x22\x6e\x16\xcc\x4e\x08\x4f\x7b\xd9\x43\xb6\x49\xf7\x5c\x32\xc5\xd6\x68\x50\x51\x8a\xe7\x05\x4f\x8d\x76\x09\x69\xaf\x78\x1b\x1d\xec\x0a\x1b\x4d\x23\xef\x17\xfc\x46\x0a\xf4\x10\x71\x3a\xcd\x21\xdc\x84\xfe\xd5\x87\xae\x53\x97\x67\xfa\x63\xdf\x73\x9f\x29\x7e\x31\x84\x88\xc8\x74\x55\x30\xd5\xfa\xe4\x9f\x3e\x14\x63\x08\x2f\x3e\xb8\x50\x6d\xbd\xd9\xc8\xa9\x6b\x97\x00\xf8\x34\x8f\xc2\xe2\x1e\x78\xa6\x9f\xe8\xd8\xdd\xa6\x11\xcf\xec\x3d\x64\x47\xf2\xc5\x07\xfb\xff\xa1\x6b\xc8\x61\xbf\xf7\x25\xba\xab\xc6\xc7\x03\x60\x28\xf8\x1b\x08\x8f\x88\xfe\x06\xac\x43\xa0\xf4\xb3\xc6\xbe\x0b\x83\xba\x26\x90\x4e\x0e\xa5\x3e\x00\x11\xa1\x4a\xac\x86\xdd\x60\x74\x75\x3d\x08\xee\xa4\xe5\x56\x24\x3e\x8e\x1b\x64\x2d\xed\x0a\x39\x45\xc9\x2e\x36\xb9\x9b\xe5\xc6\xe7\x10\xfe\xdd\x0f\xb7\xdc\xdc\x51\x36\x37\x5e\xd7\xb6\xa8\xd9\x62\xd4\xaa\xef\xe8\x29\xcf\xf4\x55\x33\xe9\xda\xf3\x34\x1a\xde\x7d\x4c\x2e\x3e\xb4\x5c\x74\xd8\x7d\x0f\xfb\xdb\xa7\xb5\x4b\x93\xa1\xa7\x5e\xd5\x6f\x1b\x57\x73\x8d\x4e\x07\x0f\x58\xa3\x59\xc9\xac\xc9\xe7\x37\xcd\x81\xf5\xc1\xea\xef\x4e\x2b\x76\x68\xda\xfe\x02\xe3\x4b\x7e\xf3\xd3\xcb\xb4\x19\xfe\x07\x2a\xd9\x19\x6f\x0f\x45\xed\xfa\x6e\x57\xf0\x93\x5a\x3a\xd5\x4a\x39\xb6\x2b\x4c\x9d\xc5\xd3\x6e\x5f\xc8\x5d\x5f\xf8\xe8\xfa\xee\xb4\x39\x63\x51\x6b\xc8\xfd\xfd\xc0\x07\xcc\x59\x55\x18\xef\x57\xc7\x92\xdd\x31\x64\xb0\xe0\xb6\x4d\xf6\x0f\x68\x6c\xe5\x7d\xeb\x8e\x23\x5b\x2f\xf4\xc7\x92\x06\x59\x41\x41\xf0\xea\x15\xbc\x18\x16\xd2\x4f\x37\xdb\x84\x30\x8b\xe2\x5d\xd9\x73\x01\xb4\x69\xd4\xe8\xfc\xac\xe5\x25\xf4\x94\xf7\xd9\xd1\x2a\x71\xa1\xbf\x70\xfb\x25\x8a\xbb\x85\xf4\xa0\x94\x5c\xa2\x19\xd2\x27\xda\xf4\xc3\xcb\xe3\xe6\x4a\x3b\x9d\xc7\x23\xa9\x68\xd5\xcf\xac\xe0\x19\x1d\x04\xb5\xdb\xf4\x5c\x54\xeb\x18\x22\x21\x8d\x7d\x5f\xd3\x56\x8b\x02\xe3\x1d\xb6\x9b\xa7\x62\xdb\x9c\xf4\x6c\x24\x2c\x98\xe6\xb6\x7e\x8d\xf3\xe4\x1d\x3d\xdb\xdc\x76\x1d\xc3\x1f\x0d\x3b\x9c\xf2\x10\xb3\x56\xdf\xa6\xd2\x38\x81\x83\xe7\x9d\x6e\x36\xda\x38\xa6\x12\xf2\xca\x4b\xe0\x52\xd8\x93\xe6\x96\x80\x3f\x83\xd0\x89\xf7\x5e\x08\x2d\x15\x3f\xeb\x9d\x47\xb7\xdb\x86\x7a\x9e\xc1\xa6\xd5\x22\x67\xbc\xc0\xcc\x26\xa4\xa5\x78\xf0\x6b\x5f\xd2\xaf\xe1\x19\xbc\xbc\x75\xf2\xe2\xba\xa9\x13\x7d\xbf\xf4\x1e\xa7\x47\x70\x22\xf2\xdf\x8e\x17\x39\x67\x61\x1b\xb6\xf1\x91\x79\xb0\xdf\x31\x2e\x3e\x90\xb7\x8e\x99\xb9\x0b\x76\x4a\x8f\xc6\xbf\x43\x68\xdb\x83\x87\x4e\x3e\xe1\x6d\x1f\x40\xcb\xc4\xdc\x45\x5a\xe5\xac\xb0\x0d\xdb\x81\x87\x3b\xf0\xc2\xc3\x28\x3e\x7c\xac\xeb\xe0\x5f\x01\x00\x00\xff\xff\x22\x99\x9d\x23\x26\x1f\x00\x00")
+var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x6f\x6f\xdb\xc8\xd1\x7f\x4d\x7e\x8a\x39\x42\x09\x48\xc3\xa6\x9c\xbc\x7b\xec\x47\x05\x72\x89\xd3\xaa\xe8\x25\x87\x73\xee\x7a\x68\x12\x04\x2b\x72\x28\x6d\x4d\x2d\x99\xdd\xa5\x6c\x57\xe1\x77\x2f\x66\x77\x49\x91\x32\xa5\x58\x8e\xdb\xe2\x80\xbc\x8a\x4c\xce\xcc\xce\xfc\xe6\xcf\xfe\x76\x99\xf5\x7a\x7c\xe4\xbf\x2c\xca\x5b\xc9\xe7\x0b\x0d\xcf\x4f\x9f\xfd\xdf\x49\x29\x51\xa1\xd0\xf0\x9a\x25\x38\x2b\x8a\x2b\x98\x8a\x24\x86\x17\x79\x0e\x46\x48\x01\xbd\x97\x2b\x4c\x63\xff\xdd\x82\x2b\x50\x45\x25\x13\x84\xa4\x48\x11\xb8\x82\x9c\x27\x28\x14\xa6\x50\x89\x14\x25\xe8\x05\xc2\x8b\x92\x25\x0b\x84\xe7\xf1\x69\xf3\x16\xb2\xa2\x12\xa9\xcf\x85\x79\xff\xb7\xe9\xcb\x8b\x37\x97\x17\x90\xf1\x1c\xc1\x3d\x93\x45\xa1\x21\xe5\x12\x13\x5d\xc8\x5b\x28\x32\xd0\x9d\xc5\xb4\x44\x8c\xfd\xa3\x71\x5d\xfb\xfe\x7a\x0d\x29\x66\x5c\x20\x04\x55\x99\x32\x8d\x01\xd4\x35\x3d\x1d\x95\x57\x73\x38\x9b\xc0\x8c\x29\x84\x51\xfc\xb2\x10\x19\x9f\xc7\x3f\xb3\xe4\x8a\xcd\x11\x9c\xaa\xc6\x65\x99\x33\x8d\x10\x2c\x90\xa5\x28\x03\x18\xdd\x7d\xc5\x97\x65\x21\x75\xf3\xca\xfe\x05\xa1\xef\xad\xd7\x27\x20\x99\x98\x23\x8c\x4a\xa6\x17\xb4\xd8\x28\xbe\xe4\xb3\x9c\x8b\xf9\xd4\x48\x29\x32\xe6\x79\x81\x71\x87\x44\xea\x3a\xb0\x7a\x28\x52\x7a\x17\xf9\x26\x82\xd1\xac\xe2\x39\xe1\x65\x4c\xfc\x6a\xe2\x78\xc3\x96\xd8\x84\x22\x31\x41\xbe\xb2\xef\xdb\xdf\xad\x92\x13\x5a\x56\x9a\x69\x5e\x08\x12\x2a\x25\x17\xba\xa3\x17\xc4\xcd\x5b\x03\x8f\x3f\x1e\x43\x77\xd9\xba\xa6\xdc\x51\x32\x9a\x27\x59\x21\xc1\xe0\xc9\xc5\xdc\x88\xc6\xce\x1f\x40\xa1\xb9\xe6\xa8\x62\x5f\xdf\x96\xb8\x6d\x46\x69\x59\x25\x1a\xd6\xbe\x97\x18\xc0\x6d\xb4\x1b\x2c\x8d\x4d\x1c\x67\x1c\xf3\x54\x11\xa4\x27\x84\x50\x29\x31\xe5\x09\xd3\xa8\xe0\xfd\xc7\xf6\x8f\xb8\xbb\xae\x6f\xbd\xfe\xfb\x02\x25\x02\x4b\x53\x05\x0c\x04\x5e\x43\x2b\x6d\x5c\xee\x84\x10\xfb\x59\x25\x12\x08\xbb\xf8\xd5\x35\x1c\xf5\x1d\x8e\xac\xc5\xb0\x54\x10\xc7\xf1\xf0\xd2\xd1\xb6\x12\x85\xd7\x37\xbb\xd1\x54\x30\x01\x56\x96\x28\xd2\x70\xa7\xc8\x31\x94\x2a\x8e\xe3\xc8\xf7\x24\xea\x4a\x0a\xe8\x4a\xba\x58\xd7\x6b\xb8\xe6\x7a\x01\x78\xa3\xa9\x56\x46\x10\xfc\x68\x51\x0e\xba\x9e\xf8\x5e\xaf\x52\x15\x6a\x4d\x12\xb1\x2b\x1c\x57\x65\x0f\x33\xe6\x52\x85\xe9\x1c\xd5\x5d\x93\xe3\x31\x5c\xb2\x15\x02\xde\x60\x52\x51\xd8\x04\xfd\xe7\x0a\xe5\x2d\x30\x91\x82\x0d\xcc\x3e\x15\xd5\x72\x86\x92\x9a\x58\x16\xd7\x6a\xbc\x42\xa9\x79\x82\x0a\x96\x4c\x27\x0b\x4c\x61\x76\x6b\xbb\xbb\x28\x51\x9a\x0a\x1e\x4a\x1d\x0c\xe5\x8e\x3c\x08\x13\x7d\x03\x49\x21\x34\xde\x68\xea\x72\xfa\x37\x82\x90\x0b\x7d\x0c\x28\x65\x21\x23\x97\xae\x2d\x04\x7e\x71\x86\x83\xce\x1a\x81\x1b\x0f\x81\x9d\x1e\xc1\x3f\x50\x16\xbf\xb1\xbc\xc2\x00\x4e\x6d\xa5\x0e\x42\xa4\xd8\x0a\x1d\x42\x6d\x73\x1b\xe9\x15\x93\x34\x28\x3c\x94\xd2\xfa\xe2\x7b\x1e\xcb\x32\x4c\x34\xa6\xc0\x85\xf6\xbd\xc8\xf7\x78\x06\x39\x8a\xed\x60\xe3\x45\x51\x5c\xa9\x08\x26\x13\x38\x85\x75\x47\xcf\x44\x05\x93\xed\x9a\xb1\xcd\x72\xa9\x0b\x69\xc7\x5b\x03\x4d\xe4\x7b\x35\x60\xae\xd0\x18\x21\x87\x96\x95\x86\x9f\x68\x1a\x14\x12\x26\xf6\x17\xbe\xae\x44\x12\x12\xe8\x43\x68\x1e\xc3\xd2\x8a\xf1\x42\x44\x10\x1a\x40\xba\xd8\x7a\x5e\x33\x5c\x8e\xa1\xb8\xa2\xf1\xb3\x8c\x43\x93\xab\xb8\x51\x6b\x3a\x89\x84\x79\x06\x3f\x14\x57\x56\xb1\x69\x00\xc1\xf3\x63\xc8\x96\x3a\xbe\x20\x94\xb2\x30\xa8\x04\xde\x94\x26\x5e\x68\x8c\x83\x99\x37\x4f\xde\x05\xc7\xb0\x8c\x48\x99\xd2\xe1\xf5\x26\x5f\x5d\xc3\xa4\x95\xf7\xbd\x6f\x01\x6d\x13\x54\x9c\x16\x02\x61\x02\x5a\x56\xe8\x6f\x5c\xee\x99\xf6\x3d\xcf\x04\x47\x33\x88\x13\x02\x7b\x32\x7a\x02\xcf\xce\x81\xc3\x9f\x26\x70\x7a\x0e\xfc\xe4\xa4\x85\x70\xc0\x3f\xa3\xf2\x9e\x7f\x0c\x97\x95\x26\xfb\x14\x32\xcf\xe0\x93\x59\x94\xd6\x59\x56\xda\x82\x6c\xfc\x3e\x86\x2d\x38\xa2\x73\x23\xf8\xc3\x04\x04\xcf\x61\xdd\x71\xff\xb4\xf5\xdb\xf7\x6a\x7f\x38\xa8\x4d\x9b\xff\x4e\xfb\x43\xce\xaf\xd0\x34\xfd\x31\xcc\x2a\x0d\x25\x13\x3c\x51\xc0\x33\x60\x82\xc4\x0b\x09\x45\x92\x54\x52\x1d\xd4\xbe\xbf\x0f\xf7\x2f\x6d\x5f\x6b\x7f\x2b\x7f\x67\x77\x01\xea\x64\x8c\x67\xdb\xb1\x1a\x0f\x43\x94\x32\x1a\x8a\xd1\xed\x28\x17\x37\x98\x0c\x4c\xb1\x7b\x07\x41\xfa\xc3\x31\x58\x4c\xd6\xbe\xf7\xe9\x3e\xee\x3b\xef\x36\xb8\x93\xe1\x0d\xee\xf4\xd7\x63\xe1\x4e\xb6\x76\xe0\xbe\x6e\x71\x1c\xf0\xb6\x09\x35\x3a\xdf\x8f\xf4\x3d\x77\x9c\xad\x69\xeb\x36\xa0\x91\x5e\x96\x79\xcb\x61\x32\x08\x52\xce\x72\x4c\xf4\xf8\x89\x1a\x37\x0c\xaf\xdb\xb3\x46\xe9\xa6\x9d\xc9\x56\x7d\x60\x03\x1c\x15\x02\x07\x68\xd6\x5b\x31\xcc\xb4\xba\x44\xab\xa3\xb9\xcd\xb5\xee\x4d\xb5\x7a\x36\xf6\xb2\x2d\x06\x8a\x8b\x79\x8e\x03\xb4\xeb\xb6\x43\xba\xfa\x06\x0f\xe6\x5d\x5f\x67\x19\xbd\x05\xee\x49\x34\x1e\x6c\xf0\xd1\xc8\x86\x35\x94\xb6\x78\xed\x69\x89\x9e\x3f\xb0\x97\x4d\x1c\x75\x73\xf1\xa8\xbc\x22\x10\x3c\x0f\x1e\x8b\x5b\x08\x3a\x85\xf5\x7c\x3d\x84\x61\x90\xf6\x77\x76\x71\x00\xbb\x78\x18\x60\x5f\x65\x16\xad\xd9\x3f\x1e\xab\x30\x3c\x6e\x80\x57\x6c\x42\xfa\x4f\x70\x8a\x5e\x23\xef\xa5\x15\xbd\xde\x70\xfd\x3b\x8a\x9b\x96\x6d\x7a\xfb\x91\x88\xc6\xb6\xed\xfd\x84\x03\x88\xe1\x2e\xf0\xe0\xc1\xf5\x87\x61\x20\x03\x5e\xff\x0f\x49\x48\xc7\x9b\xff\x2e\x0f\xd9\xfc\x1c\x1f\x81\x5a\x30\x89\x69\xb3\x7b\xdb\x5b\x11\x98\xa1\xbe\x46\xb4\xd5\xa0\xaf\x0b\x7b\x0f\x83\x52\x81\xb9\xf1\xba\x73\xe1\xd5\x6c\xea\xe4\x82\xe9\x6c\x78\xff\xf1\x2f\x45\x71\xe5\xb7\x73\x06\x06\xc7\xe5\x2e\x67\xcc\x81\x1f\x24\x2e\x8b\x15\xcb\x0f\x76\xc6\xed\xe0\x8e\x27\x35\x10\x13\x8c\x4c\x25\x2c\x87\xf8\x32\x29\x4a\x8c\x5d\x22\x9c\x1b\x8f\x7f\xc1\xb5\x5e\x37\x57\x73\x9f\x8e\x61\x84\xa4\x32\x8a\x2f\xc8\xb7\x26\x55\x3c\x83\x11\xc6\xbf\x0a\xfe\xb9\x32\x68\x78\xf4\x70\x64\xea\xb7\xb5\x1f\xbc\xcc\x91\x11\x17\xc2\xf8\xd2\xa4\xe8\x35\x41\x6d\xa5\x1d\xaf\x33\x0a\x75\x0d\x09\x49\x5a\x56\x47\x76\xb0\x1d\x32\x04\x08\xe8\xc2\x3d\x7d\x77\x5b\xb6\xaf\x62\x3a\x30\xee\xee\x97\x4d\xf4\x51\x77\xa5\x70\xf0\x3a\xea\xce\x56\x15\xf7\x54\x3a\x23\x7a\x6b\x2d\xda\x63\x4c\xe9\x9a\x5d\xbc\xc5\xa1\x24\xc4\xf2\xe2\x1a\x25\x84\x4d\x03\x3c\x89\x9f\xa9\xa0\x17\x44\xd4\x00\x37\x3e\xa2\x99\x4d\xc1\x0b\x0a\xdb\xdc\xd7\x22\x94\x4c\xb2\x25\x6a\x94\x34\x99\xb2\x9c\x27\x5a\x59\xb6\x44\x82\xad\x0f\x46\xc3\x54\x93\xe7\xf2\x82\x9f\x61\x54\xf6\x11\x21\xaf\x4b\x98\x40\xb0\x0a\xdc\x9f\xae\x74\x8d\xce\x88\xa7\xea\x75\x3f\x73\xbf\x50\xfd\x62\x00\x21\x91\xe9\x2a\x67\xb2\xcd\xc9\x17\x57\x8a\x11\x04\xd3\x57\x2a\xe8\x65\xb3\xb1\x53\xd7\xb6\x01\xf0\xb0\x8c\xc2\xec\x16\x78\xaa\x0e\x4c\xec\x66\xd1\x90\xa7\xe6\x1e\xb2\x63\x79\xfa\xca\xac\xb0\xeb\x1a\x72\x38\xef\x7d\x8b\xf6\xaa\x71\x7f\x01\x0c\x15\x7f\x03\xe1\x3d\xaa\xbf\x01\xeb\x2e\x50\xea\x51\x6b\x9f\x84\x4b\x92\x8a\xe3\xf8\xe8\xae\xd5\x1d\x10\x11\xaa\xc4\x6a\xd8\x15\x86\xef\x3f\x0e\x82\x7b\xdc\x72\x2b\x32\x1f\x45\x0d\xb2\x86\x76\x05\x9c\xaa\x64\x53\x9b\xdc\x3a\x41\x86\x38\xd5\xe4\x3f\xdd\xeb\x96\x9b\x5b\xca\x66\xdf\xd7\x35\x99\xb0\xc3\xa8\x75\xdf\xb8\xe5\xf1\x54\xbd\x6f\x84\x3e\x3a\x9e\x46\xaf\x37\x0f\xe3\xe9\xab\x96\x8b\x0e\xa7\x6f\x77\xbe\x5d\x5b\xdb\x36\x19\xfa\xd5\x9b\xfa\xed\xc6\xd5\x5c\xa3\xd3\xa5\x26\x2c\x51\x2f\x8a\xb4\xe9\xe7\xe7\xcd\x81\x75\xe7\xf4\x27\x25\x37\xfc\x4f\xda\x2f\x30\x6e\xe4\xbb\x5d\xd6\x9c\x5e\xe8\x70\x3a\xfa\x17\xca\xa2\xf3\xbe\x3d\x14\xb5\xfa\x6d\x98\x1b\xa1\x96\x4e\xb5\x56\xda\xda\x6f\x0b\x77\x78\x57\x20\x05\xbf\xf3\xc9\x86\xf6\x85\xcc\xee\x0b\x66\xaa\x2b\xe3\x98\x39\x63\xd1\xd6\x90\xb9\xfb\x81\x57\x98\xb1\x2a\xd7\x2e\xaf\x96\x25\xdb\x63\xc8\xe0\xc0\x6d\x37\xd9\x3f\xa3\xa6\x74\x44\xe7\xf6\xb2\xd3\xd4\xce\x28\x8b\xdf\x96\x24\xce\x72\xaa\xcd\xa7\x4f\xe1\x87\x61\x23\xfd\x76\x33\x9b\x10\xa6\x61\xb4\x19\x7b\xb6\xf5\x57\x8d\x1b\x9d\xcf\x5a\xce\x42\xcf\x79\xd7\x1d\xad\x13\x53\xf5\x8e\x9b\x27\x61\xb4\xa9\x86\x81\x51\x72\x89\x7a\xc8\x9f\x70\xd5\x2f\x2f\x87\x1b\x19\xa7\xab\x7f\xb3\xc0\x5f\x2f\xdf\xbe\x99\x8a\x44\xe2\x12\x85\x66\x39\x84\xa2\xd0\x64\x69\xba\xa4\x68\x67\x39\x46\x0e\xd1\xf6\x6c\xb3\xe9\x90\xaf\xe1\xf1\x42\x87\xee\x64\xc8\x33\xe0\xf0\xff\xee\xf8\xda\xeb\x10\x53\x5b\x75\xdd\x3f\xf6\x35\x5f\x05\xeb\xfa\x0c\x04\xce\x99\xe6\x2b\xfa\xe2\x98\xe2\x0d\x3c\x49\xcd\xf7\x23\x43\xa5\xe0\x83\xf9\x60\x97\x35\x53\xe5\x43\x10\x1c\x03\x6f\x0f\x85\x5f\x0f\xfc\x85\xf9\xfe\xc3\x66\x39\xee\x8e\x7b\x5f\x25\x59\x7d\x4c\x07\xc1\x8f\xce\xa1\x39\xdf\x5a\x1b\x0a\xf5\x21\xe5\xa8\x50\x0f\x56\xe3\x97\x2f\xf7\x02\xbf\x2d\xc6\xad\x5a\x1c\x04\xdf\x9c\x0d\x54\xfc\x06\xaf\xfb\xe0\xef\x80\x19\x12\x26\x08\xaf\x19\x9a\xa0\xc2\x42\x5a\x5a\x85\x69\x64\x00\x66\x0e\x97\xe6\x2b\xb1\xa2\xfc\x34\x0e\x07\x07\x24\xc8\xec\x6c\x0f\xcf\x8f\x51\xff\x9e\x9e\xed\xf4\x58\x12\xf0\xed\xd9\xf9\x09\xe5\x1c\x1f\x9e\x1d\xa3\xfe\x3d\x39\x5b\xc9\x59\x1a\x54\xbe\x39\x37\x3f\xd3\x37\x5b\xca\x04\x84\xc4\x84\x9a\x1b\x2e\x5b\x24\x81\xfa\x9c\x07\xd1\xc3\xb2\x66\x0c\x7f\xef\xa9\xed\x9e\x2a\xdd\x37\xf2\x43\xf3\x66\x8e\x59\x64\x80\x0a\x61\x94\xc5\xbf\xb1\x9c\xa7\x74\x29\xab\x28\xbe\xa9\xba\x10\xd5\x72\x7f\xa6\x56\xbb\x32\xb5\x0b\xe5\xe2\x6a\xa0\x5e\x48\x94\x28\x76\xfc\x86\xe7\x39\xd5\x82\x23\x3e\x2b\x77\x71\xb3\x05\x29\xb1\xaf\xd1\x8c\x29\x6e\xce\x21\xa3\x2c\xfe\x91\x7e\x93\x01\x77\xf2\x73\x35\xd0\xb9\x1b\xba\xcb\x7d\xda\x58\x9b\x13\x83\x35\x38\x78\x6f\x39\x98\xc3\xa7\xce\x02\x2f\x84\xa1\x0e\x6b\xaa\xee\x33\xe8\xe7\x2e\x30\x2d\x7a\xb6\x93\x60\xac\x5a\x2f\x32\xc6\x73\xdc\xcb\x2f\xce\xe0\xc9\xb5\xb5\x17\xd5\xc3\x39\xed\xfd\x3c\xb9\xc7\xdd\x86\xc9\x42\x7b\xbf\x61\x13\x8d\x6d\xf5\xdf\xa7\x23\xd7\xeb\xbb\x27\xbf\xe9\x2b\xca\xf4\x7d\x24\xdb\xa6\x31\x34\xb7\x69\xdf\x43\x3a\xc6\x8c\x2f\xfa\xef\x47\x0c\x2a\x7b\x4b\x43\xe7\x49\x07\x5e\x7b\xe4\xfb\x10\x04\xd1\x7e\xb4\x00\x45\x0a\x75\xed\xff\x7b\x00\xaf\x2e\xbb\x7a\xee\x26\x00\x00")
 
 func templateBuilderUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -261,12 +261,12 @@ func templateBuilderUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 7974, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 9966, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xdf\x73\xe3\xb6\xf1\x7f\x16\xff\x8a\xfd\x72\x7c\xf7\x25\x3d\x32\x98\xe6\xad\xea\xf8\xe1\x62\x5f\x13\xcd\x24\x76\xd2\x73\xda\xce\x64\x32\x39\x18\x04\x25\xd4\x14\x40\x83\xa0\x2d\x8f\xea\xff\xbd\xb3\x0b\xf0\x97\x44\xeb\x9c\xdc\x75\xfa\x62\x8b\x00\xb1\xbb\xf8\xec\x67\x17\xbb\xe0\x6e\x97\x9d\x46\x17\xa6\x7a\xb2\x6a\xb5\x76\xf0\xf5\x57\x7f\xfa\xf3\x59\x65\x65\x2d\xb5\x83\xbf\x72\x21\x6f\x8d\xb9\x83\xa5\x16\x0c\xde\x95\x25\xd0\x4b\x35\xe0\xbc\x7d\x90\x39\x8b\x6e\xd6\xaa\x86\xda\x34\x56\x48\x10\x26\x97\xa0\x6a\x28\x95\x90\xba\x96\x39\x34\x3a\x97\x16\xdc\x5a\xc2\xbb\x8a\x8b\xb5\x84\xaf\xd9\x57\xed\x2c\x14\xa6\xd1\x79\xa4\x34\xcd\x7f\xbf\xbc\x78\x7f\xf5\xe1\x3d\x14\xaa\x94\x10\xc6\xac\x31\x0e\x72\x65\xa5\x70\xc6\x3e\x81\x29\xc0\x0d\x94\x39\x2b\x25\x8b\x4e\xb3\xe7\xe7\x28\xda\xed\x20\x97\x85\xd2\x12\x62\x51\x2a\xa9\x5d\x0c\x61\xf8\xa4\xba\x5b\xc1\xe2\x1c\x6e\x79\x2d\xe1\x84\x5d\x18\x5d\xa8\x15\xfb\x91\x8b\x3b\xbe\x92\xf8\xd2\x6e\x07\x4e\x6e\xaa\x92\x3b\x09\xf1\x5a\xf2\x5c\xda\x18\x4e\x68\xb9\xda\x54\xc6\x3a\x48\xa2\x59\x5c\x9a\x55\x1c\x45\xb3\x18\x25\x1e\x0a\xc9\x36\x6a\x65\xb9\x93\x71\x34\xdb\xed\xc0\x72\xbd\x92\x70\xf2\xdb\x1c\x4e\x34\xaa\x3e\x61\x57\x26\x97\x35\x8a\x9c\x79\x09\x7a\x42\x84\x1f\xef\x07\x48\xd6\x19\x48\x9d\x93\x2d\xb3\x78\xa5\xdc\xba\xb9\x65\xc2\x6c\xb2\x22\xb8\x25\x93\xda\x65\xb9\xe2\xa5\x14\xee\x40\x77\xb0\x9e\x0c\xf8\xe0\x8c\xe5\x2b\xc9\x96\x34\x56\xc3\x59\x6f\x4b\x78\x2d\x28\x24\x7d\x38\x9b\x46\x51\x96\xc1\x05\x81\x89\x2e\x45\x7f\x78\x68\xc1\xad\xb9\x83\xb5\x29\xf3\x1a\x78\x59\x02\x0e\xdd\x36\xaa\xcc\xa5\xad\x59\xe4\x9e\x2a\xd9\x2e\xab\x9d\x6d\x84\x83\x5d\x34\x13\xb4\x5d\xbf\x23\x55\xa0\x41\x4d\x85\x6a\x7f\xf0\xb8\x79\x68\xb2\x0c\x3e\x88\xb5\xdc\xf0\x3d\x7d\x85\xb1\x20\xac\xe4\x4e\xe9\xd5\x1c\x3c\xd4\x4a\xaf\x80\xeb\x1c\x72\x6b\xaa\x0a\x1f\x6a\x5a\xc9\xa2\xd9\x2c\xc8\x38\x0d\x3e\x61\xfe\x79\x84\x26\xfd\x0e\x50\x1d\xba\x28\xcb\xc0\x3b\xe3\x8a\x6f\xd0\xb4\x09\x73\x94\x76\xd2\x72\x41\x66\x3c\x2a\xb7\xa6\xf9\xf1\xa2\x1e\x92\xd9\x6c\x3c\x73\x3a\x7a\xf4\x58\xed\x9b\x37\xe0\xa4\x57\x9b\x15\x4a\x96\x79\x9d\xf1\x3c\x57\x4e\x19\xcd\xcb\xc0\xd2\x67\x72\xd4\x95\x7c\x0c\xa0\x13\x52\xb2\x06\x0e\x5a\x3e\xb6\x36\x7b\xfc\x1b\x2b\xf3\xde\xdc\x95\x7a\x90\x1a\x4c\x85\xd2\x6a\x16\x15\x8d\x16\xbd\x98\xc4\x54\xae\x06\xc6\xd8\x35\xcd\xa7\x70\x1a\xc4\xa3\x33\x0b\x8a\x28\x2f\x73\x57\x9a\xd5\x02\x4a\xb3\x62\x3f\x5a\xa5\x5d\xa9\xe7\xb0\x36\xe6\xae\x5e\xc0\x5b\xfa\xbf\xc3\xfd\x88\x62\xc5\x82\x22\x12\xcc\x18\x4b\xa3\x59\xb0\x6d\x71\x0e\x6f\xbd\xf0\x9d\x17\xb9\x00\x51\xac\x9e\xdb\x79\xa6\xb4\x72\x49\x1a\xcd\xac\x74\x8d\xd5\x61\x47\xb8\x6d\xb2\x38\x11\xad\x69\x29\xf8\x37\xd1\xc4\xa3\x3c\x13\x81\x12\x70\x0e\x2d\x47\xae\xe4\xa3\x1f\x4b\x04\xcb\xad\x7a\x90\x36\x7d\x35\x61\x00\x00\x66\x82\x8d\x7d\x7c\x0e\x88\xe5\x84\xa3\x13\xc1\xfc\x2e\xc7\x0a\xbc\x17\xaf\x2b\xf2\x88\xd4\xe8\xbe\x9c\x3b\x8e\x59\x2b\xab\xef\x4b\x76\xf9\x0d\xd4\x95\x14\xaa\x50\x32\x87\xdb\x27\x72\xa0\x37\x14\x34\x8a\xe7\x3a\x47\x01\x34\xcc\x1d\x6f\x73\x24\xce\xcd\x29\x50\x3c\x7a\x7b\xb4\xe0\xce\x61\x56\xce\xc1\x19\x50\x8e\x79\x13\x3c\xbb\xa0\xe2\x96\x6f\xa4\x93\xb6\x06\xc1\x35\xdc\x4a\xe0\x79\x2e\x73\x1f\x8d\x81\x4e\x48\xff\x3e\x32\x02\x87\x70\x13\x89\xb7\xed\x8a\xd4\xa3\x41\x1f\xc8\x1e\x42\xa2\x76\x96\x02\x39\x10\x62\x48\xb2\x24\xb8\x72\x0e\xd2\x5a\x63\xc9\x95\xf5\xa3\x72\x62\x0d\xbd\x40\xa2\x20\x66\xf3\xdd\x0e\xfe\x65\x94\x1e\xa4\xb7\x4b\x9f\x0a\x6b\x88\xe7\x80\x27\xc0\x82\x62\xef\x0c\x4e\xdc\xa6\x2a\xd1\x6d\x15\x72\xb4\x80\x38\xe4\xcc\xec\x4d\x9d\x85\xf0\x42\xd4\xe3\x5e\x54\xc8\x90\xb8\x78\xdb\x85\xa2\x17\xc3\xfc\x5c\x2e\x0b\xde\x94\x0e\x55\x04\x66\x6a\x55\xce\xa1\xd8\x38\xf6\x1e\x8d\x2f\x92\xb8\xd1\xb5\xa7\x9f\xcc\x83\xfd\x0b\x78\x73\x1f\xcf\x07\x9b\x49\xa3\x59\xeb\xfc\x9b\xed\x9e\x93\x9c\xe5\xba\xc6\x24\x43\xfe\x08\x18\xc3\xcd\x5a\x42\x65\xcd\x83\x42\x67\x08\xa3\x9d\xdc\x3a\x5c\xae\x6a\x68\xfc\x91\xeb\x54\x49\x5e\x19\xac\xc7\x59\x61\x36\x1b\xe5\xd0\x16\x63\xc1\x9a\xb2\x44\x26\x71\x71\xc7\x0e\x03\xe9\x66\x9b\x08\xb7\x6d\xa5\xe3\x61\x85\xff\xd1\x3f\x37\xdb\xa1\x6f\x54\x01\xbf\xcd\xc1\xdc\x51\x3a\x08\x81\xc3\x92\x53\xb7\xbd\xf4\x31\xf4\x17\x9c\xdb\x1d\x41\xa8\x3d\xa0\x9f\x9f\x17\xc8\x32\x6d\xf0\xd0\xe0\xd6\x01\x1f\x59\x8f\x39\x4b\xe9\xf1\x60\x4c\xd0\xcd\x9c\x37\x08\x2d\xd0\xf2\xd1\x1b\x3e\x87\x41\x14\xab\x82\xe6\xff\xef\x1c\xb5\xbf\xda\x18\xb2\x82\x0e\x99\xa1\xce\x05\xbc\x79\x88\x49\x9f\x57\x3e\xce\x84\xad\x8b\xd1\x00\xca\x8a\x82\x95\x66\x35\x87\x5c\xde\x36\xf4\x44\x3f\xba\xfc\x28\x18\xfd\x78\xee\x32\xdb\xdb\x9b\x2d\x9a\x27\xdc\x76\x01\xb8\x0b\xfc\xdd\x27\xc4\xb9\x3f\x47\x5e\x2a\x2e\x3c\x5f\xc7\x27\xcd\xe2\xc5\x1c\x54\xac\xd2\x20\xaf\x3d\xef\x67\xcf\x73\x44\x24\xa2\xaa\xe9\x0c\xb2\x53\x58\x16\xc4\xa2\x3a\x04\x44\xc8\x36\x81\xd1\x35\xdc\x6c\xaf\x43\x00\x27\xa5\xba\x93\xf0\xe1\xa7\xef\x53\xa0\x6a\xac\x8f\xb8\xc9\x80\x73\xdb\x10\xf9\xc3\x70\x0b\xcb\x54\x01\x6b\x5e\xdf\x8c\x03\x2e\xe4\xd8\xe9\x58\x0c\x0b\xdb\x32\x29\xcb\xe0\x12\x51\xde\x0b\x25\x42\xfe\xac\x0d\xa1\xa5\xfb\xff\x10\x2c\xce\xc0\x4a\x3a\x78\x90\xf6\xd6\xd4\x12\xbd\xb6\x42\xa7\x1b\xdd\x66\x5b\x81\xe9\x18\xeb\x0d\x3a\x23\xb3\x2c\xca\xb2\xf6\x5c\x22\x3d\x49\x8a\xa3\x84\x64\xa2\x74\x2e\xb7\x9d\x43\xbe\x4a\x5b\xd0\xfd\x1b\x3f\x35\xd2\x3e\xb5\xaf\x5f\x98\x06\xdd\xe0\xb6\x29\xca\x3c\x88\xbf\x20\x7a\x78\xe8\xaa\xa2\x25\xd0\x90\xc3\xe2\x08\x0d\x03\xe4\xc1\xce\x36\x22\xe6\x9e\x95\xe9\x24\x45\x9d\x6d\xe4\x04\x3f\x3f\xf7\xa0\xa6\x42\x12\xf1\x15\xf8\xb7\xee\x4e\x29\xaa\xc9\x85\xd1\x5a\xfa\x30\xc7\x73\xaa\xb2\xf2\x41\x6a\x57\x93\xdb\xee\x1b\x69\x95\xac\xa1\xb0\x66\xd3\x85\xe4\x44\xbe\x22\xe9\x49\xea\x33\x13\xe2\xd3\x9a\xd0\xe6\xa4\xf0\x42\x30\xe6\xe7\x9a\x0e\x33\x6f\xc8\xa6\x71\xe4\x5e\xbf\x6d\x64\x04\x16\xb5\x38\x23\xb5\x53\xee\x29\xec\x83\xbc\x0f\x4b\x0d\xc6\x52\x4b\x63\x50\xc2\x60\x4d\x4f\x18\x11\x8e\x30\xc1\xcb\x72\x01\x1f\x03\x38\x48\x0a\xf6\x73\x2d\x13\xac\x7d\x3e\x4e\xec\x01\xe7\xbc\x38\xc6\xd8\x77\xc6\xdc\x75\x85\xcc\xd1\x7e\x62\xaf\xf0\x60\x9d\x18\x5f\x63\x8d\x4b\x8c\xe8\x78\x77\x82\x92\x7a\x5f\x53\xe8\x76\xa2\xe3\x8b\xbe\xaf\x0a\x05\x72\x78\xd5\x17\xc8\x7c\x58\x1e\x1f\x56\xc3\x6d\x79\x4e\xed\xc1\x78\xf1\x41\x97\x10\x1a\x37\x2b\x05\xd9\xa7\xd9\xdf\xa4\x90\x94\x7c\x9e\x9f\x77\x3b\xcc\x11\xf2\xde\x4f\xc7\x22\xf6\x63\xf4\xd4\x67\x9b\x37\xec\x6b\xcc\x2e\x41\xfd\xbf\xa1\x34\x8f\xed\xea\x41\xa2\x08\xc9\xb1\xb7\xa4\xcf\x19\x47\xf7\x42\x6c\xec\x2b\x68\x6f\x75\x5f\x40\x8f\x64\x26\x22\xcc\xa7\xbe\xec\xef\x95\xf5\x2c\x7d\x3b\x9a\xe8\x63\xeb\x79\x9f\xae\x1c\x4a\x55\x3b\xec\x83\x0f\x49\x8b\xf6\xf8\x87\xda\xd1\xa1\x9e\x65\xf0\x8e\x38\x88\xb3\x1f\x91\x16\xc5\x1c\xf0\xf4\x49\x3f\x82\xbc\x6f\x78\x49\xcb\x3e\xee\xb7\x9d\x44\xbd\x3a\x29\x92\x55\xb2\x4e\xd2\x34\x1d\x71\x75\x64\xe8\x4b\x94\x0d\x79\xe3\xa0\x20\xe6\x55\x25\x75\x9e\x4c\x4e\x87\xa4\x43\x9c\x0d\x09\x83\xda\x98\xa1\x4b\xfc\x40\x68\xab\xc8\x35\x63\xe6\xbf\x68\xa6\x17\x95\xa4\x6d\xe3\xe5\x9f\x5b\xc3\x76\xd1\xac\x43\xd3\x17\x11\xfe\xad\x1f\xc2\x60\x78\xaf\xab\xdb\xe7\x70\x5d\x79\x09\xe9\xd8\x83\x7b\x82\x7b\x3f\x76\x0b\xbb\xc4\xea\x31\x4e\xe7\x9d\x1f\x17\xdd\xaf\xd6\xe9\xdf\x34\xe5\xdd\x01\x06\xc3\xcd\xb7\x1d\x31\x0d\x97\x77\xc8\x8a\x31\xe2\x94\xbe\x94\xac\x3f\x05\x0c\x6a\x4a\xda\x6e\x15\x3d\x39\x05\xd3\x1e\x78\xb8\x66\x00\xe0\x14\x0c\x83\x57\x26\xa0\x68\xf5\x2d\xba\x5f\x1d\xdb\xab\x7c\xb4\x69\x0d\x8d\x1f\xf9\x03\x9e\xf7\xb2\x7a\xcf\xfb\xe7\xcf\xf1\xbc\x97\x70\xe0\xf9\x91\xe0\xcf\xf4\xbc\x97\x75\xad\x3f\x85\x41\x9f\x81\xfc\x41\xf5\x29\x18\xae\xb5\x4c\xda\x54\x79\x70\x0b\xb1\x07\xd1\xb5\xfe\x02\x28\x5d\x6b\x39\x47\x4f\xf9\x83\x24\xc6\x22\x3e\x1e\xa8\x1c\x18\x93\xbe\x00\x68\x6f\xc6\x97\xc2\x74\x79\xf9\x6a\x54\x55\xfe\x0a\x44\x97\x97\x89\xca\x03\x1d\x97\x97\xec\x06\x8f\xb7\xff\x01\x9a\xf1\xf2\x12\x4f\xc2\x44\xe5\xff\x75\x28\x2f\x65\x29\x47\x49\x29\xf7\x03\x7f\x20\x3c\xbd\xa8\x3e\x3c\xfd\xf3\xe7\x40\xe5\x25\x1c\x40\x30\x12\xfc\x45\xf6\x3f\x0a\xcf\x29\x08\x5e\x1f\x9d\x9d\xc0\x57\x44\x67\xf7\xee\x61\xf2\x15\xfd\xe4\xf2\x72\x20\x8a\x2d\x2f\xd3\x7d\xd3\x87\x51\x70\xdc\xf8\x63\x41\x30\xd4\x77\x2c\x08\xa6\x8c\x6e\xb5\xd1\xc5\x41\xcb\x03\xf6\x8f\xb5\xb4\x1e\x86\x51\x49\x42\xf2\x91\xd8\x61\x15\x6b\x7d\xc2\x54\x0e\xe7\xf0\x56\xe5\x13\x53\xa6\x82\xf3\x8e\x11\xd7\x5a\x4e\x73\x62\x10\x16\x41\x42\xeb\x67\xea\xd7\x06\x30\xdd\xd3\xf3\x1f\x60\x79\x68\xfc\x5a\x34\xe8\xf1\xc5\xb3\x73\x38\x7b\x40\xd4\xd6\xb4\x6f\xa5\x1b\x18\x36\x71\xea\x3f\xc1\xed\x13\x28\x57\x1f\x75\xdf\xb7\xd2\x4d\xdd\xf2\xcc\x61\xd2\x97\xc9\xe9\x5e\xc1\xd6\xdf\x02\x75\x04\x6c\x5b\xdc\xe3\x6e\x64\xd7\xba\x7c\xf2\xbd\x6f\xb7\x9d\x7f\xfa\xaf\x46\x77\x12\x1f\xb0\x3a\x70\x50\x71\xad\x44\x8d\xd5\x3e\xd7\xa1\xb1\x33\x42\x34\xf6\x48\x3d\x83\x82\x7e\xc7\x96\xc6\x3b\xf2\xdd\x56\x1b\x35\xdd\xa5\x92\x60\x01\x27\x14\x32\x79\x9d\x44\x86\x26\xdd\x9d\x50\x40\xa3\x17\x15\xfa\x99\x41\xdf\x25\x43\x5f\xf3\x3e\x5f\xf5\x8d\xd7\x20\x22\x4e\x24\x19\x39\x22\x43\x47\x49\x3c\x02\x78\x2d\x78\x89\xaf\xb5\xb6\xb7\x8d\x72\xdb\xac\xf4\x33\x32\x5f\x49\xac\x0d\xf9\xef\xa2\xeb\x94\x92\x4f\xa6\xa7\x76\x07\x1e\x4b\x1f\x2f\x8b\x73\xcf\xec\x7e\x6e\x82\xd5\xfe\x5d\x56\x71\xb7\x86\x73\x40\xc3\x5e\xb8\x7e\xc4\xce\xeb\xef\xb4\x91\xee\xca\xf7\x9b\x4e\xf0\x1c\x7e\x1b\x90\x92\x3a\x5f\xfa\xd6\x21\xb7\x0e\x9b\xbe\x13\x0d\x71\xdb\x48\xc6\xa1\x7d\x44\x07\xc4\xe8\x8f\x78\x99\x53\x73\x1b\x93\x86\x18\xfa\xcb\xb4\x23\x37\xc7\x64\x75\x86\x2b\xf6\x6e\xb2\x66\x47\x2f\x8e\xbb\x9e\xdc\x3f\x05\xbe\x90\x62\x7f\x05\x37\x60\x11\xa9\x88\x88\x20\x83\xc6\x95\x4e\xa9\x2e\x03\x0c\x3e\x4d\xf9\x26\xea\x45\xd7\x86\xd3\x0d\x7e\xf9\x15\x7f\x0d\xbe\x93\x18\x4b\xde\x6c\x36\x5e\xf2\x89\x66\xdf\xf1\xfa\x47\x53\x2a\xf1\xe4\xf7\xe3\xbb\x3c\x0a\x87\x89\xee\xad\xdf\x45\xe8\xf1\xe8\x9d\x5f\x16\xa5\xd4\xfe\x67\x3a\xf8\xf9\xeb\x1c\xa6\x7b\xce\x5f\x16\xbf\x0e\xee\x2c\xca\x7a\x2c\xf9\x05\xc5\xe3\xfb\x8d\x1e\xa6\x01\x60\xbb\x5d\x76\x0a\xef\xfa\x0f\x6a\xf4\xf9\x32\x7c\xd2\x30\x0f\xd2\x5a\xba\x49\x57\x7b\x37\x3b\xfd\x77\x36\xf0\x5f\xde\xda\x26\x3b\xdc\xe7\x84\x9b\xce\xbd\xcf\xce\x53\x5f\xe9\x46\xd7\x0e\xff\x09\x00\x00\xff\xff\x18\xa1\x9a\xae\x6d\x1f\x00\x00")
+var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5b\x7b\x6f\x23\x37\x92\xff\x5b\xfa\x14\xb5\x82\xc7\xdb\xf2\x6a\x5a\x49\x80\xc3\xdd\x39\xf0\x01\x93\xf1\x4c\xd6\x87\x99\x71\x12\x3b\x7b\x0b\xf8\x8c\x84\xea\xae\x96\x18\xb7\xc8\x1e\x92\xed\x07\x74\xfa\xee\x87\x2a\x92\xfd\x90\xda\x8f\x64\x72\xb8\x3f\x92\x51\xf3\x51\x55\xac\x17\x7f\x2c\xd2\x9b\xcd\xfc\x68\xfc\x56\x57\x0f\x46\x2e\x57\x0e\xbe\xf9\xea\xeb\x7f\x7f\x5d\x19\xb4\xa8\x1c\xbc\x17\x19\x2e\xb4\xbe\x81\x33\x95\xa5\xf0\xa6\x2c\x81\x07\x59\xa0\x7e\x73\x8b\x79\x3a\xbe\x5c\x49\x0b\x56\xd7\x26\x43\xc8\x74\x8e\x20\x2d\x94\x32\x43\x65\x31\x87\x5a\xe5\x68\xc0\xad\x10\xde\x54\x22\x5b\x21\x7c\x93\x7e\x15\x7b\xa1\xd0\xb5\xca\xc7\x52\x71\xff\x87\xb3\xb7\xef\x3e\x5d\xbc\x83\x42\x96\x08\xa1\xcd\x68\xed\x20\x97\x06\x33\xa7\xcd\x03\xe8\x02\x5c\x87\x99\x33\x88\xe9\xf8\x68\xbe\xdd\x8e\xc7\x9b\x0d\xe4\x58\x48\x85\x30\xc9\x4a\x89\xca\x4d\x20\x34\x1f\x54\x37\x4b\x38\x3e\x81\x85\xb0\x08\x07\xe9\x5b\xad\x0a\xb9\x4c\x7f\x10\xd9\x8d\x58\x22\x0d\xda\x6c\xc0\xe1\xba\x2a\x85\x43\x98\xac\x50\xe4\x68\x26\x70\x40\x3d\x63\xb9\xae\xb4\x71\x90\x8c\x47\x93\x52\x2f\x27\xe3\xd1\x66\xf3\x1a\x64\x01\x07\xe9\x7b\x14\xae\x36\xf8\x4e\x89\x45\x89\x39\x4c\x7e\xb3\x5a\xcd\xfd\x70\xe6\x3c\x1a\x4d\x16\x75\x21\xf5\x84\x7f\x3d\x38\xb4\xfc\x0b\x55\xa6\x73\xa9\x96\x73\x1a\xcf\x2d\x3c\x84\xc8\xa2\xca\x69\xe2\x78\x34\xd9\x6c\x86\xe4\x9c\xaf\xe5\xd2\x08\x87\x3c\x1c\x8c\x50\x4b\x84\x83\x5f\x66\x70\xa0\x68\x75\x07\xe9\x27\x9d\xa3\x0d\xac\x89\x82\x1a\x20\xe1\xdb\xdb\x86\x3d\xd6\x4b\xe9\x56\xf5\x22\xcd\xf4\x7a\x5e\x04\xcb\xcf\x51\xb9\x79\x2e\x45\x89\x99\xdb\xe3\x1d\x14\xc4\x02\x5c\x38\x6d\xc4\x12\xd3\x33\x6e\xb3\xf0\xba\x95\x25\x0c\x0b\x0c\x99\x1f\xf5\x4e\xc7\xe3\xf9\x1c\xde\xb2\xbd\xc8\x6b\xc8\x0d\xbc\xf5\xc0\xad\x84\x83\x95\x2e\x73\x0b\xa2\x2c\x81\x06\x2c\x6a\x59\xe6\x68\x6c\x3a\x76\x0f\x15\xc6\x69\xd6\x99\x3a\x73\xb0\x19\x8f\x32\x5e\x6e\xc7\x46\x17\x75\x45\x6c\x3f\x7a\xbd\xd1\x0a\x47\xa3\xf9\x1c\x2e\xb2\x15\xae\xc5\x0e\xbf\x42\x1b\xc8\x0c\x0a\x27\xd5\x72\x06\x5e\xd5\x52\x2d\x41\xa8\x1c\x72\xa3\xab\x8a\x3e\x2c\xcf\x4c\xc7\xa3\x51\xa0\x71\x14\x6c\x92\xfa\xef\x9e\x36\xf9\x77\x50\xd5\xbe\x89\xe6\x73\x20\xc5\xa8\xf4\x93\x58\x93\x25\x06\xc4\x91\xca\xa1\x11\x19\x49\x04\x77\xd2\xad\x38\x22\xfa\x93\x5a\x95\x8c\x46\xfd\x9e\xa3\xde\xa7\xd7\xd5\xae\x78\x1d\xb7\xf7\x6c\xe7\x85\xc4\x32\xb7\x73\x91\xe7\xd2\x49\xad\x44\x19\x02\x61\xcb\x86\xfa\x84\x77\x41\xe9\xac\x29\xb4\x20\x40\xe1\x5d\x94\xd9\xeb\xbf\x36\x98\xb7\xe2\x2e\xe5\x2d\x2a\xd0\x15\x51\xb3\xe9\xb8\xa8\x55\xd6\x92\x49\x74\xe5\x2c\xa4\x69\x7a\xce\xfd\x53\x38\x0a\xe4\xc9\x98\x05\x07\xad\xa7\xb9\x29\xf5\xf2\x18\x4a\xbd\x4c\x7f\x30\x52\xb9\x52\xcd\x60\xa5\xf5\x8d\x3d\x86\x43\xfe\x77\x43\xeb\xc9\x8a\x65\x1a\x18\x31\xe1\x34\x4d\xa7\xe3\x51\x90\xed\xf8\x04\x0e\x3d\xf1\x8d\x27\x79\x0c\x59\xb1\xdc\xc6\xfe\x54\x2a\xe9\x92\xe9\x78\x64\xd0\xd5\x46\x85\x15\x8d\xb7\x63\x2f\x71\x92\x45\xd1\xa6\xe0\x47\xc2\xe6\x19\x3f\xcb\x82\x4b\xc0\x49\x70\x26\x4c\x3f\xe1\x9d\x6f\x4b\xb2\x34\x37\xf2\x16\xcd\xf4\xc5\x0e\x03\x00\x30\xca\xd2\xbe\x8d\x4f\x80\x74\x39\x60\xe8\x24\x4b\xfd\x2a\xfb\x0c\xbc\x15\xcf\x2b\xb6\x08\x2a\x32\x5f\x2e\x9c\xa0\xc4\x38\xb7\x9f\xcb\xf4\xf4\x3b\xb0\x15\x66\xb2\x90\x98\xc3\xe2\x81\xfd\xcd\x0b\x0a\x8a\xdc\x4a\xa8\x9c\x08\x70\xb3\x70\x22\xa6\x61\xea\x9b\x71\xa0\x78\xed\xed\xb8\x85\x70\x8e\x12\x7f\x0e\x4e\x83\x74\x29\x51\xf0\xf6\x16\x25\x54\xc2\x88\x35\x3a\x34\x16\x32\xa1\x60\x81\x20\xf2\x1c\x73\x76\xff\xe8\x4e\xe4\xfe\x6d\x64\x04\x1f\xa2\x45\x24\x5e\x36\x5a\xf9\x8c\x17\x72\xc1\xf2\xd0\x37\x58\x67\x38\x90\x83\x43\x74\x9d\x2c\x09\xa6\x9c\x01\x1a\xa3\x0d\x9b\xd2\xde\x49\x97\xad\xa0\x25\x48\x8d\x19\x6d\x18\x9b\x0d\xfc\xa6\xa5\xea\xa4\xb7\x53\x9f\x0a\x2d\x4c\x66\x40\xa9\xfe\x98\x63\xef\x35\x1c\xb8\x75\x55\x92\xd9\x2a\xf2\xd1\x02\x26\x21\x67\xce\x5f\xd9\x79\x08\x2f\x5d\xa1\x9a\xb4\xa4\x42\x86\xa4\xc9\xf7\x4d\x28\x7a\x32\xa9\xef\xcb\xb1\x10\x75\xe9\x88\x45\xf0\x4c\x25\xcb\x19\x14\x6b\x97\xbe\x23\xe1\x8b\x64\x52\x2b\xeb\xdd\x0f\xf3\x20\xff\x31\xbc\xfa\x3c\x99\x75\x16\x33\x1d\x8f\xa2\xf1\x2f\xef\x77\x8c\xe4\x8c\x50\x96\x92\x0c\xdb\x23\xe8\x18\x2e\x57\x08\x95\xd1\xb7\x92\x8c\x91\x69\xe5\xf0\xde\xd1\x74\x69\xa1\xf6\xbb\xba\x93\x25\xfb\x47\x67\x3e\xa5\xb0\x4c\xaf\xd7\xd2\x39\xcc\x41\x1b\x30\xba\xa4\xbd\x71\x21\xb2\x9b\x74\x3f\x90\x2e\xef\x93\xcc\xdd\x47\xea\xb4\x59\xd1\xbf\x64\x9f\xcb\xfb\xae\x6d\x64\x01\xbf\xcc\x40\xdf\x90\x6a\x63\xe0\xa4\xc9\x91\xbb\x3f\xe5\x05\x4e\xbf\xa5\xbe\xcd\x13\x1a\x8a\x18\x60\xbb\x3d\x26\x2f\x53\x9a\x36\x0d\x61\x1c\x88\xee\xea\x39\x67\x49\xd5\x6f\x9c\xb0\xea\x46\xce\x0b\x44\x12\x28\xbc\xf3\x82\xcf\x1a\x61\xa6\x2c\x23\x1a\x03\x7f\x39\x01\x25\xcb\x17\x0b\xc3\x52\x90\x7b\xf7\x78\x1e\xc3\xab\xdb\x09\xf3\xf3\xcc\xfb\x99\x30\x9a\x98\x04\xe0\xac\x98\xa5\xa5\x5e\xce\x20\xc7\x45\xcd\x5f\xfc\xa3\xc9\x8f\x59\xca\x3f\xb6\x4d\x66\x3b\xbc\xbc\x27\xf1\x32\x77\x7f\x0c\x99\xbb\x9f\xd1\xef\x36\x21\xd2\xe7\x13\xe0\x82\x7d\x72\x67\xa7\x39\x7e\x34\x07\x15\xcb\x69\xa0\x17\xf7\xfb\xd1\x76\x46\x0a\x22\x5f\x24\xa7\x9f\x1f\xc1\x19\x21\x3a\x04\x1b\x02\x22\x64\x9b\xe0\xd1\x16\x2e\xef\xcf\x43\x00\x27\xa5\xbc\x41\xb8\xf8\xf1\xc3\x14\x18\xf0\xb5\x11\x37\x18\x70\xee\x3e\x44\x7e\x37\xdc\xc2\x34\x59\xc0\x4a\xd8\xcb\x7e\xc0\x85\x1c\x3b\x1c\x8b\x61\x62\x48\xa3\x14\x08\xa7\xa4\xe5\x9d\x50\x62\xcd\xbf\x8e\x21\x74\xe6\xfe\x1a\x82\xc5\x69\x58\xa2\x83\x5b\x34\x0b\x6d\x91\xb6\xb1\x25\x19\x5d\xab\x98\x6d\x33\x4a\xc7\x46\x84\x3d\x72\x3e\x1f\xcf\xe7\x71\x5f\x62\x3e\xc9\x94\xb2\x26\x6b\x32\x91\x2a\xc7\xfb\xc6\x20\x5f\x4d\xa3\xd2\xfd\x88\x1f\x6b\x34\x0f\x71\xf8\x5b\x5d\x2b\x47\xae\x3a\x1d\xcf\xe7\xfb\xf1\x17\x48\xc7\x86\x10\x6a\xc1\x81\xba\x3e\x9c\x3d\xe1\x86\x41\xe5\x41\xce\x18\x11\x14\x1b\xa5\x5e\x4e\x07\x5d\xd4\x99\x1a\x07\xfc\xf3\x4b\x37\xea\x27\x76\x63\xc2\x7c\xc1\xa3\xfe\xf3\xe2\xfc\x13\x18\xe4\xdf\x04\xe4\xe3\x5e\x46\x7b\xa0\xc7\x9c\x1d\x10\xc6\xf9\x4c\x29\xcc\x28\x9f\x39\xdd\xb8\x65\xdc\x04\x99\x18\x63\x51\xda\xfc\x48\xc1\x6c\x42\x4f\x47\x18\xf4\xe6\x0f\x1b\x29\x0f\xae\x0c\xe6\x32\x13\x0e\x6d\x0a\xef\xb5\x01\xbc\x17\xeb\xaa\xc4\x19\x51\xfc\xf8\x70\xf1\xe3\x87\x86\x07\xcd\x59\x43\x61\xf4\x9a\x1c\xc7\x52\x7a\xfd\x97\xf4\x5f\xd3\x7f\xf3\x1b\xed\xc5\x8f\x1f\xa4\x43\x30\xf8\xb9\x96\x06\x6d\xc3\xe0\x6b\xc0\x7b\x87\x8a\x86\x0f\x64\xdc\xae\x12\x1e\xc9\xbd\x0b\xad\xcb\x6e\xf2\x0d\x6a\x6e\x60\xee\x0e\x85\x6e\x12\xdc\x8b\x91\xb7\x25\xb9\x7b\x46\xff\xb7\x7d\x45\x07\xad\xd2\xa2\x68\x35\x95\xc1\x5b\x54\xce\x72\x14\x7d\xae\xd1\x48\xb4\x7e\xe9\x31\x43\x0e\x2c\x86\xa9\x27\x53\x2f\x6b\x47\xd4\x28\x50\x1a\x06\x84\x8d\xef\x67\xcb\xd8\xc2\x0b\xb2\xae\x1d\x47\x9b\xf7\x42\x02\x26\x74\xc6\xa0\x1e\x54\x4e\xba\x87\xe0\x00\x1c\x8c\x70\xa6\x40\x1b\x3e\xc4\x6a\xa2\xd0\x99\xd3\xc6\x6f\x16\x10\x45\x26\xca\xf2\x18\x7e\x0d\xbe\x4a\xe8\x2d\xfd\xd9\x62\x42\x50\xf4\xd7\x81\x35\x50\x9f\x27\x97\xa6\xe9\xdf\xb5\xbe\x69\x70\xe5\x63\x19\x38\x60\xcb\x5e\xbe\x4d\x1b\x32\xc4\x67\x17\xf1\xbd\xf8\xc4\x3a\x9f\x83\x3f\xb9\xf9\x94\x1b\x22\xbd\x8e\xee\xf5\x96\x21\xff\x7b\xa3\xd7\xe4\x3e\xb0\x46\xb7\xd2\xb9\xf5\x47\xf2\x7d\xbd\x71\x54\xf4\xc8\xd1\xe2\x93\x23\xcf\xef\x6d\x00\xa7\xa4\xde\x6e\x4b\x38\xf0\x11\xc1\xc8\xdd\xdb\x49\x17\x4f\xc8\x10\xb8\xf5\x08\xb5\xa7\xc2\xf9\x1c\xec\x8d\xac\x18\x9c\x88\xb2\xec\x1e\x4e\xd6\xa2\x2c\xb4\x59\x63\x0e\xa5\x54\x68\x67\x21\x21\x3c\x80\x5d\xe9\xba\xcc\x09\x8e\xd2\xd4\x8a\x8a\x1c\x23\xfa\xe5\x17\x21\x5b\xec\x18\x9c\xeb\xe2\x46\x56\x1f\x1b\x62\xcd\xae\xa0\xfa\x0a\x25\x50\x4b\x54\xf8\x28\xb9\xcf\x5b\x59\x87\x22\x27\x85\x16\x42\x96\x52\x2d\x63\x9a\xf1\x0b\xf3\xa0\xcc\x9f\xa7\x62\xaa\x81\x44\x16\x40\x78\x46\xc9\x72\x3a\xb4\x42\xa2\x0d\xaa\x5e\x2f\xd0\x70\xde\x70\x2b\x24\xaa\x2c\x3c\x71\x42\x91\xad\xe2\x1a\x59\x09\xfd\xb4\xc4\x38\x3c\xee\x57\x94\x90\x82\x0f\xf7\xd6\x9b\x14\x5e\x2d\x34\x1d\xa2\x6e\x82\x7e\xa6\x7d\x0d\xb4\x51\xca\x33\x32\xe8\xfb\x03\x99\x6b\x94\xa5\x24\x0f\x9c\x3c\x4e\x94\x87\x11\xe4\x2a\xba\x80\x6b\x34\x2a\x78\x74\x44\x4e\x23\xda\xb4\xe8\xbf\x08\x7d\x0d\x8a\x9c\x5c\xf7\x03\xd9\x9a\xbf\x38\xe1\xd0\x9c\xd7\x39\x96\x72\x2d\x29\xcd\xd3\x08\xd0\x8b\xdf\x18\xe1\x73\x0e\x32\x3e\xe5\x92\x6a\x2d\x14\x5e\xb7\xac\x37\xad\x10\x74\x11\xac\xb4\x4e\xe1\xdd\xba\x72\x0f\xac\x45\xcb\xd9\x5f\x2e\x95\x36\x98\xfb\xe9\xbe\xb9\xd9\x19\xc8\x68\x5d\x56\x33\xc2\xcb\xdc\xeb\xcd\x8f\x39\x68\xc5\xdb\x02\x51\x5a\x09\x95\x97\xed\x56\x32\x74\xa8\xee\xad\x2e\x31\x20\x75\xfa\x13\x97\xb2\x66\x74\xfa\xb6\x70\x75\xdd\xb5\xc4\x0c\x82\xd1\xd6\xa2\xba\xf2\x67\xa5\x6b\xaa\x48\xa5\x3f\x89\xbb\x8f\x68\xad\x58\x62\xc8\xac\x9d\x04\x1b\x10\xc0\x61\xd7\x66\x9b\xed\x78\x44\x4e\x42\x08\xbd\x72\x94\xa9\x7c\xe6\x62\x9e\x64\x16\x5d\xb9\x24\x2b\x96\x1e\xca\x2e\x0c\x8d\xe0\xba\x18\x9d\x86\xbd\x80\x09\x59\x8b\x68\x30\xd4\xfc\xfa\x5b\xf8\x16\xd4\xdf\xfe\xc6\x46\x6e\xec\x49\x3d\x0b\xc3\x2b\xfa\x8e\x6a\x69\xc9\x5f\xff\x5b\xfd\x75\x3a\x1e\xed\x00\xef\xc3\xc3\xf8\x25\x75\xfa\xee\xfc\x3d\x13\x69\x5c\xee\x11\x14\x4e\x9a\x23\xff\x26\x66\xf0\x2a\x3f\x86\x57\x77\x93\x19\xa8\xc6\x8f\xc8\x8d\x64\xc1\x76\x85\x13\x58\x10\xfb\xf4\xd2\xc8\xf5\x45\x25\x32\x64\x97\x9b\x7e\x0b\x25\x2a\xff\x13\xfe\x03\xbe\x6a\x1c\xb4\x0c\xa2\x77\x8d\x13\x9c\xb4\xe0\x49\xfd\x33\x03\xcf\xa1\x32\x86\x8f\x80\x6e\xc7\x73\x8b\xe8\xa7\x93\xde\x32\xca\x18\x0f\x64\x81\xd1\x68\x14\x19\x24\xdd\xbe\x10\x2b\x51\x9f\x27\x83\x1a\x24\xe8\xfe\x68\x48\x41\x8e\x19\x63\x52\x01\x4a\xab\xd7\xd8\x04\x03\xe5\x99\xee\x40\x3b\x10\x4f\xd2\xd9\x10\x09\x03\xee\xcc\x1a\x83\xab\x6b\x52\xfd\x1f\x72\xdc\x5b\x61\x88\x3a\x3c\x3e\xa7\x39\xc1\x1d\x9f\x00\x93\xfb\x59\xad\x85\xb1\x2b\x51\x06\x73\x1d\xea\xc5\x6f\xd3\x6f\x1f\x39\xe4\xa1\x31\xec\xde\xb2\x60\x36\x27\x43\x03\xb4\xb1\xe4\xf2\xc9\x04\xef\x2b\xcc\xe8\xec\xd9\x09\xff\x70\xcc\x0c\xa3\x8b\x84\x98\xed\xe0\xa9\xf1\x13\xc7\x32\x3e\x9e\xc0\x41\x8b\xa0\xf9\x40\xd4\x20\x84\xc9\xdb\xb6\x20\x1e\xca\x8e\x61\xa8\x2f\x3b\x8a\xb0\x6d\x73\xb6\xdf\xaf\x31\xc6\xa2\x27\x6f\xb2\xfd\xc9\x7b\xb5\xd7\x50\x71\x37\x98\x91\x18\x07\x2a\xfd\x09\x33\x24\x34\x06\xdb\xed\x66\x43\xfb\x2b\x7e\xf6\xdd\x93\x8c\xe4\x89\x83\x83\xc8\x05\x4c\x5e\xa5\xdf\xd8\x49\xc3\xfe\x7f\xa0\xd4\x77\x71\x76\x54\x85\xaf\x44\xf6\x25\x69\x4f\x62\x4f\xae\x85\x13\x7a\x9b\x42\xbd\xd4\xc1\xe5\x76\x69\x26\x59\xe8\x9f\xc2\x51\x9f\x59\xbb\x8d\x1d\xf6\x3a\xda\x13\xcb\x76\x17\x75\x0a\x28\xa5\x75\x14\x0a\xfb\xd8\x93\xe4\xf1\x28\xd0\x3a\x2e\x95\xcc\xe7\xf0\x86\xe3\x83\x7a\x7f\x25\x74\x57\xcc\x60\x39\x83\xd5\xf4\x57\xc0\xcf\xb5\x28\x2d\x77\xec\x16\xf3\x19\x41\xda\xa4\x48\x96\xc9\x2a\x99\x4e\xa7\x3d\xc8\xd9\x13\xf4\x31\xe4\x19\x4e\x63\x7b\x65\x46\x51\x55\xa8\xf2\x64\xb0\x3b\x1c\xe5\x18\x7a\x06\xdc\xcf\x28\xad\x6b\x12\xdf\x10\x8a\xd5\x6c\x9a\x1e\x89\xc7\xc5\xf4\xa0\x33\x09\x16\x50\xa9\xff\x0e\xd3\x48\xe2\x46\x9b\xbe\x34\xe3\xc9\x7e\x0c\x8d\x61\x5c\x53\x0d\x9d\xc1\x79\xe5\x29\xb4\x07\xc8\xc3\x01\xc2\xad\x1d\x9b\x89\xcd\x71\xd5\xeb\x78\x3a\x6b\xec\x78\xdc\xfc\x8a\x46\xff\xae\x2e\x6f\xf6\x74\xd0\x5d\x7c\xbc\x67\xe0\xe6\xf2\x86\xbc\xa2\xa7\x0f\x8f\xa6\x25\xda\xe7\x14\x43\x9c\x92\x40\x99\x2d\x39\xa4\xa6\x1d\xe5\xd1\x9c\xc8\x67\xc7\x91\x07\x86\x0c\xa8\x22\xf2\x3b\x6e\x6e\x1f\x7e\xf7\x39\x63\x07\xc7\xff\x7e\x2c\xd6\x5c\x3f\x0c\xe8\xed\x81\x1d\x8c\x10\x1a\x19\xc3\x83\x34\x4a\x05\x6b\xba\x54\xa4\xcf\x45\x5d\xde\x78\x34\x1d\x69\xaf\x45\x05\x7c\xf5\xc1\x75\xee\x18\x94\xd2\x78\x09\x6e\x45\x59\xc7\x5d\x4b\xd5\x65\x19\x1a\xba\x18\x8f\x0f\x8a\x1f\x5e\x0a\xf1\x56\xe2\x16\xa1\x56\x37\x4a\xdf\x29\xcf\xd7\x52\x9f\x54\xb7\xa2\x94\x79\x20\x3f\x63\x1c\xd8\x39\x00\x78\xe4\x29\x54\x03\xe0\x67\x50\xab\x12\x2d\xb1\xc4\x9d\x23\x88\xc7\x86\x70\x27\x6c\x53\xc6\x4d\xe1\x93\x76\xe8\x6b\x13\x98\x2f\xd1\xc6\x5a\x28\x9d\x72\xd0\x45\x6c\x19\x84\x7d\xce\xf3\xa2\xed\x86\xca\x08\x33\xd8\x87\x9f\x69\x1a\xae\x05\x9b\x2a\xfc\xd5\xf5\x51\xcf\x78\xdd\xaa\x03\x6d\xd8\xd1\xb9\xe0\xea\x7a\xc8\xab\xc7\xa3\xb0\x5d\xf7\xa0\x45\x12\xf8\xcd\x3c\xba\x7d\x7a\xd3\xef\x20\x84\x51\xe0\x46\xbb\x56\x16\x18\x25\x1d\x7c\x49\xf0\xd3\x8f\x48\x2d\x3a\x62\x97\x0c\x02\x82\x18\x4e\x8c\x08\x46\xdb\x41\x02\x95\xc1\x0b\x71\x8b\xc9\x4b\x66\x87\x39\xb6\xcd\xc1\xb1\xa5\x89\xc3\x69\x0b\x33\x18\x9f\x6d\x9f\x2d\x47\x47\xb8\x12\xda\xb2\x74\x20\x99\x50\x3a\x4f\x59\x4c\xae\x1f\xee\xd5\x76\x7e\xae\xf2\x5e\x7e\x53\x50\xfb\x96\x3f\x90\xe4\x3d\xad\x36\xc9\xfb\xef\x2f\x49\xf2\x9e\xc2\x5e\x92\xef\x11\xfe\xc2\x24\xef\x69\x9d\xab\xe7\x74\xd0\x82\x0d\x9f\x9c\x9e\x53\xc3\xb9\xc2\x24\xa2\xa2\xbd\x6b\xdc\x1d\x15\x9d\xab\x3f\x41\x4b\xe7\x74\xc4\xda\x6c\x3c\x00\x83\x09\x25\x99\x16\x7f\x6d\xb7\x1d\x61\xa6\x8f\x28\xf4\x5c\xfd\xd9\x3a\x3d\x3b\x7d\xb1\x56\x65\xfe\x02\x8d\x9e\x9d\x26\x32\x0f\xee\x78\x76\x9a\x5e\x3e\x54\xff\x2f\xda\x9c\x9c\x9d\x12\xe8\x4d\x64\xfe\x7f\xae\xca\x53\x2c\xb1\x87\x3f\x72\xdf\xf0\x07\xc2\xd3\x93\x6a\xc3\xd3\x7f\x7f\x89\xaa\x3c\x85\x3d\x15\xf4\x08\xff\x29\xeb\xef\x85\xe7\x90\x0a\x5e\x1e\x9d\x0d\xc1\x17\x44\x67\x33\x76\x1f\x67\x65\x41\x7d\xde\x29\x5b\x52\xe9\xd9\x69\x84\xcf\x9d\x01\x2f\x15\xfe\xa9\x20\xe8\xf2\x7b\x2a\x08\x86\x84\x8e\xdc\x78\x57\x8c\x7e\x90\xfe\xd7\x0a\x0d\x26\x7b\xa7\x0f\x0e\xb2\xe9\xb4\x99\x95\x46\x9b\xa4\x32\x87\x13\x38\x94\xf9\x40\x97\xae\xe0\xa4\xf1\x88\x73\x85\xc3\x3e\xd1\x4a\xb5\x09\x14\xa2\x9d\xf9\xc2\xab\xa3\x26\xba\x3d\x78\xf8\x23\x5e\x1e\x6e\xce\xa2\x36\xf8\x73\xdf\x7c\x87\xfb\xbd\x7b\x8e\x1a\x45\xfb\x1e\x5d\x47\xb0\x9e\x18\xc1\xdb\x08\x73\x51\xe5\xe3\x29\xf3\x7d\x8f\x6e\x18\x63\x0d\xda\x32\x39\xea\xf1\xe9\x62\xaa\xb0\x82\x2c\x0d\x2b\x7d\xc6\x8c\xe9\xb9\x2a\x1f\xe2\xe6\x1f\x96\xf3\x4f\xaa\x16\xf0\x3d\xec\xf7\xe8\x08\x80\x38\xa8\x84\x92\x99\x25\xe0\x2f\x42\x99\x03\x74\x96\xd5\xe6\x89\xa3\x0b\x11\xfa\x1d\x4b\xea\xaf\x88\x56\xd2\x46\x4d\x53\x1b\xcc\xd2\xa0\x27\x22\x32\x08\x80\x58\xd0\xa4\xb9\x54\x0f\xda\x68\x49\xb5\xab\xfc\x28\xd4\x43\x63\xb8\xfd\x12\x42\x3c\x9b\xc5\x3b\x89\x18\x81\x5c\xc2\xe7\x8d\xc9\x92\xa8\x7c\x65\x34\x83\xda\x52\x69\x91\x4e\x1d\xec\x99\xe1\x6c\xff\x49\xbb\xf7\xf4\xf4\x91\x2b\x79\x1e\x92\x53\x79\x20\x5c\x4e\x49\xcb\x93\x64\x6e\x49\xdf\x5e\x12\xcc\x49\xc7\xdd\xc3\x4c\xae\xd1\x82\xd2\x0e\xf0\x5e\x5a\xf7\xa4\xba\x69\x45\x8f\x69\x9c\xa1\xf9\x80\x23\x3d\x05\xcf\x15\xd5\xf9\x3a\xba\x7f\xd6\xa3\xce\x54\x22\x73\xc6\x94\xd3\xf4\x4d\x59\x7a\x4c\xf9\x42\x94\xba\x78\x38\x3b\x25\x48\xb3\x16\x37\xc8\x55\xbf\x5d\x61\xf7\x04\xa5\x5a\x2c\x8b\x38\x0d\x85\xe5\x5f\x66\x40\xdf\x6d\x75\x9a\xbe\x2c\x73\x24\xea\x57\xf4\x99\x9e\x9d\x5e\xc3\x09\x8f\x63\xb6\x74\x04\x49\xc6\xa3\x51\xb4\xc6\xd5\xf5\x2e\x5f\x2a\x6e\x93\x91\x91\xb2\x1b\x4b\xb7\xaf\xb3\xaf\xa8\xc4\xca\x8b\x27\x59\x5a\x71\x64\xde\x0a\x43\x36\x20\x51\x88\x75\x7c\xe7\xc2\x62\xc9\xfc\xda\x1f\x20\xfe\x12\x1e\xb8\x34\xc2\x34\xa7\x81\xd0\x10\xbc\x9e\x9f\x72\x38\xa9\x6a\x0c\xc7\x87\x56\xbe\x30\x3e\x34\x78\x7d\x4c\x63\xd1\x92\x44\x0c\x94\xda\xfa\x75\xd7\x16\x87\x3d\x87\xdd\x50\x11\xfa\x82\x11\x4e\x91\x4c\x5e\x59\xff\x5e\x65\xcf\xec\x1f\xc4\x02\xcb\x59\x74\xe7\xe9\xb6\x1b\x77\xad\x1c\xb2\xec\x47\x5e\x2f\xc7\x50\xc3\x97\xe4\x19\x9a\xff\xcf\xdf\xe9\xf9\xbb\x46\x1c\x72\xf8\x40\x3a\x26\x9c\x70\xef\xfa\xd2\xa4\xc3\xd4\xc6\xdb\xdd\x92\x2e\x86\x92\xe9\xbb\x7c\xd9\xd6\x74\xe3\x4e\x46\x5d\xc8\x42\xf6\x36\x9f\x66\x0b\x24\x00\x2f\x6c\x26\x4a\x1a\x16\x25\x8f\x57\xe9\x31\x89\xb5\x3d\x54\x00\xa0\x04\xb6\xb3\x2f\x3d\xae\xcc\x47\x99\x3c\x0b\x87\xe2\x0a\xbc\x26\x49\xa4\x07\x5a\xe8\x61\xbf\x6f\x60\x17\xf5\x63\xd3\x4a\xb8\x55\xbc\x0d\x1c\xb0\xe4\x14\x12\x2a\xea\xfe\x83\x17\x12\x1f\xd6\xa5\xdf\x35\x84\x67\xf0\x4b\x27\x73\xf1\xdd\x38\x1d\x71\xfc\x3b\x89\x9c\xde\x37\x4d\x62\x8d\x7a\x12\x2a\xd3\x64\x80\x09\xd9\x63\x72\x96\xf3\x43\xf2\x09\x73\x08\xcf\xba\xe9\x15\xce\x53\x4f\xfd\x58\xea\x39\xcd\xd8\x79\x7a\x34\x7a\xf2\xa5\x5f\x73\x6b\xef\xbf\x82\xab\x10\x99\x7f\xf8\x37\x53\x1d\x07\x62\x16\x7c\x3f\xd0\x3d\x92\x33\x2a\xee\x6d\x5c\xc1\x7e\x5c\xbb\x7c\xdc\xb4\x01\x4d\xc3\xd5\x35\xfd\x8a\x0f\x10\x68\xb7\x31\xec\x1a\xf5\x9a\xda\x2d\xfd\xfe\xbb\xb0\x3f\xe8\x52\x66\x0f\xc4\x73\x34\x62\xc2\x64\xcc\xc1\xc2\x70\xbb\x8a\x90\x7c\x78\xcc\xd5\x31\x65\x1b\xfe\x39\xed\xfc\xbc\x1e\x48\x20\xcc\xf6\xea\xf8\xba\xf3\xaa\xa1\xb4\x7d\xca\x8f\x30\xee\x14\x2b\xb6\xe3\x8e\x9a\x3a\x0a\xa3\xbf\x79\x80\x37\xed\x0b\x68\xde\x84\xc3\x1b\x54\x7d\x8b\xc6\xf0\xd3\x47\xb9\xf3\xf6\xa3\x7d\x18\x1d\xeb\x76\xa1\x7e\x1f\x5e\x7c\x84\xa7\x69\x3b\x7f\x8a\x30\xf4\xac\xba\x77\xa3\xf1\xbf\x03\x00\x69\x5b\xb4\xb8\x81\x31\x00\x00")
 
 func templateClientTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -281,12 +281,12 @@ func templateClientTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/client.tmpl", size: 8045, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/client.tmpl", size: 12673, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x6f\xe3\x36\x14\x3c\x8b\xbf\x62\x60\xf8\x60\x1b\x59\x7a\xbb\xb7\x16\xf0\x61\x91\xdd\xa2\x01\x82\xb4\x40\x7b\x2b\x8a\x82\x26\x9f\x64\xd6\x32\x9f\x4a\x52\x41\x03\xc1\xff\xbd\xe0\x87\x62\x05\xc8\x21\x27\x89\x7c\xf3\xbe\x66\x86\xd3\xb4\xdf\x89\x7b\x1e\x5e\xbc\xed\x4e\x11\x5f\x3e\xff\xf0\xe3\xa7\xc1\x53\x20\x17\xf1\xb3\xd2\x74\x64\x3e\xe3\xc1\x69\x89\xaf\x7d\x8f\x0c\x0a\x48\x71\xff\x4c\x46\x8a\x3f\x4e\x36\x20\xf0\xe8\x35\x41\xb3\x21\xd8\x80\xde\x6a\x72\x81\x0c\x46\x67\xc8\x23\x9e\x08\x5f\x07\xa5\x4f\x84\x2f\xf2\xf3\x1c\x45\xcb\xa3\x33\xc2\xba\x1c\x7f\x7c\xb8\xff\xfe\xf4\xfb\x77\xb4\xb6\x27\xd4\x3b\xcf\x1c\x61\xac\x27\x1d\xd9\xbf\x80\x5b\xc4\x45\xb3\xe8\x89\xa4\xd8\xed\xaf\x57\x21\xa6\x09\x86\x5a\xeb\x08\x2b\xcd\xae\xb5\xdd\x0a\xf5\x7a\x3d\x9c\x3b\xfc\x74\xc0\x51\x05\xc2\x5a\xde\xe7\xa8\xfc\x4d\xe9\xb3\xea\x28\x81\xa6\x09\x91\x2e\x43\xaf\x22\x61\x75\x22\x65\xc8\xaf\xb0\x9e\xd3\x6f\x21\x7b\x19\xd8\xc7\x39\xb4\xdf\xe3\xd7\x21\x5a\x76\x68\x47\xa7\xf3\x4f\x64\x94\xde\xa3\xa7\x3c\xbe\xee\x2d\xb9\x28\x45\x7c\x19\x68\x89\xde\xec\x0a\x6e\x9b\xcb\x94\x89\x12\x6b\x39\xa7\x56\x50\x05\xcd\x7e\x51\x09\xca\x19\xd8\x18\x70\x1c\x6d\x6f\xc8\xd7\xca\x25\x05\x21\xfa\x51\x47\x4c\xa2\xd9\xef\x61\xbc\x7d\x26\x8f\x31\x69\x90\x8a\xd0\x7f\xa4\xc7\x68\x5d\x07\xa3\xa2\xca\x5c\x78\xfa\x77\xa4\x10\x83\x14\x4d\x45\x1b\xab\x7a\xd2\x51\x7e\xcb\xc7\x52\x87\x8e\x63\x07\x72\xea\xd8\x13\x54\x3d\xf6\xdc\x75\xd6\x75\x29\x31\x9f\x8f\xcc\x7d\x46\xf7\xdc\xdd\x5a\x56\x14\xd8\xd5\xb4\x0b\x1b\x92\xa2\x49\xa0\xcc\x82\x94\xd2\xba\x48\xbe\x55\x9a\xa6\xeb\x36\x57\x38\x31\x9f\x43\x62\xb2\x0c\x4c\x29\xfb\x32\xc6\xcc\x46\x9a\xb4\xc4\x77\xf9\x23\x8a\x0c\xe5\x6a\x20\x5f\x59\xba\xcb\xdd\x5b\x15\x22\x94\xd6\x14\x42\xa5\xa9\xe0\x6e\x2c\x4d\xd3\x27\x78\xe5\x3a\xc2\xda\x25\x83\xac\xe5\x13\x1b\x0a\x49\x5d\x00\x68\x92\x77\x9c\x7c\x52\x97\xe4\x12\xfc\xf9\x57\x92\xf2\x17\xe6\x73\xc9\x24\x67\x12\x72\xe9\x84\x00\x35\x0c\xbd\xa5\x22\x24\xd7\x3b\x76\x0b\x5d\xc1\xc7\x7f\x12\xc3\x22\x11\x80\x8d\xc6\xec\x84\x19\xbe\xe1\x21\x06\x48\x29\x4b\xc9\x6d\x1a\x34\xad\xf3\xf7\x5d\x42\xa4\x31\xcb\xc8\x19\x36\x89\xa6\xe1\x21\x6e\xf4\x56\x34\x57\xd1\xd8\x16\x5a\x16\xaa\x53\x44\xcb\x2a\xeb\xe1\x26\x6c\x0a\x6e\xe6\xc0\x1d\xb4\xec\xb9\xcb\xc9\x65\x8f\x6f\x0b\xb5\xc3\x5b\xb1\xe7\x3d\x12\x0b\xc5\x1f\x75\x89\x52\x73\x3b\xfb\x7b\x12\x8d\xa7\x38\xfa\xea\xf4\xc5\x86\x75\xa6\x5c\xf4\x80\xe8\x47\xba\x35\x7e\xe4\x0e\x81\x62\x61\x6e\xee\xf8\xfa\xb0\x12\x01\x4b\x0b\xe5\xbe\x8f\xdc\x6d\x5a\xf7\xae\x93\x3e\x3c\x4c\xb2\xe2\x01\xad\x5b\x30\x50\x28\x7b\x7d\xc7\x61\xf9\xfc\xcc\x9b\xbd\xf3\x61\xf3\xee\xd3\xf9\x38\x1b\xaf\x0a\xd5\x27\x97\xe7\x98\xa6\xd9\x5e\xff\x07\x00\x00\xff\xff\xf2\xe9\x13\xff\x9b\x05\x00\x00")
+var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\xdd\x93\xdb\x36\x92\x7f\x16\xff\x8a\x8e\xca\x49\x28\x9b\xa6\x1c\x5f\xdd\xc3\x8d\xa3\x54\xf9\x66\x26\x75\x73\x99\x38\xc9\x8e\xb3\x5b\xbb\x8e\x2b\x81\xc8\xa6\x84\x0c\x09\xd0\x00\x38\xb6\x4a\xa5\xff\x7d\xab\x81\x06\x3f\x64\xcd\x7a\xb2\xd9\x27\x5b\x44\x77\xa3\xbf\xf0\xeb\x8f\xd9\xef\x97\x8f\x93\x73\xdd\xee\x8c\xdc\x6c\x1d\x3c\x7f\xf6\xd5\xff\x3c\x6d\x0d\x5a\x54\x0e\xbe\x15\x05\xae\xb5\xbe\x85\x2b\x55\xe4\xf0\xb2\xae\xc1\x13\x59\xa0\x73\x73\x87\x65\x9e\xbc\xde\x4a\x0b\x56\x77\xa6\x40\x28\x74\x89\x20\x2d\xd4\xb2\x40\x65\xb1\x84\x4e\x95\x68\xc0\x6d\x11\x5e\xb6\xa2\xd8\x22\x3c\xcf\x9f\xc5\x53\xa8\x74\xa7\xca\x44\x2a\x7f\x7e\x7d\x75\x7e\xf9\xea\xe6\x12\x2a\x59\x23\xf0\x37\xa3\xb5\x83\x52\x1a\x2c\x9c\x36\x3b\xd0\x15\xb8\xd1\x65\xce\x20\xe6\xc9\xe3\xe5\xe1\x90\x24\xfb\x3d\x94\x58\x49\x85\x30\x2f\xb4\xaa\xe4\x66\x0e\xfc\xf9\x51\x7b\xbb\x81\xb3\x15\xac\x85\x45\x78\x94\x9f\xfb\xd3\xfc\x47\x51\xdc\x8a\x0d\x12\xd1\x7e\x0f\x0e\x9b\xb6\x16\x0e\x61\xbe\x45\x51\xa2\x99\xc3\xa3\x9e\x1d\x55\x61\x76\xad\x23\x11\x95\xa8\x2d\xb3\x3c\x05\x59\x01\xbe\x83\x47\xf9\x8d\xd3\x46\x6c\x30\x7f\x25\x1a\x84\xb9\x7d\x57\xfb\x9b\x67\xfb\xfd\x53\x30\x42\x6d\x10\x1e\x29\xe2\x7d\x94\xbf\xd2\x25\x5a\x38\x1c\xf6\xfb\x78\x50\xf9\x03\x95\x7f\x2b\xb1\x2e\xf9\x48\x56\xf0\xa8\xca\xff\xff\xe6\x87\x57\x97\xe1\xe2\xef\x70\x17\x4e\x7a\x4d\x56\xe0\x4c\x47\x7a\xec\xf7\x80\xaa\x3c\xf9\x1f\xaf\x22\xff\x77\x6a\xa1\x6c\x5a\x6d\x5c\xb4\x70\xb9\x84\x1f\x5a\x27\xb5\x82\xaa\x53\x85\xff\x8f\xd3\x10\x5c\xd8\x19\xf4\x51\x28\x6a\x89\xca\xe5\x89\xdb\xb5\x38\xa6\x4e\x1f\x07\xba\x85\x17\x13\x1c\x4b\xc1\xf7\x3c\x2c\x41\x78\x91\x95\x36\x23\x49\x20\x54\x09\xd2\x59\x58\x77\xb2\x2e\xd1\xb0\xe4\x20\x0c\xac\x33\x5d\xe1\x60\x9f\xcc\x96\x4b\x28\x8d\xbc\x43\x03\x1d\xa5\x12\x09\xc1\x0f\x58\x74\x4e\xaa\x0d\x94\xc2\x09\x1f\x52\x83\xef\x3a\xb4\xce\xe6\xc9\x8c\xa9\x4b\x29\x6a\x2c\x5c\x7e\xe1\x7f\x06\x39\xb8\xee\x36\x80\x4a\xac\x6b\x04\xc1\x3f\x6b\xbd\xd9\x48\xb5\x21\x46\xff\x7b\xad\x75\xed\xa9\x6b\xbd\x19\xae\x64\x2a\xd0\x8a\xd9\x1a\x5d\x62\x9e\xcc\x88\xc8\x7b\x21\xcf\x73\xa9\x1c\x9a\x4a\x14\xb8\x3f\x2c\xbc\x84\xad\xd6\xb7\x16\x9c\x66\x85\x91\xb8\x9b\xce\x79\x6f\x90\xa6\xe1\xfc\xb1\xff\xc7\x33\xb4\x06\x9d\xdb\x51\xd8\x59\x4b\x0b\x52\x95\xa8\x1c\x96\xe0\xbf\xf2\x8b\xb8\x71\x46\xaa\x8d\x67\x69\xd0\x6d\x75\x49\x8f\x02\x95\x93\x4e\x22\x09\x1e\xc9\xe9\xcd\xf9\xdd\x6a\xf5\xbd\x30\x76\x2b\x6a\xef\x7a\xfa\xfd\xb3\x6a\xe2\x17\x83\x23\xff\xaa\x42\x97\x64\xad\x50\x25\x3b\x8e\x3f\x50\xf8\xee\x44\xdd\xa1\xa5\x1b\xfd\x05\x95\x4f\xdb\x1c\x94\xac\xa1\x41\xa1\x6c\xcf\xbe\xa4\x2b\xf2\x64\x36\xbe\x19\x7c\x86\xa5\x63\x57\x41\xfa\xe6\xed\x7a\xe7\x30\x03\x34\x46\x9b\x45\x32\x9b\xaa\xe6\x19\x22\xc9\x84\xd1\xd3\x8f\x8c\xfb\x70\x81\xad\xdb\xc6\xe4\x6b\xc4\x07\xd9\x74\x0d\x28\xb4\x21\x57\xfc\xa1\x47\x0f\xf4\xde\xf4\x9c\xa5\x2e\xba\x06\x95\x23\x1e\xe1\xbc\x1f\x4a\x24\x00\x2b\x73\xf8\x07\x1a\xcd\x36\x75\xaa\x96\x8d\x74\x58\xf6\xf6\xc4\xcb\x94\xf3\x72\x1a\xf1\xe1\x2f\xe8\x8c\x44\x1b\xef\x57\x5d\xb3\x46\x43\x7e\x72\xb2\x41\x96\xdf\xb5\xa5\x70\xe8\x39\xac\x13\x0e\xc3\xd5\x74\xab\xf1\xdc\x25\xbc\xdf\xa2\x82\x9b\x9f\xae\xa5\xf3\x18\xba\xee\xec\x2e\x4f\x66\x63\xf1\x74\x23\x63\x4f\x8f\x06\x04\x36\x24\x94\x7f\xa3\x21\xde\x71\x40\x89\x8a\x43\x4a\xe1\xe4\x9f\x81\x69\x1a\xd5\x28\xa2\x8c\xa1\x4d\x66\xb3\xf8\x8d\x72\xc3\xe5\x8c\x49\x68\x92\xd9\x08\x60\x0e\x1e\x00\x7c\x32\x43\x8b\x86\x9f\x79\xe6\x15\xa8\x84\x75\x20\x8a\x02\xad\xe5\x77\x1e\xe8\x86\x67\x7e\x3f\x52\x26\x00\x00\x33\xc2\x70\x15\xe0\xf5\x70\x80\x37\x6f\x49\x8f\xff\xd3\xfa\xf6\x84\x0a\x01\x9c\x2c\x88\xb6\xad\x29\x1e\x64\x9e\xe6\x6f\x5a\x8d\x80\x09\xf4\xfa\x77\x82\x88\x84\x52\x0c\xd2\x02\x22\x94\x45\xf2\x54\xb7\xce\x42\x9e\xe7\x41\xe4\x82\x14\x25\x73\x7e\xcd\x88\x82\xd4\x0c\x2a\x7b\xb2\x7d\x32\x9b\xe9\xd6\xa5\xc5\x22\x99\x1d\x92\x99\xac\xa0\xc8\x03\x56\xd0\x49\x91\x33\x2e\xad\x06\x64\xa2\xc3\x34\x1e\x64\x50\xe4\xb5\xde\x78\xe6\xe0\xca\x8b\x11\x5c\xd9\x29\x5a\x45\x3b\xc8\x0b\x01\xe0\xd8\x08\xcf\x93\x2e\x22\x40\xef\x93\x99\x41\xd7\x19\x86\xea\x91\x85\xac\x13\x91\x73\x29\x19\x2e\xbe\xd6\x1b\xb0\xe8\x9f\x04\xf6\x37\xf6\x95\x81\x1c\x30\xc6\x40\x3a\x80\x6b\xbd\x49\x2b\x75\x12\x0a\x1f\xac\x0c\x61\xe9\x0a\x2a\x35\x28\xf2\xe3\xa7\xf0\x50\x77\xae\xed\x5c\x5f\x59\x46\x80\x74\x04\x95\x13\xa4\x64\xcf\x79\xb4\x1c\x9e\x7d\x70\x14\x96\xb0\xde\x8d\x0b\x1e\x5c\x39\x7a\x4b\xa5\xb4\xa4\x00\x9d\x92\x66\x25\x56\xa2\xab\x5d\xc6\x55\x8c\x28\xc8\x66\x55\x62\x49\x80\xbf\x1e\xa1\xa9\xf7\x15\xb9\x90\x5d\x35\x18\xf5\xf0\x38\x8d\x00\xfd\x38\x58\x95\x36\x8d\x70\xfe\x28\x58\x10\xc2\x66\x7d\x89\x00\x83\xdc\xc3\xf9\xa2\x43\xcf\x5b\x8c\x60\x9b\xf9\x47\x8e\x62\x2f\xe5\x70\x55\x41\xb8\x94\x4c\xa3\x1b\xb3\x01\x26\xe8\x53\xb8\xd6\xa1\x97\x21\x2c\x08\x35\x0d\x4d\x0e\x7f\x25\x52\x86\xbd\x42\x28\xa5\x1d\xac\xc9\xef\x1e\x5c\xc9\x3d\x5e\x20\x3b\x72\xb0\x84\xbd\x34\x98\x95\xb2\x1e\x54\xc9\x32\xb8\x9b\x96\x00\x36\x73\xef\xdf\x1b\x13\x92\xcb\x64\x05\xeb\xae\xf2\x25\x85\x9e\x29\x55\x94\x9c\x6b\xd0\x95\x57\x33\xbd\xcb\x60\x3e\xcf\x60\x0e\x30\x5f\xbc\xf0\x74\xab\x95\x2f\x62\xc4\x1e\xa3\x11\xc4\xa7\xeb\xae\x5a\x24\x33\x7a\xd5\x87\x21\x50\x8d\xcb\x6f\x5a\x23\x95\xab\xd2\xf9\xe7\x77\xf3\x0c\xee\x16\x1c\x12\xd2\xfa\x5c\x97\x58\xf4\x0d\x10\xa3\x50\x7c\x43\xec\x95\xd3\x45\x77\x8a\xc6\xa3\x94\x26\x1f\xad\xb1\xd2\xa1\x21\xdb\xf9\x5a\x65\x9d\x36\x58\xc6\x64\x8f\xed\x50\x48\x4b\x8a\xec\xb8\x72\x37\xa1\xb6\xf4\xbc\xb5\x16\xbe\xcc\x5d\x39\x28\x84\x0a\xd2\x07\x7d\x0c\xb6\xb5\x28\xa2\x42\x93\x9a\x0e\x2d\xf7\xcd\xe9\x28\x82\x0b\x78\x2f\xdd\x16\x84\xc7\x7a\xaa\x3f\x4d\x5b\xfb\xf2\xe6\x33\x8f\xa4\x7b\x9b\xa5\x85\x42\x37\xad\x70\x92\x9a\x30\xcf\x22\x5d\x0e\xdf\x92\x07\x3e\x08\xe2\x39\x4b\x96\xcb\x64\xb9\x9c\xdd\x09\xe3\x27\x89\x02\x42\xfc\xa4\x43\xc3\x8d\xfb\x79\x2f\xe1\x6f\xd2\x6d\x6f\x9c\x50\xa5\x30\xe5\xb5\x5c\x1b\x61\x76\xc4\xcb\x2d\xe6\xd9\xca\xd7\xab\x57\xf8\xfe\xdc\x7f\x48\x07\xbc\x4c\x4b\x73\xb7\xc8\xfc\x71\x1f\xae\xd4\x5f\x17\xf3\x24\x0b\xb7\xe7\x7d\x27\xb2\x58\x04\xcd\x80\x3b\x75\xb6\xb7\xe8\xac\xd3\x0d\x30\x17\x55\x7c\x03\x3d\x0f\x1a\xb8\x45\x6c\xa1\xb3\x31\x08\x21\x36\x1c\xe0\x3e\x0d\x5a\x61\xc9\xf1\x4e\x27\xcb\xe5\x24\x94\x7d\x47\x7e\x9c\x0d\x90\x62\xbe\xc9\x29\xf2\xad\xc1\x52\x16\xc2\xa1\xcd\xe8\x6e\x1f\x64\xd1\xb6\xa8\x28\x60\x7c\xd3\x82\xb3\x45\xd6\x35\xdd\x30\xbc\x42\x22\x99\x04\x97\xdf\xdf\xe0\x14\xb6\xe4\xd3\x8d\x5b\x06\xdd\x43\x9b\xb6\x07\x03\xdf\xb8\x77\x5c\x01\x4b\xef\x4f\x7a\x37\xc3\x6a\xb8\x7b\x80\xc6\xfe\x13\x19\x43\x5d\x8f\x9f\xbd\x06\x18\x3b\x42\xc3\x10\xa4\x18\x00\x72\x50\x11\xab\x45\xec\x65\x46\xef\xd4\x3b\x8b\xe6\x3f\x02\x36\x8b\xa3\x2e\x22\x5a\x30\xb9\x3e\xa5\x80\x42\xf4\xc8\x11\x8a\x79\xff\x91\xc5\xb2\x82\x63\xcb\x3e\x1b\x50\x89\x3d\x75\x44\xe1\x25\x7b\xf4\x19\xa1\x13\x51\xe4\x27\x48\x06\x80\xea\x3b\x58\xdf\xd8\x72\xcb\x7a\x5f\xab\xfc\x2f\xda\xe4\x00\x2a\x7d\x39\x4d\xb5\xa1\x1b\x82\x43\xc7\xb9\x17\xd0\x06\x2a\xa3\x9b\x13\x58\x25\x3d\x06\x4d\x00\xa8\x35\xda\x61\xe1\x22\x02\xf9\x6e\xae\xe0\x49\xd2\xe8\x26\x99\x34\xef\xfc\x16\x5b\xe1\xb6\xba\xd6\x1b\x59\x88\xba\xb7\xc6\x6b\xfc\x1e\x07\xb4\x5c\xef\xa0\x53\xce\x74\x96\x8a\x55\x58\x23\xd8\x1c\x2e\xa6\x26\xe2\x87\x02\x43\x75\x23\x6d\x37\xf2\x0e\x15\xfb\x85\xac\x31\x48\x9d\x23\x59\xef\x2f\x56\x21\xad\xb3\x8f\xf0\xb9\x9f\x25\x4e\x00\xdc\x1f\x06\xa9\x18\xb2\xf4\xbf\x9e\xf7\x58\xf4\x7a\x8b\x43\xb8\x44\xef\x91\x8f\x87\x10\x1a\xb5\xc9\x23\x58\x72\xdb\x4b\x35\xbb\x04\x61\x8c\xd8\xd9\x1c\x64\x8e\x39\xb8\xb1\xb0\xdf\xbe\xfa\x8d\xa4\x3c\xcb\xc8\x07\x44\x3a\x3d\xdd\xcf\xc5\xfc\x0c\xde\x7c\xf5\xf6\xe0\xc9\x9e\xf3\xb8\x34\x2d\x09\x61\x78\xf2\x01\x18\xb8\xa5\xf5\x6f\xa6\x1f\xa8\x7a\xbc\xe9\xed\xa3\x5e\xc2\xfd\x41\x8c\xe0\x6c\x5e\xc1\xa8\x7f\x2c\xb6\x58\xdc\x52\xfe\x86\xb3\xd8\x1f\xc5\x70\xd1\xeb\xbd\x37\xed\x43\xc4\x27\xc9\xcf\x39\x61\x63\x52\xc4\x09\x73\xc2\xd7\xb7\x8d\xea\x4e\xd4\xb2\xec\x99\xe3\x94\x47\x7b\x97\xbe\xc9\x94\x5c\xa1\xd1\x7c\x0c\x1f\x53\xe5\xc7\xf8\x71\x0a\x30\x7a\x07\x7c\xbd\x82\x67\x63\xb4\x50\xb2\x26\x87\xf8\x72\xca\xfe\xa7\x01\x92\x4a\xbc\xcc\x68\xcf\x42\x09\xf8\x2c\x0b\x6b\xad\x17\x20\xe1\x6b\xa8\x51\xf9\xeb\x16\x2f\x40\x3e\x79\xe2\x85\xd9\xf7\xd2\x15\x5b\x58\x13\x31\x1d\xbd\x91\x6f\x5f\xf8\x83\x82\xb6\x2f\x24\xe5\x8b\x2f\x60\x0d\xab\x15\x7c\xf9\xcb\x2f\x5f\x9e\x51\x0b\x25\x9f\x3c\x89\xe7\xe1\x60\x1e\xbe\x13\xf1\x0a\x3e\xb3\xce\x8c\xd8\xcf\xa6\xa4\xfb\x2f\x33\xfe\xdf\x1b\x16\x56\x85\xc4\x7c\xf2\xe4\x05\xbb\xfb\x9b\x63\xc3\x49\x9b\xde\xea\xaa\x71\xf9\x25\x3d\xc9\x2a\x9d\xc7\xf5\xdf\xe1\x70\xe6\x1b\x89\x8f\xe2\x79\x3a\x98\x9f\x97\xf3\xec\xe8\x0e\x6a\x02\x67\x87\xa9\xaa\x87\x5e\xd5\xb7\x41\x55\xaf\xde\xd3\xa7\xc7\xfd\x22\xc5\x21\xa0\xef\xf7\xc3\x2c\xdf\x4f\x59\x27\x97\x05\xa4\x56\x58\x18\xc4\x25\x19\x6d\x5c\x9d\x89\xa9\xe3\x8c\x50\x56\x14\xb1\xbf\xf2\x40\x2c\x1d\x54\x42\xfa\x41\x05\x0b\x11\xbb\x6c\xde\x27\xf4\x4d\xc5\x7b\x61\xa1\xd6\xc5\x2d\x91\xed\x40\x28\xed\xb6\x34\xb1\x6b\xa5\x08\x71\xb5\x82\xf4\xe6\xa7\xeb\xab\xd7\x97\xbf\xfe\xef\xcf\x37\x7f\x27\x9d\xb5\x01\xfe\x72\xfd\xc3\xf9\x77\x97\x17\x8b\x1c\xa2\x0d\x94\xd6\xa3\xe9\x28\x3e\xfe\x2c\x62\x46\x68\x54\xe9\xc5\xf7\xd0\x1e\xad\xe9\xbb\x42\xd3\xd1\x93\xb7\xb2\xa4\xbd\xdc\xc8\xac\x0c\x04\xf9\x02\xc7\xdf\x46\xc3\x84\x41\xeb\xc4\xe8\x39\x45\x37\xe5\x11\x19\xc3\x1c\x4f\xf8\x24\x37\x2a\x62\x3f\x11\x06\x83\x79\x12\xb7\x7f\x1e\x9e\x87\x98\xa6\xff\xdd\x63\xf3\x2b\xed\x70\x88\xa4\x87\x92\x10\x4e\x1b\xc1\x22\x3a\x82\x9b\xb9\x97\xbe\x6b\xfb\xda\xf7\x97\xdf\x78\xff\x7d\x8f\x66\x83\xfc\x21\xd4\x51\x5f\x07\x43\x61\x92\x0a\xb4\x42\xf8\xf9\xc7\x8b\x97\xaf\x2f\x87\x7d\x52\xef\x79\xe3\x8b\x51\x16\x72\x26\xf6\x9f\xe4\x0c\x2b\xaa\x38\xb8\x8f\x14\xff\x63\xa8\x3b\xda\x48\x0d\x98\xcb\xab\x6b\x5e\x17\xd1\x06\x7a\xb9\x84\x7e\x5d\x74\x3c\x0e\x0d\x5b\xa5\x38\x1a\xf4\x19\xc2\x47\x1f\xcf\x43\xf4\x8b\x1b\x60\x62\x22\x37\x08\x33\x88\x2a\x81\xb2\x09\xad\x83\xd4\x57\x34\x9f\x2a\xa2\x2f\xd4\x84\xc3\xef\xea\xb8\xc0\x5a\xdc\x33\x4e\xf5\xe3\x13\x49\x1f\x96\x65\x9f\x98\xa1\x24\x3d\xce\x77\x9d\x34\x6c\x42\x61\x50\x10\x5b\x16\x82\x1e\x97\x6f\xc4\xc1\x6d\x66\xdf\x34\xb1\x6e\x47\x7b\xb7\x3f\x9d\x94\xbd\xe3\xd3\xdb\xc6\x86\xac\xa4\x58\x0e\x01\x49\x71\x4a\xf7\xe0\xe0\x47\x5d\x09\xc9\x47\xbb\x08\xfe\x3c\x59\x46\x88\xd8\xb5\x8f\xfe\xb6\x40\xd1\x66\x5a\x5e\xcf\x85\x3d\x0e\xc7\x37\x94\x5e\x66\xa3\x18\xf4\x9c\xe9\x71\x03\x9e\x51\xbe\x49\x9f\x3b\x4a\xd6\x8b\x61\xce\xea\xdd\x69\x8e\x0a\xf3\x47\x75\x96\x09\x49\xe9\xf4\x16\x77\x57\x17\x3c\xf5\x67\xf0\xe0\xc9\x67\xf1\x69\x92\x63\x97\xde\xc1\xa7\xa8\x43\x6d\x1f\xcc\x38\xb1\x9e\x50\xb2\xce\xee\x2d\x75\x8d\xb4\x71\xba\x63\x09\x94\x95\xb7\xb8\x83\xcf\xdf\xcd\x33\xf0\xa6\xf2\x56\x83\xae\x8a\xc6\x8e\xaf\xe9\xbf\x4d\x16\x28\xcc\x33\xde\xaf\x30\x61\x4a\x43\x08\x09\xa3\x75\xca\x67\xf7\xe8\x8b\xc6\xb0\x04\xfe\x3a\x32\x32\xa6\x62\x08\x44\x46\x3b\x9c\xd1\x42\x94\x9f\x62\x9c\xe6\x86\xf4\xe1\xbe\x7e\x98\xea\x54\x6f\x75\x39\x4c\x23\xff\x46\x6e\x94\x78\x5f\x6e\x4c\xda\xb1\x13\xd1\xbb\x3f\x78\xff\xc1\xd8\x1d\x92\x53\x3e\xbc\xc0\x89\x0f\x49\x53\x1a\xfc\x86\xbf\x0a\x92\x4b\x02\x58\x1c\x23\x32\xc3\x4b\xd8\x48\xb3\x3b\x7a\x58\x39\xf1\x67\xb5\x07\x03\xc6\xb0\xfc\xe6\x3f\xc7\xc5\x72\x81\xaa\x84\xc3\x21\xf9\xe7\x00\xfb\x03\xa6\x10\x7e\x1e\x00\x00")
 
 func templateConfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -301,7 +301,7 @@ func templateConfigTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/config.tmpl", size: 1435, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/config.tmpl", size: 7806, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -606,7 +606,7 @@ func templateDialectSqlByTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x7b\x6f\xdb\xc8\x11\xff\x9b\xfc\x14\x73\x82\x1b\x90\xae\x4c\xd9\x41\x51\xa0\x76\x75\xc0\x9d\xe5\x14\x02\x2e\x46\x5b\x3b\x41\x50\x43\x08\x56\xe4\x50\x5a\x98\xda\x65\x76\x97\xae\x0d\x81\xdf\xbd\x98\x59\x92\xa2\x1e\x96\xdd\xd7\x3f\x31\xb5\x3b\xef\xf9\xcd\x63\xb3\x5e\x8f\x4e\xc3\x6b\x5d\xbe\x18\xb9\x58\x3a\xf8\x78\x7e\xf1\xa7\xb3\xd2\xa0\x45\xe5\xe0\x93\x48\x71\xae\xf5\x23\x4c\x55\x9a\xc0\x2f\x45\x01\x4c\x64\x81\xee\xcd\x13\x66\x49\x78\xbf\x94\x16\xac\xae\x4c\x8a\x90\xea\x0c\x41\x5a\x28\x64\x8a\xca\x62\x06\x95\xca\xd0\x80\x5b\x22\xfc\x52\x8a\x74\x89\xf0\x31\x39\x6f\x6f\x21\xd7\x95\xca\x42\xa9\xf8\xfe\xb7\xe9\xf5\xcd\xed\xdd\x0d\xe4\xb2\x40\x68\xce\x8c\xd6\x0e\x32\x69\x30\x75\xda\xbc\x80\xce\xc1\xf5\x94\x39\x83\x98\x84\xa7\xa3\xba\x0e\xc3\xf5\x1a\x32\xcc\xa5\x42\x18\x64\x52\x14\x98\xba\x91\xfd\x51\x8c\x52\x83\xc2\xe1\x00\xea\x9a\x28\x4e\xe6\x95\x2c\xc8\x9e\xcb\x31\x94\xc2\xa6\xa2\x80\x93\xe4\x2e\xd5\x25\x26\xbf\x36\x37\x0d\xa1\xc1\x14\xe5\x93\xa7\xec\xbe\x3b\xf6\x86\x68\x55\x39\xe1\xa4\x56\x2c\xce\x48\xe5\x7a\x7c\x83\xa4\xbd\x1d\x00\xd1\x87\x79\xa5\x52\x88\xb6\x64\xd7\x35\x9c\xf6\xad\xaa\xeb\x18\xec\x8f\xe2\x4e\x3c\x61\x94\xba\x67\x48\xb5\x72\xf8\xec\x92\x6b\xff\x37\x86\x88\xc9\x93\x5b\xb1\x42\xa8\xeb\x21\xa0\x31\xda\xc4\xb0\x0e\x03\x3e\xff\xfb\x46\xf0\x10\xbe\xdb\x12\x53\xb2\x6c\x47\x65\xe2\x43\x72\x57\x62\x1a\xc5\x61\x20\x73\x92\x42\x74\xf6\x47\xb1\x30\xa2\x5c\x26\xd7\x4c\x70\xab\x33\xb6\x62\xb8\x27\x20\x33\xf4\xd5\x68\x88\xaf\x98\xff\xa7\x31\x28\x59\x90\x25\x24\x31\x45\x63\x86\xa0\x1f\x49\xac\xb4\x77\x7f\xfb\xed\x5a\x2b\xeb\x8c\x90\xca\xdd\x90\xc9\x11\x1a\x13\x5f\x11\x01\x31\x04\x24\x60\xcc\x4c\x61\x10\xd4\x61\x10\x18\x74\x95\x51\x24\x91\x7d\x0c\xe9\x70\xbd\x3e\x03\x99\x83\x50\x19\x9c\x24\xd3\x49\xf2\xc5\xa2\x99\x70\xc6\x33\x88\xb4\xf1\x87\x53\x7b\xe7\x8c\x54\x8b\xf6\xd7\x97\x2f\xd3\x49\x4c\xe1\x0f\x98\x7f\x74\x0a\x13\x0d\x4a\xbb\xa5\x54\x8b\x21\xcc\x31\x15\x95\x45\x42\x9a\x45\xf8\x08\xee\xa5\x44\x0b\xab\xca\x3a\x98\x23\xd8\xaa\x2c\x0b\x89\x19\xcc\x5f\x18\x8b\x95\x45\x93\xc0\xe9\x08\xce\xea\xc6\x1c\x2c\x2c\x6e\x84\xcb\x7c\xdf\x30\xbe\xa4\x88\xec\xe6\x27\x99\x4e\x60\x3c\x86\x73\x0e\x00\xcb\x52\x1d\x75\x46\x61\xe3\xe0\x92\xb8\xaf\xa2\xa8\x30\x89\xa4\x72\x7f\xfc\x43\x4c\xf7\x07\x45\x79\x05\xd3\x49\x72\xff\x52\x92\x4d\x91\xcc\xe2\x37\xed\xaa\x77\x74\xf7\xbf\x9b\x14\xec\xe3\x4a\xc9\x22\x7c\x3f\x9c\xfb\x60\xdb\x83\xef\xe9\x0e\xe4\x88\x8c\xd1\xfc\x24\x0c\x44\xe1\xbe\xab\x30\x86\x0f\x7d\x11\xeb\x54\xab\x5c\x2e\x2e\xf7\x31\xce\xe7\xe4\x9f\x2f\x83\x31\x7c\x38\xa0\x8b\xc1\x77\x2f\xe6\x05\x7a\x09\xc9\x5f\x45\xfa\x28\x16\x24\x39\xe1\xe3\x21\x11\x4c\x27\x97\x3d\xee\x4f\x12\x8b\xac\x63\x0e\x28\xdc\x97\x90\xd3\x61\xd2\x4f\x41\xc2\x88\x6f\x3d\x65\xd2\x6b\x5d\x54\x2b\xb5\xaf\xa9\x65\x63\x0e\xa1\x5c\xcb\xc0\xff\xd6\x61\x10\x87\xc7\xd3\x28\x73\x90\x59\x5b\x6d\x5b\x6d\xa9\x27\xfc\x73\x73\xf6\x17\x24\xf9\x51\xaf\xf8\x0e\xc3\x49\x66\x74\xb7\x0d\xc2\xf6\x78\x07\x29\xf4\x6d\x84\x5a\x20\x9c\xe4\x64\xc2\x89\x8f\x91\xed\xac\x7b\x22\xe6\x63\x06\xe6\x47\xcc\xf3\x26\x34\x12\xc7\x20\xca\x12\x55\x16\xf5\x4f\x87\xef\xcf\x4e\xfe\x5a\x6e\xd8\xbf\xcb\xc6\xd2\x37\xb3\x95\xef\xe7\xea\x70\x65\x7a\xe2\x3b\x67\xaa\xd4\xb1\x69\x1e\xc3\xeb\x35\x67\x33\x4f\x6e\x65\x51\x10\xce\xa0\xae\x09\xd7\x3e\xa2\x6c\xc4\xd1\x30\xa3\x0f\xf3\x4d\xb6\xc0\x4d\x94\x95\xce\xd0\xbe\x16\x61\xdc\x31\x62\x3a\xb1\x14\xe4\x02\x55\xc4\x7c\x31\xfc\xdc\xf4\x22\xd6\xf3\x4f\xe9\x96\x80\xcf\x8e\x74\x9f\xc0\x80\x14\x0d\x48\xed\x80\x86\x82\x1d\x80\x33\x15\xc2\xe0\x1f\x68\xf4\x00\x06\x4a\x16\x83\xa6\xa1\x50\x04\x1c\xae\xca\x42\xb8\x9d\x39\x9c\x61\x8e\x2c\x25\xa1\xb2\x5d\x8f\x4e\x9b\x69\x9d\xd1\xa4\x27\x82\xaa\xcc\x84\xc3\xc4\xad\xca\x02\x78\xa2\x07\xbb\x7d\xd1\xe7\xdc\x3b\xbd\x03\x04\x3e\x1c\x02\x69\x88\xf7\x23\xf7\x6a\x2b\x63\xe6\xd0\x2f\x0f\x0d\xf1\xf1\x3d\xe2\xfb\xbc\x2a\x1e\xff\x0f\xcb\x44\x38\x1a\x01\x4d\xfd\xa6\x5d\x5a\x9e\x37\xfd\x46\x07\xa8\x9c\x74\x12\x6d\xbb\x18\x65\xc2\x89\xb9\xb0\x98\xbc\xb7\x11\x1f\x59\x2a\x1e\x66\xaf\xae\x15\x14\x20\x06\xd5\x4a\x3c\x22\x11\x1e\xe8\xa2\x43\x86\xd1\x6e\x07\x6e\x74\xdb\x38\x0e\x83\x0e\x9a\xad\x94\x6d\x75\x6f\xb1\x33\x98\xb5\xe9\x4b\xf8\xec\x8f\xde\xe6\xcd\xb5\x01\xc9\x71\xe7\xd2\x79\x8d\x94\xa1\x4f\x91\x8c\x24\x48\xe5\x86\x7e\xf1\xdc\x0b\x15\x17\x48\x2f\xed\xaf\x89\x7b\x90\x33\xa2\xa4\x31\xb6\xaa\x1c\x34\xd6\xc2\xd8\x7f\xe1\x27\x52\xc4\xda\x0e\x24\x64\x08\x2b\x68\xdb\x61\x0c\xd1\x57\xdf\x3c\x37\x29\xf1\xfb\x44\xb3\xb3\x35\x0a\x93\xd2\x20\x27\x78\x7f\x1b\x0b\x0e\x6c\x53\xcd\xe8\x0f\x82\xb6\x4d\xb4\xcd\x79\x95\x34\x23\xba\x35\xa0\xc9\x51\xdc\xaa\xfd\xa9\x6d\xcb\xdb\x52\xf3\x95\x4b\x78\xb1\xcb\xa3\x41\xa5\xf0\xb9\xc4\xd4\x61\x06\x5d\x17\xa2\xc5\x0a\x7e\x77\x3f\x18\xc2\x2a\xee\xa9\x6f\xad\xef\xe8\xc6\x1d\x0b\xdf\x33\x6e\x1e\xe4\x6c\x08\x8c\xc3\x07\x39\x83\x8d\xcb\xdb\x6b\x6c\x13\x6d\x72\x9e\x43\xd5\x1a\x2c\xe1\xcf\x8c\x91\x16\x43\xf1\xd9\x45\xeb\xc0\x77\x8e\x46\xab\x53\x53\xd6\x7e\x7f\x31\xf3\xae\x63\x44\x00\xd8\x5f\x7d\x37\x09\x26\xd2\xd6\xd8\xc6\x27\xbf\x0f\x36\xd2\x47\x23\x98\xaa\x27\xfd\xc8\xdb\x25\x88\xd4\x55\xa2\x00\x5d\xa2\xf1\x9e\x6a\x5f\xc6\xd4\x28\xad\xdb\x04\xaa\xa9\xee\x74\x29\xa4\x4a\xbc\xa0\x26\xd9\xbd\xfd\xfc\x57\xe1\xd2\xa5\xaf\xbf\xe3\x0b\xfa\x87\x43\x2c\x3c\x1d\xb9\x8f\x5f\xfa\xb0\xd6\x07\x41\xf3\x1f\xac\xf1\xc1\xee\x2a\xbf\xc9\x74\xf3\x67\x1b\x75\x49\xa6\x15\x2d\x15\x34\x4d\xfa\xb8\x7e\x2f\x7a\xff\xdb\x17\x41\xf0\x3f\x7f\x14\xb4\x33\xab\x7b\x17\x04\x6f\xad\xe0\xc1\x66\x76\x3f\xc8\xd9\xd6\xab\x60\x6f\x00\x76\x6f\x83\xb6\x1a\x0e\x3e\x0f\x7a\x75\x73\xec\x65\xf0\x1e\xcb\xea\x83\x56\xec\xfc\x6c\xf3\xd3\xd5\x2a\x3d\x10\xba\x95\xa8\xeb\xbf\x54\x84\x6d\xe9\x2e\xb5\x7e\xb4\x31\x9c\xc1\xc5\x15\x48\xf8\x79\x0c\xe7\x57\x20\xcf\xce\x1a\xaf\xa9\x63\x6e\xca\x9c\x69\x1f\xe4\x8c\x2a\x38\x6e\x1f\x2d\xc1\xa6\x64\x67\xbe\x80\x69\xea\x47\x72\x08\xa9\x7b\x8e\xf9\xb9\x28\xf3\xed\xba\xef\x16\x1c\x99\x43\x53\xf9\x97\xbd\xd2\x3f\xef\x0a\xff\x60\x45\x75\x75\x7f\xde\xab\xfa\xfd\xb2\xd9\xc7\x6a\xcd\xc6\xf4\x63\xd4\xbd\xa0\x9a\x99\xff\x0d\x52\x51\x14\xd6\xcf\x7f\xc2\x72\x29\x94\x4c\x2d\x65\x86\x8f\x3c\xaf\x05\xa1\x7c\x67\xfb\xb7\x26\xfe\xb7\xc3\x23\x7f\x67\x04\xf3\x93\xab\x8b\xc9\xae\xef\xed\xe6\xb0\xf9\xcf\x82\x9e\xcb\x6c\x2c\xf7\x81\xbe\xa3\x4f\x61\xdd\xdb\xa9\xfe\x15\x00\x00\xff\xff\x4e\x0c\xc1\x7b\x5f\x12\x00\x00")
+var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x18\x6b\x6f\xe3\xb8\xf1\xb3\xf4\x2b\xe6\x04\xef\x42\x4a\x15\x39\xbb\x28\x0a\x34\x5b\x1f\xb0\x97\x64\x0f\xee\xdd\xa5\x0f\xef\x16\x87\x06\xc1\x82\x96\x46\x31\x1b\x99\x54\x48\xca\x17\xc3\xd0\x7f\x2f\x86\x22\x15\xf9\x11\x27\xb7\x68\xbf\x24\x32\x39\xef\xf7\x70\xb3\x19\x9f\x84\x17\xb2\x5e\x2b\x7e\xb7\x30\xf0\xfe\xec\xdd\x9f\x4f\x6b\x85\x1a\x85\x81\x4f\x2c\xc7\xb9\x94\xf7\x30\x15\x79\x06\x1f\xab\x0a\x2c\x90\x06\xba\x57\x2b\x2c\xb2\xf0\xf3\x82\x6b\xd0\xb2\x51\x39\x42\x2e\x0b\x04\xae\xa1\xe2\x39\x0a\x8d\x05\x34\xa2\x40\x05\x66\x81\xf0\xb1\x66\xf9\x02\xe1\x7d\x76\xe6\x6f\xa1\x94\x8d\x28\x42\x2e\xec\xfd\xcf\xd3\x8b\xab\xeb\xd9\x15\x94\xbc\x42\x70\x67\x4a\x4a\x03\x05\x57\x98\x1b\xa9\xd6\x20\x4b\x30\x03\x66\x46\x21\x66\xe1\xc9\xb8\x6d\xc3\x70\xb3\x81\x02\x4b\x2e\x10\xa2\x82\xb3\x0a\x73\x33\xd6\x0f\xd5\x38\x57\xc8\x0c\x46\xd0\xb6\x04\x31\x9a\x37\xbc\x22\x79\xce\x27\x50\x33\x9d\xb3\x0a\x46\xd9\x2c\x97\x35\x66\x3f\xb8\x1b\x07\xa8\x30\x47\xbe\xea\x20\xfb\xef\xd1\x7c\x1b\x68\xd9\x18\x66\xb8\x14\x04\x54\x2b\x2e\xcc\x00\x2f\xca\xfc\x6d\x04\x04\x1f\x96\x8d\xc8\x21\xde\xa2\xdd\xb6\x70\x32\x94\xaa\x6d\x13\xd0\x0f\xd5\x8c\xad\x30\xce\xcd\x23\xe4\x52\x18\x7c\x34\xd9\x45\xf7\x3f\x81\xd8\x82\x67\xd7\x6c\x89\xd0\xb6\x29\xa0\x52\x52\x25\xb0\x09\x03\x7b\xfe\xcf\x27\xc2\x29\x7c\xd5\x35\xe6\x24\xd9\x0e\xcb\xac\x33\xc9\xac\xc6\x3c\x4e\xc2\x80\x97\x44\x85\xe0\xf4\x43\x75\xa7\x58\xbd\xc8\x2e\x2c\xc0\xb5\x2c\xac\x14\xe9\x1e\x81\x42\x11\x29\xc7\x21\xf9\x60\xf1\xbf\x9b\x80\xe0\x15\x49\x42\x14\x73\x54\x2a\x05\x79\x4f\x64\xb9\x9e\xfd\xe3\xe7\x0b\x29\xb4\x51\x8c\x0b\x73\x45\x22\xc7\xa8\x54\xf2\x81\x00\x08\x21\x20\x02\x13\x8b\x14\x06\x41\x1b\x06\x81\x42\xd3\x28\x41\x14\xad\x8e\x21\x1d\x6e\x36\xa7\xc0\x4b\x60\xa2\x80\x51\x36\xbd\xcc\xbe\x68\x54\x97\xd6\xe3\x05\xc4\x52\x75\x87\x53\x3d\x33\x8a\x8b\x3b\xff\xeb\xcb\x97\xe9\x65\x42\xe6\x0f\x2c\xfe\xf8\x04\x2e\x25\x08\x69\x16\x5c\xdc\xa5\x30\xc7\x9c\x35\x1a\x29\xd2\x34\xc2\x7b\x30\xeb\x1a\x35\x2c\x1b\x6d\x60\x8e\xa0\x9b\xba\xae\x38\x16\x30\x5f\x13\x04\x34\x1a\x55\x06\x27\x63\x38\x6d\x9d\x38\x58\x69\x7c\x22\xce\xcb\x7d\xc1\xec\x25\x59\x64\xd7\x3f\xd9\xf4\x12\x26\x13\x38\xb3\x16\xb3\xb4\x44\x0f\x5d\x90\xd9\xac\x71\x89\xdc\xbf\x58\xd5\x60\x16\x73\x61\xfe\xf4\xc7\x84\xee\x0f\x92\xb2\x4e\x22\xf0\xcf\xeb\x9a\x64\x8a\x79\x91\xbc\x28\x97\x97\xdc\xf3\x1e\x7e\x3b\x17\xec\x32\x4b\xc9\x29\xe1\xeb\xc3\x79\x18\x6c\x7b\xe1\x7b\xb2\x13\x72\x04\x66\xa3\x79\xc5\x14\xc4\xe1\xbe\xaa\x30\x81\xb7\x43\x12\x9b\x5c\x8a\x92\xdf\x9d\xef\xc7\xb8\x3d\x27\xfd\xac\x1d\x09\xef\x00\x2f\xb2\x7d\xf0\x99\xcd\x2b\xec\x28\x64\x7f\x67\xf9\x3d\xbb\x23\xca\x99\x3d\x4e\x09\x60\x7a\x79\x3e\xc0\xfe\xc4\xb1\x2a\x7a\xe4\x80\xcc\x7d\x0e\x25\x1d\x66\x43\x17\x50\xce\x6a\xe3\x35\x25\x32\xc1\x85\xac\x9a\xa5\xd8\xe7\xe4\xd1\x2c\x06\x13\xc6\x23\xd8\xbf\x6d\x18\x24\xe1\x71\x37\xf2\x12\x78\xe1\xb3\x6d\xab\x2c\x0d\x88\xff\xe2\xce\x7e\x44\xa2\x1f\x0f\x92\x6f\xd7\xc6\x5d\x38\xf1\x82\x44\xd8\x0e\x42\x7f\xbc\x13\x29\x24\x9c\x62\xe2\x0e\x61\x54\x92\x08\xa3\xce\x46\x1a\xda\x76\xb3\xa1\x94\x15\xd2\xc0\xa8\xcc\xa6\xfa\x47\x14\xa8\x98\x19\x08\xbe\x22\xba\xc7\x64\x2f\x8f\x48\xde\x49\xe7\x98\x4d\x80\xd5\x35\x8a\x22\x1e\x9e\xa6\xaf\x77\x5c\xf9\x9c\xdb\x6c\xfe\x9d\x3b\x49\x5f\x74\x64\xb9\xe7\x46\x5f\x78\xae\x44\xae\xd6\x35\x29\x6f\x99\x6a\xf8\x4d\xb1\x9a\x0a\x0b\x57\xb0\x64\x4a\x2f\x58\x05\xd4\x23\x48\x57\xf8\x8d\x9b\x05\x60\x87\xf1\xd7\xd9\xdf\xae\x53\xc8\xe5\x92\x5a\xb2\x1e\xe0\x13\x8c\x7e\xa8\xb2\x0b\x77\x95\xda\xe2\xb8\x75\x2b\x15\x31\xbc\xc7\xf5\x00\x7c\x26\x95\xf9\x09\xd7\x9a\x6a\x99\x0d\x20\xca\xb3\x53\x18\x79\x0e\xe4\x8a\x88\x3a\x26\x99\x85\xca\xc1\xe0\xb7\x25\x32\x2a\x33\x92\xc9\x29\xf4\x13\xae\x1d\x6c\x4f\xc0\x75\xc3\x12\xa2\x37\x3a\x1b\x68\x11\xbf\x79\x48\x21\x1a\x64\x6a\x36\xe0\x32\x81\x28\x71\x5c\x7c\x64\x39\xe3\x0d\x99\x7a\x5d\xc9\x48\x47\xb8\x0e\xcd\xe2\xb9\xf6\x80\xdb\x5c\xad\xa8\x96\xb7\x15\xe3\x90\x00\x94\x7a\x14\xbf\x24\x00\x59\x0f\x0b\xb2\xdf\x33\xfc\x07\x27\xd1\xd0\xdc\x71\xf4\x6d\x6c\x7f\xe9\x62\xc3\xd6\x3f\x7b\x15\xb8\x93\x73\xd8\x62\xdf\xb6\x14\x3d\xf1\x0a\xb8\x30\xa8\x4a\x96\xe3\xa6\x4d\x20\xbe\xb9\x9d\xaf\x0d\x0e\x47\x05\x22\xb1\x55\xde\xf7\x42\xb8\x67\xe9\x12\x21\x5e\x65\xf1\x53\x8e\x40\xdb\x26\xd4\x5b\x82\x20\xe8\xf5\x19\xc6\xba\xed\x8a\x43\x93\x5d\x4b\xf1\x89\x0b\x6e\xf0\x45\x0d\xc8\x5e\xee\xae\x47\x3a\xc6\x82\xe2\xbd\x67\x03\x71\x5f\x68\x88\xab\xcd\xdb\x59\xce\x84\x40\x95\xbc\xc8\x79\xb7\x81\xfc\x47\x4b\xe1\x60\x0f\x0a\xd0\x7b\xaa\x3d\xdc\x93\x09\xa9\xcc\x66\x46\x35\xb9\xb1\xb5\xa8\xeb\x5e\x5d\x41\x1c\x95\xd9\x35\xaf\x2a\xea\x30\xd0\xb6\x6f\x7b\xcf\xdb\x1a\xb3\x5b\x60\x37\x9b\x43\x95\x16\xbb\x4a\x7b\x55\xdc\xa1\xee\xab\xa9\x90\x05\xea\xe7\x2a\x29\xee\x48\x33\xbd\xd4\x54\x4c\x2b\x14\xb1\xc5\x4b\xe0\x7b\x37\x8e\x3c\x25\x1d\x3e\x1a\x12\x62\x04\x11\x31\xa2\x50\x85\x88\xe6\x42\x1d\x81\x51\x0d\x42\xf4\x6f\x54\x32\x82\x48\xf0\x2a\xf2\x26\xde\x6c\xc0\xe0\xb2\xae\x98\xd9\x19\xc5\x0b\x2c\xd1\x52\xe9\xf2\x6f\x7c\xe2\x06\xf6\x82\x86\x7d\x9a\xd5\x9b\xba\x60\x06\x33\xb3\xac\xab\xbe\x34\x6d\x1b\xbb\xab\xed\x24\xcb\x5e\xc1\xb7\x87\x29\x10\x87\x64\xbf\x47\x3d\x3b\xcd\x58\x8a\x34\xcf\x3c\x99\xf9\xf8\x2a\xf1\x75\xde\x54\xf7\xff\x87\x7d\x22\x1c\x8f\x81\x06\x7f\x37\x31\x69\xea\x0c\x9d\xbc\x2e\x09\x01\x85\xe1\x86\xa3\xf6\xbb\x51\xc1\x0c\x9b\x33\x8d\xd9\x6b\x67\xb1\x23\x7b\xc5\xcd\xed\xb3\x9b\x05\x19\xc8\x06\xd5\x92\xdd\x63\x7c\x73\x7b\x68\x68\x4b\x6d\x18\xed\x08\x90\x39\xde\x9a\xaa\x45\x1f\x9a\x9e\xca\x36\xbb\x97\xd0\x6d\x30\x4b\x35\xa4\x60\xe7\x02\xa9\x5e\xc6\x1d\x8f\xe1\x63\x5d\x57\x6b\x0a\x37\xd6\x54\x46\x83\x14\x80\x2c\x5f\x80\x83\x82\x39\x96\x52\x21\xa8\x46\x08\xda\x1d\xb8\xd1\xb0\x90\xf2\x5e\xa7\x50\xf1\x7b\xda\x45\x2d\x11\xb2\xb9\xe6\xe2\xae\x42\xeb\xa8\x14\xb4\xec\xc0\x40\xa3\xdd\x21\x40\x93\x3a\x7d\xde\x71\x01\x73\x69\x16\x90\x33\x8d\x3a\x0b\x83\x52\x2a\xf8\x9a\xf6\x4c\xcf\x27\x2e\x97\x9f\x93\xdd\x2f\x53\x6e\x3d\x73\xc7\x59\xad\x90\xd8\xc7\xfb\x8b\xd7\xfe\xda\x44\x95\xa4\xed\x38\xf3\x57\x32\xa4\x58\x8a\x39\x35\x91\xb4\xdb\xbe\xf7\x82\x85\xc4\x0a\x1c\x8e\x2f\x36\x87\xc8\xdd\xf0\x5b\x82\xa4\x59\x7e\xd9\x18\x70\xfe\x82\x49\xf7\x85\x9f\x88\x91\xe5\x76\x20\x24\x53\x58\x82\x1f\xfc\x12\x88\x6d\x2d\xdf\xe9\x61\xde\xce\x7e\x7a\x5c\x66\x6e\xbd\xf0\x78\x2e\xb8\xa8\x1a\xd8\x59\xf3\x3b\x3f\x37\x6e\xef\x97\xe5\xd2\x64\x76\x29\x2d\xe3\xa8\x11\xf8\x58\x63\x4e\x33\x53\xef\x46\x5a\x0a\xe1\xcd\xe7\x28\x85\x65\x47\xca\xd6\x25\x6f\x80\x7e\xcb\x87\x49\x8f\x62\xef\x6d\xc0\xdf\xf0\xdb\x14\x6c\x02\xdd\xf0\x5b\x78\xf2\xe1\xf6\x0a\xee\x8c\x44\xde\xb4\x1a\x7a\x81\x39\xfc\xc5\x06\xb7\x0f\xfe\xe4\xf4\x9d\x57\xe0\xab\x35\x86\xe7\x29\xc9\xd8\x7f\x78\x77\xdb\xcd\xca\x18\x93\xdf\xf6\xd7\x76\xc7\xdc\x81\x7a\x61\x9d\x4e\x5d\x4b\x75\xd4\xc7\x63\x98\x8a\x95\xbc\xef\xa2\x9a\xe5\xa6\x61\x15\xc8\x9a\x66\x77\xd2\x94\x8c\xb2\x40\xa0\x0a\xaf\xcd\x93\xa1\x5c\x59\xca\x17\x8c\x8b\xac\x23\xe4\xa2\x77\xf0\xb6\xf0\x03\x33\xf9\xa2\x2b\x1c\xc7\x1f\x17\xde\x1e\x42\x21\x8b\x6d\x6c\x03\x3a\xef\xcc\xda\x1e\xc8\x82\xe0\x5b\x9e\x20\x82\xdd\x67\x88\x27\x4f\xbb\x7f\xed\x56\xd4\x65\x85\x14\x08\x13\xdb\x06\xbd\xbf\xf6\x05\xd9\x4f\xc8\x20\xd8\x9a\xef\xbe\xf9\x35\x23\xf8\x9f\x3f\x68\x04\xc1\xce\x9b\x46\x10\x1c\xdf\x3b\x9d\xd6\x3e\xd0\xb7\x5e\x34\x82\x60\xab\xfd\x06\x41\xff\xae\xe1\xb3\xe1\xe0\xd3\xc6\x20\x6f\x8e\xbd\x6a\xbc\x46\xb2\xf6\xa0\x14\x3b\x3f\xbd\x7f\x1c\xcf\xee\x71\xa3\x1f\xea\xfa\xb2\x49\x49\xe8\x53\xd7\x56\xfc\x04\x4e\xe1\xdd\x07\xe0\xf0\xfd\x04\xce\x3e\x00\x3f\x3d\x75\x5a\x53\xa1\x7b\x4a\x73\x0b\x7b\xc3\x6f\xe3\x65\x63\x12\xff\xe0\xd2\xf7\xb2\xae\x24\x2c\x1b\x43\x75\x3a\xe6\x29\xe4\xe6\x31\xb1\xf5\x9a\x97\xdb\x79\xdf\x4f\x66\xbc\x04\x97\xf9\xe7\x83\xd4\x3f\xeb\x13\xff\x60\x46\x39\x69\x2c\x9c\x0f\xdf\xdf\xd1\x3c\x86\x36\xea\x5f\x7f\xdc\xb0\xf2\x2b\xe4\xac\xaa\xb4\xfd\xb6\xcb\x67\xcd\x04\xcf\x35\x79\xc6\x1e\x75\xb8\x1a\x98\x20\x92\x52\xfd\xae\x51\xe5\xd7\xc3\xb3\xca\xce\xec\x40\x76\x59\xf5\x36\xd9\xd5\xdd\x8f\x3c\x49\x78\x20\x41\xad\xb0\xb6\x0e\x0c\x15\x5d\x85\xed\x60\x18\xfc\xef\x00\xf8\xe0\x60\x0a\x1b\x17\x00\x00")
 
 func templateDialectSqlCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -621,12 +621,12 @@ func templateDialectSqlCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 4703, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 5915, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\x1b\x37\x13\x7d\x96\x7e\xc5\x64\x21\x1b\x92\x20\xad\x9c\xe0\xc3\x07\xd4\xa9\x0b\x04\x71\x02\xa8\x2d\xdc\xc0\x8e\xf3\x12\x04\xc5\x66\x39\x94\x58\x71\x49\x85\xa4\x6c\x0b\x8b\xfd\xef\x05\x2f\x2b\x91\xba\xe5\x52\xe4\x4d\xdc\x21\xe7\x72\xe6\x9c\x21\x55\xd7\x93\x61\xf7\xb5\x5c\xae\x15\x9b\xcd\x0d\xbc\xb8\x78\xfe\xcb\x78\xa9\x50\xa3\x30\xf0\xb6\x28\xf1\xb3\x94\x0b\x98\x8a\x32\x87\x57\x9c\x83\xdb\xa4\xc1\xda\xd5\x03\x92\xbc\xfb\x7e\xce\x34\x68\xb9\x52\x25\x42\x29\x09\x02\xd3\xc0\x59\x89\x42\x23\x81\x95\x20\xa8\xc0\xcc\x11\x5e\x2d\x8b\x72\x8e\xf0\x22\xbf\x68\xad\x40\xe5\x4a\x90\x2e\x13\xce\xfe\xe7\xf4\xf5\x9b\x9b\xbb\x37\x40\x19\x47\x08\xdf\x94\x94\x06\x08\x53\x58\x1a\xa9\xd6\x20\x29\x98\x28\x98\x51\x88\x79\x77\x38\x69\x9a\x6e\xb7\xae\x81\x20\x65\x02\x21\x23\xac\xe0\x58\x9a\x89\xfe\xc2\x27\x04\x6d\x46\x13\x29\x30\x83\xa6\xb1\xbb\x7a\x0a\x4b\x64\x0f\xa8\xe0\xf2\x0a\x7a\xf9\x6d\xbb\xb2\x4e\x26\x13\xd0\x65\x21\x3e\x14\x7c\x85\xb6\x42\xb3\x52\x42\xbb\x44\xcc\x7a\x89\x1a\xa8\x54\x6e\x83\x60\x62\x06\x0f\x7e\x17\x55\xb2\x02\xfd\x85\xe7\xb7\xf2\x51\xe7\x5d\xba\x12\x25\xf4\x87\x36\x50\x7e\x53\x54\x08\x4d\x33\x88\x9c\xf6\x07\xf0\xf1\x13\x13\x06\x15\x2d\x4a\xac\x1b\xa8\xbb\x1d\x1f\x67\xff\x7b\xe7\xbc\xae\x81\x51\x10\xd2\x40\x2f\x9f\x5e\xe7\xf7\x1a\xd5\xb5\x2b\x92\x40\xd3\xd8\x98\x37\x2b\xce\xa7\xc2\xfc\xff\x7f\x75\x0d\xc8\xb5\x8d\xe6\x22\x4f\xaf\x9d\xe9\xfd\x7a\x19\x3e\xa1\xb0\x47\xea\x66\x04\x93\x09\x6c\xb6\xf8\xfc\xba\x9d\x4e\x5d\x8f\x41\x15\x62\x86\xd0\xfb\x7b\x04\x3d\xea\xb1\x79\xcb\x90\x13\xed\x77\xb8\x64\x7a\x34\x71\xbb\xf5\x46\x77\x7c\xf9\x70\xdd\x4e\xd3\x75\xad\x19\xc3\x23\x33\x73\xeb\x51\x2a\x64\x33\xf1\x07\xae\xbd\xdb\xc9\x04\xe8\xe2\xdb\xe0\xa6\xfe\xe8\x78\x61\xcf\x1e\xc6\xbe\x73\x10\xfc\x36\xc0\x21\xe8\x8f\x63\x1f\x43\x42\x17\x16\x8f\x3c\x00\xe1\x2c\x01\x22\xba\xf0\x20\xb5\xa6\xb8\x63\xf4\xdb\xfb\x45\xbf\xd6\xad\x18\xdf\x04\xe0\x8e\x03\x39\xfa\x62\x39\x5c\x68\xcd\x66\x2d\x8b\xfd\xc2\xc3\x1a\x60\x33\xf3\xc2\xc0\x23\x2a\x0c\x98\x23\x49\x91\x84\x7e\x41\x0d\x6e\xb1\x1f\x58\xa7\x46\x3a\x17\x31\xb6\x40\x1d\x41\x5a\xd2\x27\xe2\x6a\x1a\xd8\xe9\x43\x9c\x55\x3f\x64\x92\xe7\x79\x04\xfc\x00\x50\x29\xa9\x1c\xfe\x8c\x42\x35\x02\x61\x51\xe6\x28\xc2\xfe\xc1\xc8\x2d\x9c\xdf\x77\x45\xb9\x28\x66\xd6\x75\xfe\x5a\xf2\x55\x25\xf4\xe0\x25\x54\xf0\x2b\x08\xdf\xbf\xd0\x59\x5a\x99\xfc\x8d\xf5\x4a\xfb\x59\xc5\x74\x55\x98\x72\x0e\x62\x55\x7d\x46\x65\xc7\x89\x2d\x31\xc0\x72\x09\x67\x04\x9e\x5d\xc1\x19\xc9\x46\x2e\xf6\xc0\xc3\xeb\xf0\x66\x14\x0a\x41\xf6\x65\xd8\x97\xca\x7f\x9c\xea\x3b\xa3\x2c\x4f\xc3\xea\xfe\x7e\x7a\x3d\x88\x1a\xe6\x04\x80\x4f\xc6\xb6\xa9\x07\xd9\x94\x3c\x65\x70\x01\x99\x63\x4f\xe6\x0e\x41\x76\x8b\x65\x96\x40\x18\xe8\x06\x06\xab\x25\x2f\xcc\xe1\xd9\x46\xbd\x8b\xfc\x10\x3b\xdc\xc2\xf3\xcc\xda\x5c\xa1\x23\x90\x8e\xcf\xbe\xea\x8f\x17\x9f\xf2\xfe\x30\xe1\xa6\xad\xdb\xe2\xff\x4c\x2e\x3c\x94\x87\xb0\x5c\x09\x7c\x5a\x62\x69\x90\x38\xb1\xc2\xd9\x7b\x27\x57\x97\x0c\x30\x0b\xa1\xf3\xef\x7c\x85\xbc\x92\xd2\x6c\xc1\x57\x9b\x49\x14\xa8\xef\xdb\x9c\x6f\xb2\x48\x6a\x09\x94\xd9\x24\xfe\xfc\xf2\x53\x3a\xb9\xd8\x91\xc9\x75\x0c\xfe\x1e\xdb\xe2\x4f\x7f\x1a\xfa\xf1\xe2\xc8\x14\x4c\x8d\x71\xea\x7b\x45\xd7\xb5\x55\x80\x0b\xe7\xca\x4f\x63\xd8\xae\x45\x6a\x81\xab\xab\x83\x7a\x89\xe2\x0f\x42\x87\x77\x61\x4c\x27\xde\xa9\x91\x97\xc8\x83\xee\x8b\x83\x46\xd2\xa0\x3b\xc2\xf8\xe1\xe6\x64\x77\x46\xad\x4a\xb3\xd9\x10\x8f\xc7\x1f\xe8\xda\x1e\x8e\x7b\xca\xf1\xd8\x1e\xd2\x8f\x05\x97\x41\xd3\xec\xcb\xe8\x65\xa4\xa0\xef\x12\x11\x92\x19\x8e\xbd\x92\xb6\xc3\xbf\x69\x12\x4d\x59\x59\xf9\x04\xdb\xbc\xf2\x0f\x05\x67\x64\x1b\x6f\x57\x70\xc9\x3d\x02\x57\x20\xf0\xb1\xef\xbf\x05\xf5\xb5\x7e\x3b\xc3\xaf\x1d\x4d\x8e\xed\x8a\xb6\xd3\x2a\x7e\x0f\xd4\x74\xb9\xa7\x90\x00\x90\x60\xbc\xeb\x5e\x6a\xed\x8d\x76\xfa\x69\x17\x5a\x69\x3d\x38\x96\x32\x3f\x01\xee\x4a\xb9\xc4\x7c\x4a\x9e\x60\xbc\x31\xd1\xd8\xe4\x49\xbc\x35\x2a\x34\xb1\xf9\x16\xcb\xf8\xa4\xdb\xec\xe8\x9f\x47\xd4\xf3\xb7\x75\x10\xae\x3f\xb7\x67\x0d\x67\xbd\x9a\xb6\x55\xb5\xb2\x71\x9a\xf8\xfd\xee\xaf\x1b\x8f\xc1\x37\x90\x6c\xef\xc1\x10\x13\xed\x7b\x27\x75\xd2\xd9\x96\x60\x51\x3c\x77\x07\xa6\x3c\xb3\x77\xa4\x60\x1c\xce\xcf\xdd\x70\x19\x7a\x4e\xc2\x6f\x70\xe1\x53\x60\xd4\x5e\xe3\x36\xf9\x7f\xb4\x14\xf9\xbd\xa8\x0a\xa5\xe7\x05\x0f\x3b\x47\x70\xee\xe9\x65\x36\xcc\x0a\x60\x0d\x5e\xba\x83\xc1\xfd\x89\x9b\x27\x38\x3c\x54\xc2\x25\x9c\x3d\x64\x23\xeb\x67\x73\xf3\x04\xac\xb7\x62\x76\x1d\x15\x2b\xce\x1d\x1c\xbe\xa9\x1b\x38\xc7\xdf\xd3\x86\x8d\x93\x9f\xdf\x84\x68\xca\xf6\xc3\xf3\xd2\xe6\x9b\xbb\xf7\xd4\x9d\x7d\xa8\xa1\x1a\x40\x7f\x5e\xe8\x77\x0a\x29\x7b\x8a\x92\xcb\xf4\x17\x9e\xb5\x23\xf7\xd4\xd0\xd8\x52\xf2\x86\x71\x5e\x7c\xe6\x18\x8d\xc3\x83\x2d\x3b\x31\x46\x86\xc7\x8f\xa4\x0c\xf6\x5a\xc9\x5c\x3a\x59\x32\x2a\xe2\xf1\xfb\xdf\xbd\x1d\x79\x13\x1d\x61\x77\x8b\xc8\x89\xa8\xdb\x87\x7e\x04\xd7\x70\x23\x71\xe7\x6e\x77\xc6\xb5\x64\xf4\xeb\xf8\xdd\x7e\x7a\xca\x55\x85\x58\xb7\xff\x60\xb7\x27\x26\x43\x78\x45\x08\x33\x4c\x8a\x56\x0e\xfe\x5f\x93\x7d\xa9\xcf\x50\xa0\x2a\x2c\xe3\x2a\x49\x90\xbb\xef\x73\xc9\x89\xbd\x89\xad\x3d\xf9\x43\xe5\xfe\x44\x1f\x49\xc1\x1d\xf7\x73\x56\x6f\x07\x6d\xf2\xdf\xe8\xc0\x9b\xe6\xe8\x93\x21\xbd\x4c\x3c\x8e\xc7\x30\x4c\x88\xb5\x03\x5d\xfb\xeb\xdf\x00\x00\x00\xff\xff\x1b\x5a\x93\x5f\xbe\x10\x00\x00")
+var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x6d\x6f\xdb\xba\x15\xfe\x2c\xfd\x8a\x73\x05\xb7\xb0\x0d\x87\x4a\x2f\x86\x01\x4b\x97\x01\x45\xd2\x02\xde\x2d\xb2\xae\x69\xee\x97\x8b\x62\x50\xa5\xc3\x98\xb0\x4c\xba\x14\xdd\x24\x10\xf4\xdf\x87\x43\x52\x12\x65\xd9\x37\x2f\x43\xb1\x6f\xa6\x49\x9e\x97\xe7\x3c\x0f\x79\xc4\xba\x4e\xe7\xf1\x85\xda\x3e\x68\x71\xbb\x32\xf0\xeb\xe9\x9b\xbf\x9d\x6c\x35\x56\x28\x0d\x7c\xc8\x72\xfc\xa6\xd4\x1a\x96\x32\x67\xf0\xae\x2c\xc1\x2e\xaa\x80\xe6\xf5\x0f\x2c\x58\xfc\x65\x25\x2a\xa8\xd4\x4e\xe7\x08\xb9\x2a\x10\x44\x05\xa5\xc8\x51\x56\x58\xc0\x4e\x16\xa8\xc1\xac\x10\xde\x6d\xb3\x7c\x85\xf0\x2b\x3b\x6d\x67\x81\xab\x9d\x2c\x62\x21\xed\xfc\xc7\xe5\xc5\xfb\xab\xeb\xf7\xc0\x45\x89\xe0\xff\xd3\x4a\x19\x28\x84\xc6\xdc\x28\xfd\x00\x8a\x83\x09\x9c\x19\x8d\xc8\xe2\x79\xda\x34\x71\x5c\xd7\x50\x20\x17\x12\x21\x29\x44\x56\x62\x6e\xd2\xea\x7b\x99\x16\x48\x11\xa5\x4a\x62\x02\x4d\x43\xab\x26\x1a\x73\x14\x3f\x50\xc3\xd9\x39\x4c\xd8\xe7\x76\x44\x46\xd2\x14\xaa\x3c\x93\xbf\x67\xe5\x0e\x29\x43\xb3\xd3\xb2\xb2\x81\x98\x87\x2d\x56\xc0\x95\xb6\x0b\xa4\x90\xb7\xf0\xc3\xad\xe2\x5a\x6d\xa0\xfa\x5e\xb2\xcf\xea\xae\x62\x31\xdf\xc9\x1c\xa6\x73\x72\xc4\xae\xb2\x0d\x42\xd3\xcc\x02\xa3\xd3\x19\xfc\xf1\x55\x48\x83\x9a\x67\x39\xd6\x0d\xd4\x71\xe4\xfc\x8c\xff\x8f\x5e\xd7\x35\x08\x0e\x52\x19\x98\xb0\xe5\x25\xbb\xa9\x50\x5f\xda\x24\x0b\x68\x1a\xf2\x79\xb5\x2b\xcb\xa5\x34\x7f\xfd\x4b\x5d\x03\x96\x15\x79\xb3\x9e\x97\x97\x76\xea\xcb\xc3\xd6\xff\x85\x92\xb6\xd4\xcd\x02\xd2\x14\xba\x25\x2e\xbe\x38\x8a\xea\xfa\x04\x74\x26\x6f\x11\x26\xff\x59\xc0\x84\x3b\x6c\x3e\x08\x2c\x8b\x8a\x70\x8b\x5c\x30\x13\x3e\x30\xdb\x5b\xe3\x7b\xb6\x9c\xbb\x38\x6a\x62\x5b\x9a\x13\xb8\x13\x66\x05\x13\xf6\x41\x69\x14\xb7\xf2\x37\x7c\x70\x66\xd3\x14\xf8\xfa\x69\x70\x73\xb7\xf5\x64\x4d\x7b\x0f\x63\x1f\x1d\x04\x9f\xaf\x8f\x43\x7f\x1c\xfb\x10\x12\xbe\x26\x3c\x98\x07\xc2\xce\x78\x88\xf8\xda\x81\xd4\x4e\x85\x15\xe3\x4f\xaf\x17\x7f\xac\x5a\x21\xbe\x03\x80\x23\x0b\x72\xf0\x4f\x9c\xa6\x90\x55\x95\xb8\x6d\x59\xec\x06\x8e\xc5\x1e\x36\xb3\xca\x0c\xdc\xa1\x46\x8f\x39\x16\x43\x24\x61\x9a\x71\x83\x3d\xf6\x33\x32\x6a\x94\x35\x11\x62\x0b\x9c\x72\xef\x48\x3f\x10\x57\xd3\xc0\x5e\x1d\xc2\xa8\xa6\x3e\x12\xc6\x58\x00\xfc\x0c\x50\x6b\xa5\x6d\x61\x04\x87\xcd\x02\x24\xa1\x5c\xa2\xf4\xeb\x67\x0b\x3b\xb0\x76\x3f\x65\xf9\x3a\xbb\xa5\x30\xd8\x85\x2a\x77\x1b\x59\xcd\xde\xc2\x06\xfe\x0e\xd2\xee\x6f\x2b\xcb\x37\x86\xbd\x27\xab\x7c\x9a\x6c\x44\xb5\xc9\x4c\xbe\x02\xb9\xdb\x7c\x43\x4d\xc7\x09\xa5\xe8\x61\x39\x83\x57\x05\xfc\x72\x0e\xaf\x8a\x64\x61\x7d\xcf\xe2\x28\x6a\x09\x2d\x38\x64\xb2\x18\xcb\x70\xaa\xb4\xfb\x73\x59\x5d\x1b\x4d\x3c\xf5\xa3\x9b\x9b\xe5\xe5\x2c\x28\x98\x15\x00\xde\x1b\x2a\xd3\x04\x92\x65\x71\x9f\xc0\x29\x24\x96\x3d\x89\x35\x01\xc9\x67\xcc\x93\x01\x84\x9e\x6e\x60\x70\xb3\x2d\x33\x73\xf8\x6c\xb3\x45\x48\x80\x1d\x62\x87\x25\x86\xe3\x19\xd9\xb2\x89\x2e\x40\x59\x3e\xdb\x41\xf5\xc7\xe9\x57\x36\x9d\x0f\xb8\x49\x79\x47\x82\xc3\x2f\x6a\xed\xa0\x3c\x84\xe5\x4e\xe2\xfd\x16\x73\x83\x85\x15\x2b\xbc\xfa\x62\xe5\x6a\x83\x01\x41\x10\x5a\xfb\xd6\x96\x8f\x6b\x90\x1a\x25\x7c\xde\x9d\x44\x9e\xfa\xae\xcc\xac\x8b\x62\x90\x8b\xa7\x4c\x17\xf8\x9b\xb3\xaf\xf1\x40\xa6\xe2\xc8\xc9\x75\x0c\xfe\x89\xe8\xf1\xe7\x3f\x0d\xfd\x70\x70\xe4\x14\x1c\x4e\x86\xa1\x8f\x92\xae\x6b\x52\x80\x75\x77\xf6\x75\xe4\x90\xaa\x16\xa8\x05\xce\xcf\x0f\xea\x25\xf0\x3f\xf3\x15\xde\x87\x71\x78\xe2\xfd\xd9\x91\x37\x90\xc7\xf0\xcc\xb3\xe2\xe0\x81\x34\xf8\x9e\x30\x5e\x5c\x9c\xe4\xda\xe8\x5d\x6e\xba\x05\xed\x29\xe3\x8d\x3e\xb7\x6a\x23\x1c\x47\xca\x71\x8a\x38\xa4\x1f\x02\x57\x40\xd3\x8c\x65\xf4\x36\x50\xd0\xb3\x44\x84\xc5\x2d\x9e\x58\x62\x05\x87\x7f\xd3\x0c\x34\x45\xb2\x72\x57\x48\x1b\x17\xfb\x3d\x2b\x45\xd1\xfb\xdb\x17\xdc\xe0\x1e\x81\x73\x90\x78\x37\x75\xff\x79\xf5\xb5\x76\xa3\xf9\x63\x5b\x07\xdb\xf6\x45\x1b\xb5\x8a\x1f\x81\x3a\x1c\x8e\x14\xe2\x01\x92\xa2\x8c\xe9\x4a\x6b\x27\x1e\x69\xed\x7c\x29\xc9\x02\x59\x9b\x08\x62\xee\x84\x5d\xe7\x6a\x8b\x6c\x59\xdc\xc3\x49\x37\xe5\x0f\x07\x37\x65\xb9\x13\x4c\x6a\x34\xe1\xf4\x67\xcc\xc3\x9d\x76\x31\x4d\x73\x16\x50\xcf\xdd\xd6\x5e\xb8\x6e\xdf\x68\xd6\xef\x75\xfd\x43\x9f\xd5\x9e\x6c\x96\xd5\x3f\xaf\xff\x75\x05\x53\xdb\xeb\xb5\x43\x7b\x57\x5e\x53\x03\x84\xda\x4b\xe6\x09\x24\x1c\x35\x14\x21\x11\x9f\x4e\xc2\x7d\xfe\x41\x4f\xc0\xc0\xdf\x2c\x1e\xf1\x90\xee\x50\x29\x4a\x78\xfd\xda\x1e\x3e\x73\xfb\xe7\x0c\xfe\x01\xa7\x7d\x63\x35\xd9\xc9\x4d\xa6\xab\x55\x56\x52\x12\x5b\x2d\xa4\x21\xb2\x1a\x48\x58\x37\x43\x08\x50\xd3\xee\x5a\xaa\x09\x67\x37\xed\x8c\xe5\x73\x5d\x87\x56\x3a\x23\xdd\x39\x97\xb0\x64\x6f\x93\xcf\xa2\x6d\xbd\x04\xef\x91\xbe\x52\xf2\x83\x90\xc2\xe0\x01\xc3\x09\xa9\xba\x33\xd3\xad\x4c\x86\xe5\xf4\x79\x15\x99\xc9\x28\xa5\xc4\xa5\x9d\x04\x73\x8e\x26\x9c\x51\x5e\xef\x65\xae\x1f\xb6\xe6\x37\x7c\xf0\x0b\xa2\x6d\x99\x51\x27\x74\x6f\x16\xd4\x04\x91\x09\x8a\x83\x20\x69\x1a\x56\xa0\x5d\x4e\x3b\x49\xb2\xdf\x77\xca\xa0\xe5\xd3\x02\x3c\xbc\xe4\x84\x4e\x28\xda\xeb\xf1\xf7\x07\xc1\x81\x8a\x7b\x73\x87\x4a\x7c\x06\xaf\xee\x12\x1b\xc2\x2c\xee\x85\xdc\xa7\x76\x0e\x49\x17\x69\x98\xdc\x10\x86\x30\xd5\x0b\xb5\xa1\x6f\xc9\x4a\x28\xe9\x57\x44\x04\x52\x97\x26\xa1\x7b\x89\xb9\x5f\xb5\x9f\x1e\x81\x40\xcb\xbb\xe3\xe9\x19\x39\x7a\x93\x2f\x4c\x93\xbc\x1e\xcf\xd0\x91\x87\xb4\x79\xa1\x90\x3e\x7c\x7d\x6a\xb9\x1d\x15\x83\x22\x86\x57\x6f\x5d\x0f\xf7\xf9\x78\xa6\x2f\xce\xd3\x39\x7c\x61\x8e\x3e\xda\xc3\x69\x0a\x1e\x26\xe1\x99\x98\xaf\x30\x5f\x53\x59\x2f\x71\x6b\x56\x83\xb0\xdf\x8e\x03\x3e\x10\x6f\xaf\xac\xc7\x43\x6e\xe2\x51\x7b\xe1\xe5\xba\x2b\xcb\xec\x5b\x89\x9f\x8c\xee\x8e\xcc\x40\xe8\x5d\x7b\x91\xa6\x70\x23\x4b\xb1\x46\xb8\xfe\xf7\x47\xb8\xba\xf9\xf8\x71\x01\xb4\x1f\xe4\xae\x2c\xe9\x99\x82\xda\x7f\xea\x54\xb2\x0a\x32\xd8\x2a\x52\xa0\x06\xa3\x20\xb3\x0a\xb2\xd2\x62\x1e\xb6\x0e\x82\xfe\x4c\x0f\xaf\xd1\x8a\xde\x34\xda\x5b\x91\x2d\x0b\x7a\x3b\x79\xb3\x5f\x4d\x8f\x65\x8f\xc1\xb0\xf2\x0b\x38\xe2\x66\xf6\xf6\x69\x64\x78\x0e\xb8\x3d\xba\x61\x9b\xf3\xd4\x40\x5f\xff\x7f\x22\x6d\xc9\xd9\xc4\x7b\x91\xd3\xec\x84\xaa\x6a\xaf\xb0\xb3\xf3\xc1\x15\x78\xf2\x9c\xab\xb3\x33\xf2\xf3\x2f\xce\x80\xda\x2d\x8b\x29\x5e\x36\xbc\xf7\xa7\xab\xac\xfa\xa4\x91\x8b\xfb\x20\x38\xba\x94\x92\x96\xe7\x7f\xd6\x08\x7a\x1f\x74\x2c\x08\x27\x9a\xb6\xd4\x8f\x73\xda\xc7\xd3\xb1\x38\x9a\x1f\xdf\x52\xd7\x21\xe4\xae\xff\x49\x06\x77\xe0\x88\x6b\xd1\xff\x6e\xad\xe5\xc3\x9e\xe9\x23\x1d\x49\x1d\x3f\xea\xb5\x7f\xbc\x09\xe0\x9a\x77\xf7\xbc\x35\x17\xef\x39\x6f\xc9\xe8\xc6\xc1\xcf\x47\x3a\xd7\x4d\x26\x1f\xda\x57\xc9\x7e\x47\x3a\x87\x77\x45\x21\x8c\x50\xb2\x55\x87\x7b\x78\xa4\xd7\x97\x5b\x94\xa8\x33\x62\xdc\x46\x15\x58\xda\xff\x57\xaa\x2c\xe8\xeb\x8a\xe6\x07\x8f\x64\xf6\x61\xf4\x48\x08\x76\xbb\xfb\x0c\xaa\xfa\xe6\xd9\x7f\x01\xba\xf7\xae\x03\xdf\xa9\x47\x3f\x03\x87\x1f\x08\x75\x3d\xa6\x5c\x8f\xe1\x80\x58\x7b\xd0\x01\xca\x02\x9a\x26\xfe\xef\x00\xa0\xc7\xf3\xd6\x92\x16\x00\x00")
 
 func templateDialectSqlDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -641,7 +641,7 @@ func templateDialectSqlDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 4286, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 5778, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -666,7 +666,7 @@ func templateDialectSqlDeleteTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xc1\x6a\xe3\x48\x10\x3d\xab\xbf\xe2\xad\x61\x16\x29\x68\xa5\x4c\x0e\x0b\x9b\xc1\x87\x21\xeb\x85\x80\x37\x8c\xe3\xe4\xb0\x0c\x43\xe8\xb4\x4a\x72\x93\x76\xb7\x53\xdd\x72\x62\x84\xfe\x7d\x69\xc9\x32\x26\xcb\x1e\xc6\x27\xab\x5e\xbd\x57\xd5\x55\xaf\xba\xae\xbc\x10\x37\x6e\x77\x60\xdd\x6c\x02\xae\x2e\x3f\xff\xf1\xdb\x8e\xc9\x93\x0d\xf8\x4b\x2a\x7a\x76\xee\x05\xb7\x56\x15\xf8\x6a\x0c\x86\x24\x8f\x88\xf3\x9e\xaa\x42\x3c\x6c\xb4\x87\x77\x2d\x2b\x82\x72\x15\x41\x7b\x18\xad\xc8\x7a\xaa\xd0\xda\x8a\x18\x61\x43\xf8\xba\x93\x6a\x43\xb8\x2a\x2e\x27\x14\xb5\x6b\x6d\x25\xb4\x1d\xf0\xe5\xed\xcd\xe2\x6e\xbd\x40\xad\x0d\xe1\x18\x63\xe7\x02\x2a\xcd\xa4\x82\xe3\x03\x5c\x8d\x70\x56\x2c\x30\x51\x21\x2e\xca\xbe\x17\x22\xbe\x01\xaa\xf5\xc1\x6d\x41\xcc\x8e\x3d\xa4\xad\xa6\xbf\x1b\x69\x2b\x43\xec\x51\x3b\x86\x7f\x35\xa8\xb4\x34\xa4\x82\xc7\xc0\xee\x3a\x54\x54\x6b\x4b\x98\x1d\x81\xd2\xbf\x9a\x72\x24\xcf\xd0\xf7\xa2\x6e\xad\x82\xf6\xeb\xd5\xf2\xc6\x59\x1f\x58\x6a\x1b\x16\x11\x4e\x89\x79\xac\x92\x21\xbd\xf8\x00\xe6\x78\x76\xce\x64\xe8\x44\xb2\x97\x8c\x54\x24\xc9\xd6\x37\x98\x47\x42\x31\xd2\x33\x91\x24\x65\x39\x2a\xc4\xee\xb6\x32\x60\x47\x3c\x35\x58\x88\x24\x39\xbe\x61\x8e\xef\x45\x51\xfc\xf0\x81\xb5\x6d\x3a\x91\x24\xc9\x6c\x90\xc0\xe7\xcb\xdf\xaf\x66\x79\x72\xfa\x95\x25\xfe\x3e\xac\x57\xcb\x01\x38\x2a\xa7\x8b\xfb\xa7\x3f\x1f\xbf\x3d\x2d\xee\x1e\xee\xff\xc9\x8a\x81\xfd\x78\x77\xbb\x7a\x5c\x40\x9d\x7a\x46\x2d\xb5\xa1\xea\xa4\x55\x96\x58\xaf\x96\x3a\xd0\x98\x5f\xb5\x3b\xa3\x95\x0c\x84\x17\x3a\x60\x2f\x4d\x4b\xd8\x6b\x67\x64\x20\x8f\xd6\xea\xd7\x96\xce\xc4\x66\x79\xe4\x7f\x73\x3e\x34\x4c\xeb\xd5\x32\x6a\xf4\x22\xc9\x44\xa2\x6b\x3c\xe5\x70\x2f\xb8\x1e\x07\x91\x5e\xf8\x57\xd3\xb0\xdc\x6d\x8a\x0f\xf3\xcb\xbe\xc4\xb4\xf8\x56\xa6\xd0\xb2\xc5\xaf\x1f\x12\xba\xad\x6f\xf2\x28\xd2\xe7\x08\xdc\x92\x88\x25\xe2\x8e\x75\x14\x67\x69\x1b\x9a\x2c\x10\x55\x74\x8d\x71\x7c\x3e\x56\x0a\x52\x5b\x9f\x4e\x0a\x8e\xfd\x77\xfd\x63\xd8\xd5\x4f\x94\x8b\xf5\x7a\x31\xe5\x5b\x6d\x72\xd4\xd2\x78\x12\xbd\x10\x65\x09\x76\xc6\x3c\x4b\xf5\x02\x25\x8d\xf1\x08\x0e\xe1\xbd\xb8\x9f\x82\xd1\xa0\x6f\x2c\x77\x7e\xf0\x7a\xa3\xf7\x64\x8f\xeb\x7a\xd3\x61\x73\x3c\x80\x63\xee\x18\xd7\x35\x9c\x52\x2d\x73\xbc\xbb\xc1\x93\x53\x42\x1a\xde\x4f\x9e\x79\x78\x1f\x9a\x9c\x6c\x39\x52\xbb\x61\xf0\x1c\xe3\xd7\xf3\xf3\x36\xd2\xec\xcb\x18\xfe\x65\x1e\x1f\x30\x0c\x20\x7e\xce\x51\x6f\xc3\xe8\xd2\x3a\x9d\x7d\xf2\xd7\xf8\xb4\x9f\xe5\xe7\xd6\xcd\x07\x5e\x36\x4c\x40\xd7\x11\x99\xd6\xfa\x7f\x97\xf2\x9f\x85\x12\xf3\xf9\x00\xe3\xe7\x70\x8e\x64\xab\x78\x76\xff\x06\x00\x00\xff\xff\x0c\x82\x5f\x2a\x9a\x04\x00\x00")
+var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x61\x6f\xdb\x36\x10\xfd\x2c\xfd\x8a\x57\x03\x1d\xa4\x54\x95\x93\x7e\x18\xb0\x64\x1e\xd0\x35\x19\x50\x20\x2d\x9a\xa4\xfd\x30\x14\x45\x40\x53\x27\x9b\x08\x4d\x3a\x24\xe5\xd8\xb0\xf5\xdf\x87\xa3\x24\x4f\x4d\x37\x0c\xeb\x97\xca\xbc\xbb\xc7\xe3\xbb\xf7\x2e\xfb\xfd\xf4\x24\x7d\x67\xd7\x3b\xa7\x16\xcb\x80\x37\xa7\x67\xbf\xbc\x5e\x3b\xf2\x64\x02\xfe\x10\x92\xe6\xd6\x3e\xe0\xbd\x91\x25\xde\x6a\x8d\x98\xe4\xc1\x71\xb7\xa1\xaa\x4c\x3f\x2f\x95\x87\xb7\x8d\x93\x04\x69\x2b\x82\xf2\xd0\x4a\x92\xf1\x54\xa1\x31\x15\x39\x84\x25\xe1\xed\x5a\xc8\x25\xe1\x4d\x79\x3a\x44\x51\xdb\xc6\x54\xa9\x32\x31\x7e\xfd\xfe\xdd\xd5\xc7\xbb\x2b\xd4\x4a\x13\xfa\x33\x67\x6d\x40\xa5\x1c\xc9\x60\xdd\x0e\xb6\x46\x18\x5d\x16\x1c\x51\x99\x9e\x4c\xdb\x36\x4d\xf9\x0d\x90\x8d\x0f\x76\x05\x72\xce\x3a\x0f\x61\xaa\xe1\x73\x29\x4c\xa5\xc9\x79\xd4\xd6\xc1\x3f\x6a\x54\x4a\x68\x92\xc1\x23\x56\xef\xf7\xa8\xa8\x56\x86\x30\xe9\x03\x53\xff\xa8\xa7\x5d\xf1\x04\x6d\x9b\xd6\x8d\x91\x50\xfe\xee\xe6\xfa\x9d\x35\x3e\x38\xa1\x4c\xb8\xe2\x70\x46\xce\x75\xb7\xe4\xc8\x4e\x9e\x05\x0b\xcc\xad\xd5\x39\xf6\x69\xb2\x11\x0e\x59\x9a\x24\x2b\xbf\xc0\x8c\x0b\xca\x98\x91\xe5\x69\x92\x4c\xa7\x7c\x60\x1d\x77\xb7\x12\x01\x6b\x72\x43\x83\x65\x9a\x24\xfd\x1b\x66\xf8\x5a\x96\xe5\x37\x1f\x9c\x32\x8b\x7d\x9a\x24\xc9\x24\x42\xe0\xec\xf4\xe7\x37\x93\x22\x39\xfe\x9b\x4e\xf1\x61\x77\x77\x73\x1d\x03\x3d\x72\x76\x75\x7b\x7f\xf9\xe5\xd3\xfd\xd5\xc7\xcf\xb7\x7f\xe6\x8c\x9a\x4c\xbe\x7c\x7c\x7f\xf3\xe5\x0a\xf2\xd8\x33\x6a\xa1\x34\x55\x47\xac\xe9\x14\x77\x37\xd7\x2a\x50\x97\x5f\x35\x6b\xad\xa4\x08\x84\x07\xda\x61\x23\x74\x43\xd8\x28\xab\x45\x20\x8f\xc6\xa8\xc7\x86\x46\x60\x93\x82\xdf\xf5\xc9\xfa\xb0\x70\x74\x77\x73\xcd\x18\x6d\x9a\xe4\x69\xa2\x6a\xdc\x17\xb0\x0f\x38\xef\x88\xc8\x4e\xfc\xa3\x5e\x38\xb1\x5e\x96\xcf\xf8\xcb\x2f\x38\x8d\xdf\xea\x28\x34\xce\xe0\xa7\x67\x09\xfb\x95\x5f\x14\x0c\xd2\x16\x08\xae\xa1\x34\x69\xd3\x84\x67\xac\x18\xdc\x09\xb3\xa0\x41\x02\x8c\xa2\x6a\x74\xf4\x79\xbe\x29\x08\x65\x7c\x36\x20\x58\xe7\xbf\xaa\x6f\x71\x56\xff\xe3\x3a\xbe\xaf\x4d\x87\x7c\xa3\x74\x81\x5a\x68\x4f\x69\x9b\xa6\xd3\x29\x9c\xd5\x7a\x2e\xe4\x03\xa4\xd0\xda\x23\x58\x84\x6d\x79\x3b\x1c\xb2\x40\x9f\x9c\x58\xfb\xa8\xf5\x85\xda\x90\xe9\xc7\xf5\xa4\xc2\xb2\x37\x40\x9f\xdb\x9d\xab\x1a\x56\xca\xc6\x39\xf6\x5d\xd4\xe4\x90\x90\x85\xed\x51\x33\x9f\xb7\x05\x46\xb2\x8c\xff\xf1\xbb\x54\x0d\xc7\xe7\xe7\xb3\x71\x1b\x59\x7e\xd1\x1d\xbf\x98\xc1\x28\xcd\x89\x2c\x39\xcc\x50\xaf\x42\xa7\xd2\x3a\x9b\xbc\xf4\xe7\x78\xb9\x99\x14\x63\xe9\x16\xb1\x2e\x8f\x0c\xa8\x9a\x23\xc3\x58\xff\xcd\x29\x3f\x0c\x94\x9c\x1b\x13\xc8\x3f\x3b\xe6\x22\x82\x0a\xf4\x7b\xe3\x77\x70\xb4\xb6\x2e\x78\x28\xf6\x7f\x3f\x51\x3c\x09\x5e\x42\x8c\x42\x15\xe6\xbb\x5e\xaa\x98\x93\x14\x8d\xa7\x98\x58\x89\x20\xe6\xc2\x13\x03\x66\xd6\xc1\x1a\xe2\x1d\xa2\x82\x47\x10\x73\x4d\x3e\x8f\x28\xda\xca\x87\x0e\x43\x18\x1b\x96\xe4\x58\xc6\x86\x64\x50\xd6\xf4\x34\x8f\xdb\x19\x5b\x9e\xfd\xcd\x8c\xb1\xad\xcf\x67\x63\x72\x8e\x6f\xfa\x67\xc5\x4d\x86\xde\xe2\xb6\x8c\x0d\x4c\x72\x1c\x0e\xff\x99\x1e\x1b\x1f\x17\x0d\x52\xa3\xe0\x76\xbd\xce\xea\x22\x2e\xbf\xee\x87\x0a\x10\x0b\xa1\x0c\xb2\x66\xcd\x0a\x94\xe5\x4a\x6c\x6f\x29\x38\x45\x1e\x41\xad\x98\x05\xc5\xa4\xf4\xf6\x1f\x18\x64\xd2\x98\xc4\x9e\xd7\x63\x03\xcc\xd8\xbc\xf1\xbb\x12\x35\xb7\x41\xdb\x35\xc9\x40\x15\x43\xbb\xc6\x20\xe3\x9b\x59\x95\x60\x71\xe5\x91\x6c\xfb\x64\x10\x9c\x30\x5e\x44\x4e\x0b\x86\xe6\x34\xe6\x9a\x6a\xeb\xa8\x18\x0c\xb2\x14\x21\xa2\x28\xe3\x55\x45\x10\xe3\x32\x1e\x1d\x37\x24\xb5\xe2\xbf\x4c\xc2\x11\x8c\x0d\xf1\xe1\xea\xe8\x87\x4c\xf2\xf0\x6a\xb5\xc8\x63\x60\x97\xc9\xb0\xe5\x93\x40\xdb\x10\x39\xa5\x6d\x28\x50\x83\x93\xb3\xfc\x07\x8b\xdc\x17\x08\x5b\x56\xb0\x2c\x2b\xa7\x36\xc4\xdb\x29\x6c\x2f\xe3\x67\x3e\x5a\x2d\xa7\x17\xb8\x80\x7a\xf5\xea\xe8\x96\xf3\x19\x6a\x1e\x3a\x6f\x37\xd6\xc7\xac\x33\xd3\xe1\xc0\x78\x87\x03\x14\x7e\x9b\x7d\x4f\xfd\xe1\xf0\xf7\x25\x97\x9d\x73\xb3\x1c\x2f\x66\x47\x1b\xf7\xc4\x1f\x0e\x78\xf1\x5c\x7e\xdf\xaf\x29\x76\x4d\x5c\xae\x89\x27\xae\x8c\x41\x29\x3c\xe1\xd7\xd7\x32\x6c\xcb\x4b\x6b\x28\xcb\xcf\x7f\xa8\xe8\x53\x58\x03\xe5\xdb\x3a\x90\xcb\xe2\xe7\x65\xe3\x04\xf3\x9d\xa9\x57\x67\x39\x4e\x70\x76\x8a\x93\x28\x94\xf2\x83\xd2\x5a\x79\x92\xd6\x54\x11\x2e\x2e\xbf\x36\xdd\xef\x41\xa6\x42\xdb\xa6\x7f\x0d\x00\xe7\x31\xf6\xff\x4a\x08\x00\x00")
 
 func templateDialectSqlErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -681,7 +681,7 @@ func templateDialectSqlErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 1178, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 2122, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -726,7 +726,7 @@ func templateDialectSqlGroupTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x6f\xdb\x38\x10\x3d\x5b\xbf\x62\x20\xf8\xd0\x06\x09\x95\xfa\xb6\x0b\xe4\x50\xa4\x09\x60\xa4\x09\xba\x9b\xdc\x16\x8b\x05\x23\x8e\x64\xc2\x34\xa9\x92\xb4\x5d\x41\xd0\x7f\x5f\xf0\x43\x12\xe5\xb5\xbb\x87\xde\x24\xce\xf0\xcd\xcc\xe3\x7b\xd3\x75\xc5\x55\x76\xaf\x9a\x56\xf3\x7a\x63\x61\x75\xfb\xe9\xb7\x9b\x46\xa3\x41\x69\xe1\x91\x96\xf8\xae\xd4\x16\xd6\xb2\x24\xf0\x59\x08\xf0\x49\x06\x5c\x5c\x1f\x90\x91\xec\x6d\xc3\x0d\x18\xb5\xd7\x25\x42\xa9\x18\x02\x37\x20\x78\x89\xd2\x20\x83\xbd\x64\xa8\xc1\x6e\x10\x3e\x37\xb4\xdc\x20\xac\xc8\xed\x10\x85\x4a\xed\x25\xcb\xb8\xf4\xf1\xaf\xeb\xfb\x87\x97\xd7\x07\xa8\xb8\x40\x88\x67\x5a\x29\x0b\x8c\x6b\x2c\xad\xd2\x2d\xa8\x0a\x6c\x52\xcc\x6a\x44\x92\x5d\x15\x7d\x9f\x65\x6e\x06\x28\x95\x34\x96\x4a\x6b\x40\x22\x32\x64\x50\x29\x0d\xe6\xbb\x00\xc6\xa9\xc0\xd2\x1a\x02\x3e\xbb\xeb\x80\x61\xc5\x25\x42\x1e\x23\x85\xf9\x2e\x8a\x1d\x5a\x5a\x8c\x18\x39\xf4\x7d\xb6\xe8\xba\x1b\xd0\x54\xd6\x08\x4b\x0b\xbf\xdf\xc1\x92\xfc\x89\x82\x5a\x64\x6f\x6d\x83\xc6\xa7\xf8\x1c\x5e\x81\x74\x39\x64\xfd\x85\xbc\x5a\xa5\x69\x8d\x4f\xd8\xc2\xf2\xe4\xdf\xe7\x2f\x8a\x02\xba\xce\x25\xbf\xd0\x1d\x42\xdf\x3f\x72\x14\x6c\xfd\x05\x36\x4a\x30\xe3\x07\x37\x56\x73\x59\x03\x43\xa9\xac\xfb\x70\x67\x9c\x41\xe5\x12\x03\x0d\x38\x87\x20\x0e\xf7\x2c\xe8\x1d\xe4\xe1\xfc\xb4\x93\x3c\xb6\x8e\x92\x8d\xa3\x0e\xdf\x45\x01\x6f\xf4\x5d\x60\xd2\x92\xf5\xff\xd2\x81\x4f\x0d\x08\x75\x44\x0d\xcb\xa1\xe6\xf0\x6e\x8c\x5a\xfa\x4e\x0d\x92\x6c\x11\x60\x62\x13\x24\xfc\xf9\xda\x09\xb3\x18\x98\x7d\x60\xf5\x40\x69\x64\x08\xc3\x85\xfb\xf8\x26\xbe\x42\xda\x8d\xfb\x9a\x3a\x0c\x37\x86\x56\xb4\x7b\x27\xae\x64\x81\xac\x76\x8d\x0c\xcf\xb4\x44\xf2\xbc\x7a\x76\x19\x6f\x1b\x84\x46\xf3\x1d\xd5\x2d\x6c\xb1\x05\x86\xa5\xa0\x1a\x19\xbc\xa3\x50\x47\xd2\x75\x23\x1d\x8b\x0b\xcd\xc4\xb1\xd0\x89\x22\x9d\x2d\x95\x44\x3c\x77\xd7\xdb\x06\xc7\xac\x44\x07\x48\xd6\xf2\x80\xda\xe0\xcf\x87\xf5\xd4\x3b\x45\x4f\xb3\x7a\xc4\x61\x60\x94\x96\xdb\x96\x44\xe0\xb5\x05\xfc\xc1\x8d\x35\xe1\x4d\xb8\x81\x86\x96\x5b\x5a\x7b\x6f\x29\xed\x5d\xa9\x80\x1e\x14\x67\x50\x72\x5d\xee\x05\xd5\xc0\xb0\x41\xc9\x50\x96\x2d\x1c\xb9\xdd\xf8\x4a\x79\x52\xea\x5b\x84\xe8\xfb\x7c\x80\x1b\x85\x77\x79\x8a\xbb\x19\xc6\x29\x4d\x09\xc7\x81\x33\x65\xa7\x37\x9a\xb1\x74\xaf\xc4\x7e\x27\x2f\xf2\x53\xfa\xf0\xdc\x33\xff\x23\x89\xc5\x25\xe0\xd9\xc3\x86\xf0\xcf\x1d\x33\x89\x25\xac\xa2\x03\xd5\xdc\x75\xf5\x2b\xab\x68\xc4\xc8\x07\x4f\x86\x4e\x4c\xd4\x3c\x15\x02\x5e\xff\xf8\x1a\x07\x37\xbe\xc4\x19\x4f\xfa\xa5\x61\x48\xb6\x38\x50\x3d\x22\xdc\xc1\x5f\x7f\x87\x25\xd3\x45\x79\xbb\xfd\x90\x50\x70\x1d\x67\x8d\x16\xad\x82\x45\xfd\x52\x89\x1e\xf5\xb7\xaa\x73\x77\x06\x7e\x3c\x45\xc5\x95\x7b\x55\x2a\xdb\x61\x6d\xa0\xb7\xb9\x3a\x4a\x03\xd4\xf5\x8c\xbc\x96\x37\xce\x7f\x9e\x10\x87\xea\xb5\xb7\x24\x8f\x21\xf6\x84\xed\xb4\x15\xd2\xb3\xc9\xf9\x8e\x85\x04\xc9\x1d\x52\x0b\x54\xa3\x2b\xe3\x0c\xdd\x8e\x6a\x18\x69\xb1\x4e\x8c\xd9\xc2\xb3\x92\xa2\xce\x99\x99\x71\xb0\x75\x24\x90\x38\xfd\xc2\x2b\xa4\xda\x06\x4e\x06\xd8\xfc\x7a\xb8\x34\xea\x3a\xcc\x34\xa8\x23\x99\xef\x65\xbf\x1b\x55\xee\xba\xf8\x70\x52\xef\x9f\xeb\x73\xab\xf1\xbf\x8b\xcc\x1f\x4e\x36\xf9\xf6\x94\x2a\x99\x4a\x76\xc9\x3e\x2b\xcf\xd0\xa9\x81\xcc\xcc\x41\x23\x76\xba\x28\xe7\x4b\xe8\xd4\x5d\xf0\xe1\x79\xf5\xfc\x91\x84\x9b\xe7\x5a\x4a\x18\x76\x1c\x72\xc9\xf0\xc7\xdc\x6b\x06\x6e\x3d\x97\x70\x31\xfe\xc9\xc5\x27\x3a\x46\xb2\xe7\x7f\x1f\x53\xea\xa7\xaf\x7f\x03\x00\x00\xff\xff\x62\x5c\xda\x81\xf1\x08\x00\x00")
+var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xc1\x6e\xe3\x36\x10\x3d\x4b\x5f\x31\x30\x7c\xb0\x83\x84\xca\xe6\xd6\x02\x39\xec\x66\x13\xc0\xcd\x26\xd9\xad\x83\x5e\x8a\xa2\x60\xc4\x91\x4d\x58\x26\x1d\x92\x72\x22\x08\xfa\xf7\x62\x28\x4a\x96\x1c\x3b\x01\xba\x7b\x31\x2c\x91\x7c\x33\xf3\xe6\xbd\xa1\xaa\x2a\x39\x89\xaf\xf4\xa6\x34\x72\xb1\x74\x70\x71\xfe\xe9\xb7\xb3\x8d\x41\x8b\xca\xc1\x0d\x4f\xf1\x49\xeb\x15\xcc\x54\xca\xe0\x73\x9e\x83\xdf\x64\x81\xd6\xcd\x16\x05\x8b\x1f\x97\xd2\x82\xd5\x85\x49\x11\x52\x2d\x10\xa4\x85\x5c\xa6\xa8\x2c\x0a\x28\x94\x40\x03\x6e\x89\xf0\x79\xc3\xd3\x25\xc2\x05\x3b\x6f\x57\x21\xd3\x85\x12\xb1\x54\x7e\xfd\xdb\xec\xea\xfa\x7e\x7e\x0d\x99\xcc\x11\xc2\x3b\xa3\xb5\x03\x21\x0d\xa6\x4e\x9b\x12\x74\x06\xae\x17\xcc\x19\x44\x16\x9f\x24\x75\x1d\xc7\x54\x03\xa4\x5a\x59\xc7\x95\xb3\xa0\x10\x05\x0a\xc8\xb4\x01\xfb\x9c\x83\x90\x3c\xc7\xd4\x59\x06\x7e\x77\x55\x81\xc0\x4c\x2a\x84\x51\x58\x49\xec\x73\x9e\xac\xd1\xf1\xa4\xc3\x18\x41\x5d\xc7\x51\x55\x9d\x81\xe1\x6a\x81\x30\x76\xf0\xfb\x25\x8c\xd9\x9f\x98\x73\x87\xe2\xb1\xdc\xa0\xf5\x5b\xfc\x1e\x99\x81\xa2\x3d\x6c\xf6\x95\xcd\x9d\x36\x7c\x81\xb7\x58\xc2\x78\xef\xd9\xef\x8f\x92\x04\xaa\x8a\x36\xdf\xf3\x35\x42\x5d\xdf\x48\xcc\xc5\xec\x2b\x2c\x75\x2e\xac\x2f\xdc\x3a\x23\xd5\x02\x04\x2a\xed\xe8\x0f\xbd\x93\x02\x32\xda\xd8\xd0\x80\x43\x08\x46\xb8\x07\x41\x2f\x61\x54\x55\x6f\x33\xab\xeb\x51\x48\x1d\x95\xe8\x4a\x6d\xff\x27\x09\x3c\xf2\xa7\x1c\x7b\x29\x39\xff\xac\x28\xdc\x2e\x81\x5c\xbf\xa0\x81\x71\x1b\xb3\xed\x9b\xe0\x8e\x3f\x71\x8b\x2c\x8e\x1a\x98\x90\x04\x6b\x9e\x7c\xec\x1e\xb3\xd8\x30\x7b\x2d\x16\x2d\xa5\x81\x21\x6c\x0e\x5c\x85\x9e\xf8\x08\xfd\x6c\xdc\xb2\x9f\x21\x95\x89\x5d\x2a\x86\xfa\x24\xb5\x4a\x50\x2c\x90\xed\xda\x34\x46\x76\x77\x71\x47\x50\x8f\x4b\x84\x8d\x91\x6b\x6e\x4a\x58\x61\x09\x02\xd3\x9c\x1b\x14\xf0\x84\xb9\x7e\x61\x55\xd5\xd1\x11\x1d\x49\x26\x94\x85\x24\x8a\x7e\x6d\x7d\x49\x84\xf7\x63\x64\x24\x99\x6e\x57\x4f\x07\xc8\x66\x6a\x8b\xc6\xe2\xfb\xc5\x7a\xea\x49\xd1\xbb\x5a\x3d\x62\x5b\x30\x2a\x27\x5d\xc9\x02\xf0\xcc\x01\xbe\x4a\xeb\x6c\xd3\x13\x69\x61\xc3\xd3\x15\x5f\x78\x6f\x69\xe3\x5d\xa9\x81\x6f\xb5\x14\x90\x4a\x93\x16\x39\x37\x20\x70\x83\x4a\xa0\x4a\x4b\x78\x91\x6e\xe9\x99\x0e\x15\xfa\x50\xdf\x03\x44\x5d\x8f\x5a\xb8\x4e\x78\xc7\xab\xe8\x58\x1a\x10\xb0\x2f\xbf\x8e\x33\xed\x76\x3d\x1a\xb0\x74\xa5\xf3\x62\xad\x8e\xf2\x93\xfa\xe5\xa1\x67\x3e\x90\x44\x74\x0c\x78\xd0\xd8\x26\xee\xfb\x8e\xd9\x89\xa5\x19\x45\x5b\x6e\x24\x65\xf5\x33\xa3\xa8\xc3\x18\xb5\x9e\x6c\x32\xb1\x41\xf3\x3c\xcf\x61\xfe\xe3\x1b\xa4\xe1\x2d\x69\xe3\x80\x27\xfd\xd0\xb0\x2c\x8e\xb6\xdc\x74\x08\x97\xf0\xf7\x3f\xcd\x90\xa9\x82\xbc\x69\x52\xf5\x28\x38\x8d\xa3\xbe\x45\xb3\xc6\xa2\x7e\x52\x05\x8f\xfa\x53\xd9\xa1\x33\x81\x89\xc8\x53\x94\x9c\x50\x57\xb9\x0a\xe3\x1b\x81\xfc\x68\x41\xbf\x28\x0b\x9c\x68\x41\xb9\x50\x67\xe4\x3f\x3f\x9b\x09\xd5\x6b\x6f\xcc\x6e\x9a\xb5\x5b\x2c\x77\x53\xa1\xff\x6e\xe7\x7c\x62\xa1\x87\x44\x2f\xb9\x03\x6e\x90\xc2\x90\xa1\xcb\x4e\x0d\x1d\x2d\x8e\xc4\x18\x47\x9e\x95\x3e\xea\x90\x99\x01\x07\x2b\x22\x81\x85\xea\x23\xaf\x90\x6c\xd5\x70\xd2\xc2\x8e\x4e\xe3\x68\x48\x42\xc3\x42\xfb\xd8\xaf\xef\xbe\x58\x77\x2a\xa7\x2c\x26\x7b\xf1\xfe\x3d\x3d\x34\x1a\xdf\x0e\x32\x3a\xd6\xb3\xc9\xf7\xdb\x5e\x4b\x80\x2b\x01\x47\x54\x7e\xe1\x19\xda\x37\x90\x1d\x38\xa8\xc3\xee\x0f\xca\xe1\x10\xda\x77\x17\x4c\xee\x2e\xee\xa6\x7e\x2e\x44\xd1\xa1\x94\x7a\x0c\x13\x87\x52\x09\x7c\x1d\x7a\xcd\xc2\x39\xd9\xed\x14\x8e\xae\x7f\xa2\xf5\x1d\x1d\x1d\xd9\xc3\xa7\xe9\x3e\xf5\xef\xe9\x39\xd0\x4a\x84\x8d\x33\x36\xb3\x7f\xcc\x1f\xee\x61\xa2\xb4\xdb\x3d\x3e\x6c\xf8\x73\x81\xd3\x10\x2a\x49\xe0\x4b\x49\x05\x66\x6c\xee\x4c\x91\x3a\x8f\x06\x75\xfd\x17\xcf\x0b\x6c\x86\x2c\x49\x11\xe9\x63\xa9\xc8\x9d\x6d\x75\x48\x50\xb0\xf5\x9b\xac\xd3\x74\xe3\x84\x7b\x73\x21\xb7\xa8\x60\xc3\xdd\xb2\x35\x0b\x11\x30\xce\x3a\x75\x35\x5f\x00\xed\x8c\xbf\xd7\x8e\xee\x40\xee\x1a\x30\xdb\x08\x4b\xc8\x2c\x43\x43\x1f\x6f\x3e\x0e\xe9\xdc\xfa\x4e\xfb\x84\x3a\x37\x48\xe3\x97\x20\x93\xc6\x3a\x06\x73\x44\x9a\x4f\xec\x81\x36\x7d\x29\xfd\x51\xea\xf3\x5a\x1b\xba\x32\x32\x1d\x82\x36\xbf\x51\x9a\x4b\x54\x8e\xf5\x1d\xc5\x7e\x14\x68\xca\xc9\xb4\x81\x98\xf8\xa5\xdd\x85\xc1\xde\xa1\x6a\x32\x5a\x61\x39\x9a\x4e\x77\x11\xb2\x42\xa5\xef\x91\x3b\xf1\x1c\x31\xc6\x1a\x1d\x4d\x81\x0e\x4c\x4e\xa8\x80\x39\xd2\x14\xd5\x66\x0a\xde\xbf\x91\x41\x57\x18\xb5\x5f\xdb\xe4\xed\xf0\xf2\xbc\x33\xc6\x7c\x1a\x75\xdc\xbb\x7a\x0e\xa6\x00\xd6\x07\xb2\xbf\xb4\xa3\x6d\x17\x08\xaf\x89\xf2\x3f\x7b\xd0\xb0\xe0\x21\xde\x74\xe2\xc3\x3e\x50\xb9\x52\x2d\xec\x24\x75\xaf\xfb\x4d\xf9\xb9\x96\x84\xef\xda\x37\x9d\xe9\xea\xfd\xb8\x2f\x43\xc3\xf7\xfe\x57\x15\xa0\x12\x50\xd7\xf1\x7f\x03\x00\x6a\xe5\x00\xf4\xd1\x0c\x00\x00")
 
 func templateDialectSqlMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -741,7 +741,7 @@ func templateDialectSqlMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2289, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 3281, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -766,7 +766,7 @@ func templateDialectSqlOpenTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x60\x04\xa8\x1c\x38\x74\x9c\x5b\x0b\xb8\x40\xe0\xb5\x51\x63\x77\x9d\xb4\x0e\xba\x87\x20\x28\x18\x71\x64\x11\x4b\x93\x0c\x49\xdb\x30\x04\xfd\xf7\x82\x94\x2c\xcb\x71\x9a\x64\x93\xf6\xd4\xdc\x24\xce\xe7\x7b\xf3\x46\x54\x51\xf4\x4f\xe3\x91\xd2\x5b\xc3\x17\xb9\x83\x8b\xf3\xc1\xcf\x67\xda\xa0\x45\xe9\x60\x42\x53\xbc\x57\xea\x3b\x4c\x65\x4a\xe0\x52\x08\x08\x4e\x16\xbc\xdd\xac\x91\x91\xf8\x26\xe7\x16\xac\x5a\x99\x14\x21\x55\x0c\x81\x5b\x10\x3c\x45\x69\x91\xc1\x4a\x32\x34\xe0\x72\x84\x4b\x4d\xd3\x1c\xe1\x82\x9c\xef\xac\x90\xa9\x95\x64\x31\x97\xc1\xfe\x65\x3a\x1a\xcf\xe6\x63\xc8\xb8\x40\xa8\xcf\x8c\x52\x0e\x18\x37\x98\x3a\x65\xb6\xa0\x32\x70\xad\x62\xce\x20\x92\xf8\xb4\x5f\x96\x71\x5c\x14\xc0\x30\xe3\x12\xa1\xc3\x38\x15\x98\xba\xbe\x7d\x10\x7d\x6d\x90\xf1\x94\x3a\xec\x73\xd6\x81\xb3\xb2\x8c\xa3\x6c\x25\xd3\xc4\xc2\xa9\x7d\x10\x64\x8e\x22\xa4\xee\x42\x11\x47\x91\x25\xdf\x72\x34\x98\x78\xcb\xf8\xf7\xc4\x92\x51\x52\x14\x70\x42\xa6\x9f\xc8\x48\x49\xeb\xa8\x74\x50\x96\xdd\x1e\x70\xd6\xed\xc6\x51\x19\x17\xc5\x19\xa0\x64\xf0\xca\x06\xfa\x4a\xdb\xba\x09\x1f\x79\xa2\x34\xfc\x32\x84\x13\x32\x4f\x95\x46\x72\xa5\x5b\x26\x6a\x16\x6d\xdb\xa5\x59\xb4\x8c\xd6\x29\x43\x17\xd8\x76\x98\xd7\x47\x2f\x20\xf4\xe1\x3c\xf3\x95\xc9\x9f\xd4\x70\xca\x78\xea\x9b\x8f\xa2\xa8\xdf\xf7\x06\xa9\x1c\x50\xb3\x58\x2d\x51\x3a\x0b\x1b\x34\x08\xda\xa8\x35\x67\xc8\x7a\x40\xb5\xf6\x60\xfd\x5c\x26\x97\x5f\xe6\x63\x48\x6b\x52\x6c\xaf\xce\x60\xb9\x4c\x11\x36\x08\x29\x95\x3f\x39\x1f\x20\xb6\xd0\x99\xce\x20\xe9\x76\x08\x04\x9d\x6c\xb8\x10\xb0\xa4\xdf\xb1\x9a\x64\x43\x0f\x64\x54\xd8\x2d\xf1\x89\x78\x06\x02\x65\xa0\xde\xd3\x50\x96\x5d\x18\x0e\xe1\x3c\x00\x38\x1c\xd2\x84\x0a\x8b\x89\x9f\x45\x14\x45\x06\xdd\xca\x48\xff\x18\x00\xad\x3d\x3d\xbe\x50\x72\x7b\xc7\xa5\x43\x93\xd1\x14\x8b\xb2\xf7\x38\x77\x08\xce\x94\x01\xee\x03\x0c\x95\x0b\x84\x75\x5d\x6b\x7d\xcb\xef\x60\x08\x7b\xef\x5b\x7e\xb7\x2b\xd0\x9a\xfd\x61\x53\x45\x01\x29\x15\xa2\x19\x13\xb9\xd2\x23\xbf\x15\x7e\xdc\x65\xf9\x8c\xaa\x8a\xe2\x89\xd9\xac\x09\xf1\x19\x51\x58\x84\xb2\xe4\xcc\x3f\x87\xaa\x6f\x50\x60\xc6\x51\xb0\xb6\x00\xb3\xb6\x84\x26\xde\xfa\x0a\x09\xfe\xf0\xfe\x64\xc7\x38\x5b\xe4\xbf\x05\xc3\xe3\x45\x7a\x16\xc7\xc7\x96\xfd\x77\x5b\xf6\xde\x25\x38\x94\x46\xb5\x00\x9e\x1d\x4f\xdd\x8c\x8b\x9a\xb9\xb6\x64\x9e\x5c\x92\x7a\x47\x42\x23\xef\x59\x10\x64\x0b\xec\xe7\xf4\x40\x5b\x07\x02\x18\xb3\xdd\xf4\x83\xcd\x60\xc6\x59\x65\x3f\xdc\xe6\x1a\x09\xc2\x09\x92\x9b\xad\x46\x6f\xae\xc5\xf3\x19\xb7\x95\x7b\xeb\x3d\x04\xd4\xd9\x86\xa0\x0d\x97\xae\x89\x9c\xd1\x25\x42\x27\x88\x7a\xfa\xa9\xb3\x07\xf8\xd2\x2e\x3a\x0c\xba\xb7\x0f\x62\x61\xa8\xce\xc9\x0c\x37\x73\x87\x3a\xf1\xa3\x6b\x0e\x27\x46\x2d\x93\x1b\x7a\x2f\xb0\xe2\xf8\xe8\xa3\x74\xe0\x7d\xa3\xc2\xd0\x90\x84\x88\x96\x5f\x15\x5c\xf5\x7f\x14\xe5\x39\x4b\x9a\xb7\x2a\xc1\x1f\x28\x02\xba\x26\x16\xc9\xd4\x4e\xe5\x1a\x8d\x6d\x9f\x1d\xd5\x09\x12\xdc\xad\x17\x92\xaf\x17\x5f\x2b\x1e\xaa\x63\x7f\x74\xfd\xb9\xe5\x4f\x08\x69\x22\xc2\x17\xf4\x91\xf3\x48\x89\xd5\x52\xb6\x02\xf6\xde\x3b\x86\xa3\x28\xc0\xf1\xe2\x6f\x30\xfc\x46\xed\x0c\xf9\x22\xbf\x57\xc6\x26\xb6\x07\x9e\xeb\xb7\x8b\x6d\xc3\x5d\xfe\x21\xb8\x67\x04\x57\x03\xab\xd4\xd0\xb4\x59\xbd\x55\x40\x90\xd4\xda\x79\x2c\x98\xfd\xcd\x19\x2c\xcd\xb7\xe1\x7f\x2c\xd8\x6f\xdc\xe5\x3b\xd1\xf6\xe0\x9f\xe7\x19\xfe\x89\xfe\xea\x81\xde\xff\x16\x79\xed\xda\xfa\x82\xd0\x89\xed\xee\x6e\x81\xf2\xc7\xc5\x4f\xe5\x2b\x7e\xc7\x07\x41\x4f\x64\x24\x94\xc4\xa4\x4b\xe6\xe8\xae\x13\xc9\x85\xaf\xfb\x74\x73\x21\x77\xdd\xa1\x4e\xec\xc0\x7b\x1e\xdc\x4c\x03\x72\x9d\xbc\xe1\x5e\x50\xe6\xdd\xcd\xf2\x67\x9b\xe5\x19\x70\xf8\x75\x7f\xfb\x0e\xc8\x95\x49\x1a\x7e\xff\x55\x2c\x52\xb9\x17\xc1\xe8\xc4\x92\x99\x72\xc7\xe9\xff\x0e\x00\x00\xff\xff\xea\x12\x8c\xa7\x2a\x0e\x00\x00")
+var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x6f\xe2\x3a\x16\x7f\x86\x4f\x71\x16\xb1\xda\x64\x94\x31\xd3\x79\xdb\x95\xba\x52\x2f\xd3\xea\xb2\x9d\xd2\xde\xa1\x9a\xfb\x30\x1a\xad\x4c\x72\x02\xde\x06\x3b\xb5\x0d\x1d\x14\xf1\xdd\x57\xc7\x31\x21\x50\x9a\x32\xd0\xbb\x2f\x7b\xdf\x48\x7c\xfe\x9f\xdf\xef\xd8\x31\x45\xd1\x7b\xd7\xee\xab\x7c\xa9\xc5\x64\x6a\xe1\xe3\x87\xb3\xbf\xbf\xcf\x35\x1a\x94\x16\xae\x78\x8c\x63\xa5\x1e\x60\x20\x63\x06\x17\x59\x06\x4e\xc8\x00\xad\xeb\x05\x26\xac\x7d\x3f\x15\x06\x8c\x9a\xeb\x18\x21\x56\x09\x82\x30\x90\x89\x18\xa5\xc1\x04\xe6\x32\x41\x0d\x76\x8a\x70\x91\xf3\x78\x8a\xf0\x91\x7d\x58\xaf\x42\xaa\xe6\x32\x69\x0b\xe9\xd6\x3f\x0f\xfa\x97\xc3\xd1\x25\xa4\x22\x43\xf0\xef\xb4\x52\x16\x12\xa1\x31\xb6\x4a\x2f\x41\xa5\x60\x6b\xce\xac\x46\x64\xed\x77\xbd\xd5\xaa\xdd\x2e\x0a\x48\x30\x15\x12\xa1\x93\x08\x9e\x61\x6c\x7b\xe6\x31\xeb\xe5\x1a\x13\x11\x73\x8b\x3d\x91\x74\xe0\xfd\x6a\xd5\x6e\xa5\x73\x19\x07\x06\xde\x99\xc7\x8c\x8d\x90\x24\x95\x0e\xa1\x68\xb7\x5a\x86\xfd\x3e\x45\x8d\x01\xad\x5c\xfe\x16\x18\xd6\x0f\x8a\x02\xba\x6c\xf0\x89\xf5\x95\x34\x96\x4b\x0b\xab\x55\x18\x81\x48\xc2\xb0\xdd\x5a\xb5\x8b\xe2\x3d\xa0\x4c\xe0\xc0\x00\x7a\x2a\x37\x3e\x08\xd2\xec\xaa\x1c\xfe\x71\x0e\x5d\x36\x8a\x55\x8e\xec\x36\xaf\x2d\x71\x3d\xa9\xaf\x5d\xe8\x49\x6d\xd1\x58\xa5\xf9\x04\xeb\x02\x23\xff\xea\x95\x0c\x49\x5d\xa4\xd0\x55\x39\xfb\xca\xb5\xe0\x89\x88\x29\xf8\x56\xab\xd5\xeb\x81\x48\x41\x2a\x0b\x5c\x4f\xe6\x33\x94\xd6\xc0\x13\x6a\x84\x5c\xab\x85\x48\x30\x89\x80\xe7\x39\x25\x4b\xbd\xba\xba\xf8\x3c\xba\x84\xd8\x17\xc5\x44\xde\x82\x11\x32\x46\x78\x42\x88\xb9\xfc\x9b\x25\x85\x6c\x09\x9d\xc1\x10\x82\xb0\xc3\xc0\xe1\xe4\x49\x64\x19\xcc\xf8\x03\x96\x9d\xac\xca\x03\x29\xcf\xcc\x92\x91\x21\x91\x42\x86\xd2\x95\x9e\xca\xb0\x5a\x85\x70\x7e\x0e\x1f\x5c\x02\xdb\x4d\xba\xe2\x99\xc1\x80\x7a\xd1\x6a\xb5\x34\xda\xb9\x96\xf4\xd3\x25\xb4\xa0\xf2\x90\xa3\xe0\xdb\x77\x21\x2d\xea\x94\xc7\x58\xac\xa2\x5d\xdb\x4e\x39\x55\x1a\x04\x29\x68\x2e\x27\x08\x0b\xef\x6b\xf1\x4d\x7c\x87\x73\xd8\x48\x7f\x13\xdf\xd7\x0e\x6a\xbd\xdf\x0e\xaa\x28\x20\xe6\x59\x56\xb5\x89\xdd\xe6\x7d\x62\x05\xb5\x7b\xb5\x6a\x40\x55\x51\xec\xe9\xcd\x82\x31\x56\x14\x80\x99\x41\x58\xad\x44\x42\xbf\x1d\xe2\x8e\x40\x60\x2a\x30\x5b\xb3\x80\x14\xbb\x69\x1d\x42\x57\xb4\x7a\x00\x04\x7f\x9a\x3f\xe9\xf3\x3c\x6b\xc5\x3f\x26\x87\x5d\x22\x35\xe6\xf1\x27\xcb\xfe\x38\x96\xd5\x5a\x77\x14\x09\xb6\xa1\x51\x12\x80\xaa\x43\x24\x18\x8a\xcc\x57\xae\x0e\x99\xbd\x24\xf1\x1c\x71\xbc\x38\x99\x20\xbd\xff\x18\x25\xf1\xf1\x10\x7c\xbd\x0e\x81\x94\xdd\x70\x6d\xa6\x3c\x43\xed\x21\x30\x8e\x00\xb5\x26\xd8\x15\xc5\xd6\xfa\x90\xcf\x88\xe2\xc1\x22\x5c\x17\x96\x38\x5f\x1a\x19\x98\x7f\x8d\x6e\x87\x43\x25\xaf\x84\x14\x16\x9f\x99\xa2\x00\xbc\xa1\x4a\x68\xc7\xd0\xae\x0a\x65\xb9\xd6\xa9\x89\xae\x9b\xb9\x49\xa0\xf4\x3d\x52\xda\x62\x72\x8d\x4b\xe3\x9d\x93\x40\xef\x1d\x7c\xe5\xd9\x1c\x09\x70\x76\x0a\xc6\xc9\xc0\x03\x09\x71\x4d\x87\x81\x59\xce\x35\x26\x7e\x37\x17\x1a\x88\x53\x98\x40\x10\x73\xa9\xa4\x88\x79\x16\x42\xaa\xf4\x8c\x81\xdb\xc4\x4b\x54\x52\x75\xce\xcf\x41\x8a\xcc\x63\xd1\xc7\x5c\x66\x49\x71\x50\x14\x81\x14\x59\x18\xb8\x24\xbe\xf0\xa7\x1b\x34\x86\x4f\x30\x18\x87\xe1\x5e\x68\x7a\xb3\x7f\xa9\x99\xed\xf5\xd6\xb1\xdb\x29\xb7\xb4\x63\x11\xf0\xc6\x08\x28\xe9\x10\x93\x80\xc4\x05\x6a\x98\x71\x1b\x4f\xdd\x59\xa4\x82\x09\x6b\x37\x10\xa4\xe2\xc7\x3a\x06\x57\x99\x6e\xca\xa8\x88\xf7\xf8\xc3\x7e\x2a\xc7\xda\x4e\x19\x69\x15\x12\x15\xfb\x99\xe0\x42\xa2\x12\xfa\x82\x71\x03\x16\x7f\xd8\xed\xaa\x72\x03\xc2\x6c\x4a\x57\x8f\x88\x1c\x5d\xf1\x2c\x1b\xf3\xf8\x21\xa0\xc5\x16\xbd\x25\x27\x0d\x73\x79\x1c\x46\x95\x68\x83\x98\xb1\x5a\xc8\x09\x95\xba\x14\x2f\x0a\xbf\x6b\x76\x13\xc2\x15\x2b\x39\xf8\x38\x57\x16\xa1\x4b\xf5\x8f\x2a\x4a\x92\x7c\xb8\x07\x95\xf5\xd0\x5f\x0d\x72\x17\xaa\x47\x73\x3c\x43\x79\xe2\x26\xf2\x32\xff\x77\x33\xfa\x8c\xb2\x28\x9a\xa7\x5f\x04\xf2\xa4\x91\xf5\x80\xcb\x58\xcd\xa5\xfd\x9f\xe5\x74\x8d\xcb\x3e\x39\xfc\x23\x13\xb3\xc6\x11\xf0\x90\x9c\x0e\x0b\xfc\x7e\x74\x43\x06\xd7\xa1\xe6\x5a\x48\x9b\x42\xe7\xaf\x8f\x1d\x0a\xfb\x7e\xf4\xd5\x25\xdc\x57\xd9\x7c\x26\xcb\xe0\x2d\xea\xd9\x49\x8d\xc1\x59\x6e\x97\x6f\x97\x01\xc1\x69\x60\x2e\xc9\x68\x51\x54\x66\xee\x97\x39\xbe\xdc\x82\x93\xe2\x7f\xc0\xe5\x9b\x6c\x86\x5c\x26\x95\xde\x2f\xa2\xf6\x40\xe7\xae\xfd\x83\xe0\x1a\x97\x0d\xb3\xa0\x96\xfe\x35\x2e\xab\x83\xc2\x96\xd5\xed\x71\x23\xd2\x9d\xe5\x7d\x4e\xdd\xb6\x70\xc7\xed\xf4\x30\xd7\x65\xf4\x5b\xaf\xca\x68\xf6\x4d\x3a\x57\x41\xc7\xbb\x0e\xb9\x22\x2f\xbf\x72\x73\x4d\x15\xae\xce\x36\xde\x88\x2b\x91\x7b\xd9\xcd\xc1\xcb\x53\x3d\x7e\x08\x63\x8d\x17\xf7\xdd\x6c\x1d\xb4\xd5\x1c\xb1\xd7\x38\xf2\xd1\x57\xbc\x11\x72\xe2\xbf\xd2\xaf\x2f\x41\xe5\xa8\xb9\x55\x7a\xb3\xf9\xbc\xb2\xfb\xb4\xfc\x99\xb0\xdb\x38\x26\x9e\x57\x30\xda\x68\xaf\x93\x2a\xcb\xf5\xf3\x36\x7e\x72\x9b\x6a\xb9\xee\xed\xb6\x6f\x2b\xcd\x23\xf2\xd9\xd8\x5c\x3b\xaa\x3f\x1c\xcd\xd0\xb1\x52\xd9\x69\x14\xad\xe7\x45\x95\xfe\x45\xa9\xac\x01\xfe\x8b\x88\x4e\x79\x27\x4d\x95\xc7\x39\xea\x65\xce\x35\x9f\xbd\x6d\xe4\xbf\x91\xdd\x3b\xb2\xdb\x10\xff\x03\x2e\x23\x58\x44\xd0\xf9\xc2\x9f\x9c\x42\xe7\xa4\x5c\xb8\xd6\xfc\xed\x66\x64\x80\x8f\x95\xee\x6d\x0e\x9d\xbe\x92\x96\x0b\x69\x2e\xe4\xb2\x13\x36\xf0\xbb\x99\x82\xeb\xfa\x5c\x50\xac\x35\x93\x87\xa0\xd7\x8f\xd2\xa8\xbd\x4b\xc6\x93\x8d\xed\xb0\x72\x7f\x72\x87\x1f\x27\xe9\x9c\xff\x72\xf1\xf6\xd4\xe9\xc4\x8a\xec\x99\xf0\x7b\xad\xd7\x34\x6f\x1b\x47\x86\xca\x1b\xdd\x1c\x37\x28\x30\x99\x60\x6f\xca\xb7\xae\x4c\xb6\xee\x35\x2e\x93\xf5\xa5\x86\x5b\xd3\x98\x8a\xb2\x1d\x3b\x97\x54\xfe\x03\x1d\xa1\x5b\x1e\x38\x68\xd9\xdf\x89\xd0\x80\xeb\xee\x3c\xbb\xce\x79\x6b\xe7\xe5\x51\xab\xd2\x74\x5f\xbb\x1d\x47\x8d\xc1\xa7\xda\x6e\xf6\x1a\xdb\x2d\xe6\xfe\x83\x77\xa2\x79\x3e\x65\x43\x7c\x1a\x59\xcc\x1d\xc6\xab\x97\x57\x5a\xcd\x82\x7b\x3e\xce\x30\x82\xbd\x77\x6d\x5b\xd2\xf7\xca\xb5\x02\x99\xd3\xa8\xc9\x95\xca\x65\xfc\xcf\xb4\xa8\x66\x41\xf5\x44\x82\xc8\xbe\x60\xb6\x3e\x88\x95\xba\xc8\x06\x66\x20\x17\xa8\x4d\xfd\xdd\x33\x3f\xd5\xee\x4c\xe7\x13\x64\x37\x1f\x6f\xca\x6e\x78\x86\x74\x91\xdd\x5d\xd7\xe4\x19\x63\x95\x86\x43\xde\x8e\x70\x79\x7a\xad\x29\x6c\xa4\xd7\x15\x6e\xb5\x5c\x3a\x61\xbb\x96\xd1\xaf\xdc\x0c\x51\x4c\xa6\x63\xa5\x4d\x60\x22\x30\x16\xf3\xf0\x68\xb0\xd1\x77\xef\x9f\x80\x6b\x00\x9c\x4f\xac\x44\x5d\x15\x66\xf9\x54\x26\x82\xcc\x63\x67\x17\x30\x9b\x0b\x61\xb7\xe2\x33\xf9\xbf\x06\xec\xef\xc2\x4e\xd7\xa0\x8d\xe0\xe5\x7e\xba\xab\xfe\x7f\x47\x90\x6f\x6e\xfb\x09\xbb\xc6\xdf\x35\xe5\x81\xa9\x6e\x90\x56\x3f\x0f\x7e\x2e\x0f\xf8\x97\xe9\x8c\x5c\x1b\xd6\xcf\x94\xc4\x20\x64\x23\xb4\x77\xee\x36\xab\xfd\x52\x70\xce\xb6\x8f\x30\x0f\xcc\x59\xe8\xaf\x97\xaa\xad\xe6\x8c\xdd\x05\x47\x1c\x60\x94\x3e\x39\x58\xd1\x18\xac\x48\x41\xc0\x3f\x37\x97\xca\x67\xec\x56\x07\x55\x7d\xdf\x34\x17\xa9\xec\xab\xc9\xe4\x81\x61\x43\x65\x9f\x9b\xff\xef\x00\xcf\x43\x0b\x46\x01\x1d\x00\x00")
 
 func templateDialectSqlPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -781,12 +781,12 @@ func templateDialectSqlPredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 3626, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 7425, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\x6d\x6f\xdb\x46\xd2\x9f\xa9\x5f\x31\x25\xfc\x14\x92\xa1\xd0\x4e\x9e\xc3\x01\xa7\xc0\x07\xf8\xe2\x04\x10\xd2\xa6\xb9\x3a\x6d\x3f\x08\x42\xcb\x90\x43\x79\x21\x6a\xc9\x90\x2b\xc7\x81\xc2\xff\x7e\x98\xd9\x17\x2e\x45\x4a\xb6\x93\x6b\x7b\x38\xdc\x87\xc4\xd2\xee\xcc\xce\xec\xbc\xcf\xac\x76\xbb\xb3\xd3\xd1\x8b\xa2\xfc\x54\x89\xd5\x8d\x82\x67\xe7\x4f\xff\xf6\xa4\xac\xb0\x46\xa9\xe0\x55\x9c\xe0\xfb\xa2\x58\xc3\x5c\x26\x11\x5c\xe6\x39\x30\x50\x0d\xb4\x5f\xdd\x62\x1a\x8d\xde\xdd\x88\x1a\xea\x62\x5b\x25\x08\x49\x91\x22\x88\x1a\x72\x91\xa0\xac\x31\x85\xad\x4c\xb1\x02\x75\x83\x70\x59\xc6\xc9\x0d\xc2\xb3\xe8\xdc\xee\x42\x56\x6c\x65\x3a\x12\x92\xf7\xbf\x9b\xbf\x78\xf9\xe6\xfa\x25\x64\x22\x47\x30\x6b\x55\x51\x28\x48\x45\x85\x89\x2a\xaa\x4f\x50\x64\xa0\x3c\x62\xaa\x42\x8c\x46\xa7\x67\x4d\x33\x1a\xd1\x1d\xe0\x32\x4d\x85\x12\x85\x8c\x73\xc8\x04\xe6\x69\x0d\x59\xa1\x89\xbf\xdf\x8a\x3c\xc5\x2a\x02\x86\xde\xed\x20\xc5\x4c\x48\x84\x30\x15\x71\x8e\x89\x3a\xab\x3f\xe4\x67\x1f\xb6\x58\x7d\x3a\xd3\x98\x21\x34\xcd\x28\xd8\xed\x9e\xc0\x47\xa1\x6e\xe0\x24\x7a\x55\x54\x28\x56\xf2\x35\x7e\xaa\x79\x2b\xa0\xf5\x57\xaf\x6b\x78\x5f\x14\xb9\x86\x44\x99\x02\x9f\xee\x3e\x1e\xa5\x14\x6a\x60\x38\x29\xd7\x2b\x98\x5d\xc0\x49\x74\x9d\x14\x25\x46\x6f\xe3\x64\x1d\xaf\xd0\xee\x1a\xd6\x09\xa2\x8c\xeb\x24\xce\x1d\xe0\x3f\xcc\x8e\x01\xac\x30\x41\x71\xab\x21\xdd\x67\x87\x4e\xdc\x64\x5b\x99\xc0\xb8\x03\xdb\x34\x70\xea\x53\x69\x9a\x09\xd4\x1f\xf2\xcb\x3c\x1f\x27\xea\x0e\x92\x42\x2a\xbc\x53\xd1\x0b\xfd\x77\x02\xe3\xc5\x92\xe1\xa3\x37\xf1\x86\x58\x9c\x02\x56\x55\x51\x4d\x60\x37\x0a\x6e\xe3\x0a\xc6\xa3\x20\x90\x45\x8a\x35\x5c\xc0\x1e\xe8\x8e\xa4\x76\x4c\xa2\x4e\xa4\x17\xb0\xc7\x63\x64\x76\xcc\x01\x46\xba\x41\xf0\x6b\x5d\x62\x32\x00\xce\xf2\xbd\x2e\x31\x19\x4f\xba\x34\x5f\xa6\x2b\xb4\xd4\xf2\x22\x4e\x31\x7d\xf7\xa9\xd4\xcc\xee\x76\x90\xa3\x84\x08\x9a\x66\x49\x3a\xdd\x11\x0c\xe3\x56\xb1\x5c\x21\x9c\x20\x09\x36\x32\xc8\xb4\xd3\x67\x71\xb7\x73\x3a\x42\x7b\x6d\xf8\xe6\x02\xa4\xc8\xa7\xee\x38\xc7\x7d\xd0\xec\xdd\x67\x72\xdc\xe2\x3a\x9b\xaf\xfd\xab\x04\x22\x23\x19\x18\x46\xc5\xd4\x63\x76\xb7\x03\x91\xc1\x4a\xc1\x89\x80\x73\x62\xe7\xf3\x67\x02\xd5\x24\x1f\x79\x07\x87\x07\x5a\x38\x9e\xc2\x54\xb5\x45\x5e\x73\x8c\xb6\xd7\x14\x19\x58\x40\x8d\xc7\x6a\x8b\xde\x14\x29\x46\x2f\x8a\x7c\xbb\x91\x74\x42\x5c\x96\x28\xd3\x71\x7f\x6f\xca\xea\xf5\xdc\xc2\x97\x4c\x14\x45\x13\x23\x4a\x9f\xa8\x3e\xe5\x3a\x89\xe5\xcf\x71\xbe\x65\x05\x93\xf1\x8f\x27\xb0\x58\x0a\xa9\xb0\xca\xe2\x04\x77\xfa\x1e\x64\xae\x24\xad\x6f\x3b\xc6\x9a\x14\x32\x13\xab\x59\xcf\xb4\xf4\x7a\xe3\x99\xb9\x61\x9c\xbf\x4e\x81\xfe\x10\x47\xb7\x9a\xee\xec\x82\x57\xa2\xda\xb1\xb2\x6f\x92\x7d\x35\xf7\xe4\x75\x6b\xef\x60\x48\xe9\xef\x9a\x56\x94\xad\xed\xb9\x9e\x2c\xba\x1a\xa8\x50\x6d\x2b\x09\x1a\x6d\x14\x38\xf9\x5c\xd6\xb5\x58\x49\x2b\x1b\x43\x25\x8a\x22\x4f\x42\x13\xed\xdf\xcc\x88\xc8\xc8\x43\xf4\x45\x27\x70\x71\x01\xe7\x9a\x3f\x73\x7c\xb6\x51\xd1\x4b\x02\xce\xc6\xa1\x0d\x6b\x4d\x33\x03\x43\x25\x89\xf3\x1c\x53\xbe\x59\xb1\x55\xfc\x55\xc8\x15\xb4\x3a\x0a\x89\xf9\xc6\x53\x08\x13\x5a\xb4\x24\x9f\x3c\x5d\x1e\xf6\x66\x96\x05\x2f\x44\x5d\xc7\xf6\xbe\x1d\x90\x0b\xa3\xc6\xcc\xa5\x11\xa5\x16\x85\x96\x67\x33\xa2\x8b\x63\xc5\x71\xb5\xfe\x90\xaf\xaa\xb8\xbc\x89\xfe\x49\x11\x86\xac\xb4\xa6\x38\x39\xed\x99\x49\x5a\xd1\xa7\x29\xb0\xa0\x27\xcf\x19\x5f\x3b\x11\xcb\xcc\x52\x16\x39\x07\x50\x4b\x65\x48\xbc\x1e\x93\xa4\x72\x91\x8f\xac\xb1\xfb\x71\xa9\x23\x0c\x27\x22\xbc\x53\x74\xd9\x13\x08\x7f\xc4\x24\xf4\x38\x0c\x09\x3a\x24\x5c\x1b\x59\x40\xe1\xa6\xcc\x63\x35\x98\x13\x31\x5e\x61\x45\x82\x14\x72\x15\xda\x18\xe8\x8b\xd2\xff\xdc\x67\xf8\x51\xa9\xe7\x45\xb1\x95\xea\x40\xf2\x11\x52\xf9\x09\x47\x87\xff\xd9\x3d\xf1\xdf\xf0\xe3\x54\xc7\x04\x1e\xac\xba\xc7\x31\xff\xf2\x4e\xd4\x87\x98\xa7\xa4\xe2\x73\x2f\xa7\xd6\xaa\xf6\x39\xf0\xa5\x30\x71\xe6\xd7\x37\x9f\x2c\xce\x6b\x9c\x1e\x74\xbc\xe4\x06\x93\x35\x20\xb1\x84\x32\xc1\x19\xfc\xdf\x6d\xc8\x34\xb5\x55\x5b\x3d\xc1\xdf\xe1\xfc\xb1\x7a\xf2\x04\x0c\xa7\x5d\xa7\xa0\xd5\x8e\x72\xbe\xed\xef\xd3\x1d\x48\x03\x33\x6f\x93\xbe\xdb\xbd\xe0\x5d\xfc\x3e\xc7\x59\x2f\xf0\xf3\x32\x67\x52\x93\x1b\xfa\x20\x36\x69\x10\xd0\xfc\xca\x27\xf0\x8a\x0a\x3b\x47\x21\xa0\x88\x30\xd3\x75\x62\xc4\x87\xcc\xaf\x22\x5a\x23\x8d\xd5\xca\x96\x37\x0c\xaa\xcf\xec\xd3\xb2\x68\x8c\x11\x4b\x65\x11\xf8\x7f\xfe\xef\x55\x55\x6c\xfa\x39\xa4\xfe\xc0\xe5\xc0\x4f\x52\x7c\xd8\xe2\x8c\x73\xe7\xd4\x86\x80\xb2\x1e\xb2\x88\xb2\xc2\x54\x24\xb1\xc2\xfa\x39\x07\x89\xb2\x9e\x90\xda\xd8\x18\x74\x2c\x7f\x6b\x21\x6c\x38\xaf\x31\xe7\xb2\x99\xf5\x13\x5d\x9b\x6f\x13\x1d\xb2\xa9\x2e\x16\x5c\x28\x72\x0c\x29\x6d\xa6\x29\xeb\x85\x58\x3a\x54\x97\x4d\x1a\x17\xa0\xc4\x46\xa8\x21\x06\x79\xe3\xb9\xd9\xf7\x2c\x55\x33\xf7\x1d\x2f\x5f\xc0\x29\xef\xdb\xc3\x8a\x2c\xab\x71\xf0\x34\xbd\xf3\xdc\x42\xf4\xce\xfb\x41\xaf\x5f\xc0\xa9\x86\x38\x2e\xbc\xa2\x4a\xb1\x3a\x24\xb7\x1f\x68\xf3\xf7\x93\x99\x71\x32\xa6\xf5\xb8\x50\xc2\xce\x62\xdc\xcb\xb1\x42\x24\xbd\x86\x80\xb6\xae\x74\xb4\xde\x3f\xd3\x84\x31\xb7\x3d\x99\x8c\x02\xf5\x94\x90\x6c\x2f\xc4\xce\x34\x1e\x74\xb1\xc9\x28\x70\xa2\xf0\x30\x34\x17\x63\xf5\xd4\x7a\x59\x0f\xdb\xac\x53\xe6\xe4\x7f\x64\xff\x63\xf5\x54\x07\xb1\x01\x37\xf0\x55\xeb\x28\x0e\x06\x44\x0f\xc0\xf2\xe1\xbe\x3f\x90\x1b\x56\x08\x69\xf1\xd7\x29\x94\xad\x22\x0f\xfb\x1a\xb3\x55\xfa\xaa\x7d\xd0\x01\x6c\x6f\x83\xb8\x5f\x68\xf4\x67\x67\xc6\xb1\x44\x0d\x9b\x58\xa6\x31\x77\xc3\xc4\x88\x81\x4d\xf2\x78\x5b\x63\x04\xbf\x20\xd4\x2a\xae\x94\xc6\xe1\x12\x20\xc5\x2c\xde\xe6\x4a\x17\x7f\x53\x88\x65\x0a\xc5\x2d\x56\x95\xa0\x46\x5d\xc1\x7b\xcc\x8b\x8f\xd4\x21\x48\xc4\x94\xba\x79\x4f\xcc\xda\xcb\xc6\xc6\xc7\x26\xda\x8b\xc7\x9b\x58\xdd\x44\xdf\xc7\x77\x73\xa9\xfe\xff\xd9\xe4\x8b\x03\x83\xa3\xa2\x4f\xd5\x91\xa1\x93\x98\x2c\xc4\x88\xdb\xdb\xb6\xa3\x3e\x3b\xd5\xe9\xe7\xac\x8c\xf5\xfd\x84\xc4\x9a\xbb\x7c\x5e\x86\x15\x4a\xac\x62\x25\x0a\xc9\x22\x62\xa8\x22\x83\x18\x56\xe2\x16\x25\x60\xba\xc2\x87\xcc\x01\x08\xaf\x9d\x02\x9c\x48\xae\xb2\xb8\xf7\x21\x0e\x88\x1c\xd7\xa9\x1f\x8d\xc8\x3d\x06\xb2\xaa\xd8\x18\x0a\x1a\x17\xfd\xf6\x9e\x2a\xaf\xce\x31\xc4\x10\x1d\x43\x1a\x00\x55\x30\xff\xab\x8a\x22\x39\xed\x32\xfb\xaa\xe8\x9c\x27\x52\x94\xca\x3f\x73\xce\x0b\x4f\x1c\x80\x3f\x0a\xb0\x30\x3f\xb6\x4a\x19\x05\xb5\xc2\xb2\x53\xcf\xbe\xc1\x8f\xd7\x0a\x4b\x6a\xdf\xdb\x84\x49\xce\x4b\xfa\x94\xfd\x1c\x0c\xbd\x75\xbd\xb0\x97\x0d\x87\x3c\xd9\x04\xb6\xc9\xd4\xa7\xf5\xae\x60\x4a\xa8\x53\xf0\x30\xb9\xfe\xa6\xb7\xda\x25\xdc\x3d\x9c\x44\x3e\x76\xdf\x34\xd2\x8f\x98\x33\xa2\xe3\x12\xa3\x79\x3d\x97\xb7\x58\xd5\xed\x5a\xef\x82\xa8\xf9\xd9\x4f\xf8\x24\x74\x91\xd1\xf6\xf7\xcf\xbe\xd7\x7a\x30\x13\x82\x81\x13\xde\xbe\xf6\xd0\xa3\x28\x72\x0d\x73\x5e\xe3\x7d\xb8\x3a\xa2\x79\xf8\x7e\xb7\xad\x71\xe9\xea\x3c\x48\xb0\x76\xd2\x34\xe0\x29\xfa\x1a\xd5\x1b\x14\xab\x9b\xf7\x45\x55\xdf\x9b\x33\xa6\x40\x86\x32\x39\xe0\x7f\x64\xe7\xf7\xfb\x5f\xac\x5d\xce\xf3\x0d\xe7\x8a\xdc\x78\x3d\x64\x24\x57\x15\x9b\xff\x4a\x57\x64\x30\x91\x0e\xc5\xcd\xf9\xd5\x1f\xe8\xa5\x22\xfd\x9f\x37\xfe\x29\xde\xf8\x95\xae\x78\xc4\x67\xba\x2d\xfb\x51\xfb\x3f\x6e\xa9\x0c\x20\x32\xe3\x50\x03\x96\x7a\x68\x68\xf8\xdc\xa0\x78\x49\xbf\xab\x19\x2d\xaf\x6c\xcd\x45\xfb\x26\x5e\xe3\x78\xb1\x34\xd7\xfe\x59\x57\x2b\xe7\x53\x6f\x24\xc2\x95\xb5\x48\x5b\xe8\x4d\x5c\x2e\xfc\xce\x0d\x9a\x66\x7f\x38\xbd\x87\x6d\x6a\x37\x3b\x60\xd2\xe5\x9b\x9e\xe3\xe9\x5a\x5e\xa4\xf5\x82\xa3\xd2\xfc\x6a\x09\x7a\x02\xc5\xeb\xc4\xa4\x1b\xbf\x65\x6b\x3b\x7b\x9b\x5f\xb9\x72\xdf\x4d\xbf\x83\x80\xa2\x08\xf1\xb9\x58\x76\x3d\xc2\xf0\xe8\x60\xe8\xc8\xce\x45\x7a\xa0\xcb\xbd\x11\x3a\x53\xe3\xff\x06\xba\x6b\xd2\x66\xa7\xc3\x0e\x02\x5a\x9a\xed\x81\xb4\xbb\x81\x71\xb0\xd9\x90\xc7\x69\x88\x03\x7d\xf8\x11\xe7\x3b\xd2\x9a\x0f\x38\x9c\x46\x31\x7f\x5c\x0b\x3b\x33\xdd\xd8\x60\x1b\x16\x04\x75\xf4\xcb\x0d\x56\x1c\x43\xa2\xb9\x1d\xd9\x3d\x80\xd8\x42\xcf\xc2\xf7\x6e\xfa\x94\x3c\x2a\xe7\x8f\xe7\xce\xb9\x96\x53\xc8\xd6\xdc\x38\x4c\x7c\x0e\xe9\xd0\x62\xcb\xf1\x3e\x24\xea\x6f\xb6\x79\x3e\x97\xea\xaf\x7f\x09\xdd\xa4\x9d\xad\xf1\xa7\x1a\xab\x2b\x76\x4d\x3b\x65\x27\xac\x0b\xbd\x49\x48\x46\xbf\xad\x33\xdb\xd3\x85\x3c\x7a\x78\x6b\x21\x7d\x12\x42\x12\x85\x16\xe2\x20\x9d\x76\xe4\x3a\x73\x53\xf1\x67\xfe\x58\xdc\xc8\xd9\xd4\xe1\x7b\x7b\xdf\xda\xeb\x34\xcd\xae\x99\xea\xa9\xb9\x90\xfc\xad\xf1\x65\xa5\xc7\xbe\x86\x42\xb1\x55\x53\x10\x12\x0e\x4c\x96\xc9\x21\x18\xa4\x58\xd3\xf5\x8b\xad\x8a\xc6\xa7\x2d\x1d\xad\x03\x0a\x42\xdf\x14\x6b\xf8\xfc\x19\x90\xc5\xd9\xc6\x95\x60\x78\x0a\xbd\x95\x78\x57\x62\xa2\x30\x05\x91\xea\x0e\x88\x4b\x12\x72\xbe\x27\xc5\x56\x85\xe6\x60\xf3\xa2\x83\x42\x5a\x0e\x84\x34\x0c\xf0\xcd\xfa\xf4\x49\xd6\x5f\x47\x5e\xc8\x3d\xea\xc5\x56\xb1\x52\x4c\x88\xdd\x9b\xdf\x5e\x56\xab\x10\x42\xba\x77\x08\x21\x4f\xb2\x42\xb6\x26\x08\xad\x9a\x43\xa7\x95\x87\xcf\x72\xcf\x36\xcf\x36\x7a\xf0\x1d\xda\xc7\x22\xcf\x4e\x02\x21\xef\xe7\x48\x48\x8f\x21\x67\x7c\x1d\xb6\xb4\x75\xfc\xdb\xb8\xa2\xc8\xeb\xf4\x94\xd6\x0b\x2b\xb8\x65\x47\x4b\x0f\xd3\x0b\x67\x02\x91\x92\x69\x72\x44\x36\x33\x52\x7b\xe4\x9e\x7d\x98\xb8\xee\x12\x81\x59\x20\xcb\xf6\xc1\xf9\xa4\x85\x59\x5b\x76\xc1\xdb\xf5\xf6\xa9\x28\xf0\xdf\x03\x3c\x17\xb2\x8f\x41\x83\x6f\x0f\x3c\xee\xff\xa2\xb7\x87\xee\xeb\x83\x27\x98\xdf\x74\xbe\xd6\xa9\x29\xd4\x01\xd4\x24\x9e\x90\x04\xf3\x9b\x1d\x1e\x1b\xd6\x18\xdc\xc4\xe2\xe1\x8a\x70\x7e\x35\x97\x56\x4a\x2e\x98\x4a\x5b\xf3\xb8\xf9\xb7\x3e\xc8\xbc\x39\x4f\xbc\x5b\x1f\xe4\x9a\xdf\x4c\x0c\x1b\x36\xa9\x7b\x19\xdd\x52\x30\x98\xe6\x29\x42\x9b\x8c\xd6\x02\xd5\xc0\xcb\x51\xdf\x5e\x0e\x89\xc6\xb3\x99\x3d\xc9\x68\x1b\xd2\x78\x98\x6a\x31\x49\x5b\x19\x18\xd3\xd9\x1b\x1d\xfa\x15\x87\x66\x6e\x21\x96\xe6\xf1\x4a\x1f\x7e\xad\xaa\x6d\xa2\xd8\xad\x74\xc5\xe8\x3f\x32\x1e\x07\x9e\x82\xf4\x48\xbb\x87\x1a\xca\x70\x3a\x83\xfc\xf0\x51\xbe\x7a\x6d\x9f\x1a\x53\xbf\xf8\x1a\xac\x41\x86\xaa\x30\xfa\x38\x54\x89\x3d\xac\x80\x39\x22\x0d\x91\x41\xb6\x6e\xdf\xfe\xc4\xb2\x7b\xc5\xd7\xf6\x92\xcf\x09\xac\x63\x1d\x41\xc7\x33\xd9\x2b\x4f\xb3\xf5\xa4\x95\x31\x85\x8a\xd3\x6c\xbd\xec\x0a\xd3\xae\x4e\x1d\xc5\x3d\xe1\x3d\xd4\xca\xff\x83\x2c\xdc\xde\xeb\x2b\x6c\x3c\xd3\x8f\xd2\x4f\xd6\xf8\xc9\xda\xfb\xbe\x0a\xc2\xdf\xdd\xe6\xe5\x01\x33\xfe\x92\xbe\xe1\x90\xc5\x1e\xec\x1d\xee\xb3\xd4\xe1\x8e\x80\x2f\x65\xe5\xe0\xf4\xd0\x6e\xd8\xa6\x82\xbe\xee\x59\x58\xff\xb7\x14\xbe\xe5\xb9\xa1\xb4\xdf\x65\x1b\x56\xc7\xc7\xaa\xe5\x47\x14\xcb\xbd\x76\xb6\x5b\x04\x37\x7f\x96\x71\x9b\x88\x70\x20\x14\x78\x71\xa3\x5b\x92\x1d\x32\xf3\x07\xd9\xb6\xa8\xf9\x28\x62\x8e\xe3\xfb\xa0\x89\xfb\x95\x88\x1f\x4c\xfe\x18\x9f\xdb\x63\xee\x34\x5b\x0f\x73\x78\xdc\xc9\x5c\x63\xa1\x5f\x23\xa1\x69\x64\xdb\x10\x79\x81\xf2\x9e\x8c\xd3\xa9\xd1\xf6\x7f\x1d\xd0\x7c\xd1\xd4\xc2\x2f\x03\xdd\x90\x22\xae\x3a\xbf\x95\xbb\xac\x56\xed\x1e\xbf\xe5\xfa\xbb\xad\x89\xe8\xb9\xe1\x36\xcf\x15\xf9\xba\x07\xe2\x35\x49\x23\x3b\x9e\xb8\x89\xeb\xb7\x15\x66\xe2\xce\x43\xa1\x8e\x2c\x34\x33\x1d\x92\x81\x7e\x37\xb6\xd8\x9a\x10\x33\xe7\x26\x7f\xde\x00\x49\xcb\x58\x16\xca\xe1\x89\x3c\xa7\xe6\x19\x9a\xe6\xb4\xf3\x63\xac\xd8\xbb\x4f\xff\xe7\x84\xff\x0a\x00\x00\xff\xff\x8b\x13\x66\xeb\xa9\x29\x00\x00")
+var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6b\x6f\xdb\x48\x92\x9f\xa9\x5f\x51\x2b\x78\x02\xc9\x90\xa9\x24\x77\x38\xe0\x1c\xf8\x00\x6f\x1c\x03\xbe\xcc\x24\xb3\xe3\xe4\x76\x00\xc3\xd8\x69\x93\x4d\xb9\x4f\x54\x93\x66\x37\xfd\x58\x0d\xff\xfb\xa1\xaa\x1f\x6c\x52\x94\x22\x27\x9b\xdd\xc5\xe2\x3e\x24\x36\xfb\x51\xef\xaa\xae\xaa\x6e\xaf\xd7\xf3\xc3\xd1\xdb\xa2\x7c\xaa\xc4\xe2\x56\xc3\xeb\x97\xaf\xfe\xf3\xa8\xac\xb8\xe2\x52\xc3\x39\x4b\xf8\x4d\x51\x2c\xe1\x42\x26\x31\x9c\xe6\x39\xd0\x22\x05\x38\x5f\xdd\xf3\x34\x1e\x7d\xba\x15\x0a\x54\x51\x57\x09\x87\xa4\x48\x39\x08\x05\xb9\x48\xb8\x54\x3c\x85\x5a\xa6\xbc\x02\x7d\xcb\xe1\xb4\x64\xc9\x2d\x87\xd7\xf1\x4b\x37\x0b\x59\x51\xcb\x74\x24\x24\xcd\xff\x78\xf1\xf6\xdd\x87\xcb\x77\x90\x89\x9c\x83\x1d\xab\x8a\x42\x43\x2a\x2a\x9e\xe8\xa2\x7a\x82\x22\x03\x1d\x20\xd3\x15\xe7\xf1\xe8\x70\xde\x34\xa3\x11\xf2\x00\xa7\x69\x2a\xb4\x28\x24\xcb\x21\x13\x3c\x4f\x15\x64\x85\x41\x7e\x53\x8b\x3c\xe5\x55\x0c\xb4\x7a\xbd\x86\x94\x67\x42\x72\x18\xa7\x82\xe5\x3c\xd1\x73\x75\x97\xcf\xef\x6a\x5e\x3d\xcd\xcd\xce\x31\x34\xcd\x28\x5a\xaf\x8f\xe0\x41\xe8\x5b\x38\x88\xcf\x8b\x8a\x8b\x85\x7c\xcf\x9f\x14\x4d\x45\x38\x7e\xfe\x5e\xc1\x4d\x51\xe4\x66\x25\x97\x29\x4d\x65\x45\xf5\xb9\x4c\x99\xe6\x76\xae\x58\x09\x0d\x57\xd7\x4a\x57\x42\x2e\x46\xc1\x4a\x43\xf5\xa5\xae\x38\x5b\x81\x4a\x98\x54\x44\xac\x2c\x52\xae\xa0\x90\x1c\x6e\x9e\xf0\x47\x0c\xef\xd8\x82\x57\x47\x79\xc1\x52\x21\x17\x28\xdf\xe4\x96\x27\x4b\x9e\xe2\x02\xdc\x91\xb0\x3c\xdf\x8f\x3b\x45\xc8\x88\xbb\xf5\x1a\x0e\xca\xe5\x02\x8e\x4f\xe0\x20\xbe\x4c\x8a\x92\xc7\x3f\xb3\x64\xc9\x16\xdc\xcd\x5a\xa9\xe1\x8a\x92\xa9\x84\xe5\x7e\xe1\x1f\xed\x8c\x5d\x58\xf1\x84\x8b\x7b\xb3\xd2\xff\xee\xb7\x23\xa7\x59\x2d\x13\x98\x74\xd6\x36\x0d\x1c\x86\x58\x9a\x66\x0a\xea\x2e\x37\xe2\x98\x24\xfa\x11\x92\x42\x6a\xfe\xa8\xe3\xb7\xe6\xe7\x0c\x32\x09\x08\x68\x42\xfb\xe2\x0f\x6c\x85\xa4\x4e\x81\x57\x55\x51\xd9\x1f\xb0\x1e\x45\xf7\xac\x82\xc9\x28\xda\xa9\x3e\xaf\xbf\x13\xe8\x51\x15\xdb\x19\x0b\xc0\xea\x2a\x8a\xfe\xa2\x4a\x9e\x0c\x2c\x27\xc1\x5e\x96\x3c\x99\x4c\x47\xd1\x74\xb7\xd1\x88\x0c\x1c\xde\x35\x12\x41\x30\xe3\x0f\x45\xca\xe3\xb7\x45\x5e\xaf\xa4\x82\x13\x60\x65\xc9\x65\x3a\xd9\x9c\x9b\x11\xee\x40\x4b\x21\x82\x38\x8e\xa7\xa3\x28\x6a\x46\x1d\xaa\x91\x98\xf9\x21\xa4\x3c\xc9\x59\xc5\x53\x60\x99\xb6\xfe\x58\x5a\x28\x15\xcf\x78\xc5\x65\xc2\xd5\x0c\x98\x02\xa1\x61\xc5\x9e\x40\xdd\xb2\xb4\x78\xe8\x2c\x94\x6c\xc5\xad\x89\x91\x84\xd1\x4c\xa1\xa3\x89\x91\xe5\xe7\x32\x61\xf2\x7f\x58\x5e\x73\xe4\x86\x14\x36\x85\xab\x6b\x21\x35\xaf\x32\x96\xf0\x75\x83\x4a\x8a\x68\xff\x09\xbc\x08\x21\xac\x93\x42\x66\x62\x71\xbc\x21\x64\x33\x8e\x22\xbc\x37\x80\x8f\x4f\x00\x01\xc4\xca\xe3\x9a\x4c\xbf\xa4\xf2\xbe\xf4\x1d\x2c\x2f\x72\xf3\x3d\x33\x90\xb3\xa5\x83\x6b\x45\x1b\x35\x7d\x93\xa8\xb8\xae\x2b\x09\x66\xdb\x28\xf2\x02\x38\x55\x4a\x2c\xa4\x63\xde\x62\x89\xe3\x38\x10\x41\x60\xae\x91\xc8\x08\x23\x9c\x9c\x80\x14\xb9\xa1\xcd\x82\xce\x56\x3a\x7e\x87\xe6\x9d\x4d\xc6\xce\x61\x9b\xe6\x18\x2c\x06\x72\xfc\x94\xb8\x2a\x6a\x4d\x9f\x18\x21\x5a\x05\x8c\xad\x4d\x20\x0e\x5e\x55\x5e\x6c\x8c\xf6\x5b\x06\x0d\x81\xc8\xe5\x1b\xa4\x0a\xfe\xb0\x49\x07\xaf\x2a\x0b\xc8\x11\x26\x27\x48\xf3\x94\xb8\xb6\x63\xea\x2e\x5f\x54\xac\xbc\x8d\xff\x84\x2e\x81\x96\xab\xd0\x8f\x67\x1b\xda\x4c\x2b\xfc\x6d\x06\x24\xad\xe9\x88\x82\x88\x15\xea\xce\xf0\xf5\xcf\x1c\xb7\x4e\xf3\x7c\x28\x68\x4d\x61\x72\x75\xdd\xf1\x92\x99\x8b\x57\x41\xa4\x42\x51\xa2\x1d\xf6\x96\xae\x9b\x2f\x99\xf4\xf7\x89\x62\x21\xce\x77\xe9\x82\x3b\x6c\x78\x02\xf1\xf4\xd3\x53\x49\x9e\x7d\xb5\x5e\x43\xce\x25\xc4\xd0\x34\xd7\x78\xd4\x91\xc1\xd0\xde\x8a\xc9\x05\x87\x03\x8e\x82\x8d\xed\xe6\x28\xea\xe3\x44\x12\xd7\x6b\xaf\x23\xee\xd8\xb6\x06\x38\xf3\xe0\x3c\xf5\x1b\x2e\xf8\x85\x78\xdb\x99\x7c\x1f\xb2\x82\x0e\xb1\x5e\x3b\x42\xc5\x2c\x20\x76\xbd\x06\x91\xc1\x42\xc3\x81\x80\x97\xa8\xee\xdf\x7f\x07\x6f\xa0\xcf\xe4\xc1\xef\xb3\x11\x27\x38\x76\x74\x55\x73\x1a\x6b\x46\x1b\x6c\x6e\x44\xaa\xbf\xfd\x41\xd1\x3f\x29\x9e\x1d\xba\x8f\x9f\x1f\xbb\x9d\x99\x5b\xc2\xe9\xd3\x44\xdb\xe9\xbf\x6c\x64\xcf\xb9\x89\x94\x6a\x8a\xf1\xfd\xe5\xf7\x89\xee\x4e\x21\xf8\x53\x5d\xb5\x28\x8f\x5e\x5d\x6f\xf7\x66\x5c\x62\x06\xe2\xae\x63\x07\x5f\x5b\xe4\xb2\xeb\x0c\xa1\x13\xa1\x3d\x6e\xbe\xf2\x50\xd8\x38\x89\x1c\x66\x91\x53\x00\x75\x58\x86\xc4\x1b\x10\xa9\x66\xb8\x63\xe4\x8c\x3d\x8c\x4b\x1d\x61\x78\x11\xf1\x47\x8d\x1e\x71\x00\xe3\x5f\x78\x32\x0e\x28\x1c\xe3\xea\x31\x86\x09\x17\x59\x40\xf3\x55\x99\x33\x3d\x74\x52\xcd\x39\xa6\xec\x36\x63\x1f\xbb\x18\x18\x8a\x32\xfc\x7d\x93\x60\x2a\x69\x76\x22\x70\x99\xfc\x81\x3b\x35\x0f\x14\xc7\x25\x7f\x1c\x38\xfc\xc8\x43\x7f\x87\xb2\x12\x52\x67\x30\xfe\x41\x5d\xd2\x52\x3a\x4e\xe7\x73\x30\x5f\xe4\xf6\x60\x80\x98\x42\xc4\x9a\x77\x52\xac\xca\x5a\xb7\xd5\xc6\x42\xdc\x73\x93\x88\x63\xb1\xa5\x66\x20\xa4\xd2\x9c\xa5\x58\x9f\x99\xea\x29\xa6\x2a\xe7\xe0\x7f\x55\x21\x51\xd2\x63\x44\xd4\x06\xdb\x0c\xc7\x0e\xe2\x73\x5a\xea\xe3\x2d\x43\xa9\x67\xf1\x85\xfa\xef\xcb\x8f\x1f\x60\x22\x0b\xdd\x7e\x7e\x2c\xd9\x5d\xcd\xa7\x76\x14\xc1\x4e\x6d\x28\xc6\xdf\xe1\x04\x61\x36\x4d\x18\xa3\xad\x64\x5b\xcb\xa7\x85\x86\xdd\xf3\xa2\x02\xfe\xc8\x56\x65\xce\x67\x2d\x9f\xa0\x74\x81\x19\xb2\x90\xc0\x00\x91\x42\xc9\xf4\x2d\xf2\x84\x4b\xd0\x3d\x5d\xa0\x1b\x9b\xea\xf2\x78\x34\x9f\x8f\xe6\xf3\x28\xc9\x05\x97\x3a\x0e\x43\xa1\xb1\xf5\xc9\x34\xc6\xf9\x28\x10\xef\xa4\x1f\x97\x11\xec\xa5\xae\xea\x44\x93\x38\xa0\x69\xcc\xba\xf1\x92\x3f\x8d\xa7\x0e\x00\x55\x8e\xe4\x36\x53\x44\x1a\x98\xce\x7c\x0e\x9f\x15\x87\x53\x53\xea\x4a\xb6\xc2\xf4\x0f\x09\x36\x7a\xe4\xa9\x55\xe2\x0c\x1e\x6e\x39\x15\xd5\x4f\xc0\x2a\x4e\xd5\xa6\x24\x6e\x75\x01\x0c\x14\x91\x10\xef\x9b\xee\x84\x1c\x65\x12\x4e\x17\x8b\x8a\x2f\x98\xe6\xe7\xb5\x4c\xb0\x4a\xa3\x90\xd8\x19\x9d\xc2\xe1\xa6\x89\x36\x74\x9a\x98\xb1\x82\x2c\xf6\xc5\xd0\xa2\x2f\x1f\x2c\x0e\x44\x9c\x85\xe7\xe2\xd5\x75\x87\x84\x75\x26\x1b\x22\xce\x04\x29\xbf\x87\xd4\x6c\x03\xfa\x70\x02\x57\x56\xfc\x1e\x0e\xd5\x5d\x1e\x5f\xda\x4d\x14\x82\x82\x3c\x2e\x48\xaf\xfb\x44\x96\x15\x2f\x59\xc5\x8d\x45\xa0\x06\xb7\xe6\xd8\x6d\x68\x0b\x13\xed\x3e\x3c\x75\x97\x5b\xeb\x6a\x43\x9b\x5d\xea\x58\x1a\x35\x23\x6b\xe7\xb6\x0f\x91\x17\xc9\x52\xd9\x8e\xca\x03\xfe\xc2\xb4\xb1\x02\x67\x24\xd6\xb3\x29\xc9\x86\x5a\x6a\x91\xd3\x37\x1a\x99\x75\x00\x5d\x31\xa9\x18\x79\xfc\x0c\x81\xd7\xca\x59\xda\xf9\xc7\x5f\xe0\xf3\xcf\x67\xa7\x9f\xde\x41\x92\xb3\x5a\xf1\x18\x2e\x34\xa8\xdb\xa2\xce\x53\xb8\xe1\x50\x63\x1f\x08\xad\xb3\xe2\x2c\x3d\x5a\x15\xa9\xc8\x9e\x8e\x1e\x2a\xa1\x39\x64\x79\xf1\xa0\xe8\x68\x12\x32\xc4\xa0\x08\x85\xa9\x46\x6f\x0c\xf1\x49\x21\x93\xba\xaa\xb0\x27\x15\x2e\x84\xac\x2a\x56\x50\x23\x9b\x96\x1e\x65\x98\x8c\xe1\x43\xa1\xb9\x61\xf5\xf2\x4f\x3f\x22\xb6\xb4\xe0\x0a\x64\xa1\x11\xb6\xaa\xcb\xb2\xa8\x34\x2e\x3d\xca\xf9\x3d\xcf\x01\xd1\x08\xb9\x98\x51\x20\x12\x1a\x14\xaf\x04\xcb\xc5\x5f\xb9\x02\x24\x96\xa0\x87\x88\x6d\xd0\x8b\x6d\x14\xd0\x8f\xc3\x11\xe0\xcf\xb7\xbc\xda\x74\xfb\x8b\xb3\x89\x48\xa7\xd3\xd8\xab\x68\x32\x8d\x3f\xca\xfc\xe9\x57\xef\xe3\x7b\x7a\x62\x00\xa0\x3f\x89\xb6\xd5\x37\x9e\xb6\x35\xe5\xf2\xcf\x61\x2b\xb3\x16\xf4\x11\x3b\x57\xfc\x31\xc9\xeb\x94\x77\x8e\x84\x22\x0b\x4f\x02\xdb\x6b\x43\x4d\x78\x2b\x32\x72\xcc\x39\xbb\x37\x3b\x57\xf0\x57\x5e\x15\x28\xfa\xc2\xf6\xf6\x08\x31\x4f\x81\x4b\x2d\xb4\xe0\x8a\xcc\x46\x28\xb4\x97\xac\xce\x29\x9e\xa9\xa5\x28\x4b\x94\x7c\xce\xaa\x05\x77\x88\x26\x3c\x5e\xc4\x26\x44\xa7\x45\x52\xaf\xb8\xd4\x0a\x65\xd6\xda\x35\x1e\x13\x92\xf3\x74\xb3\x43\xf6\x09\xbb\x65\x36\x81\xee\x78\x00\x53\xf0\xe1\xf3\x8f\x3f\x1a\xb2\x35\x2a\x2d\x2b\x2a\x4e\x76\xa8\x6f\x3d\xea\x55\xad\x34\xda\x34\xbb\xc9\x39\xe8\x82\xc2\x28\xed\xb3\x82\x89\x47\x41\xae\xe5\x0f\xb8\x3d\x0e\x0a\x94\xf4\x86\x95\xac\xd7\x30\x11\x32\xe5\x8f\x10\xc3\xcb\x29\x56\x94\x4a\x33\xa9\x51\xf1\xf1\x69\x9e\xff\x3a\x74\x20\xec\x69\x37\x84\xcf\x32\x15\xc7\xb1\xe9\x4d\x4e\xfb\xeb\x86\x4c\x88\xba\x99\x3e\xc6\x0e\xcd\xce\xac\xb4\x4c\x9c\xdd\x6e\x60\x41\x42\xd6\x4f\x09\x10\xed\x11\x88\x2c\xc8\x08\x6c\x02\x05\x07\xc4\x21\x66\x37\x98\xcd\xe0\x82\xf0\xfc\x1c\xa3\x17\x8d\xdb\x6c\xeb\xa0\x96\x2b\x56\xa9\x5b\x96\x07\x5b\x3c\x1d\xe3\xd8\x4f\x23\x0e\x9b\xa6\x18\xb4\x9f\xdd\x0c\x31\xb6\x5e\x87\xa0\x3c\x24\xaf\xad\x71\x3c\xee\x6d\xb2\x1a\xc6\xa4\x24\x57\xbc\xc3\xcb\x87\x42\x9e\x0b\x89\x21\x69\x13\xf0\x18\x8f\x19\x0f\xc6\xaf\xb4\xa4\x59\x25\x47\xd1\x7c\x0e\x5e\x16\x4d\x63\x9d\x49\xf9\x54\xe5\x20\xeb\x25\x2b\x3d\xc7\x75\x3e\x67\x5c\x66\xc5\x74\x72\x1b\xb8\xae\x81\x7f\xf3\x64\xbd\x03\x1d\x10\xbd\x22\xe5\x49\x41\x0d\xe8\x42\xe6\x4f\x20\xb4\xb2\x9e\x14\x87\x1e\x40\xe7\x8a\xf7\x6d\xa6\xc8\xed\xed\x5c\x3c\x8a\xa2\x3d\xed\x33\x60\x6e\x6b\x57\x85\xd6\xc4\x58\xa6\xf4\xba\x2a\x58\xfe\x55\x18\xda\x95\x69\xbb\xd7\x89\xb6\x65\x21\xa5\x2c\x70\x75\x7d\xf3\xa4\x39\xfc\xa6\xee\xf2\x63\x2b\xad\x4b\x5d\x54\x6c\xc1\xdf\xf3\x27\x68\x9a\xf1\x6f\xae\x26\xdc\x71\xae\x9b\x54\x60\xc8\x67\x0f\xb2\xae\xab\x62\x4d\x8d\x4c\xcc\xe0\x05\xd2\x34\x90\x00\x0c\x64\x00\x78\xac\x47\xd1\x3d\x35\x3a\x57\x6c\xc9\x37\xf9\xc5\xca\x87\xe0\x61\x11\x18\x61\xb8\x14\xb8\xd8\x78\x14\x4e\x58\xd8\xb6\x48\xc2\x91\x2b\x71\x1d\x93\x08\xc2\x5a\x34\x8a\x30\xe3\x11\x32\xec\x46\x6c\xb8\x1f\xed\x42\x46\x24\x29\x88\xd6\x04\xc2\xb9\x27\xd0\x38\xdf\xc3\x33\xc0\x6b\x37\xdf\x09\x4b\x60\x8a\xa6\xc6\x5c\x3b\x36\x7c\x0c\x3f\x3c\x8c\x29\xe5\x22\x56\x43\x1a\xc9\xb7\x1c\x3d\x6d\xec\xcd\x62\xf4\xe7\x77\x32\xa9\x9e\x4a\x6d\x94\x6a\x71\x97\x39\x13\xf6\x36\x61\x8b\x62\x53\x4e\xbb\x10\x00\x6a\xf7\xae\xc6\x3c\x02\x0b\xb8\x19\x74\x79\x1b\x45\xa1\x14\x7a\x3c\x6e\x65\xd2\x82\xdf\x8b\x4f\xcb\x68\x14\x75\x10\xc3\x09\x78\x2e\x5a\xc6\x7d\x68\x18\x10\xc4\xdb\x62\x85\x17\x7a\x4a\x98\x1a\x08\xb7\x44\x51\xca\x34\xf3\x42\xc0\xa8\x73\xc6\x13\xbb\xee\x3b\xf1\x6d\xa1\x7f\x1b\xeb\x48\xf6\x76\xae\x4d\x98\x45\x43\x7c\x5b\x70\xbc\x91\xf4\xec\x26\xf4\x9d\x76\xd4\xbe\xe9\xb8\xed\x4e\x4b\x58\xcf\x9e\xbf\x8e\x77\x83\xfb\xdb\xf8\xb6\xf4\x0f\xb3\x2e\xb2\x90\xad\xd0\x9a\xe9\xa6\x10\x6d\xf9\x8c\x97\xfa\xf6\x5b\xbc\xb3\x3d\xa5\xf6\xe1\x63\x90\xb0\x16\x44\x5f\xb0\x33\x78\x41\x31\xe4\xfb\x93\xd4\x15\x5d\x13\xd4\x5f\xf7\xae\x59\x14\x35\xa3\x8d\x23\xf6\x57\xbc\x76\xcd\xc5\x92\x87\x83\x33\xb8\xa9\x35\x94\x4c\x8a\x44\x61\xb8\x64\xd2\xf6\xfe\x8a\x24\xa9\xab\xaf\x3c\xef\x7e\x1d\x3e\xf0\x7a\xf1\xdf\x9e\x73\x6a\xb6\x4d\xf1\x01\x44\x04\x38\x0d\x4e\xb3\x8e\x74\x89\xfa\x89\x93\x52\x57\x1e\x1b\xf7\x89\xc1\xaf\xcf\xb8\x1a\x79\x5b\xd4\x52\x6f\x39\xc6\x85\xd4\xe1\xd1\x4d\x5d\x56\x38\xfe\xc2\xfd\x44\xff\xbe\x89\x10\x3c\xe7\xbe\xe9\x19\xc4\xbf\x7b\x14\x6a\x1b\xf1\x78\xe9\x11\x52\x2f\xb7\x6a\x23\x94\xc2\x74\x34\xa0\x08\xcb\x52\xc6\x72\xc5\x67\x5b\x1b\xc3\xe4\xcd\xc0\x91\x24\xbc\xb2\x3d\x86\x1f\xee\xbd\x89\x07\x7d\x44\xf8\x2f\x78\xe9\xfb\x88\x7b\xb2\x1a\x08\x18\x0e\xbb\x4d\x5b\xbc\x16\xea\x28\xe7\xc5\xe6\x3c\xf2\x80\x1a\x38\x0e\x26\xf1\xdb\xcd\x45\x9f\xb0\x66\x3a\xde\x08\xbb\x34\x4c\x37\x3d\xf6\xee\x62\x73\x89\xbb\xd4\xc0\x45\x17\x67\x21\x02\xca\xf9\x3d\x86\x08\x5d\xe3\xd8\x44\x59\xca\xc3\xe2\x8b\x33\x4a\x97\x4c\x3a\x66\xc3\x02\xe1\x8a\x0c\xcc\x4d\x5c\x6e\x5b\x90\xc0\xd1\x06\xfa\x9f\xfe\x3b\xaf\x8a\xd5\x66\x2b\x4a\xdd\xe5\x38\xf9\x59\x8a\xbb\x9a\x1f\x53\x6d\x8d\xdf\xbe\x3c\x3f\x86\xad\xa5\x38\xae\xc3\x72\x6c\x73\x09\x15\x53\xae\xd1\x5d\xaa\x21\xbb\x2a\x2b\x9e\x8a\x84\x69\xae\xde\x50\x96\x57\xaa\x29\x2a\x1f\xb5\x65\x6f\x2c\x7e\x76\x2b\xdc\xa5\x85\xeb\x12\x75\x3b\x5a\x36\x71\xee\xa5\x91\xa5\x4b\x22\x4b\x0c\xce\x7e\xab\x0f\x15\x8d\x6f\xc3\x0b\x2c\x0b\x07\x08\xa4\x89\x37\x76\x3e\xb0\x77\x43\xdc\x8f\x34\x7c\x02\x87\x34\xef\x80\x15\x59\xa6\xf8\x20\x34\x33\xf3\xc6\xad\xd8\x80\xf7\xd1\x8c\x9f\xc0\xa1\x59\xb1\x5b\x78\x45\x95\xf2\x6a\x9b\xdc\x3e\xe2\xe4\xf7\x93\x99\x75\x55\xc2\xf5\xbc\x80\x64\x5b\x06\x5d\x52\x10\xa5\x5b\xe7\xd2\x39\x73\x65\x30\x19\x0e\x86\x7e\x7a\x3a\x1d\x45\xfa\x15\x92\x6f\xf7\x1b\x97\xdc\x28\x6c\x68\x34\xe8\x9b\x86\x3b\x6c\x2d\xa4\x5f\x39\x5f\x9d\x6c\xf1\x61\x6c\x09\xd0\x3f\xf4\xa2\x89\x7e\x65\x42\x61\x9f\x42\x75\x97\x87\xaa\xf5\x18\x37\x35\xa8\xee\xf2\x60\x81\xa3\xc3\x7f\xef\x49\x0d\x59\x09\x5a\xfe\x5f\x66\x50\xb6\x8a\xdc\xee\x6b\x28\xed\xa8\x0c\x55\xbb\x17\x00\xb2\xb7\xc1\xbd\x5f\x69\xf4\xf3\xb9\x75\x2c\xa1\x60\xc5\x64\xca\xe8\x99\x1c\x72\x62\xd7\xba\x86\xec\x9f\x39\x28\xcd\x2a\x6d\xf6\x50\x69\x90\xf2\x8c\xd5\xb9\x36\xa5\xb9\x69\x7b\x15\xf7\xbc\xaa\x04\xbe\xe0\xc3\x26\x57\x5e\x3c\x60\x4e\x63\xfa\x68\x71\x28\x66\xe3\x65\x13\xeb\x63\x53\xe3\xc5\x93\x15\xd3\xb7\xf1\x4f\xec\xf1\x42\xea\x7f\x7b\xed\xd9\x7a\x76\x60\xf0\x58\x0c\x54\x13\x19\x3c\xb8\xad\x51\xb4\xbb\x37\x68\x8b\x86\xde\xe6\xe6\xfb\x2f\x4e\xe6\x87\xa6\xf3\x31\xa7\xbb\x00\xf3\xfc\x44\xb5\x0d\x11\x58\x70\xc9\x2b\x86\x7d\x5f\x6a\x4b\xba\x8b\x21\x66\x1b\xa0\x3c\x5d\xb8\x97\x51\xbb\x5e\xaf\x10\xf4\xf6\x61\xe1\x01\xdd\x8d\x1d\xa0\x9b\x13\x05\xee\xe9\x1f\x3c\x58\x65\x05\x04\x60\x97\xdb\xbd\xbd\xa2\xbd\xf6\x06\xd3\x3c\x7f\xc1\x9b\xc9\x0e\x18\x24\x08\xc1\xa0\xee\xb0\x4d\x89\xf4\x2f\x2a\x94\x12\x82\x44\x32\x40\x17\x1d\x78\x22\xc5\xce\x7a\x00\xf3\x82\x06\x8e\xfc\x02\x2f\xf4\x60\xcd\x2f\xad\x22\x46\x91\xd2\xbc\xb4\xa1\xc7\x9e\xfe\xfc\xe1\x52\xf3\x12\x1f\xe2\xb5\x07\x36\xba\x3d\xea\x50\x86\xee\x48\xa1\x65\x06\x1b\xe3\x66\xa0\x77\x1a\xef\xb8\x10\x99\xce\x42\x5c\x9f\x0a\x8a\x42\xdc\xa4\x00\xc3\xe8\x36\x27\x83\xd1\x2e\xe2\x2e\x70\x14\xf9\xc4\x7f\x99\x4d\xbf\xf0\xdc\x65\xe7\x0e\xfa\x85\xba\x90\xf7\xbc\x52\xed\xd8\x06\x83\xdc\xd0\x13\xb2\xe8\xde\x83\x60\x31\xcb\xe3\x9f\x5e\xff\x04\x47\xb6\xd0\xdb\x02\xe1\xe7\xf7\xc1\xf6\x38\x8e\xfd\x83\x12\xec\x8e\x7c\x61\xaf\x89\x85\xc1\x7e\xbf\x59\xa6\x76\x2f\xb2\x4e\x0f\x6d\x9c\x9d\x34\x0d\x04\x8a\xbe\xe4\xfa\x03\x17\x8b\xdb\x9b\xa2\x52\x5f\x3c\x6d\x66\x80\x86\x32\xdd\xe2\x7f\x68\xe7\x5f\xf6\x3f\x2c\xb3\xd2\x45\xe8\x1b\xde\x15\xd1\x81\xf6\x71\x45\xdc\xf4\x2f\xe9\x8a\xb4\x4c\xa4\x43\x11\xf7\xe2\xec\xef\xe8\xa5\x22\xfd\x7f\x6f\xfc\x87\x78\xe3\x37\xba\xe2\x0e\x9f\xe9\x3e\x69\xd9\x69\xff\xbb\x2d\x95\x16\x88\xcc\x3a\xd4\x80\xa5\x6e\x7b\x54\xf7\xc6\x6e\x09\xd2\x85\xae\x66\x10\x70\x14\x65\xcb\xb0\x6d\x6e\xd9\xb6\x6d\xa6\x97\xb3\xe0\xc9\x10\xd5\x31\x22\x6d\x57\xaf\x58\x79\x15\x56\x8e\xf8\xb2\xb1\xf7\x78\xb3\xb7\xdb\x66\x7d\xee\x01\x96\xc9\x1c\xf1\xcb\x55\x01\x22\x55\x57\xf8\x1d\x5f\x9c\x5d\x83\x79\xa1\x85\x58\x89\x48\x7f\x8d\x96\x2d\xdd\xdb\xb4\x8b\x33\x5f\x28\xf8\xd7\xa1\x51\x84\x07\x3a\xd2\x79\x75\xdd\xf5\x08\x4b\xa3\x5f\xa3\xa0\xc7\xc8\xc6\xd2\xeb\xde\x13\x53\xc2\x36\xf5\x6f\xd1\xbb\xd5\x3d\x6a\xb3\x53\xe1\x47\x11\x0e\x85\x25\x38\x7e\xb7\xb3\x91\x75\xb0\xe3\x21\x8f\xa3\xfd\xdb\xfa\x00\x3b\x9c\x6f\x47\x6b\x60\xc0\xe1\xcc\x16\xbb\xd3\x17\xbf\xc7\xb6\x8e\x1b\x2c\xe0\xa2\x48\xd9\x7b\x7a\x9c\xbc\x70\x4f\xda\xf6\x40\x76\x65\xaf\x0b\xbb\x9c\xbe\x72\x97\x7e\x4d\xf3\xd2\x3b\xd7\xf5\x0c\xb2\x25\x95\x1c\xd3\x90\x42\x04\x5a\xd4\x94\x7a\xd1\xd5\xdf\x87\x3a\xcf\x2f\xa4\xfe\x8f\x7f\x0f\x2e\x23\x51\x7d\x9f\x15\xaf\xce\xc8\x35\xdd\x2b\x54\xdc\x85\x8e\x77\x71\x46\x9b\xac\x7e\x5b\x67\x76\xd0\x85\xdc\x09\xbc\xb5\x90\x4d\x14\x02\xdf\xb0\x07\x2b\xb6\xe2\x69\x9f\x24\x5a\x41\x4f\xe1\xea\x75\xf8\x6c\xd4\xca\xd9\xe6\xe1\xbd\xb9\x17\x8e\x9d\xa6\x59\x37\x33\xf3\xaa\x54\xe0\x4d\xc5\xba\x69\x42\x59\x99\xc7\x97\x16\x43\x51\x6b\x7c\x79\x06\x5b\x5e\x5e\xa2\x43\xd0\x92\x62\x89\xec\x17\xb5\x8e\xcd\x9f\x8d\xa0\xd8\xac\xd9\x53\xdf\xfc\x0f\xc5\x12\x7e\xff\x1d\x38\x8e\x87\x0f\xf0\x5b\x6a\xbb\x1d\x67\xfe\x58\x9a\x37\x33\xc2\xbe\xad\xa2\x92\x00\x1d\xf4\xa8\xa8\xf5\xb8\xd3\x6b\x8e\xb8\x90\x8e\x02\x21\x2d\x01\x42\x0e\xe2\x17\xf2\x5b\xd1\x0b\xd9\xc3\x5e\xd4\xf6\x51\x9f\x09\xb1\xbd\xf7\x8d\xa7\xd5\x62\x0c\x63\xe4\x7b\x0c\x63\xea\xa4\x8d\xc9\x9a\x60\xec\xd4\x3c\xf6\x5a\xd9\xff\xad\xe3\x7c\xf5\x7a\xc5\x48\x4f\xe6\xd5\x63\xd7\x4e\x22\x21\xbf\x4c\x91\x90\x01\x41\xde\xf8\x3a\x64\x91\x0c\xff\x76\x54\x61\x50\xf6\x7a\x4a\xd5\x95\x13\xdc\x75\x47\x4b\xfb\xe9\x05\x61\x81\xc0\x97\x75\xa4\x15\x65\x7b\xb4\x0e\x64\x57\x43\x2e\xae\xfb\x83\xc0\x0e\xa0\x65\x87\xcb\x71\x58\x5d\xd9\xb1\xeb\xee\xf2\x76\xbc\x7d\x4a\xdd\x52\x89\x4d\xe0\xd6\x85\x7a\x77\xda\x3e\x8a\x53\x90\xc7\x50\xfe\x75\x6f\x73\xb7\xde\xd0\xfc\x46\x06\x62\x04\x01\x74\xd5\xee\xcf\xf2\x31\x0a\xe6\xb7\xf6\x7e\x86\x48\xa3\xe5\xc1\x9b\x29\xab\xfd\x20\x08\x5f\x9c\x5d\x48\x27\x25\x1f\x4c\xa5\xcb\x79\x7c\xff\xdd\x00\xb2\x7f\x93\xb1\xf5\xee\x63\xdb\xb5\xbb\x3b\xd4\x83\x13\xdd\x61\xb0\x3b\xed\x53\x5d\x63\x32\x48\x8e\xba\xc2\x4a\xf5\x7a\xb4\x69\x2f\xdb\x44\x13\xd8\x4c\x4f\x32\xa4\xc6\xf6\x81\x14\x89\x49\xba\xcc\xc0\x9a\x4e\xaf\xe9\x18\x66\x1c\xf4\x97\x55\xd8\x7b\xb4\x8f\xbb\x0d\xf0\xee\x2b\xd3\xd6\x84\xf6\x58\x3c\x03\x19\xa0\xf6\x0f\x99\xdd\xb3\x16\x1e\x7f\x7c\x90\xe7\xef\xad\x37\x85\xe9\xd4\x96\x74\x65\x28\x0b\x43\x32\x86\x32\xb1\xfd\x12\x98\x1d\xd2\x10\x19\x64\xcb\xf6\x6d\xbc\xb8\xee\xb2\xf8\xde\x31\xf9\x06\x97\x75\xac\x23\xea\x78\x26\x79\xe5\x61\xb6\xb4\xee\x65\xe9\xbd\x3a\xcc\x96\x81\x3f\x86\xa3\x33\x8f\xb1\x27\xbc\x7d\xad\xfc\x9f\xc8\xc2\x1d\x5f\xdf\x60\xe3\xf8\x9c\x4e\x2c\xe4\xd1\x92\x3f\xc1\x78\x58\x05\xe3\xef\x6e\xf3\x72\x8b\x19\x7f\x4d\xdd\xb0\xcd\x62\x43\x5b\x7d\x96\xa5\x0e\x57\x04\x68\x40\x5e\x0e\x5e\x0f\xed\x84\x2b\x2a\x70\x9d\x57\xaf\x31\x8e\xcd\xbf\x35\x0a\x2d\xcf\xb7\xb3\xad\xb0\x90\x66\x47\xea\x64\x57\xb6\xfc\x8c\x64\x79\xa3\x9c\xed\x26\xc1\xcd\x3f\xca\xb8\x6d\x44\xe8\x9a\x89\xb7\xc3\x20\x6e\x74\x53\xb2\x6d\x66\xbe\x97\x6d\x0b\x85\x1b\x29\x5d\x43\x7d\x0d\x9b\x78\x98\x89\x38\x65\x63\x30\xf9\xfb\xf8\x5c\x8f\xb8\xc3\x6c\x39\x4c\xe1\x6e\x27\xf3\x85\x85\xb9\x0d\x85\xa6\x91\x6d\x41\x14\x04\xca\x1d\x50\xf0\xc4\xe9\xe4\x68\xde\x5b\xed\xc8\xde\x7f\x32\xba\x35\x0d\xf4\x4d\x0a\x56\x75\xfe\x96\xf4\xb4\x5a\xb4\x0d\x0c\xba\x4b\x0e\x67\x1d\x81\x76\x5e\xd6\x79\xae\xb1\xf0\x0a\x96\xb8\x34\xd5\xaf\x12\x19\xdc\x32\xf5\x73\xc5\x33\xf1\x18\x6c\xc1\x72\x6f\x6c\x7b\x3a\x68\x87\x84\xcb\x97\x72\x06\x11\x11\xe7\x3b\x7f\x41\x03\xc9\xc8\x18\xdf\x39\xbb\x7d\x22\xcf\xb1\xb2\x86\xa6\x39\xf4\xa2\x41\xb0\x2c\xe0\xc7\x0a\x6c\xbd\x3e\x02\x2e\x53\x68\x9a\xd1\xff\x0d\x00\x37\x03\x63\xae\xfc\x41\x00\x00")
 
 func templateDialectSqlQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -801,12 +801,12 @@ func templateDialectSqlQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 10665, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 16892, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x6e\x13\x31\x10\x86\xcf\xeb\xa7\xf8\xa9\x2a\x94\x8d\x82\x53\x7a\x03\xd4\x43\x89\x8a\x54\x09\x21\x41\x78\x81\xad\x3d\xdb\x58\x18\x3b\x3b\xf6\xa6\x89\x56\x7e\x77\x34\x9b\x25\x0d\x55\x2f\x1c\x22\x4f\xfc\x7f\x33\xff\x8c\x67\x87\x61\x39\x57\xab\xb8\x3d\xb0\x7b\xdc\x64\x5c\x5f\xbd\xff\xf0\x6e\xcb\x94\x28\x64\x7c\x69\x0c\x3d\xc4\xf8\x0b\xf7\xc1\x68\xdc\x7a\x8f\x11\x4a\x10\x9d\x77\x64\xb5\xfa\xb9\x71\x09\x29\xf6\x6c\x08\x26\x5a\x82\x4b\xf0\xce\x50\x48\x64\xd1\x07\x4b\x8c\xbc\x21\xdc\x6e\x1b\xb3\x21\x5c\xeb\xab\xbf\x2a\xda\xd8\x07\xab\x5c\x18\xf5\xaf\xf7\xab\xbb\x6f\xeb\x3b\xb4\xce\x13\xa6\x3b\x8e\x31\xc3\x3a\x26\x93\x23\x1f\x10\x5b\xe4\x33\xb3\xcc\x44\x5a\xcd\x97\xa5\x28\x35\x0c\xb0\xd4\xba\x40\xb8\xb0\xae\xf1\x64\xf2\x32\x75\x7e\x99\x48\xc2\x0b\x94\x22\xc4\xe5\x43\xef\xbc\xf4\xf3\xf1\x06\xdb\x26\x99\xc6\xe3\x52\xaf\x4d\xdc\x92\xfe\x3c\x29\x13\xc8\x64\xc8\xed\x8e\xe4\x29\x3e\xa5\x8b\x61\xdb\x07\x83\xd9\x3f\x6c\x29\x98\x9f\xbb\x94\x52\x23\x75\x7e\x6d\x9a\x30\x33\x79\x0f\x13\x43\xa6\x7d\xd6\xab\xe3\xb9\xc0\x0e\x2e\x64\xe2\xb6\x31\x34\x94\x1a\xc4\x1c\x19\x83\xaa\x38\x3e\x25\x71\x7e\x9b\x3a\xaf\x7f\xc4\xa7\x34\x14\x55\x75\x3d\xf1\x61\x81\x86\x1f\x47\xed\x85\xb3\x4e\x9d\xff\x2e\xc4\xac\xd6\xd3\xa9\x2a\xd7\x4a\xcd\xd7\x68\xcb\x12\x4d\xa4\xc9\xfb\x05\xce\xca\x2f\x20\x0d\xd4\x9f\xc6\xe4\x37\x37\x08\xce\x4b\x57\x15\x53\xee\x39\xc8\xad\xaa\x8a\xaa\x2c\xb5\xc4\x23\xaa\x57\x3e\x26\x12\xc7\x09\x91\xbe\x65\xec\xb5\x6c\x7a\x26\xc8\x02\xbb\x5a\x15\xf5\x3f\xef\x36\x8d\x31\x16\x93\xd8\xd1\xf8\x38\xc7\x95\xc6\x57\xc7\x4a\x9d\x7f\x06\xf4\x7a\x0c\x66\xa7\xff\xab\xe8\xfb\xdf\x21\xbd\x34\xd7\xad\x23\x6f\x93\xd6\xba\x96\xdf\xf3\x10\x53\x9e\x1a\x3f\x09\x0a\x56\xf6\xfe\x27\x00\x00\xff\xff\xec\xf4\x7b\x6f\x2c\x03\x00\x00")
+var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x51\x6b\x14\x31\x10\xc7\x9f\x37\x9f\xe2\x6f\x29\x72\x5b\xae\xb9\xda\x37\x95\x3e\x9c\x47\x0b\x05\x11\xf4\x7c\x13\x91\x34\x99\xdc\x05\x63\xb2\x97\x64\xaf\x2d\x4b\xbe\xbb\x24\xbb\x57\xce\x5a\x04\x9f\x32\xcc\xfc\x66\xfe\x33\x93\x19\x86\xc5\x19\x5b\xf9\xee\x31\x98\xcd\x36\xe1\xf2\xe2\xcd\xdb\xf3\x2e\x50\x24\x97\x70\x23\x24\xdd\x79\xff\x13\xb7\x4e\x72\x2c\xad\x45\x85\x22\x4a\x3c\xec\x49\x71\xf6\x75\x6b\x22\xa2\xef\x83\x24\x48\xaf\x08\x26\xc2\x1a\x49\x2e\x92\x42\xef\x14\x05\xa4\x2d\x61\xd9\x09\xb9\x25\x5c\xf2\x8b\x43\x14\xda\xf7\x4e\x31\xe3\x6a\xfc\xe3\xed\xea\xfa\xd3\xfa\x1a\xda\x58\xc2\xe4\x0b\xde\x27\x28\x13\x48\x26\x1f\x1e\xe1\x35\xd2\x91\x58\x0a\x44\x9c\x9d\x2d\x72\x66\xac\xcc\x80\xa5\x52\x26\x19\xef\x84\x85\x36\x64\x55\x84\xf6\xa3\xf8\x5d\x6f\xac\xa2\xc0\x51\xe9\x61\x80\x22\x6d\x1c\xe1\x44\x19\x61\x49\xa6\x45\xdc\xd9\x45\xa4\x6a\x8e\xa9\x27\xc8\x99\x35\xda\x45\x7c\xfb\xbe\xdc\x6c\x02\x6d\x44\xa2\x9b\xde\x49\x36\x0c\xe7\x20\xa7\x30\xca\xfe\xa3\x52\x2d\x31\x0c\x38\x9d\xd4\xf1\xee\x0a\x9d\x88\x52\x58\x9c\xf2\xb5\xf4\x1d\xf1\x0f\x53\x64\x02\x03\x49\x32\xfb\x91\x7c\xb2\x9f\xd2\x8b\xa0\xee\x9d\xc4\xec\x0f\x36\x67\x9c\x1d\xab\xe4\xdc\x22\xee\xec\x5a\x0a\x37\x93\xe9\x01\xd2\xbb\x44\x0f\x89\xaf\xc6\x77\x8e\x3d\x8c\x4b\x14\xb4\x90\x34\xe4\x16\x14\x82\x0f\x18\x58\x13\xfc\x7d\x2c\xca\xaf\xe3\xce\xf2\x2f\xfe\x3e\x0e\x99\x35\xbb\x9e\xc2\xe3\x1c\x22\x6c\x6a\xec\x99\x32\x8f\x3b\xfb\xb9\x10\xb3\x96\x4f\x2f\x6b\x8c\x2e\x35\x5f\xa2\x55\x28\xd6\x44\xca\xf4\x30\xc7\x51\xf9\x39\x4a\x03\xed\xfb\x9a\xfc\xea\x0a\xce\xd8\xd2\x55\x13\x28\xf5\xc1\x15\x2f\x6b\x32\x6b\x14\x69\x0a\x15\xe5\x2b\xeb\x23\x15\xc5\x09\x29\x7d\x97\xb1\xd7\xe5\xc0\x66\x05\x99\x63\xdf\xb2\xcc\xfe\x67\x6f\xd3\x18\xb5\x58\xb1\x0d\xd5\xe5\x8c\x5f\xea\x5f\x1c\x2b\xee\x2c\x6b\xa4\xb7\xfd\x2f\x57\x97\x74\x60\xf9\x6a\xf4\x3d\xd7\xe5\xe3\x89\x71\xce\x5b\xd6\x94\x13\xfd\x31\x87\x76\xf5\xd3\x85\xdb\xd0\x5f\xf5\xcb\x15\x96\x4d\x1c\x24\xae\x20\xba\x8e\x9c\x9a\x4d\x8e\x92\x3d\x3b\x88\xb6\x6d\x5d\xd3\x53\x0f\xeb\x6a\x1c\xd0\x51\xf3\xb0\xaf\x89\x61\xf5\xfa\xa6\x9b\xfe\x1d\x00\x00\xff\xff\x9a\x91\x25\x69\x0e\x04\x00\x00")
 
 func templateDialectSqlSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -821,7 +821,7 @@ func templateDialectSqlSelectTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 812, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 1038, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -846,7 +846,7 @@ func templateDialectSqlTxTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdf\x6f\x1b\xb7\x0f\x7f\xb6\xff\x0a\xf6\x10\x04\x76\xe0\x5e\xf2\xed\xdb\xd7\x85\x07\xa4\x49\x3a\x18\x6b\xd3\x2e\x4e\xfb\xb0\xa2\x08\x94\x13\xcf\x11\x22\x4b\x17\x49\x97\xa5\x33\xfc\xbf\x0f\xd4\x8f\xf3\x39\x67\xa7\xcd\x3a\x60\x0f\x71\x74\x22\x29\x92\x1f\x52\x14\xb9\x5c\x1e\x1e\xf4\x4f\x74\xf5\xcd\x88\xf9\x8d\x83\x57\x47\xff\xfb\xff\xcb\xca\xa0\x45\xe5\xe0\x2d\x2b\xf0\x5a\xeb\x5b\x98\xaa\x22\x87\x63\x29\xc1\x33\x59\x20\xba\xb9\x47\x9e\xf7\x2f\x6f\x84\x05\xab\x6b\x53\x20\x14\x9a\x23\x08\x0b\x52\x14\xa8\x2c\x72\xa8\x15\x47\x03\xee\x06\xe1\xb8\x62\xc5\x0d\xc2\xab\xfc\x28\x51\xa1\xd4\xb5\xe2\x7d\xa1\x3c\xfd\xdd\xf4\xe4\xec\x7c\x76\x06\xa5\x90\x08\x71\xcf\x68\xed\x80\x0b\x83\x85\xd3\xe6\x1b\xe8\x12\x5c\x4b\x99\x33\x88\x79\xff\xe0\x70\xb5\xea\xf7\x97\x4b\xe0\x58\x0a\x85\x90\x71\xc1\x24\x16\xee\xd0\xde\xc9\xc3\xba\xe2\xcc\x61\x06\xab\x15\x71\xec\x55\xb7\x73\x18\x4f\x60\x2f\x9f\x15\xba\xc2\xfc\x23\x2b\x6e\xd9\x1c\x13\xf5\xba\x16\x92\xac\x1d\x4f\xa0\x62\xb6\x60\xb2\x61\x7c\x13\x29\x91\xd1\x60\x81\xe2\x3e\x70\x36\xeb\x46\x3c\x32\x2d\x6a\xc7\x9c\xd0\xca\x1f\x67\x84\x72\x2d\xb9\x2c\x4f\xd4\xc6\x34\xad\x90\x38\x6f\x98\x9d\xd5\x65\x29\x1e\xd6\xe7\x65\x1f\x54\xf2\xe0\x25\xec\xfd\x85\x46\x13\xe3\x11\xac\x56\xcb\x25\x88\x32\x88\xfa\x8f\x40\x9c\x40\xa6\x84\xcc\xc2\x16\x2a\xde\x88\x1a\x74\x24\x99\xa9\x6c\x9b\x2c\x51\x09\x9a\x8b\x64\x64\x5b\xbe\x5f\xd6\xaa\x80\xc1\x86\xf3\xab\x15\x1c\xb4\x61\x5b\xad\x86\x60\xef\xe4\x8c\xdd\xe3\xa0\x70\x0f\x50\x68\xe5\xf0\xc1\xe5\x27\xe1\xff\x30\x89\x3b\x92\xdc\x50\xef\x8f\xc9\xcf\xd9\x22\xda\x82\xd2\xd2\x4a\x28\xd7\x58\x30\x02\x34\x86\xfe\xb4\x19\xc2\xb2\xdf\xbb\xb2\x15\x16\xe4\xcd\xbe\xbd\x93\x73\xc3\xaa\x9b\xfc\x93\x8f\xf5\xac\xc2\x62\xd9\xef\xf5\xce\x35\xc7\x71\x8b\x4a\xdf\x89\xd6\xbb\x64\xd7\x12\xc7\xe0\xd5\xae\x93\x20\xf7\xdb\x23\x62\x38\xd1\xb2\x5e\x28\xdb\x65\x89\x04\xcf\x34\x3d\x6d\x2b\x78\x2b\x50\xf2\x46\x43\xef\xf2\x5b\x85\x63\x28\x69\x33\xf7\x87\x4c\x4f\x73\xda\x23\x38\xac\x8b\xbe\xfa\x63\xa2\xb2\xae\xae\x24\xe6\x25\x98\x72\x49\xc0\xff\xd2\xcf\xaa\xdf\xa3\xc0\xae\x81\xec\xf7\x7a\x82\x8f\x40\xdf\x12\x32\x1b\x49\xd8\x3a\xee\x7d\xdc\xfb\xd5\x47\x62\x30\x24\xa1\x12\x5e\xe8\x5b\xf0\x96\x1b\x74\xb5\x51\xd0\xa4\x13\x61\xbf\xff\x99\x49\xc1\xbd\xd4\x19\x85\x60\x49\xf6\x8f\x21\x9b\x9e\x66\x3e\x30\x63\x28\x17\x2e\xf7\xa4\x72\x90\x2d\x84\xb5\x42\xcd\xa1\x1d\xd5\x7c\x7a\x0a\xa5\x36\x10\x2f\xe4\x90\x4c\xa5\x3f\x1f\x47\x1f\x1c\x32\xed\x33\x93\x35\xc2\x04\x04\x0f\x9e\xc5\x44\x08\x16\x56\x36\x79\xd5\x4a\xc1\xbc\x32\xc8\x45\xc1\x1c\xda\xd7\x20\x51\x0d\x2a\x3b\x84\x5f\xe0\x28\xf8\x12\x4e\xff\x98\x58\x60\x02\x94\xc7\x03\x8b\xd2\x57\x14\x38\xb0\x77\x32\x9f\xc5\xaf\x61\x90\xe9\x91\x99\xc2\x5f\x6d\xa6\xe6\x48\x6a\xc3\x7e\xaf\xb2\x5f\xc4\xd7\x46\x78\xe8\x37\x57\xfd\xf8\x13\x63\x11\xef\x8b\x5f\x07\xf9\xbd\x32\x94\x1c\x9f\x1f\x36\x78\x93\xc2\xa6\x0d\x0c\x94\x76\xb0\x57\xe6\xd3\x05\xc5\xea\x5a\xe2\x90\xbe\x42\x2e\x9f\x62\xc9\x6a\xe9\xa2\x0c\x61\x70\x4f\x00\x3d\x15\xe0\xb2\x13\xde\xd7\x90\x22\x9b\xf0\x08\x96\xe4\x33\x7f\xe1\x59\x55\xa1\xe2\x83\xc7\x94\xd1\xee\xcc\xee\xe6\x76\xb9\x2b\xb3\x7b\x3d\x1f\xd1\x71\xb4\x3b\xee\x3d\x95\xef\x65\x27\xdb\x7b\xbd\x55\x0b\xea\x84\x1c\x53\xbc\xd1\x7b\x5e\x2f\xd0\x88\x22\x0a\xdf\xa3\x71\xc8\x2f\xf5\x1b\x66\x45\x91\xa0\xfb\x2e\x76\xc7\x9c\x23\x0f\x06\xcc\x9c\xa9\x0b\xe7\x9d\xee\x00\xb8\x89\xe0\x31\xe7\x3b\x10\x3c\xe6\xfc\x49\x04\x9f\x03\xe1\x56\x0c\x9f\x0d\x62\x42\xb1\x05\x63\xca\xd5\x6d\x5f\x54\x53\xca\xfc\x43\x45\xf8\x30\xd9\xca\xc0\xed\x49\xb7\x89\xd9\x89\x44\x66\x90\x0f\x86\x5b\xf3\xce\x53\x77\xe0\xe6\x69\xff\x56\xee\xfd\x4c\x9e\x3d\xbe\xcb\x5b\xee\xf5\xd5\x08\xf6\x30\xdc\xed\x33\x3e\xc7\x78\xb5\x13\x78\x98\x7f\x52\xe2\xae\x8e\xe5\x6b\x17\x72\xf8\x1d\xe4\xe8\xb4\x3f\x85\xbb\x01\x7c\x70\x64\xc2\x1e\x64\xa4\x2b\x23\xcd\x29\xb5\x97\x4b\x70\xb8\xa8\x24\x15\xb8\x8d\xe6\x87\x63\x89\x9e\x39\x4f\xbc\x9b\x71\x8e\x61\xf1\xc6\x6f\x8f\x4a\x8b\x34\x02\x3a\x6b\x98\xca\xdd\x66\x75\x26\xf7\x94\xe6\x68\xb7\x5d\xad\x0b\x5c\xe8\xfb\x70\xb9\x1e\xbb\x3b\x3d\xb5\x74\xbf\xa8\x6e\x7b\xf1\x56\xe9\x7e\xd2\xf5\x8c\x1e\x0c\x9b\x81\x33\x35\x42\xf6\x07\x1a\x9d\x35\xaf\xd5\x7f\x0d\x4a\x3a\xe9\x29\x48\x9e\x89\xc5\x4f\x41\xf1\xe3\x48\x6c\x02\xd1\x76\x76\x4b\xa1\x6b\x08\x6b\x0c\xb6\x5c\x95\x8d\xd6\xa4\xd5\xfe\x4d\x60\x7f\xa3\xe7\x2b\xb4\x2a\xc5\x7c\xdc\x79\xdd\xc3\xfe\xba\x51\x38\xb6\x56\xcc\x15\xa4\x36\x80\xce\xca\x99\xdf\xf3\x45\xd2\x36\x8c\xb3\x82\xc5\xad\x4d\x66\xdb\xec\x53\xe3\xf3\xa4\xb9\xa2\xf4\x3d\xe7\x04\x1e\x75\x98\x04\x38\x35\xb8\xa3\x8e\xb5\xdc\xd0\x6a\x04\xde\x84\xe1\x6b\x2f\xfe\x62\x02\x4a\x48\x8a\x63\xa7\xa1\x59\x9b\x35\xda\xad\xc9\xfe\x63\x55\xad\x44\xbc\x4a\xcf\x1e\x1a\x93\x0f\x0e\x5a\x4d\xb1\x7b\x4b\x33\x98\xef\xdc\x5a\x0f\x5d\xb0\x66\x7f\x83\xbc\xec\xd4\xd1\x77\xec\x1a\xa5\xef\x7d\x82\x5f\xa2\x84\x02\x8d\x49\xba\x84\x9d\xfd\xfe\xce\x57\x59\xc3\x84\x72\xfe\x90\x01\x9a\xae\x1e\x12\x8a\xed\xe0\xb6\xe6\xd3\x53\x57\xfd\x36\x2d\xa1\xa6\x84\xec\xfb\xf1\x29\x8d\x29\x3b\xc6\xc0\x26\xd5\x53\xa0\x53\xe1\x0e\xe3\x1d\xe5\x32\xbc\x24\x1a\x71\x6d\x4e\x15\x44\x4b\xef\xcf\x05\xca\xf1\x3a\x46\xe1\x12\x5f\xa0\xf4\x2f\x50\x7c\x46\xa6\xd4\x7f\xd8\x38\x5b\x60\x3e\xb5\x71\x23\x92\x77\x0c\x1e\x81\xd9\x13\x1f\x3d\x4b\xed\x41\x24\x3c\x2b\xef\x5f\xbd\x8f\x13\x5b\xf7\x84\x8f\xbf\xb5\xc4\xd7\x83\xd4\x97\xaf\xd6\x19\xa1\xe6\xdd\x10\x06\xb1\xa0\xa4\x25\x0a\xeb\xd1\x8f\x8c\x78\x23\xb8\x48\x1e\xd1\xba\x71\xc6\xcc\xd1\x8d\x1f\x81\x15\x76\x97\x61\x40\x22\xe4\x9e\x31\x24\x61\x78\xcc\x7f\x6c\x54\x8a\xcc\x5d\x18\xe3\x11\xdf\x1b\x9b\x7c\x45\x4d\x29\xe0\xaf\x5a\xb8\x2f\xd4\xff\x5f\x8d\xe0\x76\x3d\x02\x84\x3a\x1e\x32\x96\xcf\x29\x50\xe4\x62\x94\x69\xea\x62\x87\x34\x82\xdb\x6e\x59\x6c\x2d\xff\x0e\x00\x00\xff\xff\x28\x60\xab\x61\x78\x11\x00\x00")
+var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\x96\x3f\xc5\xac\xe0\x16\x72\xe0\xaa\xbd\xbe\x9d\x17\x3e\x20\x4d\xd2\x83\xaf\x4d\x9a\x8b\xb3\xfb\x70\x45\x51\x30\xd2\xc8\xe1\x45\x26\x15\x92\xf6\x36\x67\xe8\xbb\x1f\x86\x22\x65\xca\x8a\xd3\xb8\xb7\xb7\xbb\xc0\xb6\x16\x67\x86\x33\xf3\xe3\xfc\x21\xa7\x9b\xcd\xeb\xa3\xc1\x89\xac\x1e\x14\x5f\xdc\x1a\x78\xfb\xe6\x2f\x7f\x7d\x55\x29\xd4\x28\x0c\xbc\x67\x19\xde\x48\x79\x07\x33\x91\xa5\x70\x5c\x96\x60\x99\x34\x10\x5d\xad\x31\x4f\x07\xd7\xb7\x5c\x83\x96\x2b\x95\x21\x64\x32\x47\xe0\x1a\x4a\x9e\xa1\xd0\x98\xc3\x4a\xe4\xa8\xc0\xdc\x22\x1c\x57\x2c\xbb\x45\x78\x9b\xbe\xf1\x54\x28\xe4\x4a\xe4\x03\x2e\x2c\xfd\xe3\xec\xe4\xec\x62\x7e\x06\x05\x2f\x11\xdc\x9a\x92\xd2\x40\xce\x15\x66\x46\xaa\x07\x90\x05\x98\x40\x99\x51\x88\xe9\xe0\xe8\x75\x5d\x0f\x06\x9b\x0d\xe4\x58\x70\x81\x10\xe7\x9c\x95\x98\x99\xd7\xfa\xbe\x7c\xbd\xaa\x72\x66\x30\x86\xba\x26\x8e\x61\x75\xb7\x80\xc9\x14\x86\xe9\x3c\x93\x15\xa6\x97\x2c\xbb\x63\x0b\xf4\xd4\x9b\x15\x2f\xc9\xda\xc9\x14\x2a\xa6\x33\x56\xb6\x8c\xef\x1c\xc5\x31\x2a\xcc\x90\xaf\x1b\xce\xf6\xf7\xf0\xa6\xcb\xb4\x5c\x19\x66\xb8\x14\xc4\x54\x29\x2e\x4c\x20\x17\xa7\x9e\xda\x9a\x26\x05\x12\xe7\x2d\xd3\xf3\x55\x51\xf0\x6f\x5b\x73\xe2\x4f\xc2\x7b\xf0\x0a\x86\xff\x41\x25\x89\xf1\x0d\xd4\xf5\x66\x03\xbc\x68\x44\xed\x47\x43\x9c\x42\x2c\x78\x49\x12\x9b\x0d\xa0\xc8\x5b\x51\x85\x86\x24\x63\x11\x3f\x26\x4b\x54\x82\xe6\xca\x1b\x19\xca\x0f\x8a\x95\xc8\x20\xe9\x38\x5f\xd7\x70\x14\xc2\x56\xd7\x23\xd0\xf7\xe5\x9c\xad\x31\xc9\xcc\x37\xc8\xa4\x30\xf8\xcd\xa4\x27\xcd\xdf\x23\x2f\x6e\xa0\xae\xa1\xa3\xde\x6e\x93\x5e\xb0\xa5\xb3\x05\x4b\x4d\xbf\xb8\x30\xad\x05\x63\x40\xa5\xe8\x7f\xa9\x46\xb0\x19\x44\x5f\x75\x85\x19\x79\xf3\x52\xdf\x97\x0b\xc5\xaa\xdb\xf4\x17\x7b\xd6\xf3\x0a\xb3\xcd\x20\x8a\x2e\x64\x8e\x93\x80\x4a\xdf\x9e\x16\x5d\xb3\x9b\x12\x27\x64\xc4\x30\x08\x82\xd4\x2e\x8f\x07\x51\x14\x9d\xc8\x72\xb5\x14\xba\xcf\xe2\x08\x96\x69\x76\x1a\x2a\x78\xcf\xb1\xcc\x5b\x0d\xd1\xf5\x43\x85\x13\x28\x68\x31\xb5\x9b\xcc\x4e\x53\x5a\x23\x38\xb4\x71\xbe\xda\x6d\x9c\xb2\xbe\x2e\x2f\x66\x25\x98\x30\x5e\xc0\xfe\x49\x7f\xd4\x83\x88\x0e\x76\x0b\xe4\x20\x8a\x78\x3e\x06\x79\x47\xc8\x74\x82\x30\xd8\xee\xdc\xad\xfd\x1d\x69\xc7\x64\x44\x42\x05\xfc\x24\xef\x08\xd7\x28\x52\x68\x56\x4a\x40\x1b\x4e\x75\x3d\x86\x97\xbf\xb2\x92\xe7\x56\xea\x8c\x8e\x60\x43\xf6\x4f\x20\x9e\x9d\xc6\xf6\x60\x26\x50\x2c\x4d\x6a\x49\x45\x12\x2f\xb9\xd6\x5c\x2c\x20\x3c\xd5\x74\x76\x0a\x85\x54\xe0\x12\x72\x54\x93\x0b\x83\xa8\x39\x47\x7b\x38\xe4\xe9\xaf\xac\x5c\x21\x4c\x81\xe7\x8d\x67\x2e\x10\x1a\x0b\x2b\xed\xbd\x0a\x42\x30\xad\x14\xe6\x3c\x63\x06\xf5\xcf\x50\xa2\x48\x2a\x3d\x82\xbf\xc1\x9b\xc6\x97\x66\xf7\x4b\xcf\x02\x53\xa0\x38\x4e\x34\x52\x81\x90\x0a\x8e\xf4\x7d\x99\xce\xdd\x97\x8d\xab\x28\x8a\xc8\x4c\x4e\xaa\x14\x13\x0b\x84\x4a\xbb\xf5\xa8\xd2\x9f\xf9\x97\x56\x98\x70\x6b\x7c\x88\x9c\x33\xd6\x62\x1b\xad\xcd\xef\x46\x7e\x58\xd0\x5e\xc3\x26\x3e\xb4\x25\x46\xfe\xd8\xa4\x82\x44\x48\x03\xc3\x22\x9d\x2d\xe9\xac\x6e\x4a\x1c\xd1\x57\x13\xcb\xa7\x58\xb0\x55\x69\x9c\x0c\x61\xb0\x26\x80\x9e\x3a\xe0\xa2\x77\xbc\x3f\x83\x3f\x59\x8f\x47\x63\x49\x3a\xb7\x09\xcf\xaa\x0a\x45\x9e\xec\x52\xc6\xfb\x23\xbb\x1f\xdb\xc5\xbe\xc8\x8e\x22\x7b\xa2\x13\x67\xb7\x5b\x7b\x2a\xde\x8b\x5e\xb4\x3b\xb4\x5e\x1f\xc1\x99\xc8\xd4\x43\x65\x30\x6f\x54\x6b\xf8\x4d\xb1\x8a\xfa\x04\x57\xb0\x64\x4a\xdf\xb2\xd2\x9e\x2f\x79\x0f\xbf\x71\x73\x0b\xd8\x48\xfc\x63\xfe\xe9\x62\x0c\x99\x5c\x52\x57\xd3\x81\x3c\xf1\x50\x08\x9c\x38\xd2\x18\x98\xd8\xa1\x4a\x45\x0a\xef\xf0\x21\x60\x9f\x4b\x65\x3e\xe0\x83\x4e\xc1\x36\x9f\xad\x91\x43\xaf\x83\x8e\x27\x76\x65\x76\x48\x35\x38\xf8\xb6\xdb\x0c\x8b\x94\xac\x72\x2e\x7d\xc0\x07\xc7\xdb\x6e\xe0\x9a\x46\x01\xf1\x0b\x9d\x06\x7e\x24\x2f\xee\xc7\x10\x07\x39\x90\x06\x5a\xa6\x10\x8f\xe2\x4e\xe1\xde\xda\x16\xaa\xf5\xfe\x12\x50\x4f\xe8\x0d\xa1\xf1\x7a\x5b\xc6\xae\x5e\x6b\xac\xd5\x6e\x0d\x79\xdc\x04\x2a\x54\x45\x3a\xd3\x64\x02\x61\x88\x39\xa1\xb8\xc7\x82\x60\x25\x0e\x41\x4f\xe2\x1f\x55\x7c\xde\xc4\x08\xaa\x2d\x31\x72\x6b\x13\xe8\x98\x50\xd7\x14\x47\xc9\x1a\xb8\x30\xa8\x0a\x96\xe1\xa6\x1e\x41\xf2\xf9\xcb\xcd\x83\xc1\x71\xd0\x86\xdc\x7f\x41\xcd\xec\x07\x74\xab\xd6\xa5\x46\xb2\x4e\x93\x6d\xd6\x40\x5d\x8f\x46\x7e\xa3\xd6\xaf\x6e\xec\xdb\x32\x18\x82\x77\x21\xc5\x7b\x2e\xb8\xc1\x67\x78\x42\xd8\x39\x5a\x2b\xf6\xb4\x1a\xca\x81\x56\xd5\xb6\x40\xd9\x4f\x9b\xcf\xf3\x8c\x09\x81\x6a\xf4\x0c\xed\xbb\xe5\xfa\xdf\x5a\x0a\xc7\xbb\xc7\x88\xe0\xec\xea\xa0\xc6\xf6\x02\x68\x26\x32\x85\x4b\x14\x86\x95\xad\x80\xaf\x90\x7a\x7f\x79\x9c\x1b\xb5\xca\x8c\x2d\x74\x50\xd7\xc7\x86\x0a\x24\xf5\x0d\x5b\xa1\xc2\xde\xd1\xb6\x8f\x73\x99\xf3\x82\xa3\xd2\xbb\xd5\xb2\x25\x8c\x6d\xd9\x49\x56\x4d\x3f\x69\x6a\xb7\xbb\x32\x06\x51\x62\xfb\xca\x18\xd6\xdb\xd6\xe2\x6c\x6d\x39\xa2\x95\x2d\x0a\x57\x58\x95\x2c\xc3\xe4\xfb\xf5\x11\xd6\x63\xdb\x79\xe7\x36\x0b\x8a\x24\xfe\xfc\x22\xff\x12\x8f\x81\x07\x21\x35\x88\x42\x2c\x03\x30\x83\x2c\xd9\xc5\xf6\x0a\x97\x72\x4d\x97\xa1\x1e\xb2\xfb\x7a\x8f\x95\xc0\xfc\x31\x8c\xbb\x2d\xe8\x77\x06\xd5\x23\x46\xda\x9f\x05\x18\x41\x3e\xfa\x11\x4c\x8e\xad\x95\x07\x81\xd2\x88\xfc\x69\xa8\x34\xea\xff\xbf\xa8\x9c\xa3\x5a\xe0\x2e\x28\x15\x33\xd9\x2d\xea\x7d\xb0\x58\x99\x3f\x1e\x14\xca\xbf\xaf\x63\xa8\x82\xab\x5d\x63\x67\x2f\x01\xad\x81\xcf\xc1\xad\xf2\x98\x45\xf5\x8f\x80\x77\x49\xfa\x77\xc1\x93\xd5\x5e\xe0\x2c\xff\x01\xe1\x74\xe9\xfc\xdb\xc1\xcd\x2d\x87\x57\x3c\xbb\x14\x5e\xf1\x9e\x7c\x94\x74\x51\xf0\x12\x9f\x2a\x3d\xb1\xd6\x1f\x00\x85\x6b\x35\xf6\xfa\x78\xb1\x5a\xa2\xe2\x99\xdb\x7e\x8d\x74\x3f\xb8\x96\xef\x98\xe6\xd9\xf3\x33\x2e\x3f\x24\xdd\xdc\x75\xf7\x38\xcf\xf7\x5c\x84\x8f\xf3\xfc\xc9\x8b\xf0\x21\x37\xe1\x47\xaf\xc2\x87\xc3\xfc\x14\xaa\xfd\xaf\x26\xda\x3e\x55\x94\x7a\xdb\x3e\xc9\x8b\x1e\x70\x8f\x61\x76\x52\x22\x53\x98\x27\x6d\x0e\x75\xb0\xb1\xd4\x3d\xb8\x59\xda\xef\xf5\x84\x38\x14\x22\x87\x50\x0f\x91\x3d\xcf\xb3\xaf\x63\x18\xda\xd1\xcb\x30\x3d\xcb\x17\xe8\x5e\x68\x1e\x3c\x4c\x7f\x11\xfc\x7e\xe5\x33\x74\x0f\x72\xf8\x1d\xe4\xda\xfb\x37\x7e\x33\x64\xc2\x10\x62\xd2\x45\x77\x56\x7f\x26\xd1\x66\x03\x06\x97\x55\xc9\xcc\xce\x0c\x2b\xc7\x02\x2d\x73\xea\x79\x43\x4f\xda\x63\xa1\x0d\xf7\x9c\x4a\x40\x1a\x03\xed\x35\xf2\xaf\xd6\xf6\xda\xd7\xba\x27\x64\x8e\xfa\x3b\x1d\x7e\xd7\xdd\xd9\xa9\xf6\xd7\x28\x2b\x1e\xde\xa2\x9e\x72\x3d\xa6\x77\xbf\x8e\xc1\xa8\x15\x42\xfc\x2f\x54\x32\x6e\x87\x0e\x7f\x36\x28\x7e\xa7\xa7\x20\x39\x10\x8b\xff\x09\x8a\xe7\x23\xd1\x05\x22\x74\xf6\x91\x42\xd7\x12\xb6\x18\x3c\x92\x2a\x9d\x09\x53\x30\xc5\x9b\xc2\xcb\xce\xe8\x2e\x93\xa2\xe0\x8b\x49\x6f\x48\xd3\xac\x6f\xe7\x3d\xc7\x5a\xf3\x85\x00\x3f\xcd\xa1\xbd\x52\x66\xd7\x6c\x91\xd4\x2d\x23\xbd\x34\x9a\xa5\x2e\xb3\x6e\xd7\x93\x51\xd7\x5c\x1a\x13\x4e\x7b\x06\x28\x34\xea\x81\xa6\x92\xee\xaa\x30\x82\xa4\x3b\x4f\xec\xbb\xe9\x07\x61\x6d\x0d\x6b\xee\x15\x14\xb2\xcd\x46\xbb\x3a\x72\x45\xbf\xc6\x60\x5d\x1c\xf5\x93\x6b\x6b\xbe\x7d\x41\xc2\xf4\xb1\xad\xf5\x33\xf7\xf6\xd6\xa1\x52\x83\xa8\x03\x00\x95\x3f\x5e\x58\x0d\x3f\x4d\x41\xf0\xd2\xba\xc7\x0b\xf8\xea\x9b\x26\x2a\x95\x26\x47\xad\xf2\x0b\x69\xde\xd3\x20\xde\x8e\xef\x82\x36\x49\x3b\x4c\xe1\x65\x87\xbc\xe9\x55\xe1\x8f\xec\x06\x4b\xf2\xaf\x6e\xdf\x8f\x19\x2a\xe5\x75\x71\x3d\xff\xe7\x47\x5b\xa3\x15\xe3\xc2\xd8\x4d\x08\xfa\x9e\x1e\x12\x72\x33\xc1\xc7\x26\x90\x96\x5a\x0f\xbc\xdb\x21\x96\x82\x97\x03\x9a\x70\x7b\x04\xf6\xfd\x5b\x40\x9b\x28\x3e\xaa\x7d\xd9\x6f\xfe\x31\x80\x32\x01\x5e\x11\x8d\x12\xa1\x3b\x5a\x26\x9a\xef\x5e\x57\x58\x4e\xb6\x27\x47\x86\x60\x7a\x85\xa5\x7f\xcc\x53\xd7\x9a\x89\x35\x2a\xed\x06\xcc\x98\xce\xb4\x5b\x70\xe4\x3d\xd3\xe7\x66\x2b\x4b\xdc\x69\x6a\xe1\x34\x9a\x52\x11\xd3\xf3\xb7\xe7\xee\x71\xdd\xdf\xe1\xf2\x43\x20\xbe\x9d\xa6\x7f\xfe\xa2\x8d\xe2\x62\xd1\x3f\x42\xfa\x46\x37\xd9\x0e\x44\x61\x3b\x4a\x21\xa7\xde\xf1\x9c\x7b\x8f\xe8\xb7\x5b\xbe\x66\x6a\x81\x26\x1c\x84\x13\x58\xcd\x2a\xc1\x15\xcd\x4e\x09\xb9\x03\x26\xe5\x68\xa1\x7c\xe6\xbc\xdc\x31\xf7\xbc\xf1\x5b\x7c\x6f\x76\x6e\xeb\xb1\x0f\x01\x9b\x80\x14\x42\xed\x63\xe1\x6e\xfb\x58\xb0\x9d\xcd\x45\x6c\xbe\xa0\x83\x22\x17\x9d\x4c\x5b\x55\x7b\xa4\x31\xdc\xf5\x8b\xea\x66\xf3\x0a\x50\xe4\x50\xd7\x83\xff\x0e\x00\x4a\x0d\x50\x04\x7d\x1b\x00\x00")
 
 func templateDialectSqlUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -861,12 +861,12 @@ func templateDialectSqlUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 4472, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 7037, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5f\x6f\xe3\xb8\x11\x7f\xb6\x3e\xc5\x9c\xe0\xbd\xb3\x0d\x87\xda\xde\x5b\xd3\xa6\x40\x2e\xc9\xb6\x01\x16\xb9\xb6\xc9\xa2\x0f\x8b\xc5\x86\x96\x46\x16\x1b\x99\xf4\x92\x94\x93\x40\xd0\x77\x2f\x86\x94\x64\xca\x56\xd2\xec\xed\x93\x2d\x72\xfe\xfe\x66\x38\x9c\x61\x5d\x27\x8b\xe8\x42\x6d\x9f\xb5\x58\x17\x16\x7e\x7d\xff\xa7\x3f\x9f\x6c\x35\x1a\x94\x16\x3e\xf0\x14\x57\x4a\x3d\xc0\xb5\x4c\x19\x9c\x97\x25\x38\x22\x03\xb4\xaf\x77\x98\xb1\xe8\xae\x10\x06\x8c\xaa\x74\x8a\x90\xaa\x0c\x41\x18\x28\x45\x8a\xd2\x60\x06\x95\xcc\x50\x83\x2d\x10\xce\xb7\x3c\x2d\x10\x7e\x65\xef\xbb\x5d\xc8\x55\x25\xb3\x48\x48\xb7\xff\xf1\xfa\xe2\xea\xe6\xf6\x0a\x72\x51\x22\xb4\x6b\x5a\x29\x0b\x99\xd0\x98\x5a\xa5\x9f\x41\xe5\x60\x03\x65\x56\x23\xb2\x68\x91\x34\x4d\x14\xd5\x35\x64\x98\x0b\x89\x10\x6f\x54\x86\x65\x0c\xed\xea\x74\xfb\xb0\x86\xd3\x33\x58\x71\x83\x30\x65\x17\x4a\xe6\x62\xcd\xfe\xc9\xd3\x07\xbe\x46\x22\xaa\x6b\xb0\xb8\xd9\x96\xdc\x22\xc4\x05\xf2\x0c\x75\x0c\xd3\x8e\x7d\xbf\x25\x36\x5b\xa5\x6d\xb7\xe5\xbf\x60\x16\x4d\xea\xfa\x04\x34\x97\x6b\x84\xe9\x96\xdb\x82\x74\x4d\xd9\xad\x58\x95\x42\xae\xaf\x1d\x95\x21\x8e\xc9\x24\x76\xd6\x10\x49\xd3\xc4\x9e\x0f\x65\x46\x7b\xf3\x28\x4a\x12\xa0\x6d\x76\xc3\x37\x64\x15\x61\x48\x00\x38\x5f\x00\xa5\x15\xf6\x19\x72\xe5\x91\x1c\x10\x9a\xb4\xc0\x0d\x67\x91\x7d\xde\x1e\xee\x58\x5d\xa5\x16\xea\x68\x92\x3a\xa7\x61\xe0\x8e\x93\x9c\xa8\x8d\xb0\x96\xaf\x4d\xeb\xd6\x24\x49\xe0\xfa\xd2\xe3\x8c\xa4\x96\x45\x93\xeb\x4b\x2f\xf6\xfa\x92\xdd\x91\x8e\xa6\x81\xfb\x6e\xe1\xd6\xa9\xb8\xe3\x6b\x68\x9a\xfb\x01\x14\x5f\x97\x30\xcd\x3d\x16\x1f\x04\x96\x59\x8b\x41\xeb\x66\xde\x72\xba\x2d\x92\x58\x28\x22\x21\xa5\x3b\x5e\x56\xd8\x59\x10\x7b\xe2\xd6\xa3\x18\x72\xa2\x67\x11\x00\xc0\x64\x54\x4e\x5d\x83\xc8\x1d\x8b\x28\x4b\xbe\x2a\x89\x6d\x51\xd7\x2d\xd0\x9e\xa5\xf3\xc2\xd3\x4a\x65\x9d\x1c\x94\x46\x58\xb1\xa3\x9d\xfb\x50\x74\xeb\x1c\xc9\x28\x0d\x7a\x21\xaf\xa3\xd8\xab\x1b\xc4\xd8\xfd\x7f\x14\xb6\x80\x29\xbb\xca\xd6\xb8\x07\xc4\x7f\xed\x11\xd0\x58\x72\x2b\x94\x34\x09\xba\x1d\x0a\xbb\xb2\x05\x6a\x90\x2a\x43\xd3\x9d\x8d\xb5\xe6\xdb\x82\x79\x11\x77\x1d\x70\x06\xb8\x46\x58\xa1\x90\x6b\xd8\xaa\x6d\x45\x56\x66\xb0\x7a\x3e\xca\x9b\x7f\x55\xa8\x9f\xe1\xb1\x40\x09\xc8\xd7\xa8\x4f\x4a\xc5\x33\xe2\xa2\xe3\x85\x14\xf7\x89\xb7\x2b\x64\xf2\x2b\xf7\xff\x35\x4a\x9e\xc6\xce\xb8\xf8\x7e\xef\xe4\x49\xe7\x65\xb2\x80\xf3\x2c\x13\xe4\x03\x2f\x7d\xcc\x0c\x58\x05\x3c\xeb\x4d\x31\x56\x69\x3a\x7f\x99\x16\x3b\xd4\x0c\xdc\x21\x76\xcc\x53\xbb\xd9\x96\x94\x38\x5b\x2d\xa4\xcd\x21\xce\x04\x2f\x31\xb5\xc9\x3b\x93\x78\xb4\xbd\xc0\x98\x4e\x59\x2b\xa5\xe3\x15\x39\x14\xdc\xdc\x75\xd1\xf1\xa2\x1c\xcc\xb4\xfb\x64\x87\x1b\x6c\x34\x44\x6f\x30\xbe\x32\xa1\xc9\x47\xd9\xe0\x79\x12\xde\x4b\x69\x0f\x97\x2b\x28\xc7\x39\x70\x70\xf2\x7f\x2c\x1b\x8e\xaa\x80\x17\xb7\x2f\x05\xc1\x11\x45\x42\x99\x0d\xce\x25\xbe\xf1\x5c\x7a\xda\xae\xd0\x90\x61\xcc\x81\x3c\x22\x21\x38\x65\xc8\x3e\x49\xf1\xad\x22\x9e\xcf\x5f\xfa\x53\xb2\xf0\x6c\x74\x2a\x7b\x89\x75\xdd\xc2\x84\x47\xa7\x90\x75\xa7\x71\xe4\x88\x25\x09\x50\x1a\x63\x46\xc2\x42\x10\x85\xcc\x95\xde\x38\x1c\x1d\x80\x1a\xa9\x2e\xbb\x74\xcf\x81\x3b\x46\x87\xdc\x23\x37\xad\x04\x98\x39\xb2\x6f\x15\x1a\x8b\xd9\x9c\x60\x1e\x9e\x13\x45\x01\xa0\x73\x12\x6a\xfc\x5c\xd7\x50\xa2\x74\x46\x7e\x59\x29\x55\x76\x41\x6f\x21\x17\xcb\x01\xec\x2f\xa0\xfe\xbb\xbe\xd2\xa4\xdc\x56\x5a\x9a\x00\xef\x03\x64\xdb\x88\x68\xe0\x12\x50\x6b\xa5\xc9\x19\x57\xb7\xb3\x35\x3a\xe1\xe4\x0e\x21\xdf\xba\x74\xe8\x43\x5b\x2c\x83\xb0\x2c\x49\x5c\x4b\xbd\xaa\x6c\x2f\xc0\x5d\xd4\x3d\xe8\x2c\x9a\xe4\x95\x4c\x61\x36\x92\x6a\xf3\x97\x3d\x9a\xcd\x61\xf6\x47\xb2\x61\xe9\xbd\x9b\x53\xfa\x4e\x44\x0e\xc8\x02\xc8\x09\xf1\xa9\x20\xb8\xdd\x76\x57\x06\x42\xe9\xb4\xec\xf9\x46\x61\x3c\x3b\x03\x29\x4a\xcf\xdd\x17\x53\x82\xf0\x20\xcb\x83\xdc\x38\x04\x72\xd9\xf3\x1e\x81\xc6\xfc\x96\x0f\x26\x29\x5a\xc2\xcf\x37\xca\x7e\xa0\xbd\x2b\x72\xab\x2e\xf9\x0a\xcb\x53\x08\xfc\xde\x37\x27\xec\x23\x6d\x7a\x0f\x9a\xce\xbd\x2e\xdb\x7b\xa9\xe3\x8e\x2d\x49\x5b\xe4\xf9\x0e\xd5\x7f\x74\x7e\x78\xfd\xe4\xea\xa9\xbf\x69\x7b\x67\xe3\x26\x9a\x34\x51\xa0\x2c\xf8\xeb\x9a\x2a\x57\x40\x47\x6b\x74\x86\xd4\x03\x26\x4a\xe2\x41\x85\xae\xeb\xa3\x0a\xdc\x77\x59\x53\x8d\x29\xd2\x4d\xe0\x3b\x86\x7f\x77\x5f\xed\x76\xd0\x53\xa0\xa7\xd8\xdf\xa0\xee\xae\xa6\x6c\xec\xae\x0c\x88\xdd\xdd\x16\x1f\x23\xd2\x1f\x38\x47\xdf\x34\xf0\xad\x42\x2d\xd0\xbc\x50\xd2\xc2\x62\xd7\x6d\xf4\xa9\x3f\x30\xba\x69\x60\x11\x52\xcd\x43\x2d\xb3\x39\x84\x49\xed\x8c\xeb\xeb\xdc\x3e\x36\xb3\x9f\x43\x09\x17\xa5\x40\x69\x6b\xdf\xb8\xf9\xe4\x08\xb4\x31\xbf\xde\xcc\x59\xa8\xe7\x80\x68\xee\x43\xd8\x87\x2d\x49\xe0\xd3\x36\x23\xf0\xbb\xca\xc2\x61\x55\x89\x92\xfa\x73\xaa\x89\x15\x6d\x52\x65\x73\x2d\xf6\xd0\xe9\x24\x81\x1b\x65\x11\x6c\xc1\xed\x12\x9e\x55\x05\x12\x31\xa3\x6b\x31\xe5\x65\x39\x24\xfe\x24\x1f\x35\xdf\xce\xe6\xb0\xc2\x5c\x69\x74\x14\xbd\xd8\x0d\xda\x42\x65\x4b\x5f\xa9\x0e\xd4\x44\x6d\xc5\xf2\xe6\x61\x06\xb9\x56\x1b\xe0\x60\x35\x97\x86\xa7\x54\xbc\x97\xc0\x65\xe6\x82\x12\x2c\x3a\xa6\x54\x6d\xa8\x09\xc3\x8c\x2a\x98\x56\x65\x49\x15\x8c\xa7\x0f\x2c\x7a\x53\xbc\x3c\x32\x5d\xa8\x98\xff\xfc\x5d\x62\x10\xa8\x1f\x8a\x53\x2f\xf0\x38\x4a\x6d\x68\x1c\x6a\x50\xb9\x1f\xd3\xb5\xdf\xd4\xf5\x13\xe6\xff\x0f\x17\xe0\xb9\x45\x0d\xc2\x13\xa6\xa5\x32\x98\x2d\x49\xac\x51\x9e\x9f\xa2\x24\xf1\xc9\xf6\x29\xff\x28\xca\x12\x56\x08\xf8\x84\x69\x45\xb0\xd9\x42\xab\x6a\x5d\x38\xcd\xbe\x2b\x83\xc7\x42\xa4\x05\xa4\x1a\xb9\x27\x18\xa0\xfe\x56\x60\xbb\x6c\x18\xac\x13\x9e\xf6\x69\x09\xea\x81\x8e\xed\x38\x6a\xac\xed\x0d\x67\x0b\xfb\x74\xe9\xfe\xce\x23\x2a\xe3\x3f\xa9\x07\x77\x6e\xb6\x5c\x8a\x74\x16\x77\x23\x5e\xd3\x9c\x1e\x4d\x50\x54\x85\x07\x38\xf1\x6e\x96\x8a\xdd\xe9\x98\xbc\xaa\x19\xce\xc0\x3e\xb1\x4c\xef\xfa\xd8\x1f\x90\xb7\xa1\xbb\xb5\xda\x75\x12\x9b\x6d\x89\x1b\x94\xd6\x47\x2f\xdf\x58\xe6\x77\x50\xbf\x11\x2b\x4f\x3e\x9b\x53\xbb\x46\x12\xeb\x68\xb2\xe3\xba\x3f\xa4\x7e\xd5\xb0\xdf\xfc\x77\x34\x69\x37\xd8\x7f\xb4\xb0\xd8\x32\xc7\xa1\xc8\x19\xb9\x39\x46\xe5\x8c\xf3\xc5\x7b\x16\x8b\xec\xec\xdd\x2e\x5e\x1e\x85\xe1\xfa\x72\x3e\x1f\x34\x8c\x62\x7c\xa6\xeb\xae\xdc\xe1\x10\x45\xf7\xd3\xa8\x81\x4b\x18\x0c\x75\x67\x7f\x35\x1d\xd7\xdf\xc8\x5c\x7f\xc5\xf9\x51\xab\xbb\xf1\xa6\x26\x0f\x27\x82\x77\x86\xbd\xa3\xfe\xbf\x37\xf6\x68\x0e\x0c\x3b\x81\xc1\x2c\xd8\xf5\x02\xbb\x2e\xef\x4c\x0e\x4d\xf3\x17\xd8\xc1\x4f\x83\x36\xe0\x4d\x96\x3b\x73\xf7\x9a\xa8\x34\x4d\x73\x76\x6d\xee\xc4\x06\x61\xd6\x0e\x96\xff\xe0\xe6\xef\x8a\x2a\xff\xbc\x53\x3f\x2e\x7d\xc7\x3e\xb8\x16\x75\x66\xc5\x06\xd9\xf9\xcd\xed\xf5\xc5\x3c\x90\xef\x10\x09\x95\xb4\x59\xf7\xbd\x6a\x16\xbb\x43\xa1\xaf\x92\x0f\x12\xc5\x65\xc9\x62\x37\x30\xab\xef\x45\x82\xfe\x24\x90\xfa\x3d\x38\x7e\x2f\x8c\x63\xb2\xfb\x90\xbe\x88\xe6\x1f\x04\xf3\x55\x65\x07\x92\x5f\xe3\x39\x06\x74\x2f\x25\x10\x23\xc3\x2c\xee\xbf\x0e\x67\xd5\xee\xff\x40\xd1\x6f\xcf\x16\x67\xbf\xcc\x7f\x99\xf7\x65\xab\xdb\xee\xea\x4b\xd4\x36\x5d\xa6\x14\xa9\xeb\xa7\xb6\x65\xa5\x79\x39\xbc\x89\xf7\x04\xbe\x96\x72\xd8\x72\x6d\xdc\x31\xf2\xcb\x2a\x3f\x68\x12\xfa\xd9\xb3\x67\xfb\xfc\x65\x50\xe1\x9c\x56\x37\xd7\xe1\x93\x25\xdb\xa7\x10\xdf\x12\x6d\xbc\xe7\xf1\x35\xf9\x95\x37\x80\xb6\xbf\xdc\x70\xf9\x7c\xfc\x04\x30\x3e\xe3\x07\x4d\xd0\x78\x1d\x0e\x8d\x9e\x83\xbf\x04\x66\x69\xbe\x6e\xff\xba\xc9\x83\x5a\xa4\xaf\x82\x8c\xf2\xd5\xf0\x48\x46\x3b\x00\x07\x6b\x9f\xbf\x8a\x2f\xed\x95\x02\x67\x90\xe6\x6b\xba\x73\x06\xe6\xd4\x75\xb2\x80\xf3\xfd\x0b\x82\x1b\xee\xa9\x8f\xa1\x6c\xf4\x43\xfb\x89\xe5\x6b\xd3\xbe\x36\x1c\x3e\x72\x06\x0f\x4f\xee\xd9\xa9\x7d\x5a\xb8\xe3\x6b\x3f\x8e\xfa\x49\x39\xa8\xde\xb6\x9b\x3d\xdb\x39\x8c\x96\xe1\x7d\x0b\xc1\xfe\x8d\xcc\xd2\x6d\x1a\x9f\xc4\xfd\xe2\x7d\xb8\xfd\x92\xf1\xae\xd1\x48\xb9\xa4\xb6\x42\xed\x50\x6b\xd1\xce\x4a\x4a\xbb\x37\x60\xff\x86\xc2\xc7\x1e\x57\x5c\xbb\xc3\xd3\xc2\x4d\xe1\x6c\xdc\xd7\x91\x67\x15\x32\x07\x65\xd6\x34\xd1\xff\x02\x00\x00\xff\xff\x44\xff\xc1\x0e\xc3\x16\x00\x00")
+var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xeb\x73\xdb\x36\xb6\xff\x2c\xfe\x15\xa7\x1c\xa5\x15\x3d\x0a\xd5\x9b\x99\x76\xe6\xba\xd7\x77\x26\xcd\xa3\xf5\x4e\xeb\xee\xd6\xce\xee\x07\x8f\x27\x81\xc8\x43\x09\x35\x45\x28\x00\x48\x5b\xcb\xea\x7f\xdf\x39\x78\x50\xa0\xc8\x38\x4e\xd2\xfd\x64\x11\x38\x38\x8f\xdf\x79\xe0\x00\x70\xdb\x2e\x4e\xa2\x17\x62\xbb\x93\x7c\xb5\xd6\xf0\xec\xdb\xff\xf9\xdf\xa7\x5b\x89\x0a\x2b\x0d\xaf\x59\x86\x4b\x21\x6e\xe1\xbc\xca\x52\x78\x5e\x96\x60\x88\x14\xd0\xbc\x6c\x30\x4f\xa3\xab\x35\x57\xa0\x44\x2d\x33\x84\x4c\xe4\x08\x5c\x41\xc9\x33\xac\x14\xe6\x50\x57\x39\x4a\xd0\x6b\x84\xe7\x5b\x96\xad\x11\x9e\xa5\xdf\xfa\x59\x28\x44\x5d\xe5\x11\xaf\xcc\xfc\x2f\xe7\x2f\x5e\x5d\x5c\xbe\x82\x82\x97\x08\x6e\x4c\x0a\xa1\x21\xe7\x12\x33\x2d\xe4\x0e\x44\x01\x3a\x10\xa6\x25\x62\x1a\x9d\x2c\xf6\xfb\x28\x6a\x5b\xc8\xb1\xe0\x15\x42\xbc\x11\x39\x96\x31\xb8\xd1\xe9\xf6\x76\x05\xa7\x67\xb0\x64\x0a\x61\x9a\xbe\x10\x55\xc1\x57\xe9\xdf\x59\x76\xcb\x56\x48\x44\x6d\x0b\x1a\x37\xdb\x92\x69\x84\x78\x8d\x2c\x47\x19\xc3\xd4\x2f\x3f\x4c\xf1\xcd\x56\x48\xed\xa7\xec\x17\xcc\xa2\x49\xdb\x3e\x05\xc9\xaa\x15\xc2\x74\xcb\xf4\x9a\x64\x4d\xd3\x4b\xbe\x2c\x79\xb5\x3a\x37\x54\x8a\x98\x4d\x26\xb1\xd1\x86\x48\xf6\xfb\xd8\xae\xc3\x2a\xa7\xb9\x24\x8a\x16\x0b\xa0\xe9\xf4\x82\x6d\x48\x2b\xc2\x90\x40\x31\xb6\x00\x56\x9a\xeb\x1d\x14\xc2\x22\xd9\x23\x54\xd9\x1a\x37\x2c\x8d\xf4\x6e\x7b\x3c\xa3\x65\x9d\x69\x68\xa3\x49\x66\x8c\x86\x9e\x39\x86\xf3\x42\x6c\xb8\xd6\x6c\xa5\x9c\x59\x93\xc5\x02\xce\x5f\x5a\x9c\x91\xc4\xa6\xd1\xe4\xfc\x25\x2d\x9c\xa6\xe7\x2f\xd3\x2b\x92\xb1\xdf\xc3\x3b\x3f\x70\x69\x44\x5c\xb1\x15\xec\xf7\xef\x7a\x50\xbc\x9d\xc3\xb4\xb0\x58\xbc\xe6\x58\xe6\x0e\x03\x67\x66\xe1\x56\x9a\x29\x32\x77\x2d\x88\x84\x84\x36\xac\xac\xd1\x6b\x60\x20\x2b\xbc\x45\x31\x14\x44\x9f\x46\x00\x00\x93\x51\x3e\x6d\x0b\xbc\xa0\xf1\x0b\x5e\x96\x6c\x59\xd2\xb2\x93\xb6\x75\x40\xdb\x25\xde\x0a\x4b\x5b\x09\x4d\x83\x97\x58\x29\xae\x79\x43\x0b\xde\x85\xac\x9d\x71\xc4\xa3\x54\x34\xfb\x51\x14\x3b\x71\x3d\x1f\x9b\xdf\x77\x5c\xaf\x61\x9a\xbe\xca\x57\x78\x00\xc4\x7e\x1d\x10\x90\x58\x32\xcd\x45\xa5\x16\x68\x66\xc8\xed\x42\xaf\x51\x42\x25\x72\x54\x3e\x37\x56\x92\x6d\xd7\xa9\x65\x71\xe5\x81\x53\xc0\x24\xc2\x12\x79\xb5\x82\xad\xd8\xd6\xe4\xeb\x1c\x96\xbb\x41\xdc\xfc\xa3\x46\xb9\x83\xbb\x35\x56\x80\x6c\x85\xf2\x69\x29\x58\x4e\xab\x28\xbd\x50\x13\x5f\xab\x57\xb8\xc8\x8e\xbc\xfb\x43\x89\xea\x34\x36\xca\xc5\xce\xeb\x64\xe4\x53\x6f\xe5\xe2\x04\x9e\xe7\x39\x27\x1b\x58\x69\x7d\xa6\x40\x0b\x60\x79\xa7\x8a\xd2\x42\x52\xfe\xe5\x92\x37\x28\x53\x30\x49\x6c\x38\x4d\xf5\x66\x5b\x52\xe0\x6c\x25\xaf\x74\x01\x71\xce\x59\x89\x99\x5e\x3c\x51\x0b\x1b\xb3\x96\x61\x0c\xd3\xf4\xd2\x71\xf1\x6b\x79\x01\x6b\xa6\xae\xbc\x77\x2c\x2b\x9a\x34\x9c\xef\x3b\xb7\xd9\x89\x74\xd4\x45\x8f\x50\xbe\x56\xa1\xca\x83\x68\xb0\x6b\x16\xac\xe3\xe2\x92\xcb\x14\x94\x61\x0c\x1c\x65\xfe\x97\x45\xc3\xa0\x0a\x58\x76\x87\x52\x10\xa4\x28\x12\xca\x69\x2f\x2f\xf1\x38\x9f\x3e\x90\x97\x96\xd6\x89\x00\x52\x8c\x02\x66\x94\x43\x90\x65\x98\xbe\xa9\xf8\xfb\x9a\x22\xe9\xfa\xa6\xcb\x12\x4a\xcf\x29\x9a\xda\xd2\x71\x6c\x5b\x07\x13\x0e\xb2\x30\xf5\xd9\x58\xe5\x03\xff\x2d\x16\x40\x61\x8c\x39\x31\x0b\x41\xe4\x55\x21\xe4\xc6\x64\x95\xa9\xa2\x12\xa9\x2e\x9b\x70\x2f\x80\x45\x64\xbe\x41\xee\x8e\x29\xc7\x01\x66\x86\xec\x7d\x8d\x4a\x63\x9e\x00\x3f\xce\x13\x41\x0e\xa0\x3c\x09\x25\x5e\xb7\x2d\x94\x58\x19\x25\x6f\x96\x42\x94\xde\xe9\x0e\x72\x3e\xef\xc1\xfe\x01\xd4\x7f\x93\xaf\x24\x09\xd7\xb5\xac\x54\x80\xf7\x11\xb2\xce\x23\x12\x58\x05\x28\xa5\x90\x04\x34\x51\x93\x3f\x8c\x4d\x64\x0e\x21\xef\x4c\x3a\xb6\xc1\x15\xcb\xc0\x2d\x73\x10\xd2\x53\x2f\x6b\xdd\x31\x30\x1b\x75\x07\x7a\x1a\x4d\x8a\xba\xca\x60\x36\x12\x6a\xc9\x87\x2d\x9a\x25\x30\xfb\x9c\x68\x98\x5b\xeb\x12\x0a\xdf\x09\x2f\x00\xd3\x00\x72\x42\x7c\xca\x09\x6e\x33\xed\xcb\x40\xc8\x9d\x86\xed\xba\x51\x18\xcf\xce\xa0\xe2\xa5\x5d\xdd\x15\x53\x82\xd0\x59\xe2\xb4\x08\x63\xe3\x18\xc8\x79\xb7\x76\x00\x1a\xe5\xc5\x64\x32\xb1\xce\x24\x41\x73\xf8\xfa\x42\xe8\xd7\x04\xe8\x2b\x32\xab\x2d\xd9\x12\xcb\x53\x27\x8c\x6c\x0a\x9a\x93\xf4\x17\x9a\xa4\x02\x36\x99\xec\xbd\x79\x3e\xda\x3b\xae\xe3\x86\xcd\x49\x5a\x64\xd7\x1d\x8b\xff\xc5\xd8\x61\xe5\x93\xa9\xa7\x10\xf7\x8c\x8d\xf7\xd1\x64\x1f\x05\xc2\x82\x9f\xd4\x15\xd9\x02\x3a\x5a\xa3\x73\xa4\x1e\x70\x21\x2a\x3c\xaa\xd0\x6d\x3b\xa8\xc0\x5d\x97\x35\x95\x98\x21\xed\x04\x54\x92\xa6\xe9\xef\xfe\xcb\x4d\xbb\xec\x79\xeb\xb3\x27\xdc\x41\x69\xb5\x89\x46\xbf\x65\x40\x6c\xf6\xb6\x78\x88\x48\x97\x70\x86\x7e\xbf\x87\xf7\x35\x4a\x8e\x61\x8a\x79\x67\x13\x28\x61\xb1\xf3\x13\x5d\xe8\xf7\x94\xde\xef\xe1\x24\xa4\x4a\x42\x29\xb3\x04\xc2\xa0\x36\xca\x39\x3a\x68\x0f\xbe\x99\x7d\x1d\x72\x78\x51\x72\xac\x74\x6b\x1b\xb7\x53\x38\x92\x96\xda\xf1\x7d\x92\x86\x72\x8e\x88\x12\xeb\xc2\xce\x6d\x8b\x05\xbc\xd9\xe6\x04\xbe\xaf\x2c\x0c\x96\x35\x2f\xa9\x3f\xa7\x9a\x58\xd3\x24\x55\x36\xd3\x62\x87\xca\xa4\xd4\x9d\x5e\x08\x8d\xa0\xd7\x4c\xcf\x61\x27\x6a\xa8\x10\x73\xda\x16\x33\x56\x96\x7d\x84\xde\x54\x77\x92\x6d\x67\x09\x2c\xb1\x10\x12\x0d\x45\xc7\x76\x83\x7a\x2d\xf2\x39\xa5\xe8\x40\x4c\xe4\x2a\x96\x55\x0f\x73\x28\xa4\xd8\x00\x03\x2d\x59\xa5\x58\x46\xc5\x7b\x0e\xac\xca\x8d\xbb\x82\x41\x93\x99\x99\xd8\x50\x13\x86\x39\x55\x30\x29\xca\x12\x73\x58\xb2\xec\x36\x8d\x1e\xe5\x2f\x8b\x8c\x77\x55\x6a\x3f\x7f\xab\xd0\x11\x90\xa3\xbe\xc8\x4f\x1d\xc3\x63\x45\x92\xc8\xb9\xc6\xa0\x06\xb5\xf9\xa3\x7c\xfb\x4d\x5d\x3f\x61\xfe\x31\x5c\x80\x15\x1a\x25\x70\x5b\x7c\xb2\x52\x28\xcc\xe7\x84\xa7\x12\xc6\x67\x40\x5e\xaa\xf0\x5e\x77\x21\x7f\xc7\xcb\x12\x96\x08\x78\x8f\x59\x4d\x3d\xa2\x5e\x4b\x51\xaf\xd6\x46\xb2\xed\xca\xe0\x6e\xcd\xb3\x35\x64\x12\x4d\x13\x79\x84\xfa\x63\x81\xf5\xd1\xd0\x1b\x27\x3c\xf5\xfd\x1c\xc4\x2d\x25\xfc\x38\x6a\xa9\xeb\x0d\x67\x27\xfa\xfe\xa5\xf9\x99\x44\x54\xc6\xbf\x12\xb7\xb4\x7c\xb2\x65\x15\xcf\x66\xa6\x6e\xd1\x11\x6f\xbf\x3f\xed\x45\x13\x9d\xa0\xa8\x0a\xf7\x70\x62\xa5\x43\x35\x36\xd9\x31\x79\x50\x32\x9c\x81\xbe\x4f\x73\xd9\x74\xbe\x3f\x22\x77\xae\xbb\xd4\x92\xe2\x9b\x6f\xb6\x25\x6e\xb0\xd2\xd6\x7b\xc5\x46\xd3\x26\xc8\xab\x15\xca\x47\x62\x65\xc9\x67\x09\x9d\xdc\x88\x63\x1b\x4d\x1a\x26\xbb\x24\xb5\xa3\x2a\xfd\xd1\x7e\x47\x13\x37\x91\xfe\x4b\x72\x8d\x6e\x71\x1c\xb2\x9c\xc5\xc9\x38\x95\x51\xce\x16\xef\x59\xcc\xf3\xb3\x27\x4d\x3c\x1f\xb8\xe1\xfc\x65\x92\xf4\x1a\x46\x3e\x7e\xa6\xf3\x5b\x6e\xff\x10\x45\xfb\xd3\xa8\x82\x73\x77\x02\x74\x3a\x9e\xfd\x9f\xf2\xab\xfe\x9f\xd4\x35\x02\xdd\x51\xcb\xef\x78\x53\x55\x84\x27\x82\x27\x2a\x7d\xa2\xe2\x40\xd9\xc1\x39\xd0\x2f\x1c\x9c\x05\x7d\x2f\xd0\xf8\xb8\x53\x05\xec\xf7\x3f\x40\x03\x5f\xf5\xda\x80\x47\x69\x6e\xd4\x3d\x48\xa2\xd2\x34\x2d\xd2\x73\x75\xc5\x37\x08\x33\x0a\xbe\x69\x91\xfe\xcc\xd4\x4f\x82\x2a\x7f\xe2\xc5\x8f\x73\x6f\xd2\xd7\xa6\x45\x9d\x69\xbe\xc1\xf4\xf9\xc5\xe5\xf9\x8b\x24\xe0\x6f\x10\x09\x85\xb8\xa8\xfb\x54\x31\x27\xcd\x08\x53\xa3\xf5\xdf\x2e\x7f\xbb\x78\x78\xad\xed\xa1\x89\xee\x38\x92\xd3\xad\x44\xad\x77\x34\x35\x87\x93\x66\xa0\xf8\xc3\x6c\xc3\x60\x34\x91\x78\xc4\xa1\xeb\x77\x82\x1e\x28\xe0\xfa\x29\xbe\xfa\x54\x57\x8d\xf1\xee\xc2\xe6\x83\x1e\xfb\x4c\x87\x3d\x28\x2c\x89\x3e\xee\xb5\x2f\x70\xda\x41\xce\x91\xa0\x07\x79\x0f\x3c\x37\xca\xa6\xf3\x5f\xef\x2b\xfc\x08\x7f\xf7\x04\xfd\xb8\xd3\x38\xfb\x26\xf9\x26\xe9\x6a\xb0\x9f\x76\x2a\x24\x51\xaf\x45\x1c\x96\xa7\xee\x46\xc8\x62\xf5\x33\x53\xeb\x43\x2d\x18\x36\x8f\x47\xa5\x24\x26\xfa\xb8\x77\x46\x76\xed\x96\xdb\x8e\x6d\xb1\xbf\xfc\xf9\xf9\xd3\x67\xdf\x7d\x4f\xb7\x0f\x6b\xdf\x36\x66\xac\x12\x15\xcf\x58\x09\x24\x17\xb0\xca\x04\x9d\x15\x82\xae\xf2\x7d\x4d\x3d\xd5\x21\x48\xfd\xf5\x56\xff\x4a\x87\x36\x32\xdb\x54\xe7\x26\x6e\x0d\x23\xcc\x81\xad\x18\xaf\x7c\x93\xc5\x35\x91\x91\x78\xa4\xee\xaa\x02\x21\xa9\xaf\xd3\x02\x94\x90\xda\xe8\x78\x8b\x3b\x45\xc2\xc5\xf2\x0f\xcc\xb4\xb2\x06\x99\xe6\xe0\x0e\x25\x1e\xd8\x2a\xe2\x34\xc3\x74\x95\x02\x5d\xf4\xa4\xbf\xb3\xbb\x5f\x51\x29\xb6\xc2\xc4\xb5\x5f\x02\x24\x6e\x44\x43\xed\x20\x72\x09\xbc\x52\x7c\x55\xf1\x82\x67\xac\xd2\xd4\x34\x68\x54\x5b\x96\xa1\x22\x4b\x1e\xb5\xf1\x05\xb0\xd2\x21\xf1\x5a\xad\xd9\xb3\xef\xbe\x4f\x2f\xf9\xbf\xf1\x66\xb9\xd3\xd8\x3b\x01\x4e\x96\x75\x61\x06\xc8\x69\x46\xc3\x5f\x99\x54\x6b\x56\x0e\xe2\xbb\x6d\x87\x3b\x83\x09\x4b\x3a\x0c\x4a\xd9\x2f\xf9\x2e\xbc\x06\xb2\xdb\xbd\x11\x16\xf9\xea\x43\x3b\x72\x03\xbc\xd2\x28\x0b\x96\x61\x6b\x06\x73\xcc\x3a\x6d\x2e\xf0\xee\xa5\x71\x97\x9c\x91\xee\x2a\xbd\xc0\xbb\xdf\xcd\xb5\xf2\x6c\x59\x17\xb6\x42\xe4\x98\xa5\x6f\x14\x5e\xd4\x9b\x25\xca\x59\xa8\xd3\xe9\x19\xd0\xa4\xe5\x30\xfb\xba\x49\x7e\xf8\x7c\x55\x79\x01\x1d\x56\x47\x50\x7d\x11\x5f\x47\xe7\xc9\xea\xcd\xb3\xef\xbe\x37\xb6\x05\x47\xce\xc3\xc1\x23\x38\x82\xb8\x5c\x4c\x5f\x23\xd3\xb5\xc4\x57\x15\x65\x62\x0e\x31\xa9\xb6\xc0\xf7\x35\xb3\xd7\xf6\x93\x87\xf2\xf9\x38\xa1\xbb\xd2\xf2\xb1\x4c\x7e\xe5\xf9\x53\x58\x34\x01\x5d\x17\x32\x71\x1a\x0f\x03\x26\x9a\x8c\x64\x3e\xdd\x1e\x29\x7f\xdd\x72\x7c\x33\x36\x9e\xd6\x94\x55\xc6\x44\x3a\x35\xd1\xb2\x15\x6f\xb0\xb2\x29\x9e\x46\xfd\xad\xc9\xef\x11\xbe\x71\x49\xcc\x6d\x54\x67\xf2\x8f\x4c\xf1\xec\xb9\x94\x6c\x77\x80\x81\x0a\xa5\xa2\x4f\xfa\xf8\x95\x6d\xff\x49\x7c\x7b\xdb\x8b\xe3\xff\x38\x46\xbe\xea\xd3\xc1\x8f\x97\x26\xed\x71\xb3\xd5\x3b\x50\xf4\x76\x63\x6f\x99\x8d\x35\x87\x13\x59\xc6\xb6\x2c\xa3\x03\x8b\x43\xc2\x51\x72\x05\x7c\x55\x09\x49\x2f\x45\xa3\x1b\xcb\x40\xc4\x86\x6d\x03\x01\xc1\x2a\xb7\x41\x1c\x7f\x7d\x72\x89\x69\xdc\xd5\xff\xa3\x9e\x09\x12\xa0\x7b\x3c\x68\x43\x0c\xbf\xc4\x47\x87\xed\x9e\x17\x74\x57\x68\xaa\x56\x63\x04\x7d\x75\x66\x06\x1a\x57\xe7\x0e\x99\x56\xb0\x52\xa1\x5d\xe4\xd6\xd2\x81\x9d\x53\x0c\xdb\x3c\x69\xba\x15\xbc\x00\xcf\xf0\x9a\xdf\x50\xdd\x68\xe8\xaf\x9f\x1e\xe1\x38\xd9\xf7\x38\x3b\x02\x2d\x6b\x1c\x74\x1c\xe3\x76\x8f\x04\xdd\x5f\x66\xe3\xed\x1c\xee\x3f\x60\xe6\x2e\x3c\x3e\x12\xf3\xeb\xdb\x9b\x1f\xcc\xe9\xf0\xcf\x3f\xe1\x9e\x2c\xdf\xfd\x15\x66\xef\xfb\x04\x12\x8b\x12\x33\x9d\xbe\x44\xdc\x9a\x8a\xd2\xd9\x36\x87\x26\x19\x89\x55\x57\xb2\xfc\xc0\xe1\xe7\xe1\xd7\xc3\x75\x31\x13\xdb\xdd\xa3\xcb\x62\xd7\x6d\x52\x2a\xff\x57\xea\xc8\xb0\xd0\xc6\x3f\xa1\x1e\x29\x9c\x7f\x41\x99\xf5\xd7\x54\x04\x01\x88\x4f\xa9\xb6\x73\x7b\xed\x91\x31\xea\x90\x60\x23\x72\x5e\x70\xcc\x9d\x10\x7a\x5a\x10\xb5\x06\x56\x14\x98\xb9\x2b\x2f\x7f\xdd\x92\x9a\x62\x14\xbc\xa5\x75\xb7\x2e\x4c\xd1\xee\x96\x7e\x56\xc5\x49\xa0\x57\x53\x5c\x5c\x06\xb9\x7a\x74\x0b\xed\x82\xcd\xee\xa6\x93\xc9\x17\x14\x70\xe3\x81\x0d\xbb\xc5\x59\x4f\x83\x79\x3f\x2d\xdd\x69\x8b\x70\x9e\x35\xf3\x4e\xab\x64\x3c\x15\x3e\x89\xe7\x30\x8b\x3d\x85\x37\x76\xd2\x5c\xdf\xde\xc0\x19\xdc\x87\x09\xd9\x4f\x22\x0f\x48\xf3\xf8\x8c\x3a\x7e\xc9\xb3\x37\x8f\xaa\x7b\x44\xa7\x60\xff\xe8\xf3\xde\x43\x19\x37\xda\x87\xf8\x87\x2b\xbc\xd7\xa4\xca\x14\x62\x13\xe0\x94\x1e\xce\x94\xde\x83\xa1\x69\x7c\x58\x96\xa1\x52\x42\xaa\xd8\x3d\x0b\x3d\xc2\x3a\x98\x9a\xed\x95\x40\xdd\x96\xb5\x64\xe5\x21\xf2\xfc\x83\xa2\x25\xb0\x17\x61\x0c\xb6\x4c\x2a\xaa\x2a\x76\x03\xa7\x64\x0a\xa3\x35\x78\x38\xec\x96\x5d\xdf\xf4\x02\x3a\x8a\x86\xb6\x5d\x12\x6d\x7c\x58\x63\xd4\x7d\xe8\x01\xd7\x3d\x0e\x6c\x58\xb5\x1b\xbe\xdf\x0e\x9e\x07\xd2\x23\xb3\xc7\xd3\x2e\x54\x3a\x01\x7b\x77\x38\xcb\x8a\x95\xfb\x69\x36\x1a\x72\xfa\xdb\x60\xbf\x1c\xf0\x70\x67\xc1\x60\xec\xfa\x2d\xbf\x71\xf7\x81\x70\x06\x59\xb1\xa2\x0b\xc3\x23\x2f\x50\x84\x1d\xbc\x49\x42\xcc\xff\x53\x50\x45\x51\xa6\xf5\x7c\x4a\xff\x5b\x71\x88\xa5\xde\x7f\xa8\x04\xff\x35\x60\x8a\xa5\x7b\x17\xbe\x62\x2b\xca\x27\xe5\x9e\x39\x5d\xf8\xd1\xd5\x9b\xf6\x0f\x87\xee\x11\x8d\x86\xe1\x5b\x07\xc1\xa1\x73\xd1\xb0\xdf\x9f\xc6\x4f\xe3\x6e\xf0\xf0\x5a\xfa\x80\xf2\x61\xb9\x14\x0d\x4a\xc9\xdd\x43\x57\x77\x90\xa4\x07\x70\x36\xf6\x32\x4e\x39\x83\x2c\x5b\x03\xc5\x50\x3a\x6e\xeb\xc8\x9b\x38\xa9\x83\x55\xfe\x48\x65\x72\xac\x06\xda\xf8\x94\xd6\xe2\x20\xdf\xe4\x37\xd7\x2a\xcc\x6f\xfa\x27\x16\x73\x3e\xee\x2e\xbb\x45\x95\x59\x7f\x99\x75\x07\xd2\x43\x37\xdb\xf5\xec\xac\x61\xbc\x34\x99\x53\x2b\x72\xec\x34\xbd\xcc\xc4\x16\x6d\x25\x18\xba\xf6\x38\xa3\x43\xd0\xff\x33\x00\x24\x88\x3b\x5f\x68\x25\x00\x00")
 
 func templateEntTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -881,7 +881,7 @@ func templateEntTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/ent.tmpl", size: 5827, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/ent.tmpl", size: 9576, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -966,7 +966,7 @@ func templateImportTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4f\x6f\xdb\x38\x16\x3f\xcb\x9f\xe2\x41\x50\x81\x38\x48\xe5\xce\xdc\x36\x80\x0f\xdd\x24\x9d\x04\x3b\x08\x06\x68\x32\x97\xc5\x62\x41\x8b\x4f\x16\x11\x8a\x74\x49\x2a\x69\x56\xf0\x77\x5f\xf0\x91\x92\xa8\xd8\x6e\xb7\x5d\xcc\xc5\x90\xc8\xf7\xf7\xf7\xfe\xca\x7d\xbf\x3a\x5f\x5c\xe9\xdd\xab\x11\xdb\xc6\xc1\xaf\x1f\x7e\xf9\xdb\xfb\x9d\x41\x8b\xca\xc1\x27\x56\xe1\x46\xeb\x27\xb8\x53\x55\x09\x1f\xa5\x04\x22\xb2\xe0\xef\xcd\x33\xf2\x72\xf1\xd0\x08\x0b\x56\x77\xa6\x42\xa8\x34\x47\x10\x16\xa4\xa8\x50\x59\xe4\xd0\x29\x8e\x06\x5c\x83\xf0\x71\xc7\xaa\x06\xe1\xd7\xf2\xc3\x70\x0b\xb5\xee\x14\x5f\x08\x45\xf7\xbf\xdf\x5d\xdd\xdc\x7f\xbe\x81\x5a\x48\x84\x78\x66\xb4\x76\xc0\x85\xc1\xca\x69\xf3\x0a\xba\x06\x97\x28\x73\x06\xb1\x5c\x9c\xaf\xf6\xfb\xc5\xa2\xef\x81\x63\x2d\x14\x42\xde\xa2\x63\x39\x84\xc3\xf7\xf0\x22\x5c\x03\xf8\xd5\xa1\xe2\x50\x40\xfe\x07\xab\x9e\xd8\x16\x73\x28\xca\xf8\x08\xef\xf7\xfb\x45\xd6\xf7\xe0\xb0\xdd\x49\xe6\x10\xf2\x06\x19\x47\x93\x43\xe9\xa5\xf4\x3d\x78\xde\xa8\x64\x22\x12\xed\x4e\x1b\x97\x43\x41\x57\x95\x56\xd6\xc1\xd9\x22\x5b\xad\xe0\x77\xb6\x41\x09\x8d\x96\xdc\x92\x17\xd6\x19\xa1\xb6\x20\xe9\x98\xa3\xd2\xce\xbf\xfa\x9b\xbe\x07\xa9\x5f\xd0\x40\x51\xde\xb3\x16\x61\xbf\x07\xf7\xba\x1b\xdd\xe7\xcc\xb1\x0d\xb3\x58\x2e\xb2\x20\x73\x0d\x79\xdf\x43\x51\x86\xb7\xfd\x3e\x27\x7d\x74\x74\x77\x5d\x5e\x79\x1b\x98\x72\x5e\xcc\x81\xf6\x99\x5e\xc1\xa1\x16\x28\xf9\x11\x45\xc7\x84\x0d\x6a\xef\xae\xcb\xcf\x4e\x1b\xb6\xc5\x7f\xe0\x6b\x50\xef\x21\x36\x4c\x6d\x11\x8a\x1a\x2e\xd7\x50\x94\x9f\xbc\x60\xeb\x41\xc9\xe8\xb6\x08\x9a\xfc\x5d\x9d\x4a\x5d\x64\x83\xed\x81\xe0\xbb\x46\x4f\x60\xd5\x23\x5a\xa7\xbc\xc8\x66\x72\xa3\xfd\xf5\x51\xeb\x87\xe0\x7a\x96\xe8\x09\x06\x4f\x6e\xf8\x16\x53\x47\x90\x6f\xc3\x0d\x1e\xf7\x83\xee\x7f\xc0\x0d\x1c\xdd\x20\x4e\xe5\x5f\x84\x82\xb6\x73\xcc\x09\xad\xec\xe0\xc7\x20\x37\xba\x31\xb2\x1d\x71\xa0\x70\xed\x4e\x7a\x1b\x77\x46\x28\x57\x43\xce\x05\x93\x58\xb9\xd5\x3b\xbb\xf2\x75\xb1\xaa\xa2\xe1\xd6\x57\x40\x84\x03\x62\x01\x7c\x1d\x93\x3b\x88\xa1\xcc\x5e\x52\xda\x87\x83\xd3\x62\x9f\x99\x11\x6c\x23\xf1\xad\xd8\xbe\x07\x51\x43\xc3\xec\xc3\x5c\xf4\xb7\x34\xce\x0a\x6e\x75\x0e\xb7\xcc\x02\x73\x20\x91\x59\x07\x5a\x61\x0c\xfa\x99\xd2\x0e\x50\x75\xed\x32\xd4\x38\xc7\x9a\x75\xd2\xc1\x33\x93\x1d\x02\x75\x85\x31\x09\xec\x9b\xd4\x0c\x66\x51\x42\x3f\x5a\x34\xd7\xd4\x39\x78\xb8\x18\x38\xd6\xc0\x76\x3b\xea\x1a\xf1\xc0\x93\x07\x92\x68\x9e\x27\x6e\x98\xbd\x8e\x8a\x2f\xd7\x50\x33\x69\x31\xd0\xcc\x8a\xa2\x9e\x2b\x66\x24\xb5\x1c\x18\xc9\x93\xa2\x2e\xef\xec\x0d\xb9\x13\xcc\x48\x24\xaf\xc1\x99\x0e\x53\xdd\x6f\x31\xfa\x0d\x15\x1a\x8f\xe3\x56\xea\x0d\x93\x30\xc6\x03\x6a\x6d\xa0\xd1\xfa\xc9\x5e\x78\x64\x04\x67\x4e\x1b\x4b\x16\xec\xb4\x14\xd5\x2b\x54\x0d\x56\x4f\x68\xec\x08\x99\xa8\x41\x9b\x99\xfe\xa2\xbc\x65\xf6\xcf\x89\xbb\x28\xef\xbb\xf6\xd6\x0b\x0d\x57\x7f\x04\x49\xfb\xfd\x02\x00\x80\x6a\x45\x0d\x04\x04\xfc\x48\x9e\x90\x50\x00\x0e\x98\x0f\x05\xac\x81\x71\x9e\xbc\xff\x92\x0a\x89\x20\x64\x83\x40\x95\x28\xa2\xba\xbc\xd7\x0e\xc1\x35\xcc\x51\xed\x4d\xb0\x6c\x50\xea\x17\x60\xc6\x57\x9c\x70\x82\x49\xf1\x1f\xe4\xb0\x79\x0d\x63\xa7\x53\x4e\xb4\x18\x24\xec\xe2\x98\xd0\xa1\xc9\x8c\xe4\x54\xa3\x61\x24\xa1\x4f\x15\x29\x2a\x3a\x2a\xe1\xa1\x41\x83\xb5\x36\x78\x11\x24\x08\x07\xb6\xd1\x9d\xe4\xb0\x41\x08\x63\x03\xc7\xa6\xd5\x32\xa1\x80\xf9\x38\x49\xa9\x5f\xec\x25\xb1\xd0\x4f\x16\x48\xe1\xdf\xb1\xfb\x5e\x69\x55\x8b\xed\x38\xb6\xf6\xfb\x55\xb4\x33\x8f\x3c\x29\x20\xcf\xcc\xf8\x69\x74\x02\x98\x2c\x3c\xff\xd3\xcb\x4d\x6e\xfe\x85\xca\x95\xfe\x25\x32\x0e\xc2\xb2\xe3\xf1\xca\xb2\x2c\xbe\x78\xbe\xf0\x78\x8c\xf3\xaf\xac\xc1\xec\x70\x02\xd5\xc9\x00\x1a\x2c\xff\x6e\xc5\x79\xda\x60\x2c\x9f\xca\x79\xe2\x88\x1d\x97\xa8\x62\xb7\x1f\xe8\x66\x0d\x7f\xde\x84\xb4\x82\xca\x60\x48\x14\x5f\x87\xb1\xfd\xbf\x9d\x5f\x65\x54\x3e\x93\x39\x15\xa2\x37\xf3\x41\xb4\x18\x9e\x1e\x1f\x09\x81\xba\x53\xd5\xd9\x12\xd2\x86\x50\xd4\xe5\x83\x5f\x1e\x26\xc7\x47\x8c\xc6\x00\xd6\xe5\xe3\x8e\x33\x87\xd7\xa3\xa2\x53\x8e\xcf\xe8\x7e\xda\xfd\x8e\xa4\xfc\xa4\xf3\x93\xe7\x3f\xe5\x2f\x4d\x85\xa2\x2e\x93\xc6\x95\xba\x4b\xe3\x36\xf8\x3a\x52\xcc\x08\x68\x13\xbb\x5c\xc3\x38\xf4\xbc\x0d\x70\xf6\xce\x2e\x01\x8d\xd1\x26\x1f\x2c\x48\xcd\x18\xe0\x51\xd1\x47\x61\x81\x4d\x8d\x77\x04\x22\x9f\x21\x91\x47\x28\xe0\xce\x79\x86\x8a\x49\x39\x35\xa3\x4d\x27\x24\xf7\xfd\x79\x43\x3d\x05\x2c\x7b\xc6\x09\xb4\x41\x0f\xcd\xe8\x13\x68\xa4\x2f\xcb\x83\xf1\x1a\xd7\xe6\xaa\xb3\x4e\xb7\x61\xfd\xf4\x56\xfa\xc9\x0a\xb1\x94\x86\xc9\x30\x5f\xf4\x7c\xe9\x24\xcb\x1e\xad\x26\x9e\x29\x60\x3a\x62\xe2\xcf\x0d\x56\x28\x9e\xd1\xf8\xbb\xf1\xb9\xa8\xcb\xbf\x07\xdf\x3e\xc5\x45\x8d\x88\x43\xe0\x6f\x99\xfd\x4d\x4f\xb8\x8e\xe7\xf3\xc4\x0d\x5b\x77\xc0\x72\x9e\xaa\x30\x9a\x93\xee\x7f\x91\xe6\x4f\x4a\x4f\x5a\xa0\xb2\xa4\x95\xf8\xc7\x30\xbf\xe9\x7c\x75\x0e\xba\x15\x61\x70\x0c\x43\x80\xe0\xae\x8d\x07\xaa\x41\x02\xab\x0c\xe8\x64\x93\xff\x7e\x5a\x8b\x76\x68\xd3\x43\x8e\x7c\x0e\xab\x60\x91\xf4\xef\x64\x73\x8c\x86\x86\x58\xd8\x51\xf8\x89\xc2\x99\x62\xe3\x13\x81\x08\x53\x29\x61\xeb\x5c\x2c\xd2\xb2\x9f\xe3\xe6\xcf\x57\xe7\x00\xb5\x50\x9c\xe4\x13\x2b\x8d\xc9\x13\xc5\xec\xdd\x0c\x5f\x4a\xb3\x8e\x3b\x54\x90\xcf\x85\x59\x79\x89\x1a\xf0\x8b\xdf\x55\x03\xd6\x87\xd8\x13\xe5\xe8\xff\xe8\x9a\x98\xeb\x4e\xdc\x0a\x39\xff\xad\x90\xaf\xe7\xb2\x46\x5b\xe6\x6d\xe1\x58\x59\x1c\x46\x82\x9c\xa6\x0d\x7c\xfc\xb2\xfb\x5f\x1c\x4f\x5d\x39\x92\x81\x03\x1c\x21\xf5\x48\xde\x64\xcf\xd2\x9b\x11\x7a\xcc\xac\x66\xe6\xa2\x96\x10\x32\xe9\x6c\x39\x7c\x5d\xf4\x5e\x94\x41\xd7\x19\x15\x8f\xde\xf2\x2f\x17\x59\x16\xf3\x3b\xfa\xbb\x98\x9a\xc7\xb1\x16\xf8\x7f\x34\xb1\x90\x4a\x11\xbe\x1f\x69\x68\xe4\x79\xa2\xf5\xdb\x20\x50\x07\x26\xd7\xed\x8b\x70\x55\x03\x07\xd4\xd4\x1f\x98\xa5\xd2\x88\x41\x13\x17\x87\x81\x0b\x9d\x45\xf9\x5b\xf8\x00\xfb\xfd\x45\x3a\x62\x0e\x7b\xd1\xdb\x30\x4e\x3d\x63\x16\xfc\xc3\x45\xfd\x92\x32\x24\x86\x49\x09\xe9\x5f\x63\x96\xcf\xae\xea\xd6\x95\x37\xde\xb9\xfa\x2c\xac\x7d\x53\xbf\xb8\x04\xa1\x28\x0a\x09\xc6\x14\x8c\x23\x63\xf5\x12\xde\x7d\xc9\x2f\xde\xa2\x12\x13\xe1\xf4\x9f\x1a\xf4\x31\xc7\x38\x17\x7e\x67\x61\x72\xf8\x77\xa3\xef\xe3\x34\xf5\x5f\x69\xb4\xc8\xb5\xcc\x55\xcd\xc3\x29\xbe\xd5\x79\x3e\x74\xd4\x08\xfd\xf0\x5d\x1a\x25\xcc\xb6\xfd\xe3\x9f\x81\xd9\xec\x43\x2b\xb1\x76\x36\xbd\x3e\x4e\xc6\x53\xfb\xaa\x98\xf2\x5b\xb6\x7e\x46\x63\x04\xe7\xa8\xfc\x9e\xad\x0d\xfd\x09\xa5\xe9\x4b\x62\xb2\x32\xfc\x5b\x35\x64\x33\xb5\xd1\xd8\xe7\xcb\x71\xe4\xa5\x7f\x2a\xcd\x80\x49\x97\xd0\xff\x06\x00\x00\xff\xff\x6d\x1b\x1a\x15\x41\x13\x00\x00")
+var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5f\x6f\xe3\xb8\x11\x7f\xb6\x3f\xc5\xc0\xf0\x02\x71\x90\x95\xf7\xee\xad\x06\xfc\xb0\xcd\x66\x6f\xd3\xde\xa5\x8b\x26\xb9\x97\xc3\xa1\xa0\xa5\x91\xc5\x46\x22\x7d\x24\x95\x5c\x2a\xf8\xbb\x17\x33\xfc\x23\xc9\xb1\x73\xb7\x41\xfb\x62\x58\xe4\x70\x66\x7e\xbf\xe1\xcc\x90\xec\xba\xe5\xf9\xf4\x52\xef\x9e\x8d\xdc\x56\x0e\xbe\xff\xf0\xdd\x5f\xde\xef\x0c\x5a\x54\x0e\x3e\x8b\x1c\x37\x5a\x3f\xc0\xb5\xca\x33\xf8\x58\xd7\xc0\x42\x16\x68\xde\x3c\x62\x91\x4d\xef\x2a\x69\xc1\xea\xd6\xe4\x08\xb9\x2e\x10\xa4\x85\x5a\xe6\xa8\x2c\x16\xd0\xaa\x02\x0d\xb8\x0a\xe1\xe3\x4e\xe4\x15\xc2\xf7\xd9\x87\x38\x0b\xa5\x6e\x55\x31\x95\x8a\xe7\x7f\xbc\xbe\xbc\xba\xb9\xbd\x82\x52\xd6\x08\x61\xcc\x68\xed\xa0\x90\x06\x73\xa7\xcd\x33\xe8\x12\xdc\xc0\x98\x33\x88\xd9\xf4\x7c\xb9\xdf\x4f\xa7\x5d\x07\x05\x96\x52\x21\xcc\x1a\x74\x62\x06\x7e\xf0\x3d\x3c\x49\x57\x01\xfe\xee\x50\x15\x30\x87\xd9\x57\x91\x3f\x88\x2d\xce\x60\x9e\x85\xbf\xf0\x7e\xbf\x9f\x4e\xba\x0e\x1c\x36\xbb\x5a\x38\x84\x59\x85\xa2\x40\x33\x83\x8c\xb4\x74\x1d\xd0\xda\x60\xa4\x17\x92\xcd\x4e\x1b\x37\x83\x39\x09\x4d\x73\xad\xac\x83\xb3\xe9\x64\xb9\x84\x1f\xc5\x06\x6b\xa8\x74\x5d\x58\x46\x61\x9d\x91\x6a\x0b\x35\x0f\x17\xa8\xb4\xa3\x4f\x9a\xe9\x3a\xa8\xf5\x13\x1a\x98\x67\x37\xa2\x41\xd8\xef\xc1\x3d\xef\x12\xfc\x42\x38\xb1\x11\x16\xb3\xe9\xc4\xeb\x5c\xc3\xac\xeb\x60\x9e\xf9\xaf\xfd\x7e\xc6\xf6\x78\xe8\xfa\x53\x76\x49\x3e\x08\xe5\x48\xcd\x0b\xeb\x23\xbb\xb2\x80\x52\x62\x5d\x1c\x31\x74\x4c\x59\x34\x7b\xfd\x29\xbb\x75\xda\x88\x2d\xfe\x1d\x9f\xbd\x79\xa2\xd8\x08\xb5\x45\x98\x97\xb0\x5a\xc3\x3c\xfb\x4c\x8a\x2d\x91\x32\xe1\xd9\xb9\xb7\x44\x73\xe5\x50\xeb\x74\x12\x7d\xf7\x02\x7f\xe8\x74\x4f\x56\x99\xd8\x3a\x85\x62\x32\xd2\x1b\xfc\x2f\x8f\x7a\x1f\x83\x4b\x4b\x02\x12\xf4\x48\xae\x8a\x2d\x0e\x81\x60\xb1\xf5\x33\x78\x1c\x07\xcf\x7f\x03\x0c\x4c\x30\x78\xa5\xa2\x0f\xa9\xa0\x69\x9d\x70\x52\x2b\x1b\x71\x44\xbd\x01\x46\x5a\x76\x1a\xc0\x89\x50\x84\x59\x5b\x86\x68\xfc\xed\xf6\x1f\x37\x5f\x85\xab\x82\x08\xcb\xcc\x77\xc2\x55\x34\xbf\x33\x52\x39\x92\xba\x75\xa6\xcd\x1d\x87\x95\xf2\xc7\x55\x33\x98\xdb\x14\x82\xe9\x24\xe1\xe7\x95\x23\xfc\x64\x00\x1e\x30\x64\x2e\xef\xf7\xc1\x52\xa2\xa7\xcd\xdd\x78\x2b\x9e\x8c\x32\xb1\x31\x19\x9a\x09\x74\xd8\x32\x4b\xf1\x1c\xf2\xf1\x82\x9b\xb9\x6b\x76\x75\x42\x56\xc2\xac\x90\xa2\xc6\xdc\x2d\xdf\xd9\x25\xd5\x8c\x65\x1e\x82\x6a\xa9\x3a\x84\xad\x12\x34\xc1\xef\x29\xf1\xbd\x1a\xce\xfa\x05\x97\x04\x3f\x70\x5a\xed\xa3\x30\x52\x6c\x6a\x3c\x54\xdb\x75\x20\x4b\xa8\x84\xbd\x1b\xab\x7e\xcd\xe2\xa8\x18\x2d\xcf\xe1\x8b\xb0\x20\x1c\xd4\x28\xac\x03\xad\x30\x70\x79\xa6\xb4\x03\x54\x6d\xb3\xf0\xf5\xaf\xc0\x52\xb4\xb5\x83\x47\x51\xb7\x08\x5c\x31\x53\x82\xd8\x83\xbd\xe2\xdd\xe2\x64\xbf\xb7\x68\x3e\x71\x55\xa5\x14\x19\xac\x58\x83\xd8\xed\x28\x71\xe2\x00\x89\x7b\x91\xe0\x1e\x09\x57\xc2\x7e\x0a\x86\x57\x6b\x28\x45\x6d\x69\xb3\x1f\xee\xd2\x72\x6c\x58\xb0\xd6\x2c\x2e\x64\x24\xf3\x32\xbb\xb6\x57\x0c\x67\xbf\x3f\xd0\xbc\x06\x67\xda\xa0\xd7\xdb\xee\x9d\xf0\x1c\xfd\x80\x0a\x0d\xd1\xbb\xad\xf5\x46\xd4\x90\xe2\x01\xa5\x36\x50\x69\xfd\x60\x2f\x88\x19\x59\x08\xa7\x8d\x65\x0f\x76\xba\x96\xf9\x33\xe4\x15\xe6\x0f\x68\x6c\xa2\x4c\x96\xa0\xcd\xc8\xfe\x3c\xfb\x22\xec\xcf\xfd\x6a\xfe\xfe\x49\x18\x5b\x89\x1a\xf9\xfb\xa6\x6d\xbe\x90\x11\x3f\xf5\xd5\x6b\xde\xef\xa7\x00\x00\xb4\x47\xe7\x2a\x0a\xac\xd6\x43\xf1\x81\x88\x2c\x8f\x2d\x7e\xa9\x60\x0d\xa2\x28\x06\xdf\xdf\x0d\x95\x04\x52\x26\x51\x61\x92\x8a\x35\xec\x46\x3b\x04\x57\x09\xc7\xb9\xda\xd3\xb4\xc1\x5a\x3f\x81\x30\x54\x9d\xa4\x93\xa2\x96\xff\xc1\x02\x36\xcf\x2c\x66\x5a\xe5\x64\x83\x5e\xc3\x2e\xb4\x54\xed\x73\x39\x89\x73\x3d\x8b\x45\x40\xec\x76\xb5\xcc\x79\x28\x83\xbb\x0a\x0d\x96\xda\xe0\x85\xd7\x20\x1d\xd8\x4a\xb7\x75\x01\x1b\x04\xdf\x62\x31\xd5\x86\x46\x48\x05\xc2\x42\xa9\xeb\x5a\x3f\xd9\x15\x2f\xe1\x9f\x89\x17\x85\x7f\x85\x4e\x75\xa9\x55\x29\xb7\xa9\xc5\xef\xf7\xcb\xe0\xe7\x2c\xac\x19\x12\xf2\x28\x0c\x75\xee\x13\xc4\x4c\xfc\xff\x5f\xba\x6e\x34\xf3\x2b\x2a\x97\xd1\xd4\x41\xd5\x99\x1c\x8f\xd7\x64\x32\x09\x1f\xb4\xce\xff\x3d\xb6\xf2\xff\x99\x93\xa3\x26\x70\x90\x7c\xb1\xfc\xff\x99\x0c\x24\x59\x56\x35\x8f\x75\x65\xb5\x1e\xac\x08\x55\x7b\x3a\xe9\x3b\x43\x94\x1b\x35\x87\x38\xe8\x8b\x92\x56\x90\x1b\xe4\x5d\xc1\x79\x19\xdb\xc5\xb1\x2e\x30\x39\xd0\xd9\x27\x26\xb9\x79\x27\x1b\xc2\x97\x5d\xdb\xfb\x7b\xae\x4a\x65\xab\xf2\xb3\x05\x24\x22\x68\x75\x99\xdd\xd1\x41\xab\x07\x9e\x38\x4a\x01\x2c\xb3\xfb\x5d\x21\x1c\x46\x22\x4e\x03\x1f\xc9\xbd\x19\x7e\xcb\x5a\xde\x08\xbe\x47\xfe\x26\xbc\xdc\x25\xe6\x65\x36\x28\x64\x43\xb8\x7c\x34\x59\xad\x47\x12\x61\xb5\x17\xe0\x53\xeb\x6a\x0d\xa9\x09\x92\x0f\x70\xf6\xce\x2e\x00\x8d\xd1\x66\x16\x3d\x88\x6e\x0c\xbc\xe6\x43\x02\x8f\x78\x35\xeb\x3f\x54\x72\xb0\xab\x13\xcf\x2a\x90\x25\x2d\x88\xbe\xa2\x27\x46\x67\x23\x4a\x67\x81\x53\xb8\x76\x74\x63\xc9\x45\x5d\xf7\x55\x6d\xd3\xca\xba\xa0\xf2\xbd\xe1\xe2\x04\x56\x3c\x62\xcf\x7e\xb4\x93\x5c\x3e\x45\xab\x0f\x4c\xea\x06\x07\xee\x0e\x66\x62\x98\xa5\xdf\x1b\x79\x6b\x9d\x6e\xa0\x49\x0b\x75\xf9\xbf\x45\x70\xc4\x34\x91\x7d\x36\xda\x2a\x0b\x38\xfb\xe5\xd7\xcd\xb3\xc3\x0b\x1f\xc4\xc5\x6b\x20\xef\x55\x73\x12\xe6\x60\xee\x38\xd0\x56\xbd\x09\xea\x53\x85\xbe\xd1\x84\x13\xa4\x05\x9b\x0b\xa5\x70\x90\x28\x47\x8d\x33\xd4\x08\xed\xfc\x00\x33\x43\x7d\x0d\xe9\x6d\x2e\xd4\xa5\x46\xba\xee\x1e\x02\xed\xa7\xa2\x29\x67\x84\xb2\xa5\x36\x4d\xbc\x10\x68\x83\x05\xed\xcd\x16\xed\xab\x50\x63\xdc\x5c\x85\xcf\xdc\x7b\x0b\xa4\xcb\xf5\x9b\x28\x78\xe9\x16\x33\xf0\x6f\xab\x55\xf6\x4f\xf1\xf4\x13\x5a\x2b\xb6\xb8\x80\xc3\x91\x93\x71\x1f\x7e\x2c\x5e\x1c\x4a\xc3\x45\x3c\xc4\x96\x13\x84\x52\x90\xce\xa3\x10\x1a\x4e\x3c\x4f\x8d\x9a\x51\x46\x0d\x26\xf5\x3c\xea\xd1\x30\xe7\x45\xab\xf5\x20\x42\x7e\xdc\x60\x8e\xf2\x11\x0d\x2d\x4c\xff\xe7\x65\xf6\x57\x9f\xb8\x9f\xc3\xd5\x8f\x85\x7d\xd8\xbe\x08\xfb\x83\x4e\x3a\xfa\xf1\x71\x79\xe7\x3b\x40\x88\xe6\xb8\xa0\x43\x72\xa7\xbf\x7b\x24\x99\x9f\x29\xa0\xe9\x0e\x92\xb8\x21\x66\xfc\xa9\x97\xc7\x97\xe7\xa0\x1b\xe9\x8f\x57\xf1\xa8\xc4\xb5\xa4\x34\x44\x54\x85\x7c\xfb\xcf\xfc\x69\x33\xdc\xfd\xc8\x20\x9d\x71\x65\x13\x0f\x33\x81\x8a\xec\xd6\x5f\x2e\xfb\x87\x8c\xd1\x5d\x34\x38\xea\x63\x61\x93\xf2\x13\xed\xa5\x8f\x0d\xa5\x0e\x0b\x0e\xb5\xf8\x37\x84\x69\x88\xfc\x31\xde\x68\x47\x2c\xcf\x01\x4a\xa9\x0a\xd6\xcf\x4b\xf9\x30\x79\xa2\xe5\x11\x4c\xff\xf6\x32\x3a\x97\xc4\x3e\x43\x7b\x61\xd4\x84\x64\x09\xf8\x1b\xdd\xbd\x3d\xd7\x2f\xb9\x9f\x4e\x06\xb9\x88\xd9\x41\xa5\x89\xb6\x07\xb0\x08\xea\xeb\x21\x5f\x8f\x75\x25\x5f\x62\x7c\x4f\xa7\xc5\xcb\x48\x30\x68\x4b\x36\xd3\x5b\xd1\x9f\x01\x3e\x84\x72\x64\x07\x46\x3a\xfc\xd6\x63\x7d\xbd\x3f\x0b\x8a\x18\xa5\x3a\x9c\x8d\x72\x66\xac\x6a\x01\x7e\x27\x9d\x2d\xe2\x7b\x45\x47\xc8\x0c\xba\xd6\xa8\x30\x74\xb8\x9e\x5a\x41\xd8\xdf\x01\xef\xb4\xef\x8c\xc7\x0e\x0a\x81\x8c\xb7\x74\x68\xbe\xc8\x46\xfa\xbe\xa5\xd7\x31\xf2\x81\xd5\xd7\x49\xe0\x52\xc7\xd0\xed\x93\x74\x79\x05\x2f\xa4\x89\x95\x5c\x58\x3e\x97\x86\xa0\xc9\x8b\x97\x81\xf3\x95\x45\xd1\x2c\x7c\x80\xfd\xfe\x22\xb1\x74\xb4\x16\x1d\x86\xb1\xaf\x19\xa3\xe0\x0f\x95\xf8\x00\xd3\xf5\x27\x85\x49\xc9\x9a\x3e\xc3\x2e\x1f\x4d\x95\x8d\xcb\xae\x08\x5c\x79\xc6\xb6\x06\xf5\x62\x05\x52\xf1\x39\x69\xc0\x31\x07\x63\x5c\x1d\xb8\x68\xaf\xe0\xdd\x6f\xb3\x0b\x38\xbe\x11\x4e\x3f\x93\xf2\xcb\x8a\x28\x0a\x49\x27\x7b\x51\xc7\xf7\xd2\xae\xf3\x2f\x13\xfc\xe4\xc1\xd7\x9d\x46\xb8\xbc\xba\x3b\xb5\x6e\x79\x3e\x8b\x15\x35\x50\x1f\x5f\x73\x82\x86\xd1\x9d\xf8\xf8\xe3\xc9\x60\xbb\x8e\xbd\xed\xff\x2e\xcf\xe1\x63\xef\x3c\x97\xaf\x5c\x28\xba\x8b\xea\x47\x34\x46\x16\x05\x2a\xba\x8d\x6a\xc3\xcf\xda\x9a\xef\xdb\xbd\x97\xfe\xfd\x3b\xee\x66\x2e\xa3\xa1\xce\x87\xa2\x7e\xf0\x4c\x3d\x00\x38\x1b\x86\x76\xfa\xdf\x01\x00\x71\x53\xf2\x2d\x93\x17\x00\x00")
 
 func templateMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -981,12 +981,12 @@ func templateMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/meta.tmpl", size: 4929, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/meta.tmpl", size: 6035, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4d\x6f\xdc\x36\x10\x3d\x4b\xbf\x62\xaa\xb6\xe9\x6e\xb0\xa6\x1c\x17\x05\xda\x6d\x7c\x48\x6d\xa7\x58\xa0\x75\x53\x38\x41\x7a\x0c\x97\x1c\x49\x84\x29\x52\x21\x47\xfb\x81\xc5\xfe\xf7\x82\xa4\xb4\x1f\xf9\x40\x7a\x68\x51\x5f\x16\x1e\x0e\xdf\x9b\x37\x6f\x48\x6a\xb7\x2b\x9f\xe6\x37\xb6\xdb\x3a\x55\x37\x04\x57\x97\xcf\x7e\xba\xe8\x1c\x7a\x34\x04\x2f\xb9\xc0\xa5\xb5\x8f\xb0\x30\x82\xc1\x0b\xad\x21\x26\x79\x08\xeb\x6e\x85\x92\xe5\xaf\x1b\xe5\xc1\xdb\xde\x09\x04\x61\x25\x82\xf2\xa0\x95\x40\xe3\x51\x42\x6f\x24\x3a\xa0\x06\xe1\x45\xc7\x45\x83\x70\xc5\x2e\xc7\x55\xa8\x6c\x6f\x64\xae\x4c\x5c\xff\x6d\x71\x73\x77\xff\x70\x07\x95\xd2\x08\x43\xcc\x59\x4b\x20\x95\x43\x41\xd6\x6d\xc1\x56\x40\x27\x64\xe4\x10\x59\xfe\xb4\xdc\xef\xf3\x7c\xb7\x03\x89\x95\x32\x08\x45\xab\x6a\xc7\x09\x0b\x48\xf1\x0b\x58\x2b\x6a\x00\x37\x84\x46\xc2\x37\x50\xbc\xe2\xe2\x91\xd7\x58\x9c\x64\x5e\xec\xf7\x79\xb6\xdb\x01\x61\xdb\x69\x4e\x08\x45\x83\x5c\xa2\x2b\x80\x05\x94\xdd\x0e\xc2\xde\x80\xa7\xda\xce\x3a\x82\x49\x9e\x15\xc2\x1a\xc2\x0d\x15\x79\x56\x54\x2d\x15\x79\x9e\x15\xb5\xa2\xa6\x5f\x32\x61\xdb\xb2\x1a\x1a\x57\xa2\xa1\x52\x2a\xae\x51\xc4\xd4\x2f\xa4\x94\xfe\xbd\x2e\xbd\x68\xb0\xe5\x45\x3e\xcd\xf3\x15\x77\x81\xac\x2c\xe1\xad\xa2\xe6\x57\x6d\x97\x5c\xbf\x31\xea\x7d\x8f\x8b\x5b\xf0\x48\x3e\xf6\xa9\x37\x6a\x85\xce\x73\x0d\x4a\x7a\xb0\x1d\x29\x6b\x3c\x90\x8d\x8b\x49\xa5\xb2\x86\x45\x9c\xc5\xd0\xc4\x94\x15\xcc\x42\xc3\x97\x1a\xe5\x0c\x82\xe1\x87\x6c\x58\x2b\xad\x81\x6b\x6d\x45\xe8\x08\x87\x67\xcf\x9f\x7f\x7f\x05\x8e\x9b\x1a\x23\x50\x65\x93\xb1\x91\xb2\x02\xe4\xa2\x09\x08\x8a\xb6\x30\xa1\x80\x38\x4d\x84\xf7\x96\x10\xa8\xe1\x74\xc6\x2b\xb8\x31\x96\x60\x89\xc0\xbb\x4e\x2b\x94\x60\x0d\xc4\x6d\x3e\x25\x73\xed\x90\xcb\x2d\xe0\x46\x79\x62\x79\xf6\x09\xfd\xd7\x90\x3a\xc5\x3e\x5e\x3b\xb4\xec\xd6\xd9\xee\xc6\xea\xbe\x35\xc7\x76\x49\x67\x3b\x10\x29\x38\x94\xf3\x6f\xf4\x2a\xc2\x5a\x2d\x07\x68\x1f\x21\xa2\x96\x35\x3a\x84\x3e\x9c\x87\xd0\xb4\xa5\xa5\x06\x2a\x85\x5a\x7a\xe0\x46\x02\xca\x1a\x3d\x83\x78\x8e\x24\x56\xbc\xd7\x14\xcd\xab\xb8\xf6\x38\x28\x3f\x91\x71\xa6\xfa\x18\x3f\x53\xbc\x30\x12\x37\x1f\x08\x56\x31\xf6\x5f\xe8\x8d\xc8\xf8\xa1\xde\x74\x1e\xe5\x78\x96\x87\xa2\x3f\x2f\xf3\x6c\x54\xfa\xe8\x23\x08\x6b\x3c\x39\xae\x0c\x79\xe0\x27\x98\xbd\x57\xa6\x86\x77\x6f\xee\x17\x7f\xbe\xb9\x83\xc5\xfd\xed\xdd\x5f\xef\x66\x11\x22\x34\x94\x1a\x74\x58\x59\x87\x33\x50\xf4\x5d\xb8\xab\x84\x6d\x5b\x34\x12\x65\x20\x4c\x9a\xce\x94\x92\x85\x1a\x09\x5a\xeb\x86\xd9\xd6\xb8\x51\x4b\xa5\xc3\x30\x9f\xd5\x0f\xa2\x09\x07\xc0\x9f\xd8\x92\x7a\xfd\x91\x2b\x31\x7c\x30\xe5\xa5\xda\x50\xef\xf0\x68\x49\x28\x4f\xd5\xe6\xe2\x11\xb7\xe0\xd0\xf0\x36\x08\xfa\x8c\x39\xb0\x6e\xd0\x40\xdf\xd5\x8e\x4b\x65\xea\x08\x1a\xfc\xa8\x9c\x6d\x61\x75\xc9\x9e\xb1\x4b\x98\x28\xef\x7b\xbc\xf8\xfa\xea\xc7\x1f\xa6\x0c\x6e\x4f\xfa\x4b\xae\x1f\xa7\x68\xac\xe2\xac\xd8\x21\x18\x6e\x9b\xb2\x84\x87\x24\x53\xa5\x2a\x5f\xbc\x5a\xc4\x91\x15\x0e\x39\x29\x53\xcf\xc6\xa2\x4c\x1d\x3b\x1d\x46\xa0\x8b\xff\x8c\x88\x39\x6d\x3b\x1c\x51\x3c\xb9\x5e\x10\xec\xf2\x4c\xba\x15\x8c\x7f\xc3\x55\xc7\x6e\x5d\xb8\xb5\xf2\xec\x70\x7b\x2d\x6e\x61\x69\xad\xce\xf7\xb1\x92\x7b\x5c\x0f\x30\x91\x1d\x3d\x70\x30\xb8\x3e\x18\xa1\x15\x1a\x62\x79\xd5\x1b\x71\xcc\x9d\x04\xa2\x73\x82\x29\x3c\x1d\x70\x76\xe0\x90\x7a\x67\xe0\x49\x0a\xec\xa4\x5b\xcd\x41\xba\xd5\x1e\x12\xe5\x4d\x24\x3a\xf2\x69\x3d\xb2\x39\x4c\x8f\x8e\x1f\x08\x27\x7e\x44\x9d\x0e\xbb\x26\x82\x36\x30\xbc\x09\xec\x26\xfd\xce\x82\xa3\x1e\x18\x63\x43\x77\x7e\x4f\x2f\xce\x1f\xd1\xe7\x29\xa0\x73\xd6\x85\xf6\x0c\x2f\xd1\x2c\x44\x60\x7e\xb0\xe7\x1e\xd7\xc3\x8e\x89\x67\xd2\xad\x12\x1e\x63\x6c\x9a\x67\xaa\x8a\xc9\x5f\x5d\x83\x51\x3a\x60\x64\x83\xb8\xaa\x25\x76\x17\x80\xab\x49\x11\x5e\x96\x01\x7b\x0e\xdf\xae\x8a\x48\x30\xcd\xb3\x7d\x3e\x66\x0f\xab\xec\x28\x62\x06\xaf\xe3\x35\x1c\x69\x52\x5f\xde\x3a\x45\xf8\xda\xc2\x3a\xfc\xfa\x4f\x1c\x87\x30\x67\x6b\x50\xc6\x13\x72\x19\x5e\x03\xd7\x1b\x13\xe6\x82\x1a\x6c\x81\xd7\x3c\x2c\xa5\xab\x88\x13\x5f\xf2\x70\xe0\xcb\x32\x40\x8f\x3a\xe6\xd7\xa3\xa3\x0f\xc3\x68\x26\xce\xc9\xd8\xd2\x5f\xb8\x78\xac\x5d\xf8\x62\x98\x4c\x67\x60\x3d\x7b\x20\x69\x7b\x9a\xfe\x7c\xde\x86\xb2\xcc\x32\x6d\x6b\xf6\x92\x13\xd7\x93\xa8\x36\xb0\xec\x03\xdd\x47\xce\x1d\x38\x3e\x65\xdd\x1a\x94\x4d\x55\xb8\x7f\xec\x63\x98\xbe\xf9\x35\x3c\xf1\x27\x1a\xd2\x14\x06\x83\x12\xd8\x1c\xd6\xb3\x3c\xcb\x52\x78\x0e\xc9\xd8\x68\xc9\x97\xa7\xe0\x7f\x9a\x81\xe3\x07\xd0\xdf\x01\x00\x00\xff\xff\x77\x0b\x04\xcc\x2c\x0a\x00\x00")
+var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5d\x6f\xdc\xb6\x12\x7d\x96\x7e\xc5\x5c\xdd\x7b\xd3\xdd\x60\xcd\x75\x5c\x04\x4d\xdd\xf8\x21\xb5\x9d\x62\x8b\x64\x93\xc0\x0e\xd2\xc7\x70\xc5\x91\x44\x98\x22\x15\x72\xb4\x1f\x58\xec\x7f\x2f\x86\x92\xf6\x23\x1f\x48\x1f\x5a\x34\x2f\x8e\x87\xe4\x39\x33\xe7\xcc\x8c\xbc\xdd\x4e\x1f\xa7\xd7\xae\xd9\x78\x5d\x56\x04\x17\xe7\x4f\x7e\x3e\x6b\x3c\x06\xb4\x04\x2f\x65\x8e\x0b\xe7\x1e\x60\x66\x73\x01\x2f\x8c\x81\x78\x29\x00\x9f\xfb\x25\x2a\x91\xde\x57\x3a\x40\x70\xad\xcf\x11\x72\xa7\x10\x74\x00\xa3\x73\xb4\x01\x15\xb4\x56\xa1\x07\xaa\x10\x5e\x34\x32\xaf\x10\x2e\xc4\xf9\x70\x0a\x85\x6b\xad\x4a\xb5\x8d\xe7\xaf\x66\xd7\xb7\xf3\xbb\x5b\x28\xb4\x41\xe8\x63\xde\x39\x02\xa5\x3d\xe6\xe4\xfc\x06\x5c\x01\x74\x44\x46\x1e\x51\xa4\x8f\xa7\xbb\x5d\x9a\x6e\xb7\xa0\xb0\xd0\x16\x21\xab\x75\xe9\x25\x61\x06\x5d\xfc\x0c\x56\x9a\x2a\xc0\x35\xa1\x55\xf0\x3f\xc8\xde\xca\xfc\x41\x96\x98\x1d\xdd\x3c\xdb\xed\xd2\x64\xbb\x05\xc2\xba\x31\x92\x10\xb2\x0a\xa5\x42\x9f\x81\x60\x94\xed\x16\xf8\x2d\xe3\xe9\xba\x71\x9e\x60\x94\x26\x59\xee\x2c\xe1\x9a\xb2\x34\xc9\x8a\x9a\xb2\x34\x4d\xb2\x52\x53\xd5\x2e\x44\xee\xea\x69\xd1\x0b\x37\x45\x4b\x53\xa5\xa5\xc1\x9c\xb2\xef\x5f\x99\x86\x4f\x66\x1a\xf2\x0a\x6b\x99\xa5\xe3\x34\x5d\x4a\xcf\x64\xd3\x29\x7c\xd0\x54\xfd\x66\xdc\x42\x9a\xf7\x56\x7f\x6a\x71\x76\x03\x01\x29\x44\x9d\x5a\xab\x97\xe8\x83\x34\xa0\x55\x00\xd7\x90\x76\x36\x00\xb9\x78\xd8\x55\xa9\x9d\x15\x11\x67\xd6\x8b\xd8\xdd\x62\xb3\xd0\xca\x85\x41\x35\x01\x36\x7c\x7f\x1b\x56\xda\x18\x90\xc6\xb8\x9c\x15\x91\xf0\xe4\xf9\xf3\x1f\x2f\xc0\x4b\x5b\x62\x04\x2a\x5c\x67\x6c\xa4\x2c\x00\x65\x5e\x31\x82\xa6\x0d\x8c\x88\x11\xc7\x1d\xe1\xdc\x11\x02\x55\x92\x4e\x78\x73\x69\xad\x23\x58\x20\xc8\xa6\x31\x1a\x15\x38\x0b\xf1\x19\x97\x24\x09\xa4\xf1\x28\xd5\x06\x70\xad\x03\x89\x34\xf9\x4a\xfd\x57\xd0\x29\x25\xbe\x3c\xdb\x4b\x76\xe3\x5d\x73\xed\x4c\x5b\xdb\x83\x5c\xca\xbb\x06\xf2\x2e\xd8\xa7\xf3\x77\x68\x15\x61\x9d\x51\x3d\x74\x88\x39\xc4\x5a\x56\xe8\x11\x5a\x9e\x07\x16\x6d\xe1\xa8\x82\x42\xa3\x51\x01\xa4\x55\x80\xaa\xc4\x20\x20\xce\x91\xc2\x42\xb6\x86\x6d\x75\x50\x48\x13\xb0\xaf\xfc\xa8\x8c\x93\xaa\x0f\xf1\x93\x8a\x67\x56\xe1\xfa\xb3\x82\x75\x8c\xfd\x13\xf5\x46\x64\xfc\xbc\xde\x6e\x1e\xd5\x30\xcb\x7d\xd2\xdf\x2e\xf3\xa4\x55\xda\xd8\xe3\x90\x3b\x1b\xc8\x4b\x6d\x29\x80\x3c\xc2\x6c\x83\xb6\x25\x7c\x7c\x3f\x9f\xbd\x7b\x7f\x0b\xb3\xf9\xcd\xed\x1f\x1f\x27\x11\x82\x05\xa5\x0a\x3d\x16\xce\xe3\x04\x34\xfd\xc0\xbb\x2a\x77\x75\x8d\x56\xa1\x62\xc2\xce\xc3\x93\x4a\xc9\x41\x89\x04\xb5\xf3\x7d\x6f\x1b\x5c\xeb\x85\x36\xdc\xcc\x27\xf9\x43\x5e\xf1\x00\x84\x23\x5b\x3a\xad\xbf\x70\x25\x86\xf7\xa6\xbc\xd4\x6b\x6a\x3d\x1e\x2c\xe1\xf4\x74\x69\xcf\x1e\x70\x03\x1e\xad\xac\xb9\xa0\x6f\x98\x03\xab\x0a\x2d\xb4\x4d\xe9\xa5\xd2\xb6\x8c\xa0\xec\x47\xe1\x5d\x0d\xcb\x73\xf1\x44\x9c\xc3\x48\x87\xd0\xe2\xd9\x7f\x2f\x9e\x3d\x1d\x0b\xb8\x39\xd2\x97\x7c\x3b\x74\xd1\x90\xc5\x49\xb2\x7d\x30\xa2\xde\xb5\x0d\xef\xb7\xf0\xfb\xdd\x9b\x39\x78\x8c\xff\x07\xcd\x5d\xc1\xbb\xdd\x5a\xcc\x09\x15\x28\x49\x72\x21\x03\x42\xe8\xaf\xc7\xf3\xf8\x86\x36\x0d\xee\x7d\x28\x5a\x9b\xf7\xab\x88\xa7\x40\x0e\x43\xb0\xd8\x1c\x1e\x34\x1e\x95\xe6\x0d\x13\x60\x84\xa2\x14\xf0\x7a\x73\xf7\xee\x15\x3c\x15\x3f\x89\x67\xbc\x40\x4e\x32\xda\xe7\x7d\x1c\xe5\x35\xc9\xa9\x77\xfe\x68\x26\x43\x78\xf1\x76\x16\x67\x2d\xf7\x28\x49\xdb\x72\x32\xa8\x69\xcb\x38\x73\x3c\x11\x0d\x4b\x2e\x07\xc8\x94\x53\x1f\x50\x02\xf9\x36\x27\xd8\xa6\x89\xf2\x4b\x18\xfe\xf5\x3b\x5a\xdc\x78\x5e\xb7\x69\xb2\x5f\xbb\xb3\x1b\x58\x38\x67\xd2\x5d\xcc\x64\x8e\xab\x1e\x26\xb2\x63\x00\x09\x16\x57\x3d\x11\xe4\x46\xa3\x25\x91\xb2\x3a\x87\xbb\x23\x26\x3a\x25\x18\xc3\xe3\x1e\x67\x0b\x1e\xa9\xf5\x16\x1e\x75\x81\xad\xf2\xcb\x4b\x50\x7e\xb9\x83\x8e\xf2\x3a\x12\x1d\xf8\x8c\x19\xd8\x3c\x76\x5f\xcb\xd0\x13\x8e\xc2\x80\x3a\xee\x5f\x8d\x72\x5a\x43\xff\x31\x13\xd7\xdd\xcf\x09\xaf\x80\x00\x42\x88\x5e\x9d\xd7\x51\x3d\x7c\x13\x1b\x74\x0c\xe8\xbd\xf3\x2c\x4f\xff\x09\x9d\x70\x04\x2e\xf7\xfe\xcc\x71\xd5\xbf\x18\x05\xa1\xfc\xb2\xc3\x13\x42\x8c\xd3\x44\x17\xf1\xf2\x7f\xae\xc0\x6a\xc3\x18\x49\x5f\x5c\x51\x93\xb8\x65\xe0\x62\x94\xf1\x57\xb3\xc7\xbe\x84\xff\x2f\xb3\x48\x30\x4e\x93\x5d\x3a\xdc\xee\x4f\xc5\xa1\x88\x09\xdc\xf3\x60\x77\x34\x9d\x2e\x1f\xbc\x26\xbc\x77\xb0\xe2\x9f\xe1\x2b\x73\xcc\xb3\xb6\x02\x6d\x03\xa1\x54\xfc\x07\x86\x6f\xad\xe5\xbe\xa0\x0a\x6b\x90\xa5\xe4\xa3\xf8\x6e\x68\x7a\x91\x4e\xa7\x0c\x3d\xd4\x71\x79\x35\x38\x7a\xd7\xcf\x14\x73\xdd\xbb\xd1\x20\xe9\xaf\x32\x7f\x28\x3d\xff\xa9\x33\x1a\x4f\xc0\x05\x71\x47\xca\xb5\x34\xfe\xe5\x54\x86\xe9\x34\x49\x8c\x2b\xc5\x4b\x49\xd2\x8c\x62\xb5\xcc\xb2\x63\xba\x2f\x9c\xdb\x73\x7c\xcd\xba\x15\x68\xd7\x65\xe1\xff\xb2\x8f\xdc\x7d\x97\x57\xf0\xa8\xbf\x16\x5f\x77\x5d\xc8\x06\xc5\x5f\xfd\x25\xac\x26\x69\x92\x74\xe1\x4b\xe8\x8c\x8d\x96\x7c\xbf\x0b\xfe\xa5\x1e\xd8\x6e\x01\xad\x82\xdd\x2e\xfd\x73\x00\x76\x1c\x9a\xbe\xe5\x0a\x00\x00")
 
 func templateMigrateMigrateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1001,12 +1001,12 @@ func templateMigrateMigrateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 2604, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 2789, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4b\x6f\xdc\x36\x10\x3e\x4b\xbf\x62\x20\x6c\x8b\xc4\xd8\x95\x6c\xdf\x2a\x60\x0f\x86\xe3\x00\x46\x0a\x37\xa8\x9d\x93\x61\x14\x34\x35\x5a\x11\x2b\x91\x32\x45\xb9\xde\xaa\xfa\xef\x05\x1f\x7a\xed\xcb\x9b\x26\xbe\x58\x24\xe7\xc5\x6f\xe6\x9b\xe1\x36\x4d\x74\xe6\x5f\x8b\x72\x23\xd9\x2a\x53\x70\x79\x7e\xf1\xdb\xa2\x94\x58\x21\x57\xf0\x99\x50\x7c\x16\x62\x0d\xb7\x9c\x86\x70\x95\xe7\x60\x84\x2a\xd0\xe7\xf2\x15\x93\xd0\x7f\xc8\x58\x05\x95\xa8\x25\x45\xa0\x22\x41\x60\x15\xe4\x8c\x22\xaf\x30\x81\x9a\x27\x28\x41\x65\x08\x57\x25\xa1\x19\xc2\x65\x78\xde\x9d\x42\x2a\x6a\x9e\xf8\x8c\x9b\xf3\xdf\x6f\xaf\x6f\xee\xee\x6f\x20\x65\x39\x82\xdb\x93\x42\x28\x48\x98\x44\xaa\x84\xdc\x80\x48\x41\x8d\x9c\x29\x89\x18\xfa\x67\x51\xdb\xfa\x7e\xd3\x40\x82\x29\xe3\x08\x41\x45\x33\x2c\x48\x00\x76\x7b\x01\x7f\x33\x95\x01\xbe\x29\xe4\x09\xcc\x20\xf8\x4a\xe8\x9a\xac\x30\x80\xa0\x60\x2b\x49\x14\x06\xb0\x68\x5b\xdf\x6b\x1a\x50\x58\x94\x39\x51\x08\x41\x86\x24\x41\x19\x40\xa8\xad\x34\x0d\x68\x5d\x6d\x8f\x15\xa5\x90\x0a\x3e\x18\x71\x49\xf8\x0a\x61\xf6\xd7\x1c\x66\x1c\xe2\x25\xcc\xc2\x3b\x91\x60\xa5\x05\x3d\x2f\x68\x1a\x98\x85\xd7\x82\xa7\x6c\x15\x3a\x9f\xd0\xb6\x91\xde\xe6\xa3\x8d\x40\x9b\x5a\xf4\x0e\xbc\x60\xc5\x54\x56\x3f\x87\x54\x14\x51\xea\xc0\x8f\x90\xab\xc8\x5e\x2b\x4a\x19\xe6\x49\x70\x44\x2e\x61\x24\x47\xaa\xa2\xea\x25\x77\x3a\x81\xff\xd1\xf7\x5f\x89\xb4\x61\x2f\xc6\x71\x2b\x1b\xf7\x03\x79\xce\xbb\xc0\xb5\x44\x74\x06\x29\xe3\x09\xa8\x4d\x89\xc0\x4d\x4e\x6d\x42\x56\x92\x94\x59\x9f\x07\xa5\xd5\xe6\xc0\x52\xc0\x37\x56\xa9\x0a\x4c\x2e\xac\x89\x99\x51\x8b\x97\xc0\x78\x82\x6f\x3d\x36\xe7\x83\x93\xc3\xf0\x35\x8d\xb1\xf9\x02\x33\x15\xde\x91\x02\x35\x62\x26\x44\x7b\x66\x4d\x2f\xb5\x9a\x59\x5b\xec\x86\x2c\xb9\x00\xa8\xc8\xeb\x82\x57\xda\x74\x49\x2a\x4a\xf2\xde\xdc\xbf\x50\x4a\xc6\x55\x0a\xc1\x2f\xd5\xb5\x95\x0a\xac\x62\x14\x81\x76\xd0\xa9\xb6\x2d\x64\x22\x4f\x2a\x73\xf7\x6e\x33\x15\xb6\xa0\x4d\x86\x9d\xc5\xb6\x0d\x2c\x1a\xa1\xf1\x3e\xb1\xb0\x84\xc7\xa7\x33\x9b\x89\xd0\x7a\x6b\x7c\x6f\x02\x01\x35\xd7\x57\xee\xd4\xe5\xc1\xf3\x1a\xd0\xb6\x63\xeb\x88\xf6\x8e\xe6\xf0\xb0\x29\x31\x06\x53\x09\xa1\x3d\xd3\x3b\xba\xd8\x2a\xe5\xa4\xe6\xd6\x42\xb3\xd0\x48\xce\x68\xf8\x8d\xb3\x97\x5a\x1f\x80\xfd\x8a\x41\xc9\x1a\xe7\x63\xd0\xc6\xe2\xb7\x9c\x4a\x2c\x74\x03\x68\x5b\xe8\x17\xef\x28\xdd\xd5\x79\xee\xb2\x04\xdd\x77\x0c\x2e\xf8\xe1\x6c\x8f\xbe\xa1\xe8\x8c\x86\xf7\xec\x1f\xa3\xad\xff\x1b\xcd\xf0\xb8\xfc\x95\x52\x52\xcb\xeb\xff\x16\xa7\xd0\x20\x74\x58\xe3\x86\xd7\x85\xc9\x8a\xf9\x88\xe1\xf1\xa9\x52\x92\xf1\x55\x03\x03\xa1\x4d\xd9\x1a\x43\x3a\x76\x9c\x5a\x84\x63\xf1\x7c\xc2\x94\xd4\xb9\x01\xcd\x7d\x9e\x72\x8b\x7b\x53\x1b\x3a\x85\xe6\xee\xfd\x2a\x86\x82\x94\x8f\x36\xbe\x3d\x61\xae\xe7\x30\x7b\x9d\x84\xba\xd6\x1f\xae\x5e\x5e\xa7\x61\x0f\xf4\xb0\xa5\x31\xea\x39\x9e\xd7\x53\xc6\x94\xf0\x3b\x84\x31\x44\x9c\xd2\x45\x75\x59\x1f\xc8\x62\xeb\x1d\x18\x4f\x85\x2c\x88\x62\x82\x9f\xc6\x9b\xde\xd4\x12\x7e\x75\x9c\x31\x0e\x0d\x65\x46\x74\x18\xf4\xcd\x75\x1c\x73\xe2\x2d\xf6\x9a\xb3\xaf\x92\x15\x44\x6e\xbe\xe0\x26\xde\xcf\xc4\xed\x6e\x54\xae\x1d\x1f\x07\xcd\x2e\x6d\x63\x51\x36\x3f\xc8\xdc\x9e\x15\xba\x87\x95\x6b\xd7\xc4\x7a\x0a\x4f\x83\x7c\xd4\x4b\x06\x6d\xfb\xb4\x55\x23\xd3\x24\x6d\x2f\xed\xe5\x3e\x0b\x89\x6c\xc5\xbf\xe0\xa6\x1a\xdf\x6e\xd8\xde\x7b\xc3\xb4\xbb\xe1\x48\x7d\xf0\xea\xae\x70\xbf\x29\x9e\x45\xee\xf0\x4e\xd7\xa1\x5d\xf7\x90\x8f\x51\xdf\x0f\xab\x07\xb0\xe3\x99\x5e\x18\xcf\xe9\x7a\x17\xb2\x5d\x70\x2f\x0f\xa1\x3b\x05\x98\x5e\x74\x00\x5f\x7e\x2f\xc2\xbb\x20\xef\xdb\x69\xe7\x7d\x56\xa3\x33\x28\x45\xa5\x4a\xc1\x11\x24\xa6\x12\x39\x65\x7c\x05\x4a\x00\x79\x15\xcc\x4e\x4c\x9a\x21\x5d\xeb\xdd\x5c\x88\xb2\x1f\x8a\xfa\xef\x4f\x4c\x7f\x08\xb3\x41\xff\x7d\xd8\xac\xb8\x21\xcf\xff\x03\xb0\xeb\x01\x63\x43\xc7\xc6\xe7\x4f\x44\xb9\xeb\x8d\xe9\x3a\xfc\x83\x7f\x2b\x13\xa2\xa6\xd3\xad\xb3\xd1\x1d\xc6\xae\xdf\x84\x5d\xb3\xf5\x0f\xf8\xd8\x32\xfd\x09\x73\x3c\x68\xda\x1e\x9e\x6a\x7a\x34\x71\xb7\x39\xda\x4d\x48\x15\xde\xea\xb7\x10\xf6\x79\x70\xcb\x71\x2d\x98\xad\x66\xa7\xd7\xe8\x32\x60\xc9\x9b\xe3\xc3\x96\x99\x81\xb2\xe3\x0e\xc9\x92\xb7\x69\x8f\xd4\x7f\xdd\xf0\xef\x04\xfa\x67\x41\x2f\xf1\x5e\x7d\xee\xc6\xe5\xca\x53\x9b\x3b\x54\x67\xa7\x92\xfa\xe7\xb1\x7a\x4f\xc1\xed\xd9\xea\xaf\xdd\x7d\x6c\x89\xec\x9f\x95\xe3\x75\x14\x81\x7b\x3c\xdb\xd9\x47\xf2\xdc\x0c\x39\x65\x37\xdd\xb3\xd9\x01\xe9\x7b\x4e\x76\xfc\x24\xec\xc7\xdb\xfb\x4f\x73\x6f\xc4\xca\x63\x93\x79\xee\x4f\x83\x6e\xf5\x0f\x80\xb4\xe6\x14\x18\x67\xea\xc3\x47\x68\x4e\xfd\x21\xf0\xdd\x2f\x82\xad\x6c\x1f\x19\x34\xe3\x69\x3f\x3e\x1e\xd2\xda\xb7\x1d\x58\xc2\xa9\xfd\x68\x3b\x96\x0e\x82\xd1\xb7\xfd\xb5\xe8\x16\xff\x05\x00\x00\xff\xff\xa6\xf3\x85\xd8\xfc\x0e\x00\x00")
+var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5b\x6f\xdb\x38\x16\x7e\x96\x7e\xc5\x81\xe0\x5d\x24\x81\x23\xa5\x79\x5b\x03\x79\xe8\xa6\xe9\x22\xdb\x41\x5a\x34\xe9\x53\x50\x0c\x18\xea\xc8\x26\x2c\x91\x32\x45\x67\xe2\xd1\xe8\xbf\x0f\x78\x93\x28\x5f\x12\xb7\xd3\x27\x8b\x3c\xf7\xef\x90\x9f\x8e\xdc\xb6\xd9\x59\x7c\x2d\xea\x8d\x64\xf3\x85\x82\xcb\x8b\x77\xff\x39\xaf\x25\x36\xc8\x15\x7c\x24\x14\x9f\x84\x58\xc2\x2d\xa7\x29\xbc\x2f\x4b\x30\x4a\x0d\x68\xb9\x7c\xc6\x3c\x8d\x1f\x16\xac\x81\x46\xac\x25\x45\xa0\x22\x47\x60\x0d\x94\x8c\x22\x6f\x30\x87\x35\xcf\x51\x82\x5a\x20\xbc\xaf\x09\x5d\x20\x5c\xa6\x17\x5e\x0a\x85\x58\xf3\x3c\x66\xdc\xc8\x7f\xbb\xbd\xbe\xb9\xbb\xbf\x81\x82\x95\x08\x6e\x4f\x0a\xa1\x20\x67\x12\xa9\x12\x72\x03\xa2\x00\x15\x04\x53\x12\x31\x8d\xcf\xb2\xae\x8b\xe3\xb6\x85\x1c\x0b\xc6\x11\x92\x86\x2e\xb0\x22\x09\xd8\xed\x73\xf8\x83\xa9\x05\xe0\x8b\x42\x9e\xc3\x04\x92\x2f\x84\x2e\xc9\x1c\x13\x48\x2a\x36\x97\x44\x61\x02\xe7\x5d\x17\x47\x6d\x0b\x0a\xab\xba\x24\x0a\x21\x59\x20\xc9\x51\x26\x90\x6a\x2f\x6d\x0b\xda\x56\xfb\x63\x55\x2d\xa4\x82\x13\xa3\x2e\x09\x9f\x23\x4c\x7e\x9f\xc2\x84\xc3\xec\x0a\x26\xe9\x9d\xc8\xb1\xd1\x26\x51\x94\xb4\x2d\x4c\xd2\x6b\xc1\x0b\x36\x4f\x5d\x4c\xe8\xba\x4c\x6f\xf3\x60\x23\xd1\xae\xce\xfb\x00\x51\x32\x67\x6a\xb1\x7e\x4a\xa9\xa8\xb2\xc2\x81\x9f\x21\x57\x99\x2d\x2b\x2b\x18\x96\x79\xf2\x8a\x5e\xce\x48\x89\x54\x65\xcd\xaa\x3c\x52\xcd\xb9\x4e\xe2\xd3\x38\x7e\x26\xd2\x56\x77\x1e\x96\xa7\x6c\x79\x0f\xe4\xa9\xf4\xf5\x69\x8d\xec\x0c\x0a\xc6\x73\x50\x9b\x1a\x81\x9b\xd6\xdb\xbe\xcd\x25\xa9\x17\x7d\xbb\x94\x36\x9b\x02\x2b\x00\x5f\x58\xa3\x1a\x30\x2d\xb3\x2e\x26\xc6\x6c\x76\x05\x8c\xe7\xf8\xd2\x43\x78\x31\x04\x39\x8c\x72\xdb\x1a\x9f\x2b\x98\xa8\xf4\x8e\x54\xa8\x81\x35\x29\x5a\x99\x75\x7d\xa5\x9b\x63\xd6\x16\xe2\xa1\x99\x2e\x01\x2a\xca\x75\xc5\x1b\xed\xba\x26\x0d\x25\x65\xef\xee\x2f\xa8\x25\xe3\xaa\x80\xe4\x5f\xcd\xb5\xd5\x32\xa7\x2a\x8a\xb2\x0c\xda\x76\x30\xed\x3a\x58\x88\x32\x6f\x4c\xed\x7e\xb3\x10\xf6\xdc\x9b\x83\xe0\x3c\x76\x5d\x62\xd1\x48\xe3\x28\xda\xf2\x70\x05\x8f\xdf\xcf\x6c\x27\x52\x1b\xad\x8d\xa3\x11\x04\x54\xe7\x38\x51\x4e\xea\xfa\x10\x45\x2d\x68\xdf\x33\x1b\x88\xf6\x81\xa6\xf0\xb0\xa9\x71\x06\xe6\xc0\xa4\x56\xa6\x77\xf4\x99\x6c\x94\xd3\x9a\x5a\x0f\xed\xb9\x46\x72\x42\xd3\x6f\x9c\xad\xd6\xda\x1c\xec\xd3\x0c\x94\x5c\xe3\x34\x04\x2d\x54\xbf\xe5\x54\x62\xa5\x79\xa2\xeb\xa0\x5f\xbc\x61\x74\xb7\x2e\x4b\xd7\x25\xf0\xcf\x33\x68\xdb\x2d\xd9\x1e\x7b\x73\x93\x27\x34\xbd\x67\x7f\x6a\x0d\xd0\xbf\xc6\x32\x7d\x5d\xff\xbd\x52\x52\xeb\xeb\x5f\x8b\x93\x36\x48\x5e\xb1\xb8\xe1\xeb\x4a\x03\x0c\xe6\x61\x06\x8f\xdf\x1b\x25\x19\x9f\xb7\x30\xdc\x7b\xd4\xed\x30\x8e\x74\xee\x38\xf6\x08\xaf\xe5\xf3\x01\x0b\xb2\x2e\x0d\x68\xee\xf1\x98\x2a\xae\x45\xe5\xa1\x76\x8f\xc6\x6a\xb5\x16\x0a\xdf\xb2\xfd\x2f\xa1\xcb\x82\x95\xa5\x36\xf6\xcf\xc7\x5b\xbb\x24\x6f\x5e\x6a\x19\xe4\xac\x97\xc7\xfb\xb8\x37\x27\x5b\x1f\x40\xed\x62\x58\xcd\xa0\x22\xf5\xa3\x45\x77\x0f\xc8\xcb\x29\x4c\x9e\x47\x40\x2f\x35\xd0\xee\xb4\x3f\x8f\x41\xef\x0e\x87\xff\x1f\x72\xd4\x64\xaf\x85\xd0\x2f\x7e\x36\x78\x5f\xf4\xe4\x79\x54\xf5\x90\x40\x37\xf5\x97\xb7\x4f\xa8\x67\x1c\xc3\x00\x6f\xf0\x8d\xe1\xb1\x31\xdb\x28\x7f\x69\x06\xae\xb1\x74\x01\x8c\x17\x42\x56\x44\x31\xc1\x8f\xa3\x9d\xde\xd5\x15\xfc\xdb\x51\x8e\x09\x68\x18\x27\x60\x93\xc1\xde\x94\xe3\x88\x67\x06\x63\xea\x32\xb2\x2f\x92\x55\x44\x6e\x3e\xe1\x66\xb6\x9f\xc8\xb6\xc9\xbc\x5e\x3a\x3a\x1b\x2c\x7d\xe3\x42\x55\x36\x3d\x48\x7c\x3d\xa9\xe0\x4a\xbb\x73\xef\x80\x9e\x01\xc7\x49\x3e\xea\x25\x83\xae\xfb\x3e\xb4\x6b\x08\x16\xac\xc7\x4b\xdb\xc7\x8f\x42\x22\x9b\xf3\x4f\xb8\x69\xc2\xea\x86\xed\xbd\x15\x16\xbe\xc2\xc0\xdc\x47\x89\x5a\x57\xc2\xfd\xa6\x7a\x12\xa5\xc3\xbb\x58\xa6\x76\xdd\x43\x1e\xa2\xbe\x1f\xd6\x08\x60\x27\x32\x7d\x67\x22\x17\xcb\x5d\xc8\x46\xba\x06\xdc\xcb\x43\xe8\x8e\x01\xa6\xef\x3c\xc0\x97\x3f\x8a\xf0\x0e\xaa\x7b\x77\x3a\x5f\xb0\x9e\x47\xa1\x16\x8d\xaa\x05\x47\x90\x58\x48\xe4\x94\xf1\x39\x28\x01\xe4\x59\x30\x3b\x70\xd0\x05\xd2\xa5\xde\x2d\x85\xa8\xfb\x99\x42\x3b\xf8\x8a\xc5\x3f\xc2\x6c\xb0\x7f\x1b\x36\xab\x6e\x2e\xcf\xcf\x01\xe8\x39\x20\x74\xf4\xda\xf4\xf1\x0b\x51\xf6\xec\x58\x2c\xd3\xcf\xfc\x5b\x9d\x13\x35\x1e\x0e\x9c\x62\xe4\x85\x33\xc7\x37\xa9\x7f\x57\xc5\x07\x62\x6c\xb9\xfe\x80\x25\x1e\x74\x6d\x85\xc7\xba\x76\x82\xf1\xf6\xc0\xb5\x7a\x2a\x51\xe9\xad\x1e\x25\xfd\x9c\x1a\x45\x6e\x19\x9e\x05\xb3\xd5\xc6\xdb\x7d\xd5\xb4\xc4\xf2\x17\x77\x1f\xb6\xdc\x0c\x57\x36\x64\x48\x96\xbf\xf8\x66\xf6\x17\x36\xf2\xb3\x93\x57\xe8\xa7\xaa\x5e\x63\x40\x48\xcb\xdd\x5b\xd1\x0b\x23\xbd\x0e\xe7\x94\x38\xda\x8f\xc6\xb6\x9f\xff\xdf\x7f\xbe\xfb\x42\xd4\x22\xf4\xe5\xf7\x4c\x36\xfd\x89\x5a\x25\x23\x98\xad\x9a\x3d\xbf\x41\x61\xc3\xe6\x0f\xa5\xf1\x70\xdf\x1b\x79\x85\xc8\xef\x1d\x57\xd6\x5b\xd7\x77\xb7\x6d\xee\xf6\x6a\x14\x9c\x71\x18\xfd\xc0\xe5\xdd\xcf\x79\xbf\x8e\xf4\xf6\x54\xb6\x67\xab\xef\x82\x7f\xd8\x52\xd9\x3f\x4a\x84\xeb\x2c\x03\xf7\x69\x66\x47\x03\x52\x96\x66\x06\x30\xaf\xf9\xc6\x7f\x94\x39\x20\xe3\xc8\xe9\x86\x1f\x1c\xfd\xdb\xff\xed\x0f\xbf\x28\x20\x2d\xb5\x4b\x55\xfd\xe0\x32\x8d\xa3\x51\x92\x9d\xfe\xbc\x2c\xd6\x9c\x02\xe3\x4c\x9d\x9c\x42\x7b\xec\x67\xe6\x0f\x0f\x4c\x81\x5b\xf6\xfa\x7b\x38\x1c\x86\x42\xf1\xd0\xd6\x9e\x95\xe1\x0a\x8e\xa5\xeb\xed\x5c\x3c\x04\xa3\x63\x78\x80\x67\x3c\x8d\xed\xbb\xcf\xcd\xaa\x4c\xbf\xe2\x9c\x35\x0a\xa5\x97\xd9\x13\x7c\x32\x2a\x44\x27\x34\xdd\xbe\xef\x27\xee\x0b\x3b\xbc\x22\x17\xa7\xfe\x54\xef\xa8\x6f\x27\x30\x3d\x44\x0b\xa7\x3b\xa7\x33\x5c\x04\xcf\xe6\xef\x18\x40\x9e\x43\xd7\xc5\x7f\x0f\x00\x80\x0a\x81\xf2\x74\x12\x00\x00")
 
 func templateMigrateSchemaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1021,7 +1021,7 @@ func templateMigrateSchemaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 3836, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 4724, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1066,7 +1066,7 @@ func templatePrivacyTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x4b\x6f\xdb\x3a\xf6\x5f\x5b\x9f\xe2\xfc\x0d\xff\x01\xab\x48\xe8\xb4\xbb\xc9\xc0\x8b\x4e\xd3\x4e\x0d\x4c\x8b\x62\xd2\x76\x13\x04\x17\xb4\x74\x64\xf1\x46\x22\x75\x49\x2a\x75\x60\xe8\xbb\x0f\xf8\x92\x28\xf9\xd1\x4e\x57\xb3\x49\x44\xf2\xf0\x3c\x7e\xe7\x49\x1f\x0e\xab\x57\xc9\x3b\xd1\xbc\x48\xb6\x2b\x35\xbc\xb9\x79\xfd\xb7\xeb\x46\xa2\x42\xae\xe1\x03\xcd\x70\x2b\xc4\x13\x6c\x78\x46\xe0\x6d\x55\x81\x25\x52\x60\xce\xe5\x33\xe6\x24\xf9\x5a\x32\x05\x4a\xb4\x32\x43\xc8\x44\x8e\xc0\x14\x54\x2c\x43\xae\x30\x87\x96\xe7\x28\x41\x97\x08\x6f\x1b\x9a\x95\x08\x6f\xc8\x4d\x38\x85\x42\xb4\x3c\x4f\x18\xb7\xe7\xff\xda\xbc\x7b\xff\xf9\xfe\x3d\x14\xac\x42\xf0\x7b\x52\x08\x0d\x39\x93\x98\x69\x21\x5f\x40\x14\xa0\x23\x61\x5a\x22\x92\xe4\xd5\xaa\xeb\x92\xc4\xda\xf0\xd5\x5c\x69\xb9\x66\x35\x82\xc6\xba\xa9\xa8\x46\xd8\x21\x47\x49\x35\x2a\xcb\x51\x65\x25\xd6\xf4\x5a\x69\xa6\xb3\x92\xf1\x1d\x54\x62\xc7\x32\xa0\x3c\x87\x52\x54\xb9\x25\x4a\x6a\x91\xb7\x15\xc2\x33\x4a\xc5\x84\xd1\x84\x6a\xf8\x41\x15\xb4\xc6\x22\x2d\x7a\x96\x96\x23\x55\x0a\xb5\x22\x49\xb2\xd1\x50\x52\x05\x6f\xa0\x10\xb2\xa6\x5a\x11\x78\x0b\x73\xaf\xce\x1c\x1a\x9a\x3d\xd1\x1d\x3a\x66\xaa\x14\x6d\x95\xc3\x16\x01\xeb\x46\xbf\x5c\xb3\xba\x11\x52\x63\xee\xed\x4e\x6a\xca\x78\x7f\xa3\x10\xd2\xab\xad\xe0\x07\xd3\x25\x94\x42\x3c\x29\x10\x12\x1a\x51\xb1\x8c\xa1\x82\x65\x23\x34\x72\xcd\x68\x05\xd9\x4b\x56\xb1\xcc\x73\x4c\x89\xc5\x44\x61\x26\x78\xee\xf5\x32\xee\x09\x06\xc4\xfe\x99\x23\xd7\xbd\x9a\x57\x16\x91\x58\x39\x60\x2a\xe1\x42\x03\xc7\x0c\x95\xa2\xf2\x05\x96\x5c\x80\x68\xb4\x41\xc8\xa8\x38\x11\x0c\xc7\x82\x03\x7c\x4f\x88\x4d\xb2\xa5\xd9\xd3\x0f\x2a\x73\x75\x9d\x89\xba\xa1\x9a\x6d\x59\xc5\xf4\x8b\xb3\xb0\x91\xf8\xcc\x44\xab\x82\x0b\x94\x71\x3d\x72\x3d\x78\x1b\x72\x2c\x18\xc7\x1e\xe0\x95\xd5\xbe\xeb\x12\x00\x80\xc3\x61\x70\xff\xe0\x81\x85\x39\x3e\x1c\x00\x79\x0e\x67\x98\x34\x4f\xbb\x98\x89\xd5\x05\xf7\xda\xdc\x58\xc0\xfc\x8b\xc3\x66\x1e\xf1\xf4\xb4\xe7\x85\x92\x88\x9d\x17\x3c\x3b\x1c\x60\xe1\x43\xec\x76\x0d\x0b\xf2\xc9\x7e\x6f\x78\x21\xc2\x31\x2b\x8c\x7b\x3d\x11\xf9\xee\xe3\x30\xac\xef\xdb\xda\x12\x66\x82\x2b\x0d\xcb\x64\x36\x3b\x1c\xae\x9d\xb2\xd3\x2b\x86\x6c\x36\x0b\xab\x35\xcc\x0f\x07\xab\xd2\x1c\x56\x2b\x08\xdb\x0e\x5b\x9b\xbb\x3b\xe4\xc4\xf3\x0b\xda\x1e\x33\x0f\xf2\x67\x33\xf3\x35\x61\x6a\xb6\x2e\x33\x4c\xad\x89\x7e\x75\xd1\x1f\xf3\xb0\x3f\x00\x5b\x22\xcd\x51\x7a\x5c\xcd\xd1\xc2\x65\xc3\xed\x1a\x6e\x3c\x3f\x49\xf9\x0e\x61\xc1\x1d\xb8\x9f\x45\x8e\xaa\x87\x9d\xb7\xf5\xc7\x40\xbf\xe0\xe4\x73\x58\x76\x9d\x43\x7d\xc1\xc9\x47\xaa\xbe\x98\xbc\x7a\x71\x9b\xc3\x95\x35\xd0\x3c\x8f\xd6\xaf\x1d\x41\xec\xd5\x32\x26\x74\x8b\x81\x7e\x64\xad\xa1\x96\xba\x79\xda\x19\x4d\x0a\x5a\x29\xec\x75\x28\xa9\xfa\xc0\xb0\xb2\x21\x77\x9f\x89\xc6\xc2\x30\xd0\xaf\x01\xff\x82\x05\xb1\x27\xc4\x87\xe4\x08\xb1\x31\xa4\xc6\x28\x77\xb1\xeb\xc0\x54\x49\x78\xad\x74\xc8\xc8\xeb\x50\x2e\x57\xfe\x3f\xd9\x09\xb0\x29\xe6\xa3\xd0\x1b\x11\x82\x78\x76\x2a\xc8\x57\x12\x77\x4c\x69\xe3\x95\x45\x40\x02\x9d\x41\xc9\x6c\xb6\x5a\xb9\x4a\x70\xba\xee\x8e\x6a\x11\xe3\x26\x4b\x16\xe4\x9d\xe0\x05\xdb\xf5\xb6\x75\x5d\xa4\xdd\x34\x76\x02\x70\xab\x57\xf0\x66\xa8\x34\x26\xd8\xf4\x39\x9b\x4c\x15\xfb\xdf\xb2\xeb\x82\x7d\x47\x59\x62\x3b\x1d\x04\xd5\xbc\x7c\x28\x29\xcf\x2b\x94\xca\x94\x57\xfd\xd2\x60\xa8\xe3\xca\x59\x7e\xa2\xd4\x0d\xc6\x75\x5d\xe2\x4b\xfc\x32\x89\x92\x3d\xa8\x7b\xef\x24\x58\xa3\xfb\x4c\x4f\x46\x19\x6d\xbe\xcf\x65\x9d\xbd\x73\xca\x76\x9b\x5b\xd1\xc6\x98\x67\x32\x9b\xef\x98\x2e\xdb\x2d\xc9\x44\xbd\x2a\xfc\x14\x62\xab\x7c\x92\x26\x49\xe2\xe1\x67\x9c\x69\x28\x5a\x9e\xd9\x36\x24\x91\xe6\x0a\x68\x55\x05\x58\x72\x54\x99\x64\x8d\x16\xd2\xb7\x4e\x6f\xbd\xb9\x6e\x47\x95\x65\x8e\x05\x6d\x2b\x0d\xcf\xb4\x6a\x51\x5d\x99\xff\x2c\xa7\xf6\x82\x90\xae\xd3\xa6\xb6\x17\x3a\x0f\xa3\x02\xa6\xcd\x6d\x83\x73\x89\x4c\xf6\x5d\xfa\x99\x4a\x46\xb7\x15\x2a\x92\x18\x7d\xac\x66\xcb\x14\x0e\xc9\x25\x70\xcc\xd9\xc2\x17\x81\x11\x18\xfe\xc8\x9b\x71\xbb\x86\x2d\x55\x78\xd2\x27\x83\xc3\x38\xf9\xb7\xb3\xee\x13\xdb\x33\x1e\x6a\xb7\xe3\xdf\x75\x6e\xf3\x76\x6d\x43\x51\x85\xfb\xc4\x79\xe1\x33\xad\x6d\x1a\x75\xc4\x92\x2d\xd3\x63\xff\x1e\x17\x47\xc7\xbe\x91\x8c\x6b\x27\x64\x4e\xdc\x99\x09\x29\xf8\x99\x20\x47\x6a\x24\x1d\x71\xb1\xe5\xd2\x30\x79\xb8\x79\x84\xb5\x75\xef\x92\xe3\x5e\xdb\x09\xe0\x53\xab\x8d\x7b\xd2\x78\x01\x07\xd3\x8c\x24\xea\x56\xf2\x61\x1f\x3f\x98\x8b\xf6\x76\xa6\xf7\x90\x09\xae\x71\xaf\x0d\x84\xe6\xff\x15\xd4\x03\x29\x13\x3c\x85\xa5\x59\x7e\x37\x71\x70\x05\x28\xa5\x91\x61\xf9\xce\x58\x61\xd6\x1e\xbb\x33\xf6\x92\xf7\xcf\xb4\x0a\xbc\x8c\xbc\x2b\xa8\xd3\xbf\xdb\x7b\xff\xb7\x06\xce\x2a\xcf\x2b\x68\xc9\x59\x65\xa5\xd8\x4d\xdb\x4b\xfb\x13\xa3\xa4\x33\x20\xf0\x31\xc7\x9d\xf9\xdb\x1d\xfb\xc5\xf9\xbe\x8c\x9a\x9a\x81\xef\x8b\x50\x4c\xdb\xc1\x69\x34\xa1\x5c\xc3\xea\x15\xb8\x6e\xe4\xea\x81\x2d\x4e\xde\x49\xb5\x71\xbd\x22\xbe\x56\x46\xcc\x59\xbe\xf7\xac\x3f\xb1\x3d\xe6\x1b\xde\xf7\xb3\xd9\x2c\xce\x7d\x66\xa9\x0c\x75\x24\x34\x1a\x8f\x62\xe8\x6c\x9c\x79\x47\x2f\x98\x09\x18\x1f\x9a\x51\xb4\x3e\x98\xb5\x39\x7b\x24\x4b\xc6\x35\x4a\x53\x06\x0e\x4e\xff\x65\x0a\x0f\x8f\xc6\x61\x66\x05\x5d\x4a\xfc\x6e\x50\x69\x34\xbd\xf8\xc5\x04\x87\x8d\x79\x4d\xa0\x44\xa0\x12\xfd\x4c\x1d\x81\x32\x3c\x16\x3c\x22\xf1\x6d\x1f\xd7\xfd\x28\x11\x35\x70\x8f\x45\x63\xb1\x28\x47\xc3\x85\x6d\x3c\x4d\x00\xd1\x37\xf5\x98\xd3\x1a\xb4\x6c\x31\x6e\xe1\xd1\x7c\xd1\x67\x61\x7c\x23\xf8\x60\x84\x6d\x9f\x3f\x3f\x4f\xf7\x01\xb5\x23\xd0\x82\x53\xaf\xa6\xc6\x24\x63\xb7\x9e\x29\x0d\xae\xf6\xb0\x30\x0c\x31\x3b\x2e\x1d\x79\xa7\x37\x2a\x86\xe5\x67\xb1\x13\x15\x88\x3e\x42\x60\x7d\x39\xc4\x1a\x57\xd9\x36\x3c\xc7\x7d\xb8\xd8\x90\xb0\x7c\xec\x15\xf3\xfd\xfd\xf7\x34\x38\xe7\x87\xb3\xd2\x4e\x04\xe9\xa9\xc2\x6b\xde\x02\x16\xe0\x3b\xdf\xad\xdc\xea\xfb\xd0\xab\x26\xf1\x79\x26\x6f\xed\x58\x79\xd2\x85\xbf\x9b\xc1\x8e\xe3\x2f\xa5\xb0\x23\x5d\xa6\x47\xb2\x4f\xa2\xe0\xfa\x5f\xe1\x14\x76\x46\xf4\xda\xf7\xa3\xfa\xe6\x8e\x7c\x53\x28\xef\x7c\xd6\xba\x84\xf2\x77\xd6\x40\x9b\xc6\x3e\xdc\xfc\x86\xa5\x3f\x91\x52\x0e\xab\xa2\x87\x66\x16\x77\xcd\x0f\xbd\x02\x97\xf3\xa8\x37\x6e\x36\x9b\xfd\x01\x31\x0c\xee\xe4\x27\x09\x56\x58\x13\x27\x3a\x5c\xc3\xc2\xcc\x2f\xe6\x28\xc6\xfd\x0e\x55\x36\x87\x45\x41\xee\xb5\x6c\x33\xed\x9e\x0a\xc3\x9d\xd5\x2b\x40\xde\xd6\x30\x1e\x6c\xfc\x80\x98\x03\x47\x2a\xfd\xe4\x92\x63\x56\x51\x49\x5d\x9b\x58\x9a\x92\x17\x0d\x8e\x69\xdf\x07\xa2\x20\x5c\x52\x8b\x27\x09\x61\xb8\xb4\x15\xad\x20\x1b\xf5\x9e\xb7\x75\x9a\x9a\xef\x6f\x4d\x4e\x35\xf6\x81\x5a\x90\x71\x94\x9a\xc2\xb0\x5a\x59\x7c\xac\x71\x5d\x67\x66\xe5\xa1\xd8\x46\x23\x9b\xfd\x55\xc1\x7a\x34\x00\x0d\x16\x21\xe2\xab\x8b\x2b\x1c\x05\x09\xbd\x2e\xae\x20\xb3\x50\x80\x82\x90\xe3\xe6\x3d\x8e\xdf\x31\x9b\x49\xa1\x88\x0e\xfb\x1c\x26\x77\xbd\xa2\xce\xed\xa3\xfa\x71\x46\xfe\x28\x26\xfe\x5b\xd6\xa3\x9a\x39\xed\x0a\x97\x3d\x33\x8e\x29\x47\x32\x09\x2b\x32\x8f\xee\x7b\xbc\x93\xd8\x59\xee\x56\xd7\x0d\xbf\x93\x8d\x63\x0c\x04\x87\x4c\x22\xed\x7f\x10\x32\x14\xe7\xdc\x17\x6b\xf2\xd5\x84\xdd\xa0\x4d\x41\xcc\x86\xfd\xd3\xe7\xba\x29\x80\xc6\x98\xaf\xac\x46\xf7\xf5\xed\x5b\x48\xe6\x11\x9b\xc0\x65\x6e\xc7\xbe\x14\xe6\x81\xdf\x24\xf1\x27\xb0\x7d\xa4\xea\x9f\xc2\x92\x59\xe0\x96\x25\x55\x5f\x24\x16\x6c\x3f\xe6\x6e\xb9\xce\xd3\x34\xee\x70\x11\x30\x6b\x6f\xae\x97\xb7\x8c\xfc\x1f\x80\x25\xcb\xa9\xc6\x5d\x97\xa6\xd3\xee\x73\x96\xf7\xaf\x70\xbb\xd8\x5c\xa2\xb4\x19\x27\xea\xaf\x06\xc8\xe8\xd6\xef\x86\x49\x6b\x99\xfc\x42\x90\x5c\x80\x60\xa4\x88\x05\xc2\x99\xe5\x83\xa4\xeb\x7c\x04\xc4\xa3\xd4\xe0\x9b\x93\x13\x8f\xef\x04\xc7\x15\xcb\xc2\xc2\x8d\x7e\x27\x31\xe9\xe9\x63\x72\xed\xa3\xda\xd1\x17\x2e\x76\x60\xf9\xff\x2a\x75\x8f\x8b\xf9\x34\xd2\x23\x14\xb9\x87\x82\x29\xa0\xc3\x8b\xb4\xc7\x6b\x3e\x02\x6c\xee\x11\x83\x8d\xfd\x69\x37\xa3\x95\xa9\xf3\xdb\x17\x4b\xba\x6d\x59\x95\xa3\x54\xb0\xc5\x42\x48\x04\x45\x9f\x91\x44\xf1\x8f\x7f\x4d\x0c\x7e\x1d\xc7\x5f\xd0\x63\x8c\xfc\x40\xfd\x70\xf3\xe8\x42\x50\x4f\x63\x6f\x12\xc8\x03\xa3\xc1\x2b\xe1\x52\x78\x18\x45\x2f\xef\xdb\x73\x02\x1d\x65\xc1\x2d\xc9\x03\x21\xe4\xd1\xf2\x1b\xbb\xd6\xe1\x1b\xd8\xc6\xad\xf6\xcf\x2b\xff\x06\xdf\xfb\x8d\x53\xdd\x69\xa4\x8a\xad\xd1\x7f\xba\x27\xc8\x44\xd4\x44\x5e\x7a\x15\xc9\x1b\xea\x4c\x78\xdc\x85\xd7\x5d\xc4\xe4\x1f\xce\x37\xa1\x81\xc3\x45\x2b\x8c\xef\xff\xb8\x82\xc2\xaa\xef\xb4\x37\x30\x84\xe3\xe8\x8d\x5a\xf0\xd3\xfc\x4f\xbe\x46\xa3\x67\xb3\x7f\x8b\x0e\x1a\xf7\xff\x87\x27\x6b\x6c\x51\x77\xf9\xb1\x15\x7f\x9f\xfe\x74\xbf\x4f\xfa\xc5\x7f\x02\x00\x00\xff\xff\x7c\xae\x31\xe0\x84\x1a\x00\x00")
+var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\xdf\x6f\xdb\x38\xf2\x7f\x96\xfe\x8a\xf9\x1a\xfe\x02\x52\x90\xd0\x6d\xdf\x2e\x07\x3f\xec\xf5\xc7\x35\x87\x4b\xaf\xd8\xb4\x7d\x09\x82\x05\x23\x8d\x6c\x36\x32\xa9\x25\xa9\x34\x81\xa1\xff\xfd\x30\x14\x29\x51\x8e\xed\xa4\xc5\x3e\x1c\x76\x51\x8b\xd4\x70\x38\xf3\x99\xcf\x0c\x87\xca\x76\xbb\x38\x49\xdf\xaa\xe6\x51\x8b\xd5\xda\xc2\x9b\x57\xaf\xff\x76\xd6\x68\x34\x28\x2d\x7c\xe0\x05\xde\x2a\x75\x07\x17\xb2\x60\xf0\x5b\x5d\x83\x13\x32\x40\xef\xf5\x3d\x96\x2c\xfd\xb2\x16\x06\x8c\x6a\x75\x81\x50\xa8\x12\x41\x18\xa8\x45\x81\xd2\x60\x09\xad\x2c\x51\x83\x5d\x23\xfc\xd6\xf0\x62\x8d\xf0\x86\xbd\x0a\x6f\xa1\x52\xad\x2c\x53\x21\xdd\xfb\x7f\x5f\xbc\x7d\xff\xe9\xea\x3d\x54\xa2\x46\xf0\x73\x5a\x29\x0b\xa5\xd0\x58\x58\xa5\x1f\x41\x55\x60\xa3\xcd\xac\x46\x64\xe9\xc9\xa2\xeb\xd2\xd4\xf9\xf0\x85\x96\xb4\xd2\x8a\x0d\x82\xc5\x4d\x53\x73\x8b\xb0\x42\x89\x9a\x5b\x34\x4e\xa3\x29\xd6\xb8\xe1\x67\xc6\x0a\x5b\xac\x85\x5c\x41\xad\x56\xa2\x00\x2e\x4b\x58\xab\xba\x74\x42\xe9\x46\x95\x6d\x8d\x70\x8f\xda\x08\x45\x96\x70\x0b\x3f\xb8\x81\x96\x3c\xb2\x6a\x50\x49\xc2\xc0\x8d\x41\x6b\x58\x9a\x5e\x58\x58\x73\x03\x6f\xa0\x52\x7a\xc3\xad\x61\xf0\x1b\xcc\xbc\x39\x33\x68\x78\x71\xc7\x57\xd8\x2b\x33\x6b\xd5\xd6\x25\xdc\x22\xe0\xa6\xb1\x8f\x67\x62\xd3\x28\x6d\xb1\xf4\x7e\xa7\x1b\x2e\xe4\xb0\xa2\x52\xda\x9b\x6d\xe0\x87\xb0\x6b\x58\x2b\x75\x67\x40\x69\x68\x54\x2d\x0a\x81\x06\xb2\x46\x59\x94\x56\xf0\x1a\x8a\xc7\xa2\x16\x85\xd7\x98\x53\x74\x10\x0c\x16\x4a\x96\xde\x2e\x0a\x4f\x70\x20\x8e\xcf\x0c\xa5\x1d\xcc\x3c\x75\x88\xc4\xc6\x81\x30\xa9\x54\x16\x24\x16\x68\x0c\xd7\x8f\x90\x49\x05\xaa\xb1\x84\x10\x99\xb8\xb3\x31\x3c\xdd\x38\xc0\x77\x87\xd8\xa4\xb7\xbc\xb8\xfb\xc1\x75\x69\xce\x0a\xb5\x69\xb8\x15\xb7\xa2\x16\xf6\xb1\xf7\xb0\xd1\x78\x2f\x54\x6b\x42\x08\x0c\x85\x1e\xa5\x1d\xa3\x0d\x25\x56\x42\xe2\x00\xf0\xc2\x59\xdf\x75\x29\x00\xc0\x76\x3b\x86\x7f\x8c\xc0\x1c\xba\x2e\xdd\x6e\x01\x65\x09\x07\x94\x34\x77\xab\x58\x89\xb3\x05\x1f\x2c\xad\x98\xc3\xec\x73\x1f\x90\x59\xa4\xd3\xcb\x1e\xde\x94\x45\xea\xfc\xc6\xc9\x76\x0b\x73\x4f\xb1\xf3\x25\xcc\xd9\xa5\x7b\xbe\x90\x95\x0a\xaf\x45\x45\xe1\xf5\x42\xec\x9b\xe7\x61\x18\x5f\xb5\x1b\x27\x58\x28\x69\x2c\x64\x69\x92\x6c\xb7\x67\x3d\x70\xbb\x4b\x48\x2c\x49\xc2\x68\x09\xb3\xed\xd6\x99\x34\x83\xc5\x02\xc2\x74\x8f\xad\xcb\xdd\x15\x4a\xe6\xf5\x05\x6b\x9f\x2a\x0f\xfb\x27\x09\x3d\xed\x28\xa5\xa9\xe3\x0a\xf3\x34\x19\xc1\x38\x1a\x8f\x59\x98\x1f\x81\x5d\x23\x2f\x51\x7b\x5c\x69\xc9\xbc\xcf\x86\xf3\x25\xbc\xf2\xfa\x34\x97\x2b\x84\xb9\xec\xc1\xfd\xa4\x4a\x34\x03\xec\xb2\xdd\x7c\x0c\xf2\x73\xc9\x3e\x85\x61\xd7\xf5\xa8\xcf\x25\xfb\xc8\xcd\x67\xca\xab\xc7\x7e\x72\x5c\xb2\x04\x5e\x96\x91\x8a\xd7\xbd\x80\x37\x3f\x19\x6d\xf1\x82\xbd\x61\xa3\xfc\xc4\x5b\x92\xd6\xb6\xb9\x5b\x91\x25\x15\xaf\x0d\x0e\x36\xac\xb9\xf9\x20\xb0\x76\x94\xbb\x2a\x54\xe3\x68\x36\xca\x2f\x01\xff\x84\x39\x73\x6f\x98\xa7\xe4\x04\xb1\x71\x93\xd4\x3b\xd5\x2f\xec\x3a\xa0\x2a\x09\xaf\x8d\x0d\x19\x79\x16\xca\xe5\xc2\xff\xb2\x95\x82\x93\xc5\xc8\x42\xef\x51\x20\x71\xb2\x8f\xe4\x0b\x8d\x2b\x61\x2c\x45\x65\x1e\x90\xc0\xde\xa1\x34\x49\x16\x0b\xf8\x72\xb8\xee\x4e\x6a\x91\x90\x94\x74\x73\xf6\x56\xc9\x4a\xac\x06\xdf\xba\x2e\xb2\x6e\x97\x3b\x01\xb8\xc5\x09\xbc\x19\x2b\x0d\x91\xcd\x1e\xf2\x89\xaa\xd8\xff\x96\x5f\x47\xfc\xdb\x7d\xa2\x74\x58\x9c\x40\x30\xcd\xef\x0f\x6b\x2e\xcb\x1a\xb5\xa1\xf2\x6a\x1f\x1b\x0c\x75\xdc\xf4\xd1\xdc\x53\xea\x46\xe7\xba\x2e\xf5\x25\x3e\x4b\xa3\x64\x0f\xe6\x5e\xf5\x3b\x10\x7e\xc9\x90\xe9\xe9\x24\xa3\xe9\xf9\x50\xd6\x25\xb3\x03\xbe\xd3\xb4\x8c\x26\xa6\x3a\xd3\x64\xb6\x12\x76\xdd\xde\xb2\x42\x6d\x16\x95\xef\x42\x5c\x95\x4f\xf3\x34\x4d\x3d\xfc\x42\x0a\x0b\x55\x2b\x0b\x77\x0c\x69\xe4\xa5\x01\x5e\xd7\x01\x96\x12\x4d\xa1\x45\x63\x95\xf6\x47\xa7\xf7\x9e\x96\x53\xb9\x83\xac\xc4\x8a\xb7\xb5\x85\x7b\x5e\xb7\x68\x4e\xe9\x57\x94\xdc\x2d\x50\xba\x3f\x69\x73\x77\x16\xf6\x11\x46\x03\xc2\xd2\x6a\xc2\x79\x8d\x42\x07\xa0\xe1\x9e\x6b\xc1\x6f\x6b\x34\x2c\x25\x7b\x9c\x65\x59\x0e\xdb\xf4\x18\x38\xf4\x6e\xee\x8b\xc0\x04\x0c\xff\xca\xbb\x71\xbe\x84\x5b\x6e\x70\x6f\x4c\xc6\x80\x49\xf6\x7b\xef\xdd\xa5\x78\x10\xbe\xf4\x13\xc8\xa4\xbf\xeb\xfa\xc9\xf3\xa5\x4b\x31\xaf\xb7\xeb\x18\x8d\x24\xfb\xc4\x37\x14\x93\x6d\xc7\x9c\x58\x96\x3f\x8d\xef\xd3\xe2\xd8\xab\x6f\xb4\x90\xb6\xdf\x64\xc6\xfa\xc2\x49\x94\x82\xe7\x36\xea\x45\xb3\x7c\x8f\x16\x57\x2e\x49\xc9\xf5\xab\x1b\x58\xba\xf0\x66\x12\x1f\x2c\x25\x35\xbb\x6c\x2d\x85\x27\x8f\x07\xb0\xa5\xc3\x48\xa3\x6d\xb5\x1c\xe7\xf1\x03\x2d\x74\xab\x0b\xfb\x00\x85\x92\x16\x1f\x2c\x41\x48\xbf\xa7\xb0\x19\x45\x85\x92\x39\x64\x34\xfc\x46\x3c\x38\x05\xd4\x9a\xf6\x70\x7a\x13\x51\xd1\xd8\x63\x77\xc0\x5f\xf6\xfe\x9e\xd7\x41\x57\x56\xd8\x87\x53\xd8\xe4\x7f\x77\xeb\xfe\x6f\x09\x52\xd4\x5e\x57\xb0\x52\x8a\xda\xed\xe2\x26\x29\xb5\x06\xfb\xc9\x53\xef\x40\xd0\x43\xaf\x3b\x42\xaa\x7b\x1a\x97\x3e\xf6\xc3\x21\x48\x07\x98\x52\x77\x9f\x95\x11\x64\x89\x09\x15\x8e\xfe\x27\xaa\x2c\x4e\xc0\xc1\xeb\xeb\x81\xeb\x38\x7d\x90\x36\x14\x7a\xc3\x7c\xad\x8c\x94\x8b\xf2\xc1\xab\xbe\x14\x0f\x58\x5e\xc8\xe1\x3c\x4b\x92\x38\xf7\x85\x93\x22\xe9\x68\xd3\xf0\xdf\x0e\x74\x8e\x67\x3e\xd0\x73\x41\x84\xf1\xd4\x8c\xd8\x7a\x4d\x9c\xa1\x77\x37\x2c\x13\xd2\xa2\xa6\x32\xb0\xed\xed\xcf\x72\xb8\xbe\xa1\x80\xd1\x08\xba\x9c\xf9\xd9\x34\x49\x26\x10\xc5\x83\xd1\x14\x87\xc3\x05\xdd\x26\x50\x23\x70\x8d\xb0\xde\x05\x65\xbc\x2c\x78\x44\xe2\xd5\x9e\xd7\x43\x2b\x11\x1d\xe0\x1e\x8b\xc6\x61\xb1\xf6\x40\x45\x07\x4f\x13\x40\xf4\x87\x7a\xac\x69\x09\x56\xb7\x5e\x4f\xef\xc0\x58\xf8\x93\x21\x0b\xe3\x15\x21\x06\x13\x6c\x87\xfc\x81\xf3\xe7\xb2\x70\x44\xed\x09\x68\x21\xa8\xa7\xbb\xce\x4c\x42\x7b\xb0\x34\x90\x46\x8a\x9e\x6f\x86\x04\xbc\x1e\x8c\x8d\x36\x1a\x9c\x8a\x61\x79\x8e\x3b\x6c\x70\x70\x64\x08\x2c\x8f\x53\xcc\xe9\x17\xf2\x42\x96\xf8\x10\x16\x36\x2c\x0c\x6f\x06\xc3\xfc\xf9\x1e\x76\xfe\x39\x0b\x76\xa4\xa6\x42\xfb\x76\x8b\xf1\x0e\x83\xdd\x67\x7f\x17\x70\x00\xbf\xf3\xa7\x15\x65\x39\x37\xdf\xc6\xb3\xaa\x9f\xb8\xe4\xda\xac\xb9\xeb\x03\x22\x1f\x8e\x24\xb2\xeb\x33\xf7\xc6\xf4\x57\x53\xba\xd7\xf8\xa2\x9c\xee\x45\xb3\xfc\xc9\xde\x7b\x61\x71\x83\x79\xe5\xd6\x78\x27\x06\xeb\x7d\xaf\x2a\xd9\xc5\x3b\xf6\xd5\xa0\x7e\xe7\xd3\xd8\xe5\x4f\x58\xb3\x04\xde\x34\xa4\x3a\x4c\x38\xf9\x3d\x39\xd6\x63\xe5\x85\x02\x4b\x83\x13\x7e\xcf\x67\x13\x6b\x70\x2e\x49\x92\x3f\xfc\x61\x18\x6b\xd8\xe7\x5d\x94\x71\x95\x73\x71\xc7\x86\x33\x98\x53\x43\x43\xaf\x62\xdc\xdf\xa1\x29\x66\x30\xaf\xd8\x95\xd5\x6d\x61\x9d\xfe\x68\xcd\xe2\x04\x50\xb6\x1b\x98\x76\x3a\xbe\x63\x2c\x41\x22\xd7\xbe\x95\x29\xb1\xa8\xb9\x76\xa7\xa1\x81\x4c\xc8\x49\x27\x99\x0f\x07\x43\xc4\xca\x8c\x3a\xa3\x79\xc5\x02\x2f\x33\x57\xe2\x2a\x76\x61\xde\xcb\x76\x93\xe7\x64\xd5\xd7\xa6\xe4\x16\x07\xe6\x56\x2c\xa6\x6d\xc5\x06\xce\xd2\xe0\xab\xdc\xc4\xc3\xab\x82\xcb\xb7\x0a\xe9\xc3\x92\xaf\x29\x8b\x85\x43\xd2\xc1\xd0\x75\x20\xe2\x8f\x3a\x51\xb7\x47\xf7\x00\x27\x58\x85\x90\x80\xc3\x92\xf9\xc2\xe4\xb2\x6a\x5e\xb1\x70\x4c\xc6\xc5\x27\x09\xb5\x2b\x6c\x72\xbe\x3c\xce\xf4\xa9\x9a\x9d\x1a\x13\xbd\x1c\xd2\x9f\xbd\x1b\x0c\xed\x09\x32\x29\x3d\x07\xf6\x9f\xf0\xef\x67\x55\x07\x96\x3d\xe5\x9c\xa8\xe0\x78\x0c\xa3\x85\xf3\x40\xa1\x1d\x02\xb2\x59\xb4\xde\xe3\x9d\xc6\xc1\xea\x57\x75\xdd\xf8\x89\x6d\xca\x46\x50\x12\x0a\x8d\x7c\xf8\x96\x44\x41\x3d\x14\xbe\xd8\x92\x2f\x44\xd0\xd1\x9a\x8a\xd1\x84\xfb\x67\xa8\x0a\xf4\x1d\x85\x9c\xf9\x42\x1f\x05\xdd\xd3\xd7\xaf\x21\xed\x27\x6a\x82\x96\x99\xeb\x18\x73\x98\x05\x7d\xbe\x44\x50\x78\x7a\xd6\x5c\x98\x7f\x5d\xfd\xe7\xd3\x11\x15\xd3\x85\x13\xf0\x47\xbc\x3f\x72\xf3\x4f\xe5\x96\x39\xc4\xb3\x35\x37\x9f\x35\x56\xe2\x61\xaa\xd3\x99\x33\xcb\x73\xaf\x23\xd9\x41\x74\xe9\x71\xf2\xfb\x65\x11\x71\x42\x44\x58\xb6\x6b\x67\xd7\xe5\x79\xba\x87\x76\x7b\x75\xbf\x44\xdb\x5e\x6a\x4d\x06\xa2\x7a\x5a\x0b\x5e\xca\xac\xc9\xaa\x5f\xe5\x57\xeb\x94\xbc\x80\x5d\x47\x20\x98\x18\xe2\x60\x0d\x84\x70\xec\xea\x3a\x4f\x9d\x21\xea\x93\xd8\xec\xed\xb2\xfc\x61\x13\x17\xc5\x08\x16\xc9\x37\x31\xbf\x23\x4c\x06\xf9\x58\xdc\xfa\x74\xe8\xe5\xab\x9e\x3b\x90\xfd\xbf\xc9\xe9\xaa\xa1\xf4\x40\xe9\x60\xd2\x76\xbb\x8f\xd2\x36\xe2\xf2\x11\x25\xbb\xf4\xf6\xe1\x90\x1e\x53\x61\x80\x8f\xd7\xe9\x01\xf8\xd9\x04\xf9\x99\x87\x1e\x2e\x2c\x15\xf3\x82\xd7\x35\x96\x70\xfb\xe8\x44\x6f\x5b\x51\x97\xd4\xcc\xdc\x62\xa5\x34\x82\xe1\xf7\x38\x94\x00\xba\x94\xfd\xb9\x83\x5c\x68\x31\x93\xd8\x8e\x69\x08\x47\xe9\xeb\x57\x37\x2e\x84\x73\xbb\x4b\xe2\x9d\x8c\x18\x15\x8d\xe1\x0d\x8b\xc2\xad\x2e\xfa\x6c\x70\x7e\x68\xc3\x5e\xb2\x92\xee\xc6\x70\xcd\x18\xbb\x71\xfa\xa6\x1c\xe9\x31\x0e\x6a\xe3\xb6\xe0\xfb\xa9\xff\x80\xf0\xe0\x27\x26\xae\x7b\x7b\x27\xa6\xb8\x53\xe2\xbb\xeb\xb5\x26\x8e\x9e\x46\xca\xc7\xf0\x85\x6b\x68\xb8\x87\x46\xc6\xfd\xa3\x0f\x44\xe8\x2c\xe0\xa8\xc9\x14\xe8\x3f\x4e\xa1\x72\xb6\xf6\xa6\x92\xcf\xe1\x75\x74\x9b\xae\xe4\x7e\xfd\x7b\xef\xcd\xa3\x61\xe1\xd6\x3c\x5a\x3c\xfc\x7a\x09\x29\xea\xd8\xa3\x2e\x7b\x71\x81\x1a\xbb\x91\x17\x65\xe1\x20\xfe\xb4\x2a\x05\xd6\xf8\x16\xa5\x68\x8d\x55\x1b\x18\xdb\x1b\x55\xfd\x85\xe9\x70\x88\xed\x83\x7d\x2c\xdb\xc7\xb5\xec\xfa\xe6\xf6\xd1\x0e\x9f\x3b\xf2\x23\x85\x5b\x6e\x7e\x0e\x99\x68\xc1\x4b\xb1\x69\xe5\x2f\xa1\xf3\x63\x8d\xfd\x6d\xdd\xbd\x24\xd8\x4c\xc1\xa5\xc4\xf2\x19\x6c\x22\x0b\x3d\x3a\x01\x8d\x93\x7d\xfc\x3e\x02\xce\x93\x36\xf5\x19\x6c\x46\xf9\xc3\xd0\x58\xcd\xa5\xa1\xaf\xf4\xbe\xc3\xb5\x4a\x63\x19\x7a\xf7\x63\xe8\x04\x76\xd8\x35\x3e\xba\x2f\x1b\x25\xd2\x97\xce\xbf\x0a\xb5\xd1\x76\x0f\xda\x77\xa3\x24\xfb\x9d\xff\xb8\xa4\xbf\x0a\xae\x30\x87\xdd\x99\x43\xec\x8a\x9e\xf7\x3f\xf6\x7f\xbf\x40\x59\x42\xd7\xa5\xff\x1d\x00\xfd\xa2\x66\x7a\xa4\x1e\x00\x00")
 
 func templateRuntimeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1081,7 +1081,7 @@ func templateRuntimeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/runtime.tmpl", size: 6788, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/runtime.tmpl", size: 7844, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1106,7 +1106,7 @@ func templateTxTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcf\x6f\xdb\xb8\x12\x3e\x5b\x7f\xc5\x40\x50\xf0\xec\xe2\x95\xea\xeb\xed\x2d\x90\x43\xb6\x49\xb7\x5e\x2c\x92\xee\xa6\xd8\x3d\x04\x39\x30\xe2\xc8\x22\x22\x93\x2a\x49\x3b\x0d\x04\xfd\xef\x8b\x21\x69\x49\x76\x9c\xc4\xd9\x06\x8b\xe6\xe4\x90\xc3\xf9\xf1\xcd\xf7\x0d\xc5\xb6\xcd\xdf\x24\x1f\x74\x73\x6f\xe4\xa2\x72\xf0\xfe\xdd\xff\xfe\xff\xb6\x31\x68\x51\x39\xf8\xc8\x0b\xbc\xd1\xfa\x16\xe6\xaa\x60\x70\x52\xd7\xe0\x8d\x2c\xd0\xbe\x59\xa3\x60\xc9\x97\x4a\x5a\xb0\x7a\x65\x0a\x84\x42\x0b\x04\x69\xa1\x96\x05\x2a\x8b\x02\x56\x4a\xa0\x01\x57\x21\x9c\x34\xbc\xa8\x10\xde\xb3\x77\x9b\x5d\x28\xf5\x4a\x89\x44\x2a\xbf\xff\xdb\xfc\xc3\xd9\xf9\xe5\x19\x94\xb2\x46\x88\x6b\x46\x6b\x07\x42\x1a\x2c\x9c\x36\xf7\xa0\x4b\x70\xa3\x60\xce\x20\xb2\xe4\x4d\xde\x75\x49\xd2\xb6\x20\xb0\x94\x0a\x21\xbd\xab\xd0\x60\x0a\x61\xf5\x2d\xdc\x49\x57\x01\x7e\x73\xa8\x04\x64\x90\x7e\xe6\xc5\x2d\x5f\x60\x0a\x19\x8b\x3f\xe1\x6d\xd7\x25\x93\xb6\x05\x87\xcb\xa6\xe6\x0e\x21\xad\x90\x0b\x34\x29\x30\xf2\xd2\xb6\x40\x67\x63\x94\xc1\x48\x2e\x1b\x6d\x5c\x0a\x99\xdf\xca\x73\x98\x9f\x52\xf2\x0e\x8d\x85\x35\x1a\x27\x0b\xb4\x70\xc3\x09\x05\xed\xcb\x91\x06\xa4\x40\xe5\x64\x29\xd1\xb0\xa4\x5c\xa9\x02\xe6\xa7\x53\x29\xa0\x6d\x21\x63\xf3\x53\xf6\xe5\xbe\x41\xe8\xba\x19\x34\x06\x85\x2c\xb8\x43\xe6\xb7\xce\xf9\x92\xd6\xa1\x4d\x26\x06\xdd\xca\xa8\x47\x0c\xa6\xc9\x64\x42\x35\x67\x6e\xd9\xd4\xf0\xd3\x31\x34\x46\x2a\x57\x42\x2a\x24\xaf\xb1\x70\xf9\x91\xcd\xfb\x93\xb9\x14\x84\xc2\xa5\xd3\x86\x50\x20\x10\xfc\xe1\x6f\x7d\x89\xc1\x4d\x16\x00\x9a\x25\x01\x00\xc3\xd5\x02\x21\xd3\x0d\xf9\xd7\x8d\xf5\x99\x43\x84\x30\xe3\x66\x41\xeb\x29\xf9\xee\xba\xb6\x05\x59\x92\x2d\xfb\x93\x1b\xc9\x85\x2c\xc2\xa2\x37\xf3\x56\x36\x9a\x45\x84\xbd\x0f\x0f\xcc\x28\xf9\xf9\xe9\x91\x4d\xbd\x97\x58\x66\x32\xc9\x73\xe8\x2d\xbb\x0e\x78\xd3\xd4\x12\xad\xe7\x0c\xad\x0f\xa6\x03\x50\xb1\x09\xa1\x4b\x58\x0b\x96\x4c\xfc\xf1\x91\x9f\xe9\x26\x35\x82\x7a\x5f\xea\x8c\xb1\x3e\xd7\x17\xf4\xec\xf9\xa6\x4d\xf6\x30\xf5\xc4\x2c\xd2\x90\x4e\x7a\xd1\xf8\xfa\x21\x8d\xcd\x1a\xf7\xcd\x37\xc7\x7b\x38\xb8\xed\xb9\x6e\xec\x83\xd6\xef\x6f\x3e\x8b\x9b\xb4\x47\x79\x85\x68\xb3\x64\xb2\xab\x8b\x48\x8b\x92\xc2\x67\xec\x23\x21\x6c\x63\x47\xf3\x37\xf0\xeb\xe5\xc5\x39\x14\x5c\x29\xed\xe0\x86\xc6\xc4\xb2\xe1\x86\xc6\x83\x95\x6a\x01\xe9\x71\x0a\x5c\x09\x38\x53\xab\x25\x54\xdc\x02\x07\x47\xa8\x06\x45\x8b\x00\x0c\xf5\xce\x37\x0e\x14\xe1\xe6\x65\xef\x8b\xae\xb8\xfd\x4c\x51\xc9\xf7\x54\x1b\xc8\x4a\x36\xb7\x3e\xa0\xff\x45\x4e\x67\x3d\xb7\x42\x64\x7e\x53\xa3\x4f\xb4\x64\x1f\xb4\x22\xb1\xa2\xf8\xa2\x7f\xe6\xd6\x77\x39\xf1\xd5\xca\xd2\xe7\x14\xdc\x8f\xcf\x75\x5d\x02\xf1\x6f\xcc\xf8\x75\xba\x91\xd0\xc0\xe0\xac\x64\x97\xce\xac\x0a\xe7\xf1\x08\xfb\x8f\x50\x17\xbf\xae\x78\x2d\xdd\x3d\x14\x15\x16\xb7\x0f\x69\xdb\xb6\xf0\x75\xa5\xa9\x2f\x65\x4f\xad\xc0\x63\x98\xbb\xff\xd8\x38\x59\x0a\x5e\x83\xd3\xe3\x00\x67\xbf\xb3\x64\xf2\x1c\xd3\xb3\xf2\x20\x1a\x6f\x70\xc9\x4a\xf6\x89\xdb\x5f\x74\x3c\xe3\xc9\xb3\xf6\x05\x07\x5f\x1e\x48\xbf\x19\x51\x81\x9d\x3f\x3f\xa3\xe2\x0c\x58\x17\x0f\x4c\x36\x64\x0b\xae\x9f\x17\xcf\x33\xea\xf1\xe0\xa7\xc4\xcd\x8d\x56\x0e\x17\x4b\x19\xcf\xee\x6a\xe5\x49\xb1\xec\xa8\x85\xe4\x32\x89\xac\x8a\x65\x1d\xac\x1d\x92\xbd\xed\x27\x6d\xb9\x59\xf5\xc5\xf6\x49\xb1\x8b\xc6\x0e\xe4\x23\xcb\x63\xe2\x15\x2a\x61\xc3\xbf\xd3\x82\xd7\xf5\x8e\x7d\x56\xf6\xaa\x18\x0d\xdf\xad\xe9\xee\xcf\xee\x4e\xf6\xf5\x21\x83\x7d\xfd\xec\x5c\xdf\xd5\xc6\xd6\x78\xf7\xed\x21\xfe\x04\x0d\x11\x95\xc8\x98\x66\x45\x1f\x7b\xa3\xed\x18\xd8\x9b\x1f\x83\x33\x72\xb9\xb9\xd7\xc3\xda\x70\xcf\x6f\x25\xf4\x1d\x37\xc8\xe3\x52\xdc\x7f\xa5\xc8\xd2\xcf\x26\xef\x53\xd6\x3b\x60\x1d\x7a\xd5\xb8\xa0\xb5\x7e\xed\x49\xa1\x6e\x74\xba\xed\x92\xa8\xb8\x26\x48\x97\xfc\x16\xa7\x57\xd7\x52\x39\x34\x25\x2f\xb0\xed\xfe\x0b\x35\xaa\xd1\x50\x98\x11\x65\x27\xa5\x36\x20\xe9\x40\x60\xc5\x1a\xda\x2d\x99\x8e\x85\xb7\xa5\xfa\xe9\x46\x52\x47\xf6\x4a\x5e\x07\x19\xce\x7a\xe5\xac\xaf\xe4\x35\xf8\x51\xb1\xad\x97\xda\xe2\x1e\x9b\x98\xd0\x95\xbc\xde\x52\x56\x30\xec\xaf\xa6\x9e\x77\xe9\xf0\x1d\xe3\x1d\xc6\x29\x3e\xdd\x69\xc0\x6c\xdf\x0c\x7b\x72\x84\xed\x06\x2a\xc6\x91\x36\x09\x7d\xef\x3d\x3f\x4c\xaa\xd7\xbd\xf2\x3d\x3b\x5f\xe7\xd6\x1f\xcd\x8b\xbd\x43\x2c\x68\x96\x9d\x89\x05\xda\x47\x94\x9f\x7e\xe2\x94\x08\x3e\xb8\x1b\x9f\xd0\xe4\x27\x6e\xc9\xe5\x53\x62\xc4\x5e\x02\x28\x16\xb8\x4f\x8b\xaf\xff\x8d\x46\x39\x51\x29\x2f\x6f\x09\xe5\x98\x57\xfc\x95\x3a\x12\x4a\x1c\x42\x1e\xd9\xbf\xa4\xab\xd2\xbe\xf4\xd7\xc5\x36\xa0\xc0\x61\x21\xd7\xa8\xa0\xd0\x4a\x48\x27\xb5\xb2\x30\xd5\xae\x42\x33\x38\xb2\xb3\x7d\x6d\xa0\x6d\x0b\x8c\xb1\x6d\xac\x31\xcc\xf9\x18\xe8\x47\xec\xd5\x5d\xc0\xf4\xf5\xbe\x9b\xf3\x1c\x4e\x94\x80\x85\xd1\xab\x86\x1e\xcd\xd6\xd1\x1b\x77\x80\x6f\xf8\xf2\x3d\x39\x3f\x05\xdd\xa0\xe1\x4e\x1b\xb8\x41\x77\x87\xe8\x7b\xb4\x8c\xef\xc8\x13\x25\xa6\xa3\x73\x0f\xc0\x3d\x04\xd6\x17\x3c\x2d\x9f\x01\x8c\xab\xc3\x9e\x96\x6c\xf4\xb4\xcc\x73\xb8\x30\x87\x40\x71\xf1\xc7\x93\x48\x5c\x98\x1f\x08\x08\x6d\xfe\x09\x0e\xe7\xda\x6d\x09\x94\x2e\xaf\xbe\xe4\xa8\xcd\xa0\xbd\x21\xc5\x50\xfc\xb9\x76\xd3\xe6\x91\xc4\xff\x9d\x8a\x95\x76\x2f\x2e\x79\x50\xc4\xdf\x01\x00\x00\xff\xff\x9e\x52\x46\xfd\x8b\x12\x00\x00")
+var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5d\x6f\xdb\xb8\xd2\xbe\xb6\x7f\xc5\x40\xf0\xe2\xb5\x8b\x44\xde\xdd\xbb\x77\x81\x5e\xa4\xad\x77\x9b\xd3\x6c\xd2\x6c\xb2\x3d\x17\x45\x2f\x18\x69\x64\x73\x2d\x93\x0a\x49\x3b\x35\x0c\xff\xf7\x83\xe1\x87\x64\xd9\x8e\xa5\x7c\xb5\x05\xce\xe9\x95\x2b\xf1\x63\x66\x9e\x67\x9e\x21\x29\x66\xb5\x1a\xbe\xea\xbe\x95\xc5\x52\xf1\xf1\xc4\xc0\xaf\x3f\xff\xf2\xff\xc7\x85\x42\x8d\xc2\xc0\xef\x2c\xc1\x1b\x29\xa7\x70\x2a\x92\x18\x4e\xf2\x1c\x6c\x23\x0d\xf4\x5e\x2d\x30\x8d\xbb\xd7\x13\xae\x41\xcb\xb9\x4a\x10\x12\x99\x22\x70\x0d\x39\x4f\x50\x68\x4c\x61\x2e\x52\x54\x60\x26\x08\x27\x05\x4b\x26\x08\xbf\xc6\x3f\x87\xb7\x90\xc9\xb9\x48\xbb\x5c\xd8\xf7\x67\xa7\x6f\x47\xe7\x57\x23\xc8\x78\x8e\xe0\x9f\x29\x29\x0d\xa4\x5c\x61\x62\xa4\x5a\x82\xcc\xc0\x6c\x4c\x66\x14\x62\xdc\x7d\x35\x5c\xaf\xbb\xdd\xd5\x0a\x52\xcc\xb8\x40\x88\xee\x26\xa8\x30\x02\xf7\xf4\x18\xee\xb8\x99\x00\x7e\x35\x28\x52\xe8\x41\xf4\x91\x25\x53\x36\xc6\x08\x7a\xb1\xff\x09\xc7\xeb\x75\xb7\xb3\x5a\x81\xc1\x59\x91\x33\x83\x10\x4d\x90\xa5\xa8\x22\x88\x69\x94\xd5\x0a\xa8\xaf\x9f\xa5\x6a\xc4\x67\x85\x54\x26\x82\x1e\x35\xea\x0e\x87\x70\xfa\x8e\x8c\x37\xa8\x34\x2c\x50\x19\x9e\xa0\x86\x1b\x46\x51\x90\xd6\x1d\xae\x80\xa7\x28\x0c\xcf\x38\xaa\xb8\x9b\xcd\x45\x02\xa7\xef\xfa\x3c\x85\xd5\x0a\x7a\xf1\xe9\xbb\xf8\x7a\x59\x20\xac\xd7\x03\x28\x14\xa6\x3c\x61\x06\x63\xfb\xea\x9c\xcd\xe8\x39\xac\xba\x1d\x85\x66\xae\xc4\x3d\x0d\xfa\xdd\x4e\x87\x7c\xee\x99\x59\x91\xc3\x6f\xaf\xa1\x50\x5c\x98\x0c\xa2\x94\xb3\x1c\x13\x33\xfc\x49\x0f\xcb\x9e\x43\x9e\x52\x14\xae\x8c\x54\x14\x05\x0a\x82\xed\xfc\xb5\x74\xd1\x0d\xd3\x73\x01\x1a\x74\x5d\x00\x14\x13\x63\x84\x9e\x2c\x68\x7c\x59\x68\x6b\x39\xf8\x10\xf6\x98\x1a\xd3\xf3\x88\xc6\x5e\xaf\x57\x2b\xe0\x19\xb5\x8d\x3f\x31\xc5\x59\xca\x13\xf7\xd0\x36\xb3\xad\xb4\x6f\xe6\x23\x6c\xc7\xb0\x81\xd9\x30\xfe\xf4\xdd\x4f\x3a\xb2\xa3\x78\x37\xbb\x9d\xe1\x10\xca\x96\xeb\x35\xb0\xa2\xc8\x39\x6a\x0a\xb2\x7d\x5e\x35\xad\x02\xe5\x41\x70\x28\x61\x9e\xc6\xdd\x8e\x9d\x68\x63\x9c\x7e\x30\x8d\x42\xbd\xcf\xf4\x38\x8e\x4b\x5b\x1f\x80\x59\x33\x68\x9d\x3d\x4c\x3d\x51\xe3\xc8\x99\x13\x5d\x14\xd6\x7f\x88\x3c\x58\x9b\xb8\x59\x70\xec\x08\xad\x61\x1f\xca\x42\xef\x40\xbf\x1f\xfc\xd8\xbf\xa4\x77\xe4\xb7\x9b\x6d\xd0\xed\x6c\xe7\x85\xa7\x45\x46\xd3\xf7\xe2\xdf\x39\xe6\xa9\xf6\x88\x0e\x5f\xc1\xbf\xae\x2e\xce\x21\x61\x42\x48\x03\x37\x24\x13\xb3\x82\x29\x92\x07\xcd\xc5\x18\xa2\xd7\x11\x30\x91\xc2\x48\xcc\x67\x30\x61\x1a\x18\x18\xca\x04\x97\xd1\xa9\x0b\x0c\x61\x67\x81\x03\x41\x71\xb3\x69\x6f\x9d\x9e\x30\xfd\x91\x66\xa5\xb1\xfb\x52\x41\x2f\x8b\x4f\xb5\x9d\xd0\xfe\xa2\x41\x07\x25\xb7\xdc\xcc\xec\x26\x47\xea\xd2\xcb\xe2\xb7\x52\x50\xb2\x62\x7a\x2d\xdf\x30\x6d\x09\x4a\x62\x70\x4c\xe8\x93\x4d\x6e\xf8\xcd\x7e\xeb\x75\x17\xfc\xbf\xc0\x17\x62\xfc\x22\x0a\x29\xe4\xf9\xe4\xc6\xbf\x32\x6a\x9e\x18\x1b\x0f\xf7\xfe\x1e\xea\xe2\xed\x9c\xe5\xdc\x2c\x21\x99\x60\x32\xdd\xa5\xed\x6a\x05\xb7\x73\x49\x49\x99\x95\xd4\xb2\xe1\x88\xe1\xd4\xfc\x9f\xf6\xca\x92\xb0\x1c\x8c\xdc\x9c\x60\x74\x19\x77\x3b\x4d\x4c\xef\x65\xad\x68\x1c\xe2\xd2\xcb\xe2\xf7\x4c\xff\x21\x7d\x1f\x7a\xd3\x59\x24\x14\x50\xea\x92\xc5\x36\x90\xf6\xa5\x8f\x4a\x88\x57\xf8\x47\xe3\x04\x0d\x58\x24\x3b\x4d\x02\xd9\x6c\xbc\x5a\x24\x4f\x43\xf6\xd8\xe0\x47\xd0\xcb\x3c\x7b\x1f\x92\x2c\x99\xef\xbb\x9d\x2b\x07\x93\x65\x2b\x5b\x3a\x83\x6e\xa7\x63\xf9\x57\xba\xd5\x3a\x77\x28\xed\x75\xa9\xb4\x59\x78\x6a\x33\xa2\x34\x2a\xbe\x28\x74\x45\x3e\x6a\xf9\x9a\x78\x85\x22\xd5\xae\x7f\x3f\x61\x79\x5e\x39\x61\xdb\xf7\xb2\x32\x2b\xbc\x29\x9d\xca\x14\xa7\xee\xb6\xef\xb6\xb2\x2f\xda\x08\xfb\xa2\x51\xd7\xb7\x73\xa3\x26\xef\xd4\xda\x2a\x80\xcb\x21\xa2\x52\x7c\x65\x14\x69\x45\x39\x77\xc8\x6d\x3f\xb1\x6d\xfe\x1a\x8c\xe2\xb3\x50\xd7\xdd\xb3\xaa\xce\xd7\x0c\x7a\x42\x05\xb9\x3f\x15\xf7\x97\x14\x9e\x59\x6d\xb2\x63\xf2\x7c\x2b\x58\x6d\x4b\x8d\xf5\x65\xc3\x83\x83\x89\x1a\xf2\xb4\x3e\x24\x51\x71\x41\x00\xcc\xd8\x14\xfb\x9f\xbf\x70\x61\x50\x65\x2c\xc1\xd5\xfa\x08\x72\x14\x1b\xa2\x30\x20\xca\x76\x32\xa9\x80\x53\x07\xc7\x8a\x05\xac\x6a\x69\xea\x89\xee\xb8\xb8\x99\xf5\xfd\x90\x52\x3f\xe9\xcf\xfc\x8b\x2b\x62\x83\x90\x1b\x9d\xc5\x67\xfe\x05\xac\x54\xd4\xf3\x25\xd7\xb8\xa7\x8d\x37\xe8\x33\xff\x52\xcb\x2c\xd7\xb0\x2c\x4d\x25\xef\x4a\x11\xf6\x03\x7a\x15\xef\x6f\x01\x30\xd8\xa7\x61\x07\x25\x6c\x7b\xa2\x64\x73\xa6\x60\xd0\x53\xeb\x7c\xa5\x54\xcf\x5b\xf2\x2d\x3b\x9f\xa7\xea\x6f\xe8\x45\xf5\xab\x5b\x5a\xd2\xca\x90\x7f\xb4\x14\x78\xbb\x65\x8b\x4b\xeb\x09\xd3\xd7\x75\x5b\xea\xc2\xb4\xab\x91\x64\x50\xa8\xd5\x65\xe5\x77\x78\x87\xff\x5e\x14\xec\x76\x8e\x83\xad\xa7\x9f\x58\x3e\xc7\x2b\x5a\x94\xa0\x0a\xec\x6c\x94\xa9\x68\x74\x19\xe8\x70\x40\x41\x46\x97\xbb\xaa\x71\x37\x91\x39\xba\x85\x50\x2a\x93\xf9\x8c\x76\x57\x32\x6b\x14\x14\x3b\xcf\xf5\x04\x61\x41\xe6\xd2\xde\x0a\x05\xed\xb2\x52\xaa\xf3\x34\xda\x91\x75\x9d\x66\xc8\xa4\x9a\x31\x63\x48\x25\xc3\x23\xa9\x68\xfb\x25\x33\x90\x37\xff\x60\x62\x60\x8a\x4b\x0d\x4c\x21\xf0\xb1\x90\x8a\x76\x6f\x9d\x3d\x8b\x83\x85\x4f\x82\x56\x6b\x82\x36\xf5\x79\x1f\xed\x2b\xae\x07\x3a\x37\x14\xd5\x2d\x36\xda\x55\xa8\x93\x80\x8a\x88\x81\x0d\x25\xc8\x67\x52\x4e\xe7\xc5\x07\x5c\xfa\x51\x76\x01\x8e\xde\x2c\xa3\x6d\x94\xf7\x02\xec\xfc\xa4\xc5\x69\xe9\x2a\x90\x42\xe6\x52\x4e\x29\xe6\xf3\xc2\x82\x49\x1b\x3c\xb3\x84\x9b\x25\x70\xa3\xef\x87\xf6\x08\xcc\x84\x19\x3f\x8d\x5b\xf3\xce\x05\xbf\x25\x88\x45\x8a\x5f\x63\x38\xe3\x53\xf4\x38\xd4\x4d\x1b\x5d\x1e\xfd\x00\x70\xef\xb7\xac\xbf\xd8\x07\x4a\xf5\xf3\x49\x92\x91\xa3\x78\x41\xcd\x38\x51\x8a\x2d\xef\x11\x8e\x92\x3b\x7e\x44\xb7\x3c\xca\xb9\x36\x4e\x10\xa2\x3f\xae\x23\x88\xce\xae\x83\x34\xb4\xd0\x91\x33\xeb\x8c\x2c\x42\x8f\x03\x6a\x42\x83\xd9\x86\xbb\xa2\x92\xa3\x18\x9b\x49\x3b\x1d\xd9\x05\x5e\x00\x17\xa6\x01\xee\x56\xe9\x7d\x38\xbf\xcb\x5a\x56\x25\x7a\x43\xa6\xef\xa4\xba\xcb\xf5\x50\xef\x03\x87\x5e\x82\x64\x53\x5c\x26\x72\x2e\xcc\x0b\x32\xed\xc2\x69\xf1\x37\xa3\xda\x07\x5c\xbe\xf5\x2e\x3d\x95\x6f\x62\x3e\xbb\x71\x0a\x63\x64\x71\x9c\xe3\x02\x73\x27\x32\xed\x18\x38\x1c\x82\xad\xba\x74\x52\xc3\x8c\x2d\x44\x14\x04\xf2\xdf\x4b\x96\x86\x3e\xc6\xe3\x18\xce\xff\x3e\x3b\xd3\x03\x48\xa5\x5d\x3a\xcf\x98\x49\xdc\x09\x40\x69\xd1\xff\x28\xdd\x8a\xd2\x46\xdb\xd8\x3d\x2f\x9b\xa9\x56\x5c\x7d\xb2\x87\xb2\x6f\x65\x3e\x9f\x09\xef\x65\x33\x15\xff\x24\x63\x50\x07\xf2\x1e\x20\x61\x36\xcf\xf3\x63\x83\x5f\x0d\x68\x64\x2a\x29\x35\xce\xa0\x9a\x05\x36\x6a\xb7\x25\xb4\x2b\xa3\x66\x0a\x1e\xf9\x19\xdd\x99\x13\x15\x67\xa3\x17\xd6\x89\x50\x72\xaf\xe6\x05\x1d\xe9\xda\x03\xdb\xdc\x96\xf0\x8f\x52\x9b\xb1\xc2\xab\xcb\xb3\xfd\xa5\xd3\x5a\xe3\xcc\x68\x20\x5d\x1b\xce\x1d\xa4\x5c\xc5\xb4\xc3\x44\x6b\xb3\x4a\xaa\x7e\x3e\x9a\x58\xa4\x95\x38\x2b\xcc\xf2\x19\xa9\x45\x1b\x76\xe2\x4e\x14\x6d\xb1\x6d\x53\x22\xfd\x36\xde\x6f\xee\xaa\x97\x56\x3e\xaa\xcd\x32\xed\x06\x9d\xd0\x46\xf7\xf4\x70\x05\xbf\xd6\xc1\x3e\xda\x3a\xb9\xa8\x8e\x5b\xa8\x51\x5b\xa2\x9f\xea\x91\x0b\x4e\xdc\x4c\x75\xdf\xd6\x6f\x88\x77\x45\xf7\xb0\xa8\x0e\x87\xf0\xb7\xc8\xf9\x14\x81\x09\xb0\x88\xd0\x44\xb9\xbc\x43\x65\xc7\x3b\xb2\x5a\x1a\x92\xa4\x41\x50\x77\x08\xfe\xd2\xac\x8e\x68\x8f\x41\x51\xfa\x01\xe9\x3d\xc5\xe5\xf7\x5e\x05\x1c\xc3\xf0\x95\x2b\xb0\x65\xc1\x1c\xf3\x05\x0a\x60\x06\xd4\x5c\x18\x3e\x43\xfb\xb0\x60\x9a\xbe\x33\x19\x69\x31\x4d\x99\x61\xf4\xe1\x09\x68\x5b\xa1\xc6\x76\xaf\xa9\xdd\x3e\x31\x94\xdc\x82\x29\xea\xc0\x34\x14\xcc\x4c\x74\xec\xcf\xd3\xdb\x50\xfb\x3d\xd3\x1f\x70\xd9\x42\xc2\x5d\xc3\x47\xf0\x99\xb6\xbb\x53\x5c\xd2\x66\x97\xd5\x17\x1a\xce\x09\x6e\xe8\xd5\x01\x9f\x45\xe9\xf6\x7e\x56\xd3\xe0\xdf\x46\xb5\x23\x1b\xaa\xc8\x52\x29\x7a\xc3\x45\x1a\x81\x51\x73\x7c\x2a\xdd\x6b\x48\xdd\x07\x94\x5d\x6a\x3d\xfa\xbc\xe2\x5e\x94\xfc\x49\x84\x36\x74\x80\x10\xbe\xd4\x3a\x56\x4e\x71\xf9\x1d\x30\x3c\x82\x05\x6c\x9c\x65\x7e\x53\x48\xed\x67\x86\x68\xf1\x12\xe0\x86\x73\x55\x12\x8b\x3f\x59\x61\xd1\xf4\x5b\xf4\x6e\xa7\x0d\xfe\x1f\x70\x59\xa1\xff\x50\xf8\x0f\x82\xfc\xd8\x0d\x67\x1d\x33\x5f\xf0\x1a\xf0\x6a\x05\xd8\xf3\x21\xd6\x00\x59\xcb\x65\xbc\xff\x9f\x2f\x08\x3a\xf3\x1f\x1b\x09\xca\xcd\xb2\xd0\x42\x6e\x7b\xda\x87\x36\x7a\x3c\x96\x15\x4c\x3a\x8b\xdd\x59\xd8\x53\x50\xb4\xc8\x91\x5d\x1f\xb8\x48\xbf\x21\x80\xfd\x9a\x13\x83\x0d\x28\x9f\x1b\xbe\xda\xef\xea\xe7\x93\x56\x13\x37\x52\xe6\xdf\x7b\x39\xd1\xc0\xb5\xe8\x8d\x94\xb9\xd5\x99\xc0\xb4\x07\x12\x8d\x7c\x44\x26\x9e\x26\x1b\xd5\x82\x76\xdf\x19\xa3\x2f\x6a\x47\x1b\x22\xc5\x75\x75\xa1\x80\x56\x3c\xee\x90\x3d\xd8\xc2\x05\xd0\xc7\x4f\x8f\x8e\x5f\x06\x19\xba\x23\x94\x49\x85\x61\x53\xe8\x0e\x36\xc2\xe9\xc3\x2f\x03\x90\xca\x2b\x55\x78\x16\x51\xdd\x8e\x06\xd5\x42\xac\xee\x24\xe5\x53\xc3\xe2\xfa\xdb\x57\x45\x8a\x41\x43\x76\xb6\x49\xce\x83\xb9\x59\xe5\xde\xe1\xd4\xbb\xa7\xd8\xbd\x44\xaa\xdd\xce\x51\x2d\x0b\xa6\xd8\xec\x05\x13\xee\xef\xbf\xce\x9e\x98\x6d\x97\x64\xe6\x47\x32\x73\x74\xf9\xc8\x84\x73\x15\xd9\xfa\x0b\xd6\x61\x34\xa8\x5a\xa7\x19\xad\xd1\xa2\xbf\xd8\x9d\x35\x24\x0a\xdd\xc8\x31\xba\x64\xe7\xe8\x4d\xa4\x2b\x19\x55\x4f\x1d\xff\xb9\xc3\x7d\x8d\xa2\xea\xfa\xda\xe6\x63\x04\x05\xa3\xab\x6e\xda\xcf\x62\x0f\xa0\xca\xbb\x3e\xd4\xe7\xec\xf4\xc3\x08\x64\x81\x8a\x19\xa9\x5c\x42\x96\xc6\xfb\x9d\xce\x1d\xaa\x6a\xec\x94\x67\x19\x2a\x14\x26\xaf\xe7\xd8\xbd\x29\x40\xdc\xff\xaf\x3d\x95\x61\xee\x10\xe3\xc5\x88\x6f\x3f\x8d\xb7\xfa\x5a\xd2\xc0\xff\xb7\x52\x18\xc6\x85\x3e\x11\x6d\x76\x93\xa5\xab\x8e\x20\xf6\x9a\x94\x27\xcb\x41\xb2\x83\x9e\x30\x85\x9a\x76\xcc\x39\x32\x6d\x40\x0a\x04\xcc\x91\x34\xb4\xba\x59\xe6\x12\xc9\xf2\x57\xef\xa7\xd5\x42\xc3\xe7\x2f\xf6\x41\x4c\xce\x8e\x72\x9c\xb5\xfb\x60\x76\xf0\xca\xc5\x42\xbb\xab\x16\xfb\xee\x5a\x6c\xde\x84\x58\xe8\x70\x03\x62\xfd\x3c\xc4\xb5\x27\xd8\x75\x08\xc2\x7a\x2a\x8e\xe3\xe8\xe9\xc4\xbe\xe7\x1b\x86\x9f\x29\xcf\x03\xe4\xcd\x44\x69\xf7\xd5\xc2\x21\x58\x06\xa4\xd4\x17\xe8\xdb\xc3\x31\x7d\x9b\xc7\x7f\x5c\x0f\x68\x7b\xe3\x38\x8d\xb7\xf6\x00\x3f\xf2\xec\x63\x62\x19\x58\x11\x0e\x0b\xd7\x6b\x5a\x38\xf8\x87\xba\xcc\xc9\x76\xf2\xba\x4b\x20\x59\x00\xfd\xec\x07\x31\xad\x6d\x59\x5f\x91\x7d\x1f\x83\xf1\x7e\x77\xf4\x60\xaa\xb5\xa2\x45\x0b\x5e\xb8\xc0\x7c\xd7\xd5\xb5\xa7\x8e\xbb\xa6\x19\x8f\xd2\x31\x56\x57\xd1\xea\x6c\x89\xde\x33\xba\xcd\x8a\x35\xce\x34\x5c\xf1\x7a\xcf\x34\x0d\xb9\x5b\x50\x2b\x50\xb1\x8c\x2d\xa6\x63\xdc\x77\xb5\xeb\x20\x18\xcd\x48\xec\x81\x81\x6c\x22\x57\xca\x00\x96\xea\xff\x5b\x83\xfc\x93\x8d\xc3\x09\x7b\xa6\x0b\x3e\xb5\x73\x05\x7b\x8f\xeb\xdf\xdc\x4c\xa2\xd2\xf5\xe7\x8d\xad\x23\x23\xf3\x19\x9c\x48\x91\x72\xc3\xa5\xd0\xd0\x97\xb4\xd8\xa8\x06\xd2\x83\x7d\x30\xd0\x6b\x0d\x71\x1c\x97\xed\x6c\xac\x31\x26\x79\x0e\x13\xfd\x88\x58\x91\xdb\x4f\xc7\x6b\x23\x6d\x86\x43\x38\x11\x29\x8c\x95\x9c\x17\xf4\x37\x18\x54\xec\xb2\xca\x2d\x5d\x95\xbb\x93\xf3\x77\x95\x40\xde\xa0\xb9\x43\xb4\x18\xcd\xfc\x9f\x25\x9c\x88\xb4\xbf\xd1\x6f\x27\xb8\x6d\xc2\xda\x18\xd5\xea\x2f\x15\x1a\x02\xc6\x44\xbb\xbf\x54\xf0\x5f\x11\xec\x5f\x2a\x0c\x87\x70\xa1\xda\x84\xe2\xe2\xaf\x83\x91\xb8\x50\x3f\x50\x20\xa4\x7a\x4c\x1c\xce\xa5\xa9\x25\x28\x6d\x5a\x4a\x97\xa5\xd8\x57\x3d\xbd\xf3\xe7\xd2\xf4\x0b\xf8\x9e\x1e\x0b\x69\x1e\xec\xf2\x6a\x05\x28\x52\x58\xaf\xbb\xff\x19\x00\x7d\x74\x81\xa0\xda\x34\x00\x00")
 
 func templateWhereTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1121,7 +1121,7 @@ func templateWhereTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/where.tmpl", size: 4747, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/where.tmpl", size: 13530, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -6,9 +6,11 @@ package gen
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"go/token"
 	"go/types"
+	"math"
 	"path"
 	"reflect"
 	"sort"
@@ -17,6 +19,8 @@ import (
 	"unicode"
 
 	"github.com/facebook/ent"
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/entsql"
 	"github.com/facebook/ent/dialect/sql/schema"
 	"github.com/facebook/ent/entc/load"
 	"github.com/facebook/ent/schema/field"
@@ -49,6 +53,12 @@ type (
 	// Field holds the information of a type field used for the templates.
 	Field struct {
 		def *load.Field
+		// tsColumn holds the generated tsvector column of
+		// the full-text index of the field (if any).
+		tsColumn string
+		// lookupKey indicates that the field is a JSON object or array
+		// with a unique index, that is used for looking up entities.
+		lookupKey bool
 		// Name is the name of this field in the database schema.
 		Name string
 		// Type holds the type information of the field.
@@ -72,6 +82,13 @@ type (
 		StructTag string
 		// Validators holds the number of validators this field have.
 		Validators int
+		// Marshaler indicates that this JSON field has a custom marshaler.
+		Marshaler bool
+		// Unmarshaler indicates that this JSON field has a custom unmarshaler.
+		Unmarshaler bool
+		// ScanCoerce indicates that the stored values of this JSON field are
+		// transformed by a function before they are decoded.
+		ScanCoerce bool
 		// Position info of the field.
 		Position *load.Position
 		// UserDefined indicates that this field was defined by the loaded schema.
@@ -136,8 +153,20 @@ type (
 		Name string
 		// Unique index or not.
 		Unique bool
+		// Type of the index (e.g. GIN). Postgres only.
+		Type string
+		// JSONPath and JSONColumn hold the JSON path of an index created
+		// on a JSON field, and its generated column name. MySQL only.
+		JSONPath   string
+		JSONColumn string
+		// TSColumn holds the name of the generated tsvector column of
+		// a full-text index created on a JSON field. PostgreSQL only.
+		TSColumn string
 		// Columns are the table columns.
 		Columns []string
+		// Annotations that were defined for the index in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations map[string]interface{}
 	}
 
 	// ForeignKey holds the information for foreign-key columns of types.
@@ -190,12 +219,19 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			Immutable:     f.Immutable,
 			StructTag:     structTag(f.Name, f.Tag),
 			Validators:    f.Validators,
+			Marshaler:     f.Marshaler,
+			Unmarshaler:   f.Unmarshaler,
+			ScanCoerce:    f.ScanCoerce,
 			UserDefined:   true,
 			Annotations:   f.Annotations,
 		}
 		if err := typ.checkField(tf, f); err != nil {
 			return nil, err
 		}
+		// Generated columns are computed by the database, and cannot be updated.
+		if tf.IsGenerated() {
+			tf.Immutable = true
+		}
 		// User defined id field.
 		if tf.Name == typ.ID.Name {
 			typ.ID = tf
@@ -256,6 +292,17 @@ func (t Type) HasValidators() bool {
 	return false
 }
 
+// HasMarshalers reports if any of the type's field has a custom JSON marshaler or unmarshaler,
+// or a function for coercing its values on scan.
+func (t Type) HasMarshalers() bool {
+	for _, f := range t.Fields {
+		if f.Marshaler || f.Unmarshaler || f.ScanCoerce {
+			return true
+		}
+	}
+	return false
+}
+
 // HasDefault reports if any of this type's fields has default value on creation.
 func (t Type) HasDefault() bool {
 	fields := t.Fields
@@ -324,7 +371,7 @@ func (t Type) MixedInFields() []int {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
-		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0) {
+		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0 || f.Marshaler || f.Unmarshaler || f.ScanCoerce) {
 			idx[f.Position.MixinIndex] = struct{}{}
 		}
 	}
@@ -431,7 +478,16 @@ func (t Type) TagTypes() []string {
 // AddIndex adds a new index for the type.
 // It fails if the schema index is invalid.
 func (t *Type) AddIndex(idx *load.Index) error {
-	index := &Index{Name: idx.StorageKey, Unique: idx.Unique}
+	index := &Index{Name: idx.StorageKey, Unique: idx.Unique, Annotations: idx.Annotations}
+	if ant := entsqlIndexAnnotation(idx.Annotations); ant != nil {
+		index.Type = ant.Type
+		index.JSONPath = ant.JSONPath
+		if ant.TSVector {
+			if err := t.checkTSVectorIndex(idx, index); err != nil {
+				return err
+			}
+		}
+	}
 	if len(idx.Fields) == 0 && len(idx.Edges) == 0 {
 		return fmt.Errorf("missing fields or edges")
 	}
@@ -443,6 +499,15 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		if f.def.Size != nil && *f.def.Size > schema.DefaultStringLen {
 			return fmt.Errorf("field %q exceeds the index size limit (%d)", name, schema.DefaultStringLen)
 		}
+		// JSON columns are indexed in MySQL using generated
+		// columns that hold their hashes (named <column>_hash).
+		if f.IsJSON() && index.JSONPath == "" && index.TSColumn == "" {
+			for _, f2 := range t.Fields {
+				if f2.StorageKey() == f.StorageKey()+"_hash" {
+					return fmt.Errorf("generated hash column of field %q in index conflicts with field %q", name, f2.Name)
+				}
+			}
+		}
 		index.Columns = append(index.Columns, f.StorageKey())
 	}
 	for _, name := range idx.Edges {
@@ -464,17 +529,88 @@ func (t *Type) AddIndex(idx *load.Index) error {
 			index.Columns = append(index.Columns, edge.Rel.Column())
 		}
 	}
+	if index.JSONPath != "" {
+		if err := t.checkJSONIndex(idx, index); err != nil {
+			return err
+		}
+	}
+	// JSON objects and arrays that have a unique index can be used as lookup keys.
+	if index.Unique && index.JSONPath == "" && index.TSColumn == "" && len(idx.Fields) == 1 && len(idx.Edges) == 0 {
+		if f := t.fields[idx.Fields[0]]; (f.IsJSONObject() || f.IsJSONArray()) && !f.IsJSONOpaque() && !f.IsJSONValueScanner() {
+			f.lookupKey = true
+		}
+	}
 	// If no storage-key was defined for this index, generate one.
 	if idx.StorageKey == "" {
 		// Add the type name as a prefix to the index parts, because
 		// multiple types can share the same index attributes.
 		parts := append([]string{strings.ToLower(t.Name)}, index.Columns...)
+		if index.JSONColumn != "" {
+			parts = []string{strings.ToLower(t.Name), index.JSONColumn}
+		}
+		if index.TSColumn != "" {
+			parts = []string{strings.ToLower(t.Name), index.TSColumn}
+		}
 		index.Name = strings.Join(parts, "_")
 	}
 	t.Indexes = append(t.Indexes, index)
 	return nil
 }
 
+// checkJSONIndex checks the index that was defined on a JSON path,
+// and sets the name of its generated column.
+func (t *Type) checkJSONIndex(idx *load.Index, index *Index) error {
+	if len(idx.Fields) != 1 || len(idx.Edges) != 0 {
+		return fmt.Errorf("json index %q must be defined on exactly one field", index.JSONPath)
+	}
+	f := t.fields[idx.Fields[0]]
+	if !f.IsJSON() {
+		return fmt.Errorf("json index %q must be defined on a json field (got %s)", index.JSONPath, f.Type)
+	}
+	if !strings.HasPrefix(index.JSONPath, "$") || index.JSONPath == "$" {
+		return fmt.Errorf("invalid json path %q for field %q", index.JSONPath, f.Name)
+	}
+	suffix := strings.Trim(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, index.JSONPath[1:]), "_")
+	index.JSONColumn = snake(f.StorageKey() + "_" + suffix)
+	for _, f := range t.Fields {
+		if f.StorageKey() == index.JSONColumn {
+			return fmt.Errorf("generated column %q of json index %q conflicts with field %q", index.JSONColumn, index.JSONPath, f.Name)
+		}
+	}
+	return nil
+}
+
+// checkTSVectorIndex checks the full-text index that was defined on a JSON
+// field, and sets the name of its generated tsvector column.
+func (t *Type) checkTSVectorIndex(idx *load.Index, index *Index) error {
+	if len(idx.Fields) != 1 || len(idx.Edges) != 0 {
+		return fmt.Errorf("tsvector index must be defined on exactly one field")
+	}
+	f, ok := t.fields[idx.Fields[0]]
+	if !ok {
+		return fmt.Errorf("unknown index field %q", idx.Fields[0])
+	}
+	if !f.IsJSON() || f.IsJSONOpaque() {
+		return fmt.Errorf("tsvector index must be defined on a non-compressed and non-encrypted json field (got %q)", f.Name)
+	}
+	if f.tsColumn != "" {
+		return fmt.Errorf("field %q has more than one tsvector index", f.Name)
+	}
+	index.TSColumn = f.StorageKey() + "_tsv"
+	for _, f2 := range t.Fields {
+		if f2.StorageKey() == index.TSColumn {
+			return fmt.Errorf("generated column %q of tsvector index conflicts with field %q", index.TSColumn, f2.Name)
+		}
+	}
+	f.tsColumn = index.TSColumn
+	return nil
+}
+
 // resolveFKs makes sure all edge-fks are created for the types.
 func (t *Type) resolveFKs() error {
 	for _, e := range t.Edges {
@@ -626,8 +762,24 @@ func (t *Type) check() error {
 	return nil
 }
 
+// fieldByStorageKey returns the field (including the id field) that was already
+// defined in the type and stored in the same column as the given field, or nil.
+func (t *Type) fieldByStorageKey(tf *Field) *Field {
+	key := tf.StorageKey()
+	if tf.Name != t.ID.Name && t.ID.StorageKey() == key {
+		return t.ID
+	}
+	for _, f := range t.Fields {
+		if f.StorageKey() == key {
+			return f
+		}
+	}
+	return nil
+}
+
 // checkField checks the schema field.
 func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
+	dup := t.fieldByStorageKey(tf)
 	switch {
 	case f.Name == "":
 		err = fmt.Errorf("field name cannot be empty")
@@ -639,6 +791,8 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		err = fmt.Errorf("unique field %q cannot have default value", f.Name)
 	case t.fields[f.Name] != nil:
 		err = fmt.Errorf("field %q redeclared for type %q", f.Name, t.Name)
+	case dup != nil:
+		err = fmt.Errorf("field %q has the same storage key %q as field %q in type %q", f.Name, tf.StorageKey(), dup.Name, t.Name)
 	case f.Sensitive && f.Tag != "":
 		err = fmt.Errorf("sensitive field %q cannot have struct tags", f.Name)
 	case f.Info.Type == field.TypeEnum:
@@ -648,6 +802,50 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		}
 	case tf.Validators > 0 && !tf.ConvertedToBasic():
 		err = fmt.Errorf("GoType %q for field %q must be converted to basic Go type for validators", tf.Type, f.Name)
+	case f.ScanCoerce && tf.IsJSONValueScanner():
+		err = fmt.Errorf("ScanCoerce is not supported for field %q, because its type is scanned using the sql.Scanner interface", f.Name)
+	case f.EmitNull != nil && !*f.EmitNull && !f.Optional && !tf.JSONNilEmpty():
+		err = fmt.Errorf("EmitNull(false) is allowed only for optional fields, or for required slices and maps, but was set for field %q", f.Name)
+	case tf.EntSQL() != nil && tf.EntSQL().Backfill && (f.Info.Type != field.TypeJSON || f.DefaultValue == nil):
+		err = fmt.Errorf("entsql.Annotation.Backfill is allowed only for JSON fields with a default value, but was set for field %q", f.Name)
+	case tf.EntSQL() != nil && len(tf.EntSQL().Generated) > 0:
+		switch ant := tf.EntSQL(); {
+		case !f.Optional:
+			err = fmt.Errorf("entsql.Annotation.Generated is allowed only for optional fields, but was set for field %q", f.Name)
+		case f.Default || f.UpdateDefault || f.Validators > 0 || ant.DefaultExpr != "" || ant.Compress != "" || ant.Encrypt != "":
+			err = fmt.Errorf("entsql.Annotation.Generated cannot be combined with defaults, validators, Compress or Encrypt for field %q", f.Name)
+		}
+	case tf.EntSQL() != nil && tf.EntSQL().SortedKeys && (f.Info.Type != field.TypeJSON || tf.IsJSONValueScanner()):
+		err = fmt.Errorf("entsql.Annotation.SortedKeys is allowed only for JSON fields that are encoded as JSON, but was set for field %q", f.Name)
+	case tf.EntSQL() != nil && tf.EntSQL().Compress != "":
+		switch ant := tf.EntSQL(); {
+		case f.Info.Type != field.TypeJSON:
+			err = fmt.Errorf("entsql.Annotation.Compress is allowed only for JSON fields, but was set for field %q", f.Name)
+		case ant.Compress != "gzip":
+			err = fmt.Errorf("unsupported entsql.Annotation.Compress %q for field %q (expect gzip)", ant.Compress, f.Name)
+		case tf.IsJSONValueScanner():
+			err = fmt.Errorf("entsql.Annotation.Compress is not supported for field %q that implements the driver.Valuer interface", f.Name)
+		case ant.Incremental || ant.Backfill || ant.DefaultExpr != "" || ant.Type != "":
+			err = fmt.Errorf("entsql.Annotation.Compress cannot be combined with Incremental, Backfill, DefaultExpr or Type for field %q", f.Name)
+		}
+	case tf.EntSQL() != nil && tf.EntSQL().Encrypt != "":
+		switch ant := tf.EntSQL(); {
+		case f.Info.Type != field.TypeJSON:
+			err = fmt.Errorf("entsql.Annotation.Encrypt is allowed only for JSON fields, but was set for field %q", f.Name)
+		case tf.IsJSONValueScanner():
+			err = fmt.Errorf("entsql.Annotation.Encrypt is not supported for field %q that implements the driver.Valuer interface", f.Name)
+		case ant.Incremental || ant.Backfill || ant.DefaultExpr != "" || ant.Type != "":
+			err = fmt.Errorf("entsql.Annotation.Encrypt cannot be combined with Incremental, Backfill, DefaultExpr or Type for field %q", f.Name)
+		}
+	case tf.EntSQL() != nil && tf.EntSQL().DefaultExpr != "" && f.Info.Type != field.TypeJSON:
+		err = fmt.Errorf("entsql.Annotation.DefaultExpr is allowed only for JSON fields, but was set for field %q", f.Name)
+	case tf.EntSQL() != nil && tf.EntSQL().Type != "":
+		switch typ := tf.EntSQL().Type; {
+		case f.Info.Type != field.TypeJSON:
+			err = fmt.Errorf("entsql.Annotation.Type is allowed only for JSON fields, but was set for field %q", f.Name)
+		case typ != "json" && typ != "jsonb":
+			err = fmt.Errorf("unsupported entsql.Annotation.Type %q for field %q (expect json or jsonb)", typ, f.Name)
+		}
 	}
 	return err
 }
@@ -705,6 +903,15 @@ func (f Field) EnumName(enum string) string {
 // Validator returns the validator name.
 func (f Field) Validator() string { return pascal(f.Name) + "Validator" }
 
+// MarshalerName returns the variable name of the custom JSON marshaler of this field.
+func (f Field) MarshalerName() string { return pascal(f.Name) + "Marshaler" }
+
+// UnmarshalerName returns the variable name of the custom JSON unmarshaler of this field.
+func (f Field) UnmarshalerName() string { return pascal(f.Name) + "Unmarshaler" }
+
+// ScanCoerceName returns the variable name of the function that coerces the stored values of this field.
+func (f Field) ScanCoerceName() string { return pascal(f.Name) + "ScanCoerce" }
+
 // mutMethods returns the method names of mutation interface.
 var mutMethods = func() map[string]struct{} {
 	t := reflect.TypeOf(new(ent.Mutation)).Elem()
@@ -755,6 +962,18 @@ func (f Field) IsTime() bool { return f.Type != nil && f.Type.Type == field.Type
 // IsJSON returns true if the field is a JSON field.
 func (f Field) IsJSON() bool { return f.Type != nil && f.Type.Type == field.TypeJSON }
 
+// IsJSONArray returns true if the field is a JSON field that holds a Go slice or array.
+// Byte slices are not JSON arrays, as they are encoded as base64 strings (or stored as
+// is, if the field is Raw).
+func (f Field) IsJSONArray() bool {
+	return f.IsJSON() && strings.HasPrefix(f.Type.Ident, "[") && !f.IsJSONBytes()
+}
+
+// IsJSONBytes returns true if the field is a JSON field of type []byte.
+func (f Field) IsJSONBytes() bool {
+	return f.IsJSON() && (f.Type.Ident == "[]byte" || f.Type.Ident == "[]uint8")
+}
+
 // IsString returns true if the field is a string field.
 func (f Field) IsString() bool { return f.Type != nil && f.Type.Type == field.TypeString }
 
@@ -772,7 +991,7 @@ func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
 
 // NullType returns the sql null-type for optional and nullable fields.
 func (f Field) NullType() string {
-	if f.Type.ValueScanner() {
+	if f.Type.ValueScanner() && (!f.IsJSON() || f.IsJSONValueScanner()) {
 		return f.Type.String()
 	}
 	switch f.Type.Type {
@@ -833,6 +1052,32 @@ func (f Field) Column() *schema.Column {
 	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
+		c.Comment = f.def.Comment
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Backfill && f.Default {
+		if b, err := json.Marshal(f.DefaultValue()); err == nil {
+			c.Backfill = string(b)
+		}
+	}
+	if ant := f.EntSQL(); ant != nil && ant.DefaultExpr != "" {
+		c.DefaultExpr = ant.DefaultExpr
+	}
+	if ant := f.EntSQL(); ant != nil && len(ant.Generated) > 0 {
+		c.Generated = ant.Generated
+	}
+	// Compressed and encrypted values are stored in a binary column.
+	if f.IsJSONOpaque() {
+		c.Type = field.TypeBytes
+		if c.Size == 0 {
+			c.Size = math.MaxUint32
+		}
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Type != "" && c.SchemaType[dialect.Postgres] == "" {
+		schemaType := map[string]string{dialect.Postgres: ant.Type}
+		for k, v := range c.SchemaType {
+			schemaType[k] = v
+		}
+		c.SchemaType = schemaType
 	}
 	return c
 }
@@ -880,7 +1125,377 @@ func (f Field) StorageKey() string {
 // HasGoType indicate if a basic field (like string or bool)
 // has a custom GoType.
 func (f Field) HasGoType() bool {
-	return f.Type != nil && f.Type.RType != nil
+	return f.Type != nil && f.Type.RType != nil && !f.IsJSON()
+}
+
+// JSONElemType returns the Go type of the elements of a JSON array field.
+// For example, "int" for a JSON field of type []int. An empty string is
+// returned for JSON fields that are not arrays.
+func (f Field) JSONElemType() string {
+	if !f.IsJSONArray() {
+		return ""
+	}
+	return f.Type.Ident[strings.IndexByte(f.Type.Ident, ']')+1:]
+}
+
+// IsJSONMap returns true if the field is a JSON field of type map[string]T.
+func (f Field) IsJSONMap() bool {
+	return f.IsJSON() && strings.HasPrefix(f.Type.Ident, "map[string]")
+}
+
+// JSONMapValueType returns the Go type of the values of a JSON map field, if
+// it is a basic Go type (like string or int). For example, "string" for a JSON
+// field of type map[string]string. An empty string is returned otherwise.
+func (f Field) JSONMapValueType() string {
+	if !f.IsJSONMap() {
+		return ""
+	}
+	if t := strings.TrimPrefix(f.Type.Ident, "map[string]"); isBasicType(t) {
+		return t
+	}
+	return ""
+}
+
+// IsJSONBasicArray returns true if the field is a JSON array field, and its
+// elements are of a basic Go type (like string or int). For example, []int.
+func (f Field) IsJSONBasicArray() bool {
+	return f.IsJSONArray() && isBasicType(f.JSONElemType())
+}
+
+// JSONSchema returns the JSON Schema fragment that describes the values of the field.
+// The fragment that was defined in the schema using the field.JSONSchema annotation is
+// returned as is. Otherwise, for JSON fields, the fragment is derived from the Go type
+// of the field. For example, {"items":{"type":"integer"},"type":"array"} for []int.
+// Nil is returned for other fields.
+func (f Field) JSONSchema() json.RawMessage {
+	ant := &field.JSONSchemaAnnotation{}
+	if v, ok := f.Annotations[ant.Name()]; ok {
+		if buf, err := json.Marshal(v); err == nil && json.Unmarshal(buf, ant) == nil && len(ant.Schema) > 0 {
+			return ant.Schema
+		}
+	}
+	if !f.IsJSON() {
+		return nil
+	}
+	var schema map[string]interface{}
+	switch rt := f.Type.RType; {
+	case f.IsJSONArray():
+		schema = map[string]interface{}{"type": "array", "items": basicSchema(f.JSONElemType())}
+	case f.IsJSONMap():
+		schema = map[string]interface{}{"type": "object"}
+		if t := f.JSONMapValueType(); t != "" {
+			schema["additionalProperties"] = basicSchema(t)
+		}
+	case rt != nil && rt.Name == "RawMessage" && rt.PkgPath == "encoding/json":
+		// Any JSON value.
+		schema = map[string]interface{}{}
+	case f.IsJSONObject():
+		schema = map[string]interface{}{"type": "object"}
+	default:
+		schema = basicSchema(f.Type.String())
+	}
+	buf, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+	return buf
+}
+
+// basicSchema returns the JSON Schema of the given Go type identifier.
+// An empty schema (any value) is returned for non-basic types.
+func basicSchema(t string) map[string]interface{} {
+	switch {
+	case t == "string":
+		return map[string]interface{}{"type": "string"}
+	case t == "bool":
+		return map[string]interface{}{"type": "boolean"}
+	case strings.HasPrefix(t, "float"):
+		return map[string]interface{}{"type": "number"}
+	case isBasicType(t):
+		return map[string]interface{}{"type": "integer"}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// isBasicType reports if the given type identifier is a basic Go type
+// that can be compared in the database.
+func isBasicType(t string) bool {
+	switch t {
+	case "string", "bool", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return true
+	default:
+		return false
+	}
+}
+
+// EntSQL returns the EntSQL annotation of the field if exists.
+func (f Field) EntSQL() *entsql.Annotation {
+	return entsqlAnnotation(f.Annotations)
+}
+
+// entsqlAnnotation decodes the EntSQL annotation from the given annotations.
+// It returns nil if the annotation was not defined in the schema.
+func entsqlAnnotation(annotations map[string]interface{}) *entsql.Annotation {
+	ant := &entsql.Annotation{}
+	v, ok := annotations[ant.Name()]
+	if !ok {
+		return nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil
+	}
+	return ant
+}
+
+// entsqlIndexAnnotation decodes the EntSQL index annotation from the given
+// annotations. It returns nil if the annotation was not defined in the schema.
+func entsqlIndexAnnotation(annotations map[string]interface{}) *entsql.IndexAnnotation {
+	ant := &entsql.IndexAnnotation{}
+	v, ok := annotations[ant.Name()]
+	if !ok {
+		return nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil
+	}
+	return ant
+}
+
+// IsJSONIncremental returns true if the field is a JSON array
+// field that was annotated with entsql.Incremental.
+func (f Field) IsJSONIncremental() bool {
+	ant := f.EntSQL()
+	return f.IsJSONArray() && ant != nil && ant.Incremental
+}
+
+// IsJSONHashable returns true if the field is a JSON
+// field that was annotated with entsql.Hashable.
+func (f Field) IsJSONHashable() bool {
+	ant := f.EntSQL()
+	return f.IsJSON() && ant != nil && ant.Hashable
+}
+
+// IsGenerated returns true if the field is stored in a generated column
+// (using the entsql.Generated annotation). Its value is computed by the
+// database, and therefore, it cannot be set by the generated builders.
+func (f Field) IsGenerated() bool {
+	ant := f.EntSQL()
+	return ant != nil && len(ant.Generated) > 0
+}
+
+// IsJSONNonFinite returns true if the field is a JSON array of floats
+// that was annotated with entsql.NonFinite, and its NaN and infinite
+// values are encoded as strings.
+func (f Field) IsJSONNonFinite() bool {
+	ant := f.EntSQL()
+	if ant == nil || !ant.NonFinite || !f.IsJSONArray() {
+		return false
+	}
+	t := f.JSONElemType()
+	return t == "float64" || t == "float32"
+}
+
+// IsJSONValueScanner returns true if the field is a JSON field whose Go type implements
+// the sql.Scanner and driver.Valuer interfaces, and its values are stored and scanned
+// using them instead of the JSON encoding. Fields with a custom Marshaler or Unmarshaler,
+// and fields that were annotated with entsql.ForceJSON are encoded as JSON.
+func (f Field) IsJSONValueScanner() bool {
+	if !f.IsJSON() || !f.Type.ValueScanner() || f.Marshaler || f.Unmarshaler {
+		return false
+	}
+	ant := f.EntSQL()
+	return ant == nil || !ant.ForceJSON
+}
+
+// JSONCompression returns the compression algorithm of a JSON field that was
+// annotated with entsql.Compress, or an empty string if it is not compressed.
+func (f Field) JSONCompression() string {
+	if ant := f.EntSQL(); f.IsJSON() && ant != nil {
+		return ant.Compress
+	}
+	return ""
+}
+
+// JSONEncryptKey returns the key identifier of a JSON field that was annotated
+// with entsql.Encrypt, or an empty string if it is not encrypted.
+func (f Field) JSONEncryptKey() string {
+	if ant := f.EntSQL(); f.IsJSON() && ant != nil {
+		return ant.Encrypt
+	}
+	return ""
+}
+
+// IsJSONOpaque returns true if the values of a JSON field are not stored as JSON
+// documents (i.e. compressed or encrypted), and therefore, the JSON functions of
+// the database cannot be used on them.
+func (f Field) IsJSONOpaque() bool {
+	return f.JSONCompression() != "" || f.JSONEncryptKey() != ""
+}
+
+// IsJSONSortedKeys reports if the values of a JSON field are stored in a canonical
+// form with sorted object keys (i.e. annotated with entsql.SortedKeys). The values of
+// lookup keys are always stored in this form, in order to keep their unique index
+// consistent in databases that compare them as text (e.g. SQLite).
+func (f Field) IsJSONSortedKeys() bool {
+	if f.lookupKey {
+		return true
+	}
+	ant := f.EntSQL()
+	return f.IsJSON() && ant != nil && ant.SortedKeys
+}
+
+// IsJSONLookupKey reports if the field is a JSON object or array that has a unique
+// index on its own, and a "By<Field>" predicate is generated for looking up entities
+// by its value.
+func (f Field) IsJSONLookupKey() bool {
+	return f.lookupKey
+}
+
+// JSONTextDialects returns the dialects in which a JSON field is stored in a
+// column with a non-JSON type (e.g. LONGTEXT in MySQL, using SchemaType), and
+// its generated predicates fall back to matching the text of the document.
+func (f Field) JSONTextDialects() []string {
+	if !f.IsJSON() || f.IsJSONOpaque() {
+		return nil
+	}
+	var dialects []string
+	for d, t := range f.Column().SchemaType {
+		if t := strings.ToLower(t); t != "json" && t != "jsonb" {
+			dialects = append(dialects, d)
+		}
+	}
+	sort.Strings(dialects)
+	return dialects
+}
+
+// IsJSONAppendable returns true if the field is a JSON array field, and values
+// can be appended to the array stored in the database (using Append<Field>).
+// Compressed fields cannot be modified by the database, and are not appendable.
+func (f Field) IsJSONAppendable() bool {
+	return f.IsJSONArray() && !f.IsJSONOpaque()
+}
+
+// IsJSONRemovable returns true if the field is an appendable JSON array field
+// with elements of a basic Go type, and values can be removed from the array
+// stored in the database (using Remove<Field>).
+func (f Field) IsJSONRemovable() bool {
+	return f.IsJSONAppendable() && f.IsJSONBasicArray()
+}
+
+// IsJSONMergeable returns true if the field is encoded as a JSON object, and
+// patches can be merged into the object stored in the database (using
+// Merge<Field>). Compressed fields are not mergeable.
+func (f Field) IsJSONMergeable() bool {
+	return f.IsJSONObject() && !f.IsJSONOpaque()
+}
+
+// IsJSONPatchable returns true if the field is a json.RawMessage field that is stored
+// as is, and JSON patches (RFC 6902) can be applied on its stored value (using
+// Patch<Field>). Fields with custom encoding (e.g. compressed) are not patchable.
+func (f Field) IsJSONPatchable() bool {
+	if !f.IsJSON() || f.Type.RType == nil || f.Type.RType.Name != "RawMessage" || f.Type.RType.PkgPath != "encoding/json" {
+		return false
+	}
+	return !f.IsJSONOpaque() && !f.IsJSONValueScanner() && !f.Marshaler && !f.Unmarshaler && !f.ScanCoerce
+}
+
+// IsJSONObject returns true if the field is a JSON field that is encoded as a
+// JSON object. i.e. a Go struct, a map, or a json.RawMessage.
+func (f Field) IsJSONObject() bool {
+	if !f.IsJSON() || f.Type.RType == nil {
+		return false
+	}
+	switch rt := f.Type.RType; {
+	case rt.Kind == reflect.Struct, rt.Kind == reflect.Map:
+		return true
+	default:
+		return rt.Name == "RawMessage" && rt.PkgPath == "encoding/json"
+	}
+}
+
+// IsJSONURL returns true if the field is a JSON field that
+// holds a url.URL or a pointer to it (e.g. *url.URL).
+func (f Field) IsJSONURL() bool {
+	if !f.IsJSON() || f.Type.RType == nil {
+		return false
+	}
+	return f.Type.RType.Name == "URL" && f.Type.RType.PkgPath == "net/url"
+}
+
+// IsJSONNullablePtr returns true if the field is a JSON field with a pointer to a
+// slice or a map Go type (e.g. *[]int). For these fields, SQL NULL values are scanned
+// as nil pointers, and JSON null values are scanned as pointers to nil values.
+func (f Field) IsJSONNullablePtr() bool {
+	if !f.IsJSON() || f.Type.RType == nil || !strings.HasPrefix(f.Type.Ident, "*") {
+		return false
+	}
+	k := f.Type.RType.Kind
+	return k == reflect.Slice || k == reflect.Map
+}
+
+// TSVectorColumn returns the generated tsvector column of the full-text
+// index of the field (defined using entsql.TSVector), or an empty string
+// if it has no such index.
+func (f Field) TSVectorColumn() string {
+	return f.tsColumn
+}
+
+// JSONNilClears returns true if the Set<Field> method of the mutation clears the field
+// (stored as SQL NULL) for nil values, instead of storing them as a JSON null literal.
+// By default, it applies to optional arrays, byte slices and pointers to slices or maps, and it can
+// be configured for other optional fields using the EmitNull option of the field.
+func (f Field) JSONNilClears() bool {
+	if !f.IsJSON() || !f.Optional {
+		return false
+	}
+	if f.def != nil && f.def.EmitNull != nil {
+		return !*f.def.EmitNull
+	}
+	return f.IsJSONArray() || f.IsJSONBytes() || f.IsJSONNullablePtr()
+}
+
+// JSONNilEmpty returns true if nil values of the field are stored as an empty
+// JSON array or object, i.e. required slices and maps with EmitNull(false).
+func (f Field) JSONNilEmpty() bool {
+	if !f.IsJSON() || f.Optional || f.def == nil || f.def.EmitNull == nil || *f.def.EmitNull || f.Type.RType == nil || strings.HasPrefix(f.Type.Ident, "*") {
+		return false
+	}
+	k := f.Type.RType.Kind
+	return k == reflect.Slice || k == reflect.Map
+}
+
+// JSONPaths returns the struct fields of a struct-typed JSON field, that are used
+// for generating the "<Field>Path<StructField>" constants of its JSON keys.
+func (f Field) JSONPaths() []*field.RStructField {
+	if !f.IsJSON() || f.Type.RType == nil || f.Type.RType.Kind != reflect.Struct || f.IsJSONValueScanner() || f.IsJSONOpaque() {
+		return nil
+	}
+	return f.Type.RType.Fields
+}
+
+// JSONFields returns the struct fields of a JSON field that hold basic Go
+// types (like string or int), and can be used in the generated predicates.
+func (f Field) JSONFields() []*field.RStructField {
+	if !f.IsJSON() || f.Type.RType == nil {
+		return nil
+	}
+	var fields []*field.RStructField
+	for _, sf := range f.Type.RType.Fields {
+		if k := sf.Kind; k == reflect.Bool || k == reflect.String || k >= reflect.Int && k <= reflect.Float64 {
+			fields = append(fields, sf)
+		}
+	}
+	return fields
 }
 
 // ConvertedToBasic indicates if the Go type of the field
@@ -5,8 +5,13 @@
 package gen
 
 import (
+	"database/sql/driver"
+	"math"
+	"reflect"
 	"testing"
 
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/entsql"
 	"github.com/facebook/ent/entc/load"
 	"github.com/facebook/ent/schema/field"
 
@@ -46,6 +51,32 @@ func TestType(t *testing.T) {
 	})
 	require.Error(err, "field foo redeclared")
 
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "foo", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "bar", StorageKey: "foo", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}},
+		},
+	})
+	require.EqualError(err, `field "bar" has the same storage key "foo" as field "foo" in type "T"`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "foo", StorageKey: "meta", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int"}},
+			{Name: "bar", StorageKey: "meta", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}},
+		},
+	})
+	require.EqualError(err, `field "bar" has the same storage key "meta" as field "foo" in type "T"`)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "external_id", StorageKey: "id", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(err, `field "external_id" has the same storage key "id" as field "id" in type "T"`)
+
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
 		Name: "T",
 		Fields: []*load.Field{
@@ -70,6 +101,101 @@ func TestType(t *testing.T) {
 	})
 	require.Error(err, "empty field name")
 
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Type: "jsonb"}}},
+		},
+	})
+	require.Error(err, "entsql type for non-json field")
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Type: "text"}}},
+		},
+	})
+	require.Error(err, "unsupported entsql type")
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Backfill: true}}},
+		},
+	})
+	require.Error(err, "backfill without a default value")
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{DefaultExpr: "'a8m'"}}},
+		},
+	})
+	require.Error(err, "default expression on a non-json field")
+
+	for _, ant := range []entsql.Annotation{{Compress: "zstd"}, {Compress: "gzip", Incremental: true}} {
+		_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+			Name: "T",
+			Fields: []*load.Field{
+				{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}, Annotations: map[string]interface{}{"EntSQL": ant}},
+			},
+		})
+		require.Error(err, "invalid compression")
+	}
+
+	for _, f := range []*load.Field{
+		{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Encrypt: "pii"}}},
+		{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Encrypt: "pii", Type: "jsonb"}}},
+	} {
+		_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "T", Fields: []*load.Field{f}})
+		require.Error(err, "invalid encryption")
+	}
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{SortedKeys: true}}},
+		},
+	})
+	require.Error(err, "sorted keys on a non-json field")
+
+	generated := map[string]interface{}{"EntSQL": entsql.Generated("UPPER(name)")}
+	for _, f := range []*load.Field{
+		{Name: "upper", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: generated},
+		{Name: "upper", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Default: true, Annotations: generated},
+		{Name: "upper", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Validators: 1, Annotations: generated},
+	} {
+		_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "T", Fields: []*load.Field{f}})
+		require.Error(err, "invalid generated field")
+	}
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "upper", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true, Annotations: generated},
+		},
+	})
+	require.NoError(err)
+	require.True(typ.Fields[0].IsGenerated())
+	require.True(typ.Fields[0].Immutable)
+
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "point", Info: field.JSON("point", point{}).Descriptor().Info, ScanCoerce: true},
+		},
+	})
+	require.Error(err, "scan coercion of sql.Scanner fields")
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "ints", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}, ScanCoerce: true},
+		},
+	})
+	require.NoError(err)
+	require.True(typ.Fields[0].ScanCoerce)
+	require.Equal("IntsScanCoerce", typ.Fields[0].ScanCoerceName())
+	require.True(typ.HasMarshalers())
+
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "Type"})
 	require.EqualError(err, "schema lowercase name conflicts with Go keyword \"type\"")
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "Int"})
@@ -136,6 +262,212 @@ func TestType_Receiver(t *testing.T) {
 	}
 }
 
+func TestField_Column(t *testing.T) {
+	f := Field{Name: "doc", Type: &field.TypeInfo{Type: field.TypeJSON}, def: &load.Field{}}
+	require.Nil(t, f.Column().SchemaType)
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Type: "json"}}
+	require.Equal(t, map[string]string{dialect.Postgres: "json"}, f.Column().SchemaType)
+	f.def = &load.Field{SchemaType: map[string]string{dialect.Postgres: "jsonb", dialect.MySQL: "json"}}
+	require.Equal(t, map[string]string{dialect.Postgres: "jsonb", dialect.MySQL: "json"}, f.Column().SchemaType)
+	require.Empty(t, f.Column().Comment)
+	f.def = &load.Field{Comment: "free-form metadata"}
+	require.Equal(t, "free-form metadata", f.Column().Comment)
+	f.Default = true
+	f.def = &load.Field{Default: true, DefaultValue: []interface{}{"/tmp"}}
+	require.Empty(t, f.Column().Backfill)
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Backfill: true}}
+	require.Equal(t, `["/tmp"]`, f.Column().Backfill)
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{DefaultExpr: "'[]'"}}
+	require.Equal(t, "'[]'", f.Column().DefaultExpr)
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Compress: "gzip"}}
+	require.Equal(t, field.TypeBytes, f.Column().Type)
+	require.Equal(t, int64(math.MaxUint32), f.Column().Size)
+}
+
+func TestField_JSONElemType(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}}
+	require.True(t, f.IsJSON())
+	require.Equal(t, "int", f.JSONElemType())
+	f.Type.Ident = "[]*url.URL"
+	require.Equal(t, "*url.URL", f.JSONElemType())
+	f.Type.Ident = "[2]float64"
+	require.Equal(t, "float64", f.JSONElemType())
+	f.Type.Ident = "map[string]int"
+	require.Empty(t, f.JSONElemType())
+	f.Type.Ident = "[]uint8"
+	require.True(t, f.IsJSONBytes())
+	require.False(t, f.IsJSONArray(), "byte slices are not JSON arrays")
+	require.Empty(t, f.JSONElemType())
+	f.Type.Ident = "[]byte"
+	require.False(t, f.IsJSONArray())
+	require.Empty(t, f.JSONElemType())
+	f.Type = &field.TypeInfo{Type: field.TypeString}
+	require.False(t, f.IsJSON())
+	require.Empty(t, f.JSONElemType())
+}
+
+func TestField_JSONMapValueType(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]string"}}
+	require.True(t, f.IsJSONMap())
+	require.Equal(t, "string", f.JSONMapValueType())
+	f.Type.Ident = "map[string]interface {}"
+	require.True(t, f.IsJSONMap())
+	require.Empty(t, f.JSONMapValueType())
+	f.Type.Ident = "[]string"
+	require.False(t, f.IsJSONMap())
+	require.Empty(t, f.JSONMapValueType())
+}
+
+func TestField_IsJSONBasicArray(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}}
+	require.True(t, f.IsJSONBasicArray())
+	f.Type.Ident = "[]float64"
+	require.True(t, f.IsJSONBasicArray())
+	f.Type.Ident = "[]time.Time"
+	require.False(t, f.IsJSONBasicArray())
+	f.Type.Ident = "[]*url.URL"
+	require.False(t, f.IsJSONBasicArray())
+	f.Type.Ident = "map[string]int"
+	require.False(t, f.IsJSONBasicArray())
+}
+
+func TestField_IsJSONNullablePtr(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "*[]int", RType: &field.RType{Kind: reflect.Slice}}}
+	require.True(t, f.IsJSONNullablePtr())
+	require.False(t, f.IsJSONArray())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "*map[string]int", RType: &field.RType{Kind: reflect.Map}}
+	require.True(t, f.IsJSONNullablePtr())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", RType: &field.RType{Kind: reflect.Slice}}
+	require.False(t, f.IsJSONNullablePtr())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "*url.URL", RType: &field.RType{Name: "URL", Kind: reflect.Struct, PkgPath: "net/url"}}
+	require.False(t, f.IsJSONNullablePtr())
+}
+
+func TestField_JSONNilPolicy(t *testing.T) {
+	emit, omit := true, false
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", RType: &field.RType{Kind: reflect.Slice}}, Optional: true, def: &load.Field{}}
+	require.True(t, f.JSONNilClears())
+	require.False(t, f.JSONNilEmpty())
+	f.def.EmitNull = &emit
+	require.False(t, f.JSONNilClears())
+	f.def.EmitNull = &omit
+	require.True(t, f.JSONNilClears())
+	f.Optional = false
+	require.False(t, f.JSONNilClears())
+	require.True(t, f.JSONNilEmpty())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}
+	require.True(t, f.JSONNilEmpty())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "*[]int", RType: &field.RType{Kind: reflect.Slice}}
+	require.False(t, f.JSONNilEmpty())
+	f.Optional, f.def.EmitNull = true, nil
+	require.True(t, f.JSONNilClears())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}
+	require.False(t, f.JSONNilClears())
+}
+
+func TestField_IsJSONValueScanner(t *testing.T) {
+	f := Field{Type: field.JSON("point", point{}).Descriptor().Info}
+	require.True(t, f.IsJSONValueScanner())
+	require.Equal(t, "gen.point", f.NullType())
+	require.Empty(t, f.JSONPaths())
+	f.Annotations = map[string]interface{}{"EntSQL": &entsql.Annotation{ForceJSON: true}}
+	require.False(t, f.IsJSONValueScanner())
+	require.Equal(t, "[]byte", f.NullType())
+	f = Field{Type: field.JSON("point", point{}).Descriptor().Info, Marshaler: true}
+	require.False(t, f.IsJSONValueScanner())
+	f = Field{Type: field.JSON("points", []point{}).Descriptor().Info}
+	require.False(t, f.IsJSONValueScanner())
+	require.Equal(t, "[]byte", f.NullType())
+}
+
+func TestField_IsJSONSortedKeys(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage"}}
+	require.False(t, f.IsJSONSortedKeys())
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{SortedKeys: true}}
+	require.True(t, f.IsJSONSortedKeys())
+	f.Type = &field.TypeInfo{Type: field.TypeString}
+	require.False(t, f.IsJSONSortedKeys())
+}
+
+func TestField_IsJSONPatchable(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage", RType: &field.RType{Name: "RawMessage", PkgPath: "encoding/json", Kind: reflect.Slice}}}
+	require.True(t, f.IsJSONPatchable())
+	f.Marshaler = true
+	require.False(t, f.IsJSONPatchable())
+	f.Marshaler = false
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Compress: "gzip"}}
+	require.False(t, f.IsJSONPatchable())
+	f = Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}}
+	require.False(t, f.IsJSONPatchable())
+}
+
+func TestField_JSONCompression(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", RType: &field.RType{Kind: reflect.Slice}}}
+	require.Empty(t, f.JSONCompression())
+	require.True(t, f.IsJSONAppendable())
+	require.True(t, f.IsJSONRemovable())
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Compress: "gzip"}}
+	require.Equal(t, "gzip", f.JSONCompression())
+	require.False(t, f.IsJSONAppendable())
+	require.False(t, f.IsJSONRemovable())
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}
+	require.True(t, f.IsJSONObject())
+	require.False(t, f.IsJSONMergeable())
+}
+
+func TestField_JSONEncryptKey(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", RType: &field.RType{Kind: reflect.Slice}}, def: &load.Field{}}
+	require.Empty(t, f.JSONEncryptKey())
+	require.False(t, f.IsJSONOpaque())
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Encrypt: "pii"}}
+	require.Equal(t, "pii", f.JSONEncryptKey())
+	require.True(t, f.IsJSONOpaque())
+	require.False(t, f.IsJSONAppendable())
+	require.Equal(t, field.TypeBytes, f.Column().Type)
+	f.Type = &field.TypeInfo{Type: field.TypeString}
+	require.Empty(t, f.JSONEncryptKey())
+}
+
+func TestField_JSONTextDialects(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}, def: &load.Field{}}
+	require.Empty(t, f.JSONTextDialects())
+	f.def.SchemaType = map[string]string{dialect.Postgres: "JSONB", dialect.MySQL: "json"}
+	require.Empty(t, f.JSONTextDialects())
+	f.def.SchemaType = map[string]string{dialect.SQLite: "text", dialect.MySQL: "LONGTEXT"}
+	require.Equal(t, []string{dialect.MySQL, dialect.SQLite}, f.JSONTextDialects())
+	f.Annotations = map[string]interface{}{"EntSQL": entsql.Annotation{Type: "text"}}
+	require.Equal(t, []string{dialect.MySQL, dialect.Postgres, dialect.SQLite}, f.JSONTextDialects())
+	f.Type.Type = field.TypeString
+	require.Empty(t, f.JSONTextDialects())
+}
+
+// point is a JSON type that implements the driver.Valuer and the sql.Scanner interfaces.
+type point [2]float64
+
+func (point) Value() (driver.Value, error) { return "[0,0]", nil }
+func (*point) Scan(interface{}) error      { return nil }
+
+func TestField_JSONSchema(t *testing.T) {
+	f := Field{Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int"}}
+	require.JSONEq(t, `{"type": "array", "items": {"type": "integer"}}`, string(f.JSONSchema()))
+	f.Type.Ident = "[]string"
+	require.JSONEq(t, `{"type": "array", "items": {"type": "string"}}`, string(f.JSONSchema()))
+	f.Type.Ident = "[]time.Time"
+	require.JSONEq(t, `{"type": "array", "items": {}}`, string(f.JSONSchema()))
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]float64", RType: &field.RType{Kind: reflect.Map}}
+	require.JSONEq(t, `{"type": "object", "additionalProperties": {"type": "number"}}`, string(f.JSONSchema()))
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "*url.URL", RType: &field.RType{Name: "URL", Kind: reflect.Struct, PkgPath: "net/url"}}
+	require.JSONEq(t, `{"type": "object"}`, string(f.JSONSchema()))
+	f.Type = &field.TypeInfo{Type: field.TypeJSON, Ident: "json.RawMessage", RType: &field.RType{Name: "RawMessage", Kind: reflect.Slice, PkgPath: "encoding/json"}}
+	require.JSONEq(t, `{}`, string(f.JSONSchema()))
+
+	// Annotations override the derived schema.
+	f.Annotations = map[string]interface{}{"JSONSchema": map[string]interface{}{"schema": map[string]interface{}{"type": "string", "format": "uri"}}}
+	require.JSONEq(t, `{"type": "string", "format": "uri"}`, string(f.JSONSchema()))
+	f = Field{Type: &field.TypeInfo{Type: field.TypeString}}
+	require.Nil(t, f.JSONSchema())
+}
+
 func TestField_EnumName(t *testing.T) {
 	tests := []struct {
 		name string
@@ -206,6 +538,10 @@ func TestType_AddIndex(t *testing.T) {
 		Fields: []*load.Field{
 			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
 			{Name: "text", Info: &field.TypeInfo{Type: field.TypeString}, Size: &size},
+			{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON}},
+			{Name: "doc_a", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "meta", Info: &field.TypeInfo{Type: field.TypeJSON}},
+			{Name: "meta_hash", Info: &field.TypeInfo{Type: field.TypeString}},
 		},
 	})
 	require.NoError(t, err)
@@ -238,6 +574,71 @@ func TestType_AddIndex(t *testing.T) {
 
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"owner"}})
 	require.NoError(t, err, "valid index on M2O relation and field")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: map[string]interface{}{"EntSQLIndexes": entsql.IndexType("GIN")}})
+	require.NoError(t, err, "valid index with type")
+	require.Equal(t, "GIN", typ.Indexes[len(typ.Indexes)-1].Type)
+
+	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name", "doc"}})
+	require.NoError(t, err, "valid unique index on json field")
+	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"meta"}})
+	require.Error(t, err, "generated hash column conflicts with field")
+
+	jsonIndex := func(path string, fields ...string) *load.Index {
+		return &load.Index{Fields: fields, Annotations: map[string]interface{}{"EntSQLIndexes": entsql.IndexAnnotation{JSONPath: path}}}
+	}
+	err = typ.AddIndex(jsonIndex("$.a", "doc", "name"))
+	require.Error(t, err, "json index on multiple fields")
+	err = typ.AddIndex(jsonIndex("$.a", "name"))
+	require.Error(t, err, "json index on non-json field")
+	err = typ.AddIndex(jsonIndex("a", "doc"))
+	require.Error(t, err, "invalid json path")
+	err = typ.AddIndex(jsonIndex("$.a", "doc"))
+	require.Error(t, err, "generated column conflicts with field")
+	err = typ.AddIndex(jsonIndex("$.b.Scheme[0]", "doc"))
+	require.NoError(t, err, "valid json index")
+	idx := typ.Indexes[len(typ.Indexes)-1]
+	require.Equal(t, "doc_b_scheme_0", idx.JSONColumn)
+	require.Equal(t, "user_doc_b_scheme_0", idx.Name)
+
+	tsIndex := func(fields ...string) *load.Index {
+		return &load.Index{Fields: fields, Annotations: map[string]interface{}{"EntSQLIndexes": entsql.IndexAnnotation{Type: "GIN", TSVector: true}}}
+	}
+	err = typ.AddIndex(tsIndex("doc", "meta"))
+	require.Error(t, err, "tsvector index on multiple fields")
+	err = typ.AddIndex(tsIndex("name"))
+	require.Error(t, err, "tsvector index on non-json field")
+	err = typ.AddIndex(tsIndex("doc"))
+	require.NoError(t, err, "valid tsvector index")
+	idx = typ.Indexes[len(typ.Indexes)-1]
+	require.Equal(t, "doc_tsv", idx.TSColumn)
+	require.Equal(t, "user_doc_tsv", idx.Name)
+	require.Equal(t, "doc_tsv", typ.fields["doc"].TSVectorColumn())
+	err = typ.AddIndex(tsIndex("doc"))
+	require.Error(t, err, "field with multiple tsvector indexes")
+}
+
+func TestType_JSONLookupKey(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "ext", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "schema.ExternalID", RType: &field.RType{Kind: reflect.Struct}}},
+			{Name: "ids", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]int", RType: &field.RType{Kind: reflect.Slice}}},
+			{Name: "doc", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]int", RType: &field.RType{Kind: reflect.Map}}, Annotations: map[string]interface{}{"EntSQL": entsql.Annotation{Compress: "gzip"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"ext"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name", "ids"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"doc"}}))
+	for _, f := range typ.Fields {
+		require.False(t, f.IsJSONLookupKey(), "non-unique, multi-field or compressed index on %q", f.Name)
+	}
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"ext"}}))
+	require.True(t, typ.fields["ext"].IsJSONLookupKey())
+	require.True(t, typ.fields["ext"].IsJSONSortedKeys(), "lookup keys are stored with sorted keys")
+	require.False(t, typ.fields["ids"].IsJSONLookupKey())
 }
 
 func TestField_Constant(t *testing.T) {
@@ -109,6 +109,13 @@ func (c *Client) Debug() *Client {
 	return client
 }
 
+// SupportsJSON reports if the database that the client is connected to supports
+// the JSON type and functions that are used by the JSON predicates. For example,
+// MySQL supports them from version 5.7.8, and SQLite requires the JSON1 extension.
+func (c *Client) SupportsJSON(ctx context.Context) (bool, error) {
+	return migrate.SupportsJSON(ctx, c.driver)
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -203,6 +210,44 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*User, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", user.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
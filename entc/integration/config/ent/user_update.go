@@ -105,7 +105,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	err = uu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -198,7 +202,10 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 	u = &User{config: uuo.config}
 	_spec.Assign = u.assignValues
 	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	err = uuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, uuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -213,13 +213,17 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
 	nodes := make([]*Blob, len(bcb.builders))
 	mutators := make([]Mutator, len(bcb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range bcb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range bcb.builders {
 		func(i int, root context.Context) {
 			builder := bcb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*BlobMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -33,6 +35,8 @@ type BlobQuery struct {
 	withParent *BlobQuery
 	withLinks  *BlobQuery
 	withFKs    bool
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -209,6 +213,64 @@ func (bq *BlobQuery) AllX(ctx context.Context) []*Blob {
 	return bs
 }
 
+// Stream executes the query and calls fn for each Blob, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (bq *BlobQuery) Stream(ctx context.Context, fn func(*Blob) error) error {
+	if bq.withParent != nil || bq.withLinks != nil {
+		return errors.New("ent: BlobQuery.Stream does not support eager-loading of edges")
+	}
+	if err := bq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return bq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (bq *BlobQuery) StreamX(ctx context.Context, fn func(*Blob) error) {
+	if err := bq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Blobs to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Blob is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (bq *BlobQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := bq.Stream(ctx, func(node *Blob) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (bq *BlobQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := bq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Blob ids.
 func (bq *BlobQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	var ids []uuid.UUID
@@ -492,6 +554,73 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 	return nodes, nil
 }
 
+func (bq *BlobQuery) sqlStream(ctx context.Context, fn func(*Blob) error) error {
+	var (
+		withFKs = bq.withFKs
+		_spec   = bq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.ForeignKeys...)
+	}
+	var node *Blob
+	_spec.ScanValues = func() []interface{} {
+		node = &Blob{config: bq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, bq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (bq *BlobQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *BlobSelect {
+	selector := &BlobSelect{config: bq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Blob.Query().Where(blob.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (bq *BlobQuery) ForUpdate() *BlobQuery {
+	bq.forUpdate = true
+	return bq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Blob.Query().Omit(blob.FieldUUID).AllX(ctx)
+//
+func (bq *BlobQuery) Omit(fields ...string) *BlobQuery {
+	bq.omit = append(bq.omit, fields...)
+	return bq
+}
+
 func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
 	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
@@ -515,8 +644,10 @@ func (bq *BlobQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: blob.FieldID,
 			},
 		},
-		From:   bq.sql,
-		Unique: true,
+		From:      bq.sql,
+		Unique:    true,
+		ForUpdate: bq.forUpdate,
+		Omit:      bq.omit,
 	}
 	if ps := bq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -563,6 +694,9 @@ func (bq *BlobQuery) sqlQuery() *sql.Selector {
 	if limit := bq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if bq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -811,6 +945,7 @@ func (bgb *BlobGroupBy) sqlQuery() *sql.Selector {
 type BlobSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -1033,6 +1168,10 @@ func (bs *BlobSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (bs *BlobSelect) sqlQuery() sql.Querier {
 	selector := bs.sql
-	selector.Select(selector.Columns(bs.fields...)...)
+	columns := selector.Columns(bs.fields...)
+	for _, fn := range bs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
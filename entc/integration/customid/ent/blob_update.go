@@ -248,7 +248,11 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+	err = bu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blob.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -483,7 +487,10 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (b *Blob, err error) {
 	b = &Blob{config: buo.config}
 	_spec.Assign = b.assignValues
 	_spec.ScanValues = b.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+	err = buo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, buo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blob.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -238,13 +238,17 @@ func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Car, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range ccb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*CarMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
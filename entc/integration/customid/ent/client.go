@@ -135,6 +135,13 @@ func (c *Client) Debug() *Client {
 	return client
 }
 
+// SupportsJSON reports if the database that the client is connected to supports
+// the JSON type and functions that are used by the JSON predicates. For example,
+// MySQL supports them from version 5.7.8, and SQLite requires the JSON1 extension.
+func (c *Client) SupportsJSON(ctx context.Context) (bool, error) {
+	return migrate.SupportsJSON(ctx, c.driver)
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -233,6 +240,44 @@ func (c *BlobClient) GetX(ctx context.Context, id uuid.UUID) *Blob {
 	return b
 }
 
+// GetMany returns the Blob entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *BlobClient) GetMany(ctx context.Context, ids ...uuid.UUID) ([]*Blob, error) {
+	nodes, err := c.Query().Where(blob.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*Blob, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []uuid.UUID
+		ordered = make([]*Blob, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", blob.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *BlobClient) GetManyX(ctx context.Context, ids ...uuid.UUID) []*Blob {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryParent queries the parent edge of a Blob.
 func (c *BlobClient) QueryParent(b *Blob) *BlobQuery {
 	query := &BlobQuery{config: c.config}
@@ -353,6 +398,44 @@ func (c *CarClient) GetX(ctx context.Context, id int) *Car {
 	return ca
 }
 
+// GetMany returns the Car entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *CarClient) GetMany(ctx context.Context, ids ...int) ([]*Car, error) {
+	nodes, err := c.Query().Where(car.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Car, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*Car, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", car.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CarClient) GetManyX(ctx context.Context, ids ...int) []*Car {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryOwner queries the owner edge of a Car.
 func (c *CarClient) QueryOwner(ca *Car) *PetQuery {
 	query := &PetQuery{config: c.config}
@@ -457,6 +540,44 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	return gr
 }
 
+// GetMany returns the Group entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *GroupClient) GetMany(ctx context.Context, ids ...int) ([]*Group, error) {
+	nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Group, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*Group, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", group.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupClient) GetManyX(ctx context.Context, ids ...int) []*Group {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryUsers queries the users edge of a Group.
 func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -561,6 +682,44 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 	return pe
 }
 
+// GetMany returns the Pet entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *PetClient) GetMany(ctx context.Context, ids ...string) ([]*Pet, error) {
+	nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Pet, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Pet, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", pet.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PetClient) GetManyX(ctx context.Context, ids ...string) []*Pet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryOwner queries the owner edge of a Pet.
 func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -713,6 +872,44 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*User, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", user.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryGroups queries the groups edge of a User.
 func (c *UserClient) QueryGroups(u *User) *GroupQuery {
 	query := &GroupQuery{config: c.config}
@@ -7,6 +7,9 @@
 package ent
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/facebook/ent"
 	"github.com/facebook/ent/dialect"
 )
@@ -24,6 +27,19 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// prettyJSON enables indented JSON in the String
+	// method of entities.
+	prettyJSON bool
+	// jsonMarshal and jsonUnmarshal are used for encoding and
+	// decoding the values of JSON fields. nil means encoding/json.
+	jsonMarshal   func(interface{}) ([]byte, error)
+	jsonUnmarshal func([]byte, interface{}) error
+	// jsonMaxDepth is the maximum nesting depth of the JSON
+	// documents that are decoded. Zero means unlimited.
+	jsonMaxDepth int
+	// maxRetries is the number of times that update
+	// statements are retried when SQLite is busy.
+	maxRetries int
 }
 
 // hooks per client, for fast access.
@@ -59,6 +75,111 @@ func Log(fn func(...interface{})) Option {
 	}
 }
 
+// PrettyJSON enables indented JSON output for the JSON fields in the String
+// method of the entities that are returned by the client. It is disabled by
+// default, and it is intended to be used for debugging.
+func PrettyJSON() Option {
+	return func(c *config) {
+		c.prettyJSON = true
+	}
+}
+
+// formatJSON returns the string representation of a JSON field
+// for the String method. If pretty is true, the value is formatted
+// as an indented JSON. Values that cannot be encoded use the default
+// format.
+func formatJSON(pretty bool, v interface{}) string {
+	if pretty {
+		if buf, err := json.MarshalIndent(v, "", "  "); err == nil {
+			return string(buf)
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// JSONCodec configures the functions that are used for encoding the values of JSON fields
+// before they are stored in the database, and for decoding them when they are loaded. It can
+// be used for replacing the encoding/json package (the default) with a faster implementation
+// that is compatible with it. For example:
+//
+//	var codec = jsoniter.ConfigCompatibleWithStandardLibrary
+//	client := ent.NewClient(ent.Driver(drv), ent.JSONCodec(codec.Marshal, codec.Unmarshal))
+//
+// Fields with a custom Marshaler or Unmarshaler keep using them, and values that are passed to
+// the database functions of JSON fields (e.g. in predicates, or when appending values) are still
+// encoded using encoding/json.
+func JSONCodec(marshal func(interface{}) ([]byte, error), unmarshal func([]byte, interface{}) error) Option {
+	return func(c *config) {
+		c.jsonMarshal = marshal
+		c.jsonUnmarshal = unmarshal
+	}
+}
+
+// unmarshalJSON decodes the value of a JSON field using
+// the codec of the client, or encoding/json if not set.
+func (c config) unmarshalJSON(data []byte, v interface{}) error {
+	if c.jsonUnmarshal != nil {
+		return c.jsonUnmarshal(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// JSONMaxDepth limits the nesting depth of the JSON documents that are decoded when entities (or
+// field values) are loaded from the database, and it can be used for protecting the application from
+// documents with a pathological nesting that were stored by untrusted sources. Documents that exceed
+// the given depth are rejected with an error, before they are decoded. For example:
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.JSONMaxDepth(32))
+//
+// The depth of a document is the number of its nested objects and arrays. i.e. the depth of `1` is 0,
+// and the depth of `{"a": [1]}` is 2. Zero (the default) means that the depth is not limited.
+func JSONMaxDepth(n int) Option {
+	return func(c *config) {
+		c.jsonMaxDepth = n
+	}
+}
+
+// checkJSONDepth returns an error if the nesting depth of the given JSON document exceeds
+// the maximum depth of the client. Invalid documents are reported by their decoder.
+func (c config) checkJSONDepth(data []byte) error {
+	if c.jsonMaxDepth <= 0 {
+		return nil
+	}
+	var depth int
+	for i, str := 0, false; i < len(data); i++ {
+		switch b := data[i]; {
+		case str && b == '\\':
+			i++
+		case b == '"':
+			str = !str
+		case str:
+		case b == '{', b == '[':
+			if depth++; depth > c.jsonMaxDepth {
+				return fmt.Errorf("ent: json document exceeds the maximum depth of %d", c.jsonMaxDepth)
+			}
+		case b == '}', b == ']':
+			depth--
+		}
+	}
+	return nil
+}
+
+// MaxRetries sets the number of times that the update builders retry their transaction
+// when it failed because the SQLite database was locked by another connection (SQLITE_BUSY
+// or SQLITE_LOCKED). Retries are disabled by default, and they are not used for builders
+// that run inside a transaction, as the transaction cannot be restarted by the builder.
+// The option is ignored by the other dialects. For example:
+//
+//	client := ent.NewClient(ent.Driver(drv), ent.MaxRetries(5))
+//
+// Note that the JSON updates of the builders (e.g. Append<Field> and Merge<Field>) are applied
+// in one UPDATE statement, and therefore, retrying them is safe.
+func MaxRetries(n int) Option {
+	return func(c *config) {
+		c.maxRetries = n
+	}
+}
+
 // Driver configures the client driver.
 func Driver(driver dialect.Driver) Option {
 	return func(c *config) {
@@ -175,7 +175,11 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+	err = gu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -337,7 +341,10 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 	gr = &Group{config: guo.config}
 	_spec.Assign = gr.assignValues
 	_spec.ScanValues = gr.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+	err = guo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, guo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
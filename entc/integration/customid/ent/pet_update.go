@@ -364,7 +364,11 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+	err = pu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -714,7 +718,10 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 	pe = &Pet{config: puo.config}
 	_spec.Assign = pe.assignValues
 	_spec.ScanValues = pe.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+	err = puo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, puo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -285,7 +285,11 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+	err = cu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -556,7 +560,10 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 	c = &Card{config: cuo.config}
 	_spec.Assign = c.assignValues
 	_spec.ScanValues = c.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+	err = cuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, cuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -27,6 +29,8 @@ type CommentQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Comment
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -167,6 +171,61 @@ func (cq *CommentQuery) AllX(ctx context.Context) []*Comment {
 	return cs
 }
 
+// Stream executes the query and calls fn for each Comment, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (cq *CommentQuery) Stream(ctx context.Context, fn func(*Comment) error) error {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return cq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (cq *CommentQuery) StreamX(ctx context.Context, fn func(*Comment) error) {
+	if err := cq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Comments to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Comment is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (cq *CommentQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := cq.Stream(ctx, func(node *Comment) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (cq *CommentQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := cq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Comment ids.
 func (cq *CommentQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -324,6 +383,66 @@ func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 	return nodes, nil
 }
 
+func (cq *CommentQuery) sqlStream(ctx context.Context, fn func(*Comment) error) error {
+	var (
+		_spec = cq.querySpec()
+	)
+	var node *Comment
+	_spec.ScanValues = func() []interface{} {
+		node = &Comment{config: cq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, cq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (cq *CommentQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *CommentSelect {
+	selector := &CommentSelect{config: cq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Comment.Query().Where(comment.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (cq *CommentQuery) ForUpdate() *CommentQuery {
+	cq.forUpdate = true
+	return cq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Comment.Query().Omit(comment.FieldUniqueInt).AllX(ctx)
+//
+func (cq *CommentQuery) Omit(fields ...string) *CommentQuery {
+	cq.omit = append(cq.omit, fields...)
+	return cq
+}
+
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
@@ -347,8 +466,10 @@ func (cq *CommentQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: comment.FieldID,
 			},
 		},
-		From:   cq.sql,
-		Unique: true,
+		From:      cq.sql,
+		Unique:    true,
+		ForUpdate: cq.forUpdate,
+		Omit:      cq.omit,
 	}
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -395,6 +516,9 @@ func (cq *CommentQuery) sqlQuery() *sql.Selector {
 	if limit := cq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if cq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -643,6 +767,7 @@ func (cgb *CommentGroupBy) sqlQuery() *sql.Selector {
 type CommentSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -865,6 +990,10 @@ func (cs *CommentSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (cs *CommentSelect) sqlQuery() sql.Querier {
 	selector := cs.sql
-	selector.Select(selector.Columns(cs.fields...)...)
+	columns := selector.Columns(cs.fields...)
+	for _, fn := range cs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
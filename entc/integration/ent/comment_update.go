@@ -206,7 +206,11 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: comment.FieldNillableInt,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+	err = cu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -400,7 +404,10 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error
 	c = &Comment{config: cuo.config}
 	_spec.Assign = c.assignValues
 	_spec.ScanValues = c.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+	err = cuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, cuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -28,6 +30,8 @@ type FieldTypeQuery struct {
 	unique     []string
 	predicates []predicate.FieldType
 	withFKs    bool
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -168,6 +172,61 @@ func (ftq *FieldTypeQuery) AllX(ctx context.Context) []*FieldType {
 	return fts
 }
 
+// Stream executes the query and calls fn for each FieldType, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (ftq *FieldTypeQuery) Stream(ctx context.Context, fn func(*FieldType) error) error {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return ftq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (ftq *FieldTypeQuery) StreamX(ctx context.Context, fn func(*FieldType) error) {
+	if err := ftq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the FieldTypes to w as a JSON array, while the
+// rows are read from the database (see Stream). Each FieldType is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (ftq *FieldTypeQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := ftq.Stream(ctx, func(node *FieldType) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (ftq *FieldTypeQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := ftq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of FieldType ids.
 func (ftq *FieldTypeQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -332,6 +391,73 @@ func (ftq *FieldTypeQuery) sqlAll(ctx context.Context) ([]*FieldType, error) {
 	return nodes, nil
 }
 
+func (ftq *FieldTypeQuery) sqlStream(ctx context.Context, fn func(*FieldType) error) error {
+	var (
+		withFKs = ftq.withFKs
+		_spec   = ftq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, fieldtype.ForeignKeys...)
+	}
+	var node *FieldType
+	_spec.ScanValues = func() []interface{} {
+		node = &FieldType{config: ftq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, ftq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (ftq *FieldTypeQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *FieldTypeSelect {
+	selector := &FieldTypeSelect{config: ftq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.FieldType.Query().Where(fieldtype.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (ftq *FieldTypeQuery) ForUpdate() *FieldTypeQuery {
+	ftq.forUpdate = true
+	return ftq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.FieldType.Query().Omit(fieldtype.FieldInt).AllX(ctx)
+//
+func (ftq *FieldTypeQuery) Omit(fields ...string) *FieldTypeQuery {
+	ftq.omit = append(ftq.omit, fields...)
+	return ftq
+}
+
 func (ftq *FieldTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ftq.querySpec()
 	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
@@ -355,8 +481,10 @@ func (ftq *FieldTypeQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: fieldtype.FieldID,
 			},
 		},
-		From:   ftq.sql,
-		Unique: true,
+		From:      ftq.sql,
+		Unique:    true,
+		ForUpdate: ftq.forUpdate,
+		Omit:      ftq.omit,
 	}
 	if ps := ftq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -403,6 +531,9 @@ func (ftq *FieldTypeQuery) sqlQuery() *sql.Selector {
 	if limit := ftq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if ftq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -651,6 +782,7 @@ func (ftgb *FieldTypeGroupBy) sqlQuery() *sql.Selector {
 type FieldTypeSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -873,6 +1005,10 @@ func (fts *FieldTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (fts *FieldTypeSelect) sqlQuery() sql.Querier {
 	selector := fts.sql
-	selector.Select(selector.Columns(fts.fields...)...)
+	columns := selector.Columns(fts.fields...)
+	for _, fn := range fts.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
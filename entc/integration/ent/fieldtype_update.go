@@ -1844,7 +1844,11 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldRole,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
+	err = ftu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -3671,7 +3675,10 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 	ft = &FieldType{config: ftuo.config}
 	_spec.Assign = ft.assignValues
 	_spec.ScanValues = ft.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
+	err = ftuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, ftuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -36,6 +38,8 @@ type FileQuery struct {
 	withType  *FileTypeQuery
 	withField *FieldTypeQuery
 	withFKs   bool
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -230,6 +234,64 @@ func (fq *FileQuery) AllX(ctx context.Context) []*File {
 	return fs
 }
 
+// Stream executes the query and calls fn for each File, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (fq *FileQuery) Stream(ctx context.Context, fn func(*File) error) error {
+	if fq.withOwner != nil || fq.withType != nil || fq.withField != nil {
+		return errors.New("ent: FileQuery.Stream does not support eager-loading of edges")
+	}
+	if err := fq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return fq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (fq *FileQuery) StreamX(ctx context.Context, fn func(*File) error) {
+	if err := fq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Files to w as a JSON array, while the
+// rows are read from the database (see Stream). Each File is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (fq *FileQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := fq.Stream(ctx, func(node *File) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (fq *FileQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := fq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of File ids.
 func (fq *FileQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -515,6 +577,73 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	return nodes, nil
 }
 
+func (fq *FileQuery) sqlStream(ctx context.Context, fn func(*File) error) error {
+	var (
+		withFKs = fq.withFKs
+		_spec   = fq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, file.ForeignKeys...)
+	}
+	var node *File
+	_spec.ScanValues = func() []interface{} {
+		node = &File{config: fq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, fq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (fq *FileQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *FileSelect {
+	selector := &FileSelect{config: fq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.File.Query().Where(file.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (fq *FileQuery) ForUpdate() *FileQuery {
+	fq.forUpdate = true
+	return fq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.File.Query().Omit(file.FieldSize).AllX(ctx)
+//
+func (fq *FileQuery) Omit(fields ...string) *FileQuery {
+	fq.omit = append(fq.omit, fields...)
+	return fq
+}
+
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
 	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
@@ -538,8 +667,10 @@ func (fq *FileQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: file.FieldID,
 			},
 		},
-		From:   fq.sql,
-		Unique: true,
+		From:      fq.sql,
+		Unique:    true,
+		ForUpdate: fq.forUpdate,
+		Omit:      fq.omit,
 	}
 	if ps := fq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -586,6 +717,9 @@ func (fq *FileQuery) sqlQuery() *sql.Selector {
 	if limit := fq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if fq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -834,6 +968,7 @@ func (fgb *FileGroupBy) sqlQuery() *sql.Selector {
 type FileSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -1056,6 +1191,10 @@ func (fs *FileSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (fs *FileSelect) sqlQuery() sql.Querier {
 	selector := fs.sql
-	selector.Select(selector.Columns(fs.fields...)...)
+	columns := selector.Columns(fs.fields...)
+	for _, fn := range fs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
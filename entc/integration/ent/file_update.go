@@ -416,7 +416,11 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+	err = fu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -817,7 +821,10 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 	f = &File{config: fuo.config}
 	_spec.Assign = f.assignValues
 	_spec.ScanValues = f.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+	err = fuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, fuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
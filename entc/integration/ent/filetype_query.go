@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -31,6 +33,8 @@ type FileTypeQuery struct {
 	predicates []predicate.FileType
 	// eager-loading edges.
 	withFiles *FileQuery
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (ftq *FileTypeQuery) AllX(ctx context.Context) []*FileType {
 	return fts
 }
 
+// Stream executes the query and calls fn for each FileType, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (ftq *FileTypeQuery) Stream(ctx context.Context, fn func(*FileType) error) error {
+	if ftq.withFiles != nil {
+		return errors.New("ent: FileTypeQuery.Stream does not support eager-loading of edges")
+	}
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return ftq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (ftq *FileTypeQuery) StreamX(ctx context.Context, fn func(*FileType) error) {
+	if err := ftq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the FileTypes to w as a JSON array, while the
+// rows are read from the database (see Stream). Each FileType is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (ftq *FileTypeQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := ftq.Stream(ctx, func(node *FileType) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (ftq *FileTypeQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := ftq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of FileType ids.
 func (ftq *FileTypeQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -390,6 +452,66 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 	return nodes, nil
 }
 
+func (ftq *FileTypeQuery) sqlStream(ctx context.Context, fn func(*FileType) error) error {
+	var (
+		_spec = ftq.querySpec()
+	)
+	var node *FileType
+	_spec.ScanValues = func() []interface{} {
+		node = &FileType{config: ftq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, ftq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (ftq *FileTypeQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *FileTypeSelect {
+	selector := &FileTypeSelect{config: ftq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.FileType.Query().Where(filetype.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (ftq *FileTypeQuery) ForUpdate() *FileTypeQuery {
+	ftq.forUpdate = true
+	return ftq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.FileType.Query().Omit(filetype.FieldName).AllX(ctx)
+//
+func (ftq *FileTypeQuery) Omit(fields ...string) *FileTypeQuery {
+	ftq.omit = append(ftq.omit, fields...)
+	return ftq
+}
+
 func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ftq.querySpec()
 	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
@@ -413,8 +535,10 @@ func (ftq *FileTypeQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: filetype.FieldID,
 			},
 		},
-		From:   ftq.sql,
-		Unique: true,
+		From:      ftq.sql,
+		Unique:    true,
+		ForUpdate: ftq.forUpdate,
+		Omit:      ftq.omit,
 	}
 	if ps := ftq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -461,6 +585,9 @@ func (ftq *FileTypeQuery) sqlQuery() *sql.Selector {
 	if limit := ftq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if ftq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -709,6 +836,7 @@ func (ftgb *FileTypeGroupBy) sqlQuery() *sql.Selector {
 type FileTypeSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -931,6 +1059,10 @@ func (fts *FileTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (fts *FileTypeSelect) sqlQuery() sql.Querier {
 	selector := fts.sql
-	selector.Select(selector.Columns(fts.fields...)...)
+	columns := selector.Columns(fts.fields...)
+	for _, fn := range fts.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
@@ -240,7 +240,11 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
+	err = ftu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filetype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -467,7 +471,10 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 	ft = &FileType{config: ftuo.config}
 	_spec.Assign = ft.assignValues
 	_spec.ScanValues = ft.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
+	err = ftuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, ftuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filetype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -189,13 +189,17 @@ func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error)
 	specs := make([]*sqlgraph.CreateSpec, len(gicb.builders))
 	nodes := make([]*GroupInfo, len(gicb.builders))
 	mutators := make([]Mutator, len(gicb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range gicb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range gicb.builders {
 		func(i int, root context.Context) {
 			builder := gicb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*GroupInfoMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
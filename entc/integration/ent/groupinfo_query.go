@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -31,6 +33,8 @@ type GroupInfoQuery struct {
 	predicates []predicate.GroupInfo
 	// eager-loading edges.
 	withGroups *GroupQuery
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (giq *GroupInfoQuery) AllX(ctx context.Context) []*GroupInfo {
 	return gis
 }
 
+// Stream executes the query and calls fn for each GroupInfo, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (giq *GroupInfoQuery) Stream(ctx context.Context, fn func(*GroupInfo) error) error {
+	if giq.withGroups != nil {
+		return errors.New("ent: GroupInfoQuery.Stream does not support eager-loading of edges")
+	}
+	if err := giq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return giq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (giq *GroupInfoQuery) StreamX(ctx context.Context, fn func(*GroupInfo) error) {
+	if err := giq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the GroupInfos to w as a JSON array, while the
+// rows are read from the database (see Stream). Each GroupInfo is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (giq *GroupInfoQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := giq.Stream(ctx, func(node *GroupInfo) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (giq *GroupInfoQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := giq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of GroupInfo ids.
 func (giq *GroupInfoQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -390,6 +452,66 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 	return nodes, nil
 }
 
+func (giq *GroupInfoQuery) sqlStream(ctx context.Context, fn func(*GroupInfo) error) error {
+	var (
+		_spec = giq.querySpec()
+	)
+	var node *GroupInfo
+	_spec.ScanValues = func() []interface{} {
+		node = &GroupInfo{config: giq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, giq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (giq *GroupInfoQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *GroupInfoSelect {
+	selector := &GroupInfoSelect{config: giq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := giq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return giq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.GroupInfo.Query().Where(groupinfo.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (giq *GroupInfoQuery) ForUpdate() *GroupInfoQuery {
+	giq.forUpdate = true
+	return giq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.GroupInfo.Query().Omit(groupinfo.FieldDesc).AllX(ctx)
+//
+func (giq *GroupInfoQuery) Omit(fields ...string) *GroupInfoQuery {
+	giq.omit = append(giq.omit, fields...)
+	return giq
+}
+
 func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := giq.querySpec()
 	return sqlgraph.CountNodes(ctx, giq.driver, _spec)
@@ -413,8 +535,10 @@ func (giq *GroupInfoQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: groupinfo.FieldID,
 			},
 		},
-		From:   giq.sql,
-		Unique: true,
+		From:      giq.sql,
+		Unique:    true,
+		ForUpdate: giq.forUpdate,
+		Omit:      giq.omit,
 	}
 	if ps := giq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -461,6 +585,9 @@ func (giq *GroupInfoQuery) sqlQuery() *sql.Selector {
 	if limit := giq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if giq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -709,6 +836,7 @@ func (gigb *GroupInfoGroupBy) sqlQuery() *sql.Selector {
 type GroupInfoSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -931,6 +1059,10 @@ func (gis *GroupInfoSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (gis *GroupInfoSelect) sqlQuery() sql.Querier {
 	selector := gis.sql
-	selector.Select(selector.Columns(gis.fields...)...)
+	columns := selector.Columns(gis.fields...)
+	for _, fn := range gis.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
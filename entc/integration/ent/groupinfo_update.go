@@ -223,7 +223,11 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, giu.driver, _spec); err != nil {
+	err = giu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, giu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{groupinfo.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -433,7 +437,10 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 	gi = &GroupInfo{config: giuo.config}
 	_spec.Assign = gi.assignValues
 	_spec.ScanValues = gi.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, giuo.driver, _spec); err != nil {
+	err = giuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, giuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{groupinfo.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
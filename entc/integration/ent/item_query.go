@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -27,6 +29,8 @@ type ItemQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Item
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -167,6 +171,61 @@ func (iq *ItemQuery) AllX(ctx context.Context) []*Item {
 	return is
 }
 
+// Stream executes the query and calls fn for each Item, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (iq *ItemQuery) Stream(ctx context.Context, fn func(*Item) error) error {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return iq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (iq *ItemQuery) StreamX(ctx context.Context, fn func(*Item) error) {
+	if err := iq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Items to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Item is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (iq *ItemQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := iq.Stream(ctx, func(node *Item) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (iq *ItemQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := iq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Item ids.
 func (iq *ItemQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -300,6 +359,63 @@ func (iq *ItemQuery) sqlAll(ctx context.Context) ([]*Item, error) {
 	return nodes, nil
 }
 
+func (iq *ItemQuery) sqlStream(ctx context.Context, fn func(*Item) error) error {
+	var (
+		_spec = iq.querySpec()
+	)
+	var node *Item
+	_spec.ScanValues = func() []interface{} {
+		node = &Item{config: iq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, iq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (iq *ItemQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *ItemSelect {
+	selector := &ItemSelect{config: iq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Item.Query().Where(item.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (iq *ItemQuery) ForUpdate() *ItemQuery {
+	iq.forUpdate = true
+	return iq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+func (iq *ItemQuery) Omit(fields ...string) *ItemQuery {
+	iq.omit = append(iq.omit, fields...)
+	return iq
+}
+
 func (iq *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
 	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
@@ -323,8 +439,10 @@ func (iq *ItemQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: item.FieldID,
 			},
 		},
-		From:   iq.sql,
-		Unique: true,
+		From:      iq.sql,
+		Unique:    true,
+		ForUpdate: iq.forUpdate,
+		Omit:      iq.omit,
 	}
 	if ps := iq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -371,6 +489,9 @@ func (iq *ItemQuery) sqlQuery() *sql.Selector {
 	if limit := iq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if iq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -619,6 +740,7 @@ func (igb *ItemGroupBy) sqlQuery() *sql.Selector {
 type ItemSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -841,6 +963,10 @@ func (is *ItemSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (is *ItemSelect) sqlQuery() sql.Querier {
 	selector := is.sql
-	selector.Select(selector.Columns(is.fields...)...)
+	columns := selector.Columns(is.fields...)
+	for _, fn := range is.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
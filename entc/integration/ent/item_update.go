@@ -105,7 +105,11 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+	err = iu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -198,7 +202,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
 	i = &Item{config: iuo.config}
 	_spec.Assign = i.assignValues
 	_spec.ScanValues = i.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+	err = iuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, iuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -273,7 +273,11 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+	err = nu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{node.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -534,7 +538,10 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 	n = &Node{config: nuo.config}
 	_spec.Assign = n.assignValues
 	_spec.ScanValues = n.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+	err = nuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, nuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{node.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -32,6 +34,8 @@ type PetQuery struct {
 	withTeam  *UserQuery
 	withOwner *UserQuery
 	withFKs   bool
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -208,6 +212,64 @@ func (pq *PetQuery) AllX(ctx context.Context) []*Pet {
 	return pes
 }
 
+// Stream executes the query and calls fn for each Pet, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (pq *PetQuery) Stream(ctx context.Context, fn func(*Pet) error) error {
+	if pq.withTeam != nil || pq.withOwner != nil {
+		return errors.New("ent: PetQuery.Stream does not support eager-loading of edges")
+	}
+	if err := pq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return pq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (pq *PetQuery) StreamX(ctx context.Context, fn func(*Pet) error) {
+	if err := pq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Pets to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Pet is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (pq *PetQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := pq.Stream(ctx, func(node *Pet) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (pq *PetQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := pq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Pet ids.
 func (pq *PetQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -453,6 +515,73 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	return nodes, nil
 }
 
+func (pq *PetQuery) sqlStream(ctx context.Context, fn func(*Pet) error) error {
+	var (
+		withFKs = pq.withFKs
+		_spec   = pq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.ForeignKeys...)
+	}
+	var node *Pet
+	_spec.ScanValues = func() []interface{} {
+		node = &Pet{config: pq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, pq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (pq *PetQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *PetSelect {
+	selector := &PetSelect{config: pq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Pet.Query().Where(pet.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (pq *PetQuery) ForUpdate() *PetQuery {
+	pq.forUpdate = true
+	return pq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Pet.Query().Omit(pet.FieldName).AllX(ctx)
+//
+func (pq *PetQuery) Omit(fields ...string) *PetQuery {
+	pq.omit = append(pq.omit, fields...)
+	return pq
+}
+
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
@@ -476,8 +605,10 @@ func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: pet.FieldID,
 			},
 		},
-		From:   pq.sql,
-		Unique: true,
+		From:      pq.sql,
+		Unique:    true,
+		ForUpdate: pq.forUpdate,
+		Omit:      pq.omit,
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -524,6 +655,9 @@ func (pq *PetQuery) sqlQuery() *sql.Selector {
 	if limit := pq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if pq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -772,6 +906,7 @@ func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
 type PetSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -994,6 +1129,10 @@ func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ps *PetSelect) sqlQuery() sql.Querier {
 	selector := ps.sql
-	selector.Select(selector.Columns(ps.fields...)...)
+	columns := selector.Columns(ps.fields...)
+	for _, fn := range ps.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -30,7 +32,9 @@ type SpecQuery struct {
 	unique     []string
 	predicates []predicate.Spec
 	// eager-loading edges.
-	withCard *CardQuery
+	withCard  *CardQuery
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (sq *SpecQuery) AllX(ctx context.Context) []*Spec {
 	return sSlice
 }
 
+// Stream executes the query and calls fn for each Spec, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (sq *SpecQuery) Stream(ctx context.Context, fn func(*Spec) error) error {
+	if sq.withCard != nil {
+		return errors.New("ent: SpecQuery.Stream does not support eager-loading of edges")
+	}
+	if err := sq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return sq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (sq *SpecQuery) StreamX(ctx context.Context, fn func(*Spec) error) {
+	if err := sq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Specs to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Spec is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (sq *SpecQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := sq.Stream(ctx, func(node *Spec) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (sq *SpecQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := sq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Spec ids.
 func (sq *SpecQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -401,6 +463,63 @@ func (sq *SpecQuery) sqlAll(ctx context.Context) ([]*Spec, error) {
 	return nodes, nil
 }
 
+func (sq *SpecQuery) sqlStream(ctx context.Context, fn func(*Spec) error) error {
+	var (
+		_spec = sq.querySpec()
+	)
+	var node *Spec
+	_spec.ScanValues = func() []interface{} {
+		node = &Spec{config: sq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, sq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (sq *SpecQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *SpecSelect {
+	selector := &SpecSelect{config: sq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Spec.Query().Where(spec.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (sq *SpecQuery) ForUpdate() *SpecQuery {
+	sq.forUpdate = true
+	return sq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+func (sq *SpecQuery) Omit(fields ...string) *SpecQuery {
+	sq.omit = append(sq.omit, fields...)
+	return sq
+}
+
 func (sq *SpecQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
 	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
@@ -424,8 +543,10 @@ func (sq *SpecQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: spec.FieldID,
 			},
 		},
-		From:   sq.sql,
-		Unique: true,
+		From:      sq.sql,
+		Unique:    true,
+		ForUpdate: sq.forUpdate,
+		Omit:      sq.omit,
 	}
 	if ps := sq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -472,6 +593,9 @@ func (sq *SpecQuery) sqlQuery() *sql.Selector {
 	if limit := sq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if sq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -720,6 +844,7 @@ func (sgb *SpecGroupBy) sqlQuery() *sql.Selector {
 type SpecSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -942,6 +1067,10 @@ func (ss *SpecSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ss *SpecSelect) sqlQuery() sql.Querier {
 	selector := ss.sql
-	selector.Select(selector.Columns(ss.fields...)...)
+	columns := selector.Columns(ss.fields...)
+	for _, fn := range ss.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
@@ -175,7 +175,11 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+	err = su.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{spec.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -337,7 +341,10 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (s *Spec, err error) {
 	s = &Spec{config: suo.config}
 	_spec.Assign = s.assignValues
 	_spec.ScanValues = s.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+	err = suo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, suo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{spec.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -27,6 +29,8 @@ type TaskQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Task
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -167,6 +171,61 @@ func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
 	return ts
 }
 
+// Stream executes the query and calls fn for each Task, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (tq *TaskQuery) Stream(ctx context.Context, fn func(*Task) error) error {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return tq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (tq *TaskQuery) StreamX(ctx context.Context, fn func(*Task) error) {
+	if err := tq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Tasks to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Task is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (tq *TaskQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := tq.Stream(ctx, func(node *Task) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (tq *TaskQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := tq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Task ids.
 func (tq *TaskQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -324,6 +383,66 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	return nodes, nil
 }
 
+func (tq *TaskQuery) sqlStream(ctx context.Context, fn func(*Task) error) error {
+	var (
+		_spec = tq.querySpec()
+	)
+	var node *Task
+	_spec.ScanValues = func() []interface{} {
+		node = &Task{config: tq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, tq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (tq *TaskQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *TaskSelect {
+	selector := &TaskSelect{config: tq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Task.Query().Where(task.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (tq *TaskQuery) ForUpdate() *TaskQuery {
+	tq.forUpdate = true
+	return tq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Task.Query().Omit(task.FieldPriority).AllX(ctx)
+//
+func (tq *TaskQuery) Omit(fields ...string) *TaskQuery {
+	tq.omit = append(tq.omit, fields...)
+	return tq
+}
+
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
@@ -347,8 +466,10 @@ func (tq *TaskQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: task.FieldID,
 			},
 		},
-		From:   tq.sql,
-		Unique: true,
+		From:      tq.sql,
+		Unique:    true,
+		ForUpdate: tq.forUpdate,
+		Omit:      tq.omit,
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -395,6 +516,9 @@ func (tq *TaskQuery) sqlQuery() *sql.Selector {
 	if limit := tq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if tq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -643,6 +767,7 @@ func (tgb *TaskGroupBy) sqlQuery() *sql.Selector {
 type TaskSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -865,6 +990,10 @@ func (ts *TaskSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ts *TaskSelect) sqlQuery() sql.Querier {
 	selector := ts.sql
-	selector.Select(selector.Columns(ts.fields...)...)
+	columns := selector.Columns(ts.fields...)
+	for _, fn := range ts.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
@@ -146,7 +146,11 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: task.FieldPriority,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+	err = tu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -279,7 +283,10 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (t *Task, err error) {
 	t = &Task{config: tuo.config}
 	_spec.Assign = t.assignValues
 	_spec.ScanValues = t.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+	err = tuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, tuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{task.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
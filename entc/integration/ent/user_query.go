@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -45,6 +47,8 @@ type UserQuery struct {
 	withChildren  *UserQuery
 	withParent    *UserQuery
 	withFKs       bool
+	forUpdate     bool
+	omit          []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +387,64 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 	return us
 }
 
+// Stream executes the query and calls fn for each User, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (uq *UserQuery) Stream(ctx context.Context, fn func(*User) error) error {
+	if uq.withCard != nil || uq.withPets != nil || uq.withFiles != nil || uq.withGroups != nil || uq.withFriends != nil || uq.withFollowers != nil || uq.withFollowing != nil || uq.withTeam != nil || uq.withSpouse != nil || uq.withChildren != nil || uq.withParent != nil {
+		return errors.New("ent: UserQuery.Stream does not support eager-loading of edges")
+	}
+	if err := uq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return uq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (uq *UserQuery) StreamX(ctx context.Context, fn func(*User) error) {
+	if err := uq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Users to w as a JSON array, while the
+// rows are read from the database (see Stream). Each User is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (uq *UserQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := uq.Stream(ctx, func(node *User) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (uq *UserQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := uq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of User ids.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -1128,6 +1190,73 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	return nodes, nil
 }
 
+func (uq *UserQuery) sqlStream(ctx context.Context, fn func(*User) error) error {
+	var (
+		withFKs = uq.withFKs
+		_spec   = uq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
+	}
+	var node *User
+	_spec.ScanValues = func() []interface{} {
+		node = &User{config: uq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, uq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (uq *UserQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *UserSelect {
+	selector := &UserSelect{config: uq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return uq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.User.Query().Where(user.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (uq *UserQuery) ForUpdate() *UserQuery {
+	uq.forUpdate = true
+	return uq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.User.Query().Omit(user.FieldOptionalInt).AllX(ctx)
+//
+func (uq *UserQuery) Omit(fields ...string) *UserQuery {
+	uq.omit = append(uq.omit, fields...)
+	return uq
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
 	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
@@ -1151,8 +1280,10 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: user.FieldID,
 			},
 		},
-		From:   uq.sql,
-		Unique: true,
+		From:      uq.sql,
+		Unique:    true,
+		ForUpdate: uq.forUpdate,
+		Omit:      uq.omit,
 	}
 	if ps := uq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -1199,6 +1330,9 @@ func (uq *UserQuery) sqlQuery() *sql.Selector {
 	if limit := uq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if uq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -1447,6 +1581,7 @@ func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
 type UserSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -1669,6 +1804,10 @@ func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (us *UserSelect) sqlQuery() sql.Querier {
 	selector := us.sql
-	selector.Select(selector.Columns(us.fields...)...)
+	columns := selector.Columns(us.fields...)
+	for _, fn := range us.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
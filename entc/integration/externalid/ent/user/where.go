@@ -0,0 +1,357 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/externalid/ent/predicate"
+	"github.com/facebook/ent/entc/integration/externalid/ent/schema"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// ExternalIDEQ applies the EQ predicate on the whole JSON document of the "external_id" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func ExternalIDEQ(v schema.ExternalID) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err == nil {
+			b, err = sql.SortKeys(nil)(json.RawMessage(b))
+		}
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldExternalID), b))
+	})
+}
+
+// ByExternalID returns a predicate for looking up the entity by its "external_id" field, that
+// has a unique index. Like ExternalIDEQ, the formatting and the order of object keys are ignored.
+func ByExternalID(v schema.ExternalID) predicate.User {
+	return ExternalIDEQ(v)
+}
+
+// ExternalIDKeyCountEQ applies the EQ predicate on the number of top-level keys of the "external_id" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func ExternalIDKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldExternalID), n))
+	})
+}
+
+// ExternalIDKeyCountGT applies the GT predicate on the number of top-level keys of the "external_id" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func ExternalIDKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldExternalID), n))
+	})
+}
+
+// ExternalIDKeyCountLT applies the LT predicate on the number of top-level keys of the "external_id" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func ExternalIDKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldExternalID), n))
+	})
+}
+
+// ExternalIDIsEmptyObject applies the IsEmptyObject predicate on the "external_id" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func ExternalIDIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldExternalID)))
+	})
+}
+
+// ExternalIDHasKey applies the HasKey predicate on the "external_id" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func ExternalIDHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldExternalID), key))
+	})
+}
+
+// ExternalIDValueEQ applies the EQ predicate on the "external_id" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func ExternalIDValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldExternalID), key, v))
+	})
+}
+
+// ExternalIDProviderEQ applies the EQ predicate on the "provider" key of the "external_id" field.
+func ExternalIDProviderEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldExternalID), v, "provider"))
+	})
+}
+
+// ExternalIDIDEQ applies the EQ predicate on the "id" key of the "external_id" field.
+func ExternalIDIDEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldExternalID), v, "id"))
+	})
+}
+
+// ExternalIDBoolValue applies the EQ predicate on the boolean stored in the given key of the "external_id" field.
+// Unlike ExternalIDValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func ExternalIDBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldExternalID), v, key))
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
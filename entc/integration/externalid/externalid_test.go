@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package externalid
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/externalid/ent"
+	"github.com/facebook/ent/entc/integration/externalid/ent/schema"
+	"github.com/facebook/ent/entc/integration/externalid/ent/user"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// Unique indexes on JSON columns are created on generated columns in
+// MySQL, that were added in 5.7.8, and therefore, 5.6 is not tested.
+
+func TestMySQL(t *testing.T) {
+	for version, port := range map[string]int{"57": 3307, "8": 3308} {
+		t.Run(version, func(t *testing.T) {
+			db, err := sql.Open(dialect.MySQL, fmt.Sprintf("root:pass@tcp(localhost:%d)/", port))
+			require.NoError(t, err)
+			defer db.Close()
+			ctx := context.Background()
+			err = db.Exec(ctx, "CREATE DATABASE IF NOT EXISTS externalid", []interface{}{}, nil)
+			require.NoError(t, err, "creating database")
+			defer db.Exec(ctx, "DROP DATABASE IF EXISTS externalid", []interface{}{}, nil)
+			drv, err := sql.Open(dialect.MySQL, fmt.Sprintf("root:pass@tcp(localhost:%d)/externalid", port))
+			require.NoError(t, err, "connecting to externalid database")
+			client := ent.NewClient(ent.Driver(drv))
+			defer client.Close()
+			ExternalID(t, client, drv)
+		})
+	}
+}
+
+func TestPostgres(t *testing.T) {
+	for version, port := range map[string]int{"10": 5430, "11": 5431, "12": 5433} {
+		t.Run(version, func(t *testing.T) {
+			dsn := fmt.Sprintf("host=localhost port=%d user=postgres password=pass sslmode=disable", port)
+			db, err := sql.Open(dialect.Postgres, dsn)
+			require.NoError(t, err)
+			defer db.Close()
+			ctx := context.Background()
+			err = db.Exec(ctx, "CREATE DATABASE externalid", []interface{}{}, nil)
+			require.NoError(t, err, "creating database")
+			defer db.Exec(ctx, "DROP DATABASE IF EXISTS externalid", []interface{}{}, nil)
+			drv, err := sql.Open(dialect.Postgres, dsn+" dbname=externalid")
+			require.NoError(t, err, "connecting to externalid database")
+			client := ent.NewClient(ent.Driver(drv))
+			defer client.Close()
+			ExternalID(t, client, drv)
+		})
+	}
+}
+
+func TestSQLite(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ExternalID(t, client, drv)
+}
+
+func ExternalID(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	gh := schema.ExternalID{Provider: "github", ID: "a8m"}
+	a8m := client.User.Create().SetName("a8m").SetExternalID(gh).SaveX(ctx)
+	client.User.Create().SetName("nati").SetExternalID(schema.ExternalID{Provider: "gitlab", ID: "a8m"}).SaveX(ctx)
+	require.Equal(t, a8m.ID, client.User.Query().Where(user.ByExternalID(gh)).OnlyIDX(ctx))
+	require.False(t, client.User.Query().Where(user.ByExternalID(schema.ExternalID{Provider: "github", ID: "nati"})).ExistX(ctx))
+
+	// Values are unique by their content.
+	_, err := client.User.Create().SetExternalID(gh).Save(ctx)
+	require.True(t, ent.IsConstraintError(err), "duplicate external id")
+
+	// Rows that were written outside ent (with a different order of keys) are found as well.
+	query, args := sql.Dialect(drv.Dialect()).
+		Insert(user.Table).
+		Columns(user.FieldName, user.FieldExternalID).
+		Values("ariel", `{"id": "ariel", "provider": "github"}`).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	ariel := client.User.Query().Where(user.ByExternalID(schema.ExternalID{Provider: "github", ID: "ariel"})).OnlyX(ctx)
+	require.Equal(t, "ariel", ariel.Name)
+}
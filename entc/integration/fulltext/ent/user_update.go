@@ -0,0 +1,407 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/entc/integration/fulltext/ent/predicate"
+	"github.com/facebook/ent/entc/integration/fulltext/ent/user"
+	"github.com/facebook/ent/schema/field"
+)
+
+// UserUpdate is the builder for updating User entities.
+type UserUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder.
+func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
+	uu.predicates = append(uu.predicates, ps...)
+	return uu
+}
+
+// SetName sets the name field.
+func (uu *UserUpdate) SetName(s string) *UserUpdate {
+	uu.mutation.SetName(s)
+	return uu
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (uu *UserUpdate) SetNillableName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetName(*s)
+	}
+	return uu
+}
+
+// ClearName clears the value of name.
+func (uu *UserUpdate) ClearName() *UserUpdate {
+	uu.mutation.ClearName()
+	return uu
+}
+
+// SetRaw sets the raw field.
+func (uu *UserUpdate) SetRaw(jm json.RawMessage) *UserUpdate {
+	uu.mutation.SetRaw(jm)
+	return uu
+}
+
+// MergeRaw applies the given JSON merge-patch on the raw field.
+func (uu *UserUpdate) MergeRaw(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeRaw(patch)
+	return uu
+}
+
+// PatchRaw applies the given JSON patch (RFC 6902) operations on the raw field.
+func (uu *UserUpdate) PatchRaw(ops []sql.JSONPatchOp) *UserUpdate {
+	uu.mutation.PatchRaw(ops)
+	return uu
+}
+
+// ClearRaw clears the value of raw.
+func (uu *UserUpdate) ClearRaw() *UserUpdate {
+	uu.mutation.ClearRaw()
+	return uu
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return uu.mutation
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := uu.mutation.MergedRaw(); ok {
+		if _, set := uu.mutation.Raw(); set || uu.mutation.RawCleared() {
+			return 0, errors.New("ent: field \"raw\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.PatchedRaw(); ok {
+		if _, set := uu.mutation.Raw(); set || uu.mutation.RawCleared() {
+			return 0, errors.New("ent: field \"raw\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(uu.hooks) == 0 {
+		affected, err = uu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uu.mutation = mutation
+			affected, err = uu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(uu.hooks) - 1; i >= 0; i-- {
+			mut = uu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: user.FieldID,
+			},
+		},
+	}
+	if ps := uu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldName,
+		})
+	}
+	if uu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldName,
+		})
+	}
+	if value, ok := uu.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldRaw,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedRaw(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldRaw, p)
+			}
+		})
+	}
+	if ops, ok := uu.mutation.PatchedRaw(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
+			Column: user.FieldRaw,
+			Ops:    ops,
+		})
+	}
+	if uu.mutation.RawCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldRaw,
+		})
+	}
+	err = uu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec)
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetName sets the name field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// ClearName clears the value of name.
+func (uuo *UserUpdateOne) ClearName() *UserUpdateOne {
+	uuo.mutation.ClearName()
+	return uuo
+}
+
+// SetRaw sets the raw field.
+func (uuo *UserUpdateOne) SetRaw(jm json.RawMessage) *UserUpdateOne {
+	uuo.mutation.SetRaw(jm)
+	return uuo
+}
+
+// MergeRaw applies the given JSON merge-patch on the raw field.
+func (uuo *UserUpdateOne) MergeRaw(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeRaw(patch)
+	return uuo
+}
+
+// PatchRaw applies the given JSON patch (RFC 6902) operations on the raw field.
+func (uuo *UserUpdateOne) PatchRaw(ops []sql.JSONPatchOp) *UserUpdateOne {
+	uuo.mutation.PatchRaw(ops)
+	return uuo
+}
+
+// ClearRaw clears the value of raw.
+func (uuo *UserUpdateOne) ClearRaw() *UserUpdateOne {
+	uuo.mutation.ClearRaw()
+	return uuo
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// Save executes the query and returns the updated entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if _, ok := uuo.mutation.MergedRaw(); ok {
+		if _, set := uuo.mutation.Raw(); set || uuo.mutation.RawCleared() {
+			return nil, errors.New("ent: field \"raw\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.PatchedRaw(); ok {
+		if _, set := uuo.mutation.Raw(); set || uuo.mutation.RawCleared() {
+			return nil, errors.New("ent: field \"raw\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
+	var (
+		err  error
+		node *User
+	)
+	if len(uuo.hooks) == 0 {
+		node, err = uuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uuo.mutation = mutation
+			node, err = uuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uuo.hooks) - 1; i >= 0; i-- {
+			mut = uuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	u, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: user.FieldID,
+			},
+		},
+	}
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing User.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldName,
+		})
+	}
+	if uuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldName,
+		})
+	}
+	if value, ok := uuo.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldRaw,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedRaw(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldRaw, p)
+			}
+		})
+	}
+	if ops, ok := uuo.mutation.PatchedRaw(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
+			Column: user.FieldRaw,
+			Ops:    ops,
+		})
+	}
+	if uuo.mutation.RawCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldRaw,
+		})
+	}
+	u = &User{config: uuo.config}
+	_spec.Assign = u.assignValues
+	_spec.ScanValues = u.scanValues()
+	err = uuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, uuo.driver, _spec)
+	})
+	if err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package fulltext
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/fulltext/ent"
+	"github.com/facebook/ent/entc/integration/fulltext/ent/user"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// Full-text indexes are created on generated columns, that were
+// added in PostgreSQL 12, and therefore, older versions are not tested.
+
+func TestPostgres(t *testing.T) {
+	dsn := "host=localhost port=5433 user=postgres password=pass sslmode=disable"
+	db, err := sql.Open(dialect.Postgres, dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	err = db.Exec(ctx, "CREATE DATABASE fulltext", []interface{}{}, nil)
+	require.NoError(t, err, "creating database")
+	defer db.Exec(ctx, "DROP DATABASE IF EXISTS fulltext", []interface{}{}, nil)
+	drv, err := sql.Open(dialect.Postgres, dsn+" dbname=fulltext")
+	require.NoError(t, err, "connecting to fulltext database")
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	FullText(t, client)
+}
+
+func TestSQLite(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	err = client.Schema.Create(context.Background())
+	require.Error(t, err, "tsvector indexes are supported only by PostgreSQL")
+}
+
+func FullText(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	// Running the migration again should not try to add the tsvector column.
+	require.NoError(t, client.Schema.Create(ctx))
+
+	docs := []string{
+		`{"title": "The quick brown fox", "tags": ["animals", "speed"]}`,
+		`{"title": "Lazy dogs", "body": {"text": "the dog sleeps all day"}}`,
+		`{"title": "Numbers", "values": [1, 2, 3], "quick": true}`,
+	}
+	users := make([]*ent.User, len(docs))
+	for i, doc := range docs {
+		users[i] = client.User.Create().SetRaw(json.RawMessage(doc)).SaveX(ctx)
+	}
+	empty := client.User.Create().SetName("empty").SaveX(ctx)
+
+	// Only string values are indexed, and not the keys of the objects.
+	require.Equal(t, users[0].ID, client.User.Query().Where(user.RawMatches("quick")).OnlyIDX(ctx))
+	// Nested values are indexed as well.
+	require.Equal(t, users[1].ID, client.User.Query().Where(user.RawMatches("sleeps")).OnlyIDX(ctx))
+	require.Equal(t, users[0].ID, client.User.Query().Where(user.RawMatches("animals")).OnlyIDX(ctx))
+	// All words of the term must match.
+	require.Equal(t, users[1].ID, client.User.Query().Where(user.RawMatches("lazy dog")).OnlyIDX(ctx))
+	require.False(t, client.User.Query().Where(user.RawMatches("lazy fox")).ExistX(ctx))
+	require.False(t, client.User.Query().Where(user.RawMatches("1")).ExistX(ctx))
+	require.Equal(t, []int{users[0].ID, users[1].ID}, client.User.Query().Where(user.Or(user.RawMatches("fox"), user.RawMatches("dogs"))).Order(ent.Asc(user.FieldID)).IDsX(ctx))
+	require.False(t, client.User.Query().Where(user.RawMatches("empty")).ExistX(ctx))
+
+	// The tsvector column is updated by the database on changes.
+	client.User.UpdateOne(users[2]).SetRaw(json.RawMessage(`{"title": "a quick update"}`)).ExecX(ctx)
+	require.Equal(t, []int{users[0].ID, users[2].ID}, client.User.Query().Where(user.RawMatches("quick")).Order(ent.Asc(user.FieldID)).IDsX(ctx))
+	client.User.UpdateOne(empty).SetRaw(json.RawMessage(`["empty"]`)).ExecX(ctx)
+	require.Equal(t, empty.ID, client.User.Query().Where(user.RawMatches("empty")).OnlyIDX(ctx))
+}
@@ -0,0 +1,644 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/facebook/ent/entc/integration/generated/ent/user"
+
+	"github.com/facebook/ent"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeUser = "User"
+)
+
+// UserMutation represents an operation that mutate the Users
+// nodes in the graph.
+type UserMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	ints          *[]int
+	appendints    []int
+	removeints    []int
+	name_upper    *string
+	ints_count    *int
+	addints_count *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*User, error)
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows to manage the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for $n.Name.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the id field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old name value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of name.
+func (m *UserMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[user.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the field name was cleared in this mutation.
+func (m *UserMutation) NameCleared() bool {
+	_, ok := m.clearedFields[user.FieldName]
+	return ok
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, user.FieldName)
+}
+
+// SetInts sets the ints field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetInts(i []int) {
+	if i == nil {
+		m.ClearInts()
+		return
+	}
+	delete(m.clearedFields, user.FieldInts)
+	m.ints = &i
+}
+
+// Ints returns the ints value in the mutation.
+func (m *UserMutation) Ints() (r []int, exists bool) {
+	v := m.ints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInts returns the old ints value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldInts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInts: %w", err)
+	}
+	return oldValue.Ints, nil
+}
+
+// AppendInts appends vs to the ints field. Unlike SetInts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendInts(vs ...int) {
+	m.appendints = append(m.appendints, vs...)
+}
+
+// AppendedInts returns the values that were appended to the ints field in this mutation.
+func (m *UserMutation) AppendedInts() ([]int, bool) {
+	if len(m.appendints) == 0 {
+		return nil, false
+	}
+	return m.appendints, true
+}
+
+// RemoveInts removes all occurrences of vs from the ints field. Like AppendInts, the values
+// are removed from the array stored in the database, and it cannot be used with SetInts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveInts(vs ...int) {
+	m.removeints = append(m.removeints, vs...)
+}
+
+// RemovedInts returns the values that were removed from the ints field in this mutation.
+func (m *UserMutation) RemovedInts() ([]int, bool) {
+	if len(m.removeints) == 0 {
+		return nil, false
+	}
+	return m.removeints, true
+}
+
+// ClearInts clears the value of ints.
+func (m *UserMutation) ClearInts() {
+	m.ints = nil
+	m.appendints = nil
+	m.removeints = nil
+	m.clearedFields[user.FieldInts] = struct{}{}
+}
+
+// IntsCleared returns if the field ints was cleared in this mutation.
+func (m *UserMutation) IntsCleared() bool {
+	_, ok := m.clearedFields[user.FieldInts]
+	return ok
+}
+
+// ResetInts reset all changes of the "ints" field.
+func (m *UserMutation) ResetInts() {
+	m.ints = nil
+	m.appendints = nil
+	m.removeints = nil
+	delete(m.clearedFields, user.FieldInts)
+}
+
+// SetNameUpper sets the name_upper field.
+func (m *UserMutation) SetNameUpper(s string) {
+	m.name_upper = &s
+}
+
+// NameUpper returns the name_upper value in the mutation.
+func (m *UserMutation) NameUpper() (r string, exists bool) {
+	v := m.name_upper
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameUpper returns the old name_upper value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldNameUpper(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNameUpper is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNameUpper requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameUpper: %w", err)
+	}
+	return oldValue.NameUpper, nil
+}
+
+// ClearNameUpper clears the value of name_upper.
+func (m *UserMutation) ClearNameUpper() {
+	m.name_upper = nil
+	m.clearedFields[user.FieldNameUpper] = struct{}{}
+}
+
+// NameUpperCleared returns if the field name_upper was cleared in this mutation.
+func (m *UserMutation) NameUpperCleared() bool {
+	_, ok := m.clearedFields[user.FieldNameUpper]
+	return ok
+}
+
+// ResetNameUpper reset all changes of the "name_upper" field.
+func (m *UserMutation) ResetNameUpper() {
+	m.name_upper = nil
+	delete(m.clearedFields, user.FieldNameUpper)
+}
+
+// SetIntsCount sets the ints_count field.
+func (m *UserMutation) SetIntsCount(i int) {
+	m.ints_count = &i
+	m.addints_count = nil
+}
+
+// IntsCount returns the ints_count value in the mutation.
+func (m *UserMutation) IntsCount() (r int, exists bool) {
+	v := m.ints_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntsCount returns the old ints_count value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldIntsCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIntsCount is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIntsCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntsCount: %w", err)
+	}
+	return oldValue.IntsCount, nil
+}
+
+// AddIntsCount adds i to ints_count.
+func (m *UserMutation) AddIntsCount(i int) {
+	if m.addints_count != nil {
+		*m.addints_count += i
+	} else {
+		m.addints_count = &i
+	}
+}
+
+// AddedIntsCount returns the value that was added to the ints_count field in this mutation.
+func (m *UserMutation) AddedIntsCount() (r int, exists bool) {
+	v := m.addints_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntsCount clears the value of ints_count.
+func (m *UserMutation) ClearIntsCount() {
+	m.ints_count = nil
+	m.addints_count = nil
+	m.clearedFields[user.FieldIntsCount] = struct{}{}
+}
+
+// IntsCountCleared returns if the field ints_count was cleared in this mutation.
+func (m *UserMutation) IntsCountCleared() bool {
+	_, ok := m.clearedFields[user.FieldIntsCount]
+	return ok
+}
+
+// ResetIntsCount reset all changes of the "ints_count" field.
+func (m *UserMutation) ResetIntsCount() {
+	m.ints_count = nil
+	m.addints_count = nil
+	delete(m.clearedFields, user.FieldIntsCount)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.ints != nil {
+		fields = append(fields, user.FieldInts)
+	}
+	if m.name_upper != nil {
+		fields = append(fields, user.FieldNameUpper)
+	}
+	if m.ints_count != nil {
+		fields = append(fields, user.FieldIntsCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldName:
+		return m.Name()
+	case user.FieldInts:
+		return m.Ints()
+	case user.FieldNameUpper:
+		return m.NameUpper()
+	case user.FieldIntsCount:
+		return m.IntsCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database.
+// An error is returned if the mutation operation is not UpdateOne,
+// or the query to the database was failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldInts:
+		return m.OldInts(ctx)
+	case user.FieldNameUpper:
+		return m.OldNameUpper(ctx)
+	case user.FieldIntsCount:
+		return m.OldIntsCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldInts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInts(v)
+		return nil
+	case user.FieldNameUpper:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameUpper(v)
+		return nil
+	case user.FieldIntsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntsCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addints_count != nil {
+		fields = append(fields, user.FieldIntsCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldIntsCount:
+		return m.AddedIntsCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldIntsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntsCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldName) {
+		fields = append(fields, user.FieldName)
+	}
+	if m.FieldCleared(user.FieldInts) {
+		fields = append(fields, user.FieldInts)
+	}
+	if m.FieldCleared(user.FieldNameUpper) {
+		fields = append(fields, user.FieldNameUpper)
+	}
+	if m.FieldCleared(user.FieldIntsCount) {
+		fields = append(fields, user.FieldIntsCount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldName:
+		m.ClearName()
+		return nil
+	case user.FieldInts:
+		m.ClearInts()
+		return nil
+	case user.FieldNameUpper:
+		m.ClearNameUpper()
+		return nil
+	case user.FieldIntsCount:
+		m.ClearIntsCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldInts:
+		m.ResetInts()
+		return nil
+	case user.FieldNameUpper:
+		m.ResetNameUpper()
+		return nil
+	case user.FieldIntsCount:
+		m.ResetIntsCount()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
+}
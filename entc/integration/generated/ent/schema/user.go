@@ -0,0 +1,42 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebook/ent"
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/entsql"
+	"github.com/facebook/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Optional(),
+		field.Ints("ints").
+			Optional(),
+		// NameUpper uses the same expression in all dialects.
+		field.String("name_upper").
+			Optional().
+			Annotations(entsql.Generated("UPPER(name)")),
+		// IntsCount is computed from the JSON column using the
+		// JSON functions of each dialect.
+		field.Int("ints_count").
+			Optional().
+			Annotations(entsql.Annotation{
+				Generated: map[string]string{
+					dialect.MySQL:    "JSON_LENGTH(`ints`)",
+					dialect.Postgres: `jsonb_array_length("ints")`,
+					dialect.SQLite:   "json_array_length(`ints`)",
+				},
+			}),
+	}
+}
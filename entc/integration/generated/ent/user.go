@@ -0,0 +1,126 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/generated/ent/user"
+)
+
+// User is the model entity for the User schema.
+type User struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Ints holds the value of the "ints" field.
+	Ints []int `json:"ints,omitempty"`
+	// NameUpper holds the value of the "name_upper" field.
+	NameUpper string `json:"name_upper,omitempty"`
+	// IntsCount holds the value of the "ints_count" field.
+	IntsCount int `json:"ints_count,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*User) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+		&[]byte{},         // ints
+		&sql.NullString{}, // name_upper
+		&sql.NullInt64{},  // ints_count
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the User fields.
+func (u *User) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(user.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	u.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		u.Name = value.String
+	}
+
+	if value, ok := values[1].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field ints", values[1])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field ints: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Ints); err != nil {
+			return fmt.Errorf("unmarshal field ints: %w", err)
+		}
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name_upper", values[2])
+	} else if value.Valid {
+		u.NameUpper = value.String
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field ints_count", values[3])
+	} else if value.Valid {
+		u.IntsCount = int(value.Int64)
+	}
+	return nil
+}
+
+// Update returns a builder for updating this User.
+// Note that, you need to call User.Unwrap() before calling this method, if this User
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *User) Update() *UserUpdateOne {
+	return (&UserClient{config: u.config}).UpdateOne(u)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (u *User) Unwrap() *User {
+	tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: User is not a transactional entity")
+	}
+	u.config.driver = tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *User) String() string {
+	var builder strings.Builder
+	builder.WriteString("User(")
+	builder.WriteString(fmt.Sprintf("id=%v", u.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(u.Name)
+	builder.WriteString(", ints=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Ints))
+	builder.WriteString(", name_upper=")
+	builder.WriteString(u.NameUpper)
+	builder.WriteString(", ints_count=")
+	builder.WriteString(fmt.Sprintf("%v", u.IntsCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Users is a parsable slice of User.
+type Users []*User
+
+func (u Users) config(cfg config) {
+	for _i := range u {
+		u[_i].config = cfg
+	}
+}
@@ -0,0 +1,572 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/generated/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameUpper applies equality check predicate on the "name_upper" field. It's identical to NameUpperEQ.
+func NameUpper(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNameUpper), v))
+	})
+}
+
+// IntsCount applies equality check predicate on the "ints_count" field. It's identical to IntsCountEQ.
+func IntsCount(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntsCount), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// IntsIsNil applies the IsNil predicate on the "ints" field.
+func IntsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldInts)))
+	})
+}
+
+// IntsNotNil applies the NotNil predicate on the "ints" field.
+func IntsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldInts)))
+	})
+}
+
+// NameUpperEQ applies the EQ predicate on the "name_upper" field.
+func NameUpperEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperNEQ applies the NEQ predicate on the "name_upper" field.
+func NameUpperNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperIn applies the In predicate on the "name_upper" field.
+func NameUpperIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNameUpper), v...))
+	})
+}
+
+// NameUpperNotIn applies the NotIn predicate on the "name_upper" field.
+func NameUpperNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNameUpper), v...))
+	})
+}
+
+// NameUpperGT applies the GT predicate on the "name_upper" field.
+func NameUpperGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperGTE applies the GTE predicate on the "name_upper" field.
+func NameUpperGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperLT applies the LT predicate on the "name_upper" field.
+func NameUpperLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperLTE applies the LTE predicate on the "name_upper" field.
+func NameUpperLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperContains applies the Contains predicate on the "name_upper" field.
+func NameUpperContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperHasPrefix applies the HasPrefix predicate on the "name_upper" field.
+func NameUpperHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperHasSuffix applies the HasSuffix predicate on the "name_upper" field.
+func NameUpperHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperIsNil applies the IsNil predicate on the "name_upper" field.
+func NameUpperIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNameUpper)))
+	})
+}
+
+// NameUpperNotNil applies the NotNil predicate on the "name_upper" field.
+func NameUpperNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNameUpper)))
+	})
+}
+
+// NameUpperEqualFold applies the EqualFold predicate on the "name_upper" field.
+func NameUpperEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNameUpper), v))
+	})
+}
+
+// NameUpperContainsFold applies the ContainsFold predicate on the "name_upper" field.
+func NameUpperContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNameUpper), v))
+	})
+}
+
+// IntsCountEQ applies the EQ predicate on the "ints_count" field.
+func IntsCountEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountNEQ applies the NEQ predicate on the "ints_count" field.
+func IntsCountNEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountIn applies the In predicate on the "ints_count" field.
+func IntsCountIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIntsCount), v...))
+	})
+}
+
+// IntsCountNotIn applies the NotIn predicate on the "ints_count" field.
+func IntsCountNotIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIntsCount), v...))
+	})
+}
+
+// IntsCountGT applies the GT predicate on the "ints_count" field.
+func IntsCountGT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountGTE applies the GTE predicate on the "ints_count" field.
+func IntsCountGTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountLT applies the LT predicate on the "ints_count" field.
+func IntsCountLT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountLTE applies the LTE predicate on the "ints_count" field.
+func IntsCountLTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIntsCount), v))
+	})
+}
+
+// IntsCountIsNil applies the IsNil predicate on the "ints_count" field.
+func IntsCountIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIntsCount)))
+	})
+}
+
+// IntsCountNotNil applies the NotNil predicate on the "ints_count" field.
+func IntsCountNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIntsCount)))
+	})
+}
+
+// IntsEQ applies the EQ predicate on the whole JSON document of the "ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func IntsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldInts), b))
+	})
+}
+
+// IntsLenEQ applies the EQ predicate on the length of the "ints" field.
+func IntsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldInts), n))
+	})
+}
+
+// IntsLenGT applies the GT predicate on the length of the "ints" field.
+func IntsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldInts), n))
+	})
+}
+
+// IntsLenLT applies the LT predicate on the length of the "ints" field.
+func IntsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldInts), n))
+	})
+}
+
+// IntsIsEmptyArray applies the IsEmptyArray predicate on the "ints" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func IntsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldInts)))
+	})
+}
+
+// IntsContainsAny applies the predicate that checks that the "ints" field shares at least one element with the given values.
+func IntsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldInts), v...))
+	})
+}
+
+// IntsAny applies the given predicate operator (like sql.GT) on any element of the "ints" field.
+func IntsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldInts), op, v))
+	})
+}
+
+// IntsAll applies the given predicate operator (like sql.GT) on all elements of the "ints" field.
+func IntsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldInts), op, v))
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.User) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/generated/ent"
+	"github.com/facebook/ent/entc/integration/generated/ent/user"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// Generated columns were added in MySQL 5.7.6 and PostgreSQL 12,
+// and therefore, older versions are not tested.
+
+func TestMySQL(t *testing.T) {
+	for version, port := range map[string]int{"57": 3307, "8": 3308} {
+		t.Run(version, func(t *testing.T) {
+			db, err := sql.Open("mysql", fmt.Sprintf("root:pass@tcp(localhost:%d)/", port))
+			require.NoError(t, err)
+			defer db.Close()
+			ctx := context.Background()
+			err = db.Exec(ctx, "CREATE DATABASE IF NOT EXISTS generated", []interface{}{}, nil)
+			require.NoError(t, err, "creating database")
+			defer db.Exec(ctx, "DROP DATABASE IF EXISTS generated", []interface{}{}, nil)
+			drv, err := sql.Open("mysql", fmt.Sprintf("root:pass@tcp(localhost:%d)/generated", port))
+			require.NoError(t, err, "connecting to generated database")
+			Generated(t, ent.NewClient(ent.Driver(drv)))
+		})
+	}
+}
+
+func TestPostgres(t *testing.T) {
+	dsn := "host=localhost port=5433 user=postgres password=pass sslmode=disable"
+	db, err := sql.Open(dialect.Postgres, dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	err = db.Exec(ctx, "CREATE DATABASE generated", []interface{}{}, nil)
+	require.NoError(t, err, "creating database")
+	defer db.Exec(ctx, "DROP DATABASE IF EXISTS generated", []interface{}{}, nil)
+	drv, err := sql.Open(dialect.Postgres, dsn+" dbname=generated")
+	require.NoError(t, err, "connecting to generated database")
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	Generated(t, client)
+}
+
+func TestSQLite(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	Generated(t, client)
+}
+
+func Generated(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	// Running the migration again should not try to add the generated columns.
+	require.NoError(t, client.Schema.Create(ctx))
+
+	u := client.User.Create().SetName("a8m").SetInts([]int{1, 2, 3}).SaveX(ctx)
+	// The values of generated columns are computed by the database,
+	// and are not returned by the create builders.
+	require.Empty(t, u.NameUpper)
+	require.Zero(t, u.IntsCount)
+	u = client.User.GetX(ctx, u.ID)
+	require.Equal(t, "A8M", u.NameUpper)
+	require.Equal(t, 3, u.IntsCount)
+
+	u = u.Update().SetName("nati").AppendInts(4).SaveX(ctx)
+	u = client.User.GetX(ctx, u.ID)
+	require.Equal(t, "NATI", u.NameUpper)
+	require.Equal(t, 4, u.IntsCount)
+
+	empty := client.User.Create().SaveX(ctx)
+	empty = client.User.GetX(ctx, empty.ID)
+	require.Empty(t, empty.NameUpper)
+	require.Zero(t, empty.IntsCount)
+
+	require.Equal(t, u.ID, client.User.Query().Where(user.NameUpper("NATI")).OnlyIDX(ctx))
+	require.Equal(t, u.ID, client.User.Query().Where(user.IntsCountGT(2)).OnlyIDX(ctx))
+	require.Equal(t, empty.ID, client.User.Query().Where(user.IntsCountIsNil()).OnlyIDX(ctx))
+	names := client.User.Query().Where(user.IntsCountNotNil()).Select(user.FieldNameUpper).StringsX(ctx)
+	require.Equal(t, []string{"NATI"}, names)
+}
@@ -266,6 +266,44 @@ func (c *CardClient) GetX(ctx context.Context, id string) *Card {
 	return ca
 }
 
+// GetMany returns the Card entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *CardClient) GetMany(ctx context.Context, ids ...string) ([]*Card, error) {
+	nodes, err := c.Query().Where(card.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Card, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Card, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", card.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CardClient) GetManyX(ctx context.Context, ids ...string) []*Card {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -376,6 +414,44 @@ func (c *CommentClient) GetX(ctx context.Context, id string) *Comment {
 	return co
 }
 
+// GetMany returns the Comment entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *CommentClient) GetMany(ctx context.Context, ids ...string) ([]*Comment, error) {
+	nodes, err := c.Query().Where(comment.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Comment, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Comment, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", comment.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CommentClient) GetManyX(ctx context.Context, ids ...string) []*Comment {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
@@ -464,6 +540,44 @@ func (c *FieldTypeClient) GetX(ctx context.Context, id string) *FieldType {
 	return ft
 }
 
+// GetMany returns the FieldType entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *FieldTypeClient) GetMany(ctx context.Context, ids ...string) ([]*FieldType, error) {
+	nodes, err := c.Query().Where(fieldtype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*FieldType, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*FieldType, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", fieldtype.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FieldTypeClient) GetManyX(ctx context.Context, ids ...string) []*FieldType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *FieldTypeClient) Hooks() []Hook {
 	return c.hooks.FieldType
@@ -552,6 +666,44 @@ func (c *FileClient) GetX(ctx context.Context, id string) *File {
 	return f
 }
 
+// GetMany returns the File entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *FileClient) GetMany(ctx context.Context, ids ...string) ([]*File, error) {
+	nodes, err := c.Query().Where(file.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*File, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*File, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", file.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileClient) GetManyX(ctx context.Context, ids ...string) []*File {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -673,6 +825,44 @@ func (c *FileTypeClient) GetX(ctx context.Context, id string) *FileType {
 	return ft
 }
 
+// GetMany returns the FileType entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *FileTypeClient) GetMany(ctx context.Context, ids ...string) ([]*FileType, error) {
+	nodes, err := c.Query().Where(filetype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*FileType, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*FileType, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", filetype.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileTypeClient) GetManyX(ctx context.Context, ids ...string) []*FileType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -772,6 +962,44 @@ func (c *GroupClient) GetX(ctx context.Context, id string) *Group {
 	return gr
 }
 
+// GetMany returns the Group entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *GroupClient) GetMany(ctx context.Context, ids ...string) ([]*Group, error) {
+	nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Group, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Group, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", group.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupClient) GetManyX(ctx context.Context, ids ...string) []*Group {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -904,6 +1132,44 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id string) *GroupInfo {
 	return gi
 }
 
+// GetMany returns the GroupInfo entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *GroupInfoClient) GetMany(ctx context.Context, ids ...string) ([]*GroupInfo, error) {
+	nodes, err := c.Query().Where(groupinfo.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*GroupInfo, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*GroupInfo, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", groupinfo.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupInfoClient) GetManyX(ctx context.Context, ids ...string) []*GroupInfo {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 	query := &GroupQuery{config: c.config}
@@ -1003,6 +1269,44 @@ func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
 	return i
 }
 
+// GetMany returns the Item entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *ItemClient) GetMany(ctx context.Context, ids ...string) ([]*Item, error) {
+	nodes, err := c.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Item, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Item, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", item.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *ItemClient) GetManyX(ctx context.Context, ids ...string) []*Item {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *ItemClient) Hooks() []Hook {
 	return c.hooks.Item
@@ -1091,6 +1395,44 @@ func (c *NodeClient) GetX(ctx context.Context, id string) *Node {
 	return n
 }
 
+// GetMany returns the Node entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *NodeClient) GetMany(ctx context.Context, ids ...string) ([]*Node, error) {
+	nodes, err := c.Query().Where(node.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Node, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Node, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", node.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *NodeClient) GetManyX(ctx context.Context, ids ...string) []*Node {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 	query := &NodeQuery{config: c.config}
@@ -1201,6 +1543,44 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 	return pe
 }
 
+// GetMany returns the Pet entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *PetClient) GetMany(ctx context.Context, ids ...string) ([]*Pet, error) {
+	nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Pet, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Pet, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", pet.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PetClient) GetManyX(ctx context.Context, ids ...string) []*Pet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -1311,6 +1691,44 @@ func (c *SpecClient) GetX(ctx context.Context, id string) *Spec {
 	return s
 }
 
+// GetMany returns the Spec entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *SpecClient) GetMany(ctx context.Context, ids ...string) ([]*Spec, error) {
+	nodes, err := c.Query().Where(spec.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Spec, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Spec, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", spec.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *SpecClient) GetManyX(ctx context.Context, ids ...string) []*Spec {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1410,6 +1828,44 @@ func (c *TaskClient) GetX(ctx context.Context, id string) *Task {
 	return t
 }
 
+// GetMany returns the Task entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *TaskClient) GetMany(ctx context.Context, ids ...string) ([]*Task, error) {
+	nodes, err := c.Query().Where(task.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Task, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*Task, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", task.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *TaskClient) GetManyX(ctx context.Context, ids ...string) []*Task {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *TaskClient) Hooks() []Hook {
 	return c.hooks.Task
@@ -1498,6 +1954,44 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *UserClient) GetMany(ctx context.Context, ids ...string) ([]*User, error) {
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*User, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []string
+		ordered = make([]*User, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", user.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...string) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -31,6 +33,8 @@ type CardQuery struct {
 	// eager-loading edges.
 	withOwner *UserQuery
 	withFKs   bool
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (cq *CardQuery) AllX(ctx context.Context) []*Card {
 	return cs
 }
 
+// Stream executes the query and calls fn for each Card, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (cq *CardQuery) Stream(ctx context.Context, fn func(*Card) error) error {
+	if cq.withOwner != nil {
+		return errors.New("ent: CardQuery.Stream does not support eager-loading of edges")
+	}
+	if err := cq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return cq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (cq *CardQuery) StreamX(ctx context.Context, fn func(*Card) error) {
+	if err := cq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Cards to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Card is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (cq *CardQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := cq.Stream(ctx, func(node *Card) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (cq *CardQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := cq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Card ids.
 func (cq *CardQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -397,6 +459,73 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	return nodes, nil
 }
 
+func (cq *CardQuery) sqlStream(ctx context.Context, fn func(*Card) error) error {
+	var (
+		withFKs = cq.withFKs
+		_spec   = cq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, card.ForeignKeys...)
+	}
+	var node *Card
+	_spec.ScanValues = func() []interface{} {
+		node = &Card{config: cq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, cq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (cq *CardQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *CardSelect {
+	selector := &CardSelect{config: cq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Card.Query().Where(card.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (cq *CardQuery) ForUpdate() *CardQuery {
+	cq.forUpdate = true
+	return cq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Card.Query().Omit(card.FieldNumber).AllX(ctx)
+//
+func (cq *CardQuery) Omit(fields ...string) *CardQuery {
+	cq.omit = append(cq.omit, fields...)
+	return cq
+}
+
 func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
@@ -420,8 +549,10 @@ func (cq *CardQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: card.FieldID,
 			},
 		},
-		From:   cq.sql,
-		Unique: true,
+		From:      cq.sql,
+		Unique:    true,
+		ForUpdate: cq.forUpdate,
+		Omit:      cq.omit,
 	}
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -468,6 +599,9 @@ func (cq *CardQuery) sqlQuery() *sql.Selector {
 	if limit := cq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if cq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -716,6 +850,7 @@ func (cgb *CardGroupBy) sqlQuery() *sql.Selector {
 type CardSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -938,6 +1073,10 @@ func (cs *CardSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (cs *CardSelect) sqlQuery() sql.Querier {
 	selector := cs.sql
-	selector.Select(selector.Columns(cs.fields...)...)
+	columns := selector.Columns(cs.fields...)
+	for _, fn := range cs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
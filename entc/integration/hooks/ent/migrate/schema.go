@@ -16,7 +16,7 @@ var (
 	CardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "number", Type: field.TypeString, Default: "unknown"},
-		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "Exact name written on card"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "user_cards", Type: field.TypeInt, Nullable: true},
 	}
@@ -100,6 +100,7 @@ var (
 		Clone,
 		Sanity,
 		Paging,
+		Stream,
 		Select,
 		Delete,
 		Relation,
@@ -256,6 +257,50 @@ func Paging(t *testing.T, client *ent.Client) {
 	}
 }
 
+func Stream(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	for i := 1; i <= 5; i++ {
+		client.User.Create().SetName(fmt.Sprintf("name-%d", i)).SetAge(i).SaveX(ctx)
+		client.Pet.Create().SetName(fmt.Sprintf("pet-%d", i)).SetOwner(a8m).SaveX(ctx)
+	}
+
+	var ages []int
+	client.User.Query().
+		Where(user.AgeLT(30)).
+		Order(ent.Asc(user.FieldAge)).
+		StreamX(ctx, func(u *ent.User) error {
+			ages = append(ages, u.Age)
+			return nil
+		})
+	require.Equal([]int{1, 2, 3, 4, 5}, ages)
+
+	t.Log("stop on error")
+	var n int
+	errStop := errors.New("stop")
+	err := client.User.Query().Stream(ctx, func(*ent.User) error {
+		if n++; n == 2 {
+			return errStop
+		}
+		return nil
+	})
+	require.Equal(errStop, err)
+	require.Equal(2, n)
+
+	t.Log("stream edges")
+	var names []string
+	a8m.QueryPets().Order(ent.Asc(pet.FieldName)).StreamX(ctx, func(p *ent.Pet) error {
+		names = append(names, p.Name)
+		return nil
+	})
+	require.Equal([]string{"pet-1", "pet-2", "pet-3", "pet-4", "pet-5"}, names)
+
+	t.Log("eager-loading is not supported")
+	err = client.User.Query().WithPets().Stream(ctx, func(*ent.User) error { return nil })
+	require.Error(err)
+}
+
 func Select(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	require := require.New(t)
@@ -7,8 +7,13 @@
 package ent
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 
 	"github.com/facebook/ent/entc/integration/json/ent/migrate"
@@ -109,6 +114,13 @@ func (c *Client) Debug() *Client {
 	return client
 }
 
+// SupportsJSON reports if the database that the client is connected to supports
+// the JSON type and functions that are used by the JSON predicates. For example,
+// MySQL supports them from version 5.7.8, and SQLite requires the JSON1 extension.
+func (c *Client) SupportsJSON(ctx context.Context) (bool, error) {
+	return migrate.SupportsJSON(ctx, c.driver)
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -120,6 +132,68 @@ func (c *Client) Use(hooks ...Hook) {
 	c.User.Use(hooks...)
 }
 
+// ImportOption configures the CreateFromJSON methods of the entity clients.
+type ImportOption func(*importConfig)
+
+// importConfig holds the configuration of the CreateFromJSON methods.
+type importConfig struct {
+	// skip is called with the malformed lines, if they should be skipped.
+	skip func(int, error)
+}
+
+// SkipMalformed returns an ImportOption for skipping malformed lines, instead of failing
+// the import. The given function (if not nil) is called with the line number and the
+// error of each skipped line. For example, for logging them.
+func SkipMalformed(f func(line int, err error)) ImportOption {
+	return func(c *importConfig) {
+		c.skip = func(line int, err error) {
+			if f != nil {
+				f(line, err)
+			}
+		}
+	}
+}
+
+// readJSONLines reads newline-delimited JSON objects from r, and calls f with each one of
+// them. Empty lines are ignored, and lines that are not JSON objects, or that f failed on,
+// are handled by the given options.
+func readJSONLines(r io.Reader, opts []ImportOption, f func(map[string]json.RawMessage) error) error {
+	cfg := &importConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	br := bufio.NewReader(r)
+	for n := 1; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("ent: reading line %d: %w", n, err)
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if lerr := readJSONLine(line, f); lerr != nil {
+				if cfg.skip == nil {
+					return fmt.Errorf("ent: malformed line %d: %w", n, lerr)
+				}
+				cfg.skip(n, lerr)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// readJSONLine decodes a non-empty line of readJSONLines, and calls f with its object.
+func readJSONLine(line []byte, f func(map[string]json.RawMessage) error) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(line, &obj); err != nil {
+		return err
+	}
+	if obj == nil {
+		return errors.New("expect a JSON object")
+	}
+	return f(obj)
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -147,6 +221,29 @@ func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
+// CreateFromJSON reads newline-delimited JSON objects from r, and creates a User entity for each
+// one of them in one bulk. The objects map field names to their JSON values, and null values are ignored.
+// Lines that are not JSON objects, or that have unknown fields or invalid values, fail the import with an
+// error, unless the SkipMalformed option was provided. Note that edges cannot be set by the objects.
+func (c *UserClient) CreateFromJSON(ctx context.Context, r io.Reader, opts ...ImportOption) ([]*User, error) {
+	var builders []*UserCreate
+	err := readJSONLines(r, opts, func(obj map[string]json.RawMessage) error {
+		builder := c.Create()
+		if err := builder.setJSON(obj); err != nil {
+			return err
+		}
+		if err := builder.preSave(); err != nil {
+			return err
+		}
+		builders = append(builders, builder)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateBulk(builders...).Save(ctx)
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -203,6 +300,44 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*User, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", user.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
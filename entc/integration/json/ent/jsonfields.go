@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+// JSONField describes a JSON field of the schema, as
+// it is provided by gen.Field to the templates.
+type JSONField struct {
+	Name     string
+	Type     string
+	Elem     string
+	MapValue string
+}
+
+// JSONFields holds the JSON fields of each type.
+var JSONFields = map[string][]JSONField{
+	"User": {
+		{Name: "url", Type: "*url.URL", Elem: "", MapValue: ""},
+		{Name: "urls", Type: "[]*url.URL", Elem: "*url.URL", MapValue: ""},
+		{Name: "raw", Type: "json.RawMessage", Elem: "", MapValue: ""},
+		{Name: "blob", Type: "[]uint8", Elem: "", MapValue: ""},
+		{Name: "dirs", Type: "[]http.Dir", Elem: "http.Dir", MapValue: ""},
+		{Name: "ints", Type: "[]int", Elem: "int", MapValue: ""},
+		{Name: "initial_ints", Type: "[]int", Elem: "int", MapValue: ""},
+		{Name: "floats", Type: "[]float64", Elem: "float64", MapValue: ""},
+		{Name: "nullable_ints", Type: "*[]int", Elem: "", MapValue: ""},
+		{Name: "null_ints", Type: "[]int", Elem: "int", MapValue: ""},
+		{Name: "empty_ints", Type: "[]int", Elem: "int", MapValue: ""},
+		{Name: "times", Type: "[]time.Time", Elem: "time.Time", MapValue: ""},
+		{Name: "meta", Type: "map[string]string", Elem: "", MapValue: "string"},
+		{Name: "secrets", Type: "map[string]string", Elem: "", MapValue: "string"},
+		{Name: "strings", Type: "[]string", Elem: "string", MapValue: ""},
+		{Name: "tags", Type: "[]string", Elem: "string", MapValue: ""},
+		{Name: "point", Type: "schema.Point", Elem: "", MapValue: ""},
+		{Name: "payload", Type: "schema.Payload", Elem: "", MapValue: ""},
+		{Name: "doc", Type: "json.RawMessage", Elem: "", MapValue: ""},
+		{Name: "pii", Type: "json.RawMessage", Elem: "", MapValue: ""},
+		{Name: "labels", Type: "[]string", Elem: "string", MapValue: ""},
+		{Name: "attrs", Type: "map[string]string", Elem: "", MapValue: "string"},
+		{Name: "keywords", Type: "[]string", Elem: "string", MapValue: ""},
+		{Name: "counts", Type: "[]int", Elem: "int", MapValue: ""},
+		{Name: "props", Type: "json.RawMessage", Elem: "", MapValue: ""},
+	},
+}
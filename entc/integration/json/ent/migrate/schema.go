@@ -7,6 +7,7 @@
 package migrate
 
 import (
+	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/schema"
 	"github.com/facebook/ent/schema/field"
 )
@@ -16,11 +17,31 @@ var (
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "url", Type: field.TypeJSON, Nullable: true},
-		{Name: "raw", Type: field.TypeJSON, Nullable: true},
-		{Name: "dirs", Type: field.TypeJSON, Nullable: true},
+		{Name: "url_list", Type: field.TypeJSON, Nullable: true},
+		{Name: "raw", Type: field.TypeJSON, Nullable: true, Comment: "free-form metadata", SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "blob", Type: field.TypeJSON, Nullable: true},
+		{Name: "dirs", Type: field.TypeJSON, Nullable: true, Backfill: "[\"/tmp\"]"},
 		{Name: "ints", Type: field.TypeJSON, Nullable: true},
+		{Name: "initial_ints", Type: field.TypeJSON, Nullable: true},
 		{Name: "floats", Type: field.TypeJSON, Nullable: true},
+		{Name: "nullable_ints", Type: field.TypeJSON, Nullable: true},
+		{Name: "null_ints", Type: field.TypeJSON, Nullable: true},
+		{Name: "empty_ints", Type: field.TypeJSON, DefaultExpr: "'[]'"},
+		{Name: "times", Type: field.TypeJSON, Nullable: true},
+		{Name: "meta", Type: field.TypeJSON, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
 		{Name: "strings", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "point", Type: field.TypeJSON, Nullable: true},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "doc", Type: field.TypeBytes, Nullable: true, Size: 4294967295},
+		{Name: "pii", Type: field.TypeBytes, Nullable: true, Size: 4294967295},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true, DefaultExpr: "'[]'"},
+		{Name: "attrs", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"mysql": "LONGTEXT"}},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"mysql": "LONGTEXT"}},
+		{Name: "counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "props", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"mysql": "LONGTEXT", "postgres": "text"}},
+		{Name: "version", Type: field.TypeInt},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -28,6 +49,21 @@ var (
 		Columns:     UsersColumns,
 		PrimaryKey:  []*schema.Column{UsersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_url",
+				Unique:  false,
+				Type:    "GIN",
+				Columns: []*schema.Column{UsersColumns[1]},
+			},
+			{
+				Name:       "user_url_scheme",
+				Unique:     false,
+				JSONPath:   "$.Scheme",
+				JSONColumn: "url_scheme",
+				Columns:    []*schema.Column{UsersColumns[1]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
@@ -36,4 +72,5 @@ var (
 )
 
 func init() {
+	sql.RegisterJSONPathColumn(UsersTable.Name, "url", "$.Scheme", "url_scheme")
 }
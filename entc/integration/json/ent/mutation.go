@@ -13,10 +13,13 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
 
 	"github.com/facebook/ent"
+	"github.com/facebook/ent/dialect/sql"
 )
 
 const (
@@ -35,18 +38,72 @@ const (
 // nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	url           **url.URL
-	raw           *json.RawMessage
-	dirs          *[]http.Dir
-	ints          *[]int
-	floats        *[]float64
-	strings       *[]string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
+	op                 Op
+	typ                string
+	id                 *int
+	url                **url.URL
+	mergeurl           []json.RawMessage
+	urls               *[]*url.URL
+	appendurls         []*url.URL
+	raw                *json.RawMessage
+	mergeraw           []json.RawMessage
+	patchraw           []sql.JSONPatchOp
+	blob               *[]uint8
+	dirs               *[]http.Dir
+	appenddirs         []http.Dir
+	ints               *[]int
+	atints             map[int]int
+	appendints         []int
+	removeints         []int
+	initial_ints       *[]int
+	appendinitial_ints []int
+	removeinitial_ints []int
+	floats             *[]float64
+	appendfloats       []float64
+	removefloats       []float64
+	nullable_ints      **[]int
+	null_ints          *[]int
+	appendnull_ints    []int
+	removenull_ints    []int
+	empty_ints         *[]int
+	appendempty_ints   []int
+	removeempty_ints   []int
+	times              *[]time.Time
+	appendtimes        []time.Time
+	meta               *map[string]string
+	mergemeta          []json.RawMessage
+	secrets            *map[string]string
+	mergesecrets       []json.RawMessage
+	strings            *[]string
+	appendstrings      []string
+	removestrings      []string
+	tags               *[]string
+	appendtags         []string
+	removetags         []string
+	point              *schema.Point
+	mergepoint         []json.RawMessage
+	payload            *schema.Payload
+	doc                *json.RawMessage
+	pii                *json.RawMessage
+	labels             *[]string
+	appendlabels       []string
+	removelabels       []string
+	attrs              *map[string]string
+	mergeattrs         []json.RawMessage
+	keywords           *[]string
+	appendkeywords     []string
+	removekeywords     []string
+	counts             *[]int
+	appendcounts       []int
+	removecounts       []int
+	props              *json.RawMessage
+	mergeprops         []json.RawMessage
+	patchprops         []sql.JSONPatchOp
+	version            *int
+	addversion         *int
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*User, error)
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -160,9 +217,25 @@ func (m *UserMutation) OldURL(ctx context.Context) (v *url.URL, err error) {
 	return oldValue.URL, nil
 }
 
+// MergeURL applies the given JSON merge-patch (RFC 7386) on the url field. Unlike SetURL,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetURL in the same mutation.
+func (m *UserMutation) MergeURL(patch json.RawMessage) {
+	m.mergeurl = append(m.mergeurl, patch)
+}
+
+// MergedURL returns the patches that were merged into the url field in this mutation.
+func (m *UserMutation) MergedURL() ([]json.RawMessage, bool) {
+	if len(m.mergeurl) == 0 {
+		return nil, false
+	}
+	return m.mergeurl, true
+}
+
 // ClearURL clears the value of url.
 func (m *UserMutation) ClearURL() {
 	m.url = nil
+	m.mergeurl = nil
 	m.clearedFields[user.FieldURL] = struct{}{}
 }
 
@@ -175,9 +248,82 @@ func (m *UserMutation) URLCleared() bool {
 // ResetURL reset all changes of the "url" field.
 func (m *UserMutation) ResetURL() {
 	m.url = nil
+	m.mergeurl = nil
 	delete(m.clearedFields, user.FieldURL)
 }
 
+// SetUrls sets the urls field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetUrls(u []*url.URL) {
+	if u == nil {
+		m.ClearUrls()
+		return
+	}
+	delete(m.clearedFields, user.FieldUrls)
+	m.urls = &u
+}
+
+// Urls returns the urls value in the mutation.
+func (m *UserMutation) Urls() (r []*url.URL, exists bool) {
+	v := m.urls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUrls returns the old urls value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldUrls(ctx context.Context) (v []*url.URL, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUrls is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUrls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUrls: %w", err)
+	}
+	return oldValue.Urls, nil
+}
+
+// AppendUrls appends vs to the urls field. Unlike SetUrls, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendUrls(vs ...*url.URL) {
+	m.appendurls = append(m.appendurls, vs...)
+}
+
+// AppendedUrls returns the values that were appended to the urls field in this mutation.
+func (m *UserMutation) AppendedUrls() ([]*url.URL, bool) {
+	if len(m.appendurls) == 0 {
+		return nil, false
+	}
+	return m.appendurls, true
+}
+
+// ClearUrls clears the value of urls.
+func (m *UserMutation) ClearUrls() {
+	m.urls = nil
+	m.appendurls = nil
+	m.clearedFields[user.FieldUrls] = struct{}{}
+}
+
+// UrlsCleared returns if the field urls was cleared in this mutation.
+func (m *UserMutation) UrlsCleared() bool {
+	_, ok := m.clearedFields[user.FieldUrls]
+	return ok
+}
+
+// ResetUrls reset all changes of the "urls" field.
+func (m *UserMutation) ResetUrls() {
+	m.urls = nil
+	m.appendurls = nil
+	delete(m.clearedFields, user.FieldUrls)
+}
+
 // SetRaw sets the raw field.
 func (m *UserMutation) SetRaw(jm json.RawMessage) {
 	m.raw = &jm
@@ -210,9 +356,42 @@ func (m *UserMutation) OldRaw(ctx context.Context) (v json.RawMessage, err error
 	return oldValue.Raw, nil
 }
 
+// MergeRaw applies the given JSON merge-patch (RFC 7386) on the raw field. Unlike SetRaw,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetRaw in the same mutation.
+func (m *UserMutation) MergeRaw(patch json.RawMessage) {
+	m.mergeraw = append(m.mergeraw, patch)
+}
+
+// MergedRaw returns the patches that were merged into the raw field in this mutation.
+func (m *UserMutation) MergedRaw() ([]json.RawMessage, bool) {
+	if len(m.mergeraw) == 0 {
+		return nil, false
+	}
+	return m.mergeraw, true
+}
+
+// PatchRaw applies the given JSON patch (RFC 6902) on the raw field. The value is read, patched
+// and written back in the transaction of the mutation, and the mutation fails if one of the operations failed
+// (e.g. a "test" operation). Patches are applied in order, and cannot be used with SetRaw in
+// the same mutation.
+func (m *UserMutation) PatchRaw(ops []sql.JSONPatchOp) {
+	m.patchraw = append(m.patchraw, ops...)
+}
+
+// PatchedRaw returns the JSON patch operations that were applied on the raw field in this mutation.
+func (m *UserMutation) PatchedRaw() ([]sql.JSONPatchOp, bool) {
+	if len(m.patchraw) == 0 {
+		return nil, false
+	}
+	return m.patchraw, true
+}
+
 // ClearRaw clears the value of raw.
 func (m *UserMutation) ClearRaw() {
 	m.raw = nil
+	m.mergeraw = nil
+	m.patchraw = nil
 	m.clearedFields[user.FieldRaw] = struct{}{}
 }
 
@@ -225,11 +404,75 @@ func (m *UserMutation) RawCleared() bool {
 // ResetRaw reset all changes of the "raw" field.
 func (m *UserMutation) ResetRaw() {
 	m.raw = nil
+	m.mergeraw = nil
+	m.patchraw = nil
 	delete(m.clearedFields, user.FieldRaw)
 }
 
+// SetBlob sets the blob field.
+// A nil value clears the field (stored as NULL).
+func (m *UserMutation) SetBlob(u []uint8) {
+	if u == nil {
+		m.ClearBlob()
+		return
+	}
+	delete(m.clearedFields, user.FieldBlob)
+	m.blob = &u
+}
+
+// Blob returns the blob value in the mutation.
+func (m *UserMutation) Blob() (r []uint8, exists bool) {
+	v := m.blob
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlob returns the old blob value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldBlob(ctx context.Context) (v []uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBlob is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBlob requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlob: %w", err)
+	}
+	return oldValue.Blob, nil
+}
+
+// ClearBlob clears the value of blob.
+func (m *UserMutation) ClearBlob() {
+	m.blob = nil
+	m.clearedFields[user.FieldBlob] = struct{}{}
+}
+
+// BlobCleared returns if the field blob was cleared in this mutation.
+func (m *UserMutation) BlobCleared() bool {
+	_, ok := m.clearedFields[user.FieldBlob]
+	return ok
+}
+
+// ResetBlob reset all changes of the "blob" field.
+func (m *UserMutation) ResetBlob() {
+	m.blob = nil
+	delete(m.clearedFields, user.FieldBlob)
+}
+
 // SetDirs sets the dirs field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
 func (m *UserMutation) SetDirs(h []http.Dir) {
+	if h == nil {
+		m.ClearDirs()
+		return
+	}
+	delete(m.clearedFields, user.FieldDirs)
 	m.dirs = &h
 }
 
@@ -260,9 +503,24 @@ func (m *UserMutation) OldDirs(ctx context.Context) (v []http.Dir, err error) {
 	return oldValue.Dirs, nil
 }
 
+// AppendDirs appends vs to the dirs field. Unlike SetDirs, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendDirs(vs ...http.Dir) {
+	m.appenddirs = append(m.appenddirs, vs...)
+}
+
+// AppendedDirs returns the values that were appended to the dirs field in this mutation.
+func (m *UserMutation) AppendedDirs() ([]http.Dir, bool) {
+	if len(m.appenddirs) == 0 {
+		return nil, false
+	}
+	return m.appenddirs, true
+}
+
 // ClearDirs clears the value of dirs.
 func (m *UserMutation) ClearDirs() {
 	m.dirs = nil
+	m.appenddirs = nil
 	m.clearedFields[user.FieldDirs] = struct{}{}
 }
 
@@ -275,12 +533,20 @@ func (m *UserMutation) DirsCleared() bool {
 // ResetDirs reset all changes of the "dirs" field.
 func (m *UserMutation) ResetDirs() {
 	m.dirs = nil
+	m.appenddirs = nil
 	delete(m.clearedFields, user.FieldDirs)
 }
 
 // SetInts sets the ints field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
 func (m *UserMutation) SetInts(i []int) {
+	if i == nil {
+		m.ClearInts()
+		return
+	}
+	delete(m.clearedFields, user.FieldInts)
 	m.ints = &i
+	m.atints = nil
 }
 
 // Ints returns the ints value in the mutation.
@@ -307,125 +573,1550 @@ func (m *UserMutation) OldInts(ctx context.Context) (v []int, err error) {
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldInts: %w", err)
 	}
-	return oldValue.Ints, nil
+	return oldValue.Ints, nil
+}
+
+// SetIntAt sets the element at index i of the ints field. Unlike SetInts,
+// the change is applied on the database as a partial update (JSON_REPLACE on MySQL and SQLite, or
+// jsonb_set on PostgreSQL), without rewriting the whole column. Indexes that are out of the bounds
+// of the array are ignored, and negative indexes fail the mutation on save.
+func (m *UserMutation) SetIntAt(i int, v int) {
+	if m.ints != nil && i >= 0 {
+		if i < len(*m.ints) {
+			vs := append([]int(nil), *m.ints...)
+			vs[i] = v
+			m.ints = &vs
+		}
+		return
+	}
+	if m.atints == nil {
+		m.atints = make(map[int]int)
+	}
+	m.atints[i] = v
+}
+
+// IntsAt returns the elements (by their index) that were set on the ints field using SetIntAt.
+func (m *UserMutation) IntsAt() map[int]int {
+	return m.atints
+}
+
+// AppendInts appends vs to the ints field. Unlike SetInts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendInts(vs ...int) {
+	m.appendints = append(m.appendints, vs...)
+}
+
+// AppendedInts returns the values that were appended to the ints field in this mutation.
+func (m *UserMutation) AppendedInts() ([]int, bool) {
+	if len(m.appendints) == 0 {
+		return nil, false
+	}
+	return m.appendints, true
+}
+
+// RemoveInts removes all occurrences of vs from the ints field. Like AppendInts, the values
+// are removed from the array stored in the database, and it cannot be used with SetInts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveInts(vs ...int) {
+	m.removeints = append(m.removeints, vs...)
+}
+
+// RemovedInts returns the values that were removed from the ints field in this mutation.
+func (m *UserMutation) RemovedInts() ([]int, bool) {
+	if len(m.removeints) == 0 {
+		return nil, false
+	}
+	return m.removeints, true
+}
+
+// ClearInts clears the value of ints.
+func (m *UserMutation) ClearInts() {
+	m.ints = nil
+	m.atints = nil
+	m.appendints = nil
+	m.removeints = nil
+	m.clearedFields[user.FieldInts] = struct{}{}
+}
+
+// IntsCleared returns if the field ints was cleared in this mutation.
+func (m *UserMutation) IntsCleared() bool {
+	_, ok := m.clearedFields[user.FieldInts]
+	return ok
+}
+
+// ResetInts reset all changes of the "ints" field.
+func (m *UserMutation) ResetInts() {
+	m.ints = nil
+	m.atints = nil
+	m.appendints = nil
+	m.removeints = nil
+	delete(m.clearedFields, user.FieldInts)
+}
+
+// SetInitialInts sets the initial_ints field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetInitialInts(i []int) {
+	if i == nil {
+		m.ClearInitialInts()
+		return
+	}
+	delete(m.clearedFields, user.FieldInitialInts)
+	m.initial_ints = &i
+}
+
+// InitialInts returns the initial_ints value in the mutation.
+func (m *UserMutation) InitialInts() (r []int, exists bool) {
+	v := m.initial_ints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialInts returns the old initial_ints value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldInitialInts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldInitialInts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldInitialInts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialInts: %w", err)
+	}
+	return oldValue.InitialInts, nil
+}
+
+// AppendInitialInts appends vs to the initial_ints field. Unlike SetInitialInts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendInitialInts(vs ...int) {
+	m.appendinitial_ints = append(m.appendinitial_ints, vs...)
+}
+
+// AppendedInitialInts returns the values that were appended to the initial_ints field in this mutation.
+func (m *UserMutation) AppendedInitialInts() ([]int, bool) {
+	if len(m.appendinitial_ints) == 0 {
+		return nil, false
+	}
+	return m.appendinitial_ints, true
+}
+
+// RemoveInitialInts removes all occurrences of vs from the initial_ints field. Like AppendInitialInts, the values
+// are removed from the array stored in the database, and it cannot be used with SetInitialInts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveInitialInts(vs ...int) {
+	m.removeinitial_ints = append(m.removeinitial_ints, vs...)
+}
+
+// RemovedInitialInts returns the values that were removed from the initial_ints field in this mutation.
+func (m *UserMutation) RemovedInitialInts() ([]int, bool) {
+	if len(m.removeinitial_ints) == 0 {
+		return nil, false
+	}
+	return m.removeinitial_ints, true
+}
+
+// ClearInitialInts clears the value of initial_ints.
+func (m *UserMutation) ClearInitialInts() {
+	m.initial_ints = nil
+	m.appendinitial_ints = nil
+	m.removeinitial_ints = nil
+	m.clearedFields[user.FieldInitialInts] = struct{}{}
+}
+
+// InitialIntsCleared returns if the field initial_ints was cleared in this mutation.
+func (m *UserMutation) InitialIntsCleared() bool {
+	_, ok := m.clearedFields[user.FieldInitialInts]
+	return ok
+}
+
+// ResetInitialInts reset all changes of the "initial_ints" field.
+func (m *UserMutation) ResetInitialInts() {
+	m.initial_ints = nil
+	m.appendinitial_ints = nil
+	m.removeinitial_ints = nil
+	delete(m.clearedFields, user.FieldInitialInts)
+}
+
+// SetFloats sets the floats field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetFloats(f []float64) {
+	if f == nil {
+		m.ClearFloats()
+		return
+	}
+	delete(m.clearedFields, user.FieldFloats)
+	m.floats = &f
+}
+
+// Floats returns the floats value in the mutation.
+func (m *UserMutation) Floats() (r []float64, exists bool) {
+	v := m.floats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloats returns the old floats value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldFloats(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFloats is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFloats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloats: %w", err)
+	}
+	return oldValue.Floats, nil
+}
+
+// AppendFloats appends vs to the floats field. Unlike SetFloats, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendFloats(vs ...float64) {
+	m.appendfloats = append(m.appendfloats, vs...)
+}
+
+// AppendedFloats returns the values that were appended to the floats field in this mutation.
+func (m *UserMutation) AppendedFloats() ([]float64, bool) {
+	if len(m.appendfloats) == 0 {
+		return nil, false
+	}
+	return m.appendfloats, true
+}
+
+// RemoveFloats removes all occurrences of vs from the floats field. Like AppendFloats, the values
+// are removed from the array stored in the database, and it cannot be used with SetFloats in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveFloats(vs ...float64) {
+	m.removefloats = append(m.removefloats, vs...)
+}
+
+// RemovedFloats returns the values that were removed from the floats field in this mutation.
+func (m *UserMutation) RemovedFloats() ([]float64, bool) {
+	if len(m.removefloats) == 0 {
+		return nil, false
+	}
+	return m.removefloats, true
+}
+
+// ClearFloats clears the value of floats.
+func (m *UserMutation) ClearFloats() {
+	m.floats = nil
+	m.appendfloats = nil
+	m.removefloats = nil
+	m.clearedFields[user.FieldFloats] = struct{}{}
+}
+
+// FloatsCleared returns if the field floats was cleared in this mutation.
+func (m *UserMutation) FloatsCleared() bool {
+	_, ok := m.clearedFields[user.FieldFloats]
+	return ok
+}
+
+// ResetFloats reset all changes of the "floats" field.
+func (m *UserMutation) ResetFloats() {
+	m.floats = nil
+	m.appendfloats = nil
+	m.removefloats = nil
+	delete(m.clearedFields, user.FieldFloats)
+}
+
+// SetNullableInts sets the nullable_ints field.
+// A nil value clears the field (stored as NULL), unlike a pointer to a nil value (stored as JSON null).
+func (m *UserMutation) SetNullableInts(i *[]int) {
+	if i == nil {
+		m.ClearNullableInts()
+		return
+	}
+	delete(m.clearedFields, user.FieldNullableInts)
+	m.nullable_ints = &i
+}
+
+// NullableInts returns the nullable_ints value in the mutation.
+func (m *UserMutation) NullableInts() (r *[]int, exists bool) {
+	v := m.nullable_ints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNullableInts returns the old nullable_ints value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldNullableInts(ctx context.Context) (v *[]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNullableInts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNullableInts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNullableInts: %w", err)
+	}
+	return oldValue.NullableInts, nil
+}
+
+// ClearNullableInts clears the value of nullable_ints.
+func (m *UserMutation) ClearNullableInts() {
+	m.nullable_ints = nil
+	m.clearedFields[user.FieldNullableInts] = struct{}{}
+}
+
+// NullableIntsCleared returns if the field nullable_ints was cleared in this mutation.
+func (m *UserMutation) NullableIntsCleared() bool {
+	_, ok := m.clearedFields[user.FieldNullableInts]
+	return ok
+}
+
+// ResetNullableInts reset all changes of the "nullable_ints" field.
+func (m *UserMutation) ResetNullableInts() {
+	m.nullable_ints = nil
+	delete(m.clearedFields, user.FieldNullableInts)
+}
+
+// SetNullInts sets the null_ints field.
+func (m *UserMutation) SetNullInts(i []int) {
+	m.null_ints = &i
+}
+
+// NullInts returns the null_ints value in the mutation.
+func (m *UserMutation) NullInts() (r []int, exists bool) {
+	v := m.null_ints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNullInts returns the old null_ints value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldNullInts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldNullInts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldNullInts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNullInts: %w", err)
+	}
+	return oldValue.NullInts, nil
+}
+
+// AppendNullInts appends vs to the null_ints field. Unlike SetNullInts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendNullInts(vs ...int) {
+	m.appendnull_ints = append(m.appendnull_ints, vs...)
+}
+
+// AppendedNullInts returns the values that were appended to the null_ints field in this mutation.
+func (m *UserMutation) AppendedNullInts() ([]int, bool) {
+	if len(m.appendnull_ints) == 0 {
+		return nil, false
+	}
+	return m.appendnull_ints, true
+}
+
+// RemoveNullInts removes all occurrences of vs from the null_ints field. Like AppendNullInts, the values
+// are removed from the array stored in the database, and it cannot be used with SetNullInts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveNullInts(vs ...int) {
+	m.removenull_ints = append(m.removenull_ints, vs...)
+}
+
+// RemovedNullInts returns the values that were removed from the null_ints field in this mutation.
+func (m *UserMutation) RemovedNullInts() ([]int, bool) {
+	if len(m.removenull_ints) == 0 {
+		return nil, false
+	}
+	return m.removenull_ints, true
+}
+
+// ClearNullInts clears the value of null_ints.
+func (m *UserMutation) ClearNullInts() {
+	m.null_ints = nil
+	m.appendnull_ints = nil
+	m.removenull_ints = nil
+	m.clearedFields[user.FieldNullInts] = struct{}{}
+}
+
+// NullIntsCleared returns if the field null_ints was cleared in this mutation.
+func (m *UserMutation) NullIntsCleared() bool {
+	_, ok := m.clearedFields[user.FieldNullInts]
+	return ok
+}
+
+// ResetNullInts reset all changes of the "null_ints" field.
+func (m *UserMutation) ResetNullInts() {
+	m.null_ints = nil
+	m.appendnull_ints = nil
+	m.removenull_ints = nil
+	delete(m.clearedFields, user.FieldNullInts)
+}
+
+// SetEmptyInts sets the empty_ints field.
+// A nil value is stored as an empty array.
+func (m *UserMutation) SetEmptyInts(i []int) {
+	if i == nil {
+		i = []int{}
+	}
+	m.empty_ints = &i
+}
+
+// EmptyInts returns the empty_ints value in the mutation.
+func (m *UserMutation) EmptyInts() (r []int, exists bool) {
+	v := m.empty_ints
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmptyInts returns the old empty_ints value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldEmptyInts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEmptyInts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEmptyInts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmptyInts: %w", err)
+	}
+	return oldValue.EmptyInts, nil
+}
+
+// AppendEmptyInts appends vs to the empty_ints field. Unlike SetEmptyInts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendEmptyInts(vs ...int) {
+	m.appendempty_ints = append(m.appendempty_ints, vs...)
+}
+
+// AppendedEmptyInts returns the values that were appended to the empty_ints field in this mutation.
+func (m *UserMutation) AppendedEmptyInts() ([]int, bool) {
+	if len(m.appendempty_ints) == 0 {
+		return nil, false
+	}
+	return m.appendempty_ints, true
+}
+
+// RemoveEmptyInts removes all occurrences of vs from the empty_ints field. Like AppendEmptyInts, the values
+// are removed from the array stored in the database, and it cannot be used with SetEmptyInts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveEmptyInts(vs ...int) {
+	m.removeempty_ints = append(m.removeempty_ints, vs...)
+}
+
+// RemovedEmptyInts returns the values that were removed from the empty_ints field in this mutation.
+func (m *UserMutation) RemovedEmptyInts() ([]int, bool) {
+	if len(m.removeempty_ints) == 0 {
+		return nil, false
+	}
+	return m.removeempty_ints, true
+}
+
+// ResetEmptyInts reset all changes of the "empty_ints" field.
+func (m *UserMutation) ResetEmptyInts() {
+	m.empty_ints = nil
+	m.appendempty_ints = nil
+	m.removeempty_ints = nil
+}
+
+// SetTimes sets the times field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetTimes(t []time.Time) {
+	if t == nil {
+		m.ClearTimes()
+		return
+	}
+	delete(m.clearedFields, user.FieldTimes)
+	m.times = &t
+}
+
+// Times returns the times value in the mutation.
+func (m *UserMutation) Times() (r []time.Time, exists bool) {
+	v := m.times
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimes returns the old times value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldTimes(ctx context.Context) (v []time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimes is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimes: %w", err)
+	}
+	return oldValue.Times, nil
+}
+
+// AppendTimes appends vs to the times field. Unlike SetTimes, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendTimes(vs ...time.Time) {
+	m.appendtimes = append(m.appendtimes, vs...)
+}
+
+// AppendedTimes returns the values that were appended to the times field in this mutation.
+func (m *UserMutation) AppendedTimes() ([]time.Time, bool) {
+	if len(m.appendtimes) == 0 {
+		return nil, false
+	}
+	return m.appendtimes, true
+}
+
+// ClearTimes clears the value of times.
+func (m *UserMutation) ClearTimes() {
+	m.times = nil
+	m.appendtimes = nil
+	m.clearedFields[user.FieldTimes] = struct{}{}
+}
+
+// TimesCleared returns if the field times was cleared in this mutation.
+func (m *UserMutation) TimesCleared() bool {
+	_, ok := m.clearedFields[user.FieldTimes]
+	return ok
+}
+
+// ResetTimes reset all changes of the "times" field.
+func (m *UserMutation) ResetTimes() {
+	m.times = nil
+	m.appendtimes = nil
+	delete(m.clearedFields, user.FieldTimes)
+}
+
+// SetMeta sets the meta field.
+func (m *UserMutation) SetMeta(value map[string]string) {
+	m.meta = &value
+}
+
+// Meta returns the meta value in the mutation.
+func (m *UserMutation) Meta() (r map[string]string, exists bool) {
+	v := m.meta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeta returns the old meta value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldMeta(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMeta is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMeta requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeta: %w", err)
+	}
+	return oldValue.Meta, nil
+}
+
+// MergeMeta applies the given JSON merge-patch (RFC 7386) on the meta field. Unlike SetMeta,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetMeta in the same mutation.
+func (m *UserMutation) MergeMeta(patch json.RawMessage) {
+	m.mergemeta = append(m.mergemeta, patch)
+}
+
+// MergedMeta returns the patches that were merged into the meta field in this mutation.
+func (m *UserMutation) MergedMeta() ([]json.RawMessage, bool) {
+	if len(m.mergemeta) == 0 {
+		return nil, false
+	}
+	return m.mergemeta, true
+}
+
+// ClearMeta clears the value of meta.
+func (m *UserMutation) ClearMeta() {
+	m.meta = nil
+	m.mergemeta = nil
+	m.clearedFields[user.FieldMeta] = struct{}{}
+}
+
+// MetaCleared returns if the field meta was cleared in this mutation.
+func (m *UserMutation) MetaCleared() bool {
+	_, ok := m.clearedFields[user.FieldMeta]
+	return ok
+}
+
+// ResetMeta reset all changes of the "meta" field.
+func (m *UserMutation) ResetMeta() {
+	m.meta = nil
+	m.mergemeta = nil
+	delete(m.clearedFields, user.FieldMeta)
+}
+
+// SetSecrets sets the secrets field.
+func (m *UserMutation) SetSecrets(value map[string]string) {
+	m.secrets = &value
+}
+
+// Secrets returns the secrets value in the mutation.
+func (m *UserMutation) Secrets() (r map[string]string, exists bool) {
+	v := m.secrets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecrets returns the old secrets value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldSecrets(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecrets is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecrets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecrets: %w", err)
+	}
+	return oldValue.Secrets, nil
+}
+
+// MergeSecrets applies the given JSON merge-patch (RFC 7386) on the secrets field. Unlike SetSecrets,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetSecrets in the same mutation.
+func (m *UserMutation) MergeSecrets(patch json.RawMessage) {
+	m.mergesecrets = append(m.mergesecrets, patch)
+}
+
+// MergedSecrets returns the patches that were merged into the secrets field in this mutation.
+func (m *UserMutation) MergedSecrets() ([]json.RawMessage, bool) {
+	if len(m.mergesecrets) == 0 {
+		return nil, false
+	}
+	return m.mergesecrets, true
+}
+
+// ClearSecrets clears the value of secrets.
+func (m *UserMutation) ClearSecrets() {
+	m.secrets = nil
+	m.mergesecrets = nil
+	m.clearedFields[user.FieldSecrets] = struct{}{}
+}
+
+// SecretsCleared returns if the field secrets was cleared in this mutation.
+func (m *UserMutation) SecretsCleared() bool {
+	_, ok := m.clearedFields[user.FieldSecrets]
+	return ok
+}
+
+// ResetSecrets reset all changes of the "secrets" field.
+func (m *UserMutation) ResetSecrets() {
+	m.secrets = nil
+	m.mergesecrets = nil
+	delete(m.clearedFields, user.FieldSecrets)
+}
+
+// SetStrings sets the strings field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetStrings(s []string) {
+	if s == nil {
+		m.ClearStrings()
+		return
+	}
+	delete(m.clearedFields, user.FieldStrings)
+	m.strings = &s
+}
+
+// Strings returns the strings value in the mutation.
+func (m *UserMutation) Strings() (r []string, exists bool) {
+	v := m.strings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrings returns the old strings value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldStrings(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStrings is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStrings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrings: %w", err)
+	}
+	return oldValue.Strings, nil
+}
+
+// AppendStrings appends vs to the strings field. Unlike SetStrings, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendStrings(vs ...string) {
+	m.appendstrings = append(m.appendstrings, vs...)
+}
+
+// AppendedStrings returns the values that were appended to the strings field in this mutation.
+func (m *UserMutation) AppendedStrings() ([]string, bool) {
+	if len(m.appendstrings) == 0 {
+		return nil, false
+	}
+	return m.appendstrings, true
+}
+
+// RemoveStrings removes all occurrences of vs from the strings field. Like AppendStrings, the values
+// are removed from the array stored in the database, and it cannot be used with SetStrings in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveStrings(vs ...string) {
+	m.removestrings = append(m.removestrings, vs...)
+}
+
+// RemovedStrings returns the values that were removed from the strings field in this mutation.
+func (m *UserMutation) RemovedStrings() ([]string, bool) {
+	if len(m.removestrings) == 0 {
+		return nil, false
+	}
+	return m.removestrings, true
+}
+
+// ClearStrings clears the value of strings.
+func (m *UserMutation) ClearStrings() {
+	m.strings = nil
+	m.appendstrings = nil
+	m.removestrings = nil
+	m.clearedFields[user.FieldStrings] = struct{}{}
+}
+
+// StringsCleared returns if the field strings was cleared in this mutation.
+func (m *UserMutation) StringsCleared() bool {
+	_, ok := m.clearedFields[user.FieldStrings]
+	return ok
+}
+
+// ResetStrings reset all changes of the "strings" field.
+func (m *UserMutation) ResetStrings() {
+	m.strings = nil
+	m.appendstrings = nil
+	m.removestrings = nil
+	delete(m.clearedFields, user.FieldStrings)
+}
+
+// SetTags sets the tags field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetTags(s []string) {
+	if s == nil {
+		m.ClearTags()
+		return
+	}
+	delete(m.clearedFields, user.FieldTags)
+	m.tags = &s
+}
+
+// Tags returns the tags value in the mutation.
+func (m *UserMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old tags value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTags is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags appends vs to the tags field. Unlike SetTags, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendTags(vs ...string) {
+	m.appendtags = append(m.appendtags, vs...)
+}
+
+// AppendedTags returns the values that were appended to the tags field in this mutation.
+func (m *UserMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// RemoveTags removes all occurrences of vs from the tags field. Like AppendTags, the values
+// are removed from the array stored in the database, and it cannot be used with SetTags in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveTags(vs ...string) {
+	m.removetags = append(m.removetags, vs...)
+}
+
+// RemovedTags returns the values that were removed from the tags field in this mutation.
+func (m *UserMutation) RemovedTags() ([]string, bool) {
+	if len(m.removetags) == 0 {
+		return nil, false
+	}
+	return m.removetags, true
+}
+
+// ClearTags clears the value of tags.
+func (m *UserMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.removetags = nil
+	m.clearedFields[user.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the field tags was cleared in this mutation.
+func (m *UserMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[user.FieldTags]
+	return ok
+}
+
+// ResetTags reset all changes of the "tags" field.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.removetags = nil
+	delete(m.clearedFields, user.FieldTags)
+}
+
+// SetPoint sets the point field.
+func (m *UserMutation) SetPoint(s schema.Point) {
+	m.point = &s
+}
+
+// Point returns the point value in the mutation.
+func (m *UserMutation) Point() (r schema.Point, exists bool) {
+	v := m.point
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoint returns the old point value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldPoint(ctx context.Context) (v schema.Point, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPoint is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoint: %w", err)
+	}
+	return oldValue.Point, nil
+}
+
+// MergePoint applies the given JSON merge-patch (RFC 7386) on the point field. Unlike SetPoint,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetPoint in the same mutation.
+func (m *UserMutation) MergePoint(patch json.RawMessage) {
+	m.mergepoint = append(m.mergepoint, patch)
+}
+
+// MergedPoint returns the patches that were merged into the point field in this mutation.
+func (m *UserMutation) MergedPoint() ([]json.RawMessage, bool) {
+	if len(m.mergepoint) == 0 {
+		return nil, false
+	}
+	return m.mergepoint, true
+}
+
+// ClearPoint clears the value of point.
+func (m *UserMutation) ClearPoint() {
+	m.point = nil
+	m.mergepoint = nil
+	m.clearedFields[user.FieldPoint] = struct{}{}
+}
+
+// PointCleared returns if the field point was cleared in this mutation.
+func (m *UserMutation) PointCleared() bool {
+	_, ok := m.clearedFields[user.FieldPoint]
+	return ok
+}
+
+// ResetPoint reset all changes of the "point" field.
+func (m *UserMutation) ResetPoint() {
+	m.point = nil
+	m.mergepoint = nil
+	delete(m.clearedFields, user.FieldPoint)
+}
+
+// SetPayload sets the payload field.
+func (m *UserMutation) SetPayload(s schema.Payload) {
+	m.payload = &s
+}
+
+// Payload returns the payload value in the mutation.
+func (m *UserMutation) Payload() (r schema.Payload, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old payload value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldPayload(ctx context.Context) (v schema.Payload, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPayload is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of payload.
+func (m *UserMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[user.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the field payload was cleared in this mutation.
+func (m *UserMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[user.FieldPayload]
+	return ok
+}
+
+// ResetPayload reset all changes of the "payload" field.
+func (m *UserMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, user.FieldPayload)
+}
+
+// SetDoc sets the doc field.
+func (m *UserMutation) SetDoc(jm json.RawMessage) {
+	m.doc = &jm
+}
+
+// Doc returns the doc value in the mutation.
+func (m *UserMutation) Doc() (r json.RawMessage, exists bool) {
+	v := m.doc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoc returns the old doc value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldDoc(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDoc is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDoc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoc: %w", err)
+	}
+	return oldValue.Doc, nil
+}
+
+// ClearDoc clears the value of doc.
+func (m *UserMutation) ClearDoc() {
+	m.doc = nil
+	m.clearedFields[user.FieldDoc] = struct{}{}
+}
+
+// DocCleared returns if the field doc was cleared in this mutation.
+func (m *UserMutation) DocCleared() bool {
+	_, ok := m.clearedFields[user.FieldDoc]
+	return ok
+}
+
+// ResetDoc reset all changes of the "doc" field.
+func (m *UserMutation) ResetDoc() {
+	m.doc = nil
+	delete(m.clearedFields, user.FieldDoc)
+}
+
+// SetPii sets the pii field.
+func (m *UserMutation) SetPii(jm json.RawMessage) {
+	m.pii = &jm
+}
+
+// Pii returns the pii value in the mutation.
+func (m *UserMutation) Pii() (r json.RawMessage, exists bool) {
+	v := m.pii
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPii returns the old pii value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldPii(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPii is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPii requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPii: %w", err)
+	}
+	return oldValue.Pii, nil
+}
+
+// ClearPii clears the value of pii.
+func (m *UserMutation) ClearPii() {
+	m.pii = nil
+	m.clearedFields[user.FieldPii] = struct{}{}
+}
+
+// PiiCleared returns if the field pii was cleared in this mutation.
+func (m *UserMutation) PiiCleared() bool {
+	_, ok := m.clearedFields[user.FieldPii]
+	return ok
+}
+
+// ResetPii reset all changes of the "pii" field.
+func (m *UserMutation) ResetPii() {
+	m.pii = nil
+	delete(m.clearedFields, user.FieldPii)
+}
+
+// SetLabels sets the labels field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetLabels(s []string) {
+	if s == nil {
+		m.ClearLabels()
+		return
+	}
+	delete(m.clearedFields, user.FieldLabels)
+	m.labels = &s
+}
+
+// Labels returns the labels value in the mutation.
+func (m *UserMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old labels value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLabels is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels appends vs to the labels field. Unlike SetLabels, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendLabels(vs ...string) {
+	m.appendlabels = append(m.appendlabels, vs...)
+}
+
+// AppendedLabels returns the values that were appended to the labels field in this mutation.
+func (m *UserMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// RemoveLabels removes all occurrences of vs from the labels field. Like AppendLabels, the values
+// are removed from the array stored in the database, and it cannot be used with SetLabels in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveLabels(vs ...string) {
+	m.removelabels = append(m.removelabels, vs...)
+}
+
+// RemovedLabels returns the values that were removed from the labels field in this mutation.
+func (m *UserMutation) RemovedLabels() ([]string, bool) {
+	if len(m.removelabels) == 0 {
+		return nil, false
+	}
+	return m.removelabels, true
+}
+
+// ClearLabels clears the value of labels.
+func (m *UserMutation) ClearLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.removelabels = nil
+	m.clearedFields[user.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the field labels was cleared in this mutation.
+func (m *UserMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[user.FieldLabels]
+	return ok
+}
+
+// ResetLabels reset all changes of the "labels" field.
+func (m *UserMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.removelabels = nil
+	delete(m.clearedFields, user.FieldLabels)
+}
+
+// SetAttrs sets the attrs field.
+func (m *UserMutation) SetAttrs(value map[string]string) {
+	m.attrs = &value
+}
+
+// Attrs returns the attrs value in the mutation.
+func (m *UserMutation) Attrs() (r map[string]string, exists bool) {
+	v := m.attrs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttrs returns the old attrs value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldAttrs(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttrs is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttrs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttrs: %w", err)
+	}
+	return oldValue.Attrs, nil
+}
+
+// MergeAttrs applies the given JSON merge-patch (RFC 7386) on the attrs field. Unlike SetAttrs,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetAttrs in the same mutation.
+func (m *UserMutation) MergeAttrs(patch json.RawMessage) {
+	m.mergeattrs = append(m.mergeattrs, patch)
+}
+
+// MergedAttrs returns the patches that were merged into the attrs field in this mutation.
+func (m *UserMutation) MergedAttrs() ([]json.RawMessage, bool) {
+	if len(m.mergeattrs) == 0 {
+		return nil, false
+	}
+	return m.mergeattrs, true
+}
+
+// ClearAttrs clears the value of attrs.
+func (m *UserMutation) ClearAttrs() {
+	m.attrs = nil
+	m.mergeattrs = nil
+	m.clearedFields[user.FieldAttrs] = struct{}{}
+}
+
+// AttrsCleared returns if the field attrs was cleared in this mutation.
+func (m *UserMutation) AttrsCleared() bool {
+	_, ok := m.clearedFields[user.FieldAttrs]
+	return ok
+}
+
+// ResetAttrs reset all changes of the "attrs" field.
+func (m *UserMutation) ResetAttrs() {
+	m.attrs = nil
+	m.mergeattrs = nil
+	delete(m.clearedFields, user.FieldAttrs)
+}
+
+// SetKeywords sets the keywords field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetKeywords(s []string) {
+	if s == nil {
+		m.ClearKeywords()
+		return
+	}
+	delete(m.clearedFields, user.FieldKeywords)
+	m.keywords = &s
+}
+
+// Keywords returns the keywords value in the mutation.
+func (m *UserMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old keywords value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldKeywords is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords appends vs to the keywords field. Unlike SetKeywords, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendKeywords(vs ...string) {
+	m.appendkeywords = append(m.appendkeywords, vs...)
+}
+
+// AppendedKeywords returns the values that were appended to the keywords field in this mutation.
+func (m *UserMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// RemoveKeywords removes all occurrences of vs from the keywords field. Like AppendKeywords, the values
+// are removed from the array stored in the database, and it cannot be used with SetKeywords in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveKeywords(vs ...string) {
+	m.removekeywords = append(m.removekeywords, vs...)
+}
+
+// RemovedKeywords returns the values that were removed from the keywords field in this mutation.
+func (m *UserMutation) RemovedKeywords() ([]string, bool) {
+	if len(m.removekeywords) == 0 {
+		return nil, false
+	}
+	return m.removekeywords, true
+}
+
+// ClearKeywords clears the value of keywords.
+func (m *UserMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.removekeywords = nil
+	m.clearedFields[user.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the field keywords was cleared in this mutation.
+func (m *UserMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[user.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords reset all changes of the "keywords" field.
+func (m *UserMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.removekeywords = nil
+	delete(m.clearedFields, user.FieldKeywords)
+}
+
+// SetCounts sets the counts field.
+// A nil value clears the field (stored as NULL), unlike an empty array.
+func (m *UserMutation) SetCounts(i []int) {
+	if i == nil {
+		m.ClearCounts()
+		return
+	}
+	delete(m.clearedFields, user.FieldCounts)
+	m.counts = &i
+}
+
+// Counts returns the counts value in the mutation.
+func (m *UserMutation) Counts() (r []int, exists bool) {
+	v := m.counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCounts returns the old counts value of the User.
+// If the User object wasn't provided to the builder, the object is fetched
+// from the database.
+// An error is returned if the mutation operation is not UpdateOne, or database query fails.
+func (m *UserMutation) OldCounts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCounts is allowed only on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCounts: %w", err)
+	}
+	return oldValue.Counts, nil
+}
+
+// AppendCounts appends vs to the counts field. Unlike SetCounts, the values are
+// appended to the array stored in the database, and the two cannot be used in the same mutation.
+func (m *UserMutation) AppendCounts(vs ...int) {
+	m.appendcounts = append(m.appendcounts, vs...)
+}
+
+// AppendedCounts returns the values that were appended to the counts field in this mutation.
+func (m *UserMutation) AppendedCounts() ([]int, bool) {
+	if len(m.appendcounts) == 0 {
+		return nil, false
+	}
+	return m.appendcounts, true
 }
 
-// ClearInts clears the value of ints.
-func (m *UserMutation) ClearInts() {
-	m.ints = nil
-	m.clearedFields[user.FieldInts] = struct{}{}
+// RemoveCounts removes all occurrences of vs from the counts field. Like AppendCounts, the values
+// are removed from the array stored in the database, and it cannot be used with SetCounts in the same
+// mutation. Values that do not exist in the array are ignored. Appended values are added after the removal.
+func (m *UserMutation) RemoveCounts(vs ...int) {
+	m.removecounts = append(m.removecounts, vs...)
 }
 
-// IntsCleared returns if the field ints was cleared in this mutation.
-func (m *UserMutation) IntsCleared() bool {
-	_, ok := m.clearedFields[user.FieldInts]
+// RemovedCounts returns the values that were removed from the counts field in this mutation.
+func (m *UserMutation) RemovedCounts() ([]int, bool) {
+	if len(m.removecounts) == 0 {
+		return nil, false
+	}
+	return m.removecounts, true
+}
+
+// ClearCounts clears the value of counts.
+func (m *UserMutation) ClearCounts() {
+	m.counts = nil
+	m.appendcounts = nil
+	m.removecounts = nil
+	m.clearedFields[user.FieldCounts] = struct{}{}
+}
+
+// CountsCleared returns if the field counts was cleared in this mutation.
+func (m *UserMutation) CountsCleared() bool {
+	_, ok := m.clearedFields[user.FieldCounts]
 	return ok
 }
 
-// ResetInts reset all changes of the "ints" field.
-func (m *UserMutation) ResetInts() {
-	m.ints = nil
-	delete(m.clearedFields, user.FieldInts)
+// ResetCounts reset all changes of the "counts" field.
+func (m *UserMutation) ResetCounts() {
+	m.counts = nil
+	m.appendcounts = nil
+	m.removecounts = nil
+	delete(m.clearedFields, user.FieldCounts)
 }
 
-// SetFloats sets the floats field.
-func (m *UserMutation) SetFloats(f []float64) {
-	m.floats = &f
+// SetProps sets the props field.
+func (m *UserMutation) SetProps(jm json.RawMessage) {
+	m.props = &jm
 }
 
-// Floats returns the floats value in the mutation.
-func (m *UserMutation) Floats() (r []float64, exists bool) {
-	v := m.floats
+// Props returns the props value in the mutation.
+func (m *UserMutation) Props() (r json.RawMessage, exists bool) {
+	v := m.props
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFloats returns the old floats value of the User.
+// OldProps returns the old props value of the User.
 // If the User object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *UserMutation) OldFloats(ctx context.Context) (v []float64, err error) {
+func (m *UserMutation) OldProps(ctx context.Context) (v json.RawMessage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldFloats is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldProps is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldFloats requires an ID field in the mutation")
+		return v, fmt.Errorf("OldProps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFloats: %w", err)
+		return v, fmt.Errorf("querying old value for OldProps: %w", err)
 	}
-	return oldValue.Floats, nil
+	return oldValue.Props, nil
 }
 
-// ClearFloats clears the value of floats.
-func (m *UserMutation) ClearFloats() {
-	m.floats = nil
-	m.clearedFields[user.FieldFloats] = struct{}{}
+// MergeProps applies the given JSON merge-patch (RFC 7386) on the props field. Unlike SetProps,
+// the patch is applied on the object stored in the database: existing keys are kept, and keys with null values
+// are removed. Patches are applied in order, and cannot be used with SetProps in the same mutation.
+func (m *UserMutation) MergeProps(patch json.RawMessage) {
+	m.mergeprops = append(m.mergeprops, patch)
 }
 
-// FloatsCleared returns if the field floats was cleared in this mutation.
-func (m *UserMutation) FloatsCleared() bool {
-	_, ok := m.clearedFields[user.FieldFloats]
+// MergedProps returns the patches that were merged into the props field in this mutation.
+func (m *UserMutation) MergedProps() ([]json.RawMessage, bool) {
+	if len(m.mergeprops) == 0 {
+		return nil, false
+	}
+	return m.mergeprops, true
+}
+
+// PatchProps applies the given JSON patch (RFC 6902) on the props field. The value is read, patched
+// and written back in the transaction of the mutation, and the mutation fails if one of the operations failed
+// (e.g. a "test" operation). Patches are applied in order, and cannot be used with SetProps in
+// the same mutation.
+func (m *UserMutation) PatchProps(ops []sql.JSONPatchOp) {
+	m.patchprops = append(m.patchprops, ops...)
+}
+
+// PatchedProps returns the JSON patch operations that were applied on the props field in this mutation.
+func (m *UserMutation) PatchedProps() ([]sql.JSONPatchOp, bool) {
+	if len(m.patchprops) == 0 {
+		return nil, false
+	}
+	return m.patchprops, true
+}
+
+// ClearProps clears the value of props.
+func (m *UserMutation) ClearProps() {
+	m.props = nil
+	m.mergeprops = nil
+	m.patchprops = nil
+	m.clearedFields[user.FieldProps] = struct{}{}
+}
+
+// PropsCleared returns if the field props was cleared in this mutation.
+func (m *UserMutation) PropsCleared() bool {
+	_, ok := m.clearedFields[user.FieldProps]
 	return ok
 }
 
-// ResetFloats reset all changes of the "floats" field.
-func (m *UserMutation) ResetFloats() {
-	m.floats = nil
-	delete(m.clearedFields, user.FieldFloats)
+// ResetProps reset all changes of the "props" field.
+func (m *UserMutation) ResetProps() {
+	m.props = nil
+	m.mergeprops = nil
+	m.patchprops = nil
+	delete(m.clearedFields, user.FieldProps)
 }
 
-// SetStrings sets the strings field.
-func (m *UserMutation) SetStrings(s []string) {
-	m.strings = &s
+// SetVersion sets the version field.
+func (m *UserMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Strings returns the strings value in the mutation.
-func (m *UserMutation) Strings() (r []string, exists bool) {
-	v := m.strings
+// Version returns the version value in the mutation.
+func (m *UserMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrings returns the old strings value of the User.
+// OldVersion returns the old version value of the User.
 // If the User object wasn't provided to the builder, the object is fetched
 // from the database.
 // An error is returned if the mutation operation is not UpdateOne, or database query fails.
-func (m *UserMutation) OldStrings(ctx context.Context) (v []string, err error) {
+func (m *UserMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStrings is allowed only on UpdateOne operations")
+		return v, fmt.Errorf("OldVersion is allowed only on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStrings requires an ID field in the mutation")
+		return v, fmt.Errorf("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrings: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Strings, nil
+	return oldValue.Version, nil
 }
 
-// ClearStrings clears the value of strings.
-func (m *UserMutation) ClearStrings() {
-	m.strings = nil
-	m.clearedFields[user.FieldStrings] = struct{}{}
+// AddVersion adds i to version.
+func (m *UserMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// StringsCleared returns if the field strings was cleared in this mutation.
-func (m *UserMutation) StringsCleared() bool {
-	_, ok := m.clearedFields[user.FieldStrings]
-	return ok
+// AddedVersion returns the value that was added to the version field in this mutation.
+func (m *UserMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetStrings reset all changes of the "strings" field.
-func (m *UserMutation) ResetStrings() {
-	m.strings = nil
-	delete(m.clearedFields, user.FieldStrings)
+// ResetVersion reset all changes of the "version" field.
+func (m *UserMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
 // Op returns the operation name.
@@ -442,25 +2133,85 @@ func (m *UserMutation) Type() string {
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 26)
 	if m.url != nil {
 		fields = append(fields, user.FieldURL)
 	}
+	if m.urls != nil {
+		fields = append(fields, user.FieldUrls)
+	}
 	if m.raw != nil {
 		fields = append(fields, user.FieldRaw)
 	}
+	if m.blob != nil {
+		fields = append(fields, user.FieldBlob)
+	}
 	if m.dirs != nil {
 		fields = append(fields, user.FieldDirs)
 	}
 	if m.ints != nil {
 		fields = append(fields, user.FieldInts)
 	}
+	if m.initial_ints != nil {
+		fields = append(fields, user.FieldInitialInts)
+	}
 	if m.floats != nil {
 		fields = append(fields, user.FieldFloats)
 	}
+	if m.nullable_ints != nil {
+		fields = append(fields, user.FieldNullableInts)
+	}
+	if m.null_ints != nil {
+		fields = append(fields, user.FieldNullInts)
+	}
+	if m.empty_ints != nil {
+		fields = append(fields, user.FieldEmptyInts)
+	}
+	if m.times != nil {
+		fields = append(fields, user.FieldTimes)
+	}
+	if m.meta != nil {
+		fields = append(fields, user.FieldMeta)
+	}
+	if m.secrets != nil {
+		fields = append(fields, user.FieldSecrets)
+	}
 	if m.strings != nil {
 		fields = append(fields, user.FieldStrings)
 	}
+	if m.tags != nil {
+		fields = append(fields, user.FieldTags)
+	}
+	if m.point != nil {
+		fields = append(fields, user.FieldPoint)
+	}
+	if m.payload != nil {
+		fields = append(fields, user.FieldPayload)
+	}
+	if m.doc != nil {
+		fields = append(fields, user.FieldDoc)
+	}
+	if m.pii != nil {
+		fields = append(fields, user.FieldPii)
+	}
+	if m.labels != nil {
+		fields = append(fields, user.FieldLabels)
+	}
+	if m.attrs != nil {
+		fields = append(fields, user.FieldAttrs)
+	}
+	if m.keywords != nil {
+		fields = append(fields, user.FieldKeywords)
+	}
+	if m.counts != nil {
+		fields = append(fields, user.FieldCounts)
+	}
+	if m.props != nil {
+		fields = append(fields, user.FieldProps)
+	}
+	if m.version != nil {
+		fields = append(fields, user.FieldVersion)
+	}
 	return fields
 }
 
@@ -471,16 +2222,56 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case user.FieldURL:
 		return m.URL()
+	case user.FieldUrls:
+		return m.Urls()
 	case user.FieldRaw:
 		return m.Raw()
+	case user.FieldBlob:
+		return m.Blob()
 	case user.FieldDirs:
 		return m.Dirs()
 	case user.FieldInts:
 		return m.Ints()
+	case user.FieldInitialInts:
+		return m.InitialInts()
 	case user.FieldFloats:
 		return m.Floats()
+	case user.FieldNullableInts:
+		return m.NullableInts()
+	case user.FieldNullInts:
+		return m.NullInts()
+	case user.FieldEmptyInts:
+		return m.EmptyInts()
+	case user.FieldTimes:
+		return m.Times()
+	case user.FieldMeta:
+		return m.Meta()
+	case user.FieldSecrets:
+		return m.Secrets()
 	case user.FieldStrings:
 		return m.Strings()
+	case user.FieldTags:
+		return m.Tags()
+	case user.FieldPoint:
+		return m.Point()
+	case user.FieldPayload:
+		return m.Payload()
+	case user.FieldDoc:
+		return m.Doc()
+	case user.FieldPii:
+		return m.Pii()
+	case user.FieldLabels:
+		return m.Labels()
+	case user.FieldAttrs:
+		return m.Attrs()
+	case user.FieldKeywords:
+		return m.Keywords()
+	case user.FieldCounts:
+		return m.Counts()
+	case user.FieldProps:
+		return m.Props()
+	case user.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -492,16 +2283,56 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 	switch name {
 	case user.FieldURL:
 		return m.OldURL(ctx)
+	case user.FieldUrls:
+		return m.OldUrls(ctx)
 	case user.FieldRaw:
 		return m.OldRaw(ctx)
+	case user.FieldBlob:
+		return m.OldBlob(ctx)
 	case user.FieldDirs:
 		return m.OldDirs(ctx)
 	case user.FieldInts:
 		return m.OldInts(ctx)
+	case user.FieldInitialInts:
+		return m.OldInitialInts(ctx)
 	case user.FieldFloats:
 		return m.OldFloats(ctx)
+	case user.FieldNullableInts:
+		return m.OldNullableInts(ctx)
+	case user.FieldNullInts:
+		return m.OldNullInts(ctx)
+	case user.FieldEmptyInts:
+		return m.OldEmptyInts(ctx)
+	case user.FieldTimes:
+		return m.OldTimes(ctx)
+	case user.FieldMeta:
+		return m.OldMeta(ctx)
+	case user.FieldSecrets:
+		return m.OldSecrets(ctx)
 	case user.FieldStrings:
 		return m.OldStrings(ctx)
+	case user.FieldTags:
+		return m.OldTags(ctx)
+	case user.FieldPoint:
+		return m.OldPoint(ctx)
+	case user.FieldPayload:
+		return m.OldPayload(ctx)
+	case user.FieldDoc:
+		return m.OldDoc(ctx)
+	case user.FieldPii:
+		return m.OldPii(ctx)
+	case user.FieldLabels:
+		return m.OldLabels(ctx)
+	case user.FieldAttrs:
+		return m.OldAttrs(ctx)
+	case user.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case user.FieldCounts:
+		return m.OldCounts(ctx)
+	case user.FieldProps:
+		return m.OldProps(ctx)
+	case user.FieldVersion:
+		return m.OldVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -518,6 +2349,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetURL(v)
 		return nil
+	case user.FieldUrls:
+		v, ok := value.([]*url.URL)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrls(v)
+		return nil
 	case user.FieldRaw:
 		v, ok := value.(json.RawMessage)
 		if !ok {
@@ -525,6 +2363,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetRaw(v)
 		return nil
+	case user.FieldBlob:
+		v, ok := value.([]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlob(v)
+		return nil
 	case user.FieldDirs:
 		v, ok := value.([]http.Dir)
 		if !ok {
@@ -539,6 +2384,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetInts(v)
 		return nil
+	case user.FieldInitialInts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialInts(v)
+		return nil
 	case user.FieldFloats:
 		v, ok := value.([]float64)
 		if !ok {
@@ -546,6 +2398,48 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetFloats(v)
 		return nil
+	case user.FieldNullableInts:
+		v, ok := value.(*[]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNullableInts(v)
+		return nil
+	case user.FieldNullInts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNullInts(v)
+		return nil
+	case user.FieldEmptyInts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmptyInts(v)
+		return nil
+	case user.FieldTimes:
+		v, ok := value.([]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimes(v)
+		return nil
+	case user.FieldMeta:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeta(v)
+		return nil
+	case user.FieldSecrets:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecrets(v)
+		return nil
 	case user.FieldStrings:
 		v, ok := value.([]string)
 		if !ok {
@@ -553,6 +2447,83 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStrings(v)
 		return nil
+	case user.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case user.FieldPoint:
+		v, ok := value.(schema.Point)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoint(v)
+		return nil
+	case user.FieldPayload:
+		v, ok := value.(schema.Payload)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case user.FieldDoc:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoc(v)
+		return nil
+	case user.FieldPii:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPii(v)
+		return nil
+	case user.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case user.FieldAttrs:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttrs(v)
+		return nil
+	case user.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case user.FieldCounts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCounts(v)
+		return nil
+	case user.FieldProps:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProps(v)
+		return nil
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -560,13 +2531,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, user.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
@@ -575,6 +2554,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -586,21 +2572,75 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldURL) {
 		fields = append(fields, user.FieldURL)
 	}
+	if m.FieldCleared(user.FieldUrls) {
+		fields = append(fields, user.FieldUrls)
+	}
 	if m.FieldCleared(user.FieldRaw) {
 		fields = append(fields, user.FieldRaw)
 	}
+	if m.FieldCleared(user.FieldBlob) {
+		fields = append(fields, user.FieldBlob)
+	}
 	if m.FieldCleared(user.FieldDirs) {
 		fields = append(fields, user.FieldDirs)
 	}
 	if m.FieldCleared(user.FieldInts) {
 		fields = append(fields, user.FieldInts)
 	}
+	if m.FieldCleared(user.FieldInitialInts) {
+		fields = append(fields, user.FieldInitialInts)
+	}
 	if m.FieldCleared(user.FieldFloats) {
 		fields = append(fields, user.FieldFloats)
 	}
+	if m.FieldCleared(user.FieldNullableInts) {
+		fields = append(fields, user.FieldNullableInts)
+	}
+	if m.FieldCleared(user.FieldNullInts) {
+		fields = append(fields, user.FieldNullInts)
+	}
+	if m.FieldCleared(user.FieldTimes) {
+		fields = append(fields, user.FieldTimes)
+	}
+	if m.FieldCleared(user.FieldMeta) {
+		fields = append(fields, user.FieldMeta)
+	}
+	if m.FieldCleared(user.FieldSecrets) {
+		fields = append(fields, user.FieldSecrets)
+	}
 	if m.FieldCleared(user.FieldStrings) {
 		fields = append(fields, user.FieldStrings)
 	}
+	if m.FieldCleared(user.FieldTags) {
+		fields = append(fields, user.FieldTags)
+	}
+	if m.FieldCleared(user.FieldPoint) {
+		fields = append(fields, user.FieldPoint)
+	}
+	if m.FieldCleared(user.FieldPayload) {
+		fields = append(fields, user.FieldPayload)
+	}
+	if m.FieldCleared(user.FieldDoc) {
+		fields = append(fields, user.FieldDoc)
+	}
+	if m.FieldCleared(user.FieldPii) {
+		fields = append(fields, user.FieldPii)
+	}
+	if m.FieldCleared(user.FieldLabels) {
+		fields = append(fields, user.FieldLabels)
+	}
+	if m.FieldCleared(user.FieldAttrs) {
+		fields = append(fields, user.FieldAttrs)
+	}
+	if m.FieldCleared(user.FieldKeywords) {
+		fields = append(fields, user.FieldKeywords)
+	}
+	if m.FieldCleared(user.FieldCounts) {
+		fields = append(fields, user.FieldCounts)
+	}
+	if m.FieldCleared(user.FieldProps) {
+		fields = append(fields, user.FieldProps)
+	}
 	return fields
 }
 
@@ -618,21 +2658,75 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldURL:
 		m.ClearURL()
 		return nil
+	case user.FieldUrls:
+		m.ClearUrls()
+		return nil
 	case user.FieldRaw:
 		m.ClearRaw()
 		return nil
+	case user.FieldBlob:
+		m.ClearBlob()
+		return nil
 	case user.FieldDirs:
 		m.ClearDirs()
 		return nil
 	case user.FieldInts:
 		m.ClearInts()
 		return nil
+	case user.FieldInitialInts:
+		m.ClearInitialInts()
+		return nil
 	case user.FieldFloats:
 		m.ClearFloats()
 		return nil
+	case user.FieldNullableInts:
+		m.ClearNullableInts()
+		return nil
+	case user.FieldNullInts:
+		m.ClearNullInts()
+		return nil
+	case user.FieldTimes:
+		m.ClearTimes()
+		return nil
+	case user.FieldMeta:
+		m.ClearMeta()
+		return nil
+	case user.FieldSecrets:
+		m.ClearSecrets()
+		return nil
 	case user.FieldStrings:
 		m.ClearStrings()
 		return nil
+	case user.FieldTags:
+		m.ClearTags()
+		return nil
+	case user.FieldPoint:
+		m.ClearPoint()
+		return nil
+	case user.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case user.FieldDoc:
+		m.ClearDoc()
+		return nil
+	case user.FieldPii:
+		m.ClearPii()
+		return nil
+	case user.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case user.FieldAttrs:
+		m.ClearAttrs()
+		return nil
+	case user.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	case user.FieldCounts:
+		m.ClearCounts()
+		return nil
+	case user.FieldProps:
+		m.ClearProps()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -645,21 +2739,81 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldURL:
 		m.ResetURL()
 		return nil
+	case user.FieldUrls:
+		m.ResetUrls()
+		return nil
 	case user.FieldRaw:
 		m.ResetRaw()
 		return nil
+	case user.FieldBlob:
+		m.ResetBlob()
+		return nil
 	case user.FieldDirs:
 		m.ResetDirs()
 		return nil
 	case user.FieldInts:
 		m.ResetInts()
 		return nil
+	case user.FieldInitialInts:
+		m.ResetInitialInts()
+		return nil
 	case user.FieldFloats:
 		m.ResetFloats()
 		return nil
+	case user.FieldNullableInts:
+		m.ResetNullableInts()
+		return nil
+	case user.FieldNullInts:
+		m.ResetNullInts()
+		return nil
+	case user.FieldEmptyInts:
+		m.ResetEmptyInts()
+		return nil
+	case user.FieldTimes:
+		m.ResetTimes()
+		return nil
+	case user.FieldMeta:
+		m.ResetMeta()
+		return nil
+	case user.FieldSecrets:
+		m.ResetSecrets()
+		return nil
 	case user.FieldStrings:
 		m.ResetStrings()
 		return nil
+	case user.FieldTags:
+		m.ResetTags()
+		return nil
+	case user.FieldPoint:
+		m.ResetPoint()
+		return nil
+	case user.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case user.FieldDoc:
+		m.ResetDoc()
+		return nil
+	case user.FieldPii:
+		m.ResetPii()
+		return nil
+	case user.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case user.FieldAttrs:
+		m.ResetAttrs()
+		return nil
+	case user.FieldKeywords:
+		m.ResetKeywords()
+		return nil
+	case user.FieldCounts:
+		m.ResetCounts()
+		return nil
+	case user.FieldProps:
+		m.ResetProps()
+		return nil
+	case user.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
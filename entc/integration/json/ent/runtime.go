@@ -6,8 +6,62 @@
 
 package ent
 
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
+	"github.com/facebook/ent/entc/integration/json/ent/user"
+)
+
 // The init function reads all schema descriptors with runtime
 // code (default values, validators or hooks) and stitches it
 // to their package variables.
 func init() {
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescRaw is the schema descriptor for raw field.
+	userDescRaw := userFields[2].Descriptor()
+	// user.RawValidator is a validator for the "raw" field. It is called by the builders before save.
+	user.RawValidator = userDescRaw.Validators[0].(func(json.RawMessage) error)
+	// userDescBlob is the schema descriptor for blob field.
+	userDescBlob := userFields[3].Descriptor()
+	// user.BlobMarshaler is the custom marshaler of the "blob" field. It is called by the builders before save.
+	user.BlobMarshaler = userDescBlob.Marshaler.(func([]uint8) ([]byte, error))
+	// user.BlobUnmarshaler is the custom unmarshaler of the "blob" field. It is called when the field is scanned.
+	user.BlobUnmarshaler = userDescBlob.Unmarshaler.(func([]byte, *[]uint8) error)
+	// userDescDirs is the schema descriptor for dirs field.
+	userDescDirs := userFields[4].Descriptor()
+	// user.DefaultDirs holds the default value on creation for the dirs field.
+	user.DefaultDirs = userDescDirs.Default.([]http.Dir)
+	// user.DirsMarshaler is the custom marshaler of the "dirs" field. It is called by the builders before save.
+	user.DirsMarshaler = userDescDirs.Marshaler.(func([]http.Dir) ([]byte, error))
+	// user.DirsUnmarshaler is the custom unmarshaler of the "dirs" field. It is called when the field is scanned.
+	user.DirsUnmarshaler = userDescDirs.Unmarshaler.(func([]byte, *[]http.Dir) error)
+	// userDescEmptyInts is the schema descriptor for empty_ints field.
+	userDescEmptyInts := userFields[10].Descriptor()
+	// user.DefaultEmptyInts holds the default value on creation for the empty_ints field.
+	user.DefaultEmptyInts = userDescEmptyInts.Default.([]int)
+	// userDescStrings is the schema descriptor for strings field.
+	userDescStrings := userFields[14].Descriptor()
+	// user.StringsValidator is a validator for the "strings" field. It is called by the builders before save.
+	user.StringsValidator = userDescStrings.Validators[0].(func([]string) error)
+	// userDescTags is the schema descriptor for tags field.
+	userDescTags := userFields[15].Descriptor()
+	// user.TagsValidator is a validator for the "tags" field. It is called by the builders before save.
+	user.TagsValidator = userDescTags.Validators[0].(func([]string) error)
+	// userDescPayload is the schema descriptor for payload field.
+	userDescPayload := userFields[17].Descriptor()
+	// user.PayloadMarshaler is the custom marshaler of the "payload" field. It is called by the builders before save.
+	user.PayloadMarshaler = userDescPayload.Marshaler.(func(schema.Payload) ([]byte, error))
+	// user.PayloadUnmarshaler is the custom unmarshaler of the "payload" field. It is called when the field is scanned.
+	user.PayloadUnmarshaler = userDescPayload.Unmarshaler.(func([]byte, *schema.Payload) error)
+	// userDescCounts is the schema descriptor for counts field.
+	userDescCounts := userFields[23].Descriptor()
+	// user.CountsScanCoerce transforms the stored values of the "counts" field before they are decoded. It is called when the field is scanned.
+	user.CountsScanCoerce = userDescCounts.ScanCoerce.(func(json.RawMessage) (json.RawMessage, error))
+	// userDescVersion is the schema descriptor for version field.
+	userDescVersion := userFields[25].Descriptor()
+	// user.DefaultVersion holds the default value on creation for the version field.
+	user.DefaultVersion = userDescVersion.Default.(int)
 }
@@ -5,12 +5,22 @@
 package schema
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/facebook/ent"
+	"github.com/facebook/ent/dialect"
+	"github.com/facebook/ent/dialect/entsql"
 	"github.com/facebook/ent/schema/field"
+	"github.com/facebook/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -23,15 +33,213 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.JSON("url", &url.URL{}).
 			Optional(),
+		field.JSON("urls", []*url.URL{}).
+			Optional().
+			StorageKey("url_list"),
 		field.JSON("raw", json.RawMessage{}).
-			Optional(),
+			Optional().
+			MaxLen(65535).
+			Comment("free-form metadata").
+			Annotations(entsql.Annotation{Type: "jsonb", Hashable: true}),
+		field.JSON("blob", []byte{}).
+			Optional().
+			Raw(),
 		field.JSON("dirs", []http.Dir{}).
-			Optional(),
+			Optional().
+			Default([]http.Dir{"/tmp"}).
+			Marshaler(func(dirs []http.Dir) ([]byte, error) {
+				var b bytes.Buffer
+				enc := json.NewEncoder(&b)
+				enc.SetEscapeHTML(false)
+				if err := enc.Encode(dirs); err != nil {
+					return nil, err
+				}
+				return bytes.TrimSpace(b.Bytes()), nil
+			}).
+			Unmarshaler(func(b []byte, dirs *[]http.Dir) error {
+				// Accept also a single directory, stored as a JSON string.
+				var dir http.Dir
+				if err := json.Unmarshal(b, &dir); err == nil {
+					*dirs = []http.Dir{dir}
+					return nil
+				}
+				return json.Unmarshal(b, dirs)
+			}).
+			Annotations(entsql.Backfill()),
 		field.Ints("ints").
-			Optional(),
+			Optional().
+			Annotations(entsql.Annotation{Incremental: true, Hashable: true}),
+		// InitialInts can be set only on creation.
+		field.Ints("initial_ints").
+			Optional().
+			Immutable(),
 		field.Floats("floats").
+			Optional().
+			Annotations(entsql.NonFinite()),
+		field.JSON("nullable_ints", &[]int{}).
+			Optional(),
+		// NullInts and EmptyInts store nil values as JSON null and
+		// as an empty array, unlike ints that stores them as NULL.
+		field.Ints("null_ints").
+			Optional().
+			EmitNull(true),
+		field.Ints("empty_ints").
+			Default([]int{}).
+			EmitNull(false).
+			Annotations(entsql.DefaultExpr("'[]'")),
+		field.JSON("times", []time.Time{}).
 			Optional(),
+		field.JSON("meta", map[string]string{}).
+			Optional(),
+		field.JSON("secrets", map[string]string{}).
+			Optional().
+			Sensitive(),
 		field.Strings("strings").
+			Optional().
+			Validate(func(s []string) error {
+				if len(s) == 0 {
+					return errors.New("empty list")
+				}
+				return nil
+			}),
+		field.JSON("tags", []string{}).
+			Optional().
+			Values("a", "b", "c"),
+		// Point is stored using its driver.Valuer implementation.
+		field.JSON("point", Point{}).
 			Optional(),
+		field.JSON("payload", new(Payload)).
+			Optional().
+			Discriminator("type", map[string]reflect.Type{
+				"created": reflect.TypeOf(&Created{}),
+				"deleted": reflect.TypeOf(&Deleted{}),
+			}),
+		// Doc is stored gzip-compressed in a binary column.
+		field.JSON("doc", json.RawMessage{}).
+			Optional().
+			Annotations(entsql.Compress("gzip")),
+		// PII is encrypted at rest using the "pii" key of the client encrypter.
+		field.JSON("pii", json.RawMessage{}).
+			Optional().
+			Annotations(entsql.Encrypt("pii")),
+		// Labels defaults to an empty array also in the database.
+		field.JSON("labels", []string{}).
+			Optional().
+			Annotations(entsql.DefaultExpr("'[]'")),
+		// Attrs and keywords are stored as text in MySQL (for compatibility
+		// with MySQL 5.6), and their predicates fall back to LIKE matching.
+		field.JSON("attrs", map[string]string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "LONGTEXT"}),
+		field.Strings("keywords").
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "LONGTEXT"}),
+		// Counts was changed from []string to []int, and the values
+		// of old rows are converted to the new shape on scan.
+		field.Ints("counts").
+			Optional().
+			ScanCoerce(stringsToInts),
+		// Props is stored as text in a canonical form, with sorted object
+		// keys, in order to keep its stored bytes stable between updates.
+		field.JSON("props", json.RawMessage{}).
+			Optional().
+			SchemaType(map[string]string{dialect.MySQL: "LONGTEXT", dialect.Postgres: "text"}).
+			Annotations(entsql.SortedKeys()),
+		// Version is used for optimistic locking in tests.
+		field.Int("version").
+			Default(0),
+	}
+}
+
+// stringsToInts converts the elements of a JSON array that were stored
+// as strings (e.g. ["1","2"]) to numbers. Values in the new shape are
+// returned as is.
+func stringsToInts(raw json.RawMessage) (json.RawMessage, error) {
+	var vs []interface{}
+	if err := json.Unmarshal(raw, &vs); err != nil {
+		return nil, err
+	}
+	changed := false
+	for i, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("converting %q to int: %w", s, err)
+		}
+		vs[i], changed = n, true
+	}
+	if !changed {
+		return raw, nil
+	}
+	return json.Marshal(vs)
+}
+
+// Point is a 2D point that is stored as a JSON array (e.g. [1,2]),
+// instead of the JSON object that encoding/json produces for it.
+type Point struct {
+	X, Y float64
+}
+
+// Value implements the driver.Valuer interface.
+func (p Point) Value() (driver.Value, error) {
+	return json.Marshal([]float64{p.X, p.Y})
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *Point) Scan(v interface{}) error {
+	var b []byte
+	switch v := v.(type) {
+	case nil:
+		*p = Point{}
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("unexpected type %T for point", v)
+	}
+	var xy []float64
+	if err := json.Unmarshal(b, &xy); err != nil {
+		return err
+	}
+	if len(xy) != 2 {
+		return fmt.Errorf("unexpected point length %d", len(xy))
+	}
+	p.X, p.Y = xy[0], xy[1]
+	return nil
+}
+
+// Payload is the payload of an event, that is stored with
+// the name of its concrete type in the "type" key.
+type Payload interface {
+	payload()
+}
+
+// Created is the payload of a created event.
+type Created struct {
+	Name string `json:"name"`
+}
+
+// Deleted is the payload of a deleted event.
+type Deleted struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func (*Created) payload() {}
+func (*Deleted) payload() {}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// GIN indexes are created only in PostgreSQL.
+		index.Fields("url").
+			Annotations(entsql.IndexType("GIN")),
+		// JSON path indexes are created only in MySQL.
+		entsql.JSONIndex("url", "$.Scheme"),
 	}
 }
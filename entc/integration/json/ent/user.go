@@ -7,13 +7,18 @@
 package ent
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
 )
 
@@ -24,16 +29,56 @@ type User struct {
 	ID int `json:"id,omitempty"`
 	// URL holds the value of the "url" field.
 	URL *url.URL `json:"url,omitempty"`
+	// Urls holds the value of the "urls" field.
+	Urls []*url.URL `json:"urls,omitempty"`
 	// Raw holds the value of the "raw" field.
 	Raw json.RawMessage `json:"raw,omitempty"`
+	// Blob holds the value of the "blob" field.
+	Blob []uint8 `json:"blob,omitempty"`
 	// Dirs holds the value of the "dirs" field.
 	Dirs []http.Dir `json:"dirs,omitempty"`
 	// Ints holds the value of the "ints" field.
 	Ints []int `json:"ints,omitempty"`
+	// InitialInts holds the value of the "initial_ints" field.
+	InitialInts []int `json:"initial_ints,omitempty"`
 	// Floats holds the value of the "floats" field.
 	Floats []float64 `json:"floats,omitempty"`
+	// NullableInts holds the value of the "nullable_ints" field.
+	NullableInts *[]int `json:"nullable_ints,omitempty"`
+	// NullInts holds the value of the "null_ints" field.
+	NullInts []int `json:"null_ints,omitempty"`
+	// EmptyInts holds the value of the "empty_ints" field.
+	EmptyInts []int `json:"empty_ints,omitempty"`
+	// Times holds the value of the "times" field.
+	Times []time.Time `json:"times,omitempty"`
+	// Meta holds the value of the "meta" field.
+	Meta map[string]string `json:"meta,omitempty"`
+	// Secrets holds the value of the "secrets" field.
+	Secrets map[string]string `json:"-"`
 	// Strings holds the value of the "strings" field.
 	Strings []string `json:"strings,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Point holds the value of the "point" field.
+	Point schema.Point `json:"point,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload schema.Payload `json:"payload,omitempty"`
+	// Doc holds the value of the "doc" field.
+	Doc json.RawMessage `json:"doc,omitempty"`
+	// Pii holds the value of the "pii" field.
+	Pii json.RawMessage `json:"pii,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// Attrs holds the value of the "attrs" field.
+	Attrs map[string]string `json:"attrs,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// Counts holds the value of the "counts" field.
+	Counts []int `json:"counts,omitempty"`
+	// Props holds the value of the "props" field.
+	Props json.RawMessage `json:"props,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -41,11 +86,31 @@ func (*User) scanValues() []interface{} {
 	return []interface{}{
 		&sql.NullInt64{}, // id
 		&[]byte{},        // url
+		&[]byte{},        // urls
 		&[]byte{},        // raw
+		&[]byte{},        // blob
 		&[]byte{},        // dirs
 		&[]byte{},        // ints
+		&[]byte{},        // initial_ints
 		&[]byte{},        // floats
+		&[]byte{},        // nullable_ints
+		&[]byte{},        // null_ints
+		&[]byte{},        // empty_ints
+		&[]byte{},        // times
+		&[]byte{},        // meta
+		&[]byte{},        // secrets
 		&[]byte{},        // strings
+		&[]byte{},        // tags
+		&schema.Point{},  // point
+		&[]byte{},        // payload
+		&[]byte{},        // doc
+		&[]byte{},        // pii
+		&[]byte{},        // labels
+		&[]byte{},        // attrs
+		&[]byte{},        // keywords
+		&[]byte{},        // counts
+		&[]byte{},        // props
+		&sql.NullInt64{}, // version
 	}
 }
 
@@ -65,50 +130,290 @@ func (u *User) assignValues(values ...interface{}) error {
 	if value, ok := values[0].(*[]byte); !ok {
 		return fmt.Errorf("unexpected type %T for field url", values[0])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.URL); err != nil {
-			return fmt.Errorf("unmarshal field url: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field url: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.URL); err != nil {
+			return fmt.Errorf("unmarshal field url: %w", err)
 		}
 	}
 
 	if value, ok := values[1].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field raw", values[1])
+		return fmt.Errorf("unexpected type %T for field urls", values[1])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Raw); err != nil {
-			return fmt.Errorf("unmarshal field raw: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field urls: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Urls); err != nil {
+			return fmt.Errorf("unmarshal field urls: %w", err)
 		}
 	}
 
 	if value, ok := values[2].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field dirs", values[2])
+		return fmt.Errorf("unexpected type %T for field raw", values[2])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Dirs); err != nil {
-			return fmt.Errorf("unmarshal field dirs: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field raw: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Raw); err != nil {
+			return fmt.Errorf("unmarshal field raw: %w", err)
 		}
 	}
 
 	if value, ok := values[3].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field ints", values[3])
+		return fmt.Errorf("unexpected type %T for field blob", values[3])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Ints); err != nil {
-			return fmt.Errorf("unmarshal field ints: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field blob: %w", err)
+		}
+		if err := user.BlobUnmarshaler(*value, &u.Blob); err != nil {
+			return fmt.Errorf("unmarshal field blob: %w", err)
 		}
 	}
 
 	if value, ok := values[4].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field floats", values[4])
+		return fmt.Errorf("unexpected type %T for field dirs", values[4])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Floats); err != nil {
-			return fmt.Errorf("unmarshal field floats: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field dirs: %w", err)
+		}
+		if err := user.DirsUnmarshaler(*value, &u.Dirs); err != nil {
+			return fmt.Errorf("unmarshal field dirs: %w", err)
 		}
 	}
 
 	if value, ok := values[5].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field strings", values[5])
+		return fmt.Errorf("unexpected type %T for field ints", values[5])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field ints: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Ints); err != nil {
+			return fmt.Errorf("unmarshal field ints: %w", err)
+		}
+	}
+
+	if value, ok := values[6].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field initial_ints", values[6])
 	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Strings); err != nil {
-			return fmt.Errorf("unmarshal field strings: %v", err)
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field initial_ints: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.InitialInts); err != nil {
+			return fmt.Errorf("unmarshal field initial_ints: %w", err)
 		}
 	}
+
+	if value, ok := values[7].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field floats", values[7])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field floats: %w", err)
+		}
+		if err := sql.UnmarshalNonFinite(*value, &u.Floats); err != nil {
+			return fmt.Errorf("unmarshal field floats: %w", err)
+		}
+	}
+
+	if value, ok := values[8].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field nullable_ints", values[8])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field nullable_ints: %w", err)
+		}
+		// Unlike SQL NULL, JSON null is scanned as a pointer to a nil value.
+		u.NullableInts = new([]int)
+		if err := u.unmarshalJSON(*value, u.NullableInts); err != nil {
+			return fmt.Errorf("unmarshal field nullable_ints: %w", err)
+		}
+	}
+
+	if value, ok := values[9].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field null_ints", values[9])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field null_ints: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.NullInts); err != nil {
+			return fmt.Errorf("unmarshal field null_ints: %w", err)
+		}
+	}
+
+	if value, ok := values[10].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field empty_ints", values[10])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field empty_ints: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.EmptyInts); err != nil {
+			return fmt.Errorf("unmarshal field empty_ints: %w", err)
+		}
+	}
+
+	if value, ok := values[11].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field times", values[11])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field times: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Times); err != nil {
+			return fmt.Errorf("unmarshal field times: %w", err)
+		}
+	}
+
+	if value, ok := values[12].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field meta", values[12])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field meta: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Meta); err != nil {
+			return fmt.Errorf("unmarshal field meta: %w", err)
+		}
+	}
+
+	if value, ok := values[13].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field secrets", values[13])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field secrets: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Secrets); err != nil {
+			return fmt.Errorf("unmarshal field secrets: %w", err)
+		}
+	}
+
+	if value, ok := values[14].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field strings", values[14])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field strings: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Strings); err != nil {
+			return fmt.Errorf("unmarshal field strings: %w", err)
+		}
+	}
+
+	if value, ok := values[15].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field tags", values[15])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field tags: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Tags); err != nil {
+			return fmt.Errorf("unmarshal field tags: %w", err)
+		}
+	}
+	if value, ok := values[16].(*schema.Point); !ok {
+		return fmt.Errorf("unexpected type %T for field point", values[16])
+	} else if value != nil {
+		u.Point = *value
+	}
+
+	if value, ok := values[17].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field payload", values[17])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field payload: %w", err)
+		}
+		if err := user.PayloadUnmarshaler(*value, &u.Payload); err != nil {
+			return fmt.Errorf("unmarshal field payload: %w", err)
+		}
+	}
+
+	if value, ok := values[18].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field doc", values[18])
+	} else if value != nil && len(*value) > 0 {
+		data, err := sql.Decompress("gzip", *value)
+		if err != nil {
+			return fmt.Errorf("decompress field doc: %w", err)
+		}
+		if err := u.checkJSONDepth(data); err != nil {
+			return fmt.Errorf("unmarshal field doc: %w", err)
+		}
+		if err := u.unmarshalJSON(data, &u.Doc); err != nil {
+			return fmt.Errorf("unmarshal field doc: %w", err)
+		}
+	}
+
+	if value, ok := values[19].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field pii", values[19])
+	} else if value != nil && len(*value) > 0 {
+		plaintext, err := u.decryptJSON("pii", *value)
+		if err != nil {
+			return fmt.Errorf("decrypt field pii: %w", err)
+		}
+		if err := u.checkJSONDepth(plaintext); err != nil {
+			return fmt.Errorf("unmarshal field pii: %w", err)
+		}
+		if err := u.unmarshalJSON(plaintext, &u.Pii); err != nil {
+			return fmt.Errorf("unmarshal field pii: %w", err)
+		}
+	}
+
+	if value, ok := values[20].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field labels", values[20])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field labels: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Labels); err != nil {
+			return fmt.Errorf("unmarshal field labels: %w", err)
+		}
+	}
+
+	if value, ok := values[21].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field attrs", values[21])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field attrs: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Attrs); err != nil {
+			return fmt.Errorf("unmarshal field attrs: %w", err)
+		}
+	}
+
+	if value, ok := values[22].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field keywords", values[22])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field keywords: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Keywords); err != nil {
+			return fmt.Errorf("unmarshal field keywords: %w", err)
+		}
+	}
+
+	if value, ok := values[23].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field counts", values[23])
+	} else if value != nil && len(*value) > 0 {
+		coerced, err := user.CountsScanCoerce(*value)
+		if err != nil {
+			return fmt.Errorf("coerce field counts: %w", err)
+		}
+		if err := u.checkJSONDepth(coerced); err != nil {
+			return fmt.Errorf("unmarshal field counts: %w", err)
+		}
+		if err := u.unmarshalJSON(coerced, &u.Counts); err != nil {
+			return fmt.Errorf("unmarshal field counts: %w", err)
+		}
+	}
+
+	if value, ok := values[24].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field props", values[24])
+	} else if value != nil && len(*value) > 0 {
+		if err := u.checkJSONDepth(*value); err != nil {
+			return fmt.Errorf("unmarshal field props: %w", err)
+		}
+		if err := u.unmarshalJSON(*value, &u.Props); err != nil {
+			return fmt.Errorf("unmarshal field props: %w", err)
+		}
+	}
+	if value, ok := values[25].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field version", values[25])
+	} else if value.Valid {
+		u.Version = int(value.Int64)
+	}
 	return nil
 }
 
@@ -136,21 +441,576 @@ func (u *User) String() string {
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v", u.ID))
 	builder.WriteString(", url=")
-	builder.WriteString(fmt.Sprintf("%v", u.URL))
+	builder.WriteString(formatJSON(u.prettyJSON, u.URL))
+	builder.WriteString(", urls=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Urls))
 	builder.WriteString(", raw=")
-	builder.WriteString(fmt.Sprintf("%v", u.Raw))
+	builder.WriteString(formatJSON(u.prettyJSON, u.Raw))
+	builder.WriteString(", blob=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Blob))
 	builder.WriteString(", dirs=")
-	builder.WriteString(fmt.Sprintf("%v", u.Dirs))
+	builder.WriteString(formatJSON(u.prettyJSON, u.Dirs))
 	builder.WriteString(", ints=")
-	builder.WriteString(fmt.Sprintf("%v", u.Ints))
+	builder.WriteString(formatJSON(u.prettyJSON, u.Ints))
+	builder.WriteString(", initial_ints=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.InitialInts))
 	builder.WriteString(", floats=")
-	builder.WriteString(fmt.Sprintf("%v", u.Floats))
+	builder.WriteString(formatJSON(u.prettyJSON, u.Floats))
+	builder.WriteString(", nullable_ints=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.NullableInts))
+	builder.WriteString(", null_ints=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.NullInts))
+	builder.WriteString(", empty_ints=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.EmptyInts))
+	builder.WriteString(", times=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Times))
+	builder.WriteString(", meta=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Meta))
+	builder.WriteString(", secrets=<sensitive>")
 	builder.WriteString(", strings=")
-	builder.WriteString(fmt.Sprintf("%v", u.Strings))
+	builder.WriteString(formatJSON(u.prettyJSON, u.Strings))
+	builder.WriteString(", tags=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Tags))
+	builder.WriteString(", point=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Point))
+	builder.WriteString(", payload=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Payload))
+	builder.WriteString(", doc=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Doc))
+	builder.WriteString(", pii=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Pii))
+	builder.WriteString(", labels=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Labels))
+	builder.WriteString(", attrs=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Attrs))
+	builder.WriteString(", keywords=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Keywords))
+	builder.WriteString(", counts=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Counts))
+	builder.WriteString(", props=")
+	builder.WriteString(formatJSON(u.prettyJSON, u.Props))
+	builder.WriteString(", version=")
+	builder.WriteString(fmt.Sprintf("%v", u.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// RawHash returns the SHA-256 hash of the canonical JSON encoding of the "raw" field.
+// The value is decoded and encoded again before it is hashed, in order to sort the keys of objects
+// that were encoded as is (e.g. json.RawMessage), and to remove their insignificant whitespaces.
+func (u *User) RawHash() ([sha256.Size]byte, error) {
+	buf, err := json.Marshal(u.Raw)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	if buf, err = json.Marshal(v); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(buf), nil
+}
+
+// IntsHash returns the SHA-256 hash of the canonical JSON encoding of the "ints" field.
+// The value is decoded and encoded again before it is hashed, in order to sort the keys of objects
+// that were encoded as is (e.g. json.RawMessage), and to remove their insignificant whitespaces.
+func (u *User) IntsHash() ([sha256.Size]byte, error) {
+	buf, err := json.Marshal(u.Ints)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	if buf, err = json.Marshal(v); err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(buf), nil
+}
+
+// URLEqual reports if the value of the "url" field is equal to the given value.
+func (u *User) URLEqual(v *url.URL) bool {
+	return reflect.DeepEqual(u.URL, v)
+}
+
+// UrlsEqual reports if the value of the "urls" field is equal to the given value.
+func (u *User) UrlsEqual(v []*url.URL) bool {
+	return reflect.DeepEqual(u.Urls, v)
+}
+
+// RawEqual reports if the value of the "raw" field is equal to the given value.
+func (u *User) RawEqual(v json.RawMessage) bool {
+	return reflect.DeepEqual(u.Raw, v)
+}
+
+// BlobEqual reports if the value of the "blob" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) BlobEqual(v []uint8) bool {
+	if len(u.Blob) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Blob[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DirsEqual reports if the value of the "dirs" field is equal to the given value.
+func (u *User) DirsEqual(v []http.Dir) bool {
+	return reflect.DeepEqual(u.Dirs, v)
+}
+
+// IntsEqual reports if the value of the "ints" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) IntsEqual(v []int) bool {
+	if len(u.Ints) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Ints[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// InitialIntsEqual reports if the value of the "initial_ints" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) InitialIntsEqual(v []int) bool {
+	if len(u.InitialInts) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.InitialInts[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// FloatsEqual reports if the value of the "floats" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) FloatsEqual(v []float64) bool {
+	if len(u.Floats) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Floats[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NullableIntsEqual reports if the value of the "nullable_ints" field is equal to the given value.
+func (u *User) NullableIntsEqual(v *[]int) bool {
+	return reflect.DeepEqual(u.NullableInts, v)
+}
+
+// NullIntsEqual reports if the value of the "null_ints" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) NullIntsEqual(v []int) bool {
+	if len(u.NullInts) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.NullInts[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EmptyIntsEqual reports if the value of the "empty_ints" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) EmptyIntsEqual(v []int) bool {
+	if len(u.EmptyInts) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.EmptyInts[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TimesEqual reports if the value of the "times" field is equal to the given value.
+func (u *User) TimesEqual(v []time.Time) bool {
+	return reflect.DeepEqual(u.Times, v)
+}
+
+// MetaEqual reports if the value of the "meta" field is equal to the given value.
+// Nil and empty maps are equal.
+func (u *User) MetaEqual(v map[string]string) bool {
+	if len(u.Meta) != len(v) {
+		return false
+	}
+	for k, x := range v {
+		if y, ok := u.Meta[k]; !ok || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// SecretsEqual reports if the value of the "secrets" field is equal to the given value.
+// Nil and empty maps are equal.
+func (u *User) SecretsEqual(v map[string]string) bool {
+	if len(u.Secrets) != len(v) {
+		return false
+	}
+	for k, x := range v {
+		if y, ok := u.Secrets[k]; !ok || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// StringsEqual reports if the value of the "strings" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) StringsEqual(v []string) bool {
+	if len(u.Strings) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Strings[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// TagsEqual reports if the value of the "tags" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) TagsEqual(v []string) bool {
+	if len(u.Tags) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Tags[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PointEqual reports if the value of the "point" field is equal to the given value.
+func (u *User) PointEqual(v schema.Point) bool {
+	return reflect.DeepEqual(u.Point, v)
+}
+
+// PayloadEqual reports if the value of the "payload" field is equal to the given value.
+func (u *User) PayloadEqual(v schema.Payload) bool {
+	return reflect.DeepEqual(u.Payload, v)
+}
+
+// DocEqual reports if the value of the "doc" field is equal to the given value.
+func (u *User) DocEqual(v json.RawMessage) bool {
+	return reflect.DeepEqual(u.Doc, v)
+}
+
+// PiiEqual reports if the value of the "pii" field is equal to the given value.
+func (u *User) PiiEqual(v json.RawMessage) bool {
+	return reflect.DeepEqual(u.Pii, v)
+}
+
+// LabelsEqual reports if the value of the "labels" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) LabelsEqual(v []string) bool {
+	if len(u.Labels) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Labels[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// AttrsEqual reports if the value of the "attrs" field is equal to the given value.
+// Nil and empty maps are equal.
+func (u *User) AttrsEqual(v map[string]string) bool {
+	if len(u.Attrs) != len(v) {
+		return false
+	}
+	for k, x := range v {
+		if y, ok := u.Attrs[k]; !ok || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+// KeywordsEqual reports if the value of the "keywords" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) KeywordsEqual(v []string) bool {
+	if len(u.Keywords) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Keywords[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// CountsEqual reports if the value of the "counts" field is equal to the given value.
+// Nil and empty slices are equal, and the capacity of the slices is ignored.
+func (u *User) CountsEqual(v []int) bool {
+	if len(u.Counts) != len(v) {
+		return false
+	}
+	for i := range v {
+		if u.Counts[i] != v[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// PropsEqual reports if the value of the "props" field is equal to the given value.
+func (u *User) PropsEqual(v json.RawMessage) bool {
+	return reflect.DeepEqual(u.Props, v)
+}
+
+// GetBlob returns a copy of the value of the "blob" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetBlob() []uint8 {
+	if u.Blob == nil {
+		return nil
+	}
+	v := make([]uint8, len(u.Blob))
+	copy(v, u.Blob)
+	return v
+}
+
+// GetInts returns a copy of the value of the "ints" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetInts() []int {
+	if u.Ints == nil {
+		return nil
+	}
+	v := make([]int, len(u.Ints))
+	copy(v, u.Ints)
+	return v
+}
+
+// GetInitialInts returns a copy of the value of the "initial_ints" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetInitialInts() []int {
+	if u.InitialInts == nil {
+		return nil
+	}
+	v := make([]int, len(u.InitialInts))
+	copy(v, u.InitialInts)
+	return v
+}
+
+// GetFloats returns a copy of the value of the "floats" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetFloats() []float64 {
+	if u.Floats == nil {
+		return nil
+	}
+	v := make([]float64, len(u.Floats))
+	copy(v, u.Floats)
+	return v
+}
+
+// GetNullInts returns a copy of the value of the "null_ints" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetNullInts() []int {
+	if u.NullInts == nil {
+		return nil
+	}
+	v := make([]int, len(u.NullInts))
+	copy(v, u.NullInts)
+	return v
+}
+
+// GetEmptyInts returns a copy of the value of the "empty_ints" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetEmptyInts() []int {
+	if u.EmptyInts == nil {
+		return nil
+	}
+	v := make([]int, len(u.EmptyInts))
+	copy(v, u.EmptyInts)
+	return v
+}
+
+// GetMeta returns a copy of the value of the "meta" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetMeta() map[string]string {
+	if u.Meta == nil {
+		return nil
+	}
+	v := make(map[string]string, len(u.Meta))
+	for k, x := range u.Meta {
+		v[k] = x
+	}
+	return v
+}
+
+// GetSecrets returns a copy of the value of the "secrets" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetSecrets() map[string]string {
+	if u.Secrets == nil {
+		return nil
+	}
+	v := make(map[string]string, len(u.Secrets))
+	for k, x := range u.Secrets {
+		v[k] = x
+	}
+	return v
+}
+
+// GetStrings returns a copy of the value of the "strings" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetStrings() []string {
+	if u.Strings == nil {
+		return nil
+	}
+	v := make([]string, len(u.Strings))
+	copy(v, u.Strings)
+	return v
+}
+
+// GetTags returns a copy of the value of the "tags" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetTags() []string {
+	if u.Tags == nil {
+		return nil
+	}
+	v := make([]string, len(u.Tags))
+	copy(v, u.Tags)
+	return v
+}
+
+// GetLabels returns a copy of the value of the "labels" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetLabels() []string {
+	if u.Labels == nil {
+		return nil
+	}
+	v := make([]string, len(u.Labels))
+	copy(v, u.Labels)
+	return v
+}
+
+// GetAttrs returns a copy of the value of the "attrs" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetAttrs() map[string]string {
+	if u.Attrs == nil {
+		return nil
+	}
+	v := make(map[string]string, len(u.Attrs))
+	for k, x := range u.Attrs {
+		v[k] = x
+	}
+	return v
+}
+
+// GetKeywords returns a copy of the value of the "keywords" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetKeywords() []string {
+	if u.Keywords == nil {
+		return nil
+	}
+	v := make([]string, len(u.Keywords))
+	copy(v, u.Keywords)
+	return v
+}
+
+// GetCounts returns a copy of the value of the "counts" field, that can be modified
+// without affecting the entity. Nil values are returned as nil.
+func (u *User) GetCounts() []int {
+	if u.Counts == nil {
+		return nil
+	}
+	v := make([]int, len(u.Counts))
+	copy(v, u.Counts)
+	return v
+}
+
+// UrlsLen returns the number of elements in the "urls" field.
+func (u *User) UrlsLen() int {
+	return len(u.Urls)
+}
+
+// DirsLen returns the number of elements in the "dirs" field.
+func (u *User) DirsLen() int {
+	return len(u.Dirs)
+}
+
+// IntsLen returns the number of elements in the "ints" field.
+func (u *User) IntsLen() int {
+	return len(u.Ints)
+}
+
+// InitialIntsLen returns the number of elements in the "initial_ints" field.
+func (u *User) InitialIntsLen() int {
+	return len(u.InitialInts)
+}
+
+// FloatsLen returns the number of elements in the "floats" field.
+func (u *User) FloatsLen() int {
+	return len(u.Floats)
+}
+
+// NullIntsLen returns the number of elements in the "null_ints" field.
+func (u *User) NullIntsLen() int {
+	return len(u.NullInts)
+}
+
+// EmptyIntsLen returns the number of elements in the "empty_ints" field.
+func (u *User) EmptyIntsLen() int {
+	return len(u.EmptyInts)
+}
+
+// TimesLen returns the number of elements in the "times" field.
+func (u *User) TimesLen() int {
+	return len(u.Times)
+}
+
+// StringsLen returns the number of elements in the "strings" field.
+func (u *User) StringsLen() int {
+	return len(u.Strings)
+}
+
+// TagsLen returns the number of elements in the "tags" field.
+func (u *User) TagsLen() int {
+	return len(u.Tags)
+}
+
+// LabelsLen returns the number of elements in the "labels" field.
+func (u *User) LabelsLen() int {
+	return len(u.Labels)
+}
+
+// KeywordsLen returns the number of elements in the "keywords" field.
+func (u *User) KeywordsLen() int {
+	return len(u.Keywords)
+}
+
+// CountsLen returns the number of elements in the "counts" field.
+func (u *User) CountsLen() int {
+	return len(u.Counts)
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
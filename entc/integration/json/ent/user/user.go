@@ -6,6 +6,14 @@
 
 package user
 
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
+)
+
 const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
@@ -13,16 +21,79 @@ const (
 	FieldID = "id"
 	// FieldURL holds the string denoting the url field in the database.
 	FieldURL = "url"
+	// FieldUrls holds the string denoting the urls field in the database.
+	FieldUrls = "url_list"
 	// FieldRaw holds the string denoting the raw field in the database.
 	FieldRaw = "raw"
+	// FieldBlob holds the string denoting the blob field in the database.
+	FieldBlob = "blob"
 	// FieldDirs holds the string denoting the dirs field in the database.
 	FieldDirs = "dirs"
 	// FieldInts holds the string denoting the ints field in the database.
 	FieldInts = "ints"
+	// FieldInitialInts holds the string denoting the initial_ints field in the database.
+	FieldInitialInts = "initial_ints"
 	// FieldFloats holds the string denoting the floats field in the database.
 	FieldFloats = "floats"
+	// FieldNullableInts holds the string denoting the nullable_ints field in the database.
+	FieldNullableInts = "nullable_ints"
+	// FieldNullInts holds the string denoting the null_ints field in the database.
+	FieldNullInts = "null_ints"
+	// FieldEmptyInts holds the string denoting the empty_ints field in the database.
+	FieldEmptyInts = "empty_ints"
+	// FieldTimes holds the string denoting the times field in the database.
+	FieldTimes = "times"
+	// FieldMeta holds the string denoting the meta field in the database.
+	FieldMeta = "meta"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
 	// FieldStrings holds the string denoting the strings field in the database.
 	FieldStrings = "strings"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldPoint holds the string denoting the point field in the database.
+	FieldPoint = "point"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldDoc holds the string denoting the doc field in the database.
+	FieldDoc = "doc"
+	// FieldPii holds the string denoting the pii field in the database.
+	FieldPii = "pii"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldAttrs holds the string denoting the attrs field in the database.
+	FieldAttrs = "attrs"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldCounts holds the string denoting the counts field in the database.
+	FieldCounts = "counts"
+	// FieldProps holds the string denoting the props field in the database.
+	FieldProps = "props"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+
+	// URLPathScheme holds the JSON key of the Scheme struct field in the url field.
+	URLPathScheme = "Scheme"
+	// URLPathOpaque holds the JSON key of the Opaque struct field in the url field.
+	URLPathOpaque = "Opaque"
+	// URLPathUser holds the JSON key of the User struct field in the url field.
+	URLPathUser = "User"
+	// URLPathHost holds the JSON key of the Host struct field in the url field.
+	URLPathHost = "Host"
+	// URLPathPath holds the JSON key of the Path struct field in the url field.
+	URLPathPath = "Path"
+	// URLPathFragment holds the JSON key of the Fragment struct field in the url field.
+	URLPathFragment = "Fragment"
+	// URLPathRawQuery holds the JSON key of the RawQuery struct field in the url field.
+	URLPathRawQuery = "RawQuery"
+	// URLPathRawPath holds the JSON key of the RawPath struct field in the url field.
+	URLPathRawPath = "RawPath"
+	// URLPathRawFragment holds the JSON key of the RawFragment struct field in the url field.
+	URLPathRawFragment = "RawFragment"
+	// URLPathForceQuery holds the JSON key of the ForceQuery struct field in the url field.
+	URLPathForceQuery = "ForceQuery"
+	// URLPathOmitHost holds the JSON key of the OmitHost struct field in the url field.
+	URLPathOmitHost = "OmitHost"
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
@@ -32,9 +103,450 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldURL,
+	FieldUrls,
 	FieldRaw,
+	FieldBlob,
 	FieldDirs,
 	FieldInts,
+	FieldInitialInts,
 	FieldFloats,
+	FieldNullableInts,
+	FieldNullInts,
+	FieldEmptyInts,
+	FieldTimes,
+	FieldMeta,
+	FieldSecrets,
 	FieldStrings,
+	FieldTags,
+	FieldPoint,
+	FieldPayload,
+	FieldDoc,
+	FieldPii,
+	FieldLabels,
+	FieldAttrs,
+	FieldKeywords,
+	FieldCounts,
+	FieldProps,
+	FieldVersion,
+}
+
+// ByURLValue orders the results by the JSON value stored in the given path of the "url" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByURLValue("key"))
+//
+func ByURLValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldURL, path...)
+}
+
+// URLValue selects the JSON value stored in the given path of the "url" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.URLValue("key")).Strings(ctx)
+//
+func URLValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldURL, path...)
+}
+
+// ByUrlsValue orders the results by the JSON value stored in the given path of the "urls" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByUrlsValue("key"))
+//
+func ByUrlsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldUrls, path...)
+}
+
+// UrlsValue selects the JSON value stored in the given path of the "urls" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.UrlsValue("key")).Strings(ctx)
+//
+func UrlsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldUrls, path...)
+}
+
+// ByRawValue orders the results by the JSON value stored in the given path of the "raw" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByRawValue("key"))
+//
+func ByRawValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldRaw, path...)
+}
+
+// RawValue selects the JSON value stored in the given path of the "raw" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.RawValue("key")).Strings(ctx)
+//
+func RawValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldRaw, path...)
+}
+
+// ByBlobValue orders the results by the JSON value stored in the given path of the "blob" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByBlobValue("key"))
+func ByBlobValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldBlob, path...)
+}
+
+// BlobValue selects the JSON value stored in the given path of the "blob" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.BlobValue("key")).Strings(ctx)
+func BlobValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldBlob, path...)
+}
+
+// ByDirsValue orders the results by the JSON value stored in the given path of the "dirs" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByDirsValue("key"))
+//
+func ByDirsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldDirs, path...)
+}
+
+// DirsValue selects the JSON value stored in the given path of the "dirs" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.DirsValue("key")).Strings(ctx)
+//
+func DirsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldDirs, path...)
+}
+
+// ByIntsValue orders the results by the JSON value stored in the given path of the "ints" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByIntsValue("key"))
+//
+func ByIntsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldInts, path...)
+}
+
+// IntsValue selects the JSON value stored in the given path of the "ints" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.IntsValue("key")).Strings(ctx)
+//
+func IntsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldInts, path...)
+}
+
+// ByInitialIntsValue orders the results by the JSON value stored in the given path of the "initial_ints" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByInitialIntsValue("key"))
+func ByInitialIntsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldInitialInts, path...)
+}
+
+// InitialIntsValue selects the JSON value stored in the given path of the "initial_ints" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.InitialIntsValue("key")).Strings(ctx)
+func InitialIntsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldInitialInts, path...)
+}
+
+// ByFloatsValue orders the results by the JSON value stored in the given path of the "floats" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByFloatsValue("key"))
+//
+func ByFloatsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldFloats, path...)
+}
+
+// FloatsValue selects the JSON value stored in the given path of the "floats" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.FloatsValue("key")).Strings(ctx)
+//
+func FloatsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldFloats, path...)
+}
+
+// ByNullableIntsValue orders the results by the JSON value stored in the given path of the "nullable_ints" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByNullableIntsValue("key"))
+//
+func ByNullableIntsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldNullableInts, path...)
+}
+
+// NullableIntsValue selects the JSON value stored in the given path of the "nullable_ints" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.NullableIntsValue("key")).Strings(ctx)
+//
+func NullableIntsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldNullableInts, path...)
+}
+
+// ByNullIntsValue orders the results by the JSON value stored in the given path of the "null_ints" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByNullIntsValue("key"))
+func ByNullIntsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldNullInts, path...)
+}
+
+// NullIntsValue selects the JSON value stored in the given path of the "null_ints" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.NullIntsValue("key")).Strings(ctx)
+func NullIntsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldNullInts, path...)
+}
+
+// ByEmptyIntsValue orders the results by the JSON value stored in the given path of the "empty_ints" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByEmptyIntsValue("key"))
+func ByEmptyIntsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldEmptyInts, path...)
+}
+
+// EmptyIntsValue selects the JSON value stored in the given path of the "empty_ints" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.EmptyIntsValue("key")).Strings(ctx)
+func EmptyIntsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldEmptyInts, path...)
+}
+
+// ByTimesValue orders the results by the JSON value stored in the given path of the "times" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByTimesValue("key"))
+//
+func ByTimesValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldTimes, path...)
+}
+
+// TimesValue selects the JSON value stored in the given path of the "times" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.TimesValue("key")).Strings(ctx)
+//
+func TimesValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldTimes, path...)
+}
+
+// ByMetaValue orders the results by the JSON value stored in the given path of the "meta" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByMetaValue("key"))
+//
+func ByMetaValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldMeta, path...)
+}
+
+// MetaValue selects the JSON value stored in the given path of the "meta" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.MetaValue("key")).Strings(ctx)
+//
+func MetaValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldMeta, path...)
+}
+
+// BySecretsValue orders the results by the JSON value stored in the given path of the "secrets" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.BySecretsValue("key"))
+//
+func BySecretsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldSecrets, path...)
+}
+
+// SecretsValue selects the JSON value stored in the given path of the "secrets" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.SecretsValue("key")).Strings(ctx)
+//
+func SecretsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldSecrets, path...)
+}
+
+// ByStringsValue orders the results by the JSON value stored in the given path of the "strings" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByStringsValue("key"))
+//
+func ByStringsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldStrings, path...)
+}
+
+// StringsValue selects the JSON value stored in the given path of the "strings" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.StringsValue("key")).Strings(ctx)
+//
+func StringsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldStrings, path...)
+}
+
+// ByTagsValue orders the results by the JSON value stored in the given path of the "tags" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByTagsValue("key"))
+//
+func ByTagsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldTags, path...)
+}
+
+// TagsValue selects the JSON value stored in the given path of the "tags" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.TagsValue("key")).Strings(ctx)
+//
+func TagsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldTags, path...)
+}
+
+// ByPointValue orders the results by the JSON value stored in the given path of the "point" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByPointValue("key"))
+func ByPointValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldPoint, path...)
+}
+
+// PointValue selects the JSON value stored in the given path of the "point" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.PointValue("key")).Strings(ctx)
+func PointValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldPoint, path...)
+}
+
+// ByPayloadValue orders the results by the JSON value stored in the given path of the "payload" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByPayloadValue("key"))
+func ByPayloadValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldPayload, path...)
 }
+
+// PayloadValue selects the JSON value stored in the given path of the "payload" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.PayloadValue("key")).Strings(ctx)
+func PayloadValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldPayload, path...)
+}
+
+// ByLabelsValue orders the results by the JSON value stored in the given path of the "labels" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByLabelsValue("key"))
+func ByLabelsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldLabels, path...)
+}
+
+// LabelsValue selects the JSON value stored in the given path of the "labels" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.LabelsValue("key")).Strings(ctx)
+func LabelsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldLabels, path...)
+}
+
+// ByAttrsValue orders the results by the JSON value stored in the given path of the "attrs" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByAttrsValue("key"))
+func ByAttrsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldAttrs, path...)
+}
+
+// AttrsValue selects the JSON value stored in the given path of the "attrs" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.AttrsValue("key")).Strings(ctx)
+func AttrsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldAttrs, path...)
+}
+
+// ByKeywordsValue orders the results by the JSON value stored in the given path of the "keywords" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByKeywordsValue("key"))
+func ByKeywordsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldKeywords, path...)
+}
+
+// KeywordsValue selects the JSON value stored in the given path of the "keywords" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.KeywordsValue("key")).Strings(ctx)
+func KeywordsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldKeywords, path...)
+}
+
+// ByCountsValue orders the results by the JSON value stored in the given path of the "counts" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByCountsValue("key"))
+func ByCountsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldCounts, path...)
+}
+
+// CountsValue selects the JSON value stored in the given path of the "counts" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.CountsValue("key")).Strings(ctx)
+func CountsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldCounts, path...)
+}
+
+// ByPropsValue orders the results by the JSON value stored in the given path of the "props" field.
+// Note that values with different JSON types are ordered by their type first. See sql.OrderByJSON for more info.
+//
+//	client.User.Query().Order(user.ByPropsValue("key"))
+func ByPropsValue(path ...string) func(*sql.Selector) {
+	return sql.OrderByJSON(FieldProps, path...)
+}
+
+// PropsValue selects the JSON value stored in the given path of the "props" field.
+// See sql.JSONValue for more info.
+//
+//	client.User.Query().SelectValue(user.PropsValue("key")).Strings(ctx)
+func PropsValue(path ...string) func(*sql.Selector) string {
+	return sql.JSONValue(FieldProps, path...)
+}
+
+var (
+	// RawValidator is a validator for the "raw" field. It is called by the builders before save.
+	RawValidator func(json.RawMessage) error
+	// BlobMarshaler is the custom marshaler of the "blob" field. It is called by the builders before save.
+	BlobMarshaler func([]uint8) ([]byte, error)
+	// BlobUnmarshaler is the custom unmarshaler of the "blob" field. It is called when the field is scanned.
+	BlobUnmarshaler func([]byte, *[]uint8) error
+	// DefaultDirs holds the default value on creation for the dirs field.
+	DefaultDirs []http.Dir
+	// DirsMarshaler is the custom marshaler of the "dirs" field. It is called by the builders before save.
+	DirsMarshaler func([]http.Dir) ([]byte, error)
+	// DirsUnmarshaler is the custom unmarshaler of the "dirs" field. It is called when the field is scanned.
+	DirsUnmarshaler func([]byte, *[]http.Dir) error
+	// DefaultEmptyInts holds the default value on creation for the empty_ints field.
+	DefaultEmptyInts []int
+	// StringsValidator is a validator for the "strings" field. It is called by the builders before save.
+	StringsValidator func([]string) error
+	// TagsValidator is a validator for the "tags" field. It is called by the builders before save.
+	TagsValidator func([]string) error
+	// PayloadMarshaler is the custom marshaler of the "payload" field. It is called by the builders before save.
+	PayloadMarshaler func(schema.Payload) ([]byte, error)
+	// PayloadUnmarshaler is the custom unmarshaler of the "payload" field. It is called when the field is scanned.
+	PayloadUnmarshaler func([]byte, *schema.Payload) error
+	// CountsScanCoerce transforms the stored values of the "counts" field before they are decoded. It is called when the field is scanned.
+	CountsScanCoerce func(json.RawMessage) (json.RawMessage, error)
+	// DefaultVersion holds the default value on creation for the version field.
+	DefaultVersion int
+)
@@ -7,8 +7,14 @@
 package user
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/entc/integration/json/ent/predicate"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 )
 
 // ID filters vertices based on their identifier.
@@ -94,6 +100,13 @@ func IDLTE(id int) predicate.User {
 	})
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
 // URLIsNil applies the IsNil predicate on the "url" field.
 func URLIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -108,6 +121,20 @@ func URLNotNil() predicate.User {
 	})
 }
 
+// UrlsIsNil applies the IsNil predicate on the "urls" field.
+func UrlsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUrls)))
+	})
+}
+
+// UrlsNotNil applies the NotNil predicate on the "urls" field.
+func UrlsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUrls)))
+	})
+}
+
 // RawIsNil applies the IsNil predicate on the "raw" field.
 func RawIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -122,6 +149,20 @@ func RawNotNil() predicate.User {
 	})
 }
 
+// BlobIsNil applies the IsNil predicate on the "blob" field.
+func BlobIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBlob)))
+	})
+}
+
+// BlobNotNil applies the NotNil predicate on the "blob" field.
+func BlobNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBlob)))
+	})
+}
+
 // DirsIsNil applies the IsNil predicate on the "dirs" field.
 func DirsIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -150,6 +191,20 @@ func IntsNotNil() predicate.User {
 	})
 }
 
+// InitialIntsIsNil applies the IsNil predicate on the "initial_ints" field.
+func InitialIntsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldInitialInts)))
+	})
+}
+
+// InitialIntsNotNil applies the NotNil predicate on the "initial_ints" field.
+func InitialIntsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldInitialInts)))
+	})
+}
+
 // FloatsIsNil applies the IsNil predicate on the "floats" field.
 func FloatsIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -164,6 +219,76 @@ func FloatsNotNil() predicate.User {
 	})
 }
 
+// NullableIntsIsNil applies the IsNil predicate on the "nullable_ints" field.
+func NullableIntsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNullableInts)))
+	})
+}
+
+// NullableIntsNotNil applies the NotNil predicate on the "nullable_ints" field.
+func NullableIntsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNullableInts)))
+	})
+}
+
+// NullIntsIsNil applies the IsNil predicate on the "null_ints" field.
+func NullIntsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNullInts)))
+	})
+}
+
+// NullIntsNotNil applies the NotNil predicate on the "null_ints" field.
+func NullIntsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNullInts)))
+	})
+}
+
+// TimesIsNil applies the IsNil predicate on the "times" field.
+func TimesIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimes)))
+	})
+}
+
+// TimesNotNil applies the NotNil predicate on the "times" field.
+func TimesNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimes)))
+	})
+}
+
+// MetaIsNil applies the IsNil predicate on the "meta" field.
+func MetaIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMeta)))
+	})
+}
+
+// MetaNotNil applies the NotNil predicate on the "meta" field.
+func MetaNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMeta)))
+	})
+}
+
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSecrets)))
+	})
+}
+
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSecrets)))
+	})
+}
+
 // StringsIsNil applies the IsNil predicate on the "strings" field.
 func StringsIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -178,6 +303,1704 @@ func StringsNotNil() predicate.User {
 	})
 }
 
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTags)))
+	})
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTags)))
+	})
+}
+
+// PointIsNil applies the IsNil predicate on the "point" field.
+func PointIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPoint)))
+	})
+}
+
+// PointNotNil applies the NotNil predicate on the "point" field.
+func PointNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPoint)))
+	})
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPayload)))
+	})
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPayload)))
+	})
+}
+
+// DocIsNil applies the IsNil predicate on the "doc" field.
+func DocIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDoc)))
+	})
+}
+
+// DocNotNil applies the NotNil predicate on the "doc" field.
+func DocNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDoc)))
+	})
+}
+
+// PiiIsNil applies the IsNil predicate on the "pii" field.
+func PiiIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPii)))
+	})
+}
+
+// PiiNotNil applies the NotNil predicate on the "pii" field.
+func PiiNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPii)))
+	})
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLabels)))
+	})
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLabels)))
+	})
+}
+
+// AttrsIsNil applies the IsNil predicate on the "attrs" field.
+func AttrsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAttrs)))
+	})
+}
+
+// AttrsNotNil applies the NotNil predicate on the "attrs" field.
+func AttrsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAttrs)))
+	})
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldKeywords)))
+	})
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldKeywords)))
+	})
+}
+
+// CountsIsNil applies the IsNil predicate on the "counts" field.
+func CountsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCounts)))
+	})
+}
+
+// CountsNotNil applies the NotNil predicate on the "counts" field.
+func CountsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCounts)))
+	})
+}
+
+// PropsIsNil applies the IsNil predicate on the "props" field.
+func PropsIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldProps)))
+	})
+}
+
+// PropsNotNil applies the NotNil predicate on the "props" field.
+func PropsNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldProps)))
+	})
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVersion), v))
+	})
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVersion), v...))
+	})
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVersion), v))
+	})
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVersion), v))
+	})
+}
+
+// URLEQ applies the EQ predicate on the whole JSON document of the "url" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func URLEQ(v *url.URL) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldURL), b))
+	})
+}
+
+// UrlsEQ applies the EQ predicate on the whole JSON document of the "urls" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func UrlsEQ(v []*url.URL) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldUrls), b))
+	})
+}
+
+// RawEQ applies the EQ predicate on the whole JSON document of the "raw" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func RawEQ(v json.RawMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldRaw), b))
+	})
+}
+
+// BlobEQ applies the EQ predicate on the whole JSON document of the "blob" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func BlobEQ(v []uint8) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := BlobMarshaler(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldBlob), b))
+	})
+}
+
+// DirsEQ applies the EQ predicate on the whole JSON document of the "dirs" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func DirsEQ(v []http.Dir) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := DirsMarshaler(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldDirs), b))
+	})
+}
+
+// IntsEQ applies the EQ predicate on the whole JSON document of the "ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func IntsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldInts), b))
+	})
+}
+
+// InitialIntsEQ applies the EQ predicate on the whole JSON document of the "initial_ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func InitialIntsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldInitialInts), b))
+	})
+}
+
+// FloatsEQ applies the EQ predicate on the whole JSON document of the "floats" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func FloatsEQ(v []float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := sql.MarshalNonFinite(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldFloats), b))
+	})
+}
+
+// NullableIntsEQ applies the EQ predicate on the whole JSON document of the "nullable_ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func NullableIntsEQ(v *[]int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldNullableInts), b))
+	})
+}
+
+// NullIntsEQ applies the EQ predicate on the whole JSON document of the "null_ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func NullIntsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldNullInts), b))
+	})
+}
+
+// EmptyIntsEQ applies the EQ predicate on the whole JSON document of the "empty_ints" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func EmptyIntsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldEmptyInts), b))
+	})
+}
+
+// TimesEQ applies the EQ predicate on the whole JSON document of the "times" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func TimesEQ(v []time.Time) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldTimes), b))
+	})
+}
+
+// MetaEQ applies the EQ predicate on the whole JSON document of the "meta" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func MetaEQ(v map[string]string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldMeta), b))
+	})
+}
+
+// SecretsEQ applies the EQ predicate on the whole JSON document of the "secrets" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func SecretsEQ(v map[string]string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldSecrets), b))
+	})
+}
+
+// StringsEQ applies the EQ predicate on the whole JSON document of the "strings" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func StringsEQ(v []string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldStrings), b))
+	})
+}
+
+// TagsEQ applies the EQ predicate on the whole JSON document of the "tags" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func TagsEQ(v []string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldTags), b))
+	})
+}
+
+// PayloadEQ applies the EQ predicate on the whole JSON document of the "payload" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func PayloadEQ(v schema.Payload) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := PayloadMarshaler(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldPayload), b))
+	})
+}
+
+// LabelsEQ applies the EQ predicate on the whole JSON document of the "labels" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func LabelsEQ(v []string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldLabels), b))
+	})
+}
+
+// AttrsEQ applies the EQ predicate on the whole JSON document of the "attrs" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func AttrsEQ(v map[string]string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.TextFallback(
+			sql.JSONEQ(s.C(FieldAttrs), b),
+			sql.EQ(s.C(FieldAttrs), string(b)),
+			"mysql",
+		))
+	})
+}
+
+// KeywordsEQ applies the EQ predicate on the whole JSON document of the "keywords" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func KeywordsEQ(v []string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.TextFallback(
+			sql.JSONEQ(s.C(FieldKeywords), b),
+			sql.EQ(s.C(FieldKeywords), string(b)),
+			"mysql",
+		))
+	})
+}
+
+// CountsEQ applies the EQ predicate on the whole JSON document of the "counts" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func CountsEQ(v []int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.JSONEQ(s.C(FieldCounts), b))
+	})
+}
+
+// PropsEQ applies the EQ predicate on the whole JSON document of the "props" field.
+// The value is encoded to JSON, and the formatting and the order of object keys are ignored.
+func PropsEQ(v json.RawMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		b, err := json.Marshal(v)
+		if err == nil {
+			b, err = sql.SortKeys(nil)(json.RawMessage(b))
+		}
+		if err != nil {
+			// Values that cannot be encoded never match the predicate.
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.TextFallback(
+			sql.JSONEQ(s.C(FieldProps), b),
+			sql.EQ(s.C(FieldProps), string(b)),
+			"mysql", "postgres",
+		))
+	})
+}
+
+// UrlsLenEQ applies the EQ predicate on the length of the "urls" field.
+func UrlsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldUrls), n))
+	})
+}
+
+// UrlsLenGT applies the GT predicate on the length of the "urls" field.
+func UrlsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldUrls), n))
+	})
+}
+
+// UrlsLenLT applies the LT predicate on the length of the "urls" field.
+func UrlsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldUrls), n))
+	})
+}
+
+// DirsLenEQ applies the EQ predicate on the length of the "dirs" field.
+func DirsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldDirs), n))
+	})
+}
+
+// DirsLenGT applies the GT predicate on the length of the "dirs" field.
+func DirsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldDirs), n))
+	})
+}
+
+// DirsLenLT applies the LT predicate on the length of the "dirs" field.
+func DirsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldDirs), n))
+	})
+}
+
+// IntsLenEQ applies the EQ predicate on the length of the "ints" field.
+func IntsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldInts), n))
+	})
+}
+
+// IntsLenGT applies the GT predicate on the length of the "ints" field.
+func IntsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldInts), n))
+	})
+}
+
+// IntsLenLT applies the LT predicate on the length of the "ints" field.
+func IntsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldInts), n))
+	})
+}
+
+// InitialIntsLenEQ applies the EQ predicate on the length of the "initial_ints" field.
+func InitialIntsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldInitialInts), n))
+	})
+}
+
+// InitialIntsLenGT applies the GT predicate on the length of the "initial_ints" field.
+func InitialIntsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldInitialInts), n))
+	})
+}
+
+// InitialIntsLenLT applies the LT predicate on the length of the "initial_ints" field.
+func InitialIntsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldInitialInts), n))
+	})
+}
+
+// FloatsLenEQ applies the EQ predicate on the length of the "floats" field.
+func FloatsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldFloats), n))
+	})
+}
+
+// FloatsLenGT applies the GT predicate on the length of the "floats" field.
+func FloatsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldFloats), n))
+	})
+}
+
+// FloatsLenLT applies the LT predicate on the length of the "floats" field.
+func FloatsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldFloats), n))
+	})
+}
+
+// NullIntsLenEQ applies the EQ predicate on the length of the "null_ints" field.
+func NullIntsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldNullInts), n))
+	})
+}
+
+// NullIntsLenGT applies the GT predicate on the length of the "null_ints" field.
+func NullIntsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldNullInts), n))
+	})
+}
+
+// NullIntsLenLT applies the LT predicate on the length of the "null_ints" field.
+func NullIntsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldNullInts), n))
+	})
+}
+
+// EmptyIntsLenEQ applies the EQ predicate on the length of the "empty_ints" field.
+func EmptyIntsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldEmptyInts), n))
+	})
+}
+
+// EmptyIntsLenGT applies the GT predicate on the length of the "empty_ints" field.
+func EmptyIntsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldEmptyInts), n))
+	})
+}
+
+// EmptyIntsLenLT applies the LT predicate on the length of the "empty_ints" field.
+func EmptyIntsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldEmptyInts), n))
+	})
+}
+
+// TimesLenEQ applies the EQ predicate on the length of the "times" field.
+func TimesLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldTimes), n))
+	})
+}
+
+// TimesLenGT applies the GT predicate on the length of the "times" field.
+func TimesLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldTimes), n))
+	})
+}
+
+// TimesLenLT applies the LT predicate on the length of the "times" field.
+func TimesLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldTimes), n))
+	})
+}
+
+// StringsLenEQ applies the EQ predicate on the length of the "strings" field.
+func StringsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldStrings), n))
+	})
+}
+
+// StringsLenGT applies the GT predicate on the length of the "strings" field.
+func StringsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldStrings), n))
+	})
+}
+
+// StringsLenLT applies the LT predicate on the length of the "strings" field.
+func StringsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldStrings), n))
+	})
+}
+
+// TagsLenEQ applies the EQ predicate on the length of the "tags" field.
+func TagsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldTags), n))
+	})
+}
+
+// TagsLenGT applies the GT predicate on the length of the "tags" field.
+func TagsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldTags), n))
+	})
+}
+
+// TagsLenLT applies the LT predicate on the length of the "tags" field.
+func TagsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldTags), n))
+	})
+}
+
+// LabelsLenEQ applies the EQ predicate on the length of the "labels" field.
+func LabelsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldLabels), n))
+	})
+}
+
+// LabelsLenGT applies the GT predicate on the length of the "labels" field.
+func LabelsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldLabels), n))
+	})
+}
+
+// LabelsLenLT applies the LT predicate on the length of the "labels" field.
+func LabelsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldLabels), n))
+	})
+}
+
+// KeywordsLenEQ applies the EQ predicate on the length of the "keywords" field.
+func KeywordsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldKeywords), n))
+	})
+}
+
+// KeywordsLenGT applies the GT predicate on the length of the "keywords" field.
+func KeywordsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldKeywords), n))
+	})
+}
+
+// KeywordsLenLT applies the LT predicate on the length of the "keywords" field.
+func KeywordsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldKeywords), n))
+	})
+}
+
+// CountsLenEQ applies the EQ predicate on the length of the "counts" field.
+func CountsLenEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenEQ(s.C(FieldCounts), n))
+	})
+}
+
+// CountsLenGT applies the GT predicate on the length of the "counts" field.
+func CountsLenGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenGT(s.C(FieldCounts), n))
+	})
+}
+
+// CountsLenLT applies the LT predicate on the length of the "counts" field.
+func CountsLenLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONLenLT(s.C(FieldCounts), n))
+	})
+}
+
+// URLKeyCountEQ applies the EQ predicate on the number of top-level keys of the "url" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func URLKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldURL), n))
+	})
+}
+
+// URLKeyCountGT applies the GT predicate on the number of top-level keys of the "url" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func URLKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldURL), n))
+	})
+}
+
+// URLKeyCountLT applies the LT predicate on the number of top-level keys of the "url" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func URLKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldURL), n))
+	})
+}
+
+// RawKeyCountEQ applies the EQ predicate on the number of top-level keys of the "raw" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func RawKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldRaw), n))
+	})
+}
+
+// RawKeyCountGT applies the GT predicate on the number of top-level keys of the "raw" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func RawKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldRaw), n))
+	})
+}
+
+// RawKeyCountLT applies the LT predicate on the number of top-level keys of the "raw" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func RawKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldRaw), n))
+	})
+}
+
+// MetaKeyCountEQ applies the EQ predicate on the number of top-level keys of the "meta" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func MetaKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldMeta), n))
+	})
+}
+
+// MetaKeyCountGT applies the GT predicate on the number of top-level keys of the "meta" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func MetaKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldMeta), n))
+	})
+}
+
+// MetaKeyCountLT applies the LT predicate on the number of top-level keys of the "meta" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func MetaKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldMeta), n))
+	})
+}
+
+// SecretsKeyCountEQ applies the EQ predicate on the number of top-level keys of the "secrets" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func SecretsKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldSecrets), n))
+	})
+}
+
+// SecretsKeyCountGT applies the GT predicate on the number of top-level keys of the "secrets" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func SecretsKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldSecrets), n))
+	})
+}
+
+// SecretsKeyCountLT applies the LT predicate on the number of top-level keys of the "secrets" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func SecretsKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldSecrets), n))
+	})
+}
+
+// PointKeyCountEQ applies the EQ predicate on the number of top-level keys of the "point" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PointKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldPoint), n))
+	})
+}
+
+// PointKeyCountGT applies the GT predicate on the number of top-level keys of the "point" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PointKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldPoint), n))
+	})
+}
+
+// PointKeyCountLT applies the LT predicate on the number of top-level keys of the "point" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PointKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldPoint), n))
+	})
+}
+
+// AttrsKeyCountEQ applies the EQ predicate on the number of top-level keys of the "attrs" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func AttrsKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldAttrs), n))
+	})
+}
+
+// AttrsKeyCountGT applies the GT predicate on the number of top-level keys of the "attrs" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func AttrsKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldAttrs), n))
+	})
+}
+
+// AttrsKeyCountLT applies the LT predicate on the number of top-level keys of the "attrs" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func AttrsKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldAttrs), n))
+	})
+}
+
+// PropsKeyCountEQ applies the EQ predicate on the number of top-level keys of the "props" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PropsKeyCountEQ(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountEQ(s.C(FieldProps), n))
+	})
+}
+
+// PropsKeyCountGT applies the GT predicate on the number of top-level keys of the "props" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PropsKeyCountGT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountGT(s.C(FieldProps), n))
+	})
+}
+
+// PropsKeyCountLT applies the LT predicate on the number of top-level keys of the "props" field.
+// Values that are not JSON objects (e.g. NULLs) do not match the predicate.
+func PropsKeyCountLT(n int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyCountLT(s.C(FieldProps), n))
+	})
+}
+
+// URLIsEmptyObject applies the IsEmptyObject predicate on the "url" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func URLIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldURL)))
+	})
+}
+
+// UrlsIsEmptyArray applies the IsEmptyArray predicate on the "urls" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func UrlsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldUrls)))
+	})
+}
+
+// RawIsEmptyObject applies the IsEmptyObject predicate on the "raw" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func RawIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldRaw)))
+	})
+}
+
+// DirsIsEmptyArray applies the IsEmptyArray predicate on the "dirs" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func DirsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldDirs)))
+	})
+}
+
+// IntsIsEmptyArray applies the IsEmptyArray predicate on the "ints" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func IntsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldInts)))
+	})
+}
+
+// InitialIntsIsEmptyArray applies the IsEmptyArray predicate on the "initial_ints" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func InitialIntsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldInitialInts)))
+	})
+}
+
+// FloatsIsEmptyArray applies the IsEmptyArray predicate on the "floats" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func FloatsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldFloats)))
+	})
+}
+
+// NullIntsIsEmptyArray applies the IsEmptyArray predicate on the "null_ints" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func NullIntsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldNullInts)))
+	})
+}
+
+// EmptyIntsIsEmptyArray applies the IsEmptyArray predicate on the "empty_ints" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func EmptyIntsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldEmptyInts)))
+	})
+}
+
+// TimesIsEmptyArray applies the IsEmptyArray predicate on the "times" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func TimesIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldTimes)))
+	})
+}
+
+// MetaIsEmptyObject applies the IsEmptyObject predicate on the "meta" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func MetaIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldMeta)))
+	})
+}
+
+// SecretsIsEmptyObject applies the IsEmptyObject predicate on the "secrets" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func SecretsIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldSecrets)))
+	})
+}
+
+// StringsIsEmptyArray applies the IsEmptyArray predicate on the "strings" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func StringsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldStrings)))
+	})
+}
+
+// TagsIsEmptyArray applies the IsEmptyArray predicate on the "tags" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func TagsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldTags)))
+	})
+}
+
+// PointIsEmptyObject applies the IsEmptyObject predicate on the "point" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func PointIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldPoint)))
+	})
+}
+
+// LabelsIsEmptyArray applies the IsEmptyArray predicate on the "labels" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func LabelsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldLabels)))
+	})
+}
+
+// AttrsIsEmptyObject applies the IsEmptyObject predicate on the "attrs" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func AttrsIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldAttrs)))
+	})
+}
+
+// KeywordsIsEmptyArray applies the IsEmptyArray predicate on the "keywords" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func KeywordsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldKeywords)))
+	})
+}
+
+// CountsIsEmptyArray applies the IsEmptyArray predicate on the "counts" field.
+// Unlike an empty array, NULL values do not match the predicate.
+func CountsIsEmptyArray() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyArray(s.C(FieldCounts)))
+	})
+}
+
+// PropsIsEmptyObject applies the IsEmptyObject predicate on the "props" field.
+// Unlike an empty object, NULL values do not match the predicate.
+func PropsIsEmptyObject() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONIsEmptyObject(s.C(FieldProps)))
+	})
+}
+
+// URLHasKey applies the HasKey predicate on the "url" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func URLHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldURL), key))
+	})
+}
+
+// URLValueEQ applies the EQ predicate on the "url" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func URLValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldURL), key, v))
+	})
+}
+
+// URLSchemeEQ applies the EQ predicate on the "Scheme" key of the "url" field.
+func URLSchemeEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "Scheme"))
+	})
+}
+
+// URLOpaqueEQ applies the EQ predicate on the "Opaque" key of the "url" field.
+func URLOpaqueEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "Opaque"))
+	})
+}
+
+// URLHostEQ applies the EQ predicate on the "Host" key of the "url" field.
+func URLHostEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "Host"))
+	})
+}
+
+// URLPathEQ applies the EQ predicate on the "Path" key of the "url" field.
+func URLPathEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "Path"))
+	})
+}
+
+// URLRawPathEQ applies the EQ predicate on the "RawPath" key of the "url" field.
+func URLRawPathEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "RawPath"))
+	})
+}
+
+// URLOmitHostEQ applies the EQ predicate on the "OmitHost" key of the "url" field.
+func URLOmitHostEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "OmitHost"))
+	})
+}
+
+// URLForceQueryEQ applies the EQ predicate on the "ForceQuery" key of the "url" field.
+func URLForceQueryEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "ForceQuery"))
+	})
+}
+
+// URLRawQueryEQ applies the EQ predicate on the "RawQuery" key of the "url" field.
+func URLRawQueryEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "RawQuery"))
+	})
+}
+
+// URLFragmentEQ applies the EQ predicate on the "Fragment" key of the "url" field.
+func URLFragmentEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "Fragment"))
+	})
+}
+
+// URLRawFragmentEQ applies the EQ predicate on the "RawFragment" key of the "url" field.
+func URLRawFragmentEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldURL), v, "RawFragment"))
+	})
+}
+
+// RawHasKey applies the HasKey predicate on the "raw" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func RawHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldRaw), key))
+	})
+}
+
+// RawValueEQ applies the EQ predicate on the "raw" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func RawValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldRaw), key, v))
+	})
+}
+
+// MetaHasKey applies the HasKey predicate on the "meta" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func MetaHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldMeta), key))
+	})
+}
+
+// MetaValueEQ applies the EQ predicate on the "meta" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func MetaValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldMeta), key, v))
+	})
+}
+
+// MetaKeyEQ applies the EQ predicate on the value stored in the given key of the "meta" field.
+func MetaKeyEQ(key string, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldMeta), key, v))
+	})
+}
+
+// SecretsHasKey applies the HasKey predicate on the "secrets" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func SecretsHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldSecrets), key))
+	})
+}
+
+// SecretsValueEQ applies the EQ predicate on the "secrets" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func SecretsValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldSecrets), key, v))
+	})
+}
+
+// SecretsKeyEQ applies the EQ predicate on the value stored in the given key of the "secrets" field.
+func SecretsKeyEQ(key string, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldSecrets), key, v))
+	})
+}
+
+// PointHasKey applies the HasKey predicate on the "point" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PointHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyExists(s.C(FieldPoint), key))
+	})
+}
+
+// PointValueEQ applies the EQ predicate on the "point" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PointValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldPoint), key, v))
+	})
+}
+
+// PointXEQ applies the EQ predicate on the "X" key of the "point" field.
+func PointXEQ(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldPoint), v, "X"))
+	})
+}
+
+// PointYEQ applies the EQ predicate on the "Y" key of the "point" field.
+func PointYEQ(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(s.C(FieldPoint), v, "Y"))
+	})
+}
+
+// AttrsHasKey applies the HasKey predicate on the "attrs" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func AttrsHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.TextFallback(
+			sql.JSONKeyExists(s.C(FieldAttrs), key),
+			sql.JSONTextHasKey(s.C(FieldAttrs), key),
+			"mysql",
+		))
+	})
+}
+
+// AttrsValueEQ applies the EQ predicate on the "attrs" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func AttrsValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldAttrs), key, v))
+	})
+}
+
+// AttrsKeyEQ applies the EQ predicate on the value stored in the given key of the "attrs" field.
+func AttrsKeyEQ(key string, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldAttrs), key, v))
+	})
+}
+
+// PropsHasKey applies the HasKey predicate on the "props" field.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PropsHasKey(key string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.TextFallback(
+			sql.JSONKeyExists(s.C(FieldProps), key),
+			sql.JSONTextHasKey(s.C(FieldProps), key),
+			"mysql", "postgres",
+		))
+	})
+}
+
+// PropsValueEQ applies the EQ predicate on the "props" field value stored in the given key.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PropsValueEQ(key string, v interface{}) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONKeyEQ(s.C(FieldProps), key, v))
+	})
+}
+
+// URLBoolValue applies the EQ predicate on the boolean stored in the given key of the "url" field.
+// Unlike URLValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func URLBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldURL), v, key))
+	})
+}
+
+// RawBoolValue applies the EQ predicate on the boolean stored in the given key of the "raw" field.
+// Unlike RawValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func RawBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldRaw), v, key))
+	})
+}
+
+// MetaBoolValue applies the EQ predicate on the boolean stored in the given key of the "meta" field.
+// Unlike MetaValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func MetaBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldMeta), v, key))
+	})
+}
+
+// SecretsBoolValue applies the EQ predicate on the boolean stored in the given key of the "secrets" field.
+// Unlike SecretsValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func SecretsBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldSecrets), v, key))
+	})
+}
+
+// PointBoolValue applies the EQ predicate on the boolean stored in the given key of the "point" field.
+// Unlike PointValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PointBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldPoint), v, key))
+	})
+}
+
+// AttrsBoolValue applies the EQ predicate on the boolean stored in the given key of the "attrs" field.
+// Unlike AttrsValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func AttrsBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldAttrs), v, key))
+	})
+}
+
+// PropsBoolValue applies the EQ predicate on the boolean stored in the given key of the "props" field.
+// Unlike PropsValueEQ, the value is compared as a JSON boolean in all dialects, and therefore,
+// numbers (e.g. 1) or strings (e.g. "true") that are stored in the key do not match the predicate.
+// The key is a top-level key, and it is passed to the database as an argument.
+func PropsBoolValue(key string, v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONBoolEQ(s.C(FieldProps), v, key))
+	})
+}
+
+// URLQueryParamEQ applies the EQ predicate on the given query parameter of the "url" field.
+// The "RawQuery" of the URL is stored as a string, and therefore, the encoded "key=value" pair is
+// matched using the LIKE operator, and parameters that were encoded differently do not match.
+func URLQueryParamEQ(key, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONQueryParamEQ(s.C(FieldURL), key, v, "RawQuery"))
+	})
+}
+
+// IntsContainsAny applies the predicate that checks that the "ints" field shares at least one element with the given values.
+func IntsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldInts), v...))
+	})
+}
+
+// IntsAny applies the given predicate operator (like sql.GT) on any element of the "ints" field.
+func IntsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldInts), op, v))
+	})
+}
+
+// IntsAll applies the given predicate operator (like sql.GT) on all elements of the "ints" field.
+func IntsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldInts), op, v))
+	})
+}
+
+// InitialIntsContainsAny applies the predicate that checks that the "initial_ints" field shares at least one element with the given values.
+func InitialIntsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldInitialInts), v...))
+	})
+}
+
+// InitialIntsAny applies the given predicate operator (like sql.GT) on any element of the "initial_ints" field.
+func InitialIntsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldInitialInts), op, v))
+	})
+}
+
+// InitialIntsAll applies the given predicate operator (like sql.GT) on all elements of the "initial_ints" field.
+func InitialIntsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldInitialInts), op, v))
+	})
+}
+
+// FloatsContainsAny applies the predicate that checks that the "floats" field shares at least one element with the given values.
+func FloatsContainsAny(vs []float64) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldFloats), v...))
+	})
+}
+
+// FloatsAny applies the given predicate operator (like sql.GT) on any element of the "floats" field.
+func FloatsAny(op func(string, interface{}) *sql.Predicate, v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldFloats), op, v))
+	})
+}
+
+// FloatsAll applies the given predicate operator (like sql.GT) on all elements of the "floats" field.
+func FloatsAll(op func(string, interface{}) *sql.Predicate, v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldFloats), op, v))
+	})
+}
+
+// NullIntsContainsAny applies the predicate that checks that the "null_ints" field shares at least one element with the given values.
+func NullIntsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldNullInts), v...))
+	})
+}
+
+// NullIntsAny applies the given predicate operator (like sql.GT) on any element of the "null_ints" field.
+func NullIntsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldNullInts), op, v))
+	})
+}
+
+// NullIntsAll applies the given predicate operator (like sql.GT) on all elements of the "null_ints" field.
+func NullIntsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldNullInts), op, v))
+	})
+}
+
+// EmptyIntsContainsAny applies the predicate that checks that the "empty_ints" field shares at least one element with the given values.
+func EmptyIntsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldEmptyInts), v...))
+	})
+}
+
+// EmptyIntsAny applies the given predicate operator (like sql.GT) on any element of the "empty_ints" field.
+func EmptyIntsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldEmptyInts), op, v))
+	})
+}
+
+// EmptyIntsAll applies the given predicate operator (like sql.GT) on all elements of the "empty_ints" field.
+func EmptyIntsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldEmptyInts), op, v))
+	})
+}
+
+// StringsContainsAny applies the predicate that checks that the "strings" field shares at least one element with the given values.
+func StringsContainsAny(vs []string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldStrings), v...))
+	})
+}
+
+// StringsAny applies the given predicate operator (like sql.GT) on any element of the "strings" field.
+func StringsAny(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldStrings), op, v))
+	})
+}
+
+// StringsAll applies the given predicate operator (like sql.GT) on all elements of the "strings" field.
+func StringsAll(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldStrings), op, v))
+	})
+}
+
+// TagsContainsAny applies the predicate that checks that the "tags" field shares at least one element with the given values.
+func TagsContainsAny(vs []string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldTags), v...))
+	})
+}
+
+// TagsAny applies the given predicate operator (like sql.GT) on any element of the "tags" field.
+func TagsAny(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldTags), op, v))
+	})
+}
+
+// TagsAll applies the given predicate operator (like sql.GT) on all elements of the "tags" field.
+func TagsAll(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldTags), op, v))
+	})
+}
+
+// LabelsContainsAny applies the predicate that checks that the "labels" field shares at least one element with the given values.
+func LabelsContainsAny(vs []string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldLabels), v...))
+	})
+}
+
+// LabelsAny applies the given predicate operator (like sql.GT) on any element of the "labels" field.
+func LabelsAny(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldLabels), op, v))
+	})
+}
+
+// LabelsAll applies the given predicate operator (like sql.GT) on all elements of the "labels" field.
+func LabelsAll(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldLabels), op, v))
+	})
+}
+
+// KeywordsContainsAny applies the predicate that checks that the "keywords" field shares at least one element with the given values.
+func KeywordsContainsAny(vs []string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.TextFallback(
+			sql.JSONArrayContainsAny(s.C(FieldKeywords), v...),
+			sql.JSONTextArrayContainsAny(s.C(FieldKeywords), v...),
+			"mysql",
+		))
+	})
+}
+
+// KeywordsAny applies the given predicate operator (like sql.GT) on any element of the "keywords" field.
+func KeywordsAny(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldKeywords), op, v))
+	})
+}
+
+// KeywordsAll applies the given predicate operator (like sql.GT) on all elements of the "keywords" field.
+func KeywordsAll(op func(string, interface{}) *sql.Predicate, v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldKeywords), op, v))
+	})
+}
+
+// CountsContainsAny applies the predicate that checks that the "counts" field shares at least one element with the given values.
+func CountsContainsAny(vs []int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContainsAny(s.C(FieldCounts), v...))
+	})
+}
+
+// CountsAny applies the given predicate operator (like sql.GT) on any element of the "counts" field.
+func CountsAny(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAny(s.C(FieldCounts), op, v))
+	})
+}
+
+// CountsAll applies the given predicate operator (like sql.GT) on all elements of the "counts" field.
+func CountsAll(op func(string, interface{}) *sql.Predicate, v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayAll(s.C(FieldCounts), op, v))
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
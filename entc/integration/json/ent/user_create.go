@@ -12,8 +12,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
+	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
 	"github.com/facebook/ent/schema/field"
 )
@@ -31,12 +34,24 @@ func (uc *UserCreate) SetURL(u *url.URL) *UserCreate {
 	return uc
 }
 
+// SetUrls sets the urls field.
+func (uc *UserCreate) SetUrls(u []*url.URL) *UserCreate {
+	uc.mutation.SetUrls(u)
+	return uc
+}
+
 // SetRaw sets the raw field.
 func (uc *UserCreate) SetRaw(jm json.RawMessage) *UserCreate {
 	uc.mutation.SetRaw(jm)
 	return uc
 }
 
+// SetBlob sets the blob field.
+func (uc *UserCreate) SetBlob(u []uint8) *UserCreate {
+	uc.mutation.SetBlob(u)
+	return uc
+}
+
 // SetDirs sets the dirs field.
 func (uc *UserCreate) SetDirs(h []http.Dir) *UserCreate {
 	uc.mutation.SetDirs(h)
@@ -49,18 +64,142 @@ func (uc *UserCreate) SetInts(i []int) *UserCreate {
 	return uc
 }
 
+// SetInitialInts sets the initial_ints field.
+func (uc *UserCreate) SetInitialInts(i []int) *UserCreate {
+	uc.mutation.SetInitialInts(i)
+	return uc
+}
+
 // SetFloats sets the floats field.
 func (uc *UserCreate) SetFloats(f []float64) *UserCreate {
 	uc.mutation.SetFloats(f)
 	return uc
 }
 
+// SetNullableInts sets the nullable_ints field.
+func (uc *UserCreate) SetNullableInts(i *[]int) *UserCreate {
+	uc.mutation.SetNullableInts(i)
+	return uc
+}
+
+// SetNullInts sets the null_ints field.
+func (uc *UserCreate) SetNullInts(i []int) *UserCreate {
+	uc.mutation.SetNullInts(i)
+	return uc
+}
+
+// SetEmptyInts sets the empty_ints field.
+func (uc *UserCreate) SetEmptyInts(i []int) *UserCreate {
+	uc.mutation.SetEmptyInts(i)
+	return uc
+}
+
+// SetTimes sets the times field.
+func (uc *UserCreate) SetTimes(t []time.Time) *UserCreate {
+	uc.mutation.SetTimes(t)
+	return uc
+}
+
+// SetMeta sets the meta field.
+func (uc *UserCreate) SetMeta(m map[string]string) *UserCreate {
+	uc.mutation.SetMeta(m)
+	return uc
+}
+
+// SetSecrets sets the secrets field.
+func (uc *UserCreate) SetSecrets(m map[string]string) *UserCreate {
+	uc.mutation.SetSecrets(m)
+	return uc
+}
+
 // SetStrings sets the strings field.
 func (uc *UserCreate) SetStrings(s []string) *UserCreate {
 	uc.mutation.SetStrings(s)
 	return uc
 }
 
+// SetTags sets the tags field.
+func (uc *UserCreate) SetTags(s []string) *UserCreate {
+	uc.mutation.SetTags(s)
+	return uc
+}
+
+// SetPoint sets the point field.
+func (uc *UserCreate) SetPoint(s schema.Point) *UserCreate {
+	uc.mutation.SetPoint(s)
+	return uc
+}
+
+// SetNillablePoint sets the point field if the given value is not nil.
+func (uc *UserCreate) SetNillablePoint(s *schema.Point) *UserCreate {
+	if s != nil {
+		uc.SetPoint(*s)
+	}
+	return uc
+}
+
+// SetPayload sets the payload field.
+func (uc *UserCreate) SetPayload(s schema.Payload) *UserCreate {
+	uc.mutation.SetPayload(s)
+	return uc
+}
+
+// SetDoc sets the doc field.
+func (uc *UserCreate) SetDoc(jm json.RawMessage) *UserCreate {
+	uc.mutation.SetDoc(jm)
+	return uc
+}
+
+// SetPii sets the pii field.
+func (uc *UserCreate) SetPii(jm json.RawMessage) *UserCreate {
+	uc.mutation.SetPii(jm)
+	return uc
+}
+
+// SetLabels sets the labels field.
+func (uc *UserCreate) SetLabels(s []string) *UserCreate {
+	uc.mutation.SetLabels(s)
+	return uc
+}
+
+// SetAttrs sets the attrs field.
+func (uc *UserCreate) SetAttrs(m map[string]string) *UserCreate {
+	uc.mutation.SetAttrs(m)
+	return uc
+}
+
+// SetKeywords sets the keywords field.
+func (uc *UserCreate) SetKeywords(s []string) *UserCreate {
+	uc.mutation.SetKeywords(s)
+	return uc
+}
+
+// SetCounts sets the counts field.
+func (uc *UserCreate) SetCounts(i []int) *UserCreate {
+	uc.mutation.SetCounts(i)
+	return uc
+}
+
+// SetProps sets the props field.
+func (uc *UserCreate) SetProps(jm json.RawMessage) *UserCreate {
+	uc.mutation.SetProps(jm)
+	return uc
+}
+
+// SetVersion sets the version field.
+func (uc *UserCreate) SetVersion(i int) *UserCreate {
+	uc.mutation.SetVersion(i)
+	return uc
+}
+
+// SetNillableVersion sets the version field if the given value is not nil.
+func (uc *UserCreate) SetNillableVersion(i *int) *UserCreate {
+	if i != nil {
+		uc.SetVersion(*i)
+	}
+	return uc
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -108,6 +247,204 @@ func (uc *UserCreate) SaveX(ctx context.Context) *User {
 }
 
 func (uc *UserCreate) preSave() error {
+	if v, ok := uc.mutation.Raw(); ok && v != nil {
+		if err := user.RawValidator(v); err != nil {
+			return &ValidationError{Name: "raw", err: fmt.Errorf("ent: validator failed for field \"raw\": %w", err)}
+		}
+	}
+	if _, ok := uc.mutation.Dirs(); !ok {
+		v := append(user.DefaultDirs[:0:0], user.DefaultDirs...)
+		uc.mutation.SetDirs(v)
+	}
+	if _, ok := uc.mutation.EmptyInts(); !ok {
+		v := append(user.DefaultEmptyInts[:0:0], user.DefaultEmptyInts...)
+		uc.mutation.SetEmptyInts(v)
+	}
+	if v, ok := uc.mutation.Strings(); ok && v != nil {
+		if err := user.StringsValidator(v); err != nil {
+			return &ValidationError{Name: "strings", err: fmt.Errorf("ent: validator failed for field \"strings\": %w", err)}
+		}
+	}
+	if v, ok := uc.mutation.Tags(); ok && v != nil {
+		if err := user.TagsValidator(v); err != nil {
+			return &ValidationError{Name: "tags", err: fmt.Errorf("ent: validator failed for field \"tags\": %w", err)}
+		}
+	}
+	if _, ok := uc.mutation.Version(); !ok {
+		v := user.DefaultVersion
+		uc.mutation.SetVersion(v)
+	}
+	return nil
+}
+
+// setJSON sets the fields of the builder from a JSON object that maps field names to their
+// JSON values. Null values are ignored, and unknown fields fail the decoding with an error.
+func (uc *UserCreate) setJSON(obj map[string]json.RawMessage) error {
+	for name, raw := range obj {
+		if string(raw) == "null" {
+			continue
+		}
+		switch name {
+		case user.FieldURL:
+			var v *url.URL
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetURL(v)
+		case user.FieldUrls:
+			var v []*url.URL
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetUrls(v)
+		case user.FieldRaw:
+			var v json.RawMessage
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetRaw(v)
+		case user.FieldBlob:
+			var v []uint8
+			if err := user.BlobUnmarshaler(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetBlob(v)
+		case user.FieldDirs:
+			var v []http.Dir
+			if err := user.DirsUnmarshaler(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetDirs(v)
+		case user.FieldInts:
+			var v []int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetInts(v)
+		case user.FieldInitialInts:
+			var v []int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetInitialInts(v)
+		case user.FieldFloats:
+			var v []float64
+			if err := sql.UnmarshalNonFinite(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetFloats(v)
+		case user.FieldNullableInts:
+			var v *[]int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetNullableInts(v)
+		case user.FieldNullInts:
+			var v []int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetNullInts(v)
+		case user.FieldEmptyInts:
+			var v []int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetEmptyInts(v)
+		case user.FieldTimes:
+			var v []time.Time
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetTimes(v)
+		case user.FieldMeta:
+			var v map[string]string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetMeta(v)
+		case user.FieldSecrets:
+			var v map[string]string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetSecrets(v)
+		case user.FieldStrings:
+			var v []string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetStrings(v)
+		case user.FieldTags:
+			var v []string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetTags(v)
+		case user.FieldPoint:
+			var v schema.Point
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetPoint(v)
+		case user.FieldPayload:
+			var v schema.Payload
+			if err := user.PayloadUnmarshaler(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetPayload(v)
+		case user.FieldDoc:
+			var v json.RawMessage
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetDoc(v)
+		case user.FieldPii:
+			var v json.RawMessage
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetPii(v)
+		case user.FieldLabels:
+			var v []string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetLabels(v)
+		case user.FieldAttrs:
+			var v map[string]string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetAttrs(v)
+		case user.FieldKeywords:
+			var v []string
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetKeywords(v)
+		case user.FieldCounts:
+			var v []int
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetCounts(v)
+		case user.FieldProps:
+			var v json.RawMessage
+			if err := uc.unmarshalJSON(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetProps(v)
+		case user.FieldVersion:
+			var v int
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return fmt.Errorf("decoding field %q: %w", name, err)
+			}
+			uc.SetVersion(v)
+		default:
+			return fmt.Errorf("unknown field %q", name)
+		}
+	}
 	return nil
 }
 
@@ -137,51 +474,241 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	)
 	if value, ok := uc.mutation.URL(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldURL,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldURL,
+			Marshal: uc.jsonMarshal,
 		})
 		u.URL = value
 	}
+	if value, ok := uc.mutation.Urls(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldUrls,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Urls = value
+	}
 	if value, ok := uc.mutation.Raw(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldRaw,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Raw = value
+	}
+	if value, ok := uc.mutation.Blob(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
 			Value:  value,
-			Column: user.FieldRaw,
+			Column: user.FieldBlob,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.BlobMarshaler(v.([]uint8))
+			},
 		})
-		u.Raw = value
+		u.Blob = value
 	}
 	if value, ok := uc.mutation.Dirs(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
 			Value:  value,
 			Column: user.FieldDirs,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.DirsMarshaler(v.([]http.Dir))
+			},
 		})
 		u.Dirs = value
 	}
 	if value, ok := uc.mutation.Ints(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldInts,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldInts,
+			Marshal: uc.jsonMarshal,
 		})
 		u.Ints = value
 	}
+	if value, ok := uc.mutation.InitialInts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldInitialInts,
+			Marshal: uc.jsonMarshal,
+		})
+		u.InitialInts = value
+	}
 	if value, ok := uc.mutation.Floats(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldFloats,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldFloats,
+			Marshal: sql.MarshalNonFinite,
 		})
 		u.Floats = value
 	}
+	if value, ok := uc.mutation.NullableInts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullableInts,
+			Marshal: uc.jsonMarshal,
+		})
+		u.NullableInts = value
+	}
+	if value, ok := uc.mutation.NullInts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullInts,
+			Marshal: uc.jsonMarshal,
+		})
+		u.NullInts = value
+	}
+	if value, ok := uc.mutation.EmptyInts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldEmptyInts,
+			Marshal: uc.jsonMarshal,
+		})
+		u.EmptyInts = value
+	}
+	if value, ok := uc.mutation.Times(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTimes,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Times = value
+	}
+	if value, ok := uc.mutation.Meta(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldMeta,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Meta = value
+	}
+	if value, ok := uc.mutation.Secrets(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldSecrets,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Secrets = value
+	}
 	if value, ok := uc.mutation.Strings(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldStrings,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Strings = value
+	}
+	if value, ok := uc.mutation.Tags(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTags,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Tags = value
+	}
+	if value, ok := uc.mutation.Point(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
 			Value:  value,
-			Column: user.FieldStrings,
+			Column: user.FieldPoint,
 		})
-		u.Strings = value
+		u.Point = value
+	}
+	if value, ok := uc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPayload,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.PayloadMarshaler(v.(schema.Payload))
+			},
+		})
+		u.Payload = value
+	}
+	if value, ok := uc.mutation.Doc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldDoc,
+			Marshal: sql.Compress("gzip", uc.jsonMarshal),
+		})
+		u.Doc = value
+	}
+	if value, ok := uc.mutation.Pii(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldPii,
+			Marshal: uc.encryptJSON("pii", uc.jsonMarshal),
+		})
+		u.Pii = value
+	}
+	if value, ok := uc.mutation.Labels(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldLabels,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Labels = value
+	}
+	if value, ok := uc.mutation.Attrs(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldAttrs,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Attrs = value
+	}
+	if value, ok := uc.mutation.Keywords(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldKeywords,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Keywords = value
+	}
+	if value, ok := uc.mutation.Counts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldCounts,
+			Marshal: uc.jsonMarshal,
+		})
+		u.Counts = value
+	}
+	if value, ok := uc.mutation.Props(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldProps,
+			Marshal: sql.SortKeys(uc.jsonMarshal),
+		})
+		u.Props = value
+	}
+	if value, ok := uc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+		u.Version = value
 	}
 	return u, _spec
 }
@@ -197,13 +724,17 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range ucb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*UserMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
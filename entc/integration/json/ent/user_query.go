@@ -8,13 +8,19 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/entc/integration/json/ent/predicate"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
 	"github.com/facebook/ent/schema/field"
 )
@@ -27,6 +33,8 @@ type UserQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.User
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -167,6 +175,61 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 	return us
 }
 
+// Stream executes the query and calls fn for each User, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (uq *UserQuery) Stream(ctx context.Context, fn func(*User) error) error {
+	if err := uq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return uq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (uq *UserQuery) StreamX(ctx context.Context, fn func(*User) error) {
+	if err := uq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Users to w as a JSON array, while the
+// rows are read from the database (see Stream). Each User is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (uq *UserQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := uq.Stream(ctx, func(node *User) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (uq *UserQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := uq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of User ids.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -324,6 +387,909 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	return nodes, nil
 }
 
+func (uq *UserQuery) sqlStream(ctx context.Context, fn func(*User) error) error {
+	var (
+		_spec = uq.querySpec()
+	)
+	var node *User
+	_spec.ScanValues = func() []interface{} {
+		node = &User{config: uq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, uq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// For example, the value stored in a JSON path of the "url" field:
+//
+//	client.User.Query().
+//		SelectValue(user.URLValue("key")).
+//		Strings(ctx)
+//
+// Use As for naming the selected values, when they are scanned into a struct.
+func (uq *UserQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *UserSelect {
+	selector := &UserSelect{config: uq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return uq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.User.Query().Where(user.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (uq *UserQuery) ForUpdate() *UserQuery {
+	uq.forUpdate = true
+	return uq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.User.Query().Omit(user.FieldURL).AllX(ctx)
+//
+func (uq *UserQuery) Omit(fields ...string) *UserQuery {
+	uq.omit = append(uq.omit, fields...)
+	return uq
+}
+
+// URLOnly returns the "url" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) URLOnly(ctx context.Context) ([]*url.URL, error) {
+	var rows []struct {
+		Value []byte `sql:"url"`
+	}
+	if err := uq.Select(user.FieldURL).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]*url.URL, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field url: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field url: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// URLOnlyX is like URLOnly, but panics if an error occurs.
+func (uq *UserQuery) URLOnlyX(ctx context.Context) []*url.URL {
+	vs, err := uq.URLOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// UrlsOnly returns the "urls" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) UrlsOnly(ctx context.Context) ([][]*url.URL, error) {
+	var rows []struct {
+		Value []byte `sql:"url_list"`
+	}
+	if err := uq.Select(user.FieldUrls).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]*url.URL, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field urls: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field urls: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// UrlsOnlyX is like UrlsOnly, but panics if an error occurs.
+func (uq *UserQuery) UrlsOnlyX(ctx context.Context) [][]*url.URL {
+	vs, err := uq.UrlsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// RawOnly returns the "raw" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) RawOnly(ctx context.Context) ([]json.RawMessage, error) {
+	var rows []struct {
+		Value []byte `sql:"raw"`
+	}
+	if err := uq.Select(user.FieldRaw).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]json.RawMessage, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field raw: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field raw: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// RawOnlyX is like RawOnly, but panics if an error occurs.
+func (uq *UserQuery) RawOnlyX(ctx context.Context) []json.RawMessage {
+	vs, err := uq.RawOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// BlobOnly returns the "blob" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) BlobOnly(ctx context.Context) ([][]uint8, error) {
+	var rows []struct {
+		Value []byte `sql:"blob"`
+	}
+	if err := uq.Select(user.FieldBlob).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]uint8, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field blob: %w", err)
+		}
+		if err := user.BlobUnmarshaler(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field blob: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// BlobOnlyX is like BlobOnly, but panics if an error occurs.
+func (uq *UserQuery) BlobOnlyX(ctx context.Context) [][]uint8 {
+	vs, err := uq.BlobOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// DirsOnly returns the "dirs" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) DirsOnly(ctx context.Context) ([][]http.Dir, error) {
+	var rows []struct {
+		Value []byte `sql:"dirs"`
+	}
+	if err := uq.Select(user.FieldDirs).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]http.Dir, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field dirs: %w", err)
+		}
+		if err := user.DirsUnmarshaler(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field dirs: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// DirsOnlyX is like DirsOnly, but panics if an error occurs.
+func (uq *UserQuery) DirsOnlyX(ctx context.Context) [][]http.Dir {
+	vs, err := uq.DirsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// IntsOnly returns the "ints" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) IntsOnly(ctx context.Context) ([][]int, error) {
+	var rows []struct {
+		Value []byte `sql:"ints"`
+	}
+	if err := uq.Select(user.FieldInts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field ints: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field ints: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// IntsOnlyX is like IntsOnly, but panics if an error occurs.
+func (uq *UserQuery) IntsOnlyX(ctx context.Context) [][]int {
+	vs, err := uq.IntsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// InitialIntsOnly returns the "initial_ints" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) InitialIntsOnly(ctx context.Context) ([][]int, error) {
+	var rows []struct {
+		Value []byte `sql:"initial_ints"`
+	}
+	if err := uq.Select(user.FieldInitialInts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field initial_ints: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field initial_ints: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// InitialIntsOnlyX is like InitialIntsOnly, but panics if an error occurs.
+func (uq *UserQuery) InitialIntsOnlyX(ctx context.Context) [][]int {
+	vs, err := uq.InitialIntsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// FloatsOnly returns the "floats" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) FloatsOnly(ctx context.Context) ([][]float64, error) {
+	var rows []struct {
+		Value []byte `sql:"floats"`
+	}
+	if err := uq.Select(user.FieldFloats).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]float64, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field floats: %w", err)
+		}
+		if err := sql.UnmarshalNonFinite(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field floats: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// FloatsOnlyX is like FloatsOnly, but panics if an error occurs.
+func (uq *UserQuery) FloatsOnlyX(ctx context.Context) [][]float64 {
+	vs, err := uq.FloatsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// NullableIntsOnly returns the "nullable_ints" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) NullableIntsOnly(ctx context.Context) ([]*[]int, error) {
+	var rows []struct {
+		Value []byte `sql:"nullable_ints"`
+	}
+	if err := uq.Select(user.FieldNullableInts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]*[]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field nullable_ints: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field nullable_ints: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// NullableIntsOnlyX is like NullableIntsOnly, but panics if an error occurs.
+func (uq *UserQuery) NullableIntsOnlyX(ctx context.Context) []*[]int {
+	vs, err := uq.NullableIntsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// NullIntsOnly returns the "null_ints" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) NullIntsOnly(ctx context.Context) ([][]int, error) {
+	var rows []struct {
+		Value []byte `sql:"null_ints"`
+	}
+	if err := uq.Select(user.FieldNullInts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field null_ints: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field null_ints: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// NullIntsOnlyX is like NullIntsOnly, but panics if an error occurs.
+func (uq *UserQuery) NullIntsOnlyX(ctx context.Context) [][]int {
+	vs, err := uq.NullIntsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// EmptyIntsOnly returns the "empty_ints" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) EmptyIntsOnly(ctx context.Context) ([][]int, error) {
+	var rows []struct {
+		Value []byte `sql:"empty_ints"`
+	}
+	if err := uq.Select(user.FieldEmptyInts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field empty_ints: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field empty_ints: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// EmptyIntsOnlyX is like EmptyIntsOnly, but panics if an error occurs.
+func (uq *UserQuery) EmptyIntsOnlyX(ctx context.Context) [][]int {
+	vs, err := uq.EmptyIntsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// TimesOnly returns the "times" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) TimesOnly(ctx context.Context) ([][]time.Time, error) {
+	var rows []struct {
+		Value []byte `sql:"times"`
+	}
+	if err := uq.Select(user.FieldTimes).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]time.Time, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field times: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field times: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// TimesOnlyX is like TimesOnly, but panics if an error occurs.
+func (uq *UserQuery) TimesOnlyX(ctx context.Context) [][]time.Time {
+	vs, err := uq.TimesOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// MetaOnly returns the "meta" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) MetaOnly(ctx context.Context) ([]map[string]string, error) {
+	var rows []struct {
+		Value []byte `sql:"meta"`
+	}
+	if err := uq.Select(user.FieldMeta).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]map[string]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field meta: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field meta: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// MetaOnlyX is like MetaOnly, but panics if an error occurs.
+func (uq *UserQuery) MetaOnlyX(ctx context.Context) []map[string]string {
+	vs, err := uq.MetaOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// SecretsOnly returns the "secrets" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) SecretsOnly(ctx context.Context) ([]map[string]string, error) {
+	var rows []struct {
+		Value []byte `sql:"secrets"`
+	}
+	if err := uq.Select(user.FieldSecrets).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]map[string]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field secrets: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field secrets: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// SecretsOnlyX is like SecretsOnly, but panics if an error occurs.
+func (uq *UserQuery) SecretsOnlyX(ctx context.Context) []map[string]string {
+	vs, err := uq.SecretsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// StringsOnly returns the "strings" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) StringsOnly(ctx context.Context) ([][]string, error) {
+	var rows []struct {
+		Value []byte `sql:"strings"`
+	}
+	if err := uq.Select(user.FieldStrings).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field strings: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field strings: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// StringsOnlyX is like StringsOnly, but panics if an error occurs.
+func (uq *UserQuery) StringsOnlyX(ctx context.Context) [][]string {
+	vs, err := uq.StringsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// TagsOnly returns the "tags" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) TagsOnly(ctx context.Context) ([][]string, error) {
+	var rows []struct {
+		Value []byte `sql:"tags"`
+	}
+	if err := uq.Select(user.FieldTags).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field tags: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field tags: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// TagsOnlyX is like TagsOnly, but panics if an error occurs.
+func (uq *UserQuery) TagsOnlyX(ctx context.Context) [][]string {
+	vs, err := uq.TagsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// PointOnly returns the "point" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) PointOnly(ctx context.Context) ([]schema.Point, error) {
+	var rows []struct {
+		Value []byte `sql:"point"`
+	}
+	if err := uq.Select(user.FieldPoint).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]schema.Point, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := vs[i].Scan(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("scan field point: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// PointOnlyX is like PointOnly, but panics if an error occurs.
+func (uq *UserQuery) PointOnlyX(ctx context.Context) []schema.Point {
+	vs, err := uq.PointOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// PayloadOnly returns the "payload" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) PayloadOnly(ctx context.Context) ([]schema.Payload, error) {
+	var rows []struct {
+		Value []byte `sql:"payload"`
+	}
+	if err := uq.Select(user.FieldPayload).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]schema.Payload, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field payload: %w", err)
+		}
+		if err := user.PayloadUnmarshaler(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field payload: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// PayloadOnlyX is like PayloadOnly, but panics if an error occurs.
+func (uq *UserQuery) PayloadOnlyX(ctx context.Context) []schema.Payload {
+	vs, err := uq.PayloadOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// DocOnly returns the "doc" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) DocOnly(ctx context.Context) ([]json.RawMessage, error) {
+	var rows []struct {
+		Value []byte `sql:"doc"`
+	}
+	if err := uq.Select(user.FieldDoc).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]json.RawMessage, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		data, err := sql.Decompress("gzip", rows[i].Value)
+		if err != nil {
+			return nil, fmt.Errorf("decompress field doc: %w", err)
+		}
+		rows[i].Value = data
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field doc: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field doc: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// DocOnlyX is like DocOnly, but panics if an error occurs.
+func (uq *UserQuery) DocOnlyX(ctx context.Context) []json.RawMessage {
+	vs, err := uq.DocOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// PiiOnly returns the "pii" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) PiiOnly(ctx context.Context) ([]json.RawMessage, error) {
+	var rows []struct {
+		Value []byte `sql:"pii"`
+	}
+	if err := uq.Select(user.FieldPii).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]json.RawMessage, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		plaintext, err := uq.decryptJSON("pii", rows[i].Value)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt field pii: %w", err)
+		}
+		rows[i].Value = plaintext
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field pii: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field pii: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// PiiOnlyX is like PiiOnly, but panics if an error occurs.
+func (uq *UserQuery) PiiOnlyX(ctx context.Context) []json.RawMessage {
+	vs, err := uq.PiiOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// LabelsOnly returns the "labels" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) LabelsOnly(ctx context.Context) ([][]string, error) {
+	var rows []struct {
+		Value []byte `sql:"labels"`
+	}
+	if err := uq.Select(user.FieldLabels).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field labels: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field labels: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// LabelsOnlyX is like LabelsOnly, but panics if an error occurs.
+func (uq *UserQuery) LabelsOnlyX(ctx context.Context) [][]string {
+	vs, err := uq.LabelsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// AttrsOnly returns the "attrs" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) AttrsOnly(ctx context.Context) ([]map[string]string, error) {
+	var rows []struct {
+		Value []byte `sql:"attrs"`
+	}
+	if err := uq.Select(user.FieldAttrs).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]map[string]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field attrs: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field attrs: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// AttrsOnlyX is like AttrsOnly, but panics if an error occurs.
+func (uq *UserQuery) AttrsOnlyX(ctx context.Context) []map[string]string {
+	vs, err := uq.AttrsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// KeywordsOnly returns the "keywords" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) KeywordsOnly(ctx context.Context) ([][]string, error) {
+	var rows []struct {
+		Value []byte `sql:"keywords"`
+	}
+	if err := uq.Select(user.FieldKeywords).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]string, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field keywords: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field keywords: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// KeywordsOnlyX is like KeywordsOnly, but panics if an error occurs.
+func (uq *UserQuery) KeywordsOnlyX(ctx context.Context) [][]string {
+	vs, err := uq.KeywordsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// CountsOnly returns the "counts" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) CountsOnly(ctx context.Context) ([][]int, error) {
+	var rows []struct {
+		Value []byte `sql:"counts"`
+	}
+	if err := uq.Select(user.FieldCounts).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([][]int, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		coerced, err := user.CountsScanCoerce(rows[i].Value)
+		if err != nil {
+			return nil, fmt.Errorf("coerce field counts: %w", err)
+		}
+		rows[i].Value = coerced
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field counts: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field counts: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// CountsOnlyX is like CountsOnly, but panics if an error occurs.
+func (uq *UserQuery) CountsOnlyX(ctx context.Context) [][]int {
+	vs, err := uq.CountsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
+// PropsOnly returns the "props" field values of the entities that match the query,
+// by selecting and decoding only its column. NULL values are returned as zero values.
+func (uq *UserQuery) PropsOnly(ctx context.Context) ([]json.RawMessage, error) {
+	var rows []struct {
+		Value []byte `sql:"props"`
+	}
+	if err := uq.Select(user.FieldProps).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	vs := make([]json.RawMessage, len(rows))
+	for i := range rows {
+		if len(rows[i].Value) == 0 {
+			continue
+		}
+		if err := uq.checkJSONDepth(rows[i].Value); err != nil {
+			return nil, fmt.Errorf("unmarshal field props: %w", err)
+		}
+		if err := uq.unmarshalJSON(rows[i].Value, &vs[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal field props: %w", err)
+		}
+	}
+	return vs, nil
+}
+
+// PropsOnlyX is like PropsOnly, but panics if an error occurs.
+func (uq *UserQuery) PropsOnlyX(ctx context.Context) []json.RawMessage {
+	vs, err := uq.PropsOnly(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return vs
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
 	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
@@ -347,8 +1313,10 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: user.FieldID,
 			},
 		},
-		From:   uq.sql,
-		Unique: true,
+		From:      uq.sql,
+		Unique:    true,
+		ForUpdate: uq.forUpdate,
+		Omit:      uq.omit,
 	}
 	if ps := uq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -395,6 +1363,9 @@ func (uq *UserQuery) sqlQuery() *sql.Selector {
 	if limit := uq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if uq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -643,6 +1614,7 @@ func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
 type UserSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -865,6 +1837,10 @@ func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (us *UserSelect) sqlQuery() sql.Querier {
 	selector := us.sql
-	selector.Select(selector.Columns(us.fields...)...)
+	columns := selector.Columns(us.fields...)
+	for _, fn := range us.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
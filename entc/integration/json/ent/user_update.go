@@ -9,13 +9,16 @@ package ent
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/facebook/ent/dialect/sql"
 	"github.com/facebook/ent/dialect/sql/sqlgraph"
 	"github.com/facebook/ent/entc/integration/json/ent/predicate"
+	"github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
 	"github.com/facebook/ent/schema/field"
 )
@@ -40,30 +43,84 @@ func (uu *UserUpdate) SetURL(u *url.URL) *UserUpdate {
 	return uu
 }
 
+// MergeURL applies the given JSON merge-patch on the url field.
+func (uu *UserUpdate) MergeURL(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeURL(patch)
+	return uu
+}
+
 // ClearURL clears the value of url.
 func (uu *UserUpdate) ClearURL() *UserUpdate {
 	uu.mutation.ClearURL()
 	return uu
 }
 
+// SetUrls sets the urls field.
+func (uu *UserUpdate) SetUrls(u []*url.URL) *UserUpdate {
+	uu.mutation.SetUrls(u)
+	return uu
+}
+
+// AppendUrls appends vs to the urls field.
+func (uu *UserUpdate) AppendUrls(vs ...*url.URL) *UserUpdate {
+	uu.mutation.AppendUrls(vs...)
+	return uu
+}
+
+// ClearUrls clears the value of urls.
+func (uu *UserUpdate) ClearUrls() *UserUpdate {
+	uu.mutation.ClearUrls()
+	return uu
+}
+
 // SetRaw sets the raw field.
 func (uu *UserUpdate) SetRaw(jm json.RawMessage) *UserUpdate {
 	uu.mutation.SetRaw(jm)
 	return uu
 }
 
+// MergeRaw applies the given JSON merge-patch on the raw field.
+func (uu *UserUpdate) MergeRaw(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeRaw(patch)
+	return uu
+}
+
+// PatchRaw applies the given JSON patch (RFC 6902) operations on the raw field.
+func (uu *UserUpdate) PatchRaw(ops []sql.JSONPatchOp) *UserUpdate {
+	uu.mutation.PatchRaw(ops)
+	return uu
+}
+
 // ClearRaw clears the value of raw.
 func (uu *UserUpdate) ClearRaw() *UserUpdate {
 	uu.mutation.ClearRaw()
 	return uu
 }
 
+// SetBlob sets the blob field.
+func (uu *UserUpdate) SetBlob(u []uint8) *UserUpdate {
+	uu.mutation.SetBlob(u)
+	return uu
+}
+
+// ClearBlob clears the value of blob.
+func (uu *UserUpdate) ClearBlob() *UserUpdate {
+	uu.mutation.ClearBlob()
+	return uu
+}
+
 // SetDirs sets the dirs field.
 func (uu *UserUpdate) SetDirs(h []http.Dir) *UserUpdate {
 	uu.mutation.SetDirs(h)
 	return uu
 }
 
+// AppendDirs appends vs to the dirs field.
+func (uu *UserUpdate) AppendDirs(vs ...http.Dir) *UserUpdate {
+	uu.mutation.AppendDirs(vs...)
+	return uu
+}
+
 // ClearDirs clears the value of dirs.
 func (uu *UserUpdate) ClearDirs() *UserUpdate {
 	uu.mutation.ClearDirs()
@@ -76,6 +133,24 @@ func (uu *UserUpdate) SetInts(i []int) *UserUpdate {
 	return uu
 }
 
+// SetIntAt sets the element at index i of the ints field.
+func (uu *UserUpdate) SetIntAt(i int, v int) *UserUpdate {
+	uu.mutation.SetIntAt(i, v)
+	return uu
+}
+
+// AppendInts appends vs to the ints field.
+func (uu *UserUpdate) AppendInts(vs ...int) *UserUpdate {
+	uu.mutation.AppendInts(vs...)
+	return uu
+}
+
+// RemoveInts removes all occurrences of vs from the ints field.
+func (uu *UserUpdate) RemoveInts(vs ...int) *UserUpdate {
+	uu.mutation.RemoveInts(vs...)
+	return uu
+}
+
 // ClearInts clears the value of ints.
 func (uu *UserUpdate) ClearInts() *UserUpdate {
 	uu.mutation.ClearInts()
@@ -88,24 +163,377 @@ func (uu *UserUpdate) SetFloats(f []float64) *UserUpdate {
 	return uu
 }
 
+// AppendFloats appends vs to the floats field.
+func (uu *UserUpdate) AppendFloats(vs ...float64) *UserUpdate {
+	uu.mutation.AppendFloats(vs...)
+	return uu
+}
+
+// RemoveFloats removes all occurrences of vs from the floats field.
+func (uu *UserUpdate) RemoveFloats(vs ...float64) *UserUpdate {
+	uu.mutation.RemoveFloats(vs...)
+	return uu
+}
+
 // ClearFloats clears the value of floats.
 func (uu *UserUpdate) ClearFloats() *UserUpdate {
 	uu.mutation.ClearFloats()
 	return uu
 }
 
+// SetNullableInts sets the nullable_ints field.
+func (uu *UserUpdate) SetNullableInts(i *[]int) *UserUpdate {
+	uu.mutation.SetNullableInts(i)
+	return uu
+}
+
+// ClearNullableInts clears the value of nullable_ints.
+func (uu *UserUpdate) ClearNullableInts() *UserUpdate {
+	uu.mutation.ClearNullableInts()
+	return uu
+}
+
+// SetNullInts sets the null_ints field.
+func (uu *UserUpdate) SetNullInts(i []int) *UserUpdate {
+	uu.mutation.SetNullInts(i)
+	return uu
+}
+
+// AppendNullInts appends vs to the null_ints field.
+func (uu *UserUpdate) AppendNullInts(vs ...int) *UserUpdate {
+	uu.mutation.AppendNullInts(vs...)
+	return uu
+}
+
+// RemoveNullInts removes all occurrences of vs from the null_ints field.
+func (uu *UserUpdate) RemoveNullInts(vs ...int) *UserUpdate {
+	uu.mutation.RemoveNullInts(vs...)
+	return uu
+}
+
+// ClearNullInts clears the value of null_ints.
+func (uu *UserUpdate) ClearNullInts() *UserUpdate {
+	uu.mutation.ClearNullInts()
+	return uu
+}
+
+// SetEmptyInts sets the empty_ints field.
+func (uu *UserUpdate) SetEmptyInts(i []int) *UserUpdate {
+	uu.mutation.SetEmptyInts(i)
+	return uu
+}
+
+// AppendEmptyInts appends vs to the empty_ints field.
+func (uu *UserUpdate) AppendEmptyInts(vs ...int) *UserUpdate {
+	uu.mutation.AppendEmptyInts(vs...)
+	return uu
+}
+
+// RemoveEmptyInts removes all occurrences of vs from the empty_ints field.
+func (uu *UserUpdate) RemoveEmptyInts(vs ...int) *UserUpdate {
+	uu.mutation.RemoveEmptyInts(vs...)
+	return uu
+}
+
+// SetTimes sets the times field.
+func (uu *UserUpdate) SetTimes(t []time.Time) *UserUpdate {
+	uu.mutation.SetTimes(t)
+	return uu
+}
+
+// AppendTimes appends vs to the times field.
+func (uu *UserUpdate) AppendTimes(vs ...time.Time) *UserUpdate {
+	uu.mutation.AppendTimes(vs...)
+	return uu
+}
+
+// ClearTimes clears the value of times.
+func (uu *UserUpdate) ClearTimes() *UserUpdate {
+	uu.mutation.ClearTimes()
+	return uu
+}
+
+// SetMeta sets the meta field.
+func (uu *UserUpdate) SetMeta(m map[string]string) *UserUpdate {
+	uu.mutation.SetMeta(m)
+	return uu
+}
+
+// MergeMeta applies the given JSON merge-patch on the meta field.
+func (uu *UserUpdate) MergeMeta(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeMeta(patch)
+	return uu
+}
+
+// ClearMeta clears the value of meta.
+func (uu *UserUpdate) ClearMeta() *UserUpdate {
+	uu.mutation.ClearMeta()
+	return uu
+}
+
+// SetSecrets sets the secrets field.
+func (uu *UserUpdate) SetSecrets(m map[string]string) *UserUpdate {
+	uu.mutation.SetSecrets(m)
+	return uu
+}
+
+// MergeSecrets applies the given JSON merge-patch on the secrets field.
+func (uu *UserUpdate) MergeSecrets(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeSecrets(patch)
+	return uu
+}
+
+// ClearSecrets clears the value of secrets.
+func (uu *UserUpdate) ClearSecrets() *UserUpdate {
+	uu.mutation.ClearSecrets()
+	return uu
+}
+
 // SetStrings sets the strings field.
 func (uu *UserUpdate) SetStrings(s []string) *UserUpdate {
 	uu.mutation.SetStrings(s)
 	return uu
 }
 
+// AppendStrings appends vs to the strings field.
+func (uu *UserUpdate) AppendStrings(vs ...string) *UserUpdate {
+	uu.mutation.AppendStrings(vs...)
+	return uu
+}
+
+// RemoveStrings removes all occurrences of vs from the strings field.
+func (uu *UserUpdate) RemoveStrings(vs ...string) *UserUpdate {
+	uu.mutation.RemoveStrings(vs...)
+	return uu
+}
+
 // ClearStrings clears the value of strings.
 func (uu *UserUpdate) ClearStrings() *UserUpdate {
 	uu.mutation.ClearStrings()
 	return uu
 }
 
+// SetTags sets the tags field.
+func (uu *UserUpdate) SetTags(s []string) *UserUpdate {
+	uu.mutation.SetTags(s)
+	return uu
+}
+
+// AppendTags appends vs to the tags field.
+func (uu *UserUpdate) AppendTags(vs ...string) *UserUpdate {
+	uu.mutation.AppendTags(vs...)
+	return uu
+}
+
+// RemoveTags removes all occurrences of vs from the tags field.
+func (uu *UserUpdate) RemoveTags(vs ...string) *UserUpdate {
+	uu.mutation.RemoveTags(vs...)
+	return uu
+}
+
+// ClearTags clears the value of tags.
+func (uu *UserUpdate) ClearTags() *UserUpdate {
+	uu.mutation.ClearTags()
+	return uu
+}
+
+// SetPoint sets the point field.
+func (uu *UserUpdate) SetPoint(s schema.Point) *UserUpdate {
+	uu.mutation.SetPoint(s)
+	return uu
+}
+
+// SetNillablePoint sets the point field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePoint(s *schema.Point) *UserUpdate {
+	if s != nil {
+		uu.SetPoint(*s)
+	}
+	return uu
+}
+
+// MergePoint applies the given JSON merge-patch on the point field.
+func (uu *UserUpdate) MergePoint(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergePoint(patch)
+	return uu
+}
+
+// ClearPoint clears the value of point.
+func (uu *UserUpdate) ClearPoint() *UserUpdate {
+	uu.mutation.ClearPoint()
+	return uu
+}
+
+// SetPayload sets the payload field.
+func (uu *UserUpdate) SetPayload(s schema.Payload) *UserUpdate {
+	uu.mutation.SetPayload(s)
+	return uu
+}
+
+// ClearPayload clears the value of payload.
+func (uu *UserUpdate) ClearPayload() *UserUpdate {
+	uu.mutation.ClearPayload()
+	return uu
+}
+
+// SetDoc sets the doc field.
+func (uu *UserUpdate) SetDoc(jm json.RawMessage) *UserUpdate {
+	uu.mutation.SetDoc(jm)
+	return uu
+}
+
+// ClearDoc clears the value of doc.
+func (uu *UserUpdate) ClearDoc() *UserUpdate {
+	uu.mutation.ClearDoc()
+	return uu
+}
+
+// SetPii sets the pii field.
+func (uu *UserUpdate) SetPii(jm json.RawMessage) *UserUpdate {
+	uu.mutation.SetPii(jm)
+	return uu
+}
+
+// ClearPii clears the value of pii.
+func (uu *UserUpdate) ClearPii() *UserUpdate {
+	uu.mutation.ClearPii()
+	return uu
+}
+
+// SetLabels sets the labels field.
+func (uu *UserUpdate) SetLabels(s []string) *UserUpdate {
+	uu.mutation.SetLabels(s)
+	return uu
+}
+
+// AppendLabels appends vs to the labels field.
+func (uu *UserUpdate) AppendLabels(vs ...string) *UserUpdate {
+	uu.mutation.AppendLabels(vs...)
+	return uu
+}
+
+// RemoveLabels removes all occurrences of vs from the labels field.
+func (uu *UserUpdate) RemoveLabels(vs ...string) *UserUpdate {
+	uu.mutation.RemoveLabels(vs...)
+	return uu
+}
+
+// ClearLabels clears the value of labels.
+func (uu *UserUpdate) ClearLabels() *UserUpdate {
+	uu.mutation.ClearLabels()
+	return uu
+}
+
+// SetAttrs sets the attrs field.
+func (uu *UserUpdate) SetAttrs(m map[string]string) *UserUpdate {
+	uu.mutation.SetAttrs(m)
+	return uu
+}
+
+// MergeAttrs applies the given JSON merge-patch on the attrs field.
+func (uu *UserUpdate) MergeAttrs(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeAttrs(patch)
+	return uu
+}
+
+// ClearAttrs clears the value of attrs.
+func (uu *UserUpdate) ClearAttrs() *UserUpdate {
+	uu.mutation.ClearAttrs()
+	return uu
+}
+
+// SetKeywords sets the keywords field.
+func (uu *UserUpdate) SetKeywords(s []string) *UserUpdate {
+	uu.mutation.SetKeywords(s)
+	return uu
+}
+
+// AppendKeywords appends vs to the keywords field.
+func (uu *UserUpdate) AppendKeywords(vs ...string) *UserUpdate {
+	uu.mutation.AppendKeywords(vs...)
+	return uu
+}
+
+// RemoveKeywords removes all occurrences of vs from the keywords field.
+func (uu *UserUpdate) RemoveKeywords(vs ...string) *UserUpdate {
+	uu.mutation.RemoveKeywords(vs...)
+	return uu
+}
+
+// ClearKeywords clears the value of keywords.
+func (uu *UserUpdate) ClearKeywords() *UserUpdate {
+	uu.mutation.ClearKeywords()
+	return uu
+}
+
+// SetCounts sets the counts field.
+func (uu *UserUpdate) SetCounts(i []int) *UserUpdate {
+	uu.mutation.SetCounts(i)
+	return uu
+}
+
+// AppendCounts appends vs to the counts field.
+func (uu *UserUpdate) AppendCounts(vs ...int) *UserUpdate {
+	uu.mutation.AppendCounts(vs...)
+	return uu
+}
+
+// RemoveCounts removes all occurrences of vs from the counts field.
+func (uu *UserUpdate) RemoveCounts(vs ...int) *UserUpdate {
+	uu.mutation.RemoveCounts(vs...)
+	return uu
+}
+
+// ClearCounts clears the value of counts.
+func (uu *UserUpdate) ClearCounts() *UserUpdate {
+	uu.mutation.ClearCounts()
+	return uu
+}
+
+// SetProps sets the props field.
+func (uu *UserUpdate) SetProps(jm json.RawMessage) *UserUpdate {
+	uu.mutation.SetProps(jm)
+	return uu
+}
+
+// MergeProps applies the given JSON merge-patch on the props field.
+func (uu *UserUpdate) MergeProps(patch json.RawMessage) *UserUpdate {
+	uu.mutation.MergeProps(patch)
+	return uu
+}
+
+// PatchProps applies the given JSON patch (RFC 6902) operations on the props field.
+func (uu *UserUpdate) PatchProps(ops []sql.JSONPatchOp) *UserUpdate {
+	uu.mutation.PatchProps(ops)
+	return uu
+}
+
+// ClearProps clears the value of props.
+func (uu *UserUpdate) ClearProps() *UserUpdate {
+	uu.mutation.ClearProps()
+	return uu
+}
+
+// SetVersion sets the version field.
+func (uu *UserUpdate) SetVersion(i int) *UserUpdate {
+	uu.mutation.ResetVersion()
+	uu.mutation.SetVersion(i)
+	return uu
+}
+
+// SetNillableVersion sets the version field if the given value is not nil.
+func (uu *UserUpdate) SetNillableVersion(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetVersion(*i)
+	}
+	return uu
+}
+
+// AddVersion adds i to version.
+func (uu *UserUpdate) AddVersion(i int) *UserUpdate {
+	uu.mutation.AddVersion(i)
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -113,6 +541,176 @@ func (uu *UserUpdate) Mutation() *UserMutation {
 
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	if _, ok := uu.mutation.MergedURL(); ok {
+		if _, set := uu.mutation.URL(); set || uu.mutation.URLCleared() {
+			return 0, errors.New("ent: field \"url\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedUrls(); ok {
+		if _, set := uu.mutation.Urls(); set || uu.mutation.UrlsCleared() {
+			return 0, errors.New("ent: field \"urls\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.MergedRaw(); ok {
+		if _, set := uu.mutation.Raw(); set || uu.mutation.RawCleared() {
+			return 0, errors.New("ent: field \"raw\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.PatchedRaw(); ok {
+		if _, set := uu.mutation.Raw(); set || uu.mutation.RawCleared() {
+			return 0, errors.New("ent: field \"raw\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
+	if v, ok := uu.mutation.Raw(); ok && v != nil {
+		if err := user.RawValidator(v); err != nil {
+			return 0, &ValidationError{Name: "raw", err: fmt.Errorf("ent: validator failed for field \"raw\": %w", err)}
+		}
+	}
+	if _, ok := uu.mutation.AppendedDirs(); ok {
+		if _, set := uu.mutation.Dirs(); set || uu.mutation.DirsCleared() {
+			return 0, errors.New("ent: field \"dirs\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	for i := range uu.mutation.IntsAt() {
+		if i < 0 {
+			return 0, fmt.Errorf("ent: negative index %d for field \"ints\"", i)
+		}
+	}
+	if _, ok := uu.mutation.AppendedInts(); ok {
+		if _, set := uu.mutation.Ints(); set || uu.mutation.IntsCleared() {
+			return 0, errors.New("ent: field \"ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedInts(); ok {
+		if _, set := uu.mutation.Ints(); set || uu.mutation.IntsCleared() {
+			return 0, errors.New("ent: field \"ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedFloats(); ok {
+		if _, set := uu.mutation.Floats(); set || uu.mutation.FloatsCleared() {
+			return 0, errors.New("ent: field \"floats\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedFloats(); ok {
+		if _, set := uu.mutation.Floats(); set || uu.mutation.FloatsCleared() {
+			return 0, errors.New("ent: field \"floats\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedNullInts(); ok {
+		if _, set := uu.mutation.NullInts(); set || uu.mutation.NullIntsCleared() {
+			return 0, errors.New("ent: field \"null_ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedNullInts(); ok {
+		if _, set := uu.mutation.NullInts(); set || uu.mutation.NullIntsCleared() {
+			return 0, errors.New("ent: field \"null_ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedEmptyInts(); ok {
+		if _, set := uu.mutation.EmptyInts(); set {
+			return 0, errors.New("ent: field \"empty_ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedEmptyInts(); ok {
+		if _, set := uu.mutation.EmptyInts(); set {
+			return 0, errors.New("ent: field \"empty_ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedTimes(); ok {
+		if _, set := uu.mutation.Times(); set || uu.mutation.TimesCleared() {
+			return 0, errors.New("ent: field \"times\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.MergedMeta(); ok {
+		if _, set := uu.mutation.Meta(); set || uu.mutation.MetaCleared() {
+			return 0, errors.New("ent: field \"meta\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.MergedSecrets(); ok {
+		if _, set := uu.mutation.Secrets(); set || uu.mutation.SecretsCleared() {
+			return 0, errors.New("ent: field \"secrets\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedStrings(); ok {
+		if _, set := uu.mutation.Strings(); set || uu.mutation.StringsCleared() {
+			return 0, errors.New("ent: field \"strings\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedStrings(); ok {
+		if _, set := uu.mutation.Strings(); set || uu.mutation.StringsCleared() {
+			return 0, errors.New("ent: field \"strings\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if v, ok := uu.mutation.Strings(); ok && v != nil {
+		if err := user.StringsValidator(v); err != nil {
+			return 0, &ValidationError{Name: "strings", err: fmt.Errorf("ent: validator failed for field \"strings\": %w", err)}
+		}
+	}
+	if _, ok := uu.mutation.AppendedTags(); ok {
+		if _, set := uu.mutation.Tags(); set || uu.mutation.TagsCleared() {
+			return 0, errors.New("ent: field \"tags\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedTags(); ok {
+		if _, set := uu.mutation.Tags(); set || uu.mutation.TagsCleared() {
+			return 0, errors.New("ent: field \"tags\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if v, ok := uu.mutation.Tags(); ok && v != nil {
+		if err := user.TagsValidator(v); err != nil {
+			return 0, &ValidationError{Name: "tags", err: fmt.Errorf("ent: validator failed for field \"tags\": %w", err)}
+		}
+	}
+	if _, ok := uu.mutation.MergedPoint(); ok {
+		if _, set := uu.mutation.Point(); set || uu.mutation.PointCleared() {
+			return 0, errors.New("ent: field \"point\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedLabels(); ok {
+		if _, set := uu.mutation.Labels(); set || uu.mutation.LabelsCleared() {
+			return 0, errors.New("ent: field \"labels\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedLabels(); ok {
+		if _, set := uu.mutation.Labels(); set || uu.mutation.LabelsCleared() {
+			return 0, errors.New("ent: field \"labels\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.MergedAttrs(); ok {
+		if _, set := uu.mutation.Attrs(); set || uu.mutation.AttrsCleared() {
+			return 0, errors.New("ent: field \"attrs\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedKeywords(); ok {
+		if _, set := uu.mutation.Keywords(); set || uu.mutation.KeywordsCleared() {
+			return 0, errors.New("ent: field \"keywords\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedKeywords(); ok {
+		if _, set := uu.mutation.Keywords(); set || uu.mutation.KeywordsCleared() {
+			return 0, errors.New("ent: field \"keywords\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.AppendedCounts(); ok {
+		if _, set := uu.mutation.Counts(); set || uu.mutation.CountsCleared() {
+			return 0, errors.New("ent: field \"counts\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.RemovedCounts(); ok {
+		if _, set := uu.mutation.Counts(); set || uu.mutation.CountsCleared() {
+			return 0, errors.New("ent: field \"counts\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.MergedProps(); ok {
+		if _, set := uu.mutation.Props(); set || uu.mutation.PropsCleared() {
+			return 0, errors.New("ent: field \"props\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uu.mutation.PatchedProps(); ok {
+		if _, set := uu.mutation.Props(); set || uu.mutation.PropsCleared() {
+			return 0, errors.New("ent: field \"props\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
 	var (
 		err      error
 		affected int
@@ -182,9 +780,17 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := uu.mutation.URL(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldURL,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldURL,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedURL(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldURL, p)
+			}
 		})
 	}
 	if uu.mutation.URLCleared() {
@@ -193,11 +799,44 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldURL,
 		})
 	}
-	if value, ok := uu.mutation.Raw(); ok {
+	if value, ok := uu.mutation.Urls(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldUrls,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.AppendedUrls(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldUrls, value)
+		})
+	}
+	if uu.mutation.UrlsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
-			Value:  value,
+			Column: user.FieldUrls,
+		})
+	}
+	if value, ok := uu.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldRaw,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedRaw(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldRaw, p)
+			}
+		})
+	}
+	if ops, ok := uu.mutation.PatchedRaw(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
 			Column: user.FieldRaw,
+			Ops:    ops,
 		})
 	}
 	if uu.mutation.RawCleared() {
@@ -206,11 +845,35 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uu.mutation.Blob(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldBlob,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.BlobMarshaler(v.([]uint8))
+			},
+		})
+	}
+	if uu.mutation.BlobCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldBlob,
+		})
+	}
 	if value, ok := uu.mutation.Dirs(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
 			Value:  value,
 			Column: user.FieldDirs,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.DirsMarshaler(v.([]http.Dir))
+			},
+		})
+	}
+	if value, ok := uu.mutation.AppendedDirs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldDirs, value)
 		})
 	}
 	if uu.mutation.DirsCleared() {
@@ -221,9 +884,27 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if value, ok := uu.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldInts,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldInts,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if values := uu.mutation.IntsAt(); len(values) > 0 {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for i, v := range values {
+				u.JSONReplace(user.FieldInts, v, fmt.Sprintf("[%d]", i))
+			}
+		})
+	}
+	if value, ok := uu.mutation.RemovedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldInts, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldInts, value)
 		})
 	}
 	if uu.mutation.IntsCleared() {
@@ -232,11 +913,28 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldInts,
 		})
 	}
+	if uu.mutation.InitialIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldInitialInts,
+		})
+	}
 	if value, ok := uu.mutation.Floats(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldFloats,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldFloats,
+			Marshal: sql.MarshalNonFinite,
+		})
+	}
+	if value, ok := uu.mutation.RemovedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldFloats, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldFloats, value)
 		})
 	}
 	if uu.mutation.FloatsCleared() {
@@ -245,11 +943,139 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldFloats,
 		})
 	}
-	if value, ok := uu.mutation.Strings(); ok {
+	if value, ok := uu.mutation.NullableInts(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullableInts,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if uu.mutation.NullableIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldStrings,
+			Column: user.FieldNullableInts,
+		})
+	}
+	if value, ok := uu.mutation.NullInts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullInts,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedNullInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldNullInts, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedNullInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldNullInts, value)
+		})
+	}
+	if uu.mutation.NullIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldNullInts,
+		})
+	}
+	if value, ok := uu.mutation.EmptyInts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldEmptyInts,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedEmptyInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldEmptyInts, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedEmptyInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldEmptyInts, value)
+		})
+	}
+	if value, ok := uu.mutation.Times(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTimes,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.AppendedTimes(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldTimes, value)
+		})
+	}
+	if uu.mutation.TimesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTimes,
+		})
+	}
+	if value, ok := uu.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldMeta,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedMeta(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldMeta, p)
+			}
+		})
+	}
+	if uu.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uu.mutation.Secrets(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldSecrets,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedSecrets(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldSecrets, p)
+			}
+		})
+	}
+	if uu.mutation.SecretsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldSecrets,
+		})
+	}
+	if value, ok := uu.mutation.Strings(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldStrings,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldStrings, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldStrings, value)
 		})
 	}
 	if uu.mutation.StringsCleared() {
@@ -258,7 +1084,233 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldStrings,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if value, ok := uu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTags,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedTags(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldTags, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedTags(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldTags, value)
+		})
+	}
+	if uu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTags,
+		})
+	}
+	if value, ok := uu.mutation.Point(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPoint,
+		})
+	}
+	if patches, ok := uu.mutation.MergedPoint(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldPoint, p)
+			}
+		})
+	}
+	if uu.mutation.PointCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPoint,
+		})
+	}
+	if value, ok := uu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPayload,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.PayloadMarshaler(v.(schema.Payload))
+			},
+		})
+	}
+	if uu.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPayload,
+		})
+	}
+	if value, ok := uu.mutation.Doc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldDoc,
+			Marshal: sql.Compress("gzip", uu.jsonMarshal),
+		})
+	}
+	if uu.mutation.DocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldDoc,
+		})
+	}
+	if value, ok := uu.mutation.Pii(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldPii,
+			Marshal: uu.encryptJSON("pii", uu.jsonMarshal),
+		})
+	}
+	if uu.mutation.PiiCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPii,
+		})
+	}
+	if value, ok := uu.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldLabels,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldLabels, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldLabels, value)
+		})
+	}
+	if uu.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldLabels,
+		})
+	}
+	if value, ok := uu.mutation.Attrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldAttrs,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if patches, ok := uu.mutation.MergedAttrs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldAttrs, p)
+			}
+		})
+	}
+	if uu.mutation.AttrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldAttrs,
+		})
+	}
+	if value, ok := uu.mutation.Keywords(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldKeywords,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedKeywords(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldKeywords, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedKeywords(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldKeywords, value)
+		})
+	}
+	if uu.mutation.KeywordsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldKeywords,
+		})
+	}
+	if value, ok := uu.mutation.Counts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldCounts,
+			Marshal: uu.jsonMarshal,
+		})
+	}
+	if value, ok := uu.mutation.RemovedCounts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldCounts, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedCounts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldCounts, value)
+		})
+	}
+	if uu.mutation.CountsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldCounts,
+		})
+	}
+	if value, ok := uu.mutation.Props(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldProps,
+			Marshal: sql.SortKeys(uu.jsonMarshal),
+		})
+	}
+	if patches, ok := uu.mutation.MergedProps(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldProps, p)
+			}
+		})
+	}
+	if ops, ok := uu.mutation.PatchedProps(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
+			Column: user.FieldProps,
+			Ops:    ops,
+		})
+	}
+	if uu.mutation.PropsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldProps,
+		})
+	}
+	if value, ok := uu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	err = uu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -282,30 +1334,84 @@ func (uuo *UserUpdateOne) SetURL(u *url.URL) *UserUpdateOne {
 	return uuo
 }
 
+// MergeURL applies the given JSON merge-patch on the url field.
+func (uuo *UserUpdateOne) MergeURL(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeURL(patch)
+	return uuo
+}
+
 // ClearURL clears the value of url.
 func (uuo *UserUpdateOne) ClearURL() *UserUpdateOne {
 	uuo.mutation.ClearURL()
 	return uuo
 }
 
+// SetUrls sets the urls field.
+func (uuo *UserUpdateOne) SetUrls(u []*url.URL) *UserUpdateOne {
+	uuo.mutation.SetUrls(u)
+	return uuo
+}
+
+// AppendUrls appends vs to the urls field.
+func (uuo *UserUpdateOne) AppendUrls(vs ...*url.URL) *UserUpdateOne {
+	uuo.mutation.AppendUrls(vs...)
+	return uuo
+}
+
+// ClearUrls clears the value of urls.
+func (uuo *UserUpdateOne) ClearUrls() *UserUpdateOne {
+	uuo.mutation.ClearUrls()
+	return uuo
+}
+
 // SetRaw sets the raw field.
 func (uuo *UserUpdateOne) SetRaw(jm json.RawMessage) *UserUpdateOne {
 	uuo.mutation.SetRaw(jm)
 	return uuo
 }
 
+// MergeRaw applies the given JSON merge-patch on the raw field.
+func (uuo *UserUpdateOne) MergeRaw(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeRaw(patch)
+	return uuo
+}
+
+// PatchRaw applies the given JSON patch (RFC 6902) operations on the raw field.
+func (uuo *UserUpdateOne) PatchRaw(ops []sql.JSONPatchOp) *UserUpdateOne {
+	uuo.mutation.PatchRaw(ops)
+	return uuo
+}
+
 // ClearRaw clears the value of raw.
 func (uuo *UserUpdateOne) ClearRaw() *UserUpdateOne {
 	uuo.mutation.ClearRaw()
 	return uuo
 }
 
+// SetBlob sets the blob field.
+func (uuo *UserUpdateOne) SetBlob(u []uint8) *UserUpdateOne {
+	uuo.mutation.SetBlob(u)
+	return uuo
+}
+
+// ClearBlob clears the value of blob.
+func (uuo *UserUpdateOne) ClearBlob() *UserUpdateOne {
+	uuo.mutation.ClearBlob()
+	return uuo
+}
+
 // SetDirs sets the dirs field.
 func (uuo *UserUpdateOne) SetDirs(h []http.Dir) *UserUpdateOne {
 	uuo.mutation.SetDirs(h)
 	return uuo
 }
 
+// AppendDirs appends vs to the dirs field.
+func (uuo *UserUpdateOne) AppendDirs(vs ...http.Dir) *UserUpdateOne {
+	uuo.mutation.AppendDirs(vs...)
+	return uuo
+}
+
 // ClearDirs clears the value of dirs.
 func (uuo *UserUpdateOne) ClearDirs() *UserUpdateOne {
 	uuo.mutation.ClearDirs()
@@ -318,6 +1424,24 @@ func (uuo *UserUpdateOne) SetInts(i []int) *UserUpdateOne {
 	return uuo
 }
 
+// SetIntAt sets the element at index i of the ints field.
+func (uuo *UserUpdateOne) SetIntAt(i int, v int) *UserUpdateOne {
+	uuo.mutation.SetIntAt(i, v)
+	return uuo
+}
+
+// AppendInts appends vs to the ints field.
+func (uuo *UserUpdateOne) AppendInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.AppendInts(vs...)
+	return uuo
+}
+
+// RemoveInts removes all occurrences of vs from the ints field.
+func (uuo *UserUpdateOne) RemoveInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.RemoveInts(vs...)
+	return uuo
+}
+
 // ClearInts clears the value of ints.
 func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 	uuo.mutation.ClearInts()
@@ -330,24 +1454,377 @@ func (uuo *UserUpdateOne) SetFloats(f []float64) *UserUpdateOne {
 	return uuo
 }
 
+// AppendFloats appends vs to the floats field.
+func (uuo *UserUpdateOne) AppendFloats(vs ...float64) *UserUpdateOne {
+	uuo.mutation.AppendFloats(vs...)
+	return uuo
+}
+
+// RemoveFloats removes all occurrences of vs from the floats field.
+func (uuo *UserUpdateOne) RemoveFloats(vs ...float64) *UserUpdateOne {
+	uuo.mutation.RemoveFloats(vs...)
+	return uuo
+}
+
 // ClearFloats clears the value of floats.
 func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 	uuo.mutation.ClearFloats()
 	return uuo
 }
 
+// SetNullableInts sets the nullable_ints field.
+func (uuo *UserUpdateOne) SetNullableInts(i *[]int) *UserUpdateOne {
+	uuo.mutation.SetNullableInts(i)
+	return uuo
+}
+
+// ClearNullableInts clears the value of nullable_ints.
+func (uuo *UserUpdateOne) ClearNullableInts() *UserUpdateOne {
+	uuo.mutation.ClearNullableInts()
+	return uuo
+}
+
+// SetNullInts sets the null_ints field.
+func (uuo *UserUpdateOne) SetNullInts(i []int) *UserUpdateOne {
+	uuo.mutation.SetNullInts(i)
+	return uuo
+}
+
+// AppendNullInts appends vs to the null_ints field.
+func (uuo *UserUpdateOne) AppendNullInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.AppendNullInts(vs...)
+	return uuo
+}
+
+// RemoveNullInts removes all occurrences of vs from the null_ints field.
+func (uuo *UserUpdateOne) RemoveNullInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNullInts(vs...)
+	return uuo
+}
+
+// ClearNullInts clears the value of null_ints.
+func (uuo *UserUpdateOne) ClearNullInts() *UserUpdateOne {
+	uuo.mutation.ClearNullInts()
+	return uuo
+}
+
+// SetEmptyInts sets the empty_ints field.
+func (uuo *UserUpdateOne) SetEmptyInts(i []int) *UserUpdateOne {
+	uuo.mutation.SetEmptyInts(i)
+	return uuo
+}
+
+// AppendEmptyInts appends vs to the empty_ints field.
+func (uuo *UserUpdateOne) AppendEmptyInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.AppendEmptyInts(vs...)
+	return uuo
+}
+
+// RemoveEmptyInts removes all occurrences of vs from the empty_ints field.
+func (uuo *UserUpdateOne) RemoveEmptyInts(vs ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEmptyInts(vs...)
+	return uuo
+}
+
+// SetTimes sets the times field.
+func (uuo *UserUpdateOne) SetTimes(t []time.Time) *UserUpdateOne {
+	uuo.mutation.SetTimes(t)
+	return uuo
+}
+
+// AppendTimes appends vs to the times field.
+func (uuo *UserUpdateOne) AppendTimes(vs ...time.Time) *UserUpdateOne {
+	uuo.mutation.AppendTimes(vs...)
+	return uuo
+}
+
+// ClearTimes clears the value of times.
+func (uuo *UserUpdateOne) ClearTimes() *UserUpdateOne {
+	uuo.mutation.ClearTimes()
+	return uuo
+}
+
+// SetMeta sets the meta field.
+func (uuo *UserUpdateOne) SetMeta(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetMeta(m)
+	return uuo
+}
+
+// MergeMeta applies the given JSON merge-patch on the meta field.
+func (uuo *UserUpdateOne) MergeMeta(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeMeta(patch)
+	return uuo
+}
+
+// ClearMeta clears the value of meta.
+func (uuo *UserUpdateOne) ClearMeta() *UserUpdateOne {
+	uuo.mutation.ClearMeta()
+	return uuo
+}
+
+// SetSecrets sets the secrets field.
+func (uuo *UserUpdateOne) SetSecrets(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetSecrets(m)
+	return uuo
+}
+
+// MergeSecrets applies the given JSON merge-patch on the secrets field.
+func (uuo *UserUpdateOne) MergeSecrets(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeSecrets(patch)
+	return uuo
+}
+
+// ClearSecrets clears the value of secrets.
+func (uuo *UserUpdateOne) ClearSecrets() *UserUpdateOne {
+	uuo.mutation.ClearSecrets()
+	return uuo
+}
+
 // SetStrings sets the strings field.
 func (uuo *UserUpdateOne) SetStrings(s []string) *UserUpdateOne {
 	uuo.mutation.SetStrings(s)
 	return uuo
 }
 
+// AppendStrings appends vs to the strings field.
+func (uuo *UserUpdateOne) AppendStrings(vs ...string) *UserUpdateOne {
+	uuo.mutation.AppendStrings(vs...)
+	return uuo
+}
+
+// RemoveStrings removes all occurrences of vs from the strings field.
+func (uuo *UserUpdateOne) RemoveStrings(vs ...string) *UserUpdateOne {
+	uuo.mutation.RemoveStrings(vs...)
+	return uuo
+}
+
 // ClearStrings clears the value of strings.
 func (uuo *UserUpdateOne) ClearStrings() *UserUpdateOne {
 	uuo.mutation.ClearStrings()
 	return uuo
 }
 
+// SetTags sets the tags field.
+func (uuo *UserUpdateOne) SetTags(s []string) *UserUpdateOne {
+	uuo.mutation.SetTags(s)
+	return uuo
+}
+
+// AppendTags appends vs to the tags field.
+func (uuo *UserUpdateOne) AppendTags(vs ...string) *UserUpdateOne {
+	uuo.mutation.AppendTags(vs...)
+	return uuo
+}
+
+// RemoveTags removes all occurrences of vs from the tags field.
+func (uuo *UserUpdateOne) RemoveTags(vs ...string) *UserUpdateOne {
+	uuo.mutation.RemoveTags(vs...)
+	return uuo
+}
+
+// ClearTags clears the value of tags.
+func (uuo *UserUpdateOne) ClearTags() *UserUpdateOne {
+	uuo.mutation.ClearTags()
+	return uuo
+}
+
+// SetPoint sets the point field.
+func (uuo *UserUpdateOne) SetPoint(s schema.Point) *UserUpdateOne {
+	uuo.mutation.SetPoint(s)
+	return uuo
+}
+
+// SetNillablePoint sets the point field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePoint(s *schema.Point) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPoint(*s)
+	}
+	return uuo
+}
+
+// MergePoint applies the given JSON merge-patch on the point field.
+func (uuo *UserUpdateOne) MergePoint(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergePoint(patch)
+	return uuo
+}
+
+// ClearPoint clears the value of point.
+func (uuo *UserUpdateOne) ClearPoint() *UserUpdateOne {
+	uuo.mutation.ClearPoint()
+	return uuo
+}
+
+// SetPayload sets the payload field.
+func (uuo *UserUpdateOne) SetPayload(s schema.Payload) *UserUpdateOne {
+	uuo.mutation.SetPayload(s)
+	return uuo
+}
+
+// ClearPayload clears the value of payload.
+func (uuo *UserUpdateOne) ClearPayload() *UserUpdateOne {
+	uuo.mutation.ClearPayload()
+	return uuo
+}
+
+// SetDoc sets the doc field.
+func (uuo *UserUpdateOne) SetDoc(jm json.RawMessage) *UserUpdateOne {
+	uuo.mutation.SetDoc(jm)
+	return uuo
+}
+
+// ClearDoc clears the value of doc.
+func (uuo *UserUpdateOne) ClearDoc() *UserUpdateOne {
+	uuo.mutation.ClearDoc()
+	return uuo
+}
+
+// SetPii sets the pii field.
+func (uuo *UserUpdateOne) SetPii(jm json.RawMessage) *UserUpdateOne {
+	uuo.mutation.SetPii(jm)
+	return uuo
+}
+
+// ClearPii clears the value of pii.
+func (uuo *UserUpdateOne) ClearPii() *UserUpdateOne {
+	uuo.mutation.ClearPii()
+	return uuo
+}
+
+// SetLabels sets the labels field.
+func (uuo *UserUpdateOne) SetLabels(s []string) *UserUpdateOne {
+	uuo.mutation.SetLabels(s)
+	return uuo
+}
+
+// AppendLabels appends vs to the labels field.
+func (uuo *UserUpdateOne) AppendLabels(vs ...string) *UserUpdateOne {
+	uuo.mutation.AppendLabels(vs...)
+	return uuo
+}
+
+// RemoveLabels removes all occurrences of vs from the labels field.
+func (uuo *UserUpdateOne) RemoveLabels(vs ...string) *UserUpdateOne {
+	uuo.mutation.RemoveLabels(vs...)
+	return uuo
+}
+
+// ClearLabels clears the value of labels.
+func (uuo *UserUpdateOne) ClearLabels() *UserUpdateOne {
+	uuo.mutation.ClearLabels()
+	return uuo
+}
+
+// SetAttrs sets the attrs field.
+func (uuo *UserUpdateOne) SetAttrs(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetAttrs(m)
+	return uuo
+}
+
+// MergeAttrs applies the given JSON merge-patch on the attrs field.
+func (uuo *UserUpdateOne) MergeAttrs(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeAttrs(patch)
+	return uuo
+}
+
+// ClearAttrs clears the value of attrs.
+func (uuo *UserUpdateOne) ClearAttrs() *UserUpdateOne {
+	uuo.mutation.ClearAttrs()
+	return uuo
+}
+
+// SetKeywords sets the keywords field.
+func (uuo *UserUpdateOne) SetKeywords(s []string) *UserUpdateOne {
+	uuo.mutation.SetKeywords(s)
+	return uuo
+}
+
+// AppendKeywords appends vs to the keywords field.
+func (uuo *UserUpdateOne) AppendKeywords(vs ...string) *UserUpdateOne {
+	uuo.mutation.AppendKeywords(vs...)
+	return uuo
+}
+
+// RemoveKeywords removes all occurrences of vs from the keywords field.
+func (uuo *UserUpdateOne) RemoveKeywords(vs ...string) *UserUpdateOne {
+	uuo.mutation.RemoveKeywords(vs...)
+	return uuo
+}
+
+// ClearKeywords clears the value of keywords.
+func (uuo *UserUpdateOne) ClearKeywords() *UserUpdateOne {
+	uuo.mutation.ClearKeywords()
+	return uuo
+}
+
+// SetCounts sets the counts field.
+func (uuo *UserUpdateOne) SetCounts(i []int) *UserUpdateOne {
+	uuo.mutation.SetCounts(i)
+	return uuo
+}
+
+// AppendCounts appends vs to the counts field.
+func (uuo *UserUpdateOne) AppendCounts(vs ...int) *UserUpdateOne {
+	uuo.mutation.AppendCounts(vs...)
+	return uuo
+}
+
+// RemoveCounts removes all occurrences of vs from the counts field.
+func (uuo *UserUpdateOne) RemoveCounts(vs ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCounts(vs...)
+	return uuo
+}
+
+// ClearCounts clears the value of counts.
+func (uuo *UserUpdateOne) ClearCounts() *UserUpdateOne {
+	uuo.mutation.ClearCounts()
+	return uuo
+}
+
+// SetProps sets the props field.
+func (uuo *UserUpdateOne) SetProps(jm json.RawMessage) *UserUpdateOne {
+	uuo.mutation.SetProps(jm)
+	return uuo
+}
+
+// MergeProps applies the given JSON merge-patch on the props field.
+func (uuo *UserUpdateOne) MergeProps(patch json.RawMessage) *UserUpdateOne {
+	uuo.mutation.MergeProps(patch)
+	return uuo
+}
+
+// PatchProps applies the given JSON patch (RFC 6902) operations on the props field.
+func (uuo *UserUpdateOne) PatchProps(ops []sql.JSONPatchOp) *UserUpdateOne {
+	uuo.mutation.PatchProps(ops)
+	return uuo
+}
+
+// ClearProps clears the value of props.
+func (uuo *UserUpdateOne) ClearProps() *UserUpdateOne {
+	uuo.mutation.ClearProps()
+	return uuo
+}
+
+// SetVersion sets the version field.
+func (uuo *UserUpdateOne) SetVersion(i int) *UserUpdateOne {
+	uuo.mutation.ResetVersion()
+	uuo.mutation.SetVersion(i)
+	return uuo
+}
+
+// SetNillableVersion sets the version field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableVersion(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetVersion(*i)
+	}
+	return uuo
+}
+
+// AddVersion adds i to version.
+func (uuo *UserUpdateOne) AddVersion(i int) *UserUpdateOne {
+	uuo.mutation.AddVersion(i)
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -355,6 +1832,176 @@ func (uuo *UserUpdateOne) Mutation() *UserMutation {
 
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if _, ok := uuo.mutation.MergedURL(); ok {
+		if _, set := uuo.mutation.URL(); set || uuo.mutation.URLCleared() {
+			return nil, errors.New("ent: field \"url\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedUrls(); ok {
+		if _, set := uuo.mutation.Urls(); set || uuo.mutation.UrlsCleared() {
+			return nil, errors.New("ent: field \"urls\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.MergedRaw(); ok {
+		if _, set := uuo.mutation.Raw(); set || uuo.mutation.RawCleared() {
+			return nil, errors.New("ent: field \"raw\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.PatchedRaw(); ok {
+		if _, set := uuo.mutation.Raw(); set || uuo.mutation.RawCleared() {
+			return nil, errors.New("ent: field \"raw\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
+	if v, ok := uuo.mutation.Raw(); ok && v != nil {
+		if err := user.RawValidator(v); err != nil {
+			return nil, &ValidationError{Name: "raw", err: fmt.Errorf("ent: validator failed for field \"raw\": %w", err)}
+		}
+	}
+	if _, ok := uuo.mutation.AppendedDirs(); ok {
+		if _, set := uuo.mutation.Dirs(); set || uuo.mutation.DirsCleared() {
+			return nil, errors.New("ent: field \"dirs\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	for i := range uuo.mutation.IntsAt() {
+		if i < 0 {
+			return nil, fmt.Errorf("ent: negative index %d for field \"ints\"", i)
+		}
+	}
+	if _, ok := uuo.mutation.AppendedInts(); ok {
+		if _, set := uuo.mutation.Ints(); set || uuo.mutation.IntsCleared() {
+			return nil, errors.New("ent: field \"ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedInts(); ok {
+		if _, set := uuo.mutation.Ints(); set || uuo.mutation.IntsCleared() {
+			return nil, errors.New("ent: field \"ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedFloats(); ok {
+		if _, set := uuo.mutation.Floats(); set || uuo.mutation.FloatsCleared() {
+			return nil, errors.New("ent: field \"floats\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedFloats(); ok {
+		if _, set := uuo.mutation.Floats(); set || uuo.mutation.FloatsCleared() {
+			return nil, errors.New("ent: field \"floats\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedNullInts(); ok {
+		if _, set := uuo.mutation.NullInts(); set || uuo.mutation.NullIntsCleared() {
+			return nil, errors.New("ent: field \"null_ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedNullInts(); ok {
+		if _, set := uuo.mutation.NullInts(); set || uuo.mutation.NullIntsCleared() {
+			return nil, errors.New("ent: field \"null_ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedEmptyInts(); ok {
+		if _, set := uuo.mutation.EmptyInts(); set {
+			return nil, errors.New("ent: field \"empty_ints\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedEmptyInts(); ok {
+		if _, set := uuo.mutation.EmptyInts(); set {
+			return nil, errors.New("ent: field \"empty_ints\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedTimes(); ok {
+		if _, set := uuo.mutation.Times(); set || uuo.mutation.TimesCleared() {
+			return nil, errors.New("ent: field \"times\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.MergedMeta(); ok {
+		if _, set := uuo.mutation.Meta(); set || uuo.mutation.MetaCleared() {
+			return nil, errors.New("ent: field \"meta\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.MergedSecrets(); ok {
+		if _, set := uuo.mutation.Secrets(); set || uuo.mutation.SecretsCleared() {
+			return nil, errors.New("ent: field \"secrets\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedStrings(); ok {
+		if _, set := uuo.mutation.Strings(); set || uuo.mutation.StringsCleared() {
+			return nil, errors.New("ent: field \"strings\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedStrings(); ok {
+		if _, set := uuo.mutation.Strings(); set || uuo.mutation.StringsCleared() {
+			return nil, errors.New("ent: field \"strings\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if v, ok := uuo.mutation.Strings(); ok && v != nil {
+		if err := user.StringsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "strings", err: fmt.Errorf("ent: validator failed for field \"strings\": %w", err)}
+		}
+	}
+	if _, ok := uuo.mutation.AppendedTags(); ok {
+		if _, set := uuo.mutation.Tags(); set || uuo.mutation.TagsCleared() {
+			return nil, errors.New("ent: field \"tags\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedTags(); ok {
+		if _, set := uuo.mutation.Tags(); set || uuo.mutation.TagsCleared() {
+			return nil, errors.New("ent: field \"tags\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if v, ok := uuo.mutation.Tags(); ok && v != nil {
+		if err := user.TagsValidator(v); err != nil {
+			return nil, &ValidationError{Name: "tags", err: fmt.Errorf("ent: validator failed for field \"tags\": %w", err)}
+		}
+	}
+	if _, ok := uuo.mutation.MergedPoint(); ok {
+		if _, set := uuo.mutation.Point(); set || uuo.mutation.PointCleared() {
+			return nil, errors.New("ent: field \"point\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedLabels(); ok {
+		if _, set := uuo.mutation.Labels(); set || uuo.mutation.LabelsCleared() {
+			return nil, errors.New("ent: field \"labels\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedLabels(); ok {
+		if _, set := uuo.mutation.Labels(); set || uuo.mutation.LabelsCleared() {
+			return nil, errors.New("ent: field \"labels\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.MergedAttrs(); ok {
+		if _, set := uuo.mutation.Attrs(); set || uuo.mutation.AttrsCleared() {
+			return nil, errors.New("ent: field \"attrs\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedKeywords(); ok {
+		if _, set := uuo.mutation.Keywords(); set || uuo.mutation.KeywordsCleared() {
+			return nil, errors.New("ent: field \"keywords\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedKeywords(); ok {
+		if _, set := uuo.mutation.Keywords(); set || uuo.mutation.KeywordsCleared() {
+			return nil, errors.New("ent: field \"keywords\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.AppendedCounts(); ok {
+		if _, set := uuo.mutation.Counts(); set || uuo.mutation.CountsCleared() {
+			return nil, errors.New("ent: field \"counts\" cannot be set (or cleared) and appended in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.RemovedCounts(); ok {
+		if _, set := uuo.mutation.Counts(); set || uuo.mutation.CountsCleared() {
+			return nil, errors.New("ent: field \"counts\" cannot be set (or cleared) and removed in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.MergedProps(); ok {
+		if _, set := uuo.mutation.Props(); set || uuo.mutation.PropsCleared() {
+			return nil, errors.New("ent: field \"props\" cannot be set (or cleared) and merged in the same mutation")
+		}
+	}
+	if _, ok := uuo.mutation.PatchedProps(); ok {
+		if _, set := uuo.mutation.Props(); set || uuo.mutation.PropsCleared() {
+			return nil, errors.New("ent: field \"props\" cannot be set (or cleared) and patched in the same mutation")
+		}
+	}
 	var (
 		err  error
 		node *User
@@ -422,9 +2069,17 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 	_spec.Node.ID.Value = id
 	if value, ok := uuo.mutation.URL(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldURL,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldURL,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedURL(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldURL, p)
+			}
 		})
 	}
 	if uuo.mutation.URLCleared() {
@@ -433,11 +2088,44 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldURL,
 		})
 	}
-	if value, ok := uuo.mutation.Raw(); ok {
+	if value, ok := uuo.mutation.Urls(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldUrls,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.AppendedUrls(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldUrls, value)
+		})
+	}
+	if uuo.mutation.UrlsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
-			Value:  value,
+			Column: user.FieldUrls,
+		})
+	}
+	if value, ok := uuo.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldRaw,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedRaw(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldRaw, p)
+			}
+		})
+	}
+	if ops, ok := uuo.mutation.PatchedRaw(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
 			Column: user.FieldRaw,
+			Ops:    ops,
 		})
 	}
 	if uuo.mutation.RawCleared() {
@@ -446,11 +2134,35 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uuo.mutation.Blob(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldBlob,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.BlobMarshaler(v.([]uint8))
+			},
+		})
+	}
+	if uuo.mutation.BlobCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldBlob,
+		})
+	}
 	if value, ok := uuo.mutation.Dirs(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
 			Value:  value,
 			Column: user.FieldDirs,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.DirsMarshaler(v.([]http.Dir))
+			},
+		})
+	}
+	if value, ok := uuo.mutation.AppendedDirs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldDirs, value)
 		})
 	}
 	if uuo.mutation.DirsCleared() {
@@ -461,9 +2173,27 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 	}
 	if value, ok := uuo.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldInts,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldInts,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if values := uuo.mutation.IntsAt(); len(values) > 0 {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for i, v := range values {
+				u.JSONReplace(user.FieldInts, v, fmt.Sprintf("[%d]", i))
+			}
+		})
+	}
+	if value, ok := uuo.mutation.RemovedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldInts, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldInts, value)
 		})
 	}
 	if uuo.mutation.IntsCleared() {
@@ -472,11 +2202,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldInts,
 		})
 	}
+	if uuo.mutation.InitialIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldInitialInts,
+		})
+	}
 	if value, ok := uuo.mutation.Floats(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldFloats,
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldFloats,
+			Marshal: sql.MarshalNonFinite,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldFloats, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldFloats, value)
 		})
 	}
 	if uuo.mutation.FloatsCleared() {
@@ -485,11 +2232,139 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldFloats,
 		})
 	}
-	if value, ok := uuo.mutation.Strings(); ok {
+	if value, ok := uuo.mutation.NullableInts(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullableInts,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if uuo.mutation.NullableIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
-			Value:  value,
-			Column: user.FieldStrings,
+			Column: user.FieldNullableInts,
+		})
+	}
+	if value, ok := uuo.mutation.NullInts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldNullInts,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedNullInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldNullInts, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedNullInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldNullInts, value)
+		})
+	}
+	if uuo.mutation.NullIntsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldNullInts,
+		})
+	}
+	if value, ok := uuo.mutation.EmptyInts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldEmptyInts,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedEmptyInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldEmptyInts, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedEmptyInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldEmptyInts, value)
+		})
+	}
+	if value, ok := uuo.mutation.Times(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTimes,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.AppendedTimes(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldTimes, value)
+		})
+	}
+	if uuo.mutation.TimesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTimes,
+		})
+	}
+	if value, ok := uuo.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldMeta,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedMeta(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldMeta, p)
+			}
+		})
+	}
+	if uuo.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uuo.mutation.Secrets(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldSecrets,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedSecrets(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldSecrets, p)
+			}
+		})
+	}
+	if uuo.mutation.SecretsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldSecrets,
+		})
+	}
+	if value, ok := uuo.mutation.Strings(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldStrings,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldStrings, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldStrings, value)
 		})
 	}
 	if uuo.mutation.StringsCleared() {
@@ -498,10 +2373,235 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uuo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldTags,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedTags(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldTags, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedTags(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldTags, value)
+		})
+	}
+	if uuo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldTags,
+		})
+	}
+	if value, ok := uuo.mutation.Point(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPoint,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedPoint(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldPoint, p)
+			}
+		})
+	}
+	if uuo.mutation.PointCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPoint,
+		})
+	}
+	if value, ok := uuo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPayload,
+			Marshal: func(v interface{}) ([]byte, error) {
+				return user.PayloadMarshaler(v.(schema.Payload))
+			},
+		})
+	}
+	if uuo.mutation.PayloadCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPayload,
+		})
+	}
+	if value, ok := uuo.mutation.Doc(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldDoc,
+			Marshal: sql.Compress("gzip", uuo.jsonMarshal),
+		})
+	}
+	if uuo.mutation.DocCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldDoc,
+		})
+	}
+	if value, ok := uuo.mutation.Pii(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldPii,
+			Marshal: uuo.encryptJSON("pii", uuo.jsonMarshal),
+		})
+	}
+	if uuo.mutation.PiiCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPii,
+		})
+	}
+	if value, ok := uuo.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldLabels,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldLabels, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldLabels, value)
+		})
+	}
+	if uuo.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldLabels,
+		})
+	}
+	if value, ok := uuo.mutation.Attrs(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldAttrs,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if patches, ok := uuo.mutation.MergedAttrs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldAttrs, p)
+			}
+		})
+	}
+	if uuo.mutation.AttrsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldAttrs,
+		})
+	}
+	if value, ok := uuo.mutation.Keywords(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldKeywords,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedKeywords(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldKeywords, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedKeywords(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldKeywords, value)
+		})
+	}
+	if uuo.mutation.KeywordsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldKeywords,
+		})
+	}
+	if value, ok := uuo.mutation.Counts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldCounts,
+			Marshal: uuo.jsonMarshal,
+		})
+	}
+	if value, ok := uuo.mutation.RemovedCounts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONRemove(user.FieldCounts, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedCounts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			u.JSONAppend(user.FieldCounts, value)
+		})
+	}
+	if uuo.mutation.CountsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldCounts,
+		})
+	}
+	if value, ok := uuo.mutation.Props(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:    field.TypeJSON,
+			Value:   value,
+			Column:  user.FieldProps,
+			Marshal: sql.SortKeys(uuo.jsonMarshal),
+		})
+	}
+	if patches, ok := uuo.mutation.MergedProps(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			for _, p := range patches {
+				u.JSONMerge(user.FieldProps, p)
+			}
+		})
+	}
+	if ops, ok := uuo.mutation.PatchedProps(); ok {
+		_spec.Patches = append(_spec.Patches, &sqlgraph.PatchSpec{
+			Column: user.FieldProps,
+			Ops:    ops,
+		})
+	}
+	if uuo.mutation.PropsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldProps,
+		})
+	}
+	if value, ok := uuo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
+	if value, ok := uuo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldVersion,
+		})
+	}
 	u = &User{config: uuo.config}
 	_spec.Assign = u.assignValues
 	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	err = uuo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, uuo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
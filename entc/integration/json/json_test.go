@@ -5,18 +5,37 @@
 package json
 
 import (
+	"bytes"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/facebook/ent/dialect"
 	"github.com/facebook/ent/dialect/sql"
+	"github.com/facebook/ent/dialect/sql/schema"
 	"github.com/facebook/ent/entc/integration/json/ent"
+	"github.com/facebook/ent/entc/integration/json/ent/hook"
 	"github.com/facebook/ent/entc/integration/json/ent/migrate"
+	"github.com/facebook/ent/entc/integration/json/ent/predicate"
+	entschema "github.com/facebook/ent/entc/integration/json/ent/schema"
 	"github.com/facebook/ent/entc/integration/json/ent/user"
+	"github.com/facebook/ent/schema/field"
 
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
@@ -34,21 +53,76 @@ func TestMySQL(t *testing.T) {
 			err = db.Exec(ctx, "CREATE DATABASE IF NOT EXISTS json", []interface{}{}, nil)
 			require.NoError(t, err, "creating database")
 			defer db.Exec(ctx, "DROP DATABASE IF EXISTS json", []interface{}{}, nil)
-			client, err := ent.Open("mysql", fmt.Sprintf("root:pass@tcp(localhost:%d)/json", port))
+			drv, err := sql.Open("mysql", fmt.Sprintf("root:pass@tcp(localhost:%d)/json", port))
 			require.NoError(t, err, "connecting to json database")
+			client := ent.NewClient(ent.Driver(drv))
 			err = client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true))
 			require.NoError(t, err)
 
 			URL(t, client)
+			Immutable(t, client)
+			Compress(t, client, drv)
+			URLs(t, client, drv)
 			Dirs(t, client)
 			Ints(t, client)
+			OptimisticLock(t, client)
+			Hash(t, client)
+			NullableInts(t, client, drv)
+			ScanCoerce(t, client, drv)
+			SortedKeys(t, client, drv)
+			WriteJSON(t, client)
+			RawPatch(t, client)
 			Floats(t, client)
+			Times(t, client)
 			Strings(t, client)
 			RawMessage(t, client)
+			Blob(t, client)
+			Omit(t, client)
+			Types(t, client)
+			ColumnComment(t, client, drv)
+			// Text columns do not depend on the JSON functions of MySQL, and their
+			// predicates are tested also in MySQL 5.6.
+			TextColumns(t, client, drv)
 			// Skip predicates test for MySQL old versions.
-			if version != "56" {
+			supportsJSON, err := client.SupportsJSON(ctx)
+			require.NoError(t, err)
+			require.Equal(t, version != "56", supportsJSON)
+			if supportsJSON {
 				Predicates(t, client)
+				Meta(t, client)
+				Secrets(t, client)
+				RawMerge(t, client)
+				JSONIndex(t, client, drv)
+				ArrayLen(t, client)
+				KeyCount(t, client)
+				HasKeys(t, client)
+				BoolValue(t, client)
+				NullPolicy(t, client)
+				PathQuery(t, client, false)
+				UniqueIndex(t, drv)
+				Payload(t, client, drv)
+				Pagination(t, client)
+				ContainsValue(t, client)
+			}
+			Backfill(t, drv)
+			Copies(t, client)
+			Import(t, client)
+			Tx(t, client)
+			PrettyJSON(t, drv)
+			Debug(t, drv)
+			Codec(t, drv)
+			Encrypt(t, drv)
+			MaxDepth(t, drv)
+			Valuer(t, drv)
+			// JSON_TABLE is available only in MySQL 8.
+			if version == "8" {
+				DefaultExpr(t, client, drv)
+				Aggregate(t, client)
+				ContainsAny(t, client)
+				Remove(t, client, drv)
 			}
+			// Hooks are registered on the client, and therefore, should run last.
+			Hooks(t, client)
 		})
 	}
 }
@@ -65,51 +139,1326 @@ func TestPostgres(t *testing.T) {
 			require.NoError(t, err, "creating database")
 			defer db.Exec(ctx, "DROP DATABASE IF EXISTS json", []interface{}{}, nil)
 
-			client, err := ent.Open(dialect.Postgres, dsn+" dbname=json")
+			drv, err := sql.Open(dialect.Postgres, dsn+" dbname=json")
 			require.NoError(t, err, "connecting to json database")
+			client := ent.NewClient(ent.Driver(drv))
 			defer client.Close()
 			err = client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true))
 			require.NoError(t, err)
+			supportsJSON, err := client.SupportsJSON(ctx)
+			require.NoError(t, err)
+			require.True(t, supportsJSON)
 
+			ColumnType(t, client, drv)
+			ColumnComment(t, client, drv)
+			GINIndex(t, client, drv)
 			URL(t, client)
+			Pagination(t, client)
+			Immutable(t, client)
+			Compress(t, client, drv)
+			URLs(t, client, drv)
 			Dirs(t, client)
 			Ints(t, client)
+			ContainsAny(t, client)
+			Remove(t, client, drv)
+			Copies(t, client)
+			Import(t, client)
+			OptimisticLock(t, client)
+			Hash(t, client)
+			NullableInts(t, client, drv)
+			NullPolicy(t, client)
+			ScanCoerce(t, client, drv)
+			SortedKeys(t, client, drv)
+			WriteJSON(t, client)
+			RawPatch(t, client)
 			Floats(t, client)
+			Times(t, client)
 			Strings(t, client)
 			RawMessage(t, client)
+			Blob(t, client)
+			Omit(t, client)
+			Types(t, client)
+			TextColumns(t, client, drv)
 			Predicates(t, client)
+			Meta(t, client)
+			Secrets(t, client)
+			RawMerge(t, client)
+			Aggregate(t, client)
+			ArrayLen(t, client)
+			KeyCount(t, client)
+			HasKeys(t, client)
+			BoolValue(t, client)
+			Tx(t, client)
+			PrettyJSON(t, drv)
+			Debug(t, drv)
+			Codec(t, drv)
+			Encrypt(t, drv)
+			MaxDepth(t, drv)
+			Valuer(t, drv)
+			Payload(t, client, drv)
+			DefaultExpr(t, client, drv)
+			PathQuery(t, client, version == "12")
+			// jsonb_path_exists is available only in PostgreSQL 12.
+			if version == "12" {
+				ContainsValue(t, client)
+			}
+			UniqueIndex(t, drv)
+			Backfill(t, drv)
+			Hooks(t, client)
 		})
 	}
 }
 
 func TestSQLite(t *testing.T) {
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	drv, err := sql.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
 	defer client.Close()
 	ctx := context.Background()
 	require.NoError(t, client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+	supportsJSON, err := client.SupportsJSON(ctx)
+	require.NoError(t, err)
+	require.True(t, supportsJSON, "json1 extension is enabled")
 
 	URL(t, client)
+	Pagination(t, client)
+	Immutable(t, client)
+	Compress(t, client, drv)
+	URLs(t, client, drv)
 	Dirs(t, client)
 	Ints(t, client)
+	ContainsAny(t, client)
+	Remove(t, client, drv)
+	Copies(t, client)
+	Import(t, client)
+	OptimisticLock(t, client)
+	Hash(t, client)
+	NullableInts(t, client, drv)
+	NullPolicy(t, client)
+	ScanCoerce(t, client, drv)
+	SortedKeys(t, client, drv)
+	WriteJSON(t, client)
+	RawPatch(t, client)
 	Floats(t, client)
+	Times(t, client)
 	Strings(t, client)
 	RawMessage(t, client)
+	Blob(t, client)
+	Omit(t, client)
+	Types(t, client)
+	ContainsValue(t, client)
+	TextColumns(t, client, drv)
 	Predicates(t, client)
+	Meta(t, client)
+	Secrets(t, client)
+	RawMerge(t, client)
+	Aggregate(t, client)
+	ArrayLen(t, client)
+	KeyCount(t, client)
+	HasKeys(t, client)
+	BoolValue(t, client)
+	ScanError(t, client, drv)
+	Marshaler(t, client, drv)
+	Tx(t, client)
+	Retries(t)
+	PrettyJSON(t, drv)
+	Debug(t, drv)
+	Codec(t, drv)
+	Encrypt(t, drv)
+	MaxDepth(t, drv)
+	Valuer(t, drv)
+	Payload(t, client, drv)
+	DefaultExpr(t, client, drv)
+	PathQuery(t, client, false)
+	UniqueIndex(t, drv)
+	Backfill(t, drv)
+	Hooks(t, client)
+}
+
+// TestJSONFields tests that the JSON descriptors of gen.Field are populated
+// for external templates (see ent/template/jsonfields.tmpl).
+func TestJSONFields(t *testing.T) {
+	fields := make(map[string]ent.JSONField)
+	for _, f := range ent.JSONFields["User"] {
+		fields[f.Name] = f
+	}
+	require.Equal(t, ent.JSONField{Name: "ints", Type: "[]int", Elem: "int"}, fields["ints"])
+	require.Equal(t, ent.JSONField{Name: "urls", Type: "[]*url.URL", Elem: "*url.URL"}, fields["urls"])
+	require.Equal(t, ent.JSONField{Name: "meta", Type: "map[string]string", MapValue: "string"}, fields["meta"])
+	require.Equal(t, ent.JSONField{Name: "nullable_ints", Type: "*[]int"}, fields["nullable_ints"])
+	require.Equal(t, ent.JSONField{Name: "point", Type: "schema.Point"}, fields["point"])
+	require.Equal(t, ent.JSONField{Name: "blob", Type: "[]uint8"}, fields["blob"], "byte slices are not arrays")
+	require.NotContains(t, fields, "version", "non-JSON fields are skipped")
+}
+
+// TestRawArrayMethods tests that the array methods are not generated for Raw
+// fields, as their stored bytes are a JSON document, and not an array of bytes.
+func TestRawArrayMethods(t *testing.T) {
+	for _, v := range []interface{}{&ent.User{}, &ent.UserMutation{}, &ent.UserCreate{}, &ent.UserUpdate{}, &ent.UserUpdateOne{}} {
+		for _, name := range []string{"AppendBlob", "RemoveBlob", "SetBlobAt", "BlobLen"} {
+			_, ok := reflect.TypeOf(v).MethodByName(name)
+			require.False(t, ok, "%T.%s", v, name)
+		}
+	}
+	_, ok := reflect.TypeOf(&ent.UserUpdate{}).MethodByName("AppendInts")
+	require.True(t, ok)
+}
+
+func TestJSONAccessors(t *testing.T) {
+	u := &ent.User{Ints: []int{1, 2, 3}, Tags: []string{"a"}}
+	require.Equal(t, 3, u.IntsLen())
+	require.Equal(t, 1, u.TagsLen())
+	require.Zero(t, u.FloatsLen())
+	_, ok := reflect.TypeOf(u).MethodByName("NullableIntsLen")
+	require.False(t, ok, "pointers to slices are skipped by the template")
+	_, ok = reflect.TypeOf(u).MethodByName("MetaLen")
+	require.False(t, ok, "map fields are skipped by the template")
+}
+
+// URLs tests that the "urls" field is stored in the column defined by its
+// StorageKey, and that both predicates and mutations use this column.
+func URLs(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	columns := func() (names []string) {
+		var query string
+		switch drv.Dialect() {
+		case dialect.SQLite:
+			query = "SELECT `name` FROM pragma_table_info('users')"
+		case dialect.MySQL:
+			query = "SELECT `column_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `table_schema` = (SELECT DATABASE()) AND `table_name` = 'users'"
+		case dialect.Postgres:
+			query = `SELECT "column_name" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = 'users'`
+		}
+		rows, err := drv.DB().QueryContext(ctx, query)
+		require.NoError(t, err)
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, strings.ToLower(name))
+		}
+		require.NoError(t, rows.Err())
+		return names
+	}
+	require.Equal(t, "url_list", user.FieldUrls)
+	require.Contains(t, columns(), "url_list")
+	require.NotContains(t, columns(), "urls")
+
+	u1, err := url.Parse("https://entgo.io")
+	require.NoError(t, err)
+	u2, err := url.Parse("https://github.com/facebook/ent")
+	require.NoError(t, err)
+	usr := client.User.Create().SetUrls([]*url.URL{u1}).SaveX(ctx)
+	require.Equal(t, []*url.URL{u1}, client.User.GetX(ctx, usr.ID).Urls)
+	usr = usr.Update().AppendUrls(u2).SaveX(ctx)
+	require.Equal(t, []*url.URL{u1, u2}, client.User.GetX(ctx, usr.ID).Urls)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.UrlsLenEQ(2)).OnlyIDX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.UrlsNotNil()).OnlyIDX(ctx))
+	client.User.Update().Where(user.ID(usr.ID)).ClearUrls().ExecX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Urls)
+	require.Zero(t, client.User.Query().Where(user.UrlsNotNil()).CountX(ctx))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// PrettyJSON tests that JSON fields are indented in the String
+// output of entities only if the PrettyJSON option was set.
+func PrettyJSON(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv))
+	usr := client.User.Create().SetInts([]int{1, 2}).SaveX(ctx)
+	require.Contains(t, client.User.GetX(ctx, usr.ID).String(), "ints=[1 2],")
+
+	client = ent.NewClient(ent.Driver(drv), ent.PrettyJSON())
+	require.Contains(t, client.User.GetX(ctx, usr.ID).String(), "ints=[\n  1,\n  2\n],")
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Codec tests that the JSON codec of the client is used for
+// encoding and decoding all JSON fields without a custom codec.
+// memEncrypter is an in-memory ent.Encrypter that
+// uses AES-GCM with a random key per identifier.
+type memEncrypter struct {
+	keys map[string]cipher.AEAD
+}
+
+func newMemEncrypter(t *testing.T, keyIDs ...string) *memEncrypter {
+	e := &memEncrypter{keys: make(map[string]cipher.AEAD)}
+	for _, id := range keyIDs {
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		block, err := aes.NewCipher(key)
+		require.NoError(t, err)
+		e.keys[id], err = cipher.NewGCM(block)
+		require.NoError(t, err)
+	}
+	return e
+}
+
+func (e *memEncrypter) Encrypt(keyID string, plaintext []byte) ([]byte, error) {
+	aead, ok := e.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (e *memEncrypter) Decrypt(keyID string, ciphertext []byte) ([]byte, error) {
+	aead, ok := e.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+}
+
+func Encrypt(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv), ent.Encrypter(newMemEncrypter(t, "pii")))
+	pii := json.RawMessage(`{"name":"a8m","ssn":"123-45-6789"}`)
+	usr := client.User.Create().SetPii(pii).SaveX(ctx)
+	require.JSONEq(t, string(pii), string(client.User.GetX(ctx, usr.ID).Pii))
+	piis := client.User.Query().Where(user.ID(usr.ID)).PiiOnlyX(ctx)
+	require.Len(t, piis, 1)
+	require.JSONEq(t, string(pii), string(piis[0]))
+
+	// Values are stored encrypted.
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(drv.Dialect()).Select(user.FieldPii).From(sql.Table(user.Table)).Where(sql.EQ(user.FieldID, usr.ID)).Query()
+	require.NoError(t, drv.Query(ctx, query, args, rows))
+	var raw []byte
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&raw))
+	require.NoError(t, rows.Close())
+	require.NotEmpty(t, raw)
+	require.False(t, bytes.Contains(raw, []byte("a8m")), "stored bytes should be encrypted")
+
+	pii = json.RawMessage(`{"name":"nati"}`)
+	usr = usr.Update().SetPii(pii).SaveX(ctx)
+	require.JSONEq(t, string(pii), string(usr.Pii))
+	require.JSONEq(t, string(pii), string(client.User.GetX(ctx, usr.ID).Pii))
+
+	// Encrypted values cannot be stored or loaded without the encrypter.
+	noenc := ent.NewClient(ent.Driver(drv))
+	_, err := noenc.User.Create().SetPii(pii).Save(ctx)
+	require.EqualError(t, err, `marshal value for column pii: ent: missing encrypter for key "pii"`)
+	_, err = noenc.User.Get(ctx, usr.ID)
+	require.EqualError(t, err, `decrypt field pii: ent: missing encrypter for key "pii"`)
+	_, err = ent.NewClient(ent.Driver(drv), ent.Encrypter(newMemEncrypter(t, "pii"))).User.Get(ctx, usr.ID)
+	require.Error(t, err, "decrypting with a different key should fail")
+
+	usr = usr.Update().ClearPii().SaveX(ctx)
+	require.Nil(t, noenc.User.GetX(ctx, usr.ID).Pii)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+func MaxDepth(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	client := ent.NewClient(ent.Driver(drv), ent.JSONMaxDepth(10))
+	nested := strings.Repeat(`{"a": [`, 25) + `1` + strings.Repeat(`]}`, 25)
+	usr := client.User.Create().SetRaw(json.RawMessage(nested)).SaveX(ctx)
+	_, err := client.User.Get(ctx, usr.ID)
+	require.EqualError(t, err, "unmarshal field raw: ent: json document exceeds the maximum depth of 10")
+	_, err = client.User.Query().Where(user.ID(usr.ID)).RawOnly(ctx)
+	require.EqualError(t, err, "unmarshal field raw: ent: json document exceeds the maximum depth of 10")
+
+	// Brackets inside strings do not affect the depth of the document.
+	usr = usr.Update().SetRaw(json.RawMessage(`{"a": [{"b": "[[[[[[[[[[[[\\\"{{{{{{{{{{{{"}]}`)).SaveX(ctx)
+	require.JSONEq(t, `{"a": [{"b": "[[[[[[[[[[[[\\\"{{{{{{{{{{{{"}]}`, string(client.User.GetX(ctx, usr.ID).Raw))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+func Codec(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	var encoded, decoded []string
+	client := ent.NewClient(ent.Driver(drv), ent.JSONCodec(
+		func(v interface{}) ([]byte, error) {
+			buf, err := json.Marshal(v)
+			encoded = append(encoded, string(buf))
+			return buf, err
+		},
+		func(data []byte, v interface{}) error {
+			decoded = append(decoded, string(data))
+			return json.Unmarshal(data, v)
+		},
+	))
+	usr := client.User.Create().SetInts([]int{1, 2}).SetStrings([]string{"a"}).SaveX(ctx)
+	// The "empty_ints" field is set to its default value.
+	require.ElementsMatch(t, []string{"[1,2]", `["a"]`, "[]"}, encoded, "dirs field uses its own marshaler")
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, []int{1, 2}, usr.Ints)
+	require.Contains(t, decoded, "[1,2]")
+	require.NotContains(t, decoded, `["/tmp"]`, "dirs field uses its own unmarshaler")
+
+	encoded, decoded = nil, nil
+	usr = usr.Update().SetInts([]int{3}).SaveX(ctx)
+	require.Equal(t, []string{"[3]"}, encoded)
+	decoded = nil
+	require.Equal(t, [][]int{{3}}, client.User.Query().Where(user.ID(usr.ID)).IntsOnlyX(ctx))
+	require.Equal(t, []string{"[3]"}, decoded)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// PathQuery tests the SQL/JSON path predicate. Filter expressions are tested
+// only in PostgreSQL (12 and above), and the other dialects fall back to checking
+// that a value exists in the path.
+func PathQuery(t *testing.T, client *ent.Client, filters bool) {
+	ctx := context.Background()
+	u1 := client.User.Create().SetInts([]int{1, 6}).SetRaw(json.RawMessage(`{"a":{"b":null}}`)).SaveX(ctx)
+	u2 := client.User.Create().SetInts([]int{2}).SetRaw(json.RawMessage(`{"a":{"c":1}}`)).SaveX(ctx)
+	ids := []int{u1.ID, u2.ID}
+	pathQuery := func(column, path string) []int {
+		return client.User.Query().
+			Where(user.IDIn(ids...), func(s *sql.Selector) {
+				s.Where(sql.JSONPathQuery(s.C(column), path))
+			}).
+			Order(ent.Asc(user.FieldID)).
+			IDsX(ctx)
+	}
+	require.Equal(t, []int{u1.ID}, pathQuery(user.FieldRaw, "$.a.b"), "JSON null values exist")
+	require.Equal(t, []int{u2.ID}, pathQuery(user.FieldRaw, `$."a".c`))
+	require.Equal(t, ids, pathQuery(user.FieldInts, "$[0]"))
+	require.Empty(t, pathQuery(user.FieldInts, "$[2]"))
+	if !filters {
+		return
+	}
+	require.Equal(t, []int{u1.ID}, pathQuery(user.FieldInts, "$[*] ? (@ > 5)"))
+	require.Equal(t, ids, pathQuery(user.FieldInts, "$[*] ? (@ < 5)"))
+	require.Equal(t, []int{u2.ID}, pathQuery(user.FieldRaw, "$.a ? (exists(@.c) && @.c == 1)"))
+	first := client.User.Query().
+		Where(user.IDIn(ids...)).
+		Order(ent.Asc(user.FieldID)).
+		SelectValue(sql.JSONPathQueryFirst(user.FieldInts, "$[*] ? (@ > 1)")).
+		IntsX(ctx)
+	require.Equal(t, []int{6, 2}, first)
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// Payload tests that values of the polymorphic "payload" field are stored with
+// their discriminator, and scanned into their concrete types.
+func Payload(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	usr := client.User.Create().SetPayload(&entschema.Created{Name: "a8m"}).SaveX(ctx)
+	require.Equal(t, &entschema.Created{Name: "a8m"}, client.User.GetX(ctx, usr.ID).Payload)
+	id := client.User.Query().Where(user.ID(usr.ID), func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(user.FieldPayload, "created", "type"))
+	}).OnlyIDX(ctx)
+	require.Equal(t, usr.ID, id, "discriminator is stored in the JSON object")
+
+	usr = usr.Update().SetPayload(&entschema.Deleted{Name: "a8m", Reason: "spam"}).SaveX(ctx)
+	require.Equal(t, &entschema.Deleted{Name: "a8m", Reason: "spam"}, client.User.GetX(ctx, usr.ID).Payload)
+	payloads := client.User.Query().Where(user.ID(usr.ID)).PayloadOnlyX(ctx)
+	require.Equal(t, []entschema.Payload{&entschema.Deleted{Name: "a8m", Reason: "spam"}}, payloads)
+	usr = usr.Update().SetPayload(nil).SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Payload)
+
+	// Unknown discriminators fail the scanning.
+	query, args := sql.Dialect(drv.Dialect()).
+		Update(user.Table).
+		Set(user.FieldPayload, `{"type":"updated"}`).
+		Where(sql.EQ(user.FieldID, usr.ID)).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	_, err := client.User.Get(ctx, usr.ID)
+	require.EqualError(t, err, `unmarshal field payload: unknown discriminator "updated" for key "type"`)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Valuer tests that JSON fields with Go types that implement the driver.Valuer
+// and sql.Scanner interfaces are stored and scanned using them, and not using
+// the JSON codec of the client.
+func Valuer(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	var encoded, decoded []string
+	client := ent.NewClient(ent.Driver(drv), ent.JSONCodec(
+		func(v interface{}) ([]byte, error) {
+			buf, err := json.Marshal(v)
+			if _, ok := v.(entschema.Point); ok {
+				encoded = append(encoded, string(buf))
+			}
+			return buf, err
+		},
+		func(data []byte, v interface{}) error {
+			if _, ok := v.(*entschema.Point); ok {
+				decoded = append(decoded, string(data))
+			}
+			return json.Unmarshal(data, v)
+		},
+	))
+	usr := client.User.Create().SetPoint(entschema.Point{X: 1, Y: 2}).SaveX(ctx)
+	require.Empty(t, encoded, "point field is stored using its Value method")
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(drv.Dialect()).Select(user.FieldPoint).From(sql.Table(user.Table)).Where(sql.EQ(user.FieldID, usr.ID)).Query()
+	require.NoError(t, drv.Query(ctx, query, args, rows))
+	var raw []byte
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&raw))
+	require.NoError(t, rows.Close())
+	require.JSONEq(t, "[1,2]", string(raw))
+
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, entschema.Point{X: 1, Y: 2}, usr.Point)
+	require.Empty(t, decoded, "point field is scanned using its Scan method")
+	usr = usr.Update().SetPoint(entschema.Point{X: 3, Y: 4}).SaveX(ctx)
+	require.Equal(t, []entschema.Point{{X: 3, Y: 4}}, client.User.Query().Where(user.ID(usr.ID)).PointOnlyX(ctx))
+	require.Empty(t, encoded)
+	require.Empty(t, decoded)
+
+	usr = usr.Update().ClearPoint().SaveX(ctx)
+	require.Zero(t, client.User.GetX(ctx, usr.ID).Point)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// DefaultExpr tests that the DEFAULT expression of the "labels" column
+// is applied on rows that are inserted outside ent.
+func DefaultExpr(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	query, args := sql.Dialect(drv.Dialect()).
+		Insert(user.Table).
+		Columns(user.FieldVersion).
+		Values(-1).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	usr := client.User.Query().Where(user.Version(-1)).OnlyX(ctx)
+	require.NotNil(t, usr.Labels)
+	require.Empty(t, usr.Labels)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.LabelsIsEmptyArray()).OnlyIDX(ctx))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Debug tests that marshaled JSON values are logged as
+// readable strings by the debug driver.
+func Debug(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	var logs strings.Builder
+	client := ent.NewClient(ent.Driver(drv), ent.Debug(), ent.Log(func(v ...interface{}) {
+		logs.WriteString(fmt.Sprintln(v...))
+	}))
+	usr := client.User.Create().SetInts([]int{1, 2}).SaveX(ctx)
+	require.Contains(t, logs.String(), "[1,2]")
+	logs.Reset()
+	client.User.Query().
+		Where(user.ID(usr.ID), func(s *sql.Selector) {
+			s.Where(sql.JSONEQ(s.C(user.FieldInts), json.RawMessage("[1,2]")))
+		}).
+		OnlyX(ctx)
+	require.Contains(t, logs.String(), "[1,2]")
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Retries tests that concurrent appends to the same SQLite row succeed, when
+// the client is configured to retry updates that failed on a locked database.
+// The busy handler of SQLite is disabled, and locked databases fail immediately.
+func Retries(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "ent")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	drv, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_busy_timeout=0&_fk=1", filepath.Join(dir, "retries.db")))
+	require.NoError(t, err)
+	defer drv.Close()
+	client := ent.NewClient(ent.Driver(drv), ent.MaxRetries(100))
+	require.NoError(t, client.Schema.Create(ctx))
+	usr := client.User.Create().SaveX(ctx)
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 2)
+	)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := client.User.UpdateOneID(usr.ID).AppendInts(i*100 + j).Save(ctx); err != nil {
+					errs[i] = err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	ints := client.User.GetX(ctx, usr.ID).Ints
+	require.Len(t, ints, 40)
+	// The values of each goroutine are appended in their order.
+	var first, second []int
+	for _, v := range ints {
+		if v < 100 {
+			first = append(first, v)
+		} else {
+			second = append(second, v-100)
+		}
+	}
+	require.Equal(t, first, second)
+	require.True(t, sort.IntsAreSorted(first))
+}
+
+// Tx tests that concurrent transactions that update the same JSON array do not lose updates.
+// Half of the transactions append to the array, and the rest read the array using ForUpdate,
+// and store it with the new element.
+func Tx(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{}).SaveX(ctx)
+	update := func(v int) error {
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		if v%2 == 0 {
+			err = tx.User.UpdateOneID(usr.ID).AppendInts(v).Exec(ctx)
+		} else {
+			var u *ent.User
+			if u, err = tx.User.Query().Where(user.ID(usr.ID)).ForUpdate().Only(ctx); err == nil {
+				err = u.Update().SetInts(append(u.Ints, v)).Exec(ctx)
+			}
+		}
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%v: %v", err, rerr)
+			}
+			return err
+		}
+		return tx.Commit()
+	}
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, 4)
+	)
+	for i := 1; i <= cap(errs); i++ {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			var err error
+			// SQLite fails concurrent writing transactions instead of blocking
+			// them (MySQL and PostgreSQL). Therefore, they are retried.
+			for i := 0; i < 100; i++ {
+				if err = update(v); err == nil {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	ints := client.User.GetX(ctx, usr.ID).Ints
+	sort.Ints(ints)
+	require.Equal(t, []int{1, 2, 3, 4}, ints)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Hooks tests that mutation hooks can read the old value of JSON
+// fields, in order to diff it with the new value (e.g. for auditing).
+func Hooks(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	type change struct{ old, new []int }
+	var changes []change
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+			v, set := m.Ints()
+			ok := set
+			if ok {
+				require.Contains(t, m.Fields(), user.FieldInts)
+			} else {
+				// Appended values are applied on the stored array,
+				// and are not reported by the Fields method.
+				v, ok = m.AppendedInts()
+			}
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			old, err := m.OldInts(ctx)
+			if err != nil {
+				return nil, err
+			}
+			generic, err := m.OldField(ctx, user.FieldInts)
+			if err != nil {
+				return nil, err
+			}
+			require.Equal(t, old, generic)
+			// Both values are decoded to their Go types.
+			if set && len(v) < len(old) {
+				return nil, fmt.Errorf("shrinking ints from %d to %d elements", len(old), len(v))
+			}
+			changes = append(changes, change{old: old, new: v})
+			return next.Mutate(ctx, m)
+		})
+	})
+	usr := client.User.Create().SetInts([]int{1}).SaveX(ctx)
+	require.Empty(t, changes, "old values are not available on creation")
+	usr = usr.Update().SetInts([]int{1, 2}).SaveX(ctx)
+	usr = usr.Update().AppendInts(3).SaveX(ctx)
+	usr = usr.Update().SetStrings([]string{"a"}).SaveX(ctx)
+	require.Equal(t, []change{
+		{old: []int{1}, new: []int{1, 2}},
+		{old: []int{1, 2}, new: []int{3}},
+	}, changes)
+	require.Equal(t, []int{1, 2, 3}, usr.Ints)
+	_, err := usr.Update().SetInts([]int{1, 2}).Save(ctx)
+	require.EqualError(t, err, "shrinking ints from 3 to 2 elements")
+	require.Equal(t, []int{1, 2, 3}, client.User.GetX(ctx, usr.ID).Ints)
+	usr = usr.Update().SetInts([]int{3, 2, 1}).SaveX(ctx)
+	require.Equal(t, change{old: []int{1, 2, 3}, new: []int{3, 2, 1}}, changes[len(changes)-1])
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Marshaler tests the custom marshaler and unmarshaler of the "dirs" field.
+func Marshaler(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	dirs := []http.Dir{"<a&b>"}
+	usr := client.User.Create().SetDirs(dirs).SaveX(ctx)
+	require.Equal(t, dirs, client.User.GetX(ctx, usr.ID).Dirs)
+	var raw string
+	err := drv.DB().QueryRowContext(ctx, "SELECT `dirs` FROM `users` WHERE `id` = ?", usr.ID).Scan(&raw)
+	require.NoError(t, err)
+	require.Equal(t, `["<a&b>"]`, raw, "HTML escaping should be disabled")
+	_, err = drv.DB().ExecContext(ctx, "UPDATE `users` SET `dirs` = '\"/var\"' WHERE `id` = ?", usr.ID)
+	require.NoError(t, err)
+	require.Equal(t, []http.Dir{"/var"}, client.User.GetX(ctx, usr.ID).Dirs)
+	usr = usr.Update().SetDirs([]http.Dir{"/usr", "/tmp"}).SaveX(ctx)
+	require.Equal(t, []http.Dir{"/usr", "/tmp"}, client.User.GetX(ctx, usr.ID).Dirs)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// ScanError tests that invalid JSON values are reported when scanning
+// the rows. MySQL and PostgreSQL do not accept invalid JSON documents.
+func ScanError(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{1, 2}).SaveX(ctx)
+	_, err := drv.DB().ExecContext(ctx, "UPDATE `users` SET `ints` = '[1, 2' WHERE `id` = ?", usr.ID)
+	require.NoError(t, err)
+	_, err = client.User.Get(ctx, usr.ID)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unmarshal field ints")
+	var serr *json.SyntaxError
+	require.True(t, errors.As(err, &serr), "expect the json error to be wrapped")
+	_, err = client.User.Query().Where(user.ID(usr.ID)).All(ctx)
+	require.True(t, errors.As(err, &serr))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
 }
 
 func Ints(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	ints := []int{1, 2, 3}
 	usr := client.User.Create().SetInts(ints).SaveX(ctx)
-	require.Equal(t, ints, usr.Ints)
-	require.Equal(t, ints, client.User.GetX(ctx, usr.ID).Ints)
+	require.True(t, usr.IntsEqual(ints))
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual(ints))
+	require.False(t, usr.IntsEqual([]int{1, 2, 4}))
+	require.False(t, usr.IntsEqual(ints[:2]))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.IntsLenEQ(3)).OnlyIDX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.IntsLenGT(2)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.IntsLenLT(3)).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsAny(sql.GT, 2)).OnlyIDX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsAny(sql.EQ, 1)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsAny(sql.GT, 3)).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsAll(sql.LT, 10)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsAll(sql.LT, 3)).CountX(ctx))
 	usr = usr.Update().SetInts(ints[:1]).SaveX(ctx)
-	require.Equal(t, ints[:1], usr.Ints)
-	require.Equal(t, ints[:1], client.User.GetX(ctx, usr.ID).Ints)
+	require.True(t, usr.IntsEqual(ints[:1]))
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual(ints[:1]))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.IntsLenLT(3)).OnlyIDX(ctx))
+	usr = usr.Update().SetInts(ints).SaveX(ctx)
+	usr = usr.Update().SetIntAt(0, 10).SetIntAt(2, 30).SaveX(ctx)
+	require.True(t, usr.IntsEqual([]int{10, 2, 30}))
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual([]int{10, 2, 30}))
+	usr = usr.Update().SetInts(ints).SetIntAt(1, 20).SaveX(ctx)
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual([]int{1, 20, 3}))
+	client.User.Update().Where(user.ID(usr.ID)).AppendInts(4).ExecX(ctx)
+	client.User.Update().Where(user.ID(usr.ID)).SetIntAt(0, 10).AppendInts(5).ExecX(ctx)
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual([]int{10, 20, 3, 4, 5}))
+	// Out of range indexes are ignored, and negative indexes are rejected.
+	usr = usr.Update().SetIntAt(5, 60).SetIntAt(10, 100).SaveX(ctx)
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual([]int{10, 20, 3, 4, 5}))
+	usr = usr.Update().SetInts(ints).SetIntAt(3, 40).SaveX(ctx)
+	require.True(t, usr.IntsEqual(ints))
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual(ints))
+	err := usr.Update().SetIntAt(-1, 0).Exec(ctx)
+	require.EqualError(t, err, `ent: negative index -1 for field "ints"`)
+	err = client.User.Update().Where(user.ID(usr.ID)).SetInts(ints).SetIntAt(-1, 0).Exec(ctx)
+	require.EqualError(t, err, `ent: negative index -1 for field "ints"`)
+	require.True(t, client.User.GetX(ctx, usr.ID).IntsEqual(ints))
+	usr = usr.Update().SetInts([]int{}).SaveX(ctx)
+	require.NotNil(t, usr.Ints)
+	require.Equal(t, []int{}, client.User.GetX(ctx, usr.ID).Ints, "empty arrays are not stored as NULL")
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsNotNil()).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsAny(sql.GT, 0)).CountX(ctx), "no element of an empty array matches")
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsAll(sql.LT, 0)).OnlyIDX(ctx), "all elements of an empty array match")
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsIsEmptyArray()).OnlyIDX(ctx))
 	usr = usr.Update().ClearInts().SaveX(ctx)
-	require.Empty(t, usr.Ints)
-	require.Empty(t, client.User.GetX(ctx, usr.ID).Ints)
+	require.Nil(t, usr.Ints)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Ints)
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsNotNil()).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsIsNil()).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsAny(sql.GT, 0)).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsIsEmptyArray()).CountX(ctx), "NULL is not an empty array")
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.IntsAll(sql.LT, 0)).OnlyIDX(ctx))
+	usr = usr.Update().SetInts(ints).SaveX(ctx)
+	usr = usr.Update().SetInts(nil).SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Ints)
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.IntsNotNil()).CountX(ctx), "nil values are stored as NULL")
+
+	// Each row in the batch keeps its own slice.
+	users := client.User.CreateBulk(
+		client.User.Create().SetInts([]int{1}),
+		client.User.Create().SetInts([]int{2, 3}),
+		client.User.Create(),
+	).SaveX(ctx)
+	require.Len(t, users, 3)
+	require.Equal(t, []int{1}, client.User.GetX(ctx, users[0].ID).Ints)
+	require.Equal(t, []int{2, 3}, client.User.GetX(ctx, users[1].ID).Ints)
+	require.Empty(t, client.User.GetX(ctx, users[2].ID).Ints)
+
+	// Select only the JSON column.
+	empty := client.User.Create().SetInts([]int{}).SaveX(ctx)
+	vs := client.User.Query().
+		Where(user.IDIn(users[0].ID, users[1].ID, users[2].ID, empty.ID)).
+		Order(ent.Asc(user.FieldID)).
+		IntsOnlyX(ctx)
+	require.Equal(t, [][]int{{1}, {2, 3}, nil, {}}, vs)
+	require.Empty(t, client.User.Query().Where(user.ID(-1)).IntsOnlyX(ctx))
+	client.User.DeleteOneID(users[0].ID).ExecX(ctx)
+	client.User.DeleteOneID(users[1].ID).ExecX(ctx)
+	client.User.DeleteOneID(users[2].ID).ExecX(ctx)
+	client.User.DeleteOneID(empty.ID).ExecX(ctx)
+}
+
+// ContainsAny tests the array overlap predicate on the "ints" field.
+// It requires JSON_OVERLAPS in MySQL, which is available only in MySQL 8.
+func ContainsAny(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetInts([]int{1, 2, 3}),
+		client.User.Create().SetInts([]int{3, 4}),
+		client.User.Create().SetInts([]int{}),
+		client.User.Create(),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	query := func(vs ...int) []int {
+		return client.User.Query().
+			Where(user.IDIn(ids...), user.IntsContainsAny(vs)).
+			Order(ent.Asc(user.FieldID)).
+			IDsX(ctx)
+	}
+	require.Equal(t, ids[:1], query(2, 5))
+	require.Equal(t, ids[:2], query(3))
+	require.Equal(t, ids[1:2], query(4, 5, 6))
+	require.Empty(t, query(5, 6))
+	require.Empty(t, query())
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// Remove tests that values are removed from JSON arrays in the database.
+// It requires JSON_TABLE in MySQL, which is available only in MySQL 8.
+func Remove(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{1, 2, 3, 2}).SetStrings([]string{"a", "b", "a"}).SaveX(ctx)
+	usr = usr.Update().RemoveInts(2).SaveX(ctx)
+	require.Equal(t, []int{1, 3}, usr.Ints, "all occurrences are removed")
+	require.Equal(t, []int{1, 3}, client.User.GetX(ctx, usr.ID).Ints)
+	usr = usr.Update().RemoveInts(2, 5).SaveX(ctx)
+	require.Equal(t, []int{1, 3}, usr.Ints, "removing absent values is a no-op")
+	usr = usr.Update().RemoveStrings("a").SaveX(ctx)
+	require.Equal(t, []string{"b"}, usr.Strings)
+	usr = usr.Update().RemoveInts(1, 3).SaveX(ctx)
+	require.Equal(t, []int{}, usr.Ints, "removing all values leaves an empty array")
+
+	// Values are removed before new values are appended.
+	client.User.Update().Where(user.ID(usr.ID)).SetInts([]int{1, 2}).ExecX(ctx)
+	client.User.Update().Where(user.ID(usr.ID)).RemoveInts(1).AppendInts(1, 3).ExecX(ctx)
+	require.Equal(t, []int{2, 1, 3}, client.User.GetX(ctx, usr.ID).Ints)
+
+	// NULL values are kept as is.
+	usr = usr.Update().ClearInts().SaveX(ctx)
+	usr = usr.Update().RemoveInts(1).SaveX(ctx)
+	require.Nil(t, usr.Ints)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Ints)
+
+	err := usr.Update().SetInts([]int{1}).RemoveInts(1).Exec(ctx)
+	require.Error(t, err)
+	err = usr.Update().ClearInts().RemoveInts(1).Exec(ctx)
+	require.Error(t, err)
+
+	// Nulls, booleans, objects and arrays are matched by their JSON values.
+	raw := func() string {
+		return string(client.User.GetX(ctx, usr.ID).Raw)
+	}
+	remove := func(vs ...interface{}) {
+		query, args := sql.Dialect(drv.Dialect()).
+			Update(user.Table).
+			JSONRemove(user.FieldRaw, vs).
+			Where(sql.EQ(user.FieldID, usr.ID)).
+			Query()
+		require.NoError(t, drv.Exec(ctx, query, args, nil))
+	}
+	usr = usr.Update().SetRaw(json.RawMessage(`[1, null, "1", {"a": 1}, [1, 2], true, null]`)).SaveX(ctx)
+	remove(nil)
+	require.JSONEq(t, `[1, "1", {"a": 1}, [1, 2], true]`, raw(), "only nulls are removed")
+	remove(true, map[string]int{"a": 1}, []int{1, 2})
+	require.JSONEq(t, `[1, "1"]`, raw(), "booleans are not matched as numbers")
+	remove(1)
+	require.JSONEq(t, `["1"]`, raw(), "numbers are not matched as strings")
+	client.User.DeleteOne(usr).ExecX(ctx)
+}
+
+// Copies tests that the Get<Field> methods return copies of JSON slices and
+// maps, that can be modified without affecting the entity.
+func Copies(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{1, 2, 3}).SetMeta(map[string]string{"a": "b"}).SaveX(ctx)
+	ints := usr.GetInts()
+	require.Equal(t, []int{1, 2, 3}, ints)
+	ints[0] = 10
+	require.Equal(t, []int{1, 2, 3}, usr.Ints)
+	meta := usr.GetMeta()
+	require.Equal(t, map[string]string{"a": "b"}, meta)
+	meta["a"] = "c"
+	delete(meta, "a")
+	require.Equal(t, map[string]string{"a": "b"}, usr.Meta)
+
+	usr = client.User.GetX(ctx, usr.ID)
+	usr.GetInts()[1] = 20
+	usr.GetMeta()["c"] = "d"
+	require.Equal(t, []int{1, 2, 3}, usr.Ints)
+	require.Equal(t, map[string]string{"a": "b"}, usr.Meta)
+	usr = usr.Update().SetInts([]int{}).ClearMeta().SaveX(ctx)
+	require.Equal(t, []int{}, usr.GetInts(), "empty slices are copied as empty slices")
+	require.Nil(t, usr.GetMeta())
+	require.Nil(t, usr.GetFloats())
+	client.User.DeleteOne(usr).ExecX(ctx)
+}
+
+// Import tests that users are created in bulk from newline-delimited JSON objects,
+// and that malformed lines fail the import, unless they are skipped.
+func Import(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	valid := []string{
+		`{"ints": [1, 2, 3], "strings": ["a"], "meta": {"k": "v"}}`,
+		``,
+		`{"tags": ["a", "b"], "version": 2, "url": {"Scheme": "https", "Host": "entgo.io"}}`,
+		`{"ints": null, "dirs": "/home"}`,
+	}
+	users, err := client.User.CreateFromJSON(ctx, strings.NewReader(strings.Join(valid, "\n")))
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	require.Equal(t, []int{1, 2, 3}, client.User.GetX(ctx, users[0].ID).Ints)
+	require.Equal(t, []string{"a"}, client.User.GetX(ctx, users[0].ID).Strings)
+	require.Equal(t, map[string]string{"k": "v"}, client.User.GetX(ctx, users[0].ID).Meta)
+	require.Equal(t, []http.Dir{"/tmp"}, users[0].Dirs, "default values are applied")
+	require.Equal(t, []string{"a", "b"}, client.User.GetX(ctx, users[1].ID).Tags)
+	require.Equal(t, 2, client.User.GetX(ctx, users[1].ID).Version)
+	require.Equal(t, "https://entgo.io", client.User.GetX(ctx, users[1].ID).URL.String())
+	require.Nil(t, client.User.GetX(ctx, users[2].ID).Ints)
+	require.Equal(t, []http.Dir{"/home"}, client.User.GetX(ctx, users[2].ID).Dirs, "custom unmarshalers are used")
+	ids := []int{users[0].ID, users[1].ID, users[2].ID}
+
+	malformed := []string{
+		`{"ints": "1"}`,
+		`{"unknown": 1}`,
+		`[{"ints": [1]}]`,
+		`null`,
+		`{"tags": ["d"]}`,
+		`{"strings": []}`,
+		`{"ints": [1]`,
+	}
+	lines := append(valid[:1:1], malformed...)
+	n := client.User.Query().CountX(ctx)
+	_, err = client.User.CreateFromJSON(ctx, strings.NewReader(strings.Join(lines, "\n")))
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), `ent: malformed line 2: decoding field "ints"`), err.Error())
+	require.Equal(t, n, client.User.Query().CountX(ctx), "nothing is created on failure")
+
+	var skipped []int
+	users, err = client.User.CreateFromJSON(ctx, strings.NewReader(strings.Join(lines, "\n")), ent.SkipMalformed(func(line int, err error) {
+		require.Error(t, err)
+		skipped = append(skipped, line)
+	}))
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, []int{1, 2, 3}, client.User.GetX(ctx, users[0].ID).Ints)
+	require.Equal(t, []int{2, 3, 4, 5, 6, 7, 8}, skipped)
+	ids = append(ids, users[0].ID)
+	users, err = client.User.CreateFromJSON(ctx, strings.NewReader(""))
+	require.NoError(t, err)
+	require.Empty(t, users)
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// OptimisticLock tests that updates changing only a JSON field are not
+// skipped, and can be guarded by a version column.
+func OptimisticLock(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInts([]int{1}).SaveX(ctx)
+	require.Zero(t, usr.Version)
+	update := func(version int, ints []int) int {
+		return client.User.Update().
+			Where(user.ID(usr.ID), user.Version(version)).
+			SetInts(ints).
+			AddVersion(1).
+			SaveX(ctx)
+	}
+	require.Equal(t, 1, update(usr.Version, []int{1, 2}))
+	require.Zero(t, update(usr.Version, []int{1, 2, 3}), "stale version")
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, 1, usr.Version)
+	require.Equal(t, []int{1, 2}, usr.Ints)
+
+	// Only one of the concurrent updates that read the same version is applied.
+	var (
+		wg       sync.WaitGroup
+		affected = make([]int, 5)
+	)
+	for i := range affected {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			affected[i] = update(usr.Version, []int{i})
+		}(i)
+	}
+	wg.Wait()
+	var total int
+	for _, n := range affected {
+		total += n
+	}
+	require.Equal(t, 1, total)
+	usr = client.User.GetX(ctx, usr.ID)
+	require.Equal(t, 2, usr.Version)
+	require.Len(t, usr.Ints, 1)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Hash tests that the hash of JSON fields depends only on their content,
+// and does not change with the order of object keys or their formatting.
+func Hash(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	u1 := client.User.Create().SetInts([]int{1, 2}).SetRaw(json.RawMessage(`{"a": 1, "b": [1, 2]}`)).SaveX(ctx)
+	u2 := client.User.Create().SetInts([]int{1, 2}).SetRaw(json.RawMessage(`{"b":[1,2],"a":1}`)).SaveX(ctx)
+	h1, err := u1.RawHash()
+	require.NoError(t, err)
+	h2, err := u2.RawHash()
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+	h1, err = client.User.GetX(ctx, u1.ID).RawHash()
+	require.NoError(t, err)
+	require.Equal(t, h1, h2, "hash is stable after storing the value")
+	h1, err = u1.IntsHash()
+	require.NoError(t, err)
+	h2, err = u2.IntsHash()
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+	u2 = u2.Update().AppendInts(3).SaveX(ctx)
+	h2, err = u2.IntsHash()
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h2)
+	client.User.DeleteOneID(u1.ID).ExecX(ctx)
+	client.User.DeleteOneID(u2.ID).ExecX(ctx)
+}
+
+// NullableInts tests that SQL NULL and JSON null are scanned differently for pointer types.
+func NullableInts(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	usr := client.User.Create().SetNullableInts(&[]int{1, 2}).SaveX(ctx)
+	require.Equal(t, &[]int{1, 2}, client.User.GetX(ctx, usr.ID).NullableInts)
+
+	// SQL NULL.
+	usr = usr.Update().SetNullableInts(nil).SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).NullableInts)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.NullableIntsIsNil()).OnlyIDX(ctx))
+
+	// JSON null.
+	query, args := sql.Dialect(drv.Dialect()).
+		Update(user.Table).
+		Set(user.FieldNullableInts, "null").
+		Where(sql.EQ(user.FieldID, usr.ID)).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	ints := client.User.GetX(ctx, usr.ID).NullableInts
+	require.NotNil(t, ints)
+	require.Nil(t, *ints)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.NullableIntsNotNil()).OnlyIDX(ctx))
+
+	// Pointers to nil slices are stored as JSON null.
+	usr = usr.Update().SetNullableInts(&[]int{3}).SaveX(ctx)
+	usr = usr.Update().SetNullableInts(new([]int)).SaveX(ctx)
+	ints = client.User.GetX(ctx, usr.ID).NullableInts
+	require.NotNil(t, ints)
+	require.Nil(t, *ints)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// ScanCoerce tests that values that were stored in the old shape of the
+// "counts" field ([]string) are converted to its new shape ([]int) on scan.
+func ScanCoerce(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	usr := client.User.Create().SetCounts([]int{1, 2}).SaveX(ctx)
+	require.Equal(t, []int{1, 2}, client.User.GetX(ctx, usr.ID).Counts)
+
+	// Rows that were written before the field was changed.
+	query, args := sql.Dialect(drv.Dialect()).
+		Update(user.Table).
+		Set(user.FieldCounts, `["3","4",5]`).
+		Where(sql.EQ(user.FieldID, usr.ID)).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	require.Equal(t, []int{3, 4, 5}, client.User.GetX(ctx, usr.ID).Counts)
+	require.Equal(t, [][]int{{3, 4, 5}}, client.User.Query().Where(user.ID(usr.ID)).CountsOnlyX(ctx))
+
+	// Updates are stored in the new shape.
+	usr = client.User.UpdateOneID(usr.ID).AppendCounts(6).SaveX(ctx)
+	require.Equal(t, []int{3, 4, 5, 6}, client.User.GetX(ctx, usr.ID).Counts)
+
+	// Coercion errors fail the query.
+	query, args = sql.Dialect(drv.Dialect()).
+		Update(user.Table).
+		Set(user.FieldCounts, `["x"]`).
+		Where(sql.EQ(user.FieldID, usr.ID)).
+		Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	_, err := client.User.Get(ctx, usr.ID)
+	require.Error(t, err)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// SortedKeys tests that the values of fields annotated with entsql.SortedKeys
+// are stored in a canonical form, regardless of the order of their keys.
+func SortedKeys(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	stored := func(id int) string {
+		rows := &sql.Rows{}
+		query, args := sql.Dialect(drv.Dialect()).Select(user.FieldProps).From(sql.Table(user.Table)).Where(sql.EQ(user.FieldID, id)).Query()
+		require.NoError(t, drv.Query(ctx, query, args, rows))
+		defer rows.Close()
+		var raw []byte
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&raw))
+		return string(raw)
+	}
+	const canonical = `{"a":{"c":null,"d":[2,1]},"b":1.50}`
+	usr := client.User.Create().SetProps(json.RawMessage(`{"b": 1.50, "a": {"d": [2, 1], "c": null}}`)).SaveX(ctx)
+	require.Equal(t, canonical, stored(usr.ID))
+	require.JSONEq(t, canonical, string(client.User.GetX(ctx, usr.ID).Props))
+
+	// Equal documents are stored using the same bytes.
+	usr = usr.Update().SetProps(json.RawMessage(`{"a":{"c":null, "d":[2,1]}, "b":1.50}`)).SaveX(ctx)
+	require.Equal(t, canonical, stored(usr.ID))
+	client.User.UpdateOneID(usr.ID).SetProps(json.RawMessage(`{"b":1.50,"a":{"d":[2,1],"c":null}}`)).ExecX(ctx)
+	require.Equal(t, canonical, stored(usr.ID))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// WriteJSON tests that the JSON fields of the exported users are written
+// as nested JSON values, and that the output can be decoded back to users.
+func WriteJSON(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	u, err := url.Parse("https://github.com/facebook/ent")
+	require.NoError(t, err)
+	users := client.User.CreateBulk(
+		client.User.Create().SetURL(u).SetRaw(json.RawMessage(`{"a":{"b":[1,"2"]}}`)).SetInts([]int{1, 2}),
+		client.User.Create().SetRaw(json.RawMessage(`[null]`)).SetSecrets(map[string]string{"token": "secret"}),
+		client.User.Create(),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	var b bytes.Buffer
+	client.User.Query().Where(user.IDIn(ids...)).Order(ent.Asc(user.FieldID)).WriteJSONX(ctx, &b)
+
+	var raw []map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(b.Bytes(), &raw))
+	require.Len(t, raw, 3)
+	require.JSONEq(t, `{"a":{"b":[1,"2"]}}`, string(raw[0]["raw"]))
+	require.JSONEq(t, `[1,2]`, string(raw[0]["ints"]))
+	require.Equal(t, byte('{'), raw[0]["url"][0], "url is written as a JSON object")
+	require.NotContains(t, raw[1], "secrets", "sensitive fields are omitted")
+
+	var got []*ent.User
+	require.NoError(t, json.Unmarshal(b.Bytes(), &got))
+	require.Len(t, got, 3)
+	for i := range got {
+		require.Equal(t, users[i].ID, got[i].ID)
+	}
+	require.Equal(t, u.String(), got[0].URL.String())
+	require.Equal(t, []int{1, 2}, got[0].Ints)
+	require.JSONEq(t, `[null]`, string(got[1].Raw))
+	require.Nil(t, got[2].URL)
+	require.Empty(t, got[2].Raw)
+
+	// No matching users are written as an empty array.
+	b.Reset()
+	client.User.Query().Where(user.ID(-1)).WriteJSONX(ctx, &b)
+	require.Equal(t, "[]", b.String())
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// NullPolicy tests the storage of nil values for each EmitNull policy.
+func NullPolicy(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SaveX(ctx)
+	count := func(p predicate.User) int {
+		return client.User.Query().Where(user.ID(usr.ID), p).CountX(ctx)
+	}
+	// Required fields with EmitNull(false) store nil values as empty arrays.
+	require.NotNil(t, usr.EmptyInts)
+	require.Empty(t, usr.EmptyInts)
+	require.Equal(t, 1, count(user.EmptyIntsIsEmptyArray()))
+
+	// Without a policy, nil values of optional arrays clear the field,
+	// and are distinct from empty arrays.
+	usr = usr.Update().SetInts(nil).SetNullInts(nil).SetEmptyInts(nil).SaveX(ctx)
+	require.Equal(t, 1, count(user.IntsIsNil()))
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Ints)
+	usr = usr.Update().SetInts([]int{}).SaveX(ctx)
+	require.Equal(t, 1, count(user.IntsIsEmptyArray()))
+	require.Equal(t, []int{}, client.User.GetX(ctx, usr.ID).Ints)
+
+	// EmitNull(true) stores nil values as JSON null, and the
+	// field is cleared (stored as NULL) only by ClearNullInts.
+	require.Equal(t, 1, count(user.NullIntsNotNil()))
+	require.Nil(t, client.User.GetX(ctx, usr.ID).NullInts)
+	usr = usr.Update().ClearNullInts().SaveX(ctx)
+	require.Equal(t, 1, count(user.NullIntsIsNil()))
+
+	// EmitNull(false) on a required field stores nil values as empty arrays.
+	usr = usr.Update().SetEmptyInts([]int{1}).SaveX(ctx)
+	require.Equal(t, []int{1}, client.User.GetX(ctx, usr.ID).EmptyInts)
+	usr = usr.Update().SetEmptyInts(nil).SaveX(ctx)
+	require.Equal(t, []int{}, usr.EmptyInts)
+	require.Equal(t, []int{}, client.User.GetX(ctx, usr.ID).EmptyInts)
+	require.Equal(t, 1, count(user.EmptyIntsIsEmptyArray()))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Aggregate tests the aggregation functions on the elements of JSON arrays.
+func Aggregate(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetInts([]int{1, 2, 3}),
+		client.User.Create().SetInts([]int{4}),
+	).SaveX(ctx)
+	var v []struct {
+		ID  int `json:"id"`
+		Sum int `json:"sum"`
+	}
+	client.User.Query().
+		Where(user.IDIn(users[0].ID, users[1].ID)).
+		GroupBy(user.FieldID).
+		Aggregate(ent.As(sql.JSONArraySum(user.FieldInts), "sum")).
+		ScanX(ctx, &v)
+	require.Len(t, v, 2)
+	sums := map[int]int{v[0].ID: v[0].Sum, v[1].ID: v[1].Sum}
+	require.Equal(t, map[int]int{users[0].ID: 6, users[1].ID: 4}, sums)
+	client.User.Delete().Where(user.IDIn(users[0].ID, users[1].ID)).ExecX(ctx)
+}
+
+// ArrayLen tests the selection of JSON array lengths in group-by queries.
+func ArrayLen(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetInts([]int{1, 2, 3}),
+		client.User.Create().SetInts([]int{4}),
+		client.User.Create().SetInts([]int{}),
+	).SaveX(ctx)
+	ids := []int{users[0].ID, users[1].ID, users[2].ID}
+	var v []struct {
+		ID int `json:"id"`
+		N  int `json:"n"`
+	}
+	client.User.Query().
+		Where(user.IDIn(ids...)).
+		GroupBy(user.FieldID).
+		Aggregate(ent.As(sql.JSONArrayLen(user.FieldInts), "n")).
+		ScanX(ctx, &v)
+	require.Len(t, v, 3)
+	lens := make(map[int]int)
+	for i := range v {
+		lens[v[i].ID] = v[i].N
+	}
+	require.Equal(t, map[int]int{ids[0]: 3, ids[1]: 1, ids[2]: 0}, lens)
+
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+func KeyCount(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	u, err := url.Parse("https://github.com/facebook/ent")
+	require.NoError(t, err)
+	users := client.User.CreateBulk(
+		client.User.Create().SetRaw([]byte(`{}`)),
+		client.User.Create().SetRaw([]byte(`{"a":1}`)),
+		client.User.Create().SetRaw([]byte(`{"a":1,"b":{"c":2,"d":3},"e":[4,5]}`)),
+		client.User.Create().SetRaw([]byte(`[1,2,3]`)),
+		client.User.Create().SetURL(u),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	query := func(p predicate.User) []int {
+		return client.User.Query().Where(user.IDIn(ids...), p).Order(ent.Asc(user.FieldID)).IDsX(ctx)
+	}
+	// Only the top-level keys are counted, and arrays
+	// and NULL values do not match the predicates.
+	require.Equal(t, ids[:1], query(user.RawKeyCountEQ(0)))
+	require.Equal(t, ids[1:2], query(user.RawKeyCountEQ(1)))
+	require.Equal(t, ids[2:3], query(user.RawKeyCountEQ(3)))
+	require.Equal(t, ids[1:3], query(user.RawKeyCountGT(0)))
+	require.Equal(t, ids[:2], query(user.RawKeyCountLT(3)))
+	require.Empty(t, query(user.RawKeyCountGT(3)))
+	require.Equal(t, ids[4:], query(user.URLKeyCountGT(3)))
+	require.Empty(t, query(user.URLKeyCountLT(3)))
+
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+func HasKeys(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	u, err := url.Parse("https://github.com/facebook/ent")
+	require.NoError(t, err)
+	users := client.User.CreateBulk(
+		client.User.Create().SetRaw([]byte(`{"a":1}`)),
+		client.User.Create().SetRaw([]byte(`{"a":1,"b":null,"c":{"d":2}}`)),
+		client.User.Create().SetRaw([]byte(`{"c":3}`)),
+		client.User.Create().SetURL(u),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	query := func(p *sql.Predicate) []int {
+		return client.User.Query().
+			Where(user.IDIn(ids...), func(s *sql.Selector) { s.Where(p) }).
+			Order(ent.Asc(user.FieldID)).
+			IDsX(ctx)
+	}
+	// Keys that hold null values exist, and only top-level keys are checked.
+	require.Equal(t, ids[1:2], query(sql.JSONHasKeys(user.FieldRaw, "a", "b", "c")))
+	require.Equal(t, ids[:2], query(sql.JSONHasKeys(user.FieldRaw, "a")))
+	require.Empty(t, query(sql.JSONHasKeys(user.FieldRaw, "a", "d")))
+	require.Equal(t, ids[1:3], query(sql.JSONHasAnyKey(user.FieldRaw, "b", "c")))
+	require.Equal(t, ids[:3], query(sql.JSONHasAnyKey(user.FieldRaw, "a", "c", "x")))
+	require.Empty(t, query(sql.JSONHasAnyKey(user.FieldRaw, "d", "x")))
+	require.Empty(t, query(sql.JSONHasAnyKey(user.FieldRaw)))
+	require.Equal(t, ids[3:], query(sql.JSONHasKeys(user.FieldURL, "Scheme", "Host")))
+	require.Equal(t, ids[3:], query(sql.JSONHasAnyKey(user.FieldURL, "Host", "a")))
+
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
 }
 
 func Floats(t *testing.T, client *ent.Client) {
@@ -118,12 +1467,57 @@ func Floats(t *testing.T, client *ent.Client) {
 	usr := client.User.Create().SetFloats(flts).SaveX(ctx)
 	require.Equal(t, flts, usr.Floats)
 	require.Equal(t, flts, client.User.GetX(ctx, usr.ID).Floats)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.FloatsAny(sql.GT, 2.5)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.FloatsAny(sql.LT, 0.5)).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.FloatsAll(sql.GTE, 1.0)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.FloatsAll(sql.GT, 1.5)).CountX(ctx))
 	usr = usr.Update().SetFloats(flts[:1]).SaveX(ctx)
 	require.Equal(t, flts[:1], usr.Floats)
 	require.Equal(t, flts[:1], client.User.GetX(ctx, usr.ID).Floats)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.FloatsNotNil()).OnlyIDX(ctx))
 	usr = usr.Update().ClearFloats().SaveX(ctx)
 	require.Empty(t, usr.Floats)
 	require.Empty(t, client.User.GetX(ctx, usr.ID).Floats)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.FloatsIsNil()).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.FloatsAny(sql.GT, 0.0)).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.FloatsAll(sql.GT, 0.0)).OnlyIDX(ctx))
+
+	// NaN and infinite values are encoded as strings.
+	flts = []float64{math.Inf(1), math.NaN(), -1.5, math.Inf(-1)}
+	usr = usr.Update().SetFloats(flts).SaveX(ctx)
+	for _, got := range [][]float64{usr.Floats, client.User.GetX(ctx, usr.ID).Floats, client.User.Query().Where(user.ID(usr.ID)).FloatsOnlyX(ctx)[0]} {
+		require.Len(t, got, 4)
+		require.True(t, math.IsInf(got[0], 1))
+		require.True(t, math.IsNaN(got[1]))
+		require.Equal(t, -1.5, got[2])
+		require.True(t, math.IsInf(got[3], -1))
+	}
+}
+
+// Times tests that time values are stored in JSON fields using the RFC 3339
+// format (with nanoseconds), and are decoded without losing precision.
+func Times(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	times := []time.Time{
+		time.Date(2020, time.January, 2, 3, 4, 5, 123456789, time.UTC),
+		time.Date(2020, time.January, 2, 3, 4, 5, 100000000, time.UTC),
+		time.Unix(1, 1).UTC(),
+	}
+	usr := client.User.Create().SetTimes(times).SaveX(ctx)
+	require.Equal(t, times, usr.Times)
+	require.Equal(t, times, client.User.GetX(ctx, usr.ID).Times)
+	usr = usr.Update().AppendTimes(times[0]).SaveX(ctx)
+	require.Equal(t, append(times, times[0]), client.User.GetX(ctx, usr.ID).Times)
+
+	// The zone offset is kept, but the location of the decoded value is not.
+	zoned := time.Date(2020, time.January, 2, 3, 4, 5, 123456789, time.FixedZone("IST", 5*60*60+30*60))
+	usr = usr.Update().SetTimes([]time.Time{zoned}).SaveX(ctx)
+	got := client.User.GetX(ctx, usr.ID).Times
+	require.Len(t, got, 1)
+	require.True(t, zoned.Equal(got[0]))
+	_, offset := got[0].Zone()
+	require.Equal(t, 5*60*60+30*60, offset)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
 }
 
 func Strings(t *testing.T, client *ent.Client) {
@@ -136,10 +1530,91 @@ func Strings(t *testing.T, client *ent.Client) {
 	require.Equal(t, str[:1], usr.Strings)
 	require.Equal(t, str[:1], client.User.GetX(ctx, usr.ID).Strings)
 	require.Equal(t, 1, client.User.Query().Where(user.StringsNotNil()).CountX(ctx))
+	err := usr.Update().SetStrings([]string{}).Exec(ctx)
+	require.True(t, ent.IsValidationError(err), "empty list is not allowed")
+	_, err = client.User.Create().SetStrings([]string{}).Save(ctx)
+	require.True(t, ent.IsValidationError(err), "empty list is not allowed")
+	usr = usr.Update().SetStrings(nil).SaveX(ctx)
+	require.Empty(t, usr.Strings, "validator is skipped for nil values")
+	require.Zero(t, client.User.Query().Where(user.StringsNotNil()).CountX(ctx), "nil values are stored as NULL")
+	usr = usr.Update().ClearStrings().SetStrings(str).SaveX(ctx)
+	require.Equal(t, str, client.User.GetX(ctx, usr.ID).Strings)
+	require.Equal(t, 1, client.User.Query().Where(user.StringsNotNil()).CountX(ctx))
 	usr = usr.Update().ClearStrings().SaveX(ctx)
 	require.Empty(t, usr.Strings)
 	require.Empty(t, client.User.GetX(ctx, usr.ID).Strings)
 	require.Zero(t, client.User.Query().Where(user.StringsNotNil()).CountX(ctx))
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.StringsIsNil()).OnlyIDX(ctx))
+	usr = usr.Update().AppendStrings("d").SaveX(ctx)
+	require.Equal(t, []string{"d"}, usr.Strings)
+	usr = usr.Update().AppendStrings("e", "f").SaveX(ctx)
+	require.Equal(t, []string{"d", "e", "f"}, usr.Strings)
+	require.Equal(t, []string{"d", "e", "f"}, client.User.GetX(ctx, usr.ID).Strings)
+	err = usr.Update().SetStrings(str).AppendStrings("g").Exec(ctx)
+	require.Error(t, err, "set and append cannot be used together")
+	require.Equal(t, []string{"d", "e", "f"}, client.User.GetX(ctx, usr.ID).Strings)
+
+	// Append to the arrays of all matched rows in one statement.
+	other := client.User.Create().SetStrings([]string{"a"}).SaveX(ctx)
+	none := client.User.Create().SaveX(ctx)
+	n := client.User.Update().Where(user.StringsNotNil()).AppendStrings("x", "y").SaveX(ctx)
+	require.Equal(t, 2, n)
+	require.Equal(t, []string{"d", "e", "f", "x", "y"}, client.User.GetX(ctx, usr.ID).Strings)
+	require.Equal(t, []string{"a", "x", "y"}, client.User.GetX(ctx, other.ID).Strings)
+	require.Nil(t, client.User.GetX(ctx, none.ID).Strings)
+	client.User.Delete().Where(user.IDIn(other.ID, none.ID)).ExecX(ctx)
+
+	// Tags are restricted to an enum set.
+	usr = usr.Update().SetTags([]string{"a", "c"}).SaveX(ctx)
+	require.Equal(t, []string{"a", "c"}, client.User.GetX(ctx, usr.ID).Tags)
+	err = usr.Update().SetTags([]string{"b", "d"}).Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.EqualError(t, err, `ent: validator failed for field "tags": invalid enum value for tags field: "d"`)
+	_, err = client.User.Create().SetTags([]string{"e"}).Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+	err = client.User.Update().Where(user.ID(usr.ID)).SetTags([]string{"f"}).Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.Equal(t, []string{"a", "c"}, client.User.GetX(ctx, usr.ID).Tags)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Blob tests that []byte fields marked as Raw are stored as JSON documents,
+// like json.RawMessage, and not as base64-encoded JSON strings.
+func Blob(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	blob := []byte(`{"a": [1, "2"]}`)
+	usr := client.User.Create().SetBlob(blob).SaveX(ctx)
+	require.Equal(t, blob, usr.Blob)
+	require.JSONEq(t, string(blob), string(client.User.GetX(ctx, usr.ID).Blob))
+	stored := client.User.Query().Where(user.ID(usr.ID)).Select(user.FieldBlob).StringX(ctx)
+	require.JSONEq(t, string(blob), stored)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.BlobNotNil()).OnlyIDX(ctx))
+	usr = usr.Update().ClearBlob().SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Blob)
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.BlobNotNil()).CountX(ctx))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Omit tests that omitted JSON fields are left empty, without affecting the other fields.
+func Omit(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().
+		SetRaw(json.RawMessage(`{"a": 1}`)).
+		SetInts([]int{1, 2}).
+		SetMeta(map[string]string{"a": "b"}).
+		SaveX(ctx)
+	omitted := client.User.Query().Where(user.ID(usr.ID)).Omit(user.FieldRaw).OnlyX(ctx)
+	require.Empty(t, omitted.Raw)
+	require.Equal(t, []int{1, 2}, omitted.Ints)
+	require.Equal(t, map[string]string{"a": "b"}, omitted.Meta)
+	omitted = client.User.Query().Where(user.ID(usr.ID)).Omit(user.FieldRaw, user.FieldMeta).OnlyX(ctx)
+	require.Empty(t, omitted.Raw)
+	require.Empty(t, omitted.Meta)
+	require.Equal(t, usr.ID, omitted.ID)
+	require.Equal(t, []int{1, 2}, omitted.Ints)
+	_, err := client.User.Query().Omit(user.FieldID).All(ctx)
+	require.Error(t, err, "id field cannot be omitted")
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
 }
 
 func RawMessage(t *testing.T, client *ent.Client) {
@@ -148,6 +1623,247 @@ func RawMessage(t *testing.T, client *ent.Client) {
 	usr := client.User.Create().SetRaw(raw).SaveX(ctx)
 	require.Equal(t, raw, usr.Raw)
 	require.Equal(t, raw, client.User.GetX(ctx, usr.ID).Raw)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.RawNotNil()).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.ID(usr.ID), user.RawIsNil()).CountX(ctx))
+	id := client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONEQ(user.FieldRaw, json.RawMessage("{ }")))
+	}).OnlyIDX(ctx)
+	require.Equal(t, usr.ID, id)
+	usr = client.User.Create().SetRaw(json.RawMessage(`{"a": 1, "b": [1, "2"]}`)).SaveX(ctx)
+	id = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONEQ(user.FieldRaw, json.RawMessage(`{"b":[1,"2"],"a":1}`)))
+	}).OnlyIDX(ctx)
+	require.Equal(t, usr.ID, id)
+	count := client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.JSONEQ(user.FieldRaw, json.RawMessage(`{"a":1}`)),
+			sql.JSONEQ(user.FieldRaw, json.RawMessage(`{"a":1,"b":["2",1]}`)),
+		))
+	}).CountX(ctx)
+	require.Zero(t, count)
+
+	// Unlike NULL values, empty objects are not empty arrays or non-empty objects.
+	empty := client.User.Create().SetRaw(json.RawMessage("{ }")).SaveX(ctx)
+	arr := client.User.Create().SetRaw(json.RawMessage("[]")).SaveX(ctx)
+	null := client.User.Create().SaveX(ctx)
+	ids := []int{empty.ID, arr.ID, null.ID, usr.ID}
+	require.Equal(t, empty.ID, client.User.Query().Where(user.IDIn(ids...), user.RawIsEmptyObject()).OnlyIDX(ctx))
+	require.Equal(t, []int{usr.ID, arr.ID}, client.User.Query().Where(user.IDIn(ids...), user.Not(user.RawIsEmptyObject())).Order(ent.Asc(user.FieldID)).IDsX(ctx))
+	client.User.DeleteOne(empty).ExecX(ctx)
+	client.User.DeleteOne(arr).ExecX(ctx)
+	client.User.DeleteOne(null).ExecX(ctx)
+
+	// The encoded value is limited to 65535 bytes.
+	large := json.RawMessage(`"` + strings.Repeat("a", 65534) + `"`)
+	_, err := client.User.Create().SetRaw(large).Save(ctx)
+	require.True(t, ent.IsValidationError(err), "value is greater than the required length")
+	err = usr.Update().SetRaw(large).Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	usr = usr.Update().SetRaw(json.RawMessage(`"` + strings.Repeat("a", 65533) + `"`)).SaveX(ctx)
+	require.Len(t, client.User.GetX(ctx, usr.ID).Raw, 65535)
+	err = usr.Update().SetRaw(nil).Exec(ctx)
+	require.NoError(t, err, "validator is skipped for nil values")
+	usr = usr.Update().ClearRaw().SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Raw)
+}
+
+// Types tests that JSONTypeEQ uses the same type names in all dialects.
+func Types(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetRaw(json.RawMessage(`{"i": 1, "f": 1.5, "s": "a", "b": true, "n": null, "o": {}, "a": [1, "2"]}`)).SaveX(ctx)
+	for key, typ := range map[string]string{"i": "number", "f": "number", "s": "string", "b": "boolean", "n": "null", "o": "object", "a": "array"} {
+		id := client.User.Query().Where(user.ID(usr.ID), func(s *sql.Selector) {
+			s.Where(sql.JSONTypeEQ(user.FieldRaw, typ, key))
+		}).OnlyIDX(ctx)
+		require.Equal(t, usr.ID, id, "type of %q is %s", key, typ)
+		count := client.User.Query().Where(user.ID(usr.ID), func(s *sql.Selector) {
+			s.Where(sql.Or(sql.JSONTypeEQ(user.FieldRaw, "string", key+"x"), sql.JSONTypeEQ(user.FieldRaw, "null", key+"x")))
+		}).CountX(ctx)
+		require.Zero(t, count, "missing keys do not match")
+	}
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), func(s *sql.Selector) {
+		s.Where(sql.And(sql.JSONTypeEQ(user.FieldRaw, "object"), sql.JSONTypeEQ(user.FieldRaw, "string", "a", "[1]")))
+	}).OnlyIDX(ctx))
+	client.User.DeleteOne(usr).ExecX(ctx)
+}
+
+// ContainsValue tests that JSONContainsValue searches scalars in any depth of the document.
+func ContainsValue(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetRaw(json.RawMessage(`{"a": {"b": {"c": [1, "x", {"d": true}]}}}`)),
+		client.User.Create().SetRaw(json.RawMessage(`[{"a": "1"}, [[2.5]], null]`)),
+		client.User.Create().SetRaw(json.RawMessage(`"x"`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"x": 1}`)),
+		client.User.Create(),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	search := func(v interface{}) []int {
+		return client.User.Query().
+			Where(user.IDIn(ids...), func(s *sql.Selector) {
+				s.Where(sql.JSONContainsValue(s.C(user.FieldRaw), v))
+			}).
+			Order(ent.Asc(user.FieldID)).
+			IDsX(ctx)
+	}
+	require.Equal(t, []int{ids[0], ids[2]}, search("x"), "keys are not values")
+	require.Equal(t, []int{ids[0], ids[3]}, search(1), "numbers do not match strings")
+	require.Equal(t, []int{ids[1]}, search("1"))
+	require.Equal(t, []int{ids[1]}, search(2.5))
+	require.Equal(t, []int{ids[0]}, search(true))
+	require.Empty(t, search(false))
+	require.Equal(t, []int{ids[1]}, search(nil), "JSON null values only")
+	require.Empty(t, search("y"))
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// TextColumns tests JSON fields that are stored as text in MySQL, and
+// that their predicates fall back to LIKE matching in this dialect.
+func TextColumns(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	if drv.Dialect() == dialect.MySQL {
+		var typ string
+		err := drv.DB().QueryRowContext(ctx, "SELECT `data_type` FROM INFORMATION_SCHEMA.COLUMNS WHERE `table_schema` = (SELECT DATABASE()) AND `table_name` = 'users' AND `column_name` = 'attrs'").Scan(&typ)
+		require.NoError(t, err)
+		require.Equal(t, "longtext", typ)
+		err = client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true))
+		require.NoError(t, err, "text columns are not modified on re-runs")
+	}
+	users := client.User.CreateBulk(
+		client.User.Create().SetAttrs(map[string]string{"a": "b", "b_c": "d"}).SetKeywords([]string{"x", "y,z"}),
+		client.User.Create().SetAttrs(map[string]string{"bxc": "a"}).SetKeywords([]string{"y"}),
+		client.User.Create(),
+	).SaveX(ctx)
+	ids := []int{users[0].ID, users[1].ID, users[2].ID}
+	require.Equal(t, map[string]string{"a": "b", "b_c": "d"}, client.User.GetX(ctx, ids[0]).Attrs)
+	require.Equal(t, []string{"x", "y,z"}, client.User.GetX(ctx, ids[0]).Keywords)
+	query := func(ps ...predicate.User) []int {
+		return client.User.Query().Where(user.IDIn(ids...)).Where(ps...).Order(ent.Asc(user.FieldID)).IDsX(ctx)
+	}
+	require.Equal(t, []int{ids[0]}, query(user.AttrsHasKey("a")), "values are not keys")
+	require.Equal(t, []int{ids[0]}, query(user.AttrsHasKey("b_c")), "LIKE wildcards are escaped")
+	require.Equal(t, []int{ids[1]}, query(user.AttrsHasKey("bxc")))
+	require.Empty(t, query(user.AttrsHasKey("b")))
+	require.Equal(t, []int{ids[0]}, query(user.KeywordsContainsAny([]string{"x"})))
+	require.Equal(t, []int{ids[0], ids[1]}, query(user.KeywordsContainsAny([]string{"y,z", "y"})))
+	require.Empty(t, query(user.KeywordsContainsAny([]string{"z"})))
+	require.Empty(t, query(user.KeywordsContainsAny(nil)))
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+// RawMerge tests that JSON merge-patches (RFC 7386) are applied on the value
+// stored in the database, and that they cannot be mixed with SetRaw.
+func RawMerge(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetRaw(json.RawMessage(`{"a": 1, "b": {"c": 2, "d": 3}, "e": 4}`)).SaveX(ctx)
+	usr = usr.Update().MergeRaw(json.RawMessage(`{"a": 10, "b": {"c": null, "f": [5]}, "e": null, "g": {"h": 6}}`)).SaveX(ctx)
+	require.JSONEq(t, `{"a": 10, "b": {"d": 3, "f": [5]}, "g": {"h": 6}}`, string(usr.Raw))
+	require.JSONEq(t, `{"a": 10, "b": {"d": 3, "f": [5]}, "g": {"h": 6}}`, string(client.User.GetX(ctx, usr.ID).Raw))
+
+	// Non-object values replace the stored value, and patches are applied in order.
+	client.User.Update().
+		Where(user.ID(usr.ID)).
+		MergeRaw(json.RawMessage(`{"b": "b", "g": null}`)).
+		MergeRaw(json.RawMessage(`{"b": {"i": 7}}`)).
+		ExecX(ctx)
+	require.JSONEq(t, `{"a": 10, "b": {"i": 7}}`, string(client.User.GetX(ctx, usr.ID).Raw))
+
+	// NULL columns are patched as empty objects.
+	usr = usr.Update().ClearRaw().SaveX(ctx)
+	usr = usr.Update().MergeRaw(json.RawMessage(`{"a": 1, "b": null}`)).SaveX(ctx)
+	require.JSONEq(t, `{"a": 1}`, string(client.User.GetX(ctx, usr.ID).Raw))
+
+	err := usr.Update().SetRaw(json.RawMessage(`{}`)).MergeRaw(json.RawMessage(`{"a": 2}`)).Exec(ctx)
+	require.EqualError(t, err, `ent: field "raw" cannot be set (or cleared) and merged in the same mutation`)
+	err = usr.Update().ClearRaw().MergeRaw(json.RawMessage(`{"a": 2}`)).Exec(ctx)
+	require.Error(t, err)
+	require.JSONEq(t, `{"a": 1}`, string(client.User.GetX(ctx, usr.ID).Raw))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+func BoolValue(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetRaw(json.RawMessage(`{"active": true, "a": {"b": false}}`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"active": false}`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"active": 1, "a": {"b": 0}}`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"active": "true"}`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"a": null}`)),
+		client.User.Create().SetRaw(json.RawMessage(`{"c'\"}": true, "c.d": true}`)),
+	).SaveX(ctx)
+	ids := make([]int, len(users))
+	for i := range users {
+		ids[i] = users[i].ID
+	}
+	query := func(p predicate.User) []int {
+		return client.User.Query().
+			Where(user.IDIn(ids...), p).
+			Order(ent.Asc(user.FieldID)).
+			IDsX(ctx)
+	}
+	// Numbers and strings are not booleans, even if the database represents booleans as integers.
+	require.Equal(t, ids[:1], query(user.RawBoolValue("active", true)))
+	require.Equal(t, ids[1:2], query(user.RawBoolValue("active", false)))
+	// Objects and null values do not match the predicate as well.
+	require.Empty(t, query(user.RawBoolValue("a", false)))
+	require.Empty(t, query(user.RawBoolValue("b", false)))
+
+	// Keys are passed to the database as arguments, and are not parsed as paths.
+	require.Equal(t, ids[5:], query(user.RawBoolValue(`c'"}`, true)))
+	require.Equal(t, ids[5:], query(user.RawBoolValue("c.d", true)))
+	for _, key := range []string{"active') OR 1=1 OR ('", `active") OR 1=1 OR ("`, "a}' OR '1'='1", "a.b"} {
+		require.Empty(t, query(user.RawBoolValue(key, true)), key)
+		require.Empty(t, query(user.MetaBoolValue(key, true)), key)
+	}
+
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
+}
+
+func RawPatch(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetRaw(json.RawMessage(`{"a": 1, "b": {"c": [1, 2]}, "d": "d"}`)).SaveX(ctx)
+	usr = usr.Update().
+		PatchRaw([]sql.JSONPatchOp{
+			{Op: "test", Path: "/a", Value: 1},
+			{Op: "replace", Path: "/a", Value: 2},
+			{Op: "add", Path: "/b/c/1", Value: 3},
+			{Op: "remove", Path: "/b/c/0"},
+			{Op: "move", From: "/d", Path: "/b/d"},
+			{Op: "copy", From: "/b/c", Path: "/e"},
+		}).
+		SaveX(ctx)
+	require.JSONEq(t, `{"a": 2, "b": {"c": [3, 2], "d": "d"}, "e": [3, 2]}`, string(usr.Raw))
+	require.JSONEq(t, `{"a": 2, "b": {"c": [3, 2], "d": "d"}, "e": [3, 2]}`, string(client.User.GetX(ctx, usr.ID).Raw))
+
+	// Failed operations fail the whole mutation, and the stored value is kept as is.
+	err := usr.Update().
+		PatchRaw([]sql.JSONPatchOp{{Op: "replace", Path: "/a", Value: 3}, {Op: "test", Path: "/e/0", Value: 2}}).
+		Exec(ctx)
+	require.True(t, errors.Is(err, sql.ErrJSONPatchTest), "unexpected error: %v", err)
+	err = usr.Update().PatchRaw([]sql.JSONPatchOp{{Op: "remove", Path: "/f"}}).Exec(ctx)
+	require.Error(t, err)
+	err = usr.Update().PatchRaw([]sql.JSONPatchOp{{Op: "increment", Path: "/a"}}).Exec(ctx)
+	require.Error(t, err)
+	require.JSONEq(t, `{"a": 2, "b": {"c": [3, 2], "d": "d"}, "e": [3, 2]}`, string(client.User.GetX(ctx, usr.ID).Raw))
+
+	// Patches are applied on each of the updated entities, and NULL columns are patched as JSON null.
+	usr1 := client.User.Create().SaveX(ctx)
+	n := client.User.Update().
+		Where(user.IDIn(usr.ID, usr1.ID)).
+		PatchRaw([]sql.JSONPatchOp{{Op: "add", Path: "", Value: map[string]int{"a": 1}}}).
+		PatchRaw([]sql.JSONPatchOp{{Op: "add", Path: "/b", Value: []int{1}}}).
+		SaveX(ctx)
+	require.Equal(t, 2, n)
+	require.JSONEq(t, `{"a": 1, "b": [1]}`, string(client.User.GetX(ctx, usr.ID).Raw))
+	require.JSONEq(t, `{"a": 1, "b": [1]}`, string(client.User.GetX(ctx, usr1.ID).Raw))
+
+	err = usr.Update().SetRaw(json.RawMessage(`{}`)).PatchRaw([]sql.JSONPatchOp{{Op: "remove", Path: "/a"}}).Exec(ctx)
+	require.EqualError(t, err, `ent: field "raw" cannot be set (or cleared) and patched in the same mutation`)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+	client.User.DeleteOneID(usr1.ID).ExecX(ctx)
 }
 
 func Dirs(t *testing.T, client *ent.Client) {
@@ -156,6 +1872,135 @@ func Dirs(t *testing.T, client *ent.Client) {
 	usr := client.User.Create().SetDirs(dirs).SaveX(ctx)
 	require.Equal(t, dirs, usr.Dirs)
 	require.Equal(t, dirs, client.User.GetX(ctx, usr.ID).Dirs)
+	usr = client.User.Create().SaveX(ctx)
+	require.Equal(t, []http.Dir{"/tmp"}, usr.Dirs)
+	require.Equal(t, []http.Dir{"/tmp"}, client.User.GetX(ctx, usr.ID).Dirs)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.DirsNotNil()).OnlyIDX(ctx))
+	usr = usr.Update().ClearDirs().SaveX(ctx)
+	require.Empty(t, usr.Dirs)
+	require.Empty(t, client.User.GetX(ctx, usr.ID).Dirs)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.DirsIsNil()).OnlyIDX(ctx))
+
+	// Rows that omit the field in a bulk get their own copy of the default.
+	users := client.User.CreateBulk(
+		client.User.Create(),
+		client.User.Create().SetDirs(dirs),
+		client.User.Create(),
+	).SaveX(ctx)
+	for i, want := range [][]http.Dir{{"/tmp"}, dirs, {"/tmp"}} {
+		require.Equal(t, want, users[i].Dirs)
+		require.Equal(t, want, client.User.GetX(ctx, users[i].ID).Dirs)
+	}
+	users[0].Dirs[0] = "/var"
+	require.Equal(t, []http.Dir{"/tmp"}, users[2].Dirs)
+	require.Equal(t, []http.Dir{"/tmp"}, client.User.Create().SaveX(ctx).Dirs)
+}
+
+// Compress tests that the values of the "doc" field are stored compressed,
+// and decompressed when they are scanned.
+func Compress(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	doc := json.RawMessage(`{"items":[` + strings.Repeat(`{"name":"a8m","tags":["go","ent"]},`, 100) + `{}]}`)
+	usr := client.User.Create().SetDoc(doc).SaveX(ctx)
+	require.JSONEq(t, string(doc), string(client.User.GetX(ctx, usr.ID).Doc))
+	docs := client.User.Query().Where(user.ID(usr.ID)).DocOnlyX(ctx)
+	require.Len(t, docs, 1)
+	require.JSONEq(t, string(doc), string(docs[0]))
+
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(drv.Dialect()).Select(user.FieldDoc).From(sql.Table(user.Table)).Where(sql.EQ(user.FieldID, usr.ID)).Query()
+	require.NoError(t, drv.Query(ctx, query, args, rows))
+	var raw []byte
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&raw))
+	require.NoError(t, rows.Close())
+	require.NotEqual(t, []byte(doc), raw, "stored bytes should be compressed")
+	require.Less(t, len(raw), len(doc))
+
+	doc = json.RawMessage(`{"items":[]}`)
+	usr = usr.Update().SetDoc(doc).SaveX(ctx)
+	require.JSONEq(t, string(doc), string(client.User.GetX(ctx, usr.ID).Doc))
+	usr = usr.Update().ClearDoc().SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).Doc)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.DocIsNil()).OnlyIDX(ctx))
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Immutable tests that immutable JSON fields can be set only on creation.
+func Immutable(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetInitialInts([]int{1, 2}).SaveX(ctx)
+	require.Equal(t, []int{1, 2}, client.User.GetX(ctx, usr.ID).InitialInts)
+	for _, u := range []interface{}{client.User.Update(), usr.Update()} {
+		v := reflect.ValueOf(u)
+		for _, name := range []string{"SetInitialInts", "AppendInitialInts", "ClearInitialInts"} {
+			require.False(t, v.MethodByName(name).IsValid(), "update builder should not have %s", name)
+		}
+		require.True(t, v.MethodByName("SetInts").IsValid())
+	}
+	usr = usr.Update().SetInts([]int{3}).SaveX(ctx)
+	require.Equal(t, []int{1, 2}, client.User.GetX(ctx, usr.ID).InitialInts)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Pagination tests cursor pagination over users that are ordered by their URL scheme.
+// The cursor holds the JSON encoding of the scheme, and ties are broken by the user ID.
+func Pagination(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	type cursor struct {
+		ID    int
+		Value json.RawMessage
+	}
+	var ids []int
+	for _, s := range []string{"https", "ftp", "http", "ftp", "https", "", "http"} {
+		create := client.User.Create()
+		if s != "" {
+			create.SetURL(&url.URL{Scheme: s, Host: "github.com"})
+		}
+		ids = append(ids, create.SaveX(ctx).ID)
+	}
+	query := func() *ent.UserQuery {
+		return client.User.Query().
+			Where(user.IDIn(ids...)).
+			Order(user.ByURLValue("Scheme"), ent.Asc(user.FieldID))
+	}
+	var want []int
+	for _, u := range query().AllX(ctx) {
+		want = append(want, u.ID)
+	}
+	require.Len(t, want, len(ids))
+
+	var (
+		got   []int
+		after *cursor
+	)
+	for {
+		q := query().Limit(2)
+		if after != nil {
+			// Cursors are passed to clients as opaque strings.
+			buf, err := json.Marshal(after)
+			require.NoError(t, err)
+			c := &cursor{}
+			require.NoError(t, json.Unmarshal(buf, c))
+			q.Where(func(s *sql.Selector) {
+				s.Where(sql.JSONValueAfter(s.C(user.FieldURL), c.Value, s.C(user.FieldID), c.ID, "Scheme"))
+			})
+		}
+		page := q.AllX(ctx)
+		if len(page) == 0 {
+			break
+		}
+		last := page[len(page)-1]
+		after = &cursor{ID: last.ID}
+		if last.URL != nil {
+			after.Value, _ = json.Marshal(last.URL.Scheme)
+		}
+		for _, u := range page {
+			got = append(got, u.ID)
+		}
+	}
+	require.Equal(t, want, got)
+	client.User.Delete().Where(user.IDIn(ids...)).ExecX(ctx)
 }
 
 func URL(t *testing.T, client *ent.Client) {
@@ -165,6 +2010,141 @@ func URL(t *testing.T, client *ent.Client) {
 	usr := client.User.Create().SetURL(u).SaveX(ctx)
 	require.Equal(t, u, usr.URL)
 	require.Equal(t, u, client.User.GetX(ctx, usr.ID).URL)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.URLNotNil()).OnlyIDX(ctx))
+	usr = usr.Update().ClearURL().SaveX(ctx)
+	require.Nil(t, client.User.GetX(ctx, usr.ID).URL)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.ID(usr.ID), user.URLIsNil()).OnlyIDX(ctx))
+
+	client.User.Delete().ExecX(ctx)
+	for _, s := range []string{"https", "ftp", "http"} {
+		client.User.Create().SetURL(&url.URL{Scheme: s, Host: "github.com"}).SaveX(ctx)
+	}
+	users := client.User.Query().Order(user.ByURLValue("Scheme")).AllX(ctx)
+	require.Len(t, users, 3)
+	for i, s := range []string{"ftp", "http", "https"} {
+		require.Equal(t, s, users[i].URL.Scheme)
+	}
+
+	// Select only the JSON values, without unmarshaling the whole field.
+	schemes := client.User.Query().
+		Order(user.ByURLValue("Scheme")).
+		SelectValue(user.URLValue("Scheme")).
+		StringsX(ctx)
+	require.Equal(t, []string{"ftp", "http", "https"}, schemes)
+	host := client.User.Query().
+		Where(user.URLHasKey("Host")).
+		Limit(1).
+		SelectValue(user.URLValue("Host")).
+		StringX(ctx)
+	require.Equal(t, "github.com", host)
+	var v []struct {
+		Scheme string `json:"scheme"`
+		Host   string `json:"host"`
+	}
+	client.User.Query().
+		Order(ent.Asc(user.FieldID)).
+		SelectValue(ent.As(user.URLValue("Scheme"), "scheme"), ent.As(user.URLValue("Host"), "host")).
+		ScanX(ctx, &v)
+	require.Len(t, v, 3)
+	require.Equal(t, "https", v[0].Scheme)
+	require.Equal(t, "github.com", v[0].Host)
+
+	// Query parameters are matched on the encoded query string.
+	u1 := client.User.Create().SetURL(&url.URL{Host: "a8m.io", RawQuery: "ref=x&lang=go"}).SaveX(ctx)
+	u2 := client.User.Create().SetURL(&url.URL{Host: "a8m.io", RawQuery: "ref=xy&q=a+b"}).SaveX(ctx)
+	require.Equal(t, u1.ID, client.User.Query().Where(user.URLQueryParamEQ("ref", "x")).OnlyIDX(ctx))
+	require.Equal(t, u1.ID, client.User.Query().Where(user.URLQueryParamEQ("lang", "go")).OnlyIDX(ctx))
+	require.Equal(t, u2.ID, client.User.Query().Where(user.URLQueryParamEQ("ref", "xy")).OnlyIDX(ctx))
+	require.Equal(t, u2.ID, client.User.Query().Where(user.URLQueryParamEQ("q", "a b")).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.URLQueryParamEQ("ref", "X")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.URLQueryParamEQ("lang", "g_")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.URLQueryParamEQ("ref", "%")).CountX(ctx))
+	client.User.DeleteOneID(u1.ID).ExecX(ctx)
+	client.User.DeleteOneID(u2.ID).ExecX(ctx)
+
+	// Numbers are extracted as text in MySQL and PostgreSQL.
+	usr = client.User.Create().SetInts([]int{10, 20}).SaveX(ctx)
+	first := client.User.Query().Where(user.ID(usr.ID)).SelectValue(user.IntsValue("[1]")).StringX(ctx)
+	require.Equal(t, "20", first)
+	n := client.User.Query().Where(user.ID(usr.ID)).SelectValue(user.IntsValue("[0]")).IntX(ctx)
+	require.Equal(t, 10, n)
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Secrets tests that sensitive JSON fields are omitted when encoding
+// the entity, but are stored in the database as usual.
+func Secrets(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	secrets := map[string]string{"token": "t0ps3cr3t"}
+	usr := client.User.Create().SetSecrets(secrets).SaveX(ctx)
+	require.Equal(t, secrets, client.User.GetX(ctx, usr.ID).Secrets)
+	require.Equal(t, usr.ID, client.User.Query().Where(user.SecretsKeyEQ("token", "t0ps3cr3t")).OnlyIDX(ctx))
+	buf, err := json.Marshal(usr)
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), "secrets")
+	require.NotContains(t, string(buf), "t0ps3cr3t")
+	require.Contains(t, usr.String(), "secrets=<sensitive>")
+	require.NotContains(t, usr.String(), "t0ps3cr3t")
+	client.User.DeleteOneID(usr.ID).ExecX(ctx)
+}
+
+// Meta tests the key predicates of map fields.
+func Meta(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	users := client.User.CreateBulk(
+		client.User.Create().SetMeta(map[string]string{"env": "prod", "region": "us"}),
+		client.User.Create().SetMeta(map[string]string{"env": "dev"}),
+		client.User.Create().SetMeta(map[string]string{"a.b": "c", `x"y`: "z'"}),
+	).SaveX(ctx)
+	require.Equal(t, map[string]string{"env": "prod", "region": "us"}, client.User.GetX(ctx, users[0].ID).Meta)
+	require.Equal(t, users[0].ID, client.User.Query().Where(user.MetaKeyEQ("env", "prod")).OnlyIDX(ctx))
+	require.Equal(t, users[1].ID, client.User.Query().Where(user.MetaValueEQ("env", "dev")).OnlyIDX(ctx))
+	require.Equal(t, users[0].ID, client.User.Query().Where(user.MetaHasKey("region")).OnlyIDX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.MetaHasKey("env")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.MetaKeyEQ("env", "staging")).CountX(ctx))
+
+	// Keys are not parsed as JSON paths.
+	require.Equal(t, users[2].ID, client.User.Query().Where(user.MetaKeyEQ("a.b", "c")).OnlyIDX(ctx))
+	require.Equal(t, users[2].ID, client.User.Query().Where(user.MetaKeyEQ(`x"y`, "z'")).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.MetaHasKey("a")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.MetaHasKey(`env") OR 1=1 OR ("`)).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.MetaHasKey("env' OR '1'='1")).CountX(ctx))
+
+	// Paths that were built from untrusted input.
+	extract := func(path ...string) predicate.User {
+		return func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Join(sql.JSONExtract(s.C(user.FieldMeta), path)).WriteOp(sql.OpNotNull)
+			}))
+		}
+	}
+	require.Equal(t, 2, client.User.Query().Where(extract("env")).CountX(ctx))
+	require.Equal(t, users[2].ID, client.User.Query().Where(extract("a.b")).OnlyIDX(ctx))
+	for _, path := range [][]string{{"a"}, {"*"}, {"env", "*"}, {`env"), 1) OR (1`}, {"env' OR '1'='1"}, {`a\.b`}} {
+		require.Zero(t, client.User.Query().Where(extract(path...)).CountX(ctx), path)
+	}
+
+	// Case-insensitive keys.
+	hasKeyFold := func(column, key string) predicate.User {
+		return func(s *sql.Selector) {
+			s.Where(sql.JSONHasKeyFold(s.C(column), key))
+		}
+	}
+	require.Equal(t, users[0].ID, client.User.Query().Where(hasKeyFold(user.FieldMeta, "REGION")).OnlyIDX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(hasKeyFold(user.FieldMeta, "Env")).CountX(ctx))
+	require.Equal(t, users[2].ID, client.User.Query().Where(hasKeyFold(user.FieldMeta, `X"Y`)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(hasKeyFold(user.FieldMeta, "reg")).CountX(ctx))
+	arr := client.User.Create().SetInts([]int{1}).SaveX(ctx)
+	require.Zero(t, client.User.Query().Where(hasKeyFold(user.FieldInts, "0")).CountX(ctx), "arrays have no keys")
+	client.User.DeleteOne(arr).ExecX(ctx)
+
+	// Compare the documents stored in two columns.
+	usr := client.User.Create().SetMeta(map[string]string{"env": "dev", "region": "eu"}).SetSecrets(map[string]string{"region": "eu", "env": "dev"}).SaveX(ctx)
+	client.User.Create().SetMeta(map[string]string{"env": "dev"}).SetSecrets(map[string]string{"env": "prod"}).SaveX(ctx)
+	require.Equal(t, usr.ID, client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONColumnEQ(user.FieldMeta, user.FieldSecrets))
+	}).OnlyIDX(ctx))
+	client.User.Delete().Where(user.MetaNotNil()).ExecX(ctx)
 }
 
 func Predicates(t *testing.T, client *ent.Client) {
@@ -182,8 +2162,18 @@ func Predicates(t *testing.T, client *ent.Client) {
 	require.NoError(t, err)
 	require.Len(t, users, 2)
 
+	// Entities are returned in the order of the given ids, and their JSON fields are scanned.
+	many := client.User.GetManyX(ctx, users[1].ID, users[0].ID)
+	require.Len(t, many, 2)
+	require.Equal(t, "ftp", many[0].URL.Scheme)
+	require.Equal(t, "https", many[1].URL.Scheme)
+	require.Empty(t, client.User.GetManyX(ctx))
+	_, err = client.User.GetMany(ctx, users[0].ID, users[1].ID+100, users[1].ID+200)
+	require.True(t, ent.IsNotFound(err))
+	require.EqualError(t, err, fmt.Sprintf("ent: user [%d %d] not found", users[1].ID+100, users[1].ID+200))
+
 	count, err := client.User.Query().Where(func(s *sql.Selector) {
-		s.Where(sql.JSONHasKey(user.FieldURL, "Scheme"))
+		s.Where(sql.JSONHasKey(user.FieldURL, user.URLPathScheme))
 	}).Count(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 2, count)
@@ -193,4 +2183,286 @@ func Predicates(t *testing.T, client *ent.Client) {
 	}).Count(ctx)
 	require.NoError(t, err)
 	require.Zero(t, count)
+
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONPathHasKey(user.FieldURL, "Scheme"))
+	}).CountX(ctx)
+	require.Equal(t, 2, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(user.FieldURL, "https", "Scheme"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONValueEQ(user.FieldURL, "Scheme", "https"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count, "dot paths are still supported")
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(user.FieldURL, "github.com", user.URLPathHost))
+	}).CountX(ctx)
+	require.Equal(t, 2, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONPathHasKey(user.FieldURL, "Missing", "Scheme"))
+	}).CountX(ctx)
+	require.Zero(t, count, "missing intermediate key should not fail")
+
+	require.Equal(t, 2, client.User.Query().Where(user.URLHasKey("Scheme")).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.URLValueEQ("Scheme", "https")).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.URLSchemeEQ("ftp")).CountX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.URLHostEQ("github.com")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.URLSchemeEQ("http")).CountX(ctx))
+
+	// Keys of the generated predicates are passed to the database as arguments.
+	hostile := []string{"Scheme') OR 1=1 OR ('", `Scheme") OR 1=1 OR ("`, "a}' OR '1'='1", `'"}`}
+	for _, key := range hostile {
+		require.Zero(t, client.User.Query().Where(user.URLHasKey(key)).CountX(ctx), key)
+		require.Zero(t, client.User.Query().Where(user.URLValueEQ(key, "https")).CountX(ctx), key)
+		require.Zero(t, client.User.Query().Where(user.PointValueEQ(key, 1)).CountX(ctx), key)
+		require.Zero(t, client.User.Query().Where(user.PropsHasKey(key)).CountX(ctx), key)
+	}
+
+	client.User.Delete().ExecX(ctx)
+	client.User.Create().SetRaw(json.RawMessage(`{"a":{"b":{"c":[1,2]}}}`)).SaveX(ctx)
+	client.User.Create().SetRaw(json.RawMessage(`{"a":{"b":{"c":[3]}}}`)).SaveX(ctx)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONPathHasKey(user.FieldRaw, "a", "b", "c", "[1]"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONValuePathEQ(user.FieldRaw, 3, "a", "b", "c", "[0]"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	require.Equal(t, 2, client.User.Query().Where(user.RawHasKey("a")).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.RawHasKey("b")).CountX(ctx))
+	// Whole documents are compared regardless of their formatting and the order of object keys.
+	require.Equal(t, 1, client.User.Query().Where(user.RawEQ(json.RawMessage(`{ "a": {"b": {"c": [3]}} }`))).CountX(ctx))
+	require.Zero(t, client.User.Query().Where(user.RawEQ(json.RawMessage(`{"a":{"b":{"c":[2,1]}}}`))).CountX(ctx))
+	// Keys with special characters are quoted (and escaped) in the JSON path.
+	client.User.Create().SetRaw(json.RawMessage(`{"a.b": {"c'd": 1}, "a": {"b": {"c'd": 2}}, "e\"f": {"}": 3}}`)).SaveX(ctx)
+	for _, p := range []*sql.Predicate{
+		sql.JSONValuePathEQ(user.FieldRaw, 1, "a.b", "c'd"),
+		sql.JSONValuePathEQ(user.FieldRaw, 2, "a", "b", "c'd"),
+		sql.JSONValuePathEQ(user.FieldRaw, 3, `e"f`, "}"),
+		sql.JSONPathHasKey(user.FieldRaw, "a.b"),
+	} {
+		require.Equal(t, 1, client.User.Query().Where(func(s *sql.Selector) { s.Where(p) }).CountX(ctx), p)
+	}
+	for _, p := range []*sql.Predicate{
+		sql.JSONPathHasKey(user.FieldRaw, "a}' OR '1'='1"),
+		sql.JSONPathHasKey(user.FieldRaw, `a") OR 1=1 OR ("`),
+	} {
+		require.Zero(t, client.User.Query().Where(func(s *sql.Selector) { s.Where(p) }).CountX(ctx), p)
+	}
+	raw := client.User.Create().SetRaw(json.RawMessage(`{"k'\"}": 1}`)).SaveX(ctx)
+	require.Equal(t, raw.ID, client.User.Query().Where(user.RawHasKey(`k'"}`)).OnlyIDX(ctx))
+	require.Equal(t, raw.ID, client.User.Query().Where(user.RawValueEQ(`k'"}`, 1)).OnlyIDX(ctx))
+	require.Zero(t, client.User.Query().Where(user.RawHasKey("a.b.c'd")).CountX(ctx), "keys are not parsed as paths")
+	for _, key := range hostile {
+		require.Zero(t, client.User.Query().Where(user.RawHasKey(key)).CountX(ctx), key)
+		require.Zero(t, client.User.Query().Where(user.RawValueEQ(key, 1)).CountX(ctx), key)
+	}
+
+	client.User.Delete().ExecX(ctx)
+	client.User.Create().SetInts([]int{1, 2, 3}).SetStrings([]string{"a", "b"}).SaveX(ctx)
+	client.User.Create().SetInts([]int{3, 4}).SetFloats([]float64{1.5, 2}).SaveX(ctx)
+
+	// Find the user whose ints exactly equal the given slice.
+	exact := client.User.Query().Where(user.IntsEQ([]int{1, 2, 3})).OnlyX(ctx)
+	require.Equal(t, []int{1, 2, 3}, exact.Ints)
+	require.Zero(t, client.User.Query().Where(user.IntsEQ([]int{3, 2, 1})).CountX(ctx), "arrays are ordered")
+	require.Zero(t, client.User.Query().Where(user.IntsEQ([]int{1, 2})).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.StringsEQ([]string{"a", "b"})).CountX(ctx))
+
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldInts, 3))
+	}).CountX(ctx)
+	require.Equal(t, 2, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldInts, 1))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.Not(sql.JSONArrayContains(user.FieldInts, 1)))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldStrings, "b"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldFloats, 1.5))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONHasKey(user.FieldInts, "2"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count, "only the first user has an element at index 2")
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONHasKey(user.FieldInts, "1"))
+	}).CountX(ctx)
+	require.Equal(t, 2, count)
+
+	// Elements are compared by their JSON types. i.e. numbers do not match strings,
+	// but integers match floats. In SQLite, the JSON_EACH values are integers, reals
+	// and texts, and they are compared without type affinity conversion.
+	client.User.Create().SetStrings([]string{"1", "2"}).SaveX(ctx)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldStrings, "1"))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldStrings, 1))
+	}).CountX(ctx)
+	require.Zero(t, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldInts, "3"))
+	}).CountX(ctx)
+	require.Zero(t, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldFloats, 2))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+	count = client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONArrayContains(user.FieldInts, 4.0))
+	}).CountX(ctx)
+	require.Equal(t, 1, count)
+}
+
+// ColumnType checks that the raw column is created as jsonb in PostgreSQL,
+// and that the migration converts it back from json if it was changed.
+func ColumnType(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	columnType := func() (typ string) {
+		err := drv.DB().QueryRowContext(ctx, `SELECT "data_type" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = 'users' AND "column_name" = 'raw'`).Scan(&typ)
+		require.NoError(t, err)
+		return typ
+	}
+	require.Equal(t, "jsonb", columnType())
+	_, err := drv.DB().ExecContext(ctx, `ALTER TABLE "users" ALTER COLUMN "raw" TYPE json`)
+	require.NoError(t, err)
+	require.Equal(t, "json", columnType())
+	err = client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	require.Equal(t, "jsonb", columnType())
+}
+
+// ColumnComment checks that the comment of the "raw" field is set
+// on its column by the migration, and that it is kept on re-runs.
+func ColumnComment(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	query := "SELECT `column_comment` FROM INFORMATION_SCHEMA.COLUMNS WHERE `table_schema` = (SELECT DATABASE()) AND `table_name` = 'users' AND `column_name` = 'raw'"
+	if drv.Dialect() == dialect.Postgres {
+		query = `SELECT col_description('users'::regclass, "ordinal_position") FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = 'users' AND "column_name" = 'raw'`
+	}
+	comment := func() (c string) {
+		err := drv.DB().QueryRowContext(ctx, query).Scan(&c)
+		require.NoError(t, err)
+		return c
+	}
+	require.Equal(t, "free-form metadata", comment())
+	err := client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true))
+	require.NoError(t, err)
+	require.Equal(t, "free-form metadata", comment())
+}
+
+// GINIndex checks that the GIN index on the url column is created
+// in PostgreSQL, and that running the migration again is a no-op.
+func GINIndex(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	var def string
+	err := drv.DB().QueryRowContext(ctx, `SELECT "indexdef" FROM "pg_indexes" WHERE "tablename" = 'users' AND "indexname" = 'user_url'`).Scan(&def)
+	require.NoError(t, err)
+	require.Contains(t, def, "USING gin")
+	err = client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true))
+	require.NoError(t, err)
+}
+
+// UniqueIndex tests that unique indexes on JSON columns are created by the
+// migration (using a generated hash column in MySQL), and that they are
+// enforced by the database.
+func UniqueIndex(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	id := &schema.Column{Name: "id", Type: field.TypeInt, Increment: true}
+	table := schema.NewTable("unique_urls").
+		AddPrimary(id).
+		AddColumn(&schema.Column{Name: "url", Type: field.TypeJSON, Nullable: true})
+	table.AddIndex("unique_urls_url", true, []string{"url"})
+	m, err := schema.NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, table))
+	defer drv.Exec(ctx, "DROP TABLE unique_urls", []interface{}{}, nil)
+
+	insert := func(u *url.URL) error {
+		buf, err := json.Marshal(u)
+		require.NoError(t, err)
+		query, args := sql.Dialect(drv.Dialect()).Insert("unique_urls").Columns("url").Values(string(buf)).Query()
+		return drv.Exec(ctx, query, args, nil)
+	}
+	require.NoError(t, insert(&url.URL{Scheme: "https", Host: "github.com"}))
+	require.NoError(t, insert(&url.URL{Scheme: "https", Host: "entgo.io"}))
+	require.Error(t, insert(&url.URL{Scheme: "https", Host: "github.com"}), "duplicate url")
+}
+
+// Backfill checks that the migration sets the backfill value of new JSON
+// columns on the existing rows of the table, and only on them.
+func Backfill(t *testing.T, drv *sql.Driver) {
+	ctx := context.Background()
+	id := &schema.Column{Name: "id", Type: field.TypeInt, Increment: true}
+	name := &schema.Column{Name: "name", Type: field.TypeString}
+	table := schema.NewTable("backfill_users").AddPrimary(id).AddColumn(name)
+	m, err := schema.NewMigrate(drv)
+	require.NoError(t, err)
+	require.NoError(t, m.Create(ctx, table))
+	defer drv.Exec(ctx, "DROP TABLE backfill_users", []interface{}{}, nil)
+	query, args := sql.Dialect(drv.Dialect()).Insert("backfill_users").Columns("name").Values("a8m").Values("nati").Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+
+	table = schema.NewTable("backfill_users").
+		AddPrimary(id).
+		AddColumn(name).
+		AddColumn(&schema.Column{Name: "dirs", Type: field.TypeJSON, Backfill: `["/tmp"]`}).
+		AddColumn(&schema.Column{Name: "meta", Type: field.TypeJSON, Nullable: true, Backfill: `{"env":"prod"}`})
+	require.NoError(t, m.Create(ctx, table))
+	query, args = sql.Dialect(drv.Dialect()).Insert("backfill_users").Columns("name", "dirs").Values("ariel", `["/etc"]`).Query()
+	require.NoError(t, drv.Exec(ctx, query, args, nil))
+	rows := &sql.Rows{}
+	query, args = sql.Dialect(drv.Dialect()).Select("dirs", "meta").From(sql.Table("backfill_users")).OrderBy("id").Query()
+	require.NoError(t, drv.Query(ctx, query, args, rows))
+	defer rows.Close()
+	var got []string
+	for rows.Next() {
+		var (
+			dirs []string
+			meta map[string]string
+			b1   []byte
+			b2   []byte
+		)
+		require.NoError(t, rows.Scan(&b1, &b2))
+		require.NoError(t, json.Unmarshal(b1, &dirs))
+		if b2 != nil {
+			require.NoError(t, json.Unmarshal(b2, &meta))
+		}
+		got = append(got, fmt.Sprint(dirs, meta))
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []string{"[/tmp] map[env:prod]", "[/tmp] map[env:prod]", "[/etc] map[]"}, got)
+}
+
+// JSONIndex checks that the JSON path index on the url column is created using
+// a generated column in MySQL, and that it's used by the JSONHasKey predicate.
+func JSONIndex(t *testing.T, client *ent.Client, drv *sql.Driver) {
+	ctx := context.Background()
+	var extra string
+	err := drv.DB().QueryRowContext(ctx, "SELECT `extra` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = 'users' AND `COLUMN_NAME` = 'url_scheme'").Scan(&extra)
+	require.NoError(t, err)
+	require.Contains(t, extra, "STORED GENERATED")
+
+	query, _ := sql.Dialect(dialect.MySQL).Select("*").From(sql.Table(user.Table)).Where(sql.JSONHasKey(user.FieldURL, "Scheme")).Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE `url_scheme` IS NOT NULL", query)
+	n := client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.JSONHasKey(user.FieldURL, "Scheme"))
+	}).CountX(ctx)
+	require.Equal(t, client.User.Query().Where(user.URLNotNil()).CountX(ctx), n)
+
+	err = client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true))
+	require.NoError(t, err)
 }
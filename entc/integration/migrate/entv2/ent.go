@@ -7,9 +7,11 @@
 package entv2
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/facebook/ent"
 	"github.com/facebook/ent/dialect"
@@ -248,3 +250,28 @@ func rollback(tx dialect.Tx, err error) error {
 	}
 	return err
 }
+
+// isSQLiteBusy reports if the error was returned by SQLite because the database
+// (or one of its tables) was locked by another connection.
+func isSQLiteBusy(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") || strings.Contains(msg, "database table is locked")
+}
+
+// retry calls f, and calls it again (up to c.maxRetries times) if it failed because
+// the SQLite database was busy. f is expected to run (and roll back) its own transaction,
+// and therefore, calls that run inside a transaction of the client are not retried.
+func (c config) retry(ctx context.Context, f func() error) error {
+	_, tx := c.driver.(*txDriver)
+	for i := 0; ; i++ {
+		err := f()
+		if err == nil || tx || i >= c.maxRetries || c.driver.Dialect() != dialect.SQLite || !isSQLiteBusy(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(i+1) * 10 * time.Millisecond):
+		}
+	}
+}
@@ -8,8 +8,10 @@ package entv2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -27,6 +29,8 @@ type GroupQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Group
+	forUpdate  bool
+	omit       []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -167,6 +171,61 @@ func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
 	return grs
 }
 
+// Stream executes the query and calls fn for each Group, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (gq *GroupQuery) Stream(ctx context.Context, fn func(*Group) error) error {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return gq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (gq *GroupQuery) StreamX(ctx context.Context, fn func(*Group) error) {
+	if err := gq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Groups to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Group is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (gq *GroupQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := gq.Stream(ctx, func(node *Group) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (gq *GroupQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := gq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Group ids.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -300,6 +359,63 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	return nodes, nil
 }
 
+func (gq *GroupQuery) sqlStream(ctx context.Context, fn func(*Group) error) error {
+	var (
+		_spec = gq.querySpec()
+	)
+	var node *Group
+	_spec.ScanValues = func() []interface{} {
+		node = &Group{config: gq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("entv2: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, gq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (gq *GroupQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *GroupSelect {
+	selector := &GroupSelect{config: gq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Group.Query().Where(group.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (gq *GroupQuery) ForUpdate() *GroupQuery {
+	gq.forUpdate = true
+	return gq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+func (gq *GroupQuery) Omit(fields ...string) *GroupQuery {
+	gq.omit = append(gq.omit, fields...)
+	return gq
+}
+
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
@@ -323,8 +439,10 @@ func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: group.FieldID,
 			},
 		},
-		From:   gq.sql,
-		Unique: true,
+		From:      gq.sql,
+		Unique:    true,
+		ForUpdate: gq.forUpdate,
+		Omit:      gq.omit,
 	}
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -371,6 +489,9 @@ func (gq *GroupQuery) sqlQuery() *sql.Selector {
 	if limit := gq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if gq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -619,6 +740,7 @@ func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
 type GroupSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -841,6 +963,10 @@ func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (gs *GroupSelect) sqlQuery() sql.Querier {
 	selector := gs.sql
-	selector.Select(selector.Columns(gs.fields...)...)
+	columns := selector.Columns(gs.fields...)
+	for _, fn := range gs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
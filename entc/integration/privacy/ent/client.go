@@ -116,6 +116,13 @@ func (c *Client) Debug() *Client {
 	return client
 }
 
+// SupportsJSON reports if the database that the client is connected to supports
+// the JSON type and functions that are used by the JSON predicates. For example,
+// MySQL supports them from version 5.7.8, and SQLite requires the JSON1 extension.
+func (c *Client) SupportsJSON(ctx context.Context) (bool, error) {
+	return migrate.SupportsJSON(ctx, c.driver)
+}
+
 // Close closes the database connection and prevents new queries from starting.
 func (c *Client) Close() error {
 	return c.driver.Close()
@@ -211,6 +218,44 @@ func (c *GalaxyClient) GetX(ctx context.Context, id int) *Galaxy {
 	return ga
 }
 
+// GetMany returns the Galaxy entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *GalaxyClient) GetMany(ctx context.Context, ids ...int) ([]*Galaxy, error) {
+	nodes, err := c.Query().Where(galaxy.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Galaxy, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*Galaxy, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", galaxy.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GalaxyClient) GetManyX(ctx context.Context, ids ...int) []*Galaxy {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryPlanets queries the planets edge of a Galaxy.
 func (c *GalaxyClient) QueryPlanets(ga *Galaxy) *PlanetQuery {
 	query := &PlanetQuery{config: c.config}
@@ -316,6 +361,44 @@ func (c *PlanetClient) GetX(ctx context.Context, id int) *Planet {
 	return pl
 }
 
+// GetMany returns the Planet entities of the given ids, in the same order, using one query.
+// A NotFoundError that lists the missing ids is returned if one of them does not exist.
+func (c *PlanetClient) GetMany(ctx context.Context, ids ...int) ([]*Planet, error) {
+	nodes, err := c.Query().Where(planet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Planet, len(nodes))
+	for _, node := range nodes {
+		byID[node.ID] = node
+	}
+	var (
+		missing []int
+		ordered = make([]*Planet, 0, len(ids))
+	)
+	for _, id := range ids {
+		node, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		ordered = append(ordered, node)
+	}
+	if len(missing) > 0 {
+		return nil, &NotFoundError{fmt.Sprintf("%s %v", planet.Label, missing)}
+	}
+	return ordered, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PlanetClient) GetManyX(ctx context.Context, ids ...int) []*Planet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 // QueryNeighbors queries the neighbors edge of a Planet.
 func (c *PlanetClient) QueryNeighbors(pl *Planet) *PlanetQuery {
 	query := &PlanetQuery{config: c.config}
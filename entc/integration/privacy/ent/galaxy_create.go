@@ -190,13 +190,17 @@ func (gcb *GalaxyCreateBulk) Save(ctx context.Context) ([]*Galaxy, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Galaxy, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range gcb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range gcb.builders {
 		func(i int, root context.Context) {
 			builder := gcb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*GalaxyMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -31,6 +33,8 @@ type GalaxyQuery struct {
 	predicates []predicate.Galaxy
 	// eager-loading edges.
 	withPlanets *PlanetQuery
+	forUpdate   bool
+	omit        []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (gq *GalaxyQuery) AllX(ctx context.Context) []*Galaxy {
 	return gas
 }
 
+// Stream executes the query and calls fn for each Galaxy, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (gq *GalaxyQuery) Stream(ctx context.Context, fn func(*Galaxy) error) error {
+	if gq.withPlanets != nil {
+		return errors.New("ent: GalaxyQuery.Stream does not support eager-loading of edges")
+	}
+	if err := gq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return gq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (gq *GalaxyQuery) StreamX(ctx context.Context, fn func(*Galaxy) error) {
+	if err := gq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Galaxies to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Galaxy is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (gq *GalaxyQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := gq.Stream(ctx, func(node *Galaxy) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (gq *GalaxyQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := gq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Galaxy ids.
 func (gq *GalaxyQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -393,6 +455,66 @@ func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
 	return nodes, nil
 }
 
+func (gq *GalaxyQuery) sqlStream(ctx context.Context, fn func(*Galaxy) error) error {
+	var (
+		_spec = gq.querySpec()
+	)
+	var node *Galaxy
+	_spec.ScanValues = func() []interface{} {
+		node = &Galaxy{config: gq.config}
+		values := node.scanValues()
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, gq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (gq *GalaxyQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *GalaxySelect {
+	selector := &GalaxySelect{config: gq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Galaxy.Query().Where(galaxy.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (gq *GalaxyQuery) ForUpdate() *GalaxyQuery {
+	gq.forUpdate = true
+	return gq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Galaxy.Query().Omit(galaxy.FieldName).AllX(ctx)
+//
+func (gq *GalaxyQuery) Omit(fields ...string) *GalaxyQuery {
+	gq.omit = append(gq.omit, fields...)
+	return gq
+}
+
 func (gq *GalaxyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
@@ -416,8 +538,10 @@ func (gq *GalaxyQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: galaxy.FieldID,
 			},
 		},
-		From:   gq.sql,
-		Unique: true,
+		From:      gq.sql,
+		Unique:    true,
+		ForUpdate: gq.forUpdate,
+		Omit:      gq.omit,
 	}
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -464,6 +588,9 @@ func (gq *GalaxyQuery) sqlQuery() *sql.Selector {
 	if limit := gq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if gq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -712,6 +839,7 @@ func (ggb *GalaxyGroupBy) sqlQuery() *sql.Selector {
 type GalaxySelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -934,6 +1062,10 @@ func (gs *GalaxySelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (gs *GalaxySelect) sqlQuery() sql.Querier {
 	selector := gs.sql
-	selector.Select(selector.Columns(gs.fields...)...)
+	columns := selector.Columns(gs.fields...)
+	for _, fn := range gs.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
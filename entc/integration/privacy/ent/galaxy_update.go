@@ -211,7 +211,11 @@ func (gu *GalaxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+	err = gu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{galaxy.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -409,7 +413,10 @@ func (guo *GalaxyUpdateOne) sqlSave(ctx context.Context) (ga *Galaxy, err error)
 	ga = &Galaxy{config: guo.config}
 	_spec.Assign = ga.assignValues
 	_spec.ScanValues = ga.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+	err = guo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, guo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{galaxy.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -189,13 +189,17 @@ func (pcb *PlanetCreateBulk) Save(ctx context.Context) ([]*Planet, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Planet, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
+	// Apply defaults on each builder before running its hooks, like in
+	// the single Save, so hooks see the same mutation in both cases.
+	for _, builder := range pcb.builders {
+		if err := builder.preSave(); err != nil {
+			return nil, err
+		}
+	}
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				if err := builder.preSave(); err != nil {
-					return nil, err
-				}
 				mutation, ok := m.(*PlanetMutation)
 				if !ok {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -31,6 +33,8 @@ type PlanetQuery struct {
 	// eager-loading edges.
 	withNeighbors *PlanetQuery
 	withFKs       bool
+	forUpdate     bool
+	omit          []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (pq *PlanetQuery) AllX(ctx context.Context) []*Planet {
 	return pls
 }
 
+// Stream executes the query and calls fn for each Planet, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (pq *PlanetQuery) Stream(ctx context.Context, fn func(*Planet) error) error {
+	if pq.withNeighbors != nil {
+		return errors.New("ent: PlanetQuery.Stream does not support eager-loading of edges")
+	}
+	if err := pq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return pq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (pq *PlanetQuery) StreamX(ctx context.Context, fn func(*Planet) error) {
+	if err := pq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Planets to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Planet is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (pq *PlanetQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := pq.Stream(ctx, func(node *Planet) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (pq *PlanetQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := pq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Planet ids.
 func (pq *PlanetQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -435,6 +497,73 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 	return nodes, nil
 }
 
+func (pq *PlanetQuery) sqlStream(ctx context.Context, fn func(*Planet) error) error {
+	var (
+		withFKs = pq.withFKs
+		_spec   = pq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, planet.ForeignKeys...)
+	}
+	var node *Planet
+	_spec.ScanValues = func() []interface{} {
+		node = &Planet{config: pq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, pq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (pq *PlanetQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *PlanetSelect {
+	selector := &PlanetSelect{config: pq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Planet.Query().Where(planet.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (pq *PlanetQuery) ForUpdate() *PlanetQuery {
+	pq.forUpdate = true
+	return pq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Planet.Query().Omit(planet.FieldName).AllX(ctx)
+//
+func (pq *PlanetQuery) Omit(fields ...string) *PlanetQuery {
+	pq.omit = append(pq.omit, fields...)
+	return pq
+}
+
 func (pq *PlanetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
@@ -458,8 +587,10 @@ func (pq *PlanetQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: planet.FieldID,
 			},
 		},
-		From:   pq.sql,
-		Unique: true,
+		From:      pq.sql,
+		Unique:    true,
+		ForUpdate: pq.forUpdate,
+		Omit:      pq.omit,
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -506,6 +637,9 @@ func (pq *PlanetQuery) sqlQuery() *sql.Selector {
 	if limit := pq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if pq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -754,6 +888,7 @@ func (pgb *PlanetGroupBy) sqlQuery() *sql.Selector {
 type PlanetSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -976,6 +1111,10 @@ func (ps *PlanetSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ps *PlanetSelect) sqlQuery() sql.Querier {
 	selector := ps.sql
-	selector.Select(selector.Columns(ps.fields...)...)
+	columns := selector.Columns(ps.fields...)
+	for _, fn := range ps.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
@@ -221,7 +221,11 @@ func (pu *PlanetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+	err = pu.retry(ctx, func() (err error) {
+		n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec)
+		return err
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{planet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -430,7 +434,10 @@ func (puo *PlanetUpdateOne) sqlSave(ctx context.Context) (pl *Planet, err error)
 	pl = &Planet{config: puo.config}
 	_spec.Assign = pl.assignValues
 	_spec.ScanValues = pl.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+	err = puo.retry(ctx, func() (err error) {
+		return sqlgraph.UpdateNode(ctx, puo.driver, _spec)
+	})
+	if err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{planet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x51\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xf9\x99\xfe\xa8\xdd\xa5\x4a\xdb\xb7\x0d\xf2\x50\xda\x0c\x32\xb6\x76\x90\xc2\x1e\xba\x52\x14\xfb\x3a\x11\x75\x24\xef\x4a\x29\x0b\x42\xdf\x7d\x48\x4e\xc2\xf6\x64\x4b\xe7\xdc\xf3\x47\x37\x84\xe9\x85\xb8\xb3\xc3\x9e\xf5\x7a\xe3\x71\x73\x75\xfd\xf1\x72\x60\x72\x64\x3c\x3e\xab\x86\x56\xd6\xbe\x61\x61\x1a\x89\xdb\xbe\x47\x26\x39\x24\x9c\xdf\xa9\x95\xe2\x69\xa3\x1d\x9c\xdd\x71\x43\x68\x6c\x4b\xd0\x0e\xbd\x6e\xc8\x38\x6a\xb1\x33\x2d\x31\xfc\x86\x70\x3b\xa8\x66\x43\xb8\x91\x57\x47\x14\x9d\xdd\x99\x56\x68\x93\xf1\xaf\x8b\xbb\xf9\xc3\x72\x8e\x4e\xf7\x84\xc3\x1d\x5b\xeb\xd1\x6a\xa6\xc6\x5b\xde\xc3\x76\xf0\x7f\x99\x79\x26\x92\xe2\x62\x1a\xa3\x10\x21\xa0\xa5\x4e\x1b\x42\xb9\x55\xda\x94\x88\x51\x4c\xa7\xb8\x4b\x79\xd6\x64\x88\x95\xa7\x16\xab\x3d\xce\xc9\xf8\xe6\x74\x75\x2e\x71\xff\x88\x87\xc7\x27\xcc\xef\x17\x4f\x52\x0c\xaa\x79\x53\x6b\x42\xd2\x10\x42\x6f\x07\xcb\x1e\x95\x28\x4a\xeb\x4a\x51\x94\xab\xbd\xa7\xf4\x13\x02\x3c\x6d\x87\x5e\x79\x42\x39\xb2\x5c\xb6\xcc\xd0\xc0\xda\xf8\x0e\xe5\xff\xbf\x4a\xc8\xef\x07\xc5\x18\x45\x9d\x63\x9e\xad\x94\x23\x7c\x9a\x21\x7f\x8f\x78\x9a\x7d\x57\x0c\xd7\x6c\x68\xab\x1c\x66\x78\x7e\x21\xe3\xe5\xc2\x78\xe2\x4e\x35\x14\xb2\x34\x2b\xb3\x26\x9c\xbd\x4e\x70\x66\xd4\x36\xcb\xc8\x07\xb5\x25\x97\xf4\x8b\x22\x84\xcb\x83\x7e\x8c\x32\x1d\x4e\x51\x5c\x88\xe5\x61\x26\xc6\x49\xd6\x22\xd3\xe2\x32\x46\x11\x85\xe8\x76\xa6\xc9\x9d\xab\x1a\x41\x14\x29\x48\xaf\x0d\x39\x3c\xbf\x3c\xbf\xa4\xd2\xa2\xe8\x2c\xe3\x75\x72\xc8\x97\x7c\xc7\x28\xc7\xbc\x41\x14\xc5\x6a\x02\x62\x4e\xd8\x37\xc5\x6e\xa3\xfa\x65\x06\xab\x91\x53\x8b\xa2\xd0\x5d\x66\xfc\x37\x83\xd1\x7d\x9e\x29\x3a\xa5\xfb\x8a\x98\x13\x9c\x2a\x8c\xbe\x33\xa8\x61\x20\xd3\x56\xf9\x38\xc1\xaa\x16\x09\xb5\x4e\x2e\x7d\x6b\x77\x5e\xfe\x60\xed\xa9\xca\xfb\x90\x5f\xac\x36\x47\xe2\x18\xb7\x2a\x7f\x9a\xb2\xae\xeb\x53\xb7\xa3\x4b\xb2\xb7\x9c\x4b\x8e\x5a\xc4\x3c\x6a\x2d\x3d\x6b\xb3\x4e\x1c\x39\x4f\x9c\xaa\xfe\x90\x45\x32\x71\xfe\x5b\xfb\xea\x3a\xcb\xfd\xb3\xfa\xb1\xd9\xb8\xf9\xc3\x8b\xc6\x28\xfe\x04\x00\x00\xff\xff\x95\x06\x0f\xa4\x50\x03\x00\x00")
+var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x51\x6b\xdb\x3c\x18\x85\xaf\xad\x5f\x71\x3e\xd3\x8f\xda\x5d\xaa\xb4\xbd\xdb\x20\x17\xa5\xcd\x20\x63\x6b\x07\x29\xec\xa2\x2b\x45\xb1\x5f\x27\xa2\x8e\xe4\xbd\x52\xca\x82\xd0\x7f\x1f\x92\x93\xb0\x5d\x25\xd6\x39\x7a\xce\x39\x28\x84\xe9\x85\xb8\xb3\xc3\x9e\xf5\x7a\xe3\x71\x73\x75\xfd\xf1\x72\x60\x72\x64\x3c\x3e\xab\x86\x56\xd6\xbe\x61\x61\x1a\x89\xdb\xbe\x47\x36\x39\x24\x9d\xdf\xa9\x95\xe2\x69\xa3\x1d\x9c\xdd\x71\x43\x68\x6c\x4b\xd0\x0e\xbd\x6e\xc8\x38\x6a\xb1\x33\x2d\x31\xfc\x86\x70\x3b\xa8\x66\x43\xb8\x91\x57\x47\x15\x9d\xdd\x99\x56\x68\x93\xf5\xaf\x8b\xbb\xf9\xc3\x72\x8e\x4e\xf7\x84\xc3\x19\x5b\xeb\xd1\x6a\xa6\xc6\x5b\xde\xc3\x76\xf0\x7f\x85\x79\x26\x92\xe2\x62\x1a\xa3\x10\x21\xa0\xa5\x4e\x1b\x42\xb9\x55\xda\x94\x88\x51\x4c\xa7\xb8\x4b\x7d\xd6\x64\x88\x95\xa7\x16\xab\x3d\xce\xc9\xf8\xe6\x74\x74\x2e\x71\xff\x88\x87\xc7\x27\xcc\xef\x17\x4f\x52\x0c\xaa\x79\x53\x6b\x42\x62\x08\xa1\xb7\x83\x65\x8f\x4a\x14\xa5\x75\xa5\x28\xca\xd5\xde\x53\xfa\x13\x02\x3c\x6d\x87\x5e\x79\x42\x39\xba\x5c\x8e\xcc\xd2\xc0\xda\xf8\x0e\xe5\xff\xbf\x4a\xc8\xef\x07\x62\x8c\xa2\xce\x35\xcf\x56\xca\x11\x3e\xcd\x90\x7f\x8f\x7a\xba\xfb\xae\x18\xae\xd9\xd0\x56\x39\xcc\xf0\xfc\x42\xc6\xcb\x85\xf1\xc4\x9d\x6a\x28\x64\x34\x2b\xb3\x26\x9c\xbd\x4e\x70\x66\xd4\x36\x63\xe4\x83\xda\x92\x4b\xe1\x45\x11\xc2\xe5\x81\x1f\xa3\x4c\x1f\xa7\x2a\x2e\xc4\xf2\x70\x27\xc6\x49\x66\x91\x69\x71\x19\xa3\x88\x42\x74\x3b\xd3\xe4\xcd\x55\x8d\x20\x8a\x54\xa4\xd7\x86\x1c\x9e\x5f\x9e\x5f\xd2\x68\x51\x74\x96\xf1\x3a\x39\xf4\x4b\xb9\x63\x95\x63\xdf\x20\x8a\x62\x35\x01\x31\x27\xed\x9b\x62\xb7\x51\xfd\x32\x8b\xd5\xe8\xa9\x45\x51\xe8\x2e\x3b\xfe\x9b\xc1\xe8\x3e\x25\x15\x45\xa7\x74\x5f\x11\x73\x92\xd3\x84\x31\x77\x06\x35\x0c\x64\xda\x2a\x7f\x4e\xb0\xaa\x45\x11\x45\x61\x9d\x5c\xfa\xd6\xee\xbc\xfc\xc1\xda\x53\x95\xaa\x39\xf9\xc5\x6a\x73\x34\x8e\x75\xab\xf2\xa7\x29\xeb\xba\x3e\x6d\x3b\xa6\xa4\x78\xcb\x79\xe4\xc8\x22\xe6\x91\xb5\xf4\xac\xcd\x3a\x79\xe4\x3c\x79\xaa\xfa\x43\x86\xe4\xd0\xf9\x6f\xed\xab\xeb\x8c\xfb\xe7\xe9\xc7\x65\xe3\xcb\x87\x00\x32\x2d\x62\x14\x7f\x06\x00\x95\x06\x0f\xa4\x50\x03\x00\x00")
 
 func templateMainTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,12 +93,12 @@ func templateMainTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/main.tmpl", size: 848, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/main.tmpl", size: 848, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x6d\x6f\xe3\x36\x12\xfe\x6c\xff\x8a\xd9\x00\x0d\xa4\x85\x2b\xf7\x8a\xa2\xb8\xf3\x9e\x0f\x28\xda\x2d\x9a\xeb\x35\x5d\x74\x77\xfb\x65\xb1\x48\x15\x89\xb4\xb9\x91\x48\x97\xa4\xb3\x49\xd3\xfc\xf7\xc3\x0c\x5f\x44\xc9\xb2\xe3\x7d\x89\xbf\x44\x1a\x0e\x87\x33\x0f\x1f\x0e\x87\x54\xe6\x73\xf8\x5e\x6d\x6e\xb5\x58\xad\x2d\x7c\xfd\xd5\x3f\xfe\xf5\xe5\x46\x33\xc3\xa4\x85\x1f\xcb\x8a\x5d\x2a\x75\x05\x67\xb2\x2a\xe0\xbb\xa6\x01\x52\x32\x80\xed\xfa\x9a\xd5\xc5\x74\x3e\x87\x57\x6b\x61\xc0\xa8\xad\xae\x18\x54\xaa\x66\x20\x0c\x34\xa2\x62\xd2\xb0\x1a\xb6\xb2\x66\x1a\xec\x9a\xc1\x77\x9b\xb2\x5a\x33\xf8\xba\xf8\x2a\xb4\x02\x57\x5b\x59\xa3\x09\x21\x49\xe5\x7f\x67\xdf\x3f\x3f\x7f\xf9\x1c\xb8\x68\x58\x90\x69\xa5\x2c\xd4\x42\xb3\xca\x2a\x7d\x0b\x8a\x83\x4d\xc6\xb3\x9a\xb1\x62\x3a\xdd\x94\xd5\x55\xb9\x62\xd0\xa8\xb2\x9e\x4e\x45\xbb\x51\xda\x42\x36\x9d\x9c\x30\x59\xa9\x5a\xc8\xd5\xfc\x9d\x51\xf2\x64\x3a\x39\xe1\xad\xc5\x3f\x9a\xf1\x86\x55\xf6\x64\x3a\x9d\x9c\xac\x84\x5d\x6f\x2f\x8b\x4a\xb5\x73\xee\x03\x9e\x33\x49\x6a\x7b\x9a\xe6\xa6\x5a\xb3\xb6\x9c\xb3\x7a\xc5\x8e\x50\xe3\x82\x35\xf5\x11\x7a\x42\xd6\xec\xe6\x64\x9a\x4f\x11\x92\x97\x24\x03\xcd\xfc\x64\x18\x28\x25\x30\x69\x0b\xdf\x60\xd7\xa5\x85\xf7\xa5\xa1\x98\x59\x0d\x5c\xab\x16\x4a\xa8\x54\xbb\x69\x04\x02\x6f\x98\x06\x8f\x4b\x31\xb5\xb7\x1b\x16\x4c\x1a\xab\xb7\x95\x85\xbb\xe9\xe4\xbc\x6c\x19\x00\xa0\x44\xc8\x15\xd0\xef\x0f\x44\x6a\x71\x22\xcb\x96\xcd\x54\x2b\x2c\x6b\x37\xf6\xf6\xe4\x8f\xe9\xe4\x7b\x25\xb9\x58\x01\xf9\x10\x9e\xbd\x72\x45\xaf\x7d\xf5\xe7\xf5\x8a\x19\x00\x78\xf3\xf6\x29\x3e\xa6\xb6\x11\x36\xd3\xd7\xfe\x11\x21\x32\xa4\x4d\x8f\x89\x36\xa1\x37\x50\x3f\x43\xa4\x98\x41\x75\x7a\x4c\xd4\x85\x6b\xea\xeb\xff\xa4\xd4\x95\x77\xe6\x85\x32\xc2\x0a\x25\x83\xfe\x1a\x9b\xfa\xda\x2f\x54\x23\xaa\x5b\x80\x4b\xa5\x1a\x80\x1e\x2c\x1b\x6a\xea\xa9\xdf\xd3\x74\x45\xb3\x35\x33\x95\x16\x97\xcc\x40\x09\xe4\x3a\x6c\x42\x93\x67\xb4\x9b\x6d\x3f\x27\xb1\x5f\x37\x2b\x31\x22\x00\x21\x2d\xc0\x7c\x0e\x0e\x13\x0a\x2d\x58\x71\xb6\x1b\x61\x6c\x31\x9d\xfc\x22\x6e\x58\x7d\x26\xb1\x0b\x39\x3d\x9f\xc3\x99\xac\x45\x55\x5a\x66\x40\xf0\xa4\x03\x32\xa6\x45\xed\x2f\x85\x74\x1d\x85\x3c\xf3\x76\xdd\x58\x24\xea\x8f\xd5\x92\xc8\x8d\xe5\xc2\x75\x0e\xed\x92\xd3\xc9\x3f\x82\x9b\xae\xe3\x2e\x35\xdd\x2f\x25\x68\xfa\xdb\x4b\xd6\x33\xc9\x55\xa7\xf6\x94\x62\x2f\x5e\xdd\x6e\x58\xaf\xc1\x77\x47\x07\xfa\xdd\x5f\x95\xe9\x60\x0f\x8c\x6e\xcb\x01\xf5\x5f\x8a\xbf\x12\xdf\x9f\x0a\x69\xbf\xfd\x66\x6f\x6f\x23\xfe\x1a\x0c\xfe\x5c\x6e\x5b\x13\xd5\xde\xbc\x75\xa0\xdc\xc1\xf9\x0c\x7e\x0f\xbe\xdc\xc7\xb5\x84\xca\xfd\xfe\xaf\xa5\xf8\x73\x1b\x1d\x48\x49\x3c\x32\xfc\x96\x94\xfb\x06\xce\x45\xd3\x94\x97\x0d\x3b\xca\x80\xf4\xca\x7d\x13\xbf\x6e\x90\xd4\x65\x73\x94\x09\xe5\x95\xfb\x26\x7e\x60\xbc\xdc\x36\xf6\xb8\x30\x6a\xa7\x3c\x6a\xe1\xf7\xb2\x41\x38\x84\xb4\x4c\x63\xda\xbd\xbb\x3f\x60\xe1\xe2\x1a\xb5\x07\x80\x6e\xea\xd2\xb2\xe0\xcf\x43\x80\x92\xf2\xc5\xa8\x43\x67\x6d\xbb\xb5\x11\xd9\x07\x0c\x89\xa0\xdc\xb7\xf1\x7b\xd9\x88\xba\xb4\x4a\x13\x45\x68\xd1\xee\xb7\x71\x1d\x95\x07\x0c\xb5\x4a\x97\x2b\xf6\x33\xbb\x85\x87\xf9\x6d\x9c\xf2\xc5\x15\xbb\x1d\xe6\x49\x9f\xbb\xe8\xf7\xb4\xff\x3a\xb4\x12\xb2\xe0\xc0\x11\x26\x51\x7c\x7d\x14\x22\x26\x28\x0f\x6c\x50\x3e\xc5\xc5\x8d\xba\x6d\xb9\x79\xe3\x02\x7a\xdb\x8b\x2b\xd8\x20\xe5\x8b\xdd\x25\xff\x9d\x94\xca\x96\xe8\xa1\xe9\x5b\xe9\xf1\xc6\x5b\x29\x3b\xe5\x91\xbd\x80\xf6\xbb\xdd\xdc\x48\xe2\x8f\x48\x8d\xd4\x6f\x3c\x33\xee\x99\xb9\xbd\x69\x31\x80\xf4\x70\xdf\xc3\x39\xf1\x81\xbe\xc3\x84\xf8\x1b\xe3\xd1\xeb\xc3\x5d\x35\xe3\x17\xbb\x6e\xff\xc6\x78\x54\xec\xaa\x89\x3d\xfd\xf7\x27\xc3\x3d\xf4\x3a\x90\x09\xcf\xe4\x35\xd3\xe6\x20\x39\x63\xd9\x41\x9a\x43\xbf\xff\xdc\x0a\xcd\xea\x87\xbb\x6b\xaf\xb9\x7f\x99\x3e\xc5\xaa\xa9\xe8\x2f\xdc\x23\xd6\x68\x4a\xeb\x3d\xa4\x3e\x8a\xd3\xae\x46\xd8\x25\xb5\x93\x7f\x04\xab\x5d\xc7\x8e\xd6\xc9\x44\x45\xa8\x0e\xcc\x4c\x28\x2f\xc3\x0e\x89\x9c\x7a\xb8\xbc\x1c\xd1\x1e\x2b\x2f\x13\x94\x23\x5d\x1f\x00\xda\xa1\x74\xce\xde\x13\x3d\x2b\xcd\xa8\xf4\x2a\x65\x40\x04\x9d\x72\xb0\xd0\x93\xab\x12\x37\x56\xe9\x62\xca\xb7\xb2\x0a\x3d\x33\x56\xfb\x99\xfe\x21\x6a\xe4\x9e\xf3\x77\xd3\x89\x64\xb0\x58\xc2\x29\xbe\xde\x4d\x27\xb8\x24\x17\x91\x49\xac\x2e\x5e\x95\xab\x19\x8a\x6f\x37\x6c\x91\x8a\x71\x2d\x4f\x27\x94\x39\x52\x39\xbe\xa3\xdc\x41\xbf\x88\x72\xf7\x8e\x2d\x9e\xff\x8b\xd0\xe2\xdf\xb1\x29\x70\x7b\xe1\x9b\xc2\xbb\x6b\xe3\xdd\x58\xd4\xc6\xc3\x58\x1d\xb4\x0b\x6a\xea\xde\xb1\x35\x61\xeb\x02\xda\xf2\x8a\x65\xe3\x9c\xcd\x67\xd3\xc9\xfd\x74\xc2\x95\x86\x8b\x19\x94\x16\x51\xd1\xa5\x5c\x31\x34\x99\x52\x1e\x51\x92\x2c\x15\xbd\x29\x2d\x05\x9e\xe5\x6f\x61\x09\xa5\x25\x43\x82\x83\x66\x1c\xad\x38\x6f\x9f\xd1\xeb\x93\x25\x48\xd1\x04\x1b\x98\x84\x96\x71\x9e\x34\xe3\xb9\x93\x27\x64\x59\x82\xd3\x4b\x64\x64\x5e\x33\xbb\xd5\x12\x24\xeb\x68\xe2\xea\xdd\x5d\x9e\xb8\x2a\x9d\x88\xe2\x1e\xc7\x98\x42\x9d\x33\x5e\x87\xc2\x36\xe5\x4a\xe6\x0e\x50\x33\x60\x5a\xe3\xfb\x1d\x45\xc7\xb4\xc6\xe8\x78\x5d\x3c\xd7\x3a\xcb\x9f\x91\x20\x89\x2f\x78\x28\x9a\x19\xf0\xd6\xa2\x96\xd2\x3c\x73\xab\x03\xbe\xf8\x73\x01\x5f\x5c\x9f\xcc\xb0\x3f\x4d\x24\x76\xcf\x29\x34\x43\xa8\x9d\xd2\x98\x77\x43\x8e\x41\xec\x40\x5c\xe2\xaa\xdf\x82\x92\xd9\x90\xc6\xd4\xe2\x89\x4c\x95\xf0\x22\x6d\x20\xc9\x0e\x67\xa9\xa9\x63\x6d\xa8\x5f\x17\x9d\x0f\xa1\x48\x9d\x4e\x62\x69\xda\xb5\x06\x09\xb6\xfa\x2a\x6f\xd1\xd9\x0d\x75\x9f\x43\x8b\xc6\x4e\xeb\xc1\x05\x8d\xdd\xab\x10\x3b\xcd\x58\xf0\x2d\x62\xcc\xb1\xaa\x1b\x2e\x06\x6a\xee\x2f\x87\xae\xd6\xa3\xf6\x86\xc9\x8c\xd7\x45\x27\xcd\xc9\x48\xa8\x8a\xe2\x18\x51\x42\xcd\xb1\x3a\x8a\x63\x44\xc9\xce\x92\x83\x8f\x5b\x74\x7c\x77\xd1\x19\x7e\xcc\xa2\x33\x9c\x48\x00\xcb\x87\x99\xd8\x0a\x63\x30\x13\xd3\xe6\x21\xb0\x13\x3a\x12\xf8\x79\x32\x43\x5b\x38\x44\x1e\x6d\xe3\x29\x6c\xb1\x04\x3a\x7e\x21\x6e\x78\x2c\xcb\x9f\x39\xf9\x93\x25\x7c\x15\xfc\xa4\xe3\xda\x12\x4e\xb1\x81\x3a\xe3\x76\xe7\xce\xcc\xbe\x8a\x07\x3a\x14\x40\x55\x4a\xb8\x64\x40\x77\x4a\xac\x06\xab\x48\x67\xc5\x24\xd3\x25\xad\x4f\xec\xf9\xa3\xd2\xc0\x6e\xca\x76\xd3\xb0\x19\x48\x65\xa1\x04\x5c\xb6\x54\x18\x37\xe2\x8a\x81\x15\x2d\x2b\xce\xd5\xfb\x82\xbc\xbc\x98\x85\xb5\x89\xfb\x4b\xf1\x4b\xa9\xcd\xba\x6c\xb2\x8e\x77\x7e\xad\x26\x08\x19\x5e\xf4\x4e\x36\xcb\x84\xa5\x69\xba\x31\x7c\x86\x7d\xba\x9c\xe3\xb6\xdc\xdd\x9c\xe3\xce\xfa\x94\x73\xdc\xe3\x58\xce\xa1\xce\x99\xa8\x6f\xf0\x40\x5b\xb3\x9b\xfe\x06\xe5\x4c\xdf\xc5\xb1\x4f\x49\x80\xde\xd2\x46\xed\x97\x93\xa8\x6f\xa8\x0a\xa6\x15\xec\xf6\xe4\x45\x6c\x70\xef\xc3\xb5\x8d\x2d\xdd\xca\x4e\x17\x0c\xb6\xf4\x96\xcb\xbd\x8f\xd4\x63\xe8\x6f\xbb\xdc\x6c\xd1\x4c\x25\xb7\x67\x91\xd6\xf8\xa4\xa0\x84\xff\xbe\xfc\xf5\x1c\x3b\x53\x25\xe3\x27\xba\x66\x6e\xa2\x49\x05\x0d\xf8\xce\xea\xf2\x1d\xab\xac\xff\xe3\x11\xea\x0d\x9a\x99\x30\x36\x16\x48\x7e\xa4\x1c\xb2\x4b\x78\xf3\xf6\xf2\xd6\xba\xfc\x99\x24\x68\x43\x39\xd4\xf5\x45\xcc\xdc\xf5\xda\x22\xdc\x14\xb9\xd7\x2c\x4f\xf7\x70\x21\xdd\x9d\x68\xe6\x6f\x32\x69\x93\xff\x95\xfb\x91\xf3\xdc\x2f\xb7\x59\x58\x0d\x9e\x64\xa6\xc0\x39\xa7\x2b\x9e\xa0\x7a\xf4\x5e\xe0\x83\x8a\x9b\x81\x19\xee\x05\xc3\x61\xdc\x8c\x7e\xfe\x71\x5c\x81\x17\xc7\x2a\x39\x23\x52\x85\x81\xa2\x23\x9f\x63\x2c\x9f\xed\x58\x5a\x61\x60\xe5\x49\x0b\xd1\x91\x19\x33\xda\x66\xc3\x64\x9d\x79\xc1\xac\xab\xe6\x92\x55\x92\xe5\xb9\x87\xc9\xdf\x50\xa6\x01\xf8\x0b\xcd\xc7\x0c\x01\x97\x6e\x0c\xc2\xfb\xe0\xc3\x08\xd7\xa9\x49\x20\x67\xc1\xc9\x74\xe9\x8f\x46\x33\x98\x74\xba\x6a\x7d\x7c\x6e\xb9\x3b\xda\xcf\x3f\x8e\xef\xd8\x4b\xc6\x26\xf7\x99\xe5\xb5\x6c\x7b\xb9\xc5\x25\x08\xe3\xb6\x01\x71\xcd\x24\x5c\x6e\x39\x67\x1a\x28\xa5\xf8\xec\x1a\xae\x7b\x29\x4d\x0c\x2c\x64\x97\x5b\xee\x73\x02\x56\x6e\x4e\x38\xdb\x97\x19\x7a\x30\x90\x87\xd1\x1c\x1a\x9a\x81\x39\x0c\x04\xd3\x3a\x25\x04\xef\xe8\x60\x7c\xf6\xa5\x2e\x49\xb9\x58\xf8\x0d\xd0\x8c\x94\x8c\xbb\xa6\xd1\x76\xb2\xfd\xa4\xbb\x4f\xcc\x3a\xf4\x64\xfc\x8d\xb2\x55\x1e\x1d\x7f\x32\x4a\xd3\xa5\x07\x2c\x33\xe0\x61\xc9\x61\x98\xba\x86\xf9\x95\x60\x43\xdf\xc8\x7a\x6f\x7d\xf5\x32\xde\x81\xd5\x95\x42\x24\x66\xd0\x26\x4b\xc6\xb9\x4c\x87\x81\xb2\xf5\x95\xc5\x78\x0e\x6e\x6f\x62\xfe\x9d\x4e\x26\xfe\x80\x99\x7a\xe3\x13\x63\x7b\x93\x77\x70\x8f\x20\xdb\x2f\x7f\x70\xf4\xc8\x5b\x99\xb0\x16\xfd\x25\x87\xdf\xf5\xe6\x94\x77\x33\x3a\xc1\x52\xc0\x8f\xdf\x1d\x1f\xfa\xab\x19\xd5\x46\x5c\xf9\x50\x5f\xc8\x19\x2c\x51\xe2\x6d\xe0\x12\x4e\xc3\xb3\xb3\x48\xe9\xc4\x57\x04\xef\x66\x24\xf2\xdf\x2f\x48\x68\xb5\xdb\xeb\x27\xc9\xc7\x89\x05\x88\x59\x67\x3c\x90\x35\x49\x57\xbe\x78\x00\xc3\x03\x20\xfb\x36\x89\xcf\x0d\xfa\xbe\xcd\xe1\xa3\x76\x07\xb2\x7a\x68\x7f\x78\x04\xef\xf7\xee\x0b\x9f\xb2\x31\xd0\x00\xee\xd3\x5a\x1a\x86\xdb\x1c\x3e\x3b\xef\x3b\xff\x69\xc8\xe0\xbd\xfb\xea\x97\xf8\xfe\x93\x73\xe8\x33\xf2\x31\x1f\x66\xbd\x7e\xca\xf3\x44\x75\x39\xcf\x9d\x55\x3e\x22\xe7\xf5\xea\xa8\xbd\x49\x6f\x7f\x9e\xf9\xe0\xb4\x37\x9e\x45\x8e\x4b\x22\xfb\xa7\x35\xee\x11\x7b\xd3\x43\xc0\x96\x74\x1e\x5a\xe5\x3b\x98\x8f\x62\x97\x96\x23\x7b\xa1\xdb\x47\xd4\x0f\x04\x6e\x8c\x86\xc7\xb2\x30\x92\xd0\x11\x2b\x12\x90\x97\x8d\xbb\x6f\xbb\x3f\x3a\xe4\x5e\x69\xb4\x37\x66\xff\x25\x3b\x0d\xba\x5f\x53\x1d\x11\xb5\x29\xfc\xa7\xf2\x25\x38\x73\x5e\x77\xdc\x4d\x0e\xee\x6a\x2a\x87\xae\xaa\xe8\xfc\x11\x1c\x9e\xc4\x83\x2d\xfc\xfd\x37\xbe\x9d\x49\xae\x8a\xf3\x6d\xcb\xb4\xa8\xb2\x7c\x50\xcf\x90\x07\x72\x06\xea\xca\x95\x2a\xe9\x99\xb8\xc8\x78\xa3\x4a\xfb\xed\x37\x2e\x8a\x27\xea\x2a\xed\x9c\xe6\x97\xad\x64\x37\x1b\x56\x59\x56\x0f\x0e\xfb\x74\xcf\x10\xaf\x18\x16\xee\x8e\x21\xbd\x62\x30\xef\x85\xad\xd6\x60\xdd\xe8\xe4\x2a\xee\xff\xcf\x70\xa4\xaa\x34\x0c\x2c\xfc\x67\x09\xe9\x97\x67\xfb\x4f\x38\x3d\x05\x0b\xff\x1e\x88\xbf\xfd\x66\x81\x99\x6c\x78\xaa\x77\x17\x17\x32\x1f\x37\xf7\x5a\x8c\xdb\x7b\x2d\xf6\x1a\xdc\x76\x16\xc7\x12\x56\x97\x31\xe0\xbd\x2e\x37\x26\xfd\x67\x05\x2f\x2f\x65\xed\xea\xa0\x20\x68\x99\x5d\xab\x1a\xde\x0b\xbb\x06\xcd\x2a\x75\xed\x8a\x5f\x26\xcd\x56\x33\x90\x0a\x36\xa5\x14\x95\x01\x21\xc1\x57\xaa\x42\xae\x7c\x9a\x4b\x32\x14\xaf\x93\xcf\xb3\xe0\x85\x39\xbc\x79\xdb\xfd\x4f\xc1\x7d\x0e\x99\x4f\x46\x89\x78\x78\x92\xae\x19\x96\xdf\x68\xde\xf3\x45\x70\xb8\xa6\x75\xe9\x9c\xc3\x3a\xf6\xba\x97\x9c\xe8\x72\xa5\x47\x89\x2f\x5e\x85\xe8\x9c\xf3\xf1\xee\x73\x06\xd7\x54\xe2\xf0\x90\x98\x88\x85\x94\xff\xb1\xd2\x0b\xec\xaa\x8b\x10\xc0\x6c\x80\xae\x2b\x08\x76\xc0\x75\xe2\x4f\x85\x32\x3d\x03\xa7\x68\x3a\x79\x00\x93\xbe\x24\x20\x96\xae\x52\xe9\x84\x8f\x81\x64\x2f\xbe\x1e\x98\x0e\x48\xe6\x0b\xa4\x51\x1c\xd3\xce\xbb\x50\x86\xca\x64\x07\xcc\xd0\xf0\xa9\x70\xf6\x4f\xe4\x29\xa0\xa1\x25\x40\xea\xee\xbe\x10\x53\x11\xff\x2d\x29\xca\x1f\x11\xd6\x10\xe9\x08\xb0\x22\xd6\x6d\x87\xa0\x8d\x81\x0c\xc1\x75\x27\xb5\x1d\x68\x9d\xf8\x53\x81\x3d\x74\x82\xcb\x5c\xb9\xe7\xf0\xfb\xa5\x3b\xc5\x3d\x0a\x7e\x2e\x9c\x11\xf4\x9c\x13\x87\xb1\x73\x51\xec\x20\xe7\x36\xfb\x1d\xe4\x9c\xf8\x53\x91\xeb\xd5\x32\x09\x21\x9d\x3c\xd0\x11\xdf\x88\x8d\xae\x08\xe9\x84\x8f\x08\xa5\x8b\x6f\x04\xca\xb5\x2f\x7e\x0e\x41\xe9\xdd\x1f\x42\xe9\x4b\x8b\x1d\x2c\xbd\xfc\x53\xc1\x3c\x58\x25\x65\xbe\x9c\x41\xf1\x8b\xa4\x50\x7a\x14\xf0\x7c\x40\x23\xe8\x6d\x42\x75\x75\x08\x3e\x1f\x48\x87\x1f\x85\x18\xef\x26\x2c\xa4\xb7\x13\x79\xef\x8d\x8e\x0d\x4a\x83\x2d\x7e\x16\xb2\xce\x72\x58\x2e\x63\xfb\x0b\x4b\x65\xd9\xc4\xc2\x12\x6c\xf1\xbc\x61\x6d\xd6\xab\x1b\xec\xf4\x7e\xfa\xff\x00\x00\x00\xff\xff\x37\x71\x9b\x6f\x3a\x2c\x00\x00")
+var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\x51\x6f\xdc\x36\x12\x7e\x96\x7e\xc5\xc4\x40\x03\xc9\xd8\x6a\x7b\x45\x10\xdc\x6d\x6e\x0f\x28\x52\x17\xe7\xeb\xd5\x0d\x9a\xa4\x2f\x41\xe0\xca\x12\xb9\xcb\x58\xa2\xb6\x22\xd7\xb1\xeb\xfa\xbf\x1f\x66\x38\x94\xa8\x5d\xad\x77\x1b\xdb\xe7\x17\x4b\xc3\x99\xe1\xf0\xe3\xc7\xe1\x90\xda\xe9\x14\x5e\x37\xab\x9b\x56\x2d\x96\x16\xbe\xfd\xe6\x6f\xff\xf8\x7a\xd5\x0a\x23\xb4\x85\x1f\xf2\x42\x5c\x34\xcd\x25\x9c\xea\x22\x83\xef\xaa\x0a\x48\xc9\x00\xb6\xb7\x57\xa2\xcc\xe2\xe9\x14\xde\x2d\x95\x01\xd3\xac\xdb\x42\x40\xd1\x94\x02\x94\x81\x4a\x15\x42\x1b\x51\xc2\x5a\x97\xa2\x05\xbb\x14\xf0\xdd\x2a\x2f\x96\x02\xbe\xcd\xbe\xf1\xad\x20\x9b\xb5\x2e\xd1\x85\xd2\xa4\xf2\xdf\xd3\xd7\x27\x67\x6f\x4f\x40\xaa\x4a\x78\x59\xdb\x34\x16\x4a\xd5\x8a\xc2\x36\xed\x0d\x34\x12\x6c\xd0\x9f\x6d\x85\xc8\xe2\x78\x95\x17\x97\xf9\x42\x40\xd5\xe4\x65\x1c\xab\x7a\xd5\xb4\x16\x92\x38\x3a\x12\xba\x68\x4a\xa5\x17\xd3\x4f\xa6\xd1\x47\x71\x74\x24\x6b\x8b\xff\x5a\x21\x2b\x51\xd8\xa3\x38\x8e\x8e\x16\xca\x2e\xd7\x17\x59\xd1\xd4\x53\xc9\x03\x9e\x0a\x6d\x8f\x76\x37\x4d\x4d\xb1\x14\x75\x3e\x15\xe5\x42\x1c\xa0\x26\x95\xa8\xca\x03\xf4\x94\x2e\xc5\xf5\x51\x9c\xc6\x08\xc9\x5b\xea\x02\x5a\xc1\x93\x61\x20\xd7\x20\xb4\xcd\xb8\xc1\x2e\x73\x0b\x9f\x73\x43\x63\x16\x25\xc8\xb6\xa9\x21\x87\xa2\xa9\x57\x95\x42\xe0\x8d\x68\x81\x71\xc9\x62\x7b\xb3\x12\xde\xa5\xb1\xed\xba\xb0\x70\x1b\x47\x67\x79\x2d\x00\x00\x8c\x6d\x95\x5e\xe0\x13\xc0\x6f\x88\xd4\xec\x48\xe7\xb5\x98\x34\xb5\xb2\xa2\x5e\xd9\x9b\xa3\xdf\xe2\xe8\x75\xa3\xa5\x5a\x00\xc5\xe0\x9f\x59\xb9\xa0\xd7\xa1\xfa\x49\xb9\x10\x06\x00\x3e\x7c\x3c\xc6\xc7\xd0\x37\xc2\x66\x86\xda\x3f\x20\x44\x86\xb4\xe9\x31\xd0\x26\xf4\x36\xd4\x4f\x11\x29\x61\x50\x9d\x1e\x03\x75\x02\x71\xd3\xfd\xbf\x9b\xe6\x92\x83\x79\xd3\x18\x65\x55\xa3\xbd\xfe\x12\x9b\x86\xda\x6f\x9a\x4a\x15\x37\x00\x17\x4d\x53\x01\xff\xb1\xf6\x8a\x9a\x06\xea\x77\x34\x5d\x9d\xdb\x52\x98\xa2\x55\x17\xc2\x40\x0e\x14\x3a\xac\x7c\x13\x33\xda\xcd\x36\xcf\x49\x67\xd7\xcf\x4a\x37\x22\x00\xa5\x2d\xc0\x74\x0a\x0e\x13\x1a\x9a\xf7\xe2\x7c\x57\xca\xd8\x2c\x8e\x7e\x52\xd7\xa2\x3c\xd5\x68\x43\x41\x4f\xa7\x70\xaa\x4b\x55\xe4\x56\x18\x50\x32\x30\x40\xc6\xd4\xa8\xfd\xb5\xd2\xce\x50\xe9\x53\xf6\xeb\xfa\x22\xd1\xb0\xaf\x9a\x44\xae\x2f\x37\x5c\x17\xd0\x36\x39\x9d\xfc\x0b\xb8\xe9\x0c\xb7\xa9\x09\xb0\x49\xd0\xf0\x6f\x27\x59\x4f\xb5\x6c\xbc\x12\xc0\x31\x8d\x3d\x7b\x77\xb3\x12\x83\x06\x36\xc7\x00\x86\xe6\xef\xf2\x05\x1c\xdc\xbb\xcd\x17\x43\xeb\xb7\xea\x8f\x20\xf6\x63\xa5\xed\xcb\x17\xfe\x6d\xcb\xda\xa8\x3f\x36\x3a\x3f\xd1\xeb\xda\x74\x9d\x7f\xf8\xe8\x40\xb9\x85\xb3\x09\xfc\xea\x63\xb9\xf3\xe6\x02\x95\x87\xf6\xef\xb5\xfa\x7d\xdd\x05\x10\x92\x78\xa4\xfb\x35\x29\x0f\x1d\x9c\xa9\xaa\xca\x2f\x2a\x71\x90\x03\xcd\xca\x43\x17\x3f\xaf\x90\xd4\x79\x75\x90\x8b\x86\x95\x87\x2e\xbe\x17\x32\x5f\x57\x16\x0e\x72\x51\x3a\xe5\x51\x0f\xbf\xe6\x15\xc2\xa1\xb4\x15\x2d\x66\xf1\xdb\xbb\x7b\x3c\x9c\x5f\xa1\xf6\xd0\xcf\xfb\x55\x99\x5b\xe1\xe3\xd9\x13\xc9\x9a\x94\xcf\x47\x03\x3a\xad\xeb\xb5\xed\x90\xdd\xe3\x48\x79\xe5\xa1\x8f\x5f\xf3\x4a\x95\xb9\x6d\x5a\xd3\x25\x88\xdd\x3e\xae\x3a\xe5\xa1\x93\x9f\xf2\xd6\x2c\xf3\x4a\xb4\x87\x04\x52\x7b\xe5\xa1\x8f\xf7\xba\x6b\xd8\xef\x63\xad\x77\x78\x79\x5b\xe4\xfa\x75\x23\xb0\x76\xd8\xef\xc5\x14\xb9\x3e\x2f\x48\x7b\xc3\x8b\x6d\xda\x7c\x21\x7e\x14\x37\x07\xac\x57\xe3\x94\xcf\x2f\xc5\xcd\xd0\x4b\x97\x8b\x51\x19\x8e\x87\xaf\x9b\x5e\x7c\x56\xdf\x08\x44\x68\x14\x5f\x1d\x34\xc3\xc6\x2b\x6f\xf8\xa0\xfd\x01\x93\x15\xea\xd6\xf9\xea\x83\x1b\xd0\xc7\xc1\xb8\xbc\x0f\x52\x3e\xdf\x4e\x61\xaf\x9b\xba\x16\x1d\x3b\xf6\x40\x52\x38\xe5\xa1\x87\xef\xb4\x6e\x6c\x8e\x63\x34\xc3\x38\x06\x2b\x89\x3d\xe4\xbd\xf2\xd0\xcb\x49\xad\xec\xd9\xba\x62\x1c\x8e\x77\x40\xc2\x5e\x44\xad\xec\xb9\x5e\x57\xd5\xc0\x87\xdb\x72\xa8\x8a\xd8\xde\x71\x48\xfc\x05\x1b\x0e\xd9\x8d\xef\x37\x3b\xc0\xda\xb9\xd9\xf8\xa9\xda\x6f\x7b\xff\x4e\xb3\xc7\x76\x73\x9b\xf9\x45\xc8\x2e\xea\xfb\x4d\x5b\x21\xcf\xb7\xc3\xfe\x45\x48\xaf\x07\x7d\x8d\xb6\xc3\x7e\xf7\x16\x73\xff\x8c\x8e\xed\x2f\xa7\xfa\x4a\xb4\x46\x1c\x60\xad\x9c\xe6\xd0\xfc\x17\xf1\xfb\x5a\xb5\xa2\xdc\x6f\xde\xb2\xe6\xd0\x3e\x4c\x16\xc7\x58\x8b\x66\xa1\xe4\xa0\x4c\x11\x2e\x8d\x1d\x0b\x63\xcf\xba\x70\x9c\x76\x95\xd7\x36\xa9\x9d\xfc\x0b\x58\xed\x0c\x7b\x5a\x3f\x6c\xa2\x7c\x0d\xdf\xd5\x21\xdb\x1c\xdb\x5f\xd2\xef\x37\x1e\xab\xf0\xc3\x29\x19\xb5\xfd\xff\x4d\xd2\x99\xf8\x8c\x40\x40\xd1\x0a\xaa\xa7\x73\xed\x27\x04\xc9\xe3\x0e\x5e\xf4\xe4\x4a\xff\x95\x6d\xda\x2c\x96\x6b\x5d\x78\xcb\x44\x94\x4c\xb4\xef\x3b\x8d\x94\x97\xdc\x6d\x1c\x69\x01\xb3\x39\x3c\xc7\xd7\xdb\x38\x8a\xde\xe5\x8b\x99\x1f\x22\x88\x32\x7b\x97\x2f\x26\x28\xbe\x59\x89\x4e\x8e\x62\x4c\x25\x71\x44\x67\xb8\x50\x8e\xef\xa8\xef\x66\x9e\x5b\x44\x99\xb9\x77\x6c\xe1\xe5\x37\xf3\x2d\xfc\x8e\x4d\x7e\x69\xcd\xb8\xc9\xbf\xbb\x36\xd9\xf7\x45\x6d\xd2\xf7\xd5\x4f\xd6\x8c\x3c\xf6\xef\x68\x18\xcc\xc3\x0c\xea\xfc\x52\x24\xe3\xb3\x91\x4e\xe2\xe8\x2e\x8e\x64\xd3\xc2\xf9\x04\x72\x8b\xa8\xb4\xb9\x5e\x08\x74\x19\x4e\x26\xa2\xa4\x45\x28\xfa\x90\xdb\x0c\x83\x49\xd2\x8f\x30\x87\xdc\x92\x23\x25\xa1\x15\x12\xbd\xb8\x68\x5f\xd1\xeb\xb3\x39\x68\x55\x79\x1f\x98\x03\xe7\xdd\x3c\xb5\x42\xa6\x4e\xde\x8f\x00\xe6\xe0\xf4\x02\x19\xb9\x6f\x85\x5d\xb7\x1a\xb4\xe8\x69\x42\x94\x1f\xe1\x09\x11\xdc\x11\xc5\x3d\x8e\x31\x85\x8c\x13\x59\xfa\xd3\x4a\xc8\x95\xe4\x98\x5a\x27\x20\xda\x16\xdf\x6f\xe3\x48\x49\x7c\xc1\xd1\xc9\x32\x3b\x69\xdb\x24\x7d\x45\x82\x60\x7c\x3e\x42\x55\x4d\x40\xd6\x16\xb5\x9a\x56\x26\x47\xe4\x1f\xbe\xfa\x7d\x06\x5f\x5d\x1d\x4d\x40\x32\x69\xd0\x3c\xa5\xa1\x19\x42\xed\x39\xf5\x79\xbb\xc9\x31\xe8\x0c\x88\x4b\xb2\x19\xb6\xe0\x01\x6b\xb2\x49\x63\xb2\x61\x22\xd3\xf1\xa6\x6f\xc2\xe8\x51\xb2\xc5\x59\x6a\xea\x59\xeb\x0f\x25\xdc\x8a\x31\xf8\x93\x47\x1c\x75\xe7\x8d\xbe\xd5\x4b\xd0\x96\x4b\x77\x6e\xc4\x56\x96\x30\x5a\xa8\x33\x28\xf2\x67\xa8\x33\x2c\xfb\x7b\xcd\xae\x8a\x9f\x79\x6f\x9d\x64\x6b\x31\x50\x73\x2f\xc1\xf6\xbe\x80\xa7\xf6\x4a\xe8\x44\x96\x59\x2f\xc5\x65\xd0\x17\xe8\x5d\x1f\x7d\xc9\x1e\xc4\xdc\x57\xd5\xa8\x87\x31\xeb\x7a\x44\xaf\x2f\xb2\xbb\x90\xfa\xb2\x3b\x50\xf3\xf5\x68\xd7\xe9\xdb\xae\x42\x8d\xa3\xa0\x2e\xed\xbd\x78\x09\x9a\x73\xc5\x19\xa0\xcc\x92\xad\x24\x00\xfb\xd2\x40\x57\x35\xb2\x33\x24\x09\x4b\x76\x26\x09\xb9\x9d\x24\x8c\x3c\x24\x49\x18\x49\xa4\x85\xf9\xfe\x95\x53\x2b\x63\x70\x0f\xa4\xbd\x56\xa1\x11\x06\xe2\xd7\xd3\xd1\x04\x8c\xa4\xb5\x94\x76\xbe\xf1\x2a\x60\x36\xc7\x73\xda\xcb\x17\x09\x22\xaa\xfe\x10\xe9\x2b\x27\x7f\x36\x87\x6f\x7c\x9c\x74\x67\x30\x87\xe7\xd8\x40\xc6\x78\x85\xe3\x2e\x6e\xf8\x28\x09\x74\x32\x85\x22\xd7\x70\x21\x80\x2e\x36\x45\x09\xb6\x21\x9d\x85\xd0\xa2\xc5\x83\x5e\x16\x47\x78\x5f\xd4\xb4\x20\xae\xf3\x7a\x55\x89\x09\xe8\xc6\xe2\x5d\xd4\x5a\x17\x88\x0c\x54\xea\x52\x80\x55\xb5\xc8\xce\x9a\xcf\x19\x45\x79\x3e\xf1\xb9\x04\xf7\x43\x4f\xb5\xa4\x5f\x27\x9c\x5b\x02\x84\x8c\xf4\x6d\xee\x78\x3d\x0f\x56\x55\x98\x1e\x8d\x9c\xa0\x4d\x9f\x23\x5d\x85\xb2\x9d\x23\xdd\x85\x13\xe5\x48\xf7\x38\x96\x23\xc9\x38\x51\xe5\x35\x1c\x93\xd2\x20\x49\xf2\x55\x20\xee\xa8\x8a\xf2\x17\xbd\x23\xbe\x98\xdc\x8d\xe7\xa5\x2a\xaf\x33\x12\x20\xcf\x28\xc5\xf9\x26\x6c\x71\x82\xad\x64\x84\x4d\x7d\x2e\x1a\x2c\x71\x6c\x1a\xae\xf0\x87\x6f\x78\xe8\x33\xf0\x42\x88\x6b\xb5\x97\xcc\x1d\x6d\x19\x6e\x9e\x48\xbe\xf7\x75\x94\x21\xba\x04\xf7\xc8\x5d\x3c\xc8\xd1\x06\x72\xf8\xcf\xdb\x9f\xcf\xe2\xe9\xd4\x55\x9f\xcc\xb6\x52\x38\xb6\x91\x0a\x3a\x60\xe3\xe6\xe2\x93\x28\x2c\xff\xe3\x69\x1a\x74\x9a\x18\xdf\x37\x16\xb5\xdc\x53\x0a\xc9\x05\x7c\xf8\x78\x71\x63\x85\x23\x5e\xbf\xab\x19\xc4\xe0\xb9\xf3\x8e\x83\x76\x17\xcd\x33\x7f\x67\xea\x5e\x93\x34\x2c\x7c\x94\x76\x5f\x07\x12\xbe\xd3\xa7\xca\xe8\x67\xc9\x3d\xa7\x29\xc3\x34\xf1\x4b\x92\x99\x6e\x32\xdc\x9c\xe9\xb2\xd3\xab\x1e\xbc\x81\xf2\xa0\xba\x1d\xd4\x6c\x6e\xa0\x9b\xdd\x38\x56\x3d\x7e\x3f\x58\x54\x9a\x6e\xf1\x9a\x5c\x0a\x62\xb6\xef\xa8\x0b\xe4\x31\xfa\x62\x9a\x8a\x9e\xa5\xd4\x3b\x39\x35\x6e\x45\x61\xed\xb5\x5a\x09\x5d\x26\x2c\x98\xf4\x25\x70\xb0\x54\x93\x34\x65\x98\xf8\xae\x3e\x1c\x00\x5f\xed\x3f\xe5\x10\x30\x7f\xf4\x4b\x8d\x3f\x25\xa0\x63\x93\xf9\x0f\x0b\xc1\x40\x58\x34\x19\xe4\x9f\xd1\xd1\x6c\x4c\x3a\x7d\x74\x78\xfc\x39\xdf\xec\xc6\x7d\xad\x78\xfc\x7e\xd8\x70\xb0\x23\x98\x94\x33\x4b\x57\x67\x70\x22\x70\x09\xc2\x50\x72\x59\xa8\x2b\xa1\xe1\x62\x2d\x25\x7e\xf9\xc3\x94\xc2\x29\xde\x7f\xf8\xa0\x34\xb1\xe1\x21\xb9\x58\x4b\xce\x09\x58\xee\x3a\xb7\x93\x5d\x99\x61\x00\x03\x45\xd8\xb9\x43\x47\x13\x30\xf7\x03\x21\xda\x36\x24\x84\xec\xe9\x60\x78\x07\xc0\x2e\x83\x3e\x64\xc6\xbb\xb0\x19\xa9\xb3\xb7\x5d\x47\x77\x21\x84\x26\xdc\x02\xbb\xac\x43\x1b\x9f\xe1\x6f\x2b\xb6\xe1\x14\xc7\xc7\xc9\x30\x5d\x32\x60\x89\x01\x86\x25\xed\x9d\xec\xc8\xaf\x04\x1b\xc6\x46\xde\x07\x09\x62\x90\xf1\x3a\x18\xb7\x71\x0a\x21\x52\x13\xa8\x83\x25\x43\x4e\x49\x17\x2f\x95\x50\xbe\x2b\x07\xd7\xd7\x5d\xfe\x8d\xa3\x88\xcf\xf9\x61\x34\x9c\x18\xeb\xeb\x34\x8e\x46\x62\xf1\xc1\x84\xc4\x75\xbd\x77\xbc\xd5\x01\x6b\x31\x5e\x9a\xd3\x4f\x83\x39\x95\xfd\x8c\x46\x46\x76\xfd\xf7\x67\xae\xe1\x6a\x8e\xa3\xd1\x50\xfe\x6a\x2c\x14\x0c\x96\x76\xdd\x3d\xf2\x1c\x9e\xfb\x67\xe7\x91\x52\x0b\x57\x18\x9f\x70\x4f\x8b\xfc\x97\x3c\x12\xda\xd6\x95\x1b\x51\xf0\x99\x6e\x06\x6a\xd2\x3b\xf7\x64\x0d\xd2\x15\x17\x30\x60\xa4\x07\x64\xd7\x26\xf1\xd8\xa0\xef\xda\x1c\xbe\x68\x77\xa0\xc8\xfd\xb7\xdc\x30\x76\x4e\xc7\x4f\x11\xfd\xce\x7d\xe1\x21\x1b\x03\x75\xe0\x3e\x32\x87\xc3\x70\x9b\xc3\x63\x0f\xe2\x53\x1f\x3f\x75\xe9\xa3\xa7\xde\xc2\xd8\x49\x30\x79\x4c\x3e\xa6\x9b\x59\x6f\x98\xf2\x98\xa8\xf8\x68\xf8\xc0\xf4\x05\x39\x6f\x50\x47\xed\x4c\x7a\xbb\xf3\xcc\x5f\x4e\x7b\xe3\x59\xe4\xb0\x24\xb2\x7b\x5a\xbb\x3d\x62\x67\x7a\xf0\xd8\xde\xc5\x07\xac\xf2\x2d\xcc\x47\xb1\x0b\xcb\x91\x9d\xd0\xed\x22\xea\x5f\x04\x6e\x8c\x86\x87\xb2\x90\x87\x0e\x4c\xac\x8e\x80\x32\xaf\x0c\xd1\xef\xee\xe0\x21\x0f\x4a\xa3\x9d\x63\xe6\xdf\x74\x84\x83\x1e\xd6\x54\x07\x8c\xda\x64\xfc\xa3\x91\x39\x38\x77\xac\x3b\x1e\xa6\x04\x77\x9f\x97\xfa\xb3\xbd\x49\x82\x78\x94\x84\x67\xdd\xe9\x1a\xfe\xfc\x13\xdf\xf0\x82\x22\x3b\x5b\xd7\xa2\x55\x45\x92\x86\x11\x50\x27\x77\x71\xa4\x27\xd0\x5c\x62\xfc\xc3\x83\x79\x96\xc8\xaa\xc9\xed\xcb\x17\x6e\xee\x9e\x35\x97\xa1\x71\x98\x5f\xd6\x5a\x5c\xaf\x44\x61\x45\xb9\x71\xe3\x40\x97\x1d\xdd\x3d\xc7\xcc\x5d\x74\x84\xf7\x1c\xe6\xb3\xb2\xc5\x12\xe8\x2e\x86\x43\xc5\x33\xd8\x2b\xec\xa9\xc8\x8d\x00\x0b\xff\x9a\x43\xf8\x1b\x0c\xfb\x77\x78\xfe\x1c\x2c\xfc\x73\x43\xfc\xf2\xc5\x0c\xd3\xf1\x60\x04\xe0\x6f\x4f\x74\x3a\xee\xee\xbd\x1a\xf7\xf7\x5e\xed\x74\xb8\xee\x3d\x6e\x31\x69\x3a\x0d\x32\x06\x7c\x6e\xf3\x95\x09\x7f\xb6\xc3\xf2\x5c\x97\xae\x74\xf3\x8b\xb3\x16\x76\xd9\x94\xf0\x59\xd9\x25\xb4\xa2\x68\xae\x5c\xf1\x2b\xb4\x59\xb7\x02\x74\x03\xab\x5c\xab\xc2\xe0\x4f\x6a\xb8\x52\x55\x7a\xc1\x69\x2e\xc8\x50\xb2\x0c\x7e\xa8\x00\x2c\x4c\xe1\xc3\xc7\xfe\xd7\x35\x77\x29\x24\x9c\x8c\x02\xf1\xe6\x49\xba\x14\x58\x7e\xa3\x7b\xe6\x8b\x92\x70\x85\x33\xc4\xc1\x61\x1d\x7b\x15\x32\x3a\x42\xfb\xf9\x80\x12\x5f\xbd\xf3\xa3\x73\xc1\xf3\xd6\x23\xcb\x09\x5c\x61\x86\xe3\x8a\x0e\x98\xea\xc8\x85\xbb\x24\xed\x00\x95\x25\x9b\x27\x69\x58\x01\x77\x15\xc8\x36\xb8\x4e\xfc\x50\x28\xc3\x33\x70\x88\xa6\x93\x7b\x30\xf1\x8d\xb0\x74\x95\x4a\x2f\x7c\x0a\x24\x07\xe3\x1b\x80\xe9\x80\x14\x5c\x20\x8d\xe2\x18\x1a\x6f\x43\xe9\x2b\x93\x2d\x30\x7d\xc3\x43\xe1\x64\x3f\x23\x80\xfa\x16\x0f\x29\xbd\x13\xa6\xbe\x7a\x0a\xe4\x4f\x08\x2b\xc7\x31\x06\xac\x0f\xe4\x7e\x68\xbb\x81\x6c\x82\x4b\x85\xf7\x36\xb4\x4e\xfc\x50\x60\xef\x3b\xc1\x25\x94\x5c\x18\xbf\x9f\xfa\x53\xdc\x93\xe0\x47\xfe\xc7\xd0\x73\x41\xdc\x8f\x1d\x19\x6f\x23\xe7\x36\xfb\x2d\xe4\x9c\xf8\xa1\xc8\x0d\x6a\x99\x80\x90\x4e\xee\xe9\x88\x6f\xc4\x46\x57\x84\xf4\xc2\x27\x84\x12\xdd\x8f\xae\xf0\x25\x17\x3f\xf7\x41\xc9\xe1\x6f\x42\xc9\xa5\xc5\x16\x96\x2c\x7f\x28\x98\xf7\x56\x49\x09\x97\x33\x28\x7e\x13\x14\x4a\x4f\x02\x1e\x0f\x68\x04\x3d\x8e\xe2\x7e\xf8\x78\x20\x3d\x15\x31\xa8\xfe\x6e\xc2\x42\x78\x3b\x91\x0e\xde\x30\x30\x2c\x71\x6c\xf6\xa3\xd2\x65\x92\xe2\xc7\x20\xdf\xfe\xc6\x52\x59\x16\x59\x98\x83\xcd\x4e\x2a\x51\x27\x83\xba\xc1\xc6\x77\xf1\xff\x06\x00\x87\x33\x34\x45\x44\x2f\x00\x00")
 
 func schemaGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func schemaGo() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.go", size: 11322, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "schema.go", size: 12100, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
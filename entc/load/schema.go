@@ -48,11 +48,16 @@ type Field struct {
 	UpdateDefault bool                    `json:"update_default,omitempty"`
 	Immutable     bool                    `json:"immutable,omitempty"`
 	Validators    int                     `json:"validators,omitempty"`
+	Marshaler     bool                    `json:"marshaler,omitempty"`
+	Unmarshaler   bool                    `json:"unmarshaler,omitempty"`
+	ScanCoerce    bool                    `json:"scan_coerce,omitempty"`
 	StorageKey    string                  `json:"storage_key,omitempty"`
 	Position      *Position               `json:"position,omitempty"`
 	Sensitive     bool                    `json:"sensitive,omitempty"`
 	SchemaType    map[string]string       `json:"schema_type,omitempty"`
+	Comment       string                  `json:"comment,omitempty"`
 	Annotations   map[string]interface{}  `json:"annotations,omitempty"`
+	EmitNull      *bool                   `json:"emit_null,omitempty"`
 }
 
 // Edge represents an ent.Edge that was loaded from a complied user package.
@@ -71,10 +76,11 @@ type Edge struct {
 
 // Index represents an ent.Index that was loaded from a complied user package.
 type Index struct {
-	Unique     bool     `json:"unique,omitempty"`
-	Edges      []string `json:"edges,omitempty"`
-	Fields     []string `json:"fields,omitempty"`
-	StorageKey string   `json:"storage_key,omitempty"`
+	Unique      bool                   `json:"unique,omitempty"`
+	Edges       []string               `json:"edges,omitempty"`
+	Fields      []string               `json:"fields,omitempty"`
+	StorageKey  string                 `json:"storage_key,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // NewEdge creates an loaded edge from edge descriptor.
@@ -118,9 +124,14 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		Immutable:     fd.Immutable,
 		StorageKey:    fd.StorageKey,
 		Validators:    len(fd.Validators),
+		Marshaler:     fd.Marshaler != nil,
+		Unmarshaler:   fd.Unmarshaler != nil,
+		ScanCoerce:    fd.ScanCoerce != nil,
 		Sensitive:     fd.Sensitive,
 		SchemaType:    fd.SchemaType,
+		Comment:       fd.Comment,
 		Annotations:   make(map[string]interface{}),
+		EmitNull:      fd.EmitNull,
 	}
 	for _, at := range fd.Annotations {
 		sf.Annotations[at.Name()] = at
@@ -141,12 +152,17 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 
 // NewIndex creates an loaded index from index descriptor.
 func NewIndex(idx *index.Descriptor) *Index {
-	return &Index{
-		Edges:      idx.Edges,
-		Fields:     idx.Fields,
-		Unique:     idx.Unique,
-		StorageKey: idx.StorageKey,
+	ni := &Index{
+		Edges:       idx.Edges,
+		Fields:      idx.Fields,
+		Unique:      idx.Unique,
+		StorageKey:  idx.StorageKey,
+		Annotations: make(map[string]interface{}),
+	}
+	for _, at := range idx.Annotations {
+		ni.Annotations[at.Name()] = at
 	}
+	return ni
 }
 
 // MarshalSchema encode the ent.Schema interface into a JSON
@@ -81,6 +81,8 @@ func (User) Indexes() []ent.Index {
 			Edges("parent").
 			StorageKey("user_parent_name").
 			Unique(),
+		index.Fields("name").
+			Annotations(&OrderConfig{FieldName: "name"}),
 	}
 }
 
@@ -169,6 +171,9 @@ func TestMarshalSchema(t *testing.T) {
 		require.Equal(t, []string{"parent"}, schema.Indexes[1].Edges)
 		require.Equal(t, "user_parent_name", schema.Indexes[1].StorageKey)
 		require.True(t, schema.Indexes[1].Unique)
+		require.Empty(t, schema.Indexes[1].Annotations)
+		ant = schema.Indexes[2].Annotations["order_config"].(map[string]interface{})
+		require.Equal(t, ant["FieldName"], "name")
 	}
 }
 
@@ -194,6 +199,16 @@ func (InvalidUUID) Fields() []ent.Field {
 	}
 }
 
+type InvalidJSON struct {
+	ent.Schema
+}
+
+func (InvalidJSON) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("bad", make(chan int)),
+	}
+}
+
 func TestMarshalFails(t *testing.T) {
 	i1 := InvalidEdge{}
 	buf, err := MarshalSchema(i1)
@@ -204,6 +219,11 @@ func TestMarshalFails(t *testing.T) {
 	buf, err = MarshalSchema(i2)
 	require.Nil(t, buf)
 	require.EqualError(t, err, `schema "InvalidUUID": field "invalid": expect type (func() uuid.UUID) for uuid default value`)
+
+	i3 := InvalidJSON{}
+	buf, err = MarshalSchema(i3)
+	require.Nil(t, buf)
+	require.EqualError(t, err, `schema "InvalidJSON": field "bad": type chan int (chan) is not supported by encoding/json`)
 }
 
 type WithDefaults struct {
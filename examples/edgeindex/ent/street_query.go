@@ -8,8 +8,10 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -29,8 +31,10 @@ type StreetQuery struct {
 	unique     []string
 	predicates []predicate.Street
 	// eager-loading edges.
-	withCity *CityQuery
-	withFKs  bool
+	withCity  *CityQuery
+	withFKs   bool
+	forUpdate bool
+	omit      []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -189,6 +193,64 @@ func (sq *StreetQuery) AllX(ctx context.Context) []*Street {
 	return sSlice
 }
 
+// Stream executes the query and calls fn for each Street, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (sq *StreetQuery) Stream(ctx context.Context, fn func(*Street) error) error {
+	if sq.withCity != nil {
+		return errors.New("ent: StreetQuery.Stream does not support eager-loading of edges")
+	}
+	if err := sq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return sq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (sq *StreetQuery) StreamX(ctx context.Context, fn func(*Street) error) {
+	if err := sq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Streets to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Street is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (sq *StreetQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := sq.Stream(ctx, func(node *Street) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (sq *StreetQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := sq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Street ids.
 func (sq *StreetQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -397,6 +459,73 @@ func (sq *StreetQuery) sqlAll(ctx context.Context) ([]*Street, error) {
 	return nodes, nil
 }
 
+func (sq *StreetQuery) sqlStream(ctx context.Context, fn func(*Street) error) error {
+	var (
+		withFKs = sq.withFKs
+		_spec   = sq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, street.ForeignKeys...)
+	}
+	var node *Street
+	_spec.ScanValues = func() []interface{} {
+		node = &Street{config: sq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, sq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (sq *StreetQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *StreetSelect {
+	selector := &StreetSelect{config: sq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return sq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Street.Query().Where(street.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (sq *StreetQuery) ForUpdate() *StreetQuery {
+	sq.forUpdate = true
+	return sq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Street.Query().Omit(street.FieldName).AllX(ctx)
+//
+func (sq *StreetQuery) Omit(fields ...string) *StreetQuery {
+	sq.omit = append(sq.omit, fields...)
+	return sq
+}
+
 func (sq *StreetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
 	return sqlgraph.CountNodes(ctx, sq.driver, _spec)
@@ -420,8 +549,10 @@ func (sq *StreetQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: street.FieldID,
 			},
 		},
-		From:   sq.sql,
-		Unique: true,
+		From:      sq.sql,
+		Unique:    true,
+		ForUpdate: sq.forUpdate,
+		Omit:      sq.omit,
 	}
 	if ps := sq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -468,6 +599,9 @@ func (sq *StreetQuery) sqlQuery() *sql.Selector {
 	if limit := sq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if sq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -716,6 +850,7 @@ func (sgb *StreetGroupBy) sqlQuery() *sql.Selector {
 type StreetSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -938,6 +1073,10 @@ func (ss *StreetSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ss *StreetSelect) sqlQuery() sql.Querier {
 	selector := ss.sql
-	selector.Select(selector.Columns(ss.fields...)...)
+	columns := selector.Columns(ss.fields...)
+	for _, fn := range ss.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
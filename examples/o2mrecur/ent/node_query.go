@@ -9,8 +9,10 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/facebook/ent/dialect/sql"
@@ -32,6 +34,8 @@ type NodeQuery struct {
 	withParent   *NodeQuery
 	withChildren *NodeQuery
 	withFKs      bool
+	forUpdate    bool
+	omit         []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -208,6 +212,64 @@ func (nq *NodeQuery) AllX(ctx context.Context) []*Node {
 	return ns
 }
 
+// Stream executes the query and calls fn for each Node, while the rows are read from the
+// database, without loading the whole result set into memory. The iteration stops on the first
+// error returned by fn, and the error is returned by Stream.
+//
+// Note that, eager-loading of edges is not supported by Stream, because edges are loaded for all
+// nodes after the result set was read. Hence, an error is returned if edges were requested.
+func (nq *NodeQuery) Stream(ctx context.Context, fn func(*Node) error) error {
+	if nq.withParent != nil || nq.withChildren != nil {
+		return errors.New("ent: NodeQuery.Stream does not support eager-loading of edges")
+	}
+	if err := nq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return nq.sqlStream(ctx, fn)
+}
+
+// StreamX is like Stream, but panics if an error occurs.
+func (nq *NodeQuery) StreamX(ctx context.Context, fn func(*Node) error) {
+	if err := nq.Stream(ctx, fn); err != nil {
+		panic(err)
+	}
+}
+
+// WriteJSON executes the query and writes the Nodes to w as a JSON array, while the
+// rows are read from the database (see Stream). Each Node is encoded using encoding/json,
+// and therefore, its JSON fields are written as nested JSON values, and its sensitive fields are
+// omitted. Note that, if an error occurs, the array that was written to w is not terminated.
+func (nq *NodeQuery) WriteJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	sep := ""
+	err := nq.Stream(ctx, func(node *Node) error {
+		buf, err := json.Marshal(node)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		sep = ","
+		_, err = w.Write(buf)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "]")
+	return err
+}
+
+// WriteJSONX is like WriteJSON, but panics if an error occurs.
+func (nq *NodeQuery) WriteJSONX(ctx context.Context, w io.Writer) {
+	if err := nq.WriteJSON(ctx, w); err != nil {
+		panic(err)
+	}
+}
+
 // IDs executes the query and returns a list of Node ids.
 func (nq *NodeQuery) IDs(ctx context.Context) ([]int, error) {
 	var ids []int
@@ -456,6 +518,73 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	return nodes, nil
 }
 
+func (nq *NodeQuery) sqlStream(ctx context.Context, fn func(*Node) error) error {
+	var (
+		withFKs = nq.withFKs
+		_spec   = nq.querySpec()
+	)
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, node.ForeignKeys...)
+	}
+	var node *Node
+	_spec.ScanValues = func() []interface{} {
+		node = &Node{config: nq.config}
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if node == nil {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		if err := node.assignValues(values...); err != nil {
+			return err
+		}
+		return fn(node)
+	}
+	return sqlgraph.QueryNodes(ctx, nq.driver, _spec)
+}
+
+// SelectValue selects the values computed by the given functions, instead of fields.
+// Use As for naming the selected values, when they are scanned into a struct.
+func (nq *NodeQuery) SelectValue(fn AggregateFunc, fns ...AggregateFunc) *NodeSelect {
+	selector := &NodeSelect{config: nq.config}
+	selector.fns = append([]AggregateFunc{fn}, fns...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery(), nil
+	}
+	return selector
+}
+
+// ForUpdate locks the rows that are selected by the query until the end of the transaction,
+// using the FOR UPDATE clause. It should be used for read-modify-write flows within transactions,
+// as it blocks concurrent transactions from updating these rows. Note that SQLite does not
+// support row-level locking, and it serializes writing transactions instead.
+//
+//	tx.Node.Query().Where(node.ID(id)).ForUpdate().OnlyX(ctx)
+//
+func (nq *NodeQuery) ForUpdate() *NodeQuery {
+	nq.forUpdate = true
+	return nq
+}
+
+// Omit excludes the values of the given fields from the query, and leaves them zero
+// on the returned entities. It is useful for skipping large fields (e.g. JSON documents)
+// that are not needed by the caller. The columns are selected as NULL, and therefore,
+// the fields must be able to scan NULL values.
+//
+//	client.Node.Query().Omit(node.FieldValue).AllX(ctx)
+//
+func (nq *NodeQuery) Omit(fields ...string) *NodeQuery {
+	nq.omit = append(nq.omit, fields...)
+	return nq
+}
+
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
 	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
@@ -479,8 +608,10 @@ func (nq *NodeQuery) querySpec() *sqlgraph.QuerySpec {
 				Column: node.FieldID,
 			},
 		},
-		From:   nq.sql,
-		Unique: true,
+		From:      nq.sql,
+		Unique:    true,
+		ForUpdate: nq.forUpdate,
+		Omit:      nq.omit,
 	}
 	if ps := nq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -527,6 +658,9 @@ func (nq *NodeQuery) sqlQuery() *sql.Selector {
 	if limit := nq.limit; limit != nil {
 		selector.Limit(*limit)
 	}
+	if nq.forUpdate {
+		selector.ForUpdate()
+	}
 	return selector
 }
 
@@ -775,6 +909,7 @@ func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
 type NodeSelect struct {
 	config
 	fields []string
+	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -997,6 +1132,10 @@ func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
 
 func (ns *NodeSelect) sqlQuery() sql.Querier {
 	selector := ns.sql
-	selector.Select(selector.Columns(ns.fields...)...)
+	columns := selector.Columns(ns.fields...)
+	for _, fn := range ns.fns {
+		columns = append(columns, fn(selector))
+	}
+	selector.Select(columns...)
 	return selector
 }
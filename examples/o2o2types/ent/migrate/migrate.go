@@ -35,6 +35,9 @@ var (
 	// WithFixture sets the foreign-key renaming option to the migration when upgrading
 	// ent from v0.1.0 (issue-#285). Defaults to true.
 	WithFixture = schema.WithFixture
+	// SupportsJSON reports if the connected database supports the JSON type
+	// and functions that are used by the JSON predicates (e.g. MySQL 5.7.8).
+	SupportsJSON = schema.SupportsJSON
 )
 
 // Schema is the API for creating, migrating and dropping a schema.
@@ -7,12 +7,15 @@ package field
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -69,22 +72,42 @@ func Time(name string) *timeBuilder {
 //	field.JSON("info", &Info{}).
 //		Optional()
 //
+// Interface types are passed as pointers (e.g. new(Payload)), and the field
+// holds the interface type itself. See Discriminator for decoding their values.
 func JSON(name string, typ interface{}) *jsonBuilder {
 	t := reflect.TypeOf(typ)
+	if t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+		t = t.Elem()
+	}
 	info := &TypeInfo{
 		Type:    TypeJSON,
 		Ident:   t.String(),
 		PkgPath: t.PkgPath(),
 	}
 	switch t.Kind() {
-	case reflect.Slice, reflect.Array, reflect.Ptr, reflect.Map:
+	case reflect.Slice, reflect.Array, reflect.Ptr, reflect.Map, reflect.Interface:
 		info.Nillable = true
 		info.PkgPath = pkgPath(t)
 	}
-	return &jsonBuilder{&Descriptor{
-		Name: name,
-		Info: info,
-	}}
+	tv := indirect(t)
+	info.RType = &RType{
+		Name:    tv.Name(),
+		Kind:    tv.Kind(),
+		PkgPath: tv.PkgPath(),
+		Fields:  structFields(tv),
+	}
+	// JSON types that implement the driver.Valuer interface, and whose pointer
+	// implements the sql.Scanner interface, are stored and scanned using them.
+	if t.Kind() != reflect.Ptr && t.Implements(valuerType) && reflect.PtrTo(t).Implements(valueScannerType) {
+		info.RType.Methods = methods(reflect.PtrTo(t))
+	}
+	return &jsonBuilder{
+		desc: &Descriptor{
+			Name: name,
+			Info: info,
+		},
+		typ: t,
+	}
 }
 
 // Strings returns a new JSON Field with type []string.
@@ -227,7 +250,8 @@ func (b *stringBuilder) Immutable() *stringBuilder {
 }
 
 // Comment sets the comment of the field.
-func (b *stringBuilder) Comment(string) *stringBuilder {
+func (b *stringBuilder) Comment(c string) *stringBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -313,6 +337,7 @@ func (b *timeBuilder) Immutable() *timeBuilder {
 
 // Comment sets the comment of the field.
 func (b *timeBuilder) Comment(c string) *timeBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -427,6 +452,7 @@ func (b *boolBuilder) Immutable() *boolBuilder {
 
 // Comment sets the comment of the field.
 func (b *boolBuilder) Comment(c string) *boolBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -503,7 +529,8 @@ func (b *bytesBuilder) Immutable() *bytesBuilder {
 }
 
 // Comment sets the comment of the field.
-func (b *bytesBuilder) Comment(string) *bytesBuilder {
+func (b *bytesBuilder) Comment(c string) *bytesBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -573,6 +600,7 @@ func (b *bytesBuilder) Descriptor() *Descriptor {
 // jsonBuilder is the builder for json fields.
 type jsonBuilder struct {
 	desc *Descriptor
+	typ  reflect.Type
 }
 
 // StorageKey sets the storage key of the field.
@@ -589,6 +617,346 @@ func (b *jsonBuilder) Optional() *jsonBuilder {
 	return b
 }
 
+// EmitNull sets the policy for storing nil values (of slices, maps and pointers) in the
+// database. If emit is true, nil values are encoded as a JSON null literal. If it is false,
+// nil values of optional fields clear the field (stored as SQL NULL), and nil values of
+// required fields are stored as an empty JSON array or object. For example:
+//
+//	field.Ints("ints").
+//		Optional().
+//		EmitNull(true)
+//
+// Without a policy, nil values of optional slices (and of pointers to slices or maps) clear
+// the field, and nil values of other types are encoded as a JSON null literal.
+func (b *jsonBuilder) EmitNull(emit bool) *jsonBuilder {
+	switch b.typ.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Ptr, reflect.Interface:
+		b.desc.EmitNull = &emit
+	default:
+		b.desc.err = fmt.Errorf("EmitNull is supported only for slices, maps and pointers, got %s", b.desc.Info)
+	}
+	return b
+}
+
+// Default sets the default value of the field. The value must be
+// of the same Go type that was provided to the JSON field. For example:
+//
+//	field.JSON("dirs", []http.Dir{}).
+//		Default([]http.Dir{"/tmp"})
+//
+func (b *jsonBuilder) Default(v interface{}) *jsonBuilder {
+	if t := reflect.TypeOf(v); t == nil || t.String() != b.desc.Info.Ident {
+		b.desc.err = fmt.Errorf("expect type (%s) for default value", b.desc.Info)
+	}
+	b.desc.Default = v
+	return b
+}
+
+// Validate adds a validator for this field. Operation fails if the validation fails.
+// The validator must be a function that accepts the Go type that was provided to the
+// JSON field, and returns an error. For example:
+//
+//	field.Strings("strings").
+//		Validate(func(s []string) error {
+//			if len(s) == 0 {
+//				return errors.New("empty list")
+//			}
+//			return nil
+//		})
+//
+func (b *jsonBuilder) Validate(fn interface{}) *jsonBuilder {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 1 ||
+		t.In(0).String() != b.desc.Info.Ident || t.Out(0) != errorType {
+		b.desc.err = fmt.Errorf("expect validator of type func(%s) error", b.desc.Info)
+	}
+	b.desc.Validators = append(b.desc.Validators, fn)
+	return b
+}
+
+// Values adds a validator that restricts the elements of a JSON array of strings
+// (e.g. []string) to the given values. Like the validators of enum fields, it fails
+// the mutation if one of the elements is not one of the values. Note that, like other
+// validators, it is not called for the values that are passed to Append<Field>.
+//
+//	field.JSON("tags", []string{}).
+//		Values("a", "b", "c")
+//
+func (b *jsonBuilder) Values(values ...string) *jsonBuilder {
+	if t := b.typ; t == nil || t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.String {
+		b.desc.err = fmt.Errorf("Values is supported only by arrays of strings, got %s", b.desc.Info)
+		return b
+	}
+	enums := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		enums[v] = struct{}{}
+	}
+	name := b.desc.Name
+	fn := reflect.MakeFunc(reflect.FuncOf([]reflect.Type{b.typ}, []reflect.Type{errorType}, false), func(in []reflect.Value) []reflect.Value {
+		var err error
+		for i := 0; i < in[0].Len() && err == nil; i++ {
+			v := in[0].Index(i).String()
+			if _, ok := enums[v]; !ok {
+				err = fmt.Errorf("invalid enum value for %s field: %q", name, v)
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(&err).Elem()}
+	})
+	return b.Validate(fn.Interface())
+}
+
+// MaxLen adds a validator for the length (in bytes) of the encoded value. The value
+// is encoded using the field Marshaler if it was set, or json.Marshal otherwise. Like
+// other validators of nillable JSON fields, it is not called for nil values.
+//
+//	field.JSON("raw", json.RawMessage{}).
+//		MaxLen(65535)
+//
+func (b *jsonBuilder) MaxLen(i int) *jsonBuilder {
+	desc := b.desc
+	fn := reflect.MakeFunc(reflect.FuncOf([]reflect.Type{b.typ}, []reflect.Type{errorType}, false), func(args []reflect.Value) []reflect.Value {
+		var (
+			buf []byte
+			err error
+		)
+		if desc.Marshaler != nil {
+			out := reflect.ValueOf(desc.Marshaler).Call(args)
+			buf, _ = out[0].Interface().([]byte)
+			err, _ = out[1].Interface().(error)
+		} else {
+			buf, err = json.Marshal(args[0].Interface())
+		}
+		if err == nil && len(buf) > i {
+			err = errors.New("value is greater than the required length")
+		}
+		return []reflect.Value{reflect.ValueOf(&err).Elem()}
+	})
+	b.desc.Validators = append(b.desc.Validators, fn.Interface())
+	return b
+}
+
+// Marshaler sets a custom function for encoding the field value before it is stored
+// in the database, instead of json.Marshal. The function must accept the Go type that
+// was provided to the JSON field. For example:
+//
+//	field.JSON("dirs", []http.Dir{}).
+//		Marshaler(func(dirs []http.Dir) ([]byte, error) {
+//			var b bytes.Buffer
+//			enc := json.NewEncoder(&b)
+//			enc.SetEscapeHTML(false)
+//			err := enc.Encode(dirs)
+//			return b.Bytes(), err
+//		})
+//
+// Note that the Marshaler and Unmarshaler are independent of each other. If only one of
+// them is provided, the other direction uses the standard encoding/json package.
+func (b *jsonBuilder) Marshaler(fn interface{}) *jsonBuilder {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 2 ||
+		t.In(0).String() != b.desc.Info.Ident || t.Out(0) != bytesType || t.Out(1) != errorType {
+		b.desc.err = fmt.Errorf("expect marshaler of type func(%s) ([]byte, error)", b.desc.Info)
+	}
+	b.desc.Marshaler = fn
+	return b
+}
+
+// Unmarshaler sets a custom function for decoding the field value after it is read
+// from the database, instead of json.Unmarshal. The function must accept the stored
+// data and a pointer to the Go type that was provided to the JSON field. For example:
+//
+//	field.JSON("dirs", []http.Dir{}).
+//		Unmarshaler(func(b []byte, dirs *[]http.Dir) error {
+//			return json.Unmarshal(b, dirs)
+//		})
+//
+func (b *jsonBuilder) Unmarshaler(fn interface{}) *jsonBuilder {
+	t := reflect.TypeOf(fn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 1 || t.In(0) != bytesType ||
+		t.In(1).String() != "*"+b.desc.Info.Ident || t.Out(0) != errorType {
+		b.desc.err = fmt.Errorf("expect unmarshaler of type func([]byte, *%s) error", b.desc.Info)
+	}
+	b.desc.Unmarshaler = fn
+	return b
+}
+
+// ScanCoerce sets a function for transforming the stored JSON value of the field
+// before it is decoded, when the field is scanned from the database. It allows
+// changing the shape of a field (e.g. from []string to []int) without migrating
+// the existing rows at once, by converting their old values on read. For example:
+//
+//	field.Ints("ints").
+//		ScanCoerce(func(raw json.RawMessage) (json.RawMessage, error) {
+//			var vs []interface{}
+//			if err := json.Unmarshal(raw, &vs); err != nil {
+//				return nil, err
+//			}
+//			for i, v := range vs {
+//				if s, ok := v.(string); ok {
+//					vs[i], _ = strconv.Atoi(s)
+//				}
+//			}
+//			return json.Marshal(vs)
+//		})
+//
+// The function is not called for NULL values, and the values written by the builders
+// are not transformed. Hence, rows keep their old shape until they are updated.
+func (b *jsonBuilder) ScanCoerce(fn func(json.RawMessage) (json.RawMessage, error)) *jsonBuilder {
+	if fn == nil {
+		b.desc.err = fmt.Errorf("nil scan coerce function for field %q", b.desc.Name)
+		return b
+	}
+	b.desc.ScanCoerce = fn
+	return b
+}
+
+// Raw stores the bytes of a []byte field as is, like json.RawMessage, instead of
+// encoding them as a base64 JSON string (the behavior of encoding/json). Hence, the
+// stored bytes must be a valid JSON document. For example:
+//
+//	field.JSON("blob", []byte{}).
+//		Raw()
+//
+// Raw sets both the Marshaler and the Unmarshaler of the field, and is supported only
+// for byte slices.
+func (b *jsonBuilder) Raw() *jsonBuilder {
+	if b.typ.Kind() != reflect.Slice || b.typ.Elem().Kind() != reflect.Uint8 {
+		b.desc.err = fmt.Errorf("raw is supported only for byte slices, got %s", b.desc.Info)
+		return b
+	}
+	b.desc.Marshaler = reflect.MakeFunc(reflect.FuncOf([]reflect.Type{b.typ}, []reflect.Type{bytesType, errorType}, false), func(in []reflect.Value) []reflect.Value {
+		buf := in[0].Bytes()
+		if buf == nil {
+			buf = []byte("null")
+		}
+		return []reflect.Value{reflect.ValueOf(buf), reflect.Zero(errorType)}
+	}).Interface()
+	b.desc.Unmarshaler = reflect.MakeFunc(reflect.FuncOf([]reflect.Type{bytesType, reflect.PtrTo(b.typ)}, []reflect.Type{errorType}, false), func(in []reflect.Value) []reflect.Value {
+		// Copy the data, as the scanned buffer may be reused by the driver.
+		buf := append([]byte(nil), in[0].Bytes()...)
+		in[1].Elem().Set(reflect.ValueOf(buf).Convert(b.typ))
+		return []reflect.Value{reflect.Zero(errorType)}
+	}).Interface()
+	return b
+}
+
+// Discriminator sets the Marshaler and the Unmarshaler of a field with an interface type,
+// for storing values of different concrete types. The values are stored as JSON objects,
+// where the given key holds the name of their concrete type, and they are decoded into
+// the type that matches this name when the field is scanned. For example:
+//
+//	field.JSON("payload", new(Payload)).
+//		Discriminator("type", map[string]reflect.Type{
+//			"created": reflect.TypeOf(&Created{}),
+//			"deleted": reflect.TypeOf(&Deleted{}),
+//		})
+//
+// The concrete types must implement the interface, and be encoded as JSON objects (i.e.
+// structs or maps). Values of other types fail the builders, and stored values with a
+// missing or an unknown name fail the scanning with an error.
+func (b *jsonBuilder) Discriminator(key string, types map[string]reflect.Type) *jsonBuilder {
+	if b.desc.err != nil {
+		return b
+	}
+	if b.typ.Kind() != reflect.Interface {
+		b.desc.err = fmt.Errorf("discriminator is supported only for interface types, got %s", b.desc.Info)
+		return b
+	}
+	if key == "" || len(types) == 0 {
+		b.desc.err = fmt.Errorf("discriminator key and types of field %q must not be empty", b.desc.Name)
+		return b
+	}
+	names := make(map[reflect.Type]string, len(types))
+	for name, t := range types {
+		switch {
+		case t == nil || !t.Implements(b.typ):
+			b.desc.err = fmt.Errorf("discriminator type %v of %q does not implement %s", t, name, b.desc.Info)
+		case indirect(t).Kind() != reflect.Struct && indirect(t).Kind() != reflect.Map:
+			b.desc.err = fmt.Errorf("discriminator type %v of %q is not encoded as a JSON object", t, name)
+		case names[t] != "":
+			b.desc.err = fmt.Errorf("discriminator type %v is used by both %q and %q", t, names[t], name)
+		default:
+			if err := checkJSONType(t, make(map[reflect.Type]bool)); err != nil {
+				b.desc.err = err
+			}
+		}
+		if b.desc.err != nil {
+			return b
+		}
+		names[t] = name
+	}
+	b.desc.Marshaler = reflect.MakeFunc(reflect.FuncOf([]reflect.Type{b.typ}, []reflect.Type{bytesType, errorType}, false), func(in []reflect.Value) []reflect.Value {
+		buf, err := marshalDiscriminated(in[0], key, names)
+		return []reflect.Value{reflect.ValueOf(buf), errorValue(err)}
+	}).Interface()
+	b.desc.Unmarshaler = reflect.MakeFunc(reflect.FuncOf([]reflect.Type{bytesType, reflect.PtrTo(b.typ)}, []reflect.Type{errorType}, false), func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{errorValue(unmarshalDiscriminated(in[0].Bytes(), in[1].Elem(), key, types))}
+	}).Interface()
+	return b
+}
+
+// marshalDiscriminated encodes the concrete value of the given interface value
+// as a JSON object, and adds its discriminator name to the object.
+func marshalDiscriminated(v reflect.Value, key string, names map[reflect.Type]string) ([]byte, error) {
+	if v.IsNil() {
+		return []byte("null"), nil
+	}
+	v = v.Elem()
+	name, ok := names[v.Type()]
+	if !ok {
+		return nil, fmt.Errorf("unknown discriminator type %s", v.Type())
+	}
+	buf, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(buf, &obj); err != nil || obj == nil {
+		return nil, fmt.Errorf("discriminator type %s is not encoded as a JSON object", v.Type())
+	}
+	if obj[key], err = json.Marshal(name); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// unmarshalDiscriminated decodes the given JSON object into the concrete type that
+// matches its discriminator name, and sets it to the given interface value.
+func unmarshalDiscriminated(data []byte, v reflect.Value, key string, types map[string]reflect.Type) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if obj == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	var name string
+	if raw, ok := obj[key]; !ok || json.Unmarshal(raw, &name) != nil {
+		return fmt.Errorf("missing discriminator key %q", key)
+	}
+	t, ok := types[name]
+	if !ok {
+		return fmt.Errorf("unknown discriminator %q for key %q", name, key)
+	}
+	nv := reflect.New(indirect(t))
+	if err := json.Unmarshal(data, nv.Interface()); err != nil {
+		return err
+	}
+	if t.Kind() != reflect.Ptr {
+		nv = nv.Elem()
+	}
+	v.Set(nv)
+	return nil
+}
+
+// errorValue returns the given error as a reflect.Value of the error type.
+func errorValue(err error) reflect.Value {
+	if err == nil {
+		return reflect.Zero(errorType)
+	}
+	return reflect.ValueOf(&err).Elem()
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *jsonBuilder) Immutable() *jsonBuilder {
 	b.desc.Immutable = true
@@ -597,6 +965,13 @@ func (b *jsonBuilder) Immutable() *jsonBuilder {
 
 // Comment sets the comment of the field.
 func (b *jsonBuilder) Comment(c string) *jsonBuilder {
+	b.desc.Comment = c
+	return b
+}
+
+// Sensitive fields not printable and not serializable.
+func (b *jsonBuilder) Sensitive() *jsonBuilder {
+	b.desc.Sensitive = true
 	return b
 }
 
@@ -635,6 +1010,13 @@ func (b *jsonBuilder) Annotations(annotations ...Annotation) *jsonBuilder {
 
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *jsonBuilder) Descriptor() *Descriptor {
+	// Types that cannot be encoded by encoding/json are allowed
+	// only if both custom marshaler and unmarshaler were provided.
+	if b.desc.err == nil && (b.desc.Marshaler == nil || b.desc.Unmarshaler == nil) {
+		if err := checkJSONType(b.typ, make(map[reflect.Type]bool)); err != nil {
+			b.desc.err = err
+		}
+	}
 	return b.desc
 }
 
@@ -727,6 +1109,7 @@ func (b *enumBuilder) Immutable() *enumBuilder {
 
 // Comment sets the comment of the field.
 func (b *enumBuilder) Comment(c string) *enumBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -823,6 +1206,7 @@ func (b *uuidBuilder) Immutable() *uuidBuilder {
 
 // Comment sets the comment of the field.
 func (b *uuidBuilder) Comment(c string) *uuidBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -887,6 +1271,32 @@ type Annotation interface {
 	Name() string
 }
 
+// JSONSchemaAnnotation is a builtin field annotation for attaching a JSON Schema
+// fragment (see https://json-schema.org) that describes the values of the field.
+// It is not used by ent itself, and it is exposed to codegen extensions (like API
+// spec generators) using the gen.Field.JSONSchema method.
+type JSONSchemaAnnotation struct {
+	// Schema holds the JSON encoding of the schema fragment.
+	Schema json.RawMessage `json:"schema,omitempty"`
+}
+
+// Name describes the annotation name.
+func (JSONSchemaAnnotation) Name() string {
+	return "JSONSchema"
+}
+
+// JSONSchema returns an annotation that describes the field values using the
+// given JSON Schema fragment. For example:
+//
+//	field.JSON("url", &url.URL{}).
+//		Annotations(field.JSONSchema(`{"type": "string", "format": "uri"}`))
+//
+// Note that the fragment must be a valid JSON document. Otherwise, the loading
+// of the schema fails when the annotation is encoded.
+func JSONSchema(schema string) *JSONSchemaAnnotation {
+	return &JSONSchemaAnnotation{Schema: json.RawMessage(schema)}
+}
+
 // A Descriptor for field configuration.
 type Descriptor struct {
 	Tag           string                  // struct tag.
@@ -900,11 +1310,16 @@ type Descriptor struct {
 	Default       interface{}             // default value on create.
 	UpdateDefault interface{}             // default value on update.
 	Validators    []interface{}           // validator functions.
+	Marshaler     interface{}             // custom JSON marshaler.
+	Unmarshaler   interface{}             // custom JSON unmarshaler.
+	ScanCoerce    interface{}             // JSON coercion on scan.
 	StorageKey    string                  // sql column or gremlin property.
 	Enums         []struct{ N, V string } // enum values.
 	Sensitive     bool                    // sensitive info string field.
 	SchemaType    map[string]string       // override the schema type.
+	Comment       string                  // field comment.
 	Annotations   []Annotation            // field annotations.
+	EmitNull      *bool                   // JSON null policy.
 	err           error
 }
 
@@ -924,7 +1339,6 @@ func (d *Descriptor) goType(typ interface{}, expectType reflect.Type) {
 			Name:    tv.Name(),
 			Kind:    tv.Kind(),
 			PkgPath: tv.PkgPath(),
-			Methods: make(map[string]struct{ In, Out []*RType }, t.NumMethod()),
 		},
 	}
 	switch t.Kind() {
@@ -933,34 +1347,44 @@ func (d *Descriptor) goType(typ interface{}, expectType reflect.Type) {
 	}
 	switch {
 	case t.Kind() == expectType.Kind() && t.ConvertibleTo(expectType):
+		info.RType.Methods = make(map[string]struct{ In, Out []*RType })
 	case t.Implements(valueScannerType):
-		n := t.NumMethod()
-		for i := 0; i < n; i++ {
-			m := t.Method(i)
-			in := make([]*RType, m.Type.NumIn()-1)
-			for j := range in {
-				arg := m.Type.In(j + 1)
-				in[j] = &RType{Name: arg.Name(), Kind: arg.Kind(), PkgPath: arg.PkgPath()}
-			}
-			out := make([]*RType, m.Type.NumOut())
-			for j := range out {
-				ret := m.Type.Out(j)
-				out[j] = &RType{Name: ret.Name(), Kind: ret.Kind(), PkgPath: ret.PkgPath()}
-			}
-			info.RType.Methods[m.Name] = struct{ In, Out []*RType }{in, out}
-		}
+		info.RType.Methods = methods(t)
 	default:
 		d.err = fmt.Errorf("GoType must be a %q type or ValueScanner", expectType)
 	}
 	d.Info = info
 }
 
+// methods returns the serializable method set of the given type.
+func methods(t reflect.Type) map[string]struct{ In, Out []*RType } {
+	n := t.NumMethod()
+	ms := make(map[string]struct{ In, Out []*RType }, n)
+	for i := 0; i < n; i++ {
+		m := t.Method(i)
+		in := make([]*RType, m.Type.NumIn()-1)
+		for j := range in {
+			arg := m.Type.In(j + 1)
+			in[j] = &RType{Name: arg.Name(), Kind: arg.Kind(), PkgPath: arg.PkgPath()}
+		}
+		out := make([]*RType, m.Type.NumOut())
+		for j := range out {
+			ret := m.Type.Out(j)
+			out[j] = &RType{Name: ret.Name(), Kind: ret.Kind(), PkgPath: ret.PkgPath()}
+		}
+		ms[m.Name] = struct{ In, Out []*RType }{in, out}
+	}
+	return ms
+}
+
 var (
 	boolType         = reflect.TypeOf(false)
 	bytesType        = reflect.TypeOf([]byte(nil))
 	timeType         = reflect.TypeOf(time.Time{})
 	stringType       = reflect.TypeOf("")
 	valueScannerType = reflect.TypeOf((*ValueScanner)(nil)).Elem()
+	valuerType       = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	errorType        = reflect.TypeOf((*error)(nil)).Elem()
 )
 
 // ValueScanner is the interface that groups the Value and the Scan methods.
@@ -977,6 +1401,144 @@ func indirect(t reflect.Type) reflect.Type {
 	return t
 }
 
+// structFields returns the exported fields of the given struct
+// type that are encoded by the encoding/json package. Like in
+// encoding/json, the fields of embedded structs are promoted to
+// the outer struct, unless their embedding field has a JSON name.
+func structFields(t reflect.Type) []*RStructField {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	type candidate struct {
+		*RStructField
+		depth  int
+		tagged bool
+	}
+	var (
+		all   []candidate
+		byKey = make(map[string][]candidate)
+		walk  func(reflect.Type, int, map[reflect.Type]bool)
+	)
+	walk = func(t reflect.Type, depth int, seen map[reflect.Type]bool) {
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			ft := indirect(f.Type)
+			if f.Anonymous && f.PkgPath != "" && ft.Kind() != reflect.Struct || !f.Anonymous && f.PkgPath != "" {
+				continue
+			}
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			if name == "" && f.Anonymous && ft.Kind() == reflect.Struct {
+				walk(ft, depth+1, seen)
+				continue
+			}
+			c := candidate{
+				RStructField: &RStructField{Name: f.Name, Key: f.Name, Kind: ft.Kind()},
+				depth:        depth,
+				tagged:       name != "",
+			}
+			if c.tagged {
+				c.Key = name
+			}
+			all = append(all, c)
+			byKey[c.Key] = append(byKey[c.Key], c)
+		}
+	}
+	walk(t, 0, make(map[reflect.Type]bool))
+	var fields []*RStructField
+	for _, f := range all {
+		// Resolve conflicts using the rules of encoding/json. The shallowest
+		// field wins, then, the tagged one. Otherwise, all fields are dropped.
+		var dominant []candidate
+		for _, c := range byKey[f.Key] {
+			switch {
+			case len(dominant) == 0 || c.depth < dominant[0].depth:
+				dominant = []candidate{c}
+			case c.depth == dominant[0].depth:
+				dominant = append(dominant, c)
+			}
+		}
+		if len(dominant) > 1 {
+			var tagged []candidate
+			for _, c := range dominant {
+				if c.tagged {
+					tagged = append(tagged, c)
+				}
+			}
+			dominant = tagged
+		}
+		if len(dominant) == 1 && dominant[0].RStructField == f.RStructField {
+			fields = append(fields, f.RStructField)
+		}
+	}
+	return fields
+}
+
+// jsonUnsupported holds the Go kinds that are
+// not supported by the encoding/json package.
+var jsonUnsupported = map[reflect.Kind]bool{
+	reflect.Chan:          true,
+	reflect.Func:          true,
+	reflect.Complex64:     true,
+	reflect.Complex128:    true,
+	reflect.UnsafePointer: true,
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// checkJSONType returns an error if values of the given type cannot be encoded
+// by the encoding/json package. i.e. the type, or one of its elements or struct
+// fields, has one of the unsupported kinds, and does not implement json.Marshaler.
+func checkJSONType(t reflect.Type, seen map[reflect.Type]bool) error {
+	if seen[t] {
+		return nil
+	}
+	seen[t] = true
+	if implements(t, jsonMarshalerType) {
+		return nil
+	}
+	switch k := t.Kind(); {
+	case jsonUnsupported[k]:
+		return fmt.Errorf("type %s (%s) is not supported by encoding/json", t, k)
+	case k == reflect.Ptr, k == reflect.Slice, k == reflect.Array:
+		return checkJSONType(t.Elem(), seen)
+	case k == reflect.Map:
+		switch kk := t.Key().Kind(); {
+		case kk == reflect.String, kk >= reflect.Int && kk <= reflect.Uintptr, implements(t.Key(), textMarshalerType):
+		default:
+			return fmt.Errorf("map key type %s is not supported by encoding/json", t.Key())
+		}
+		return checkJSONType(t.Elem(), seen)
+	case k == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" && !f.Anonymous || f.Tag.Get("json") == "-" {
+				continue
+			}
+			if err := checkJSONType(f.Type, seen); err != nil {
+				return fmt.Errorf("struct field %s: %v", f.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// implements reports if the given type, or a pointer to it, implements the interface.
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(iface)
+}
+
 func pkgPath(t reflect.Type) string {
 	pkg := t.PkgPath()
 	if pkg != "" {
@@ -6,11 +6,14 @@ package field_test
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"net"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -300,6 +303,63 @@ func TestJSON(t *testing.T) {
 	assert.Equal(t, field.TypeJSON, fd.Info.Type)
 	assert.Equal(t, "map[string]string", fd.Info.String())
 
+	fd = field.JSON("secrets", map[string]string{}).
+		Sensitive().
+		Descriptor()
+	assert.True(t, fd.Sensitive)
+
+	fd = field.JSON("url", &url.URL{}).
+		Annotations(field.JSONSchema(`{"type": "string", "format": "uri"}`)).
+		Descriptor()
+	assert.Len(t, fd.Annotations, 1)
+	ant := fd.Annotations[0].(*field.JSONSchemaAnnotation)
+	assert.Equal(t, "JSONSchema", ant.Name())
+	assert.JSONEq(t, `{"type": "string", "format": "uri"}`, string(ant.Schema))
+
+	fd = field.JSON("tags", []string{}).
+		Values("a", "b").
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.Len(t, fd.Validators, 1)
+	validate := fd.Validators[0].(func([]string) error)
+	assert.NoError(t, validate([]string{"a", "b", "a"}))
+	assert.NoError(t, validate(nil))
+	assert.EqualError(t, validate([]string{"a", "c"}), `invalid enum value for tags field: "c"`)
+	type Tag string
+	fd = field.JSON("tags", []Tag{}).
+		Values("a").
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.EqualError(t, fd.Validators[0].(func([]Tag) error)([]Tag{"b"}), `invalid enum value for tags field: "b"`)
+	fd = field.JSON("ints", []int{}).
+		Values("a").
+		Descriptor()
+	assert.Error(t, fd.Err())
+
+	fd = field.JSON("bad", make(chan int)).Descriptor()
+	assert.EqualError(t, fd.Err(), "type chan int (chan) is not supported by encoding/json")
+	fd = field.JSON("bad", []func(){}).Descriptor()
+	assert.EqualError(t, fd.Err(), "type func() (func) is not supported by encoding/json")
+	fd = field.JSON("bad", map[string]complex128{}).Descriptor()
+	assert.EqualError(t, fd.Err(), "type complex128 (complex128) is not supported by encoding/json")
+	fd = field.JSON("bad", map[[2]int]string{}).Descriptor()
+	assert.EqualError(t, fd.Err(), "map key type [2]int is not supported by encoding/json")
+	fd = field.JSON("bad", &struct {
+		C    chan int
+		Skip func() `json:"-"`
+	}{}).Descriptor()
+	assert.EqualError(t, fd.Err(), "struct field C: type chan int (chan) is not supported by encoding/json")
+	fd = field.JSON("custom", make(chan int)).
+		Marshaler(func(chan int) ([]byte, error) { return nil, nil }).
+		Unmarshaler(func([]byte, *chan int) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err(), "custom encoding is allowed for any type")
+	type node struct {
+		Children []*node
+		value    func()
+	}
+	assert.NoError(t, field.JSON("tree", &node{}).Descriptor().Err())
+
 	fd = field.JSON("dir", http.Dir("dir")).
 		Optional().
 		Descriptor()
@@ -318,6 +378,77 @@ func TestJSON(t *testing.T) {
 	assert.Equal(t, field.TypeJSON, fd.Info.Type)
 	assert.Equal(t, "[]string", fd.Info.String())
 
+	fd = field.Strings("strings").
+		Default([]string{"a"}).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.Equal(t, []string{"a"}, fd.Default)
+	fd = field.Strings("strings").
+		Default([]int{1}).
+		Descriptor()
+	assert.Error(t, fd.Err(), "invalid default type")
+	fd = field.Strings("strings").
+		Validate(func([]string) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.Len(t, fd.Validators, 1)
+	fd = field.Strings("strings").
+		Validate(func([]int) error { return nil }).
+		Descriptor()
+	assert.Error(t, fd.Err(), "invalid validator type")
+	fd = field.JSON("url", &url.URL{}).
+		Marshaler(func(*url.URL) ([]byte, error) { return nil, nil }).
+		Unmarshaler(func([]byte, **url.URL) error { return nil }).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.NotNil(t, fd.Marshaler)
+	assert.NotNil(t, fd.Unmarshaler)
+	fd = field.Strings("strings").
+		Marshaler(func([]string) []byte { return nil }).
+		Descriptor()
+	assert.Error(t, fd.Err(), "invalid marshaler type")
+	fd = field.Strings("strings").
+		Unmarshaler(func([]byte, []string) error { return nil }).
+		Descriptor()
+	assert.Error(t, fd.Err(), "invalid unmarshaler type")
+	fd = field.Strings("strings").
+		MaxLen(9).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.Len(t, fd.Validators, 1)
+	maxlen := fd.Validators[0].(func([]string) error)
+	assert.NoError(t, maxlen([]string{"a", "b"}))
+	assert.Error(t, maxlen([]string{"a", "bc"}), `["a","bc"] is 10 bytes`)
+	fd = field.Strings("strings").
+		MaxLen(3).
+		Marshaler(func(s []string) ([]byte, error) { return []byte(strings.Join(s, ",")), nil }).
+		Descriptor()
+	maxlen = fd.Validators[0].(func([]string) error)
+	assert.NoError(t, maxlen([]string{"a", "b"}), "marshaler is used for computing the length")
+	assert.Error(t, maxlen([]string{"a", "bc"}))
+	fd = field.JSON("blob", []byte{}).
+		Raw().
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	buf, err := fd.Marshaler.(func([]byte) ([]byte, error))([]byte(`{"a":1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(buf))
+	buf, err = fd.Marshaler.(func([]byte) ([]byte, error))(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(buf))
+	var blob []byte
+	assert.NoError(t, fd.Unmarshaler.(func([]byte, *[]byte) error)([]byte(`[1]`), &blob))
+	assert.Equal(t, `[1]`, string(blob))
+	fd = field.JSON("raw", json.RawMessage{}).
+		Raw().
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.NotNil(t, fd.Marshaler.(func(json.RawMessage) ([]byte, error)))
+	fd = field.Strings("strings").
+		Raw().
+		Descriptor()
+	assert.Error(t, fd.Err(), "raw is not supported for strings")
+
 	fd = field.JSON("values", &url.Values{}).Descriptor()
 	assert.Equal(t, "net/url", fd.Info.PkgPath)
 	fd = field.JSON("values", []url.Values{}).Descriptor()
@@ -328,8 +459,165 @@ func TestJSON(t *testing.T) {
 	assert.Equal(t, "net/url", fd.Info.PkgPath)
 	fd = field.JSON("values", map[string]*url.Values{}).Descriptor()
 	assert.Equal(t, "net/url", fd.Info.PkgPath)
+	assert.Equal(t, reflect.Map, fd.Info.RType.Kind)
+	assert.Empty(t, fd.Info.RType.Fields)
+
+	fd = field.JSON("info", &struct {
+		Name  string `json:"name,omitempty"`
+		Skip  string `json:"-"`
+		Count *int
+		priv  int
+	}{}).Descriptor()
+	assert.Equal(t, reflect.Struct, fd.Info.RType.Kind)
+	assert.Equal(t, []*field.RStructField{
+		{Name: "Name", Key: "name", Kind: reflect.String},
+		{Name: "Count", Key: "Count", Kind: reflect.Int},
+	}, fd.Info.RType.Fields)
+
+	fd = field.JSON("info", &Extended{}).Descriptor()
+	assert.Equal(t, []*field.RStructField{
+		{Name: "Name", Key: "name", Kind: reflect.String},
+		{Name: "Base", Key: "base", Kind: reflect.Struct},
+		{Name: "ID", Key: "ID", Kind: reflect.Int},
+		{Name: "Tags", Key: "tags", Kind: reflect.Slice},
+	}, fd.Info.RType.Fields)
+
+	fd = field.JSON("point", Point{}).Descriptor()
+	assert.True(t, fd.Info.ValueScanner())
+	fd = field.JSON("point", &Point{}).Descriptor()
+	assert.False(t, fd.Info.ValueScanner())
+	fd = field.JSON("urls", []*url.URL{}).Descriptor()
+	assert.False(t, fd.Info.ValueScanner())
+}
+
+func TestJSON_EmitNull(t *testing.T) {
+	fd := field.Ints("ints").Descriptor()
+	assert.Nil(t, fd.EmitNull)
+	fd = field.Ints("ints").EmitNull(false).Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.False(t, *fd.EmitNull)
+	fd = field.JSON("url", &url.URL{}).EmitNull(true).Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.True(t, *fd.EmitNull)
+	fd = field.JSON("point", Point{}).EmitNull(false).Descriptor()
+	assert.Error(t, fd.Err())
+}
+
+func TestJSON_ScanCoerce(t *testing.T) {
+	fd := field.Ints("ints").Descriptor()
+	assert.Nil(t, fd.ScanCoerce)
+	fd = field.Ints("ints").
+		ScanCoerce(func(raw json.RawMessage) (json.RawMessage, error) {
+			return json.RawMessage("[1]"), nil
+		}).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	coerce := fd.ScanCoerce.(func(json.RawMessage) (json.RawMessage, error))
+	raw, err := coerce(json.RawMessage(`["1"]`))
+	assert.NoError(t, err)
+	assert.Equal(t, "[1]", string(raw))
+	fd = field.Ints("ints").ScanCoerce(nil).Descriptor()
+	assert.Error(t, fd.Err())
+}
+
+func TestJSON_Discriminator(t *testing.T) {
+	types := map[string]reflect.Type{
+		"created": reflect.TypeOf(&Created{}),
+		"deleted": reflect.TypeOf(Deleted{}),
+	}
+	fd := field.JSON("payload", new(Payload)).
+		Discriminator("type", types).
+		Descriptor()
+	assert.NoError(t, fd.Err())
+	assert.Equal(t, "field_test.Payload", fd.Info.String())
+	assert.True(t, fd.Info.Nillable)
+	marshal := fd.Marshaler.(func(Payload) ([]byte, error))
+	unmarshal := fd.Unmarshaler.(func([]byte, *Payload) error)
+
+	buf, err := marshal(&Created{Name: "a8m"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"created","name":"a8m"}`, string(buf))
+	var p Payload
+	assert.NoError(t, unmarshal(buf, &p))
+	assert.Equal(t, &Created{Name: "a8m"}, p)
+	buf, err = marshal(Deleted{ID: 1})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"deleted","id":1}`, string(buf))
+	assert.NoError(t, unmarshal(buf, &p))
+	assert.Equal(t, Deleted{ID: 1}, p)
+	buf, err = marshal(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(buf))
+	assert.NoError(t, unmarshal(buf, &p))
+	assert.Nil(t, p)
+
+	_, err = marshal(&Deleted{})
+	assert.EqualError(t, err, "unknown discriminator type *field_test.Deleted")
+	assert.EqualError(t, unmarshal([]byte(`{"id":1}`), &p), `missing discriminator key "type"`)
+	assert.EqualError(t, unmarshal([]byte(`{"type":"updated"}`), &p), `unknown discriminator "updated" for key "type"`)
+
+	fd = field.JSON("payload", &Created{}).Discriminator("type", types).Descriptor()
+	assert.EqualError(t, fd.Err(), "discriminator is supported only for interface types, got *field_test.Created")
+	fd = field.JSON("payload", new(Payload)).Discriminator("type", map[string]reflect.Type{"point": reflect.TypeOf(&Point{})}).Descriptor()
+	assert.EqualError(t, fd.Err(), `discriminator type *field_test.Point of "point" does not implement field_test.Payload`)
+	fd = field.JSON("payload", new(Payload)).Discriminator("", types).Descriptor()
+	assert.EqualError(t, fd.Err(), `discriminator key and types of field "payload" must not be empty`)
+	fd = field.JSON("payload", new(Payload)).Raw().Discriminator("type", types).Descriptor()
+	assert.EqualError(t, fd.Err(), "raw is supported only for byte slices, got field_test.Payload", "errors of previous options are kept")
+}
+
+type (
+	// Payload is implemented by the Created and Deleted types.
+	Payload interface {
+		payload()
+	}
+	Created struct {
+		Name string `json:"name"`
+	}
+	Deleted struct {
+		ID int `json:"id"`
+	}
+)
+
+func (*Created) payload() {}
+func (Deleted) payload()  {}
+
+// Point implements the driver.Valuer and the sql.Scanner interfaces.
+type Point [2]float64
+
+func (p Point) Value() (driver.Value, error) { return json.Marshal(p[:]) }
+
+func (p *Point) Scan(v interface{}) error {
+	if b, ok := v.([]byte); ok {
+		return json.Unmarshal(b, (*[2]float64)(p))
+	}
+	return nil
 }
 
+type (
+	Base struct {
+		ID   int
+		Name string
+	}
+	Meta struct {
+		Tags  []string `json:"tags"`
+		Label string
+	}
+	Other struct {
+		Label string
+	}
+	Extended struct {
+		Name string `json:"name"`
+		Base `json:"base"`
+		*base
+		Meta
+		Other
+	}
+	base struct {
+		ID int
+	}
+)
+
 func TestField_Tag(t *testing.T) {
 	fd := field.Bool("expired").
 		StructTag(`json:"expired,omitempty"`).
@@ -337,6 +625,17 @@ func TestField_Tag(t *testing.T) {
 	assert.Equal(t, `json:"expired,omitempty"`, fd.Tag)
 }
 
+func TestField_Comment(t *testing.T) {
+	fd := field.JSON("raw", json.RawMessage{}).
+		Comment("free-form metadata").
+		Descriptor()
+	assert.Equal(t, "free-form metadata", fd.Comment)
+	fd = field.Int("age").
+		Comment("age in years").
+		Descriptor()
+	assert.Equal(t, "age in years", fd.Comment)
+}
+
 type Role string
 
 func (Role) Values() []string {
@@ -181,6 +181,7 @@ func (b *intBuilder) Nillable() *intBuilder {
 
 // Comment sets the comment of the field.
 func (b *intBuilder) Comment(c string) *intBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -321,6 +322,7 @@ func (b *uintBuilder) Nillable() *uintBuilder {
 
 // Comment sets the comment of the field.
 func (b *uintBuilder) Comment(c string) *uintBuilder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -471,6 +473,7 @@ func (b *int8Builder) Nillable() *int8Builder {
 
 // Comment sets the comment of the field.
 func (b *int8Builder) Comment(c string) *int8Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -621,6 +624,7 @@ func (b *int16Builder) Nillable() *int16Builder {
 
 // Comment sets the comment of the field.
 func (b *int16Builder) Comment(c string) *int16Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -771,6 +775,7 @@ func (b *int32Builder) Nillable() *int32Builder {
 
 // Comment sets the comment of the field.
 func (b *int32Builder) Comment(c string) *int32Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -921,6 +926,7 @@ func (b *int64Builder) Nillable() *int64Builder {
 
 // Comment sets the comment of the field.
 func (b *int64Builder) Comment(c string) *int64Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1061,6 +1067,7 @@ func (b *uint8Builder) Nillable() *uint8Builder {
 
 // Comment sets the comment of the field.
 func (b *uint8Builder) Comment(c string) *uint8Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1201,6 +1208,7 @@ func (b *uint16Builder) Nillable() *uint16Builder {
 
 // Comment sets the comment of the field.
 func (b *uint16Builder) Comment(c string) *uint16Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1341,6 +1349,7 @@ func (b *uint32Builder) Nillable() *uint32Builder {
 
 // Comment sets the comment of the field.
 func (b *uint32Builder) Comment(c string) *uint32Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1481,6 +1490,7 @@ func (b *uint64Builder) Nillable() *uint64Builder {
 
 // Comment sets the comment of the field.
 func (b *uint64Builder) Comment(c string) *uint64Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1639,6 +1649,7 @@ func (b *float64Builder) Nillable() *float64Builder {
 
 // Comment sets the comment of the field.
 func (b *float64Builder) Comment(c string) *float64Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -1785,6 +1796,7 @@ func (b *float32Builder) Nillable() *float32Builder {
 
 // Comment sets the comment of the field.
 func (b *float32Builder) Comment(c string) *float32Builder {
+	b.desc.Comment = c
 	return b
 }
 
@@ -167,6 +167,15 @@ type RType struct {
 	Kind    reflect.Kind
 	PkgPath string
 	Methods map[string]struct{ In, Out []*RType }
+	Fields  []*RStructField `json:",omitempty"`
+}
+
+// RStructField holds a serializable information of an exported
+// struct field. Used by the entc package for JSON fields.
+type RStructField struct {
+	Name string       // Go field name.
+	Key  string       // JSON key.
+	Kind reflect.Kind // Kind of the field (after indirection).
 }
 
 // TypeEqual tests if the RType is equal to given reflect.Type.
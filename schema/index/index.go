@@ -4,12 +4,21 @@
 
 package index
 
+// Annotation is used to attach arbitrary metadata to the index object in codegen.
+// The object must be serializable to JSON raw value (e.g. struct, map or slice).
+// Template extensions can retrieve this metadata and use it inside their templates.
+type Annotation interface {
+	// Name defines the name of the annotation to be retrieved by the codegen.
+	Name() string
+}
+
 // A Descriptor for index configuration.
 type Descriptor struct {
-	Unique     bool     // unique index.
-	Edges      []string // edge columns.
-	Fields     []string // field columns.
-	StorageKey string   // custom index name.
+	Unique      bool         // unique index.
+	Edges       []string     // edge columns.
+	Fields      []string     // field columns.
+	StorageKey  string       // custom index name.
+	Annotations []Annotation // index annotations.
 }
 
 // Builder for indexes on vertex columns and edges in the graph.
@@ -97,6 +106,17 @@ func (b *Builder) StorageKey(key string) *Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the index object to be used by
+// codegen extensions.
+//
+//	index.Fields("doc").
+//		Annotations(entsql.IndexType("GIN"))
+//
+func (b *Builder) Annotations(annotations ...Annotation) *Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Descriptor interface.
 func (b *Builder) Descriptor() *Descriptor {
 	return b.desc